@@ -25,6 +25,6 @@ func main() {
 
 	// Execute CLI
 	if err := cli.Execute(); err != nil {
-		os.Exit(1)
+		os.Exit(cli.ExitCode(err))
 	}
 }
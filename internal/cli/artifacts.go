@@ -0,0 +1,226 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+	"github.com/spf13/cobra"
+)
+
+// shortIDLength is how much of a session ID listings show.
+const shortIDLength = 8
+
+// artifactsCmd creates the `samedi artifacts` command group.
+func artifactsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "artifacts",
+		Short: "List and open session artifacts",
+		Long: `List and open the URLs and files recorded with 'samedi stop --artifact'.
+
+Local file artifacts are checked on every listing, so moved or deleted
+files show up as missing.
+
+Examples:
+  samedi artifacts list                 # All artifacts, newest first
+  samedi artifacts list rust-async      # Artifacts for one plan
+  samedi artifacts list --missing       # Only files that no longer exist
+  samedi artifacts open 3f2a9c1e        # Open every artifact of a session
+  samedi artifacts open 3f2a9c1e 2      # Open the second artifact`,
+	}
+
+	cmd.AddCommand(artifactsListCmd())
+	cmd.AddCommand(artifactsOpenCmd())
+
+	return cmd
+}
+
+// artifactsListCmd creates the `samedi artifacts list` subcommand.
+func artifactsListCmd() *cobra.Command {
+	var missingOnly bool
+
+	cmd := &cobra.Command{
+		Use:   "list [plan-id]",
+		Short: "List session artifacts",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			svc, err := getSessionService(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			var sessions []*session.Session
+			if len(args) == 1 {
+				sessions, err = svc.GetByPlan(context.Background(), args[0])
+			} else {
+				sessions, err = svc.ListAll(context.Background())
+			}
+			if err != nil {
+				return err
+			}
+
+			entries := collectArtifacts(sessions, missingOnly)
+
+			jsonOutput, err := cmd.Flags().GetBool("json")
+			if err != nil {
+				return fmt.Errorf("failed to get json flag: %w", err)
+			}
+			if jsonOutput {
+				return printJSON(entries)
+			}
+
+			renderArtifacts(os.Stdout, entries)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&missingOnly, "missing", false, "only show file artifacts that no longer exist")
+
+	return cmd
+}
+
+// artifactsOpenCmd creates the `samedi artifacts open` subcommand.
+func artifactsOpenCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "open <session-id> [number]",
+		Short: "Open a session's artifacts in the browser or default app",
+		Long: `Open a session's artifacts. URLs open in the default browser and
+files in their default application.
+
+The session ID may be shortened to any unambiguous prefix, such as the
+ID shown by 'samedi artifacts list'. Without a number, every artifact of
+the session is opened.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			svc, err := getSessionService(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			sess, err := svc.Find(context.Background(), args[0])
+			if err != nil {
+				return err
+			}
+
+			selected, err := selectArtifacts(sess, args[1:])
+			if err != nil {
+				return err
+			}
+
+			for _, artifact := range selected {
+				if err := session.OpenArtifact(artifact); err != nil {
+					return err
+				}
+				fmt.Printf("Opened %s\n", artifact.Target)
+			}
+			return nil
+		},
+	}
+}
+
+// artifactEntry is one artifact with the session it belongs to.
+type artifactEntry struct {
+	SessionID string    `json:"session_id"`
+	PlanID    string    `json:"plan_id"`
+	ChunkID   string    `json:"chunk_id,omitempty"`
+	StartTime time.Time `json:"start_time"`
+	Number    int       `json:"number"` // 1-based position within the session
+	session.Artifact
+}
+
+// collectArtifacts flattens session artifacts in session order.
+func collectArtifacts(sessions []*session.Session, missingOnly bool) []artifactEntry {
+	entries := make([]artifactEntry, 0)
+	for _, sess := range sessions {
+		for i, raw := range sess.Artifacts {
+			artifact := session.ParseArtifact(raw)
+			if missingOnly && artifact.Exists {
+				continue
+			}
+			entries = append(entries, artifactEntry{
+				SessionID: sess.ID,
+				PlanID:    sess.PlanID,
+				ChunkID:   sess.ChunkID,
+				StartTime: sess.StartTime,
+				Number:    i + 1,
+				Artifact:  artifact,
+			})
+		}
+	}
+	return entries
+}
+
+// selectArtifacts returns the artifact named by an optional 1-based number
+// argument, or all of the session's artifacts.
+func selectArtifacts(sess *session.Session, args []string) ([]session.Artifact, error) {
+	if len(sess.Artifacts) == 0 {
+		return nil, fmt.Errorf("session %s has no artifacts", shortID(sess.ID))
+	}
+
+	if len(args) == 0 {
+		artifacts := make([]session.Artifact, 0, len(sess.Artifacts))
+		for _, raw := range sess.Artifacts {
+			artifacts = append(artifacts, session.ParseArtifact(raw))
+		}
+		return artifacts, nil
+	}
+
+	number, err := strconv.Atoi(args[0])
+	if err != nil || number < 1 || number > len(sess.Artifacts) {
+		return nil, fmt.Errorf("artifact number must be between 1 and %d, got %q", len(sess.Artifacts), args[0])
+	}
+	return []session.Artifact{session.ParseArtifact(sess.Artifacts[number-1])}, nil
+}
+
+// renderArtifacts prints artifacts as a table.
+func renderArtifacts(w io.Writer, entries []artifactEntry) {
+	if len(entries) == 0 {
+		fmt.Fprintln(w, "No artifacts found.")
+		fmt.Fprintln(w, "\nRecord one with: samedi stop --artifact <url-or-path>")
+		return
+	}
+
+	tw := components.NewTabWriter(w, 2)
+	fmt.Fprintln(tw, "SESSION\t#\tDATE\tPLAN\tKIND\tARTIFACT")
+	missing := 0
+	for _, entry := range entries {
+		target := entry.Raw
+		if entry.Kind == session.ArtifactFile && !entry.Exists {
+			target += " (missing)"
+			missing++
+		}
+		planRef := entry.PlanID
+		if entry.ChunkID != "" {
+			planRef += "/" + entry.ChunkID
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%s\t%s\t%s\n",
+			shortID(entry.SessionID),
+			entry.Number,
+			entry.StartTime.Format("2006-01-02"),
+			planRef,
+			entry.Kind,
+			target,
+		)
+	}
+	_ = tw.Flush()
+
+	if missing > 0 {
+		fmt.Fprintf(w, "\n%d file artifact(s) missing\n", missing)
+	}
+}
+
+// shortID abbreviates a session ID for display.
+func shortID(id string) string {
+	if len(id) <= shortIDLength {
+		return id
+	}
+	return id[:shortIDLength]
+}
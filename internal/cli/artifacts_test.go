@@ -0,0 +1,101 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func artifactTestSessions(t *testing.T) ([]*session.Session, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "notes.md")
+	require.NoError(t, os.WriteFile(path, []byte("# Notes"), 0o600))
+
+	start := time.Date(2025, 10, 14, 9, 0, 0, 0, time.UTC)
+	return []*session.Session{
+		{
+			ID:        "3f2a9c1e-aaaa-bbbb-cccc-000000000001",
+			PlanID:    "rust-async",
+			ChunkID:   "chunk-003",
+			StartTime: start,
+			Artifacts: []string{"https://github.com/user/repo", path},
+		},
+		{
+			ID:        "7b8c0d2e-aaaa-bbbb-cccc-000000000002",
+			PlanID:    "rust-async",
+			StartTime: start.Add(-24 * time.Hour),
+			Artifacts: []string{filepath.Join(filepath.Dir(path), "deleted.md")},
+		},
+		{
+			ID:        "9d0e1f2a-aaaa-bbbb-cccc-000000000003",
+			PlanID:    "french-b1",
+			StartTime: start.Add(-48 * time.Hour),
+		},
+	}, path
+}
+
+func TestCollectArtifacts(t *testing.T) {
+	sessions, path := artifactTestSessions(t)
+
+	entries := collectArtifacts(sessions, false)
+	require.Len(t, entries, 3)
+	assert.Equal(t, session.ArtifactURL, entries[0].Kind)
+	assert.Equal(t, 1, entries[0].Number)
+	assert.Equal(t, path, entries[1].Target)
+	assert.Equal(t, 2, entries[1].Number)
+	assert.True(t, entries[1].Exists)
+	assert.False(t, entries[2].Exists)
+
+	missing := collectArtifacts(sessions, true)
+	require.Len(t, missing, 1)
+	assert.Equal(t, "7b8c0d2e-aaaa-bbbb-cccc-000000000002", missing[0].SessionID)
+}
+
+func TestSelectArtifacts(t *testing.T) {
+	sessions, path := artifactTestSessions(t)
+
+	all, err := selectArtifacts(sessions[0], nil)
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	second, err := selectArtifacts(sessions[0], []string{"2"})
+	require.NoError(t, err)
+	require.Len(t, second, 1)
+	assert.Equal(t, path, second[0].Target)
+
+	_, err = selectArtifacts(sessions[0], []string{"3"})
+	assert.ErrorContains(t, err, "between 1 and 2")
+
+	_, err = selectArtifacts(sessions[2], nil)
+	assert.ErrorContains(t, err, "has no artifacts")
+}
+
+func TestRenderArtifacts(t *testing.T) {
+	sessions, _ := artifactTestSessions(t)
+
+	var buf bytes.Buffer
+	renderArtifacts(&buf, collectArtifacts(sessions, false))
+	output := buf.String()
+
+	assert.Contains(t, output, "SESSION")
+	assert.Contains(t, output, "3f2a9c1e ")
+	assert.Contains(t, output, "rust-async/chunk-003")
+	assert.Contains(t, output, "https://github.com/user/repo")
+	assert.Contains(t, output, "deleted.md (missing)")
+	assert.Contains(t, output, "1 file artifact(s) missing")
+}
+
+func TestRenderArtifacts_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	renderArtifacts(&buf, nil)
+	assert.Contains(t, buf.String(), "No artifacts found.")
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pezware/samedi.dev/internal/export"
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/spf13/cobra"
+)
+
+// badgeCmd creates the `samedi badge` command.
+func badgeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "badge <plan-id>",
+		Short: "Render an SVG progress badge for a plan",
+		Long: `Render a plan's progress as an SVG badge showing its title, percent
+complete, and hours spent, ready to embed in a README or blog post.
+
+The badge is generated locally; nothing is uploaded or fetched. Plans with
+sub-plans show the totals of the whole tree. Without --output the SVG is
+printed to stdout; --output overwrites the file so the badge can be
+regenerated in place, and a directory gets a name from export.export_filename.
+
+Examples:
+  samedi badge rust-async                    # Print the SVG
+  samedi badge rust-async --output badge.svg # Write it to a file
+  samedi badge rust-async -o docs/           # Write it into a directory`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFile, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return fmt.Errorf("failed to get output flag: %w", err)
+			}
+
+			statsService, err := getStatsService(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to initialize stats service: %w", err)
+			}
+
+			planStats, err := statsService.GetPlanStats(context.Background(), args[0], stats.NewTimeRangeAll())
+			if err != nil {
+				return fmt.Errorf("failed to get plan stats: %w", err)
+			}
+			badge := stats.RenderBadge(planStats)
+
+			if outputFile == "" {
+				fmt.Print(badge)
+				return nil
+			}
+
+			vars := export.Vars{Type: "badge", Plan: args[0], Range: "all", Ext: "svg"}
+			cfg, err := getConfig(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			path, err := writeOutput(cfg, outputFile, vars, []byte(badge), cfg.Export.ExportFilename, nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Badge written to: %s\n", path)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP("output", "o", "", "Output file path or directory (default: stdout)")
+
+	return cmd
+}
@@ -0,0 +1,22 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBadgeCmd(t *testing.T) {
+	cmd := badgeCmd()
+	assert.Error(t, cmd.Args(cmd, nil), "a plan ID is required")
+	assert.NoError(t, cmd.Args(cmd, []string{"rust-async"}))
+
+	flag := cmd.Flags().ShorthandLookup("o")
+	if assert.NotNil(t, flag) {
+		assert.Equal(t, "output", flag.Name)
+		assert.Empty(t, flag.DefValue)
+	}
+}
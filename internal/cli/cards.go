@@ -0,0 +1,136 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/pezware/samedi.dev/internal/export"
+	"github.com/pezware/samedi.dev/internal/flashcard"
+	"github.com/spf13/cobra"
+)
+
+// cardsCmd creates the `samedi cards` command group.
+func cardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cards",
+		Short: "Work with flashcards",
+		Long: `Work with flashcards stored in ~/.samedi/cards/{plan-id}.cards.md.
+
+Examples:
+  samedi cards export > samedi.txt             # All plans, Anki import file
+  samedi cards export --plan french-b1 --save  # Save to export.dir`,
+	}
+
+	cmd.AddCommand(cardsExportCmd())
+
+	return cmd
+}
+
+// cardsExportCmd creates the `samedi cards export` subcommand.
+func cardsExportCmd() *cobra.Command {
+	var (
+		planID     string
+		format     string
+		outputFile string
+		save       bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export flashcards for import into Anki",
+		Long: `Export flashcards as an Anki plain-text import file, so cards can be
+studied on mobile through Anki.
+
+Each line holds a card's front, back, tags, and deck. Cards land in one
+deck per plan (Samedi::<plan-id>) and are tagged with their provenance:
+samedi::plan::<plan-id> and samedi::chunk::<chunk-id>, next to the
+card's own tags. In Anki, use File → Import and pick the file; the
+header lines set up the columns (Anki 2.1.55 or later).
+
+Only the tsv format is available; Anki's .apkg package format is not
+supported yet.
+
+Examples:
+  samedi cards export > samedi.txt
+  samedi cards export --plan french-b1 -o ~/french.txt
+  samedi cards export --save                   # export.dir, export.export_filename`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if format != "tsv" {
+				return fmt.Errorf("unsupported format: %s (supported: tsv)", format)
+			}
+
+			paths, err := getPaths()
+			if err != nil {
+				return fmt.Errorf("failed to get paths: %w", err)
+			}
+
+			var cards []flashcard.Card
+			if planID != "" {
+				cards, err = flashcard.Load(paths, planID)
+			} else {
+				cards, err = flashcard.LoadAll(paths)
+			}
+			if err != nil {
+				return err
+			}
+			if len(cards) == 0 {
+				return fmt.Errorf("no flashcards found in %s", paths.CardsDir)
+			}
+
+			var buf bytes.Buffer
+			if err := flashcard.WriteAnkiTSV(&buf, cards); err != nil {
+				return err
+			}
+
+			if outputFile == "" && !save {
+				_, err := os.Stdout.Write(buf.Bytes())
+				return err
+			}
+
+			vars := export.Vars{Type: "anki", Plan: "all", Ext: "txt"}
+			if planID != "" {
+				vars.Plan = planID
+			}
+			cfg, err := getConfig(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			path, err := writeOutput(cfg, outputFile, vars, buf.Bytes(), cfg.Export.ExportFilename, nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("✓ Exported %d card(s) to %s\n", len(cards), path)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&planID, "plan", "p", "", "export only this plan's cards")
+	cmd.Flags().StringVarP(&format, "format", "f", "tsv", "export format: tsv (Anki text import)")
+	cmd.Flags().StringVarP(&outputFile, "output", "o", "", "output file path or directory (default: stdout)")
+	cmd.Flags().BoolVar(&save, "save", false, "save to export.dir using export.export_filename")
+
+	return cmd
+}
+
+// getCardScheduler initializes the flashcard scheduler and the repository
+// holding its schedules and review log.
+func getCardScheduler() (*flashcard.Scheduler, *flashcard.SQLiteRepository, error) {
+	paths, err := getPaths()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get paths: %w", err)
+	}
+
+	db, err := sharedDatabase(paths)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	repo := flashcard.NewSQLiteRepository(db)
+	return flashcard.NewScheduler(paths, repo), repo, nil
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCardsCmd_Structure(t *testing.T) {
+	cmd := cardsCmd()
+
+	names := make([]string, 0)
+	for _, sub := range cmd.Commands() {
+		names = append(names, sub.Name())
+	}
+	assert.ElementsMatch(t, []string{"export"}, names)
+
+	export := cardsExportCmd()
+	for _, flag := range []string{"plan", "format", "output", "save"} {
+		assert.NotNil(t, export.Flags().Lookup(flag), flag)
+	}
+}
+
+func TestCardsExport_WritesAnkiFile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	cardsDir := filepath.Join(home, ".samedi", "cards")
+	require.NoError(t, os.MkdirAll(cardsDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(cardsDir, "french-b1.cards.md"),
+		[]byte("## Card 1 {#card-001}\n**Q**: Bonjour?\n**A**: Hello\n**Source**: Chunk 2\n"), 0o600))
+
+	out := filepath.Join(home, "french.txt")
+	cmd := cardsExportCmd()
+	cmd.SetArgs([]string{"--plan", "french-b1", "-o", out})
+	require.NoError(t, cmd.Execute())
+
+	content, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(content), "#separator:tab\n"))
+	assert.Contains(t, string(content), "Bonjour?\tHello\tsamedi::plan::french-b1 samedi::chunk::chunk-002\tSamedi::french-b1")
+}
+
+func TestCardsExport_RejectsUnknownFormat(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	cmd := cardsExportCmd()
+	cmd.SetArgs([]string{"--format", "apkg"})
+	cmd.SilenceUsage, cmd.SilenceErrors = true, true
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "supported: tsv")
+}
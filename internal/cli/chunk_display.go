@@ -6,10 +6,16 @@ package cli
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
+	"strings"
 
 	"github.com/pezware/samedi.dev/internal/plan"
 	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 )
 
 // ChunkDisplayInfo contains all information needed to display chunk details.
@@ -18,6 +24,17 @@ type ChunkDisplayInfo struct {
 	SessionStats   *session.ChunkStats
 	RecentSessions []*session.Session
 	PlanID         string
+
+	// PlanTitle, Position (1-based) and ChunkCount place the chunk in its
+	// plan; ChunkCount is 0 when unknown.
+	PlanTitle  string
+	Position   int
+	ChunkCount int
+
+	// Markdown is the chunk's body in the plan file, notes and code
+	// examples included. When set it is rendered in place of the parsed
+	// objectives, resources, and deliverable.
+	Markdown string
 }
 
 // getChunkDisplayInfo fetches chunk details and session statistics.
@@ -28,11 +45,22 @@ func getChunkDisplayInfo(cmd *cobra.Command, planID, chunkID string) (*ChunkDisp
 		return nil, fmt.Errorf("failed to get plan service: %w", err)
 	}
 
-	// Get the chunk
-	chunk, err := planSvc.GetChunk(context.Background(), planID, chunkID)
+	// Get the chunk and where it sits in the plan
+	p, err := planSvc.Get(context.Background(), planID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get chunk: %w", err)
+		return nil, fmt.Errorf("failed to load plan: %w", err)
+	}
+	position := 0
+	for i := range p.Chunks {
+		if p.Chunks[i].ID == chunkID {
+			position = i + 1
+			break
+		}
+	}
+	if position == 0 {
+		return nil, fmt.Errorf("chunk not found: %s in plan %s", chunkID, planID)
 	}
+	chunk := &p.Chunks[position-1]
 
 	// Get session service for statistics
 	sessionSvc, err := getSessionService(cmd)
@@ -58,31 +86,51 @@ func getChunkDisplayInfo(cmd *cobra.Command, planID, chunkID string) (*ChunkDisp
 		recentSessions = recentSessions[:3]
 	}
 
+	// Without the file's markdown the parsed fields are shown instead
+	var markdown string
+	if content, err := planSvc.Markdown(context.Background(), planID); err == nil {
+		markdown, _ = plan.ChunkMarkdown(content, chunkID)
+	}
+
 	return &ChunkDisplayInfo{
 		Chunk:          chunk,
 		SessionStats:   stats,
 		RecentSessions: recentSessions,
 		PlanID:         planID,
+		PlanTitle:      p.Title,
+		Position:       position,
+		ChunkCount:     len(p.Chunks),
+		Markdown:       markdown,
 	}, nil
 }
 
 // displayChunkDetails displays comprehensive chunk information.
 func displayChunkDetails(info *ChunkDisplayInfo) {
+	writeChunkDetails(os.Stdout, info)
+}
+
+// writeChunkDetails writes the chunk's details to w: a header placing it
+// in its plan, time and resource progress, its rendered markdown, and
+// recent sessions.
+func writeChunkDetails(w io.Writer, info *ChunkDisplayInfo) {
 	chunk := info.Chunk
 	stats := info.SessionStats
 
 	// Header: Title and ID
 	if chunk.Title != "" {
-		fmt.Printf("\n%s\n", chunk.Title)
-		fmt.Printf("ID: %s\n", chunk.ID)
+		fmt.Fprintf(w, "\n%s\n", styles.Title().Render(chunk.Title))
+		fmt.Fprintf(w, "ID: %s\n", chunk.ID)
 	} else {
-		fmt.Printf("\nChunk: %s\n", chunk.ID)
+		fmt.Fprintf(w, "\nChunk: %s\n", chunk.ID)
+	}
+	if info.PlanTitle != "" && info.ChunkCount > 0 {
+		fmt.Fprintf(w, "Plan: %s (chunk %d of %d)\n", info.PlanTitle, info.Position, info.ChunkCount)
 	}
 
 	// Status and Duration
 	statusIcon := getStatusIcon(chunk.Status)
-	fmt.Printf("Status: %s %s\n", statusIcon, chunk.Status)
-	fmt.Printf("Duration: %d min", chunk.Duration)
+	fmt.Fprintf(w, "Status: %s %s\n", statusIcon, chunk.Status)
+	fmt.Fprintf(w, "Duration: %d min", chunk.Duration)
 
 	// Progress information
 	if stats.TotalDuration > 0 {
@@ -93,53 +141,88 @@ func displayChunkDetails(info *ChunkDisplayInfo) {
 				progress = 100
 			}
 		}
-		fmt.Printf(" (%d/%d min, %d%%)", stats.TotalDuration, chunk.Duration, progress)
+		fmt.Fprintf(w, " (%d/%d min, %d%%)", stats.TotalDuration, chunk.Duration, progress)
+	}
+	fmt.Fprintln(w)
+	if stats.TotalDuration > 0 && chunk.Duration > 0 {
+		bar := components.NewProgressBar(float64(stats.TotalDuration)/float64(chunk.Duration), 20)
+		fmt.Fprintf(w, "Progress: %s\n", bar.View())
 	}
-	fmt.Println()
 
 	// Session count
 	if stats.SessionCount > 0 {
-		fmt.Printf("Sessions: %d\n", stats.SessionCount)
+		fmt.Fprintf(w, "Sessions: %d\n", stats.SessionCount)
 	}
-
-	// Objectives
-	if len(chunk.Objectives) > 0 {
-		fmt.Println("\nObjectives:")
-		for _, obj := range chunk.Objectives {
-			fmt.Printf("  • %s\n", obj)
-		}
+	if done, total := chunk.ResourceProgress(); total > 0 {
+		fmt.Fprintf(w, "Resources: %d/%d done\n", done, total)
 	}
 
-	// Resources
-	if len(chunk.Resources) > 0 {
-		fmt.Println("\nResources:")
-		for _, res := range chunk.Resources {
-			fmt.Printf("  • %s\n", res)
-		}
-	}
-
-	// Deliverable
-	if chunk.Deliverable != "" {
-		fmt.Printf("\nDeliverable: %s\n", chunk.Deliverable)
+	if body := chunkBody(chunk, info.Markdown, markdownWidth()); body != "" {
+		fmt.Fprintf(w, "\n%s\n", body)
 	}
 
 	// Recent sessions
 	if len(info.RecentSessions) > 0 {
-		fmt.Println("\nRecent sessions:")
+		fmt.Fprintln(w, "\nRecent sessions:")
 		for _, sess := range info.RecentSessions {
 			if sess.IsActive() {
-				fmt.Printf("  → Active (started %s)\n", sess.StartTime.Format("Jan 2 15:04"))
+				fmt.Fprintf(w, "  → Active (started %s)\n", sess.StartTime.Format("Jan 2 15:04"))
 			} else {
-				fmt.Printf("  ✓ %s - %d min", sess.EndTime.Format("Jan 2 15:04"), sess.Duration)
+				fmt.Fprintf(w, "  ✓ %s - %d min", sess.EndTime.Format("Jan 2 15:04"), sess.Duration)
 				if sess.Notes != "" {
-					fmt.Printf(" - %s", sess.Notes)
+					fmt.Fprintf(w, " - %s", sess.Notes)
 				}
-				fmt.Println()
+				fmt.Fprintln(w)
 			}
 		}
 	}
 }
 
+// chunkBody renders the chunk's markdown wrapped to width columns. Without
+// markdown, or if it fails to render, it lists the parsed objectives,
+// resources, deliverable, and session notes.
+func chunkBody(chunk *plan.Chunk, markdown string, width int) string {
+	if markdown != "" {
+		if rendered, err := components.RenderMarkdown(markdown, width); err == nil {
+			return rendered
+		}
+	}
+
+	var b strings.Builder
+	if len(chunk.Objectives) > 0 {
+		b.WriteString("\nObjectives:\n")
+		for _, obj := range chunk.Objectives {
+			fmt.Fprintf(&b, "  • %s\n", obj)
+		}
+	}
+	if len(chunk.Resources) > 0 {
+		b.WriteString("\nResources:\n")
+		for _, res := range chunk.ParsedResources() {
+			fmt.Fprintf(&b, "  %s %s\n", resourceIcon(res), res.Text)
+		}
+	}
+	if chunk.Deliverable != "" {
+		fmt.Fprintf(&b, "\nDeliverable: %s\n", chunk.Deliverable)
+	}
+	if len(chunk.Notes) > 0 {
+		b.WriteString("\nNotes:\n")
+		for _, note := range chunk.Notes {
+			fmt.Fprintf(&b, "  • %s\n", note)
+		}
+	}
+	return strings.Trim(b.String(), "\n")
+}
+
+// markdownWidth is the column rendered markdown wraps at: the terminal's
+// width up to 100, or 80 when stdout isn't a terminal.
+func markdownWidth() int {
+	width, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil || width <= 0 {
+		return 80
+	}
+	return min(width, 100)
+}
+
 // getStatusIcon returns an icon/symbol for the chunk status.
 func getStatusIcon(status plan.Status) string {
 	switch status {
@@ -155,3 +238,15 @@ func getStatusIcon(status plan.Status) string {
 		return "?"
 	}
 }
+
+// resourceIcon returns the bullet for a resource: a checkbox when it has one.
+func resourceIcon(res plan.Resource) string {
+	switch {
+	case !res.Checkbox:
+		return "•"
+	case res.Done:
+		return "☑"
+	default:
+		return "☐"
+	}
+}
@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -451,3 +452,43 @@ func TestDisplayChunkDetails_AllStatuses(t *testing.T) {
 		})
 	}
 }
+
+func TestChunkBody(t *testing.T) {
+	chunk := &plan.Chunk{
+		ID:          "chunk-001",
+		Objectives:  []string{"Understand borrowing"},
+		Resources:   []string{"[x] Rust Book ch. 4"},
+		Deliverable: "A borrow checker cheat sheet",
+		Notes:       []string{"2026-10-16: &mut is exclusive"},
+	}
+
+	fallback := chunkBody(chunk, "", 80)
+	assert.Contains(t, fallback, "Notes:\n  • 2026-10-16: &mut is exclusive")
+	assert.Contains(t, fallback, "Objectives:\n  • Understand borrowing")
+	assert.Contains(t, fallback, "☑ Rust Book ch. 4")
+	assert.Contains(t, fallback, "Deliverable: A borrow checker cheat sheet")
+
+	markdown := "**Objectives**:\n- Understand borrowing\n\nNotes:\n\n```rust\nlet r = &s;\n```\n"
+	body := chunkBody(chunk, markdown, 80)
+	assert.Contains(t, body, "• Understand borrowing")
+	assert.Contains(t, body, "let r = &s;", "notes and code the parser drops are shown")
+	assert.NotContains(t, body, "```")
+	assert.NotContains(t, body, "Deliverable", "the markdown replaces the parsed fields")
+}
+
+func TestWriteChunkDetails_PlanContext(t *testing.T) {
+	info := &ChunkDisplayInfo{
+		Chunk:        &plan.Chunk{ID: "chunk-002", Title: "Borrowing", Duration: 60, Status: plan.StatusInProgress},
+		SessionStats: &session.ChunkStats{TotalDuration: 30, SessionCount: 1},
+		PlanID:       "rust",
+		PlanTitle:    "Rust Basics",
+		Position:     2,
+		ChunkCount:   8,
+	}
+
+	var out strings.Builder
+	writeChunkDetails(&out, info)
+	assert.Contains(t, out.String(), "Plan: Rust Basics (chunk 2 of 8)")
+	assert.Contains(t, out.String(), "Duration: 60 min (30/60 min, 50%)")
+	assert.Contains(t, out.String(), "Progress: [")
+}
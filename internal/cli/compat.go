@@ -0,0 +1,201 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// deprecation is a flag or command that still works after being replaced,
+// until the release it is scheduled to be removed in. Using it prints a
+// warning; `samedi doctor` lists the whole schedule.
+type deprecation struct {
+	Kind        string `json:"kind"`        // "flag" or "command"
+	Command     string `json:"command"`     // Command path: the flag's command, or the old command itself
+	Name        string `json:"name"`        // Flag name without dashes, or the old command name
+	Replacement string `json:"replacement"` // Flag with value, or full command path, to use instead
+	Since       string `json:"since"`       // Release that deprecated it
+	RemovedIn   string `json:"removed_in"`  // Release that removes it
+}
+
+// deprecations is the compatibility schedule. Add entries here instead of
+// deleting a flag or command outright; remove them once RemovedIn ships.
+var deprecations = []deprecation{
+	{Kind: "flag", Command: "samedi stats", Name: "today", Replacement: "--range today", Since: "0.1.0", RemovedIn: "0.3.0"},
+	{Kind: "flag", Command: "samedi stats", Name: "this-week", Replacement: "--range this-week", Since: "0.1.0", RemovedIn: "0.3.0"},
+	{Kind: "flag", Command: "samedi stats", Name: "this-month", Replacement: "--range this-month", Since: "0.1.0", RemovedIn: "0.3.0"},
+	{Kind: "command", Command: "samedi", Name: "check", Replacement: "samedi plan validate", Since: "0.1.0", RemovedIn: "0.3.0"},
+}
+
+// Old returns how the deprecated flag or command is written.
+func (d deprecation) Old() string {
+	if d.Kind == "flag" {
+		return d.Command + " --" + d.Name
+	}
+	return d.Command + " " + d.Name
+}
+
+// warning is printed to stderr whenever the deprecated form is used.
+func (d deprecation) warning() string {
+	return fmt.Sprintf("Warning: '%s' is deprecated and will be removed in v%s; use '%s' instead\n",
+		d.Old(), d.RemovedIn, d.Replacement)
+}
+
+// Overdue reports whether version has reached the scheduled removal.
+// Development builds are never overdue.
+func (d deprecation) Overdue(version string) bool {
+	return versionAtLeast(version, d.RemovedIn)
+}
+
+// installCompat adds the hidden flags and commands for every deprecation
+// under root. Entries whose command no longer exists are skipped; the
+// doctor command flags them.
+func installCompat(root *cobra.Command) {
+	for _, d := range deprecations {
+		cmd, _, err := root.Find(strings.Fields(d.Command)[1:])
+		if err != nil {
+			continue
+		}
+		switch d.Kind {
+		case "flag":
+			cmd.Flags().Bool(d.Name, false, "deprecated: use "+d.Replacement)
+			_ = cmd.Flags().MarkHidden(d.Name) //nolint:errcheck // flag was just defined
+		case "command":
+			cmd.AddCommand(deprecatedCommand(d))
+		}
+	}
+}
+
+// applyDeprecatedFlags rewrites deprecated flags set on cmd into their
+// replacements, warning about each. A replacement flag set explicitly
+// wins over the deprecated one.
+func applyDeprecatedFlags(cmd *cobra.Command) error {
+	for _, d := range deprecations {
+		if d.Kind != "flag" || d.Command != cmd.CommandPath() || !cmd.Flags().Changed(d.Name) {
+			continue
+		}
+		fmt.Fprint(cmd.ErrOrStderr(), d.warning())
+
+		name, value := splitReplacementFlag(d.Replacement)
+		if cmd.Flags().Changed(name) {
+			continue
+		}
+		if err := cmd.Flags().Set(name, value); err != nil {
+			return fmt.Errorf("failed to apply %s for --%s: %w", d.Replacement, d.Name, err)
+		}
+	}
+	return nil
+}
+
+// splitReplacementFlag splits "--range today" into its flag name and
+// value; a bare "--flag" means true.
+func splitReplacementFlag(replacement string) (string, string) {
+	fields := strings.Fields(replacement)
+	name := strings.TrimLeft(fields[0], "-")
+	if len(fields) < 2 {
+		return name, "true"
+	}
+	return name, strings.Join(fields[1:], " ")
+}
+
+// deprecatedCommand returns a hidden command that warns and runs its
+// replacement with the same arguments and flags.
+func deprecatedCommand(d deprecation) *cobra.Command {
+	return &cobra.Command{
+		Use:                d.Name,
+		Short:              "Deprecated: use '" + d.Replacement + "'",
+		Hidden:             true,
+		DisableFlagParsing: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fmt.Fprint(cmd.ErrOrStderr(), d.warning())
+
+			path := append(strings.Fields(d.Replacement)[1:], args...)
+			target, rest, err := cmd.Root().Find(path)
+			if err != nil {
+				return err
+			}
+			if err := target.ParseFlags(rest); err != nil {
+				return err
+			}
+			targetArgs := target.Flags().Args()
+			if err := target.ValidateArgs(targetArgs); err != nil {
+				return err
+			}
+			if err := checkReadOnly(target, targetArgs); err != nil {
+				return err
+			}
+
+			if target.RunE != nil {
+				return target.RunE(target, targetArgs)
+			}
+			if target.Run != nil {
+				target.Run(target, targetArgs)
+				return nil
+			}
+			return target.Help()
+		},
+	}
+}
+
+// preRun runs before every command: logging starts, deprecated flags are
+// translated, then read-only mode is enforced.
+func preRun(cmd *cobra.Command, args []string) error {
+	setupLogging(cmd)
+	if err := applyDeprecatedFlags(cmd); err != nil {
+		return err
+	}
+	return checkReadOnly(cmd, args)
+}
+
+// postRun runs after every command that succeeds: a scheduled report is
+// written once one is due, and the database is backed up once a backup is.
+func postRun(cmd *cobra.Command, _ []string) {
+	autoReportAfter(cmd)
+	autoBackupAfter(cmd)
+}
+
+// versionAtLeast reports whether version is a release at or after target.
+// Both are "MAJOR.MINOR.PATCH" with an optional "v"; anything else, such
+// as a "dev" build, compares as false.
+func versionAtLeast(version, target string) bool {
+	v, ok := parseVersion(version)
+	if !ok {
+		return false
+	}
+	t, ok := parseVersion(target)
+	if !ok {
+		return false
+	}
+	for i := range v {
+		if v[i] != t[i] {
+			return v[i] > t[i]
+		}
+	}
+	return true
+}
+
+// parseVersion parses "v1.2.3" or "1.2.3", ignoring any pre-release or
+// build suffix on the patch number.
+func parseVersion(version string) ([3]int, bool) {
+	var parts [3]int
+	fields := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3)
+	if len(fields) != 3 {
+		return parts, false
+	}
+	if i := strings.IndexAny(fields[2], "-+"); i >= 0 {
+		fields[2] = fields[2][:i]
+	}
+	for i, field := range fields {
+		n, err := strconv.Atoi(field)
+		if err != nil {
+			return parts, false
+		}
+		parts[i] = n
+	}
+	return parts, true
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionAtLeast(t *testing.T) {
+	assert.True(t, versionAtLeast("0.3.0", "0.3.0"))
+	assert.True(t, versionAtLeast("v0.3.1", "0.3.0"))
+	assert.True(t, versionAtLeast("1.0.0", "0.3.0"))
+	assert.True(t, versionAtLeast("0.3.0-rc1", "0.3.0"))
+	assert.False(t, versionAtLeast("0.2.9", "0.3.0"))
+	assert.False(t, versionAtLeast("dev", "0.3.0"), "dev builds are never overdue")
+	assert.False(t, versionAtLeast("0.3", "0.3.0"))
+}
+
+func TestSplitReplacementFlag(t *testing.T) {
+	name, value := splitReplacementFlag("--range this-week")
+	assert.Equal(t, "range", name)
+	assert.Equal(t, "this-week", value)
+
+	name, value = splitReplacementFlag("--breakdown")
+	assert.Equal(t, "breakdown", name)
+	assert.Equal(t, "true", value)
+}
+
+// runCompatTestCmd runs args against a root with stats and plan validate
+// stand-ins and the compatibility shims installed. It returns the range
+// stats saw, whether validate ran, and stderr.
+func runCompatTestCmd(t *testing.T, args ...string) (statsRange string, validated bool, stderr string) {
+	t.Helper()
+
+	root := &cobra.Command{Use: "samedi", PersistentPreRunE: preRun, SilenceErrors: true, SilenceUsage: true}
+	root.PersistentFlags().Bool("read-only", false, "")
+	stats := &cobra.Command{Use: "stats", RunE: func(cmd *cobra.Command, _ []string) error {
+		statsRange, _ = cmd.Flags().GetString("range")
+		return nil
+	}}
+	stats.Flags().String("range", "all", "")
+	plan := &cobra.Command{Use: "plan"}
+	plan.AddCommand(&cobra.Command{Use: "validate", Args: cobra.NoArgs, RunE: func(*cobra.Command, []string) error {
+		validated = true
+		return nil
+	}})
+	root.AddCommand(stats, plan)
+	installCompat(root)
+
+	var errOut bytes.Buffer
+	root.SetErr(&errOut)
+	root.SetArgs(args)
+	require.NoError(t, root.Execute())
+	return statsRange, validated, errOut.String()
+}
+
+func TestCompat_DeprecatedFlag(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	statsRange, _, stderr := runCompatTestCmd(t, "stats", "--this-week")
+	assert.Equal(t, "this-week", statsRange)
+	assert.Contains(t, stderr, "'samedi stats --this-week' is deprecated")
+	assert.Contains(t, stderr, "use '--range this-week'")
+
+	statsRange, _, _ = runCompatTestCmd(t, "stats", "--today", "--range", "this-month")
+	assert.Equal(t, "this-month", statsRange, "an explicit replacement wins")
+
+	statsRange, _, stderr = runCompatTestCmd(t, "stats")
+	assert.Equal(t, "all", statsRange)
+	assert.Empty(t, stderr)
+}
+
+func TestCompat_DeprecatedCommand(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	_, validated, stderr := runCompatTestCmd(t, "check")
+	assert.True(t, validated)
+	assert.Contains(t, stderr, "use 'samedi plan validate'")
+}
+
+func TestDeprecations_Resolve(t *testing.T) {
+	for _, d := range deprecations {
+		assert.True(t, deprecationResolves(rootCmd, d), d.Old())
+		assert.True(t, versionAtLeast(d.RemovedIn, d.Since), d.Old())
+	}
+
+	cmd, _, err := rootCmd.Find([]string{"check"})
+	require.NoError(t, err)
+	assert.True(t, cmd.Hidden)
+}
+
+func TestPrintDoctorReport(t *testing.T) {
+	report := &doctorReport{
+		Version: "0.3.0",
+		Checks: []doctorCheck{
+			{Name: "config", OK: true, Detail: "/tmp/config.toml"},
+			{Name: "database", Detail: "/tmp/sessions.db not found (run 'samedi init')"},
+		},
+		Deprecations: []doctorDeprecation{{
+			deprecation: deprecations[0],
+			Old:         deprecations[0].Old(),
+			Overdue:     deprecations[0].Overdue("0.3.0"),
+		}},
+	}
+
+	var out bytes.Buffer
+	printDoctorReport(&out, report)
+	assert.Contains(t, out.String(), "✓ config: /tmp/config.toml")
+	assert.Contains(t, out.String(), "✗ database")
+	assert.Contains(t, out.String(), "samedi stats --today")
+	assert.Contains(t, out.String(), "overdue for removal")
+}
+
+func TestSummarizeCheckResults(t *testing.T) {
+	detail, ok := summarizeCheckResults([]plan.CheckResult{{ID: "rust"}, {ID: "go"}})
+	assert.True(t, ok)
+	assert.Equal(t, "2 plan(s) OK", detail)
+
+	detail, ok = summarizeCheckResults([]plan.CheckResult{
+		{ID: "rust"},
+		{ID: "go", Warnings: []plan.Warning{{Line: 12, Message: "unknown field"}}},
+		{ID: "broken", Err: "invalid frontmatter"},
+	})
+	assert.False(t, ok)
+	assert.Equal(t, "2 of 3 plan(s) have errors or warnings: go, broken (run 'samedi plan validate')", detail)
+}
@@ -4,15 +4,16 @@
 package cli
 
 import (
-	"encoding/json"
 	"fmt"
+	"io"
 	"os"
-	"os/exec"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/tui/components"
 	"github.com/spf13/cobra"
-	"gopkg.in/yaml.v3"
 )
 
 func configCmd() *cobra.Command {
@@ -43,52 +44,68 @@ func configListCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "list",
 		Short: "List all configuration settings",
-		Run: func(cmd *cobra.Command, _ []string) {
+		Long: `List every setting by the key that 'config get' and 'config set' take.
+
+Examples:
+  samedi config list
+  samedi config list --json`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
 			cfg, err := getConfig(cmd)
 			if err != nil {
-				exitWithError("Failed to load config: %v", err)
+				return fmt.Errorf("failed to load config: %w", err)
 			}
 
 			jsonOutput, err := cmd.Flags().GetBool("json")
 			if err != nil {
-				exitWithError("Failed to get json flag: %v", err)
+				return fmt.Errorf("failed to get json flag: %w", err)
 			}
 			if jsonOutput {
-				data, err := json.MarshalIndent(cfg, "", "  ")
-				if err != nil {
-					exitWithError("Failed to marshal config: %v", err)
+				values := make(map[string]interface{})
+				for _, key := range config.Keys() {
+					values[key] = getConfigValue(cfg, key)
 				}
-				fmt.Println(string(data))
-			} else {
-				// Pretty print as YAML
-				data, err := yaml.Marshal(cfg)
-				if err != nil {
-					exitWithError("Failed to marshal config: %v", err)
+				if err := printJSON(values); err != nil {
+					return fmt.Errorf("failed to output JSON: %w", err)
 				}
-				fmt.Println(string(data))
+				return nil
 			}
+
+			renderConfigList(os.Stdout, cfg)
+
+			return nil
 		},
 	}
 }
 
+// renderConfigList writes one "key = value" line per setting.
+func renderConfigList(w io.Writer, cfg *config.Config) {
+	tw := components.NewTabWriter(w, 1)
+	for _, key := range config.Keys() {
+		fmt.Fprintf(tw, "%s\t= %v\n", key, getConfigValue(cfg, key))
+	}
+	_ = tw.Flush()
+}
+
 func configGetCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "get <key>",
 		Short: "Get a configuration value",
 		Args:  cobra.ExactArgs(1),
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			cfg, err := getConfig(cmd)
 			if err != nil {
-				exitWithError("Failed to load config: %v", err)
+				return fmt.Errorf("failed to load config: %w", err)
 			}
 
 			key := args[0]
 			value := getConfigValue(cfg, key)
 			if value == nil {
-				exitWithError("Unknown config key: %s", key)
+				return fmt.Errorf("unknown config key: %s (see 'samedi config list')", key)
 			}
 
 			fmt.Println(value)
+
+			return nil
 		},
 	}
 }
@@ -97,26 +114,48 @@ func configSetCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "set <key> <value>",
 		Short: "Set a configuration value",
-		Args:  cobra.ExactArgs(2),
-		Run: func(cmd *cobra.Command, args []string) {
-			cfg, err := getConfig(cmd)
+		Long: `Set one configuration value.
+
+The value is checked against the key's type (number, true/false, or a
+comma-separated list) and the whole config is validated before anything
+is written, so a bad value leaves the file untouched. The previous file
+is kept as config.toml.bak.
+
+set works on a config that currently fails validation, so it can repair
+the offending value.
+
+Examples:
+  samedi config set llm.provider claude
+  samedi config set learning.weekly_goal_hours 6
+  samedi config set learning.reminder_times 08:00,20:00`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.LoadUnchecked()
 			if err != nil {
-				// If config doesn't exist, create default
-				cfg = config.DefaultConfig()
+				return fmt.Errorf("failed to load config: %w (fix it with 'samedi config edit')", err)
 			}
 
 			key := args[0]
 			value := args[1]
 
 			if err := setConfigValue(cfg, key, value); err != nil {
-				exitWithError("Failed to set config: %v", err)
+				return &usageError{command: cmd.CommandPath(), err: fmt.Errorf("failed to set config: %w", err)}
+			}
+			if err := cfg.Validate(); err != nil {
+				return fmt.Errorf("%w: invalid value for %s: %w", config.ErrInvalid, key, err)
 			}
 
+			_, statErr := os.Stat(config.Path())
 			if err := config.Save(cfg); err != nil {
-				exitWithError("Failed to save config: %v", err)
+				return fmt.Errorf("failed to save config: %w", err)
 			}
 
 			fmt.Printf("✓ Set %s = %s\n", key, value)
+			if statErr == nil {
+				fmt.Printf("  Previous config saved to %s\n", config.BackupPath())
+			}
+
+			return nil
 		},
 	}
 }
@@ -125,29 +164,19 @@ func configEditCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "edit",
 		Short: "Edit configuration in $EDITOR",
-		Run: func(_ *cobra.Command, _ []string) {
-			editor := os.Getenv("EDITOR")
-			if editor == "" {
-				editor = "vi"
-			}
-
+		RunE: func(_ *cobra.Command, _ []string) error {
 			configPath := config.Path()
 
 			// Ensure config exists
 			if _, err := os.Stat(configPath); os.IsNotExist(err) {
 				if err := config.InitConfig(); err != nil {
-					exitWithError("Failed to create config: %v", err)
+					return fmt.Errorf("failed to create config: %w", err)
 				}
 			}
 
 			// Open in editor
-			editorCmd := exec.Command(editor, configPath)
-			editorCmd.Stdin = os.Stdin
-			editorCmd.Stdout = os.Stdout
-			editorCmd.Stderr = os.Stderr
-
-			if err := editorCmd.Run(); err != nil {
-				exitWithError("Failed to edit config: %v", err)
+			if err := editorCommand(configPath).Run(); err != nil {
+				return fmt.Errorf("failed to edit config: %w", err)
 			}
 
 			// Validate after editing
@@ -155,6 +184,8 @@ func configEditCmd() *cobra.Command {
 				fmt.Fprintf(os.Stderr, "Warning: Config validation failed: %v\n", err)
 				fmt.Fprintf(os.Stderr, "Please fix the config file and try again.\n")
 			}
+
+			return nil
 		},
 	}
 }
@@ -163,12 +194,14 @@ func configInitCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "init",
 		Short: "Initialize default configuration file",
-		Run: func(_ *cobra.Command, _ []string) {
+		RunE: func(_ *cobra.Command, _ []string) error {
 			if err := config.InitConfig(); err != nil {
-				exitWithError("Failed to initialize config: %v", err)
+				return fmt.Errorf("failed to initialize config: %w", err)
 			}
 
 			fmt.Printf("✓ Configuration created at %s\n", config.Path())
+
+			return nil
 		},
 	}
 }
@@ -177,25 +210,81 @@ var configValueResolvers = map[string]func(*config.Config) interface{}{
 	"user.email":                     func(cfg *config.Config) interface{} { return cfg.User.Email },
 	"user.username":                  func(cfg *config.Config) interface{} { return cfg.User.Username },
 	"user.timezone":                  func(cfg *config.Config) interface{} { return cfg.User.Timezone },
+	"user.editor":                    func(cfg *config.Config) interface{} { return cfg.User.Editor },
 	"llm.provider":                   func(cfg *config.Config) interface{} { return cfg.LLM.Provider },
 	"llm.cli_command":                func(cfg *config.Config) interface{} { return cfg.LLM.CLICommand },
 	"llm.default_model":              func(cfg *config.Config) interface{} { return cfg.LLM.DefaultModel },
 	"llm.timeout_seconds":            func(cfg *config.Config) interface{} { return cfg.LLM.TimeoutSeconds },
+	"llm.max_retries":                func(cfg *config.Config) interface{} { return cfg.LLM.MaxRetries },
+	"llm.base_url":                   func(cfg *config.Config) interface{} { return cfg.LLM.BaseURL },
+	"llm.api_key_env":                func(cfg *config.Config) interface{} { return cfg.LLM.APIKeyEnv },
+	"llm.max_tokens":                 func(cfg *config.Config) interface{} { return cfg.LLM.MaxTokens },
+	"llm.repair_attempts":            func(cfg *config.Config) interface{} { return cfg.LLM.RepairAttempts },
 	"storage.data_dir":               func(cfg *config.Config) interface{} { return cfg.Storage.DataDir },
 	"storage.backup_enabled":         func(cfg *config.Config) interface{} { return cfg.Storage.BackupEnabled },
 	"storage.backup_dir":             func(cfg *config.Config) interface{} { return cfg.Storage.BackupDir },
 	"storage.auto_backup_days":       func(cfg *config.Config) interface{} { return cfg.Storage.AutoBackupDays },
+	"storage.backup_keep":            func(cfg *config.Config) interface{} { return cfg.Storage.BackupKeep },
+	"storage.auto_vacuum_percent":    func(cfg *config.Config) interface{} { return cfg.Storage.AutoVacuumPercent },
+	"storage.read_only":              func(cfg *config.Config) interface{} { return cfg.Storage.ReadOnly },
+	"storage.archive_plan_files":     func(cfg *config.Config) interface{} { return cfg.Storage.ArchivePlanFiles },
+	"storage.profile":                func(cfg *config.Config) interface{} { return cfg.Storage.Profile },
+	"storage.encrypt":                func(cfg *config.Config) interface{} { return cfg.Storage.Encrypt },
+	"storage.passphrase_env":         func(cfg *config.Config) interface{} { return cfg.Storage.PassphraseEnv },
 	"sync.enabled":                   func(cfg *config.Config) interface{} { return cfg.Sync.Enabled },
 	"sync.cloudflare_endpoint":       func(cfg *config.Config) interface{} { return cfg.Sync.CloudflareEndpoint },
 	"sync.sync_interval_minutes":     func(cfg *config.Config) interface{} { return cfg.Sync.SyncIntervalMinutes },
+	"sync.git_remote":                func(cfg *config.Config) interface{} { return cfg.Sync.GitRemote },
+	"sync.auto_commit":               func(cfg *config.Config) interface{} { return cfg.Sync.AutoCommit },
 	"tui.theme":                      func(cfg *config.Config) interface{} { return cfg.TUI.Theme },
 	"tui.date_format":                func(cfg *config.Config) interface{} { return cfg.TUI.DateFormat },
 	"tui.time_format":                func(cfg *config.Config) interface{} { return cfg.TUI.TimeFormat },
 	"tui.first_day_of_week":          func(cfg *config.Config) interface{} { return cfg.TUI.FirstDayOfWeek },
+	"tui.pinned_plan":                func(cfg *config.Config) interface{} { return cfg.TUI.PinnedPlan },
+	"tui.plan_sort":                  func(cfg *config.Config) interface{} { return cfg.TUI.PlanSort },
+	"tui.refresh_seconds":            func(cfg *config.Config) interface{} { return cfg.TUI.RefreshSeconds },
+	"tui.quick_actions":              func(cfg *config.Config) interface{} { return strings.Join(cfg.TUI.QuickActions, ",") },
+	"tui.keys":                       func(cfg *config.Config) interface{} { return formatKeyBindings(cfg.TUI.Keys) },
+	"tui.themes":                     func(cfg *config.Config) interface{} { return formatThemes(cfg.TUI.Themes) },
 	"learning.default_chunk_minutes": func(cfg *config.Config) interface{} { return cfg.Learning.DefaultChunkMinutes },
 	"learning.reminder_enabled":      func(cfg *config.Config) interface{} { return cfg.Learning.ReminderEnabled },
 	"learning.reminder_message":      func(cfg *config.Config) interface{} { return cfg.Learning.ReminderMessage },
+	"learning.reminder_times":        func(cfg *config.Config) interface{} { return strings.Join(cfg.Learning.ReminderTimes, ",") },
+	"learning.weekly_goal_hours":     func(cfg *config.Config) interface{} { return cfg.Learning.WeeklyGoalHours },
 	"learning.streak_tracking":       func(cfg *config.Config) interface{} { return cfg.Learning.StreakTracking },
+	"learning.streak_min_minutes":    func(cfg *config.Config) interface{} { return cfg.Learning.StreakMinMinutes },
+	"learning.streak_rest_days":      func(cfg *config.Config) interface{} { return strings.Join(cfg.Learning.StreakRestDays, ",") },
+	"learning.chunk_selection":       func(cfg *config.Config) interface{} { return cfg.Learning.ChunkSelection },
+	"learning.chunk_alert":           func(cfg *config.Config) interface{} { return cfg.Learning.ChunkAlert },
+	"learning.prompt_stop_notes":     func(cfg *config.Config) interface{} { return cfg.Learning.PromptStopNotes },
+	"learning.prompt_artifacts":      func(cfg *config.Config) interface{} { return cfg.Learning.PromptArtifacts },
+	"learning.prompt_reflection":     func(cfg *config.Config) interface{} { return cfg.Learning.PromptReflection },
+	"learning.auto_advance_chunks":   func(cfg *config.Config) interface{} { return cfg.Learning.AutoAdvanceChunks },
+	"learning.total_hours_source":    func(cfg *config.Config) interface{} { return cfg.Learning.TotalHoursSource },
+	"export.dir":                     func(cfg *config.Config) interface{} { return cfg.Export.Dir },
+	"export.report_filename":         func(cfg *config.Config) interface{} { return cfg.Export.ReportFilename },
+	"export.export_filename":         func(cfg *config.Config) interface{} { return cfg.Export.ExportFilename },
+	"export.backup_filename":         func(cfg *config.Config) interface{} { return cfg.Export.BackupFilename },
+	"obsidian.vault_path":            func(cfg *config.Config) interface{} { return cfg.Obsidian.VaultPath },
+	"obsidian.folder":                func(cfg *config.Config) interface{} { return cfg.Obsidian.Folder },
+	"reports.schedule":               func(cfg *config.Config) interface{} { return cfg.Reports.Schedule },
+	"reports.day":                    func(cfg *config.Config) interface{} { return cfg.Reports.Day },
+	"reports.dir":                    func(cfg *config.Config) interface{} { return cfg.Reports.Dir },
+	"reports.type":                   func(cfg *config.Config) interface{} { return cfg.Reports.Type },
+	"reports.keep":                   func(cfg *config.Config) interface{} { return cfg.Reports.Keep },
+	"events.webhooks":                func(cfg *config.Config) interface{} { return strings.Join(cfg.Events.Webhooks, ",") },
+	"events.types":                   func(cfg *config.Config) interface{} { return strings.Join(cfg.Events.Types, ",") },
+	"events.timeout_seconds":         func(cfg *config.Config) interface{} { return cfg.Events.TimeoutSeconds },
+	"hooks.post_session_start":       func(cfg *config.Config) interface{} { return cfg.Hooks.PostSessionStart },
+	"hooks.post_session":             func(cfg *config.Config) interface{} { return cfg.Hooks.PostSession },
+	"hooks.post_chunk":               func(cfg *config.Config) interface{} { return cfg.Hooks.PostChunk },
+	"hooks.post_plan":                func(cfg *config.Config) interface{} { return cfg.Hooks.PostPlan },
+	"hooks.post_milestone":           func(cfg *config.Config) interface{} { return cfg.Hooks.PostMilestone },
+	"hooks.chunk_time_up":            func(cfg *config.Config) interface{} { return cfg.Hooks.ChunkTimeUp },
+	"hooks.timeout_seconds":          func(cfg *config.Config) interface{} { return cfg.Hooks.TimeoutSeconds },
+	"status.format":                  func(cfg *config.Config) interface{} { return cfg.Status.Format },
+	"log.level":                      func(cfg *config.Config) interface{} { return cfg.Log.Level },
+	"log.keep":                       func(cfg *config.Config) interface{} { return cfg.Log.Keep },
 }
 
 // getConfigValue retrieves a nested config value by dot-notation key.
@@ -207,34 +296,157 @@ func getConfigValue(cfg *config.Config, key string) interface{} {
 }
 
 var stringConfigSetters = map[string]func(*config.Config, string){
-	"user.email":                func(cfg *config.Config, value string) { cfg.User.Email = value },
-	"user.username":             func(cfg *config.Config, value string) { cfg.User.Username = value },
-	"user.timezone":             func(cfg *config.Config, value string) { cfg.User.Timezone = value },
-	"llm.provider":              func(cfg *config.Config, value string) { cfg.LLM.Provider = value },
-	"llm.cli_command":           func(cfg *config.Config, value string) { cfg.LLM.CLICommand = value },
-	"llm.default_model":         func(cfg *config.Config, value string) { cfg.LLM.DefaultModel = value },
-	"storage.data_dir":          func(cfg *config.Config, value string) { cfg.Storage.DataDir = value },
-	"storage.backup_dir":        func(cfg *config.Config, value string) { cfg.Storage.BackupDir = value },
-	"sync.cloudflare_endpoint":  func(cfg *config.Config, value string) { cfg.Sync.CloudflareEndpoint = value },
-	"tui.theme":                 func(cfg *config.Config, value string) { cfg.TUI.Theme = value },
-	"tui.date_format":           func(cfg *config.Config, value string) { cfg.TUI.DateFormat = value },
-	"tui.time_format":           func(cfg *config.Config, value string) { cfg.TUI.TimeFormat = value },
-	"tui.first_day_of_week":     func(cfg *config.Config, value string) { cfg.TUI.FirstDayOfWeek = value },
-	"learning.reminder_message": func(cfg *config.Config, value string) { cfg.Learning.ReminderMessage = value },
+	"user.email":                  func(cfg *config.Config, value string) { cfg.User.Email = value },
+	"user.username":               func(cfg *config.Config, value string) { cfg.User.Username = value },
+	"user.timezone":               func(cfg *config.Config, value string) { cfg.User.Timezone = value },
+	"user.editor":                 func(cfg *config.Config, value string) { cfg.User.Editor = value },
+	"llm.provider":                func(cfg *config.Config, value string) { cfg.LLM.Provider = value },
+	"llm.cli_command":             func(cfg *config.Config, value string) { cfg.LLM.CLICommand = value },
+	"llm.default_model":           func(cfg *config.Config, value string) { cfg.LLM.DefaultModel = value },
+	"llm.base_url":                func(cfg *config.Config, value string) { cfg.LLM.BaseURL = value },
+	"llm.api_key_env":             func(cfg *config.Config, value string) { cfg.LLM.APIKeyEnv = value },
+	"storage.data_dir":            func(cfg *config.Config, value string) { cfg.Storage.DataDir = value },
+	"storage.backup_dir":          func(cfg *config.Config, value string) { cfg.Storage.BackupDir = value },
+	"storage.profile":             func(cfg *config.Config, value string) { cfg.Storage.Profile = value },
+	"storage.passphrase_env":      func(cfg *config.Config, value string) { cfg.Storage.PassphraseEnv = value },
+	"sync.cloudflare_endpoint":    func(cfg *config.Config, value string) { cfg.Sync.CloudflareEndpoint = value },
+	"sync.git_remote":             func(cfg *config.Config, value string) { cfg.Sync.GitRemote = value },
+	"tui.theme":                   func(cfg *config.Config, value string) { cfg.TUI.Theme = value },
+	"tui.date_format":             func(cfg *config.Config, value string) { cfg.TUI.DateFormat = value },
+	"tui.time_format":             func(cfg *config.Config, value string) { cfg.TUI.TimeFormat = value },
+	"tui.first_day_of_week":       func(cfg *config.Config, value string) { cfg.TUI.FirstDayOfWeek = value },
+	"tui.pinned_plan":             func(cfg *config.Config, value string) { cfg.TUI.PinnedPlan = value },
+	"tui.plan_sort":               func(cfg *config.Config, value string) { cfg.TUI.PlanSort = value },
+	"learning.reminder_message":   func(cfg *config.Config, value string) { cfg.Learning.ReminderMessage = value },
+	"learning.chunk_selection":    func(cfg *config.Config, value string) { cfg.Learning.ChunkSelection = value },
+	"learning.chunk_alert":        func(cfg *config.Config, value string) { cfg.Learning.ChunkAlert = value },
+	"learning.total_hours_source": func(cfg *config.Config, value string) { cfg.Learning.TotalHoursSource = value },
+	"export.dir":                  func(cfg *config.Config, value string) { cfg.Export.Dir = value },
+	"export.report_filename":      func(cfg *config.Config, value string) { cfg.Export.ReportFilename = value },
+	"export.export_filename":      func(cfg *config.Config, value string) { cfg.Export.ExportFilename = value },
+	"export.backup_filename":      func(cfg *config.Config, value string) { cfg.Export.BackupFilename = value },
+	"obsidian.vault_path":         func(cfg *config.Config, value string) { cfg.Obsidian.VaultPath = value },
+	"obsidian.folder":             func(cfg *config.Config, value string) { cfg.Obsidian.Folder = value },
+	"reports.schedule":            func(cfg *config.Config, value string) { cfg.Reports.Schedule = value },
+	"reports.day":                 func(cfg *config.Config, value string) { cfg.Reports.Day = strings.ToLower(value) },
+	"reports.dir":                 func(cfg *config.Config, value string) { cfg.Reports.Dir = value },
+	"reports.type":                func(cfg *config.Config, value string) { cfg.Reports.Type = value },
+	"hooks.post_session_start":    func(cfg *config.Config, value string) { cfg.Hooks.PostSessionStart = value },
+	"hooks.post_session":          func(cfg *config.Config, value string) { cfg.Hooks.PostSession = value },
+	"hooks.post_chunk":            func(cfg *config.Config, value string) { cfg.Hooks.PostChunk = value },
+	"hooks.post_plan":             func(cfg *config.Config, value string) { cfg.Hooks.PostPlan = value },
+	"hooks.post_milestone":        func(cfg *config.Config, value string) { cfg.Hooks.PostMilestone = value },
+	"hooks.chunk_time_up":         func(cfg *config.Config, value string) { cfg.Hooks.ChunkTimeUp = value },
+	"status.format":               func(cfg *config.Config, value string) { cfg.Status.Format = value },
+	"log.level":                   func(cfg *config.Config, value string) { cfg.Log.Level = strings.ToLower(value) },
 }
 
 var intConfigSetters = map[string]func(*config.Config, int){
 	"llm.timeout_seconds":            func(cfg *config.Config, value int) { cfg.LLM.TimeoutSeconds = value },
+	"llm.max_retries":                func(cfg *config.Config, value int) { cfg.LLM.MaxRetries = value },
+	"llm.max_tokens":                 func(cfg *config.Config, value int) { cfg.LLM.MaxTokens = value },
+	"llm.repair_attempts":            func(cfg *config.Config, value int) { cfg.LLM.RepairAttempts = value },
 	"storage.auto_backup_days":       func(cfg *config.Config, value int) { cfg.Storage.AutoBackupDays = value },
+	"storage.backup_keep":            func(cfg *config.Config, value int) { cfg.Storage.BackupKeep = value },
+	"storage.auto_vacuum_percent":    func(cfg *config.Config, value int) { cfg.Storage.AutoVacuumPercent = value },
 	"sync.sync_interval_minutes":     func(cfg *config.Config, value int) { cfg.Sync.SyncIntervalMinutes = value },
 	"learning.default_chunk_minutes": func(cfg *config.Config, value int) { cfg.Learning.DefaultChunkMinutes = value },
+	"learning.weekly_goal_hours":     func(cfg *config.Config, value int) { cfg.Learning.WeeklyGoalHours = value },
+	"learning.streak_min_minutes":    func(cfg *config.Config, value int) { cfg.Learning.StreakMinMinutes = value },
+	"reports.keep":                   func(cfg *config.Config, value int) { cfg.Reports.Keep = value },
+	"events.timeout_seconds":         func(cfg *config.Config, value int) { cfg.Events.TimeoutSeconds = value },
+	"hooks.timeout_seconds":          func(cfg *config.Config, value int) { cfg.Hooks.TimeoutSeconds = value },
+	"log.keep":                       func(cfg *config.Config, value int) { cfg.Log.Keep = value },
+	"tui.refresh_seconds":            func(cfg *config.Config, value int) { cfg.TUI.RefreshSeconds = value },
+}
+
+// listConfigSetters accept comma-separated values.
+var listConfigSetters = map[string]func(*config.Config, []string){
+	"learning.reminder_times":   func(cfg *config.Config, value []string) { cfg.Learning.ReminderTimes = value },
+	"learning.streak_rest_days": func(cfg *config.Config, value []string) { cfg.Learning.StreakRestDays = lowerAll(value) },
+	"tui.quick_actions":         func(cfg *config.Config, value []string) { cfg.TUI.QuickActions = value },
+	"tui.keys":                  func(cfg *config.Config, value []string) { cfg.TUI.Keys = parseKeyBindings(value) },
+	"tui.themes":                func(cfg *config.Config, value []string) { cfg.TUI.Themes = parseThemes(value) },
+	"events.webhooks":           func(cfg *config.Config, value []string) { cfg.Events.Webhooks = value },
+	"events.types":              func(cfg *config.Config, value []string) { cfg.Events.Types = value },
+}
+
+// lowerAll lowercases each value in place.
+func lowerAll(values []string) []string {
+	for i := range values {
+		values[i] = strings.ToLower(values[i])
+	}
+	return values
+}
+
+// formatKeyBindings renders [tui.keys] as "action=key key,...", the form
+// parseKeyBindings reads back.
+func formatKeyBindings(bindings map[string][]string) string {
+	actions := make([]string, 0, len(bindings))
+	for action := range bindings {
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+
+	items := make([]string, len(actions))
+	for i, action := range actions {
+		items[i] = action + "=" + strings.Join(bindings[action], " ")
+	}
+	return strings.Join(items, ",")
+}
+
+// parseKeyBindings reads "action=key key" items into [tui.keys]. Items
+// without keys are kept empty so validation reports them.
+func parseKeyBindings(items []string) map[string][]string {
+	bindings := make(map[string][]string, len(items))
+	for _, item := range items {
+		action, keys, _ := strings.Cut(item, "=")
+		bindings[strings.TrimSpace(action)] = strings.Fields(keys)
+	}
+	return bindings
+}
+
+// formatThemes renders [tui.themes] as "name.role=color,...", the form
+// parseThemes reads back.
+func formatThemes(themes map[string]map[string]string) string {
+	var items []string
+	for name, roles := range themes {
+		for role, color := range roles {
+			items = append(items, name+"."+role+"="+color)
+		}
+	}
+	sort.Strings(items)
+	return strings.Join(items, ",")
+}
+
+// parseThemes reads "name.role=color" items into [tui.themes]. Items
+// without a theme name are kept under "" so validation reports them.
+func parseThemes(items []string) map[string]map[string]string {
+	themes := make(map[string]map[string]string)
+	for _, item := range items {
+		key, color, _ := strings.Cut(item, "=")
+		name, role, _ := strings.Cut(strings.TrimSpace(key), ".")
+		if themes[name] == nil {
+			themes[name] = make(map[string]string)
+		}
+		themes[name][role] = strings.TrimSpace(color)
+	}
+	return themes
 }
 
 var boolConfigSetters = map[string]func(*config.Config, bool){
-	"storage.backup_enabled":    func(cfg *config.Config, value bool) { cfg.Storage.BackupEnabled = value },
-	"sync.enabled":              func(cfg *config.Config, value bool) { cfg.Sync.Enabled = value },
-	"learning.reminder_enabled": func(cfg *config.Config, value bool) { cfg.Learning.ReminderEnabled = value },
-	"learning.streak_tracking":  func(cfg *config.Config, value bool) { cfg.Learning.StreakTracking = value },
+	"storage.backup_enabled":       func(cfg *config.Config, value bool) { cfg.Storage.BackupEnabled = value },
+	"storage.read_only":            func(cfg *config.Config, value bool) { cfg.Storage.ReadOnly = value },
+	"storage.archive_plan_files":   func(cfg *config.Config, value bool) { cfg.Storage.ArchivePlanFiles = value },
+	"storage.encrypt":              func(cfg *config.Config, value bool) { cfg.Storage.Encrypt = value },
+	"sync.enabled":                 func(cfg *config.Config, value bool) { cfg.Sync.Enabled = value },
+	"sync.auto_commit":             func(cfg *config.Config, value bool) { cfg.Sync.AutoCommit = value },
+	"learning.reminder_enabled":    func(cfg *config.Config, value bool) { cfg.Learning.ReminderEnabled = value },
+	"learning.streak_tracking":     func(cfg *config.Config, value bool) { cfg.Learning.StreakTracking = value },
+	"learning.prompt_stop_notes":   func(cfg *config.Config, value bool) { cfg.Learning.PromptStopNotes = value },
+	"learning.prompt_artifacts":    func(cfg *config.Config, value bool) { cfg.Learning.PromptArtifacts = value },
+	"learning.prompt_reflection":   func(cfg *config.Config, value bool) { cfg.Learning.PromptReflection = value },
+	"learning.auto_advance_chunks": func(cfg *config.Config, value bool) { cfg.Learning.AutoAdvanceChunks = value },
 }
 
 // setConfigValue sets a nested config value by dot-notation key.
@@ -262,7 +474,12 @@ func setConfigValue(cfg *config.Config, key, value string) error {
 		return nil
 	}
 
-	return fmt.Errorf("unknown config key: %s", key)
+	if setter, ok := listConfigSetters[key]; ok {
+		setter(cfg, parseList(value))
+		return nil
+	}
+
+	return fmt.Errorf("unknown config key: %s (see 'samedi config list')", key)
 }
 
 func parseBool(value, key string) (bool, error) {
@@ -273,6 +490,17 @@ func parseBool(value, key string) (bool, error) {
 	return parsed, nil
 }
 
+// parseList splits a comma-separated value, dropping empty items.
+func parseList(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if trimmed := strings.TrimSpace(item); trimmed != "" {
+			items = append(items, trimmed)
+		}
+	}
+	return items
+}
+
 func parseInt(value, key string) (int, error) {
 	parsed, err := strconv.Atoi(value)
 	if err != nil {
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/pezware/samedi.dev/internal/config"
@@ -63,3 +65,85 @@ func TestGetConfigValue(t *testing.T) {
 	missing := getConfigValue(cfg, "does.not.exist")
 	assert.Nil(t, missing)
 }
+
+func TestSetConfigValue_List(t *testing.T) {
+	cfg := config.DefaultConfig()
+
+	require.NoError(t, setConfigValue(cfg, "learning.reminder_times", "08:30, 20:00,"))
+	assert.Equal(t, []string{"08:30", "20:00"}, cfg.Learning.ReminderTimes)
+	assert.Equal(t, "08:30,20:00", getConfigValue(cfg, "learning.reminder_times"))
+}
+
+func TestSetConfigValue_KeyBindings(t *testing.T) {
+	cfg := config.DefaultConfig()
+	assert.Equal(t, "", getConfigValue(cfg, "tui.keys"))
+
+	require.NoError(t, setConfigValue(cfg, "tui.keys", "up=w up, quit=ctrl+q"))
+	assert.Equal(t, map[string][]string{"up": {"w", "up"}, "quit": {"ctrl+q"}}, cfg.TUI.Keys)
+	assert.Equal(t, "quit=ctrl+q,up=w up", getConfigValue(cfg, "tui.keys"))
+	assert.NoError(t, cfg.Validate())
+
+	require.NoError(t, setConfigValue(cfg, "tui.keys", "up"))
+	assert.Error(t, cfg.Validate(), "an action without keys")
+}
+
+func TestSetConfigValue_Themes(t *testing.T) {
+	cfg := config.DefaultConfig()
+
+	require.NoError(t, setConfigValue(cfg, "tui.themes", "ocean.base=light, ocean.accent=#5fafff"))
+	assert.Equal(t, map[string]map[string]string{"ocean": {"base": "light", "accent": "#5fafff"}}, cfg.TUI.Themes)
+	assert.Equal(t, "ocean.accent=#5fafff,ocean.base=light", getConfigValue(cfg, "tui.themes"))
+
+	require.NoError(t, setConfigValue(cfg, "tui.theme", "ocean"))
+	assert.NoError(t, cfg.Validate())
+
+	require.NoError(t, setConfigValue(cfg, "tui.themes", "ocean.accent=sky"))
+	assert.Error(t, cfg.Validate())
+}
+
+func TestSetConfigValue_SessionBehavior(t *testing.T) {
+	cfg := config.DefaultConfig()
+
+	require.NoError(t, setConfigValue(cfg, "learning.chunk_selection", "next"))
+	require.NoError(t, setConfigValue(cfg, "learning.prompt_stop_notes", "false"))
+	require.NoError(t, setConfigValue(cfg, "learning.prompt_artifacts", "false"))
+	require.NoError(t, setConfigValue(cfg, "learning.auto_advance_chunks", "false"))
+
+	assert.Equal(t, "next", getConfigValue(cfg, "learning.chunk_selection"))
+	assert.Equal(t, false, getConfigValue(cfg, "learning.prompt_stop_notes"))
+	assert.Equal(t, false, getConfigValue(cfg, "learning.prompt_artifacts"))
+	assert.Equal(t, false, getConfigValue(cfg, "learning.auto_advance_chunks"))
+}
+
+func TestConfigKeys_ResolvableAndSettable(t *testing.T) {
+	cfg := config.DefaultConfig()
+	for _, key := range config.Keys() {
+		assert.NotNil(t, getConfigValue(cfg, key), "no resolver for %s", key)
+
+		_, isString := stringConfigSetters[key]
+		_, isInt := intConfigSetters[key]
+		_, isBool := boolConfigSetters[key]
+		_, isList := listConfigSetters[key]
+		assert.True(t, isString || isInt || isBool || isList, "no setter for %s", key)
+	}
+}
+
+func TestRenderConfigList(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.LLM.Provider = "claude"
+
+	var buf bytes.Buffer
+	renderConfigList(&buf, cfg)
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	assert.Len(t, lines, len(config.Keys()))
+	assert.Contains(t, buf.String(), "llm.provider ")
+	assert.Contains(t, buf.String(), "= claude\n")
+	assert.Contains(t, buf.String(), "= f2=start-next,f3=stop-note,f4=status\n")
+}
+
+func TestConfigSetCmd_InvalidValuesExitInvalid(t *testing.T) {
+	assert.Equal(t, ExitInvalid, executeExitCode(t, configSetCmd(), "tui.theme", "nope"))
+	assert.Equal(t, ExitInvalid, executeExitCode(t, configSetCmd(), "no.such_key", "1"))
+	assert.Equal(t, ExitInvalid, executeExitCode(t, configSetCmd(), "sync.enabled", "maybe"))
+}
@@ -0,0 +1,657 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/export"
+	"github.com/pezware/samedi.dev/internal/jobs"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+	"github.com/spf13/cobra"
+)
+
+// dbCmd creates the `samedi db` command group.
+func dbCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "db",
+		Short: "Inspect and maintain the local database",
+		Long: `Inspect and maintain ~/.samedi/sessions.db.
+
+Examples:
+  samedi db info                  # Sizes, row counts, last backup
+  samedi db check                 # Look for corruption
+  samedi db backup                # Copy to storage.backup_dir
+  samedi db vacuum                # Reclaim free space
+  samedi db vacuum --if-needed    # Only past storage.auto_vacuum_percent
+  samedi db migrate --to 12       # Roll back before downgrading samedi`,
+	}
+
+	cmd.AddCommand(dbInfoCmd())
+	cmd.AddCommand(dbCheckCmd())
+	cmd.AddCommand(dbBackupCmd())
+	cmd.AddCommand(mutating(keepsData(dbVacuumCmd())))
+	cmd.AddCommand(mutating(dbMigrateCmd()))
+
+	return cmd
+}
+
+// dbInfoCmd creates the `samedi db info` subcommand.
+func dbInfoCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "info",
+		Short: "Show database size, row counts, and last backup",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := getConfig(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			paths, db, err := openDatabase()
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			info, err := collectDBInfo(db, paths, paths.BackupDir)
+			if err != nil {
+				return err
+			}
+			info.AutoVacuumPercent = cfg.Storage.AutoVacuumPercent
+
+			jsonOutput, err := cmd.Flags().GetBool("json")
+			if err != nil {
+				return fmt.Errorf("failed to get json flag: %w", err)
+			}
+			if jsonOutput {
+				return printJSON(info)
+			}
+
+			renderDBInfo(os.Stdout, info)
+			return nil
+		},
+	}
+}
+
+// dbVacuumCmd creates the `samedi db vacuum` subcommand.
+func dbVacuumCmd() *cobra.Command {
+	var ifNeeded bool
+
+	cmd := &cobra.Command{
+		Use:   "vacuum",
+		Short: "Reclaim unused space in the database",
+		Long: `Rebuild the database file to return space left behind by deleted
+rows (e.g. after removing plans or importing and discarding data).
+
+With --if-needed, vacuum only when free space has reached
+storage.auto_vacuum_percent. Samedi also checks this threshold
+automatically after bulk changes such as 'samedi plan reindex'.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := getConfig(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			_, db, err := openDatabase()
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			before, err := db.Stats()
+			if err != nil {
+				return err
+			}
+			if ifNeeded && !before.NeedsVacuum(cfg.Storage.AutoVacuumPercent) {
+				fmt.Printf("No vacuum needed (%d%% free, threshold %d%%)\n",
+					before.FreePercent(), cfg.Storage.AutoVacuumPercent)
+				return nil
+			}
+
+			after, err := vacuumDatabase(db)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("✓ Vacuumed database: %s → %s (reclaimed %s)\n",
+				formatBytes(before.SizeBytes()), formatBytes(after.SizeBytes()),
+				formatBytes(before.SizeBytes()-after.SizeBytes()))
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&ifNeeded, "if-needed", false, "only vacuum past storage.auto_vacuum_percent")
+
+	return cmd
+}
+
+// dbCheckCmd creates the `samedi db check` subcommand.
+func dbCheckCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "check",
+		Short: "Check the database for corruption",
+		Long: `Run SQLite's integrity check on the database and report pending
+migrations and the latest backup. The database is opened read-only.
+
+Exits with an error if the check finds problems; restore the latest
+backup from storage.backup_dir (see 'samedi db info') if it does.`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			paths, err := getPaths()
+			if err != nil {
+				return fmt.Errorf("failed to get paths: %w", err)
+			}
+			db, err := storage.OpenSQLiteReadOnly(paths.DatabasePath)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			check, err := checkDatabase(db, paths)
+			if err != nil {
+				return err
+			}
+
+			if jsonOutput, _ := cmd.Flags().GetBool("json"); jsonOutput {
+				if err := printJSON(check); err != nil {
+					return err
+				}
+			} else {
+				renderDBCheck(os.Stdout, check)
+			}
+			if len(check.Problems) > 0 {
+				return fmt.Errorf("database integrity check failed")
+			}
+			return nil
+		},
+	}
+}
+
+// dbCheck is the JSON shape of `samedi db check`.
+type dbCheck struct {
+	Path       string          `json:"path"`
+	Problems   []string        `json:"problems"`
+	Pending    int             `json:"pending_migrations"`
+	LastBackup *storage.Backup `json:"last_backup,omitempty"`
+}
+
+// checkDatabase runs the integrity check and gathers pending migrations
+// and the latest database backup.
+func checkDatabase(db *storage.SQLiteDB, paths *storage.Paths) (*dbCheck, error) {
+	problems, err := db.IntegrityCheck()
+	if err != nil {
+		return nil, err
+	}
+	check := &dbCheck{Path: paths.DatabasePath, Problems: problems}
+	if check.Problems == nil {
+		check.Problems = []string{}
+	}
+
+	if check.Pending, err = storage.NewMigrator(db).Pending(); err != nil {
+		return nil, err
+	}
+	backups, err := storage.Backups(paths.BackupDir)
+	if err != nil {
+		return nil, err
+	}
+	if len(backups) > 0 {
+		check.LastBackup = &backups[0]
+	}
+	return check, nil
+}
+
+// renderDBCheck writes the result of a database check.
+func renderDBCheck(w io.Writer, check *dbCheck) {
+	if len(check.Problems) == 0 {
+		fmt.Fprintln(w, "✓ Integrity: ok")
+	} else {
+		fmt.Fprintf(w, "✗ Integrity: %d problem(s)\n", len(check.Problems))
+		for _, problem := range check.Problems {
+			fmt.Fprintf(w, "  %s\n", problem)
+		}
+	}
+
+	if check.Pending == 0 {
+		fmt.Fprintln(w, "✓ Schema: up to date")
+	} else {
+		fmt.Fprintf(w, "! Schema: %d migration(s) pending; the next samedi command applies them\n", check.Pending)
+	}
+
+	if check.LastBackup != nil {
+		fmt.Fprintf(w, "  Last backup: %s (%s)\n", check.LastBackup.Path, check.LastBackup.Time.Format("Jan 2, 2006 3:04 PM"))
+	} else {
+		fmt.Fprintln(w, "  Last backup: never (run 'samedi db backup')")
+	}
+	if len(check.Problems) > 0 && check.LastBackup != nil {
+		fmt.Fprintf(w, "\nTo restore, replace %s with the last backup.\n", check.Path)
+	}
+}
+
+// dbBackupCmd creates the `samedi db backup` subcommand.
+func dbBackupCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "backup [path]",
+		Short: "Copy the database to a backup file",
+		Long: `Write a consistent copy of the database, including changes not yet
+checkpointed from the write-ahead log. The copy is compacted.
+
+Without a path, the copy goes to storage.backup_dir, named by
+export.backup_filename (sessions-<date>-<time>.db by default). A path
+that is a directory gets the same name inside it. Named copies never
+overwrite: a taken name gets "-2", "-3", ... Copies made here are never
+pruned; only the automatic backups taken before migrations and every
+storage.auto_backup_days are, down to storage.backup_keep.
+
+Examples:
+  samedi db backup
+  samedi db backup ~/Dropbox/samedi/`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := getConfig(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			paths, db, err := openDatabase()
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			dir := paths.BackupDir
+			var path string
+			if len(args) == 1 {
+				path = export.ExpandHome(args[0])
+				if fi, err := os.Stat(path); err == nil && fi.IsDir() {
+					dir, path = path, ""
+				}
+			}
+			if path == "" {
+				name, err := export.Filename(cfg.Export.BackupFilename, export.Vars{Type: "backup", Ext: "db"})
+				if err != nil {
+					return fmt.Errorf("%w: invalid export.backup_filename: %w", config.ErrInvalid, err)
+				}
+				path = export.FreePath(filepath.Join(dir, name))
+			}
+			if err := db.Backup(path); err != nil {
+				return err
+			}
+
+			fi, err := os.Stat(path)
+			if err != nil {
+				return fmt.Errorf("failed to read backup: %w", err)
+			}
+			if jsonOutput, _ := cmd.Flags().GetBool("json"); jsonOutput {
+				return printJSON(storage.Backup{Path: path, Time: fi.ModTime(), Bytes: fi.Size()})
+			}
+			fmt.Printf("✓ Backed up database to %s (%s)\n", path, formatBytes(fi.Size()))
+			return nil
+		},
+	}
+}
+
+// dbMigrateCmd creates the `samedi db migrate` subcommand.
+func dbMigrateCmd() *cobra.Command {
+	var to int
+
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Show the schema version, or move the database to another",
+		Long: `Show the database's schema version, or move it to another with --to.
+
+Every samedi command migrates the database forward to the newest schema
+it knows, and an older release can't read a schema newer than its own.
+To go back to an older release, roll the schema back with this release
+first, then install the older one:
+
+  samedi db migrate              # Note the version, e.g. 16 of 16
+  samedi db migrate --to 12      # The older release's latest version
+
+Rolling back drops the tables and columns added since, and the data in
+them. The database is copied to storage.backup_dir first, whether or not
+automatic backups are on. Any command of this release afterward
+migrates forward again.`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := getConfig(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			paths, err := configPaths(cfg)
+			if err != nil {
+				return fmt.Errorf("failed to get paths: %w", err)
+			}
+			if err := paths.EnsureDirectories(); err != nil {
+				return fmt.Errorf("failed to create directories: %w", err)
+			}
+
+			// Opened directly: sharedDatabase would migrate it forward
+			db, err := storage.NewSQLiteDB(paths.DatabasePath)
+			if err != nil {
+				return fmt.Errorf("failed to initialize database: %w", err)
+			}
+			defer db.Close()
+
+			migrator := storage.NewMigrator(db)
+			from, err := migrator.Version()
+			if err != nil {
+				return err
+			}
+			latest, err := storage.LatestVersion()
+			if err != nil {
+				return err
+			}
+
+			if !cmd.Flags().Changed("to") {
+				if jsonOutput, _ := cmd.Flags().GetBool("json"); jsonOutput {
+					return printJSON(map[string]int{"version": from, "latest": latest})
+				}
+				fmt.Printf("Schema version: %d of %d\n", from, latest)
+				return nil
+			}
+
+			// Always keep a copy to roll forward from
+			policy := backupPolicy(cfg, paths)
+			policy.Dir = paths.BackupDir
+			migrator.SetBackup(policy)
+			if err := migrator.MigrateTo(to); err != nil {
+				return err
+			}
+
+			switch {
+			case to == from:
+				fmt.Printf("Schema already at version %d\n", to)
+			case to < from:
+				fmt.Printf("✓ Rolled back schema from version %d to %d (backup in %s)\n", from, to, paths.BackupDir)
+			default:
+				fmt.Printf("✓ Migrated schema from version %d to %d\n", from, to)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&to, "to", 0, "schema version to migrate or roll back to")
+
+	return cmd
+}
+
+// setsSchema reports whether cmd is `samedi db migrate`. Nothing may open
+// the database the usual way after it, which would migrate it forward.
+func setsSchema(cmd *cobra.Command) bool {
+	return cmd.Name() == "migrate" && cmd.Parent() != nil && cmd.Parent().Name() == "db"
+}
+
+// backupPolicy returns the automatic backup settings of cfg for the
+// database at paths. Disabled backups give the zero policy.
+func backupPolicy(cfg *config.Config, paths *storage.Paths) storage.BackupPolicy {
+	if !cfg.Storage.BackupEnabled {
+		return storage.BackupPolicy{}
+	}
+	return storage.BackupPolicy{
+		Dir:   paths.BackupDir,
+		Keep:  cfg.Storage.BackupKeep,
+		Every: time.Duration(cfg.Storage.AutoBackupDays) * 24 * time.Hour,
+	}
+}
+
+// newMigrator returns a migrator for the database at paths that backs it
+// up before migrating, as configured. A config that fails to load means
+// no backup; the command itself reports the config.
+func newMigrator(db *storage.SQLiteDB, paths *storage.Paths) *storage.Migrator {
+	migrator := storage.NewMigrator(db)
+	if cfg, err := config.Load(); err == nil {
+		migrator.SetBackup(backupPolicy(cfg, paths))
+	}
+	return migrator
+}
+
+// autoBackupAfter backs up the database once storage.auto_backup_days
+// have passed since the last backup, through the job queue. It runs after
+// every command and acts only after ones that change the data in the
+// database, never in read-only mode; failures only produce a warning.
+func autoBackupAfter(cmd *cobra.Command) {
+	if !writesData(cmd) || readOnlyMode(cmd) {
+		return
+	}
+	cfg, err := getConfig(cmd)
+	if err != nil {
+		return
+	}
+	paths, err := configPaths(cfg)
+	if err != nil {
+		return
+	}
+
+	// Check the backup directory before opening the database
+	policy := backupPolicy(cfg, paths)
+	now := time.Now()
+	if due, err := storage.BackupDue(policy, now); err != nil || !due {
+		return
+	}
+
+	runJobNow(cmd, "scheduled backup", jobs.EnqueueRequest{Type: jobTypeBackup})
+}
+
+// autoVacuum vacuums the database if free space has reached the configured
+// threshold. Called after bulk changes; failures only produce a warning.
+func autoVacuum(cfg *config.Config) {
+	_, db, err := openDatabase()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: auto-vacuum skipped: %v\n", err)
+		return
+	}
+	defer db.Close()
+
+	before, err := db.Stats()
+	if err != nil || !before.NeedsVacuum(cfg.Storage.AutoVacuumPercent) {
+		return
+	}
+
+	after, err := vacuumDatabase(db)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: auto-vacuum failed: %v\n", err)
+		return
+	}
+	fmt.Fprintf(os.Stderr, "✓ Reclaimed %s of free database space\n", formatBytes(before.SizeBytes()-after.SizeBytes()))
+}
+
+// vacuumDatabase vacuums db and returns its stats afterwards.
+func vacuumDatabase(db *storage.SQLiteDB) (*storage.DBStats, error) {
+	if err := db.Vacuum(); err != nil {
+		return nil, err
+	}
+	return db.Stats()
+}
+
+// openDatabase opens and migrates the active profile's database.
+func openDatabase() (*storage.Paths, *storage.SQLiteDB, error) {
+	paths, err := getPaths()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get paths: %w", err)
+	}
+
+	if err := paths.EnsureDirectories(); err != nil {
+		return nil, nil, fmt.Errorf("failed to create directories: %w", err)
+	}
+
+	db, err := storage.NewSQLiteDB(paths.DatabasePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to initialize database: %w", err)
+	}
+
+	if err := newMigrator(db, paths).Migrate(); err != nil {
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("failed to run migrations: %w", err)
+	}
+
+	return paths, db, nil
+}
+
+// dbInfo is the JSON shape of `samedi db info`.
+type dbInfo struct {
+	Path              string               `json:"path"`
+	FileBytes         int64                `json:"file_bytes"`
+	WALBytes          int64                `json:"wal_bytes"`
+	FreeBytes         int64                `json:"free_bytes"`
+	FreePercent       int                  `json:"free_percent"`
+	AutoVacuumPercent int                  `json:"auto_vacuum_percent"`
+	Tables            []storage.TableStats `json:"tables"`
+	PlansBytes        int64                `json:"plans_bytes"`
+	CardsBytes        int64                `json:"cards_bytes"`
+	LastBackup        *backupInfo          `json:"last_backup,omitempty"`
+}
+
+// backupInfo describes the newest file in the backup directory.
+type backupInfo struct {
+	Path      string    `json:"path"`
+	SizeBytes int64     `json:"size_bytes"`
+	ModTime   time.Time `json:"modified_at"`
+}
+
+// collectDBInfo gathers file sizes, row counts, and the last backup.
+func collectDBInfo(db *storage.SQLiteDB, paths *storage.Paths, backupDir string) (*dbInfo, error) {
+	stats, err := db.Stats()
+	if err != nil {
+		return nil, err
+	}
+
+	info := &dbInfo{
+		Path:        paths.DatabasePath,
+		FreeBytes:   stats.FreeBytes(),
+		FreePercent: stats.FreePercent(),
+		Tables:      stats.Tables,
+	}
+
+	if fi, err := os.Stat(paths.DatabasePath); err == nil {
+		info.FileBytes = fi.Size()
+	}
+	if fi, err := os.Stat(paths.DatabasePath + "-wal"); err == nil {
+		info.WALBytes = fi.Size()
+	}
+
+	if info.PlansBytes, err = dirSize(paths.PlansDir); err != nil {
+		return nil, err
+	}
+	if info.CardsBytes, err = dirSize(paths.CardsDir); err != nil {
+		return nil, err
+	}
+	if info.LastBackup, err = lastBackup(backupDir); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// dirSize returns the total size of regular files under dir; a missing
+// directory has size 0.
+func dirSize(dir string) (int64, error) {
+	var total int64
+	err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if d.Type().IsRegular() {
+			fi, err := d.Info()
+			if err != nil {
+				return err
+			}
+			total += fi.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to measure %s: %w", dir, err)
+	}
+	return total, nil
+}
+
+// lastBackup returns the most recently modified file in dir, or nil if
+// there is none.
+func lastBackup(dir string) (*backupInfo, error) {
+	if dir == "" {
+		return nil, nil
+	}
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup directory: %w", err)
+	}
+
+	var newest *backupInfo
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		fi, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		if newest == nil || fi.ModTime().After(newest.ModTime) {
+			newest = &backupInfo{Path: filepath.Join(dir, entry.Name()), SizeBytes: fi.Size(), ModTime: fi.ModTime()}
+		}
+	}
+	return newest, nil
+}
+
+// renderDBInfo writes the database report.
+func renderDBInfo(w io.Writer, info *dbInfo) {
+	fmt.Fprintln(w, "🗄️  Database")
+	fmt.Fprintln(w, strings.Repeat("─", 50))
+
+	fmt.Fprintf(w, "File:         %s\n", info.Path)
+	size := formatBytes(info.FileBytes)
+	if info.WALBytes > 0 {
+		size += fmt.Sprintf(" (+ %s write-ahead log)", formatBytes(info.WALBytes))
+	}
+	fmt.Fprintf(w, "Size:         %s\n", size)
+	fmt.Fprintf(w, "Free space:   %s (%d%%)", formatBytes(info.FreeBytes), info.FreePercent)
+	if info.AutoVacuumPercent > 0 && info.FreePercent >= info.AutoVacuumPercent {
+		fmt.Fprint(w, " — run 'samedi db vacuum'")
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "Plans:        %s\n", formatBytes(info.PlansBytes))
+	fmt.Fprintf(w, "Cards:        %s\n", formatBytes(info.CardsBytes))
+
+	if info.LastBackup != nil {
+		fmt.Fprintf(w, "Last backup:  %s (%s, %s)\n", filepath.Base(info.LastBackup.Path),
+			info.LastBackup.ModTime.Format("Jan 2, 2006 3:04 PM"), formatBytes(info.LastBackup.SizeBytes))
+	} else {
+		fmt.Fprintln(w, "Last backup:  never")
+	}
+
+	fmt.Fprintln(w)
+	tw := components.NewTabWriter(w, 2)
+	fmt.Fprintln(tw, "TABLE\tROWS")
+	for _, table := range info.Tables {
+		fmt.Fprintf(tw, "%s\t%d\n", table.Name, table.Rows)
+	}
+	tw.Flush()
+}
+
+// formatBytes renders a byte count with binary units (e.g. 512 B, 1.5 KB).
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit && exp < 3; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMGT"[exp])
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDBCmd_Structure(t *testing.T) {
+	cmd := dbCmd()
+
+	names := make([]string, 0)
+	for _, sub := range cmd.Commands() {
+		names = append(names, sub.Name())
+	}
+	assert.ElementsMatch(t, []string{"info", "check", "backup", "vacuum", "migrate"}, names)
+	assert.NotNil(t, dbMigrateCmd().Flags().Lookup("to"))
+
+	for _, sub := range cmd.Commands() {
+		assert.Equal(t, sub.Name() == "migrate", setsSchema(sub), "only db migrate sets the schema")
+	}
+	assert.NotNil(t, dbVacuumCmd().Flags().Lookup("if-needed"))
+}
+
+func TestDBBackupCmd_BackupFilename(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	cfg := config.DefaultConfig()
+	cfg.Export.BackupFilename = "{{type}}-{{date}}.{{ext}}"
+	require.NoError(t, config.Save(cfg))
+	paths, err := getPaths()
+	require.NoError(t, err)
+
+	for range 2 {
+		cmd := dbBackupCmd()
+		cmd.SetArgs(nil)
+		require.NoError(t, cmd.Execute())
+	}
+	name := "backup-" + time.Now().Format("2006-01-02")
+	assert.FileExists(t, filepath.Join(paths.BackupDir, name+".db"))
+	assert.FileExists(t, filepath.Join(paths.BackupDir, name+"-2.db"), "a taken name is never overwritten")
+
+	dir := t.TempDir()
+	cmd := dbBackupCmd()
+	cmd.SetArgs([]string{dir})
+	require.NoError(t, cmd.Execute())
+	assert.FileExists(t, filepath.Join(dir, name+".db"), "a directory gets the same name")
+}
+
+func TestCollectDBInfo(t *testing.T) {
+	root := t.TempDir()
+	paths := &storage.Paths{
+		BaseDir:      root,
+		PlansDir:     filepath.Join(root, "plans"),
+		CardsDir:     filepath.Join(root, "cards"),
+		DatabasePath: filepath.Join(root, "sessions.db"),
+	}
+	require.NoError(t, os.MkdirAll(paths.PlansDir, 0o755))
+	require.NoError(t, os.WriteFile(paths.PlanPath("rust"), make([]byte, 2048), 0o644))
+
+	backupDir := filepath.Join(root, "backups")
+	require.NoError(t, os.MkdirAll(backupDir, 0o755))
+	older := filepath.Join(backupDir, "samedi-2025-09-01.tar.gz")
+	newer := filepath.Join(backupDir, "samedi-2025-10-01.tar.gz")
+	require.NoError(t, os.WriteFile(older, []byte("old"), 0o644))
+	require.NoError(t, os.WriteFile(newer, []byte("new"), 0o644))
+	require.NoError(t, os.Chtimes(older, time.Now().Add(-48*time.Hour), time.Now().Add(-48*time.Hour)))
+
+	db, err := storage.NewSQLiteDB(paths.DatabasePath)
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, storage.NewMigrator(db).Migrate())
+
+	info, err := collectDBInfo(db, paths, backupDir)
+	require.NoError(t, err)
+
+	assert.Positive(t, info.FileBytes)
+	assert.Equal(t, int64(2048), info.PlansBytes)
+	assert.Zero(t, info.CardsBytes, "missing directory counts as empty")
+	require.NotNil(t, info.LastBackup)
+	assert.Equal(t, newer, info.LastBackup.Path)
+	assert.NotEmpty(t, info.Tables)
+}
+
+func TestCheckDatabase(t *testing.T) {
+	root := t.TempDir()
+	paths := &storage.Paths{
+		DatabasePath: filepath.Join(root, "sessions.db"),
+		BackupDir:    filepath.Join(root, "backups"),
+	}
+	db, err := storage.NewSQLiteDB(paths.DatabasePath)
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, storage.NewMigrator(db).Migrate())
+
+	check, err := checkDatabase(db, paths)
+	require.NoError(t, err)
+	assert.Empty(t, check.Problems)
+	assert.Zero(t, check.Pending)
+	assert.Nil(t, check.LastBackup)
+
+	backup, err := db.BackupTo(paths.BackupDir, "", time.Now())
+	require.NoError(t, err)
+	check, err = checkDatabase(db, paths)
+	require.NoError(t, err)
+	require.NotNil(t, check.LastBackup)
+	assert.Equal(t, backup, check.LastBackup.Path)
+}
+
+func TestRenderDBCheck(t *testing.T) {
+	var buf bytes.Buffer
+	renderDBCheck(&buf, &dbCheck{Path: "/home/user/.samedi/sessions.db", Problems: []string{}})
+	assert.Contains(t, buf.String(), "✓ Integrity: ok")
+	assert.Contains(t, buf.String(), "Last backup: never")
+
+	buf.Reset()
+	renderDBCheck(&buf, &dbCheck{
+		Path:       "/home/user/.samedi/sessions.db",
+		Problems:   []string{"row 3 missing from index idx_sessions_plan"},
+		Pending:    1,
+		LastBackup: &storage.Backup{Path: "/home/user/samedi-backups/sessions-20251013-090000-auto.db"},
+	})
+	out := buf.String()
+	assert.Contains(t, out, "✗ Integrity: 1 problem(s)\n  row 3 missing from index idx_sessions_plan")
+	assert.Contains(t, out, "1 migration(s) pending")
+	assert.Contains(t, out, "To restore, replace /home/user/.samedi/sessions.db with the last backup.")
+}
+
+func TestBackupPolicy(t *testing.T) {
+	cfg := config.DefaultConfig()
+	paths := &storage.Paths{BackupDir: "/backups"}
+
+	policy := backupPolicy(cfg, paths)
+	assert.Equal(t, storage.BackupPolicy{Dir: "/backups", Keep: 5, Every: 7 * 24 * time.Hour}, policy)
+
+	cfg.Storage.BackupEnabled = false
+	assert.Equal(t, storage.BackupPolicy{}, backupPolicy(cfg, paths))
+}
+
+func TestLastBackup_None(t *testing.T) {
+	backup, err := lastBackup(filepath.Join(t.TempDir(), "missing"))
+	require.NoError(t, err)
+	assert.Nil(t, backup)
+}
+
+func TestRenderDBInfo(t *testing.T) {
+	info := &dbInfo{
+		Path:              "/home/user/.samedi/sessions.db",
+		FileBytes:         3 << 20,
+		WALBytes:          64 << 10,
+		FreeBytes:         1 << 20,
+		FreePercent:       33,
+		AutoVacuumPercent: 25,
+		Tables:            []storage.TableStats{{Name: "sessions", Rows: 412}},
+	}
+
+	var out bytes.Buffer
+	renderDBInfo(&out, info)
+	text := out.String()
+
+	assert.Contains(t, text, "3.0 MB (+ 64.0 KB write-ahead log)")
+	assert.Contains(t, text, "1.0 MB (33%) — run 'samedi db vacuum'")
+	assert.Contains(t, text, "Last backup:  never")
+	assert.Contains(t, text, "sessions")
+	assert.Contains(t, text, "412")
+}
+
+func TestFormatBytes(t *testing.T) {
+	assert.Equal(t, "512 B", formatBytes(512))
+	assert.Equal(t, "1.5 KB", formatBytes(1536))
+	assert.Equal(t, "2.0 MB", formatBytes(2<<20))
+	assert.Equal(t, "1.0 GB", formatBytes(1<<30))
+}
+
+func TestAutoBackupAfter(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	paths, err := getPaths()
+	require.NoError(t, err)
+	backups := func() []storage.Backup {
+		t.Helper()
+		found, err := storage.Backups(paths.BackupDir)
+		require.NoError(t, err)
+		return found
+	}
+
+	profileCreate, _, err := rootCmd.Find([]string{"profile", "create"})
+	require.NoError(t, err)
+	autoBackupAfter(profileCreate)
+	assert.Empty(t, backups(), "config-only commands leave the database alone")
+
+	cfg := config.DefaultConfig()
+	cfg.Storage.ReadOnly = true
+	require.NoError(t, config.Save(cfg))
+	stop, _, err := rootCmd.Find([]string{"stop"})
+	require.NoError(t, err)
+	autoBackupAfter(stop)
+	assert.Empty(t, backups(), "read-only mode writes nothing")
+
+	cfg.Storage.ReadOnly = false
+	require.NoError(t, config.Save(cfg))
+	autoBackupAfter(stop)
+	assert.Len(t, backups(), 1)
+}
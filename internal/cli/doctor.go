@@ -0,0 +1,217 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+	"github.com/spf13/cobra"
+)
+
+// doctorCheck is one health check reported by `samedi doctor`.
+type doctorCheck struct {
+	Name   string `json:"name"`
+	OK     bool   `json:"ok"`
+	Detail string `json:"detail"`
+}
+
+// doctorDeprecation is a deprecation with its status for this build.
+type doctorDeprecation struct {
+	deprecation
+	Old     string `json:"old"`
+	Overdue bool   `json:"overdue"` // This build is at or past RemovedIn
+	Broken  bool   `json:"broken"`  // Its command or replacement no longer exists
+}
+
+// doctorReport is the output of `samedi doctor`.
+type doctorReport struct {
+	Version      string              `json:"version"`
+	Checks       []doctorCheck       `json:"checks"`
+	Deprecations []doctorDeprecation `json:"deprecations"`
+}
+
+// doctorCmd creates the `samedi doctor` command.
+func doctorCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "doctor",
+		Short: "Check the installation and list deprecated flags and commands",
+		Long: `Check that the configuration loads, the data directory and database
+exist, and every plan file parses cleanly, then list every deprecated
+flag and command with its replacement and the release it will be
+removed in.
+
+Deprecated forms keep working until then, printing a warning to stderr
+each time they are used. Run doctor after upgrading to find scripts that
+need updating.
+
+Examples:
+  samedi doctor
+  samedi doctor --json`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			report := runDoctor(cmd)
+
+			if jsonOutput, _ := cmd.Flags().GetBool("json"); jsonOutput {
+				if err := printJSON(report); err != nil {
+					return err
+				}
+			} else {
+				printDoctorReport(os.Stdout, report)
+			}
+
+			failed := 0
+			for _, check := range report.Checks {
+				if !check.OK {
+					failed++
+				}
+			}
+			if failed > 0 {
+				return fmt.Errorf("%d check(s) failed", failed)
+			}
+			return nil
+		},
+	}
+}
+
+// runDoctor runs the health checks and resolves the deprecation schedule
+// against the root of cmd.
+func runDoctor(cmd *cobra.Command) *doctorReport {
+	report := &doctorReport{Version: Version}
+
+	configCheck := doctorCheck{Name: "config", OK: true, Detail: config.Path()}
+	cfg, err := config.Load()
+	if err != nil {
+		configCheck.OK = false
+		configCheck.Detail = err.Error()
+	}
+	report.Checks = append(report.Checks, configCheck)
+
+	var paths *storage.Paths
+	if cfg != nil {
+		paths, err = configPaths(cfg)
+	} else {
+		paths, err = storage.DefaultPaths()
+	}
+	if err != nil {
+		report.Checks = append(report.Checks, doctorCheck{Name: "data directory", Detail: err.Error()})
+	} else {
+		dataCheck := pathCheck("data directory", paths.BaseDir)
+		dbCheck := pathCheck("database", paths.DatabasePath)
+		report.Checks = append(report.Checks, dataCheck, dbCheck)
+		// Plans are only checked once init has set up the data
+		if cfg != nil && dataCheck.OK && dbCheck.OK {
+			report.Checks = append(report.Checks, plansCheck(cmd))
+		}
+	}
+
+	root := cmd.Root()
+	for _, d := range deprecations {
+		report.Deprecations = append(report.Deprecations, doctorDeprecation{
+			deprecation: d,
+			Old:         d.Old(),
+			Overdue:     d.Overdue(Version),
+			Broken:      !deprecationResolves(root, d),
+		})
+	}
+
+	return report
+}
+
+// deprecationResolves reports whether d's command and its replacement
+// still exist under root, so the shim has something to forward to.
+func deprecationResolves(root *cobra.Command, d deprecation) bool {
+	cmd, _, err := root.Find(strings.Fields(d.Command)[1:])
+	if err != nil {
+		return false
+	}
+	if d.Kind == "flag" {
+		name, _ := splitReplacementFlag(d.Replacement)
+		return cmd.Flags().Lookup(name) != nil
+	}
+	target, rest, err := root.Find(strings.Fields(d.Replacement)[1:])
+	return err == nil && len(rest) == 0 && target != root
+}
+
+// pathCheck reports whether path exists, suggesting `samedi init` if not.
+func pathCheck(name, path string) doctorCheck {
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			return doctorCheck{Name: name, Detail: path + " not found (run 'samedi init')"}
+		}
+		return doctorCheck{Name: name, Detail: err.Error()}
+	}
+	return doctorCheck{Name: name, OK: true, Detail: path}
+}
+
+// plansCheck parses every plan file, as 'samedi plan validate' does, and
+// names the plans with errors or warnings.
+func plansCheck(cmd *cobra.Command) doctorCheck {
+	check := doctorCheck{Name: "plans"}
+	svc, err := getPlanService(cmd, "")
+	if err != nil {
+		check.Detail = err.Error()
+		return check
+	}
+	results, err := svc.Check(cmd.Context())
+	if err != nil {
+		check.Detail = err.Error()
+		return check
+	}
+	check.Detail, check.OK = summarizeCheckResults(results)
+	return check
+}
+
+// summarizeCheckResults describes plan check results in one line, and
+// reports whether every plan parsed cleanly.
+func summarizeCheckResults(results []plan.CheckResult) (string, bool) {
+	var problems []string
+	for _, result := range results {
+		if !result.OK() {
+			problems = append(problems, result.ID)
+		}
+	}
+	if len(problems) == 0 {
+		return fmt.Sprintf("%d plan(s) OK", len(results)), true
+	}
+	return fmt.Sprintf("%d of %d plan(s) have errors or warnings: %s (run 'samedi plan validate')",
+		len(problems), len(results), strings.Join(problems, ", ")), false
+}
+
+// printDoctorReport prints the checks and the deprecation schedule.
+func printDoctorReport(w io.Writer, report *doctorReport) {
+	fmt.Fprintf(w, "samedi %s\n\n", report.Version)
+	for _, check := range report.Checks {
+		mark := "✓"
+		if !check.OK {
+			mark = "✗"
+		}
+		fmt.Fprintf(w, "%s %s: %s\n", mark, check.Name, check.Detail)
+	}
+
+	if len(report.Deprecations) == 0 {
+		return
+	}
+	fmt.Fprintln(w, "\nDeprecations:")
+	tw := components.NewTabWriter(w, 2)
+	fmt.Fprintln(tw, "  DEPRECATED\tUSE INSTEAD\tSINCE\tREMOVED IN\t")
+	for _, d := range report.Deprecations {
+		note := ""
+		switch {
+		case d.Broken:
+			note = "⚠ replacement no longer exists"
+		case d.Overdue:
+			note = "⚠ overdue for removal"
+		}
+		fmt.Fprintf(tw, "  %s\t%s\tv%s\tv%s\t%s\n", d.Old, d.Replacement, d.Since, d.RemovedIn, note)
+	}
+	_ = tw.Flush()
+}
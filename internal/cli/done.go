@@ -0,0 +1,118 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/spf13/cobra"
+)
+
+// doneCmd creates the `samedi done` command, a shortcut for marking a
+// chunk completed.
+func doneCmd() *cobra.Command {
+	var startNext bool
+
+	cmd := &cobra.Command{
+		Use:   "done <plan-id> [chunk-id]",
+		Short: "Mark a chunk completed",
+		Long: `Mark a chunk completed without asking, then show the plan's progress
+and the chunk that comes next.
+
+Without a chunk ID the plan's in-progress chunk is completed. With --next
+a session starts right away on the next chunk.
+
+Examples:
+  samedi done rust-async              # Complete the chunk in progress
+  samedi done rust-async chunk-004    # Complete a given chunk
+  samedi done rust-async --next       # ...and start on the next one`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			planID := args[0]
+			chunkID := ""
+			if len(args) > 1 {
+				chunkID = args[1]
+			}
+
+			svc, err := getPlanService(cmd, "")
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+			ctx := context.Background()
+
+			p, err := svc.Get(ctx, planID)
+			if err != nil {
+				return fmt.Errorf("failed to load plan: %w", err)
+			}
+			chunk, err := doneChunk(p, chunkID)
+			if err != nil {
+				return err
+			}
+			if err := svc.UpdateChunkStatus(ctx, planID, chunk.ID, plan.StatusCompleted); err != nil {
+				return fmt.Errorf("failed to complete chunk: %w", err)
+			}
+
+			// Reload for the progress and next chunk after the update
+			p, err = svc.Get(ctx, planID)
+			if err != nil {
+				return fmt.Errorf("failed to load plan: %w", err)
+			}
+			printDone(os.Stdout, p, chunk)
+
+			autoCommit(cmd, fmt.Sprintf("samedi: chunk completed: %s/%s", planID, chunk.ID))
+			autoMirror(cmd)
+
+			next := p.NextChunk()
+			if !startNext || next == nil {
+				return nil
+			}
+			fmt.Println()
+			return executeStart(cmd, []string{planID, next.ID}, startOptions{noPrompt: true})
+		},
+	}
+
+	cmd.Flags().BoolVar(&startNext, "next", false, "start a session on the next chunk")
+
+	return cmd
+}
+
+// doneChunk returns the chunk of p that `samedi done` completes: the one
+// named by chunkID, or the chunk in progress when chunkID is empty.
+func doneChunk(p *plan.Plan, chunkID string) (*plan.Chunk, error) {
+	if chunkID == "" {
+		next := p.NextChunk()
+		if next == nil || next.Status != plan.StatusInProgress {
+			return nil, fmt.Errorf("no chunk in progress in %s; name the chunk to complete", p.ID)
+		}
+		return next, nil
+	}
+
+	for i := range p.Chunks {
+		if p.Chunks[i].ID != chunkID {
+			continue
+		}
+		if p.Chunks[i].Status == plan.StatusCompleted {
+			return nil, fmt.Errorf("%s is already completed", chunkID)
+		}
+		return &p.Chunks[i], nil
+	}
+	return nil, fmt.Errorf("chunk not found: %s in plan %s", chunkID, p.ID)
+}
+
+// printDone reports the completed chunk with p's progress and what's next.
+func printDone(w io.Writer, p *plan.Plan, chunk *plan.Chunk) {
+	fmt.Fprintf(w, "✓ Completed %s: %s\n", chunk.ID, chunk.Title)
+	fmt.Fprintf(w, "  %s %d%% (%d/%d chunks)\n",
+		buildProgressBar(p.Progress(), 30), p.ProgressPercent(), p.CompletedChunks(), len(p.Chunks))
+
+	if next := p.NextChunk(); next != nil {
+		fmt.Fprintf(w, "  Next: %s — %s (%s)\n", next.ID, next.Title, formatDuration(next.Duration))
+	} else {
+		fmt.Fprintln(w, "  All chunks completed!")
+	}
+}
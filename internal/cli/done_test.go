@@ -0,0 +1,62 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func doneTestPlan() *plan.Plan {
+	return &plan.Plan{
+		ID: "rust-async",
+		Chunks: []plan.Chunk{
+			{ID: "chunk-001", Title: "Futures", Duration: 60, Status: plan.StatusCompleted},
+			{ID: "chunk-002", Title: "Pinning", Duration: 60, Status: plan.StatusInProgress},
+			{ID: "chunk-003", Title: "Executors", Duration: 90, Status: plan.StatusNotStarted},
+		},
+	}
+}
+
+func TestDoneChunk(t *testing.T) {
+	p := doneTestPlan()
+
+	chunk, err := doneChunk(p, "")
+	require.NoError(t, err)
+	assert.Equal(t, "chunk-002", chunk.ID, "defaults to the chunk in progress")
+
+	chunk, err = doneChunk(p, "chunk-003")
+	require.NoError(t, err)
+	assert.Equal(t, "chunk-003", chunk.ID)
+
+	_, err = doneChunk(p, "chunk-001")
+	assert.ErrorContains(t, err, "already completed")
+
+	_, err = doneChunk(p, "chunk-009")
+	assert.ErrorContains(t, err, "chunk not found")
+
+	p.Chunks[1].Status = plan.StatusNotStarted
+	_, err = doneChunk(p, "")
+	assert.ErrorContains(t, err, "no chunk in progress")
+}
+
+func TestPrintDone(t *testing.T) {
+	p := doneTestPlan()
+	p.Chunks[1].Status = plan.StatusCompleted
+
+	var buf bytes.Buffer
+	printDone(&buf, p, &p.Chunks[1])
+	assert.Contains(t, buf.String(), "✓ Completed chunk-002: Pinning")
+	assert.Contains(t, buf.String(), "66% (2/3 chunks)")
+	assert.Contains(t, buf.String(), "Next: chunk-003 — Executors (1.5h)")
+
+	p.Chunks[2].Status = plan.StatusCompleted
+	buf.Reset()
+	printDone(&buf, p, &p.Chunks[2])
+	assert.Contains(t, buf.String(), "All chunks completed!")
+}
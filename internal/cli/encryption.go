@@ -0,0 +1,380 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+// openCipher returns the cipher for plan files, notes, and the journal when
+// storage.encrypt is on, or nil when it is off.
+func openCipher(cfg *config.Config) (*storage.Cipher, error) {
+	if !cfg.Storage.Encrypt {
+		return nil, nil
+	}
+	return newCipher(cfg)
+}
+
+// newCipher keys a cipher from the passphrase in storage.passphrase_env,
+// salted from the data directory shared by all profiles.
+func newCipher(cfg *config.Config) (*storage.Cipher, error) {
+	env := cfg.Storage.PassphraseEnv
+	passphrase := os.Getenv(env)
+	if passphrase == "" {
+		return nil, fmt.Errorf("$%s is not set; export your encryption passphrase first", env)
+	}
+	root, err := rootPaths(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get paths: %w", err)
+	}
+	return storage.NewCipher(passphrase, root)
+}
+
+func encryptionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "encryption",
+		Short: "Encrypt plan files and session notes at rest",
+		Long: `Keep plan files, session notes, and journal notes encrypted on disk,
+so learning journals aren't readable by anyone who copies the data
+directory or the sync repository.
+
+Encryption uses AES-256-GCM with a key derived from a passphrase read
+from $SAMEDI_PASSPHRASE (change the variable with storage.passphrase_env).
+The passphrase is never stored; without it encrypted data can't be read,
+so keep it somewhere safe. Every samedi command decrypts and encrypts as
+it goes, and 'samedi plan edit' hands your editor a temporary plaintext
+copy that is removed afterwards, as does 'samedi journal edit'.
+
+Plan history snapshots are encrypted along with the plan files. Session
+times, plan titles, and the rest of the database stay readable, as do
+flashcards. The Obsidian mirror writes plaintext notes into your
+vault, so leave obsidian.vault_path empty if that matters.
+
+Examples:
+  export SAMEDI_PASSPHRASE='correct horse battery staple'
+  samedi encryption enable
+  samedi encryption status
+  samedi encryption disable`,
+	}
+
+	cmd.AddCommand(encryptionStatusCmd())
+	cmd.AddCommand(mutating(encryptionEnableCmd()))
+	cmd.AddCommand(mutating(encryptionDisableCmd()))
+
+	return cmd
+}
+
+// encryptionStatus is the output of `samedi encryption status`.
+type encryptionStatus struct {
+	Enabled        bool   `json:"enabled"`
+	PassphraseEnv  string `json:"passphrase_env"`
+	PassphraseSet  bool   `json:"passphrase_set"`
+	EncryptedPlans int    `json:"encrypted_plans"`
+	PlaintextPlans int    `json:"plaintext_plans"`
+	EncryptedNotes int    `json:"encrypted_notes"`
+	PlaintextNotes int    `json:"plaintext_notes"`
+
+	EncryptedJournal int `json:"encrypted_journal"`
+	PlaintextJournal int `json:"plaintext_journal"`
+}
+
+func encryptionStatusCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Show whether data is encrypted",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := config.Load()
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			paths, err := configPaths(cfg)
+			if err != nil {
+				return fmt.Errorf("failed to get paths: %w", err)
+			}
+
+			status := encryptionStatus{
+				Enabled:       cfg.Storage.Encrypt,
+				PassphraseEnv: cfg.Storage.PassphraseEnv,
+				PassphraseSet: os.Getenv(cfg.Storage.PassphraseEnv) != "",
+			}
+			plans, err := planFiles(paths)
+			if err != nil {
+				return err
+			}
+			if status.EncryptedPlans, status.PlaintextPlans, err = countFiles(plans); err != nil {
+				return err
+			}
+			notes, err := journalFiles(paths)
+			if err != nil {
+				return err
+			}
+			if status.EncryptedJournal, status.PlaintextJournal, err = countFiles(notes); err != nil {
+				return err
+			}
+			if _, err := os.Stat(paths.DatabasePath); err == nil {
+				db, err := storage.OpenSQLiteReadOnly(paths.DatabasePath)
+				if err != nil {
+					return err
+				}
+				defer db.Close()
+				if status.EncryptedNotes, status.PlaintextNotes, err = session.CountNotes(cmd.Context(), db); err != nil {
+					return err
+				}
+			}
+
+			jsonOutput, err := cmd.Flags().GetBool("json")
+			if err != nil {
+				return fmt.Errorf("failed to get json flag: %w", err)
+			}
+			if jsonOutput {
+				return printJSON(status)
+			}
+
+			renderEncryptionStatus(os.Stdout, status)
+			return nil
+		},
+	}
+}
+
+// renderEncryptionStatus writes the status with a hint for anything left
+// to do.
+func renderEncryptionStatus(w io.Writer, status encryptionStatus) {
+	state := "off"
+	if status.Enabled {
+		state = "on"
+	}
+	passphrase := "not set"
+	if status.PassphraseSet {
+		passphrase = "set"
+	}
+
+	fmt.Fprintf(w, "Encryption:  %s\n", state)
+	fmt.Fprintf(w, "Passphrase:  $%s (%s)\n", status.PassphraseEnv, passphrase)
+	fmt.Fprintf(w, "Plan files:  %d encrypted, %d plaintext\n", status.EncryptedPlans, status.PlaintextPlans)
+	fmt.Fprintf(w, "Notes:       %d encrypted, %d plaintext\n", status.EncryptedNotes, status.PlaintextNotes)
+	fmt.Fprintf(w, "Journal:     %d encrypted, %d plaintext\n", status.EncryptedJournal, status.PlaintextJournal)
+
+	switch {
+	case status.Enabled && !status.PassphraseSet:
+		fmt.Fprintf(w, "\n⚠ Export $%s to read and write plans.\n", status.PassphraseEnv)
+	case status.Enabled && status.PlaintextPlans+status.PlaintextNotes+status.PlaintextJournal > 0:
+		fmt.Fprintln(w, "\nRun 'samedi encryption enable' to encrypt the rest now.")
+	case !status.Enabled && status.EncryptedPlans+status.EncryptedNotes+status.EncryptedJournal > 0:
+		fmt.Fprintln(w, "\n⚠ Encrypted data remains; run 'samedi encryption disable' to decrypt it.")
+	}
+}
+
+func encryptionEnableCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "enable",
+		Short: "Turn on encryption and encrypt existing data",
+		Long: `Turn on storage.encrypt and encrypt the active profile's plan files,
+session notes, and journal notes with the passphrase in $SAMEDI_PASSPHRASE
+(or the variable named by storage.passphrase_env). Other profiles are encrypted as they
+are written; run 'samedi --profile NAME encryption enable' to do one now.
+
+Running enable again encrypts anything still in plaintext.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return setEncryption(cmd.Context(), true)
+		},
+	}
+}
+
+func encryptionDisableCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "disable",
+		Short: "Decrypt existing data and turn off encryption",
+		Long: `Decrypt the active profile's plan files, session notes, and journal
+notes with the passphrase in $SAMEDI_PASSPHRASE, then turn off
+storage.encrypt. Decrypt
+other profiles first with 'samedi --profile NAME encryption disable'.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return setEncryption(cmd.Context(), false)
+		},
+	}
+}
+
+// setEncryption converts the active profile's data to match encrypt, then
+// saves storage.encrypt. The config changes last, so an interrupted run
+// leaves mixed data that either direction can finish converting.
+func setEncryption(ctx context.Context, encrypt bool) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	paths, err := configPaths(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to get paths: %w", err)
+	}
+	cipher, err := newCipher(cfg)
+	if err != nil {
+		return err
+	}
+	if err := paths.EnsureDirectories(); err != nil {
+		return fmt.Errorf("failed to create directories: %w", err)
+	}
+
+	files, err := planFiles(paths)
+	if err != nil {
+		return err
+	}
+	plans, err := convertFiles(paths, files, cipher, encrypt)
+	if err != nil {
+		return err
+	}
+	if files, err = journalFiles(paths); err != nil {
+		return err
+	}
+	journals, err := convertFiles(paths, files, cipher, encrypt)
+	if err != nil {
+		return err
+	}
+
+	db, err := storage.NewSQLiteDB(paths.DatabasePath)
+	if err != nil {
+		return fmt.Errorf("failed to initialize database: %w", err)
+	}
+	defer db.Close()
+	if err := newMigrator(db, paths).Migrate(); err != nil {
+		return fmt.Errorf("failed to run migrations: %w", err)
+	}
+	notes, err := convertNotes(ctx, db, cipher, encrypt)
+	if err != nil {
+		return err
+	}
+	versions := plan.NewSQLiteRepository(db)
+	if encrypt {
+		versions.SetCipher(cipher)
+	}
+	if _, err := versions.RewriteVersions(ctx, cipher); err != nil {
+		return err
+	}
+
+	verb := "Decrypted"
+	if encrypt {
+		verb = "Encrypted"
+	}
+	fmt.Printf("✓ %s %d plan file(s), %d session note(s), and %d journal note(s)\n", verb, plans, notes, journals)
+
+	if cfg.Storage.Encrypt != encrypt {
+		cfg.Storage.Encrypt = encrypt
+		if err := config.Save(cfg); err != nil {
+			return fmt.Errorf("failed to save config: %w", err)
+		}
+	}
+	if encrypt {
+		fmt.Printf("✓ Encryption is on; keep $%s set for every samedi command\n", cfg.Storage.PassphraseEnv)
+	} else {
+		fmt.Println("✓ Encryption is off")
+	}
+	return nil
+}
+
+// planFiles returns the plan files in the plans directory and its archive.
+func planFiles(paths *storage.Paths) ([]string, error) {
+	var files []string
+	for _, dir := range []string{paths.PlansDir, paths.ArchiveDir()} {
+		matches, err := filepath.Glob(filepath.Join(dir, "*.md"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list plan files: %w", err)
+		}
+		files = append(files, matches...)
+	}
+	return files, nil
+}
+
+// journalFiles returns the notes in the journal directory.
+func journalFiles(paths *storage.Paths) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(paths.JournalDir(), "*.md"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list journal notes: %w", err)
+	}
+	return files, nil
+}
+
+// countFiles counts the encrypted and plaintext files among files.
+func countFiles(files []string) (encrypted, plaintext int, err error) {
+	for _, file := range files {
+		data, err := os.ReadFile(file) // #nosec G304 - paths come from the data directory
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to read file %s: %w", file, err)
+		}
+		if storage.IsEncrypted(data) {
+			encrypted++
+		} else {
+			plaintext++
+		}
+	}
+	return encrypted, plaintext, nil
+}
+
+// convertFiles encrypts or decrypts the plan files or journal notes among
+// files that aren't already in that form and returns how many it changed.
+func convertFiles(paths *storage.Paths, files []string, cipher *storage.Cipher, encrypt bool) (int, error) {
+	reader := storage.NewFilesystemStorage(paths)
+	reader.SetCipher(cipher)
+	writer := storage.NewFilesystemStorage(paths)
+	if encrypt {
+		writer.SetCipher(cipher)
+	}
+
+	converted := 0
+	for _, file := range files {
+		raw, err := os.ReadFile(file) // #nosec G304 - paths come from the data directory
+		if err != nil {
+			return converted, fmt.Errorf("failed to read file %s: %w", file, err)
+		}
+		if storage.IsEncrypted(raw) == encrypt {
+			continue
+		}
+		data, err := reader.ReadFile(file)
+		if err != nil {
+			return converted, err
+		}
+		if err := writer.WriteFile(file, data); err != nil {
+			return converted, err
+		}
+		converted++
+	}
+	return converted, nil
+}
+
+// convertNotes rewrites every session's notes and reflection encrypted or
+// decrypted and returns how many sessions had either.
+func convertNotes(ctx context.Context, db *storage.SQLiteDB, cipher *storage.Cipher, encrypt bool) (int, error) {
+	sessions, err := session.NewEncryptedSQLiteRepository(db, cipher).List(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	target := session.NewSQLiteRepository(db)
+	if encrypt {
+		target = session.NewEncryptedSQLiteRepository(db, cipher)
+	}
+
+	converted := 0
+	for _, sess := range sessions {
+		if sess.Notes == "" && sess.Reflection.IsEmpty() {
+			continue
+		}
+		if err := target.Update(ctx, sess); err != nil {
+			return converted, err
+		}
+		converted++
+	}
+	return converted, nil
+}
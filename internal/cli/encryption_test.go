@@ -0,0 +1,133 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenCipher(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Storage.DataDir = t.TempDir()
+
+	cipher, err := openCipher(cfg)
+	require.NoError(t, err)
+	assert.Nil(t, cipher, "off by default")
+
+	cfg.Storage.Encrypt = true
+	t.Setenv("SAMEDI_PASSPHRASE", "")
+	_, err = openCipher(cfg)
+	assert.ErrorContains(t, err, "$SAMEDI_PASSPHRASE is not set")
+
+	t.Setenv("SAMEDI_PASSPHRASE", "hunter2")
+	cipher, err = openCipher(cfg)
+	require.NoError(t, err)
+	assert.NotNil(t, cipher)
+}
+
+func TestConvertPlanFilesAndNotes(t *testing.T) {
+	ctx := context.Background()
+	paths, err := storage.NewPaths(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, paths.EnsureDirectories())
+	require.NoError(t, os.WriteFile(paths.PlanPath("rust"), []byte("# Rust"), 0o600))
+	require.NoError(t, os.MkdirAll(paths.ArchiveDir(), 0o755))
+	require.NoError(t, os.WriteFile(paths.ArchivedPlanPath("go"), []byte("# Go"), 0o600))
+	require.NoError(t, os.MkdirAll(paths.JournalDir(), 0o755))
+	require.NoError(t, os.WriteFile(paths.JournalPath("2026-10-16"), []byte("# Friday"), 0o600))
+
+	db, err := storage.NewSQLiteDB(paths.DatabasePath)
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, storage.NewMigrator(db).Migrate())
+	_, err = db.DB().Exec(`INSERT INTO plans (id, title, created_at, updated_at, total_hours, status, tags, file_path)
+		VALUES ('rust', 'Rust', ?, ?, 1, 'not-started', '[]', ?)`, time.Now(), time.Now(), paths.PlanPath("rust"))
+	require.NoError(t, err)
+	now := time.Now()
+	require.NoError(t, session.NewSQLiteRepository(db).Create(ctx, &session.Session{
+		ID: "s1", PlanID: "rust", StartTime: now, Notes: "private", CreatedAt: now,
+	}))
+
+	cipher, err := storage.NewCipher("hunter2", paths)
+	require.NoError(t, err)
+
+	plans, err := planFiles(paths)
+	require.NoError(t, err)
+	notes, err := journalFiles(paths)
+	require.NoError(t, err)
+	require.Len(t, notes, 1)
+
+	n, err := convertFiles(paths, plans, cipher, true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	n, err = convertFiles(paths, plans, cipher, true)
+	require.NoError(t, err)
+	assert.Zero(t, n, "already encrypted")
+	n, err = convertFiles(paths, notes, cipher, true)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	n, err = convertNotes(ctx, db, cipher, true)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	status := encryptionStatus{Enabled: true, PassphraseEnv: "SAMEDI_PASSPHRASE", PassphraseSet: true}
+	status.EncryptedPlans, status.PlaintextPlans, err = countFiles(plans)
+	require.NoError(t, err)
+	status.EncryptedJournal, status.PlaintextJournal, err = countFiles(notes)
+	require.NoError(t, err)
+	status.EncryptedNotes, status.PlaintextNotes, err = session.CountNotes(ctx, db)
+	require.NoError(t, err)
+	assert.Equal(t, encryptionStatus{
+		Enabled: true, PassphraseEnv: "SAMEDI_PASSPHRASE", PassphraseSet: true,
+		EncryptedPlans: 2, EncryptedNotes: 1, EncryptedJournal: 1,
+	}, status)
+
+	// And back again
+	n, err = convertFiles(paths, plans, cipher, false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	n, err = convertFiles(paths, notes, cipher, false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	_, err = convertNotes(ctx, db, cipher, false)
+	require.NoError(t, err)
+	data, err := os.ReadFile(paths.PlanPath("rust"))
+	require.NoError(t, err)
+	assert.Equal(t, "# Rust", string(data))
+	data, err = os.ReadFile(paths.JournalPath("2026-10-16"))
+	require.NoError(t, err)
+	assert.Equal(t, "# Friday", string(data))
+	sess, err := session.NewSQLiteRepository(db).Get(ctx, "s1")
+	require.NoError(t, err)
+	assert.Equal(t, "private", sess.Notes)
+}
+
+func TestRenderEncryptionStatus(t *testing.T) {
+	var buf bytes.Buffer
+	renderEncryptionStatus(&buf, encryptionStatus{Enabled: true, PassphraseEnv: "SAMEDI_PASSPHRASE", EncryptedPlans: 2})
+	assert.Contains(t, buf.String(), "Encryption:  on")
+	assert.Contains(t, buf.String(), "$SAMEDI_PASSPHRASE (not set)")
+	assert.Contains(t, buf.String(), "⚠ Export $SAMEDI_PASSPHRASE")
+
+	buf.Reset()
+	renderEncryptionStatus(&buf, encryptionStatus{PassphraseEnv: "SAMEDI_PASSPHRASE", EncryptedNotes: 1})
+	assert.Contains(t, buf.String(), "Encryption:  off")
+	assert.Contains(t, buf.String(), "run 'samedi encryption disable'")
+
+	buf.Reset()
+	renderEncryptionStatus(&buf, encryptionStatus{PassphraseEnv: "SAMEDI_PASSPHRASE", EncryptedJournal: 1})
+	assert.Contains(t, buf.String(), "Journal:     1 encrypted, 0 plaintext")
+	assert.Contains(t, buf.String(), "run 'samedi encryption disable'")
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/flashcard"
+	"github.com/pezware/samedi.dev/internal/jobs"
+	"github.com/pezware/samedi.dev/internal/llm"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+// Exit codes, so scripts can branch on why a command failed. Every
+// command returns its failure to Execute, which maps it to one of these.
+const (
+	ExitOK       = 0
+	ExitError    = 1 // Any failure not listed below
+	ExitInvalid  = 2 // Bad arguments or flags, invalid config, or a plan that fails validation
+	ExitNotFound = 3 // A plan, chunk, session, card, or job does not exist
+	ExitLLM      = 4 // The LLM provider is unavailable or the call failed
+	ExitStorage  = 5 // The database is locked, unreadable, or encrypted
+)
+
+// errorKind is how a class of errors is reported: the exit code and a
+// hint at what to do about it.
+type errorKind struct {
+	err  error
+	code int
+	hint string
+}
+
+// errorKinds classifies the sentinel errors of the services. The first
+// one an error wraps wins.
+var errorKinds = []errorKind{
+	{config.ErrInvalid, ExitInvalid, "fix it with 'samedi config set', or check it with 'samedi doctor'"},
+	{stats.ErrInvalidTimeRange, ExitInvalid, ""},
+	{plan.ErrInvalidStatus, ExitInvalid, ""},
+	{plan.ErrPlanNotFound, ExitNotFound, "list plan IDs with 'samedi plan list'"},
+	{plan.ErrChunkNotFound, ExitNotFound, "list a plan's chunks with 'samedi plan show <plan-id>'"},
+	{session.ErrSessionNotFound, ExitNotFound, "list session IDs with 'samedi session list'"},
+	{flashcard.ErrCardNotFound, ExitNotFound, ""},
+	{jobs.ErrJobNotFound, ExitNotFound, "list job IDs with 'samedi jobs list'"},
+	{session.ErrActiveSessionExists, ExitError, "stop it first with 'samedi stop'"},
+	{session.ErrNoActiveSession, ExitError, "start one with 'samedi start <plan-id>'"},
+	{llm.ErrLLMUnavailable, ExitLLM, "check the LLM provider with 'samedi doctor', or pick one with 'samedi setup'"},
+	{storage.ErrLocked, ExitStorage, ""},
+	{storage.ErrNoPassphrase, ExitStorage, ""},
+	{storage.ErrWrongPassphrase, ExitStorage, ""},
+}
+
+// classifyError returns the exit code for err and a hint, if there is
+// one, at how to fix it.
+func classifyError(err error) (int, string) {
+	var reported *reportedError
+	if errors.As(err, &reported) {
+		return reported.code, ""
+	}
+	var usage *usageError
+	if errors.As(err, &usage) {
+		return ExitInvalid, fmt.Sprintf("run '%s --help' for usage", usage.command)
+	}
+	for _, kind := range errorKinds {
+		if errors.Is(err, kind.err) {
+			return kind.code, kind.hint
+		}
+	}
+	var providerErr *llm.ProviderError
+	if errors.As(err, &providerErr) {
+		return ExitLLM, "the failed call is in ~/.samedi/logs/samedi.log"
+	}
+	if storage.IsBusy(err) {
+		// SQLite's own "database is locked" is not much of a hint
+		return ExitStorage, storage.ErrLocked.Error()
+	}
+	if strings.HasPrefix(err.Error(), "unknown command ") {
+		// Cobra's errors are untyped; it prints this hint itself unless
+		// errors are silenced, as they are for rendering here
+		return ExitInvalid, "run 'samedi --help' for usage"
+	}
+	return ExitError, ""
+}
+
+// ExitCode returns the process exit code for an error Execute returned.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	code, _ := classifyError(err)
+	return code
+}
+
+// renderError prints err, followed by a hint when one is known. Errors
+// the command already reported are not printed again.
+func renderError(w io.Writer, err error) {
+	var reported *reportedError
+	if errors.As(err, &reported) {
+		return
+	}
+	_, hint := classifyError(err)
+	printError(w, err.Error(), hint)
+}
+
+func printError(w io.Writer, msg, hint string) {
+	fmt.Fprintf(w, "Error: %s\n", msg)
+	if hint != "" {
+		fmt.Fprintf(w, "Hint: %s\n", hint)
+	}
+}
+
+// usageError is an argument or flag a command rejected.
+type usageError struct {
+	command string // Command path, for the --help hint
+	err     error
+}
+
+func (e *usageError) Error() string { return e.err.Error() }
+func (e *usageError) Unwrap() error { return e.err }
+
+// reportedError is a failure a command has already described in its
+// output, such as the problems plan validate found. It only sets the exit
+// code.
+type reportedError struct {
+	code int
+	msg  string // For the log
+}
+
+func (e *reportedError) Error() string { return e.msg }
+
+// markUsageErrors makes the argument and flag errors of cmd and its
+// subcommands usage errors, so they exit with ExitInvalid.
+func markUsageErrors(cmd *cobra.Command) {
+	cmd.SetFlagErrorFunc(func(c *cobra.Command, err error) error {
+		return &usageError{command: c.CommandPath(), err: err}
+	})
+	if args := cmd.Args; args != nil {
+		cmd.Args = func(c *cobra.Command, a []string) error {
+			if err := args(c, a); err != nil {
+				return &usageError{command: c.CommandPath(), err: err}
+			}
+			return nil
+		}
+	}
+	for _, sub := range cmd.Commands() {
+		markUsageErrors(sub)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/llm"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code int
+		hint string
+	}{
+		{"plan not found", fmt.Errorf("failed to load plan: %w: rust", plan.ErrPlanNotFound), ExitNotFound, "samedi plan list"},
+		{"chunk not found", fmt.Errorf("%w: chunk-009 in plan rust", plan.ErrChunkNotFound), ExitNotFound, "samedi plan show"},
+		{"session active", fmt.Errorf("%w: abc (plan: rust)", session.ErrActiveSessionExists), ExitError, "samedi stop"},
+		{"no session", session.ErrNoActiveSession, ExitError, "samedi start"},
+		{"cli missing", &llm.ProviderError{Provider: "claude", Err: fmt.Errorf("execution failed: %w", exec.ErrNotFound)}, ExitLLM, "samedi doctor"},
+		{"llm failed", &llm.ProviderError{Provider: "claude", Err: errors.New("CLI error (exit code 1)")}, ExitLLM, "samedi.log"},
+		{"locked", fmt.Errorf("failed to save: %w", storage.ErrLocked), ExitStorage, ""},
+		{"passphrase", storage.ErrNoPassphrase, ExitStorage, ""},
+		{"unknown command", errors.New(`unknown command "bogus" for "samedi"`), ExitInvalid, "samedi --help"},
+		{"usage", &usageError{command: "samedi show", err: errors.New("accepts 2 arg(s), received 0")}, ExitInvalid, "samedi show --help"},
+		{"time range", fmt.Errorf("%w: yesterday", stats.ErrInvalidTimeRange), ExitInvalid, ""},
+		{"status", fmt.Errorf("%w: done", plan.ErrInvalidStatus), ExitInvalid, ""},
+		{"config", fmt.Errorf("failed to load config: %w: %w", config.ErrInvalid, errors.New("invalid LLM provider: x")), ExitInvalid, "samedi config set"},
+		{"reported", &reportedError{code: ExitInvalid, msg: "plans failed validation"}, ExitInvalid, ""},
+		{"other", errors.New("boom"), ExitError, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, hint := classifyError(tt.err)
+			assert.Equal(t, tt.code, code)
+			assert.Equal(t, tt.code, ExitCode(tt.err))
+			if tt.hint == "" {
+				assert.Empty(t, hint)
+			} else {
+				assert.Contains(t, hint, tt.hint)
+			}
+		})
+	}
+	assert.Equal(t, ExitOK, ExitCode(nil))
+}
+
+func TestRenderError(t *testing.T) {
+	var out bytes.Buffer
+	renderError(&out, fmt.Errorf("%w to stop", session.ErrNoActiveSession))
+	assert.Equal(t, "Error: no active session to stop\nHint: start one with 'samedi start <plan-id>'\n", out.String())
+
+	out.Reset()
+	renderError(&out, errors.New("boom"))
+	assert.Equal(t, "Error: boom\n", out.String())
+
+	out.Reset()
+	renderError(&out, &reportedError{code: ExitInvalid, msg: "plans failed validation"})
+	assert.Empty(t, out.String())
+}
+
+func TestMarkUsageErrors(t *testing.T) {
+	root := &cobra.Command{Use: "samedi", SilenceErrors: true, SilenceUsage: true}
+	root.AddCommand(&cobra.Command{
+		Use:  "show <plan-id>",
+		Args: cobra.ExactArgs(1),
+		RunE: func(*cobra.Command, []string) error { return nil },
+	})
+	markUsageErrors(root)
+
+	for _, args := range [][]string{{"show"}, {"show", "rust", "--bogus"}} {
+		root.SetArgs(args)
+		err := root.Execute()
+		require.Error(t, err)
+		code, hint := classifyError(err)
+		assert.Equal(t, ExitInvalid, code, args)
+		assert.Equal(t, "run 'samedi show --help' for usage", hint)
+	}
+
+	root.SetArgs([]string{"show", "rust"})
+	assert.NoError(t, root.Execute())
+}
+
+// executeExitCode runs cmd with args in a fresh home and returns the exit
+// code of the error it fails with.
+func executeExitCode(t *testing.T, cmd *cobra.Command, args ...string) int {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	cmd.SetArgs(args)
+	cmd.SilenceUsage, cmd.SilenceErrors = true, true
+	err := cmd.Execute()
+	require.Error(t, err, args)
+	return ExitCode(err)
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/events"
+	"github.com/spf13/cobra"
+)
+
+// newEventBus builds the bus for the [events] webhooks and [hooks]
+// commands, or returns nil when none are configured. Failed deliveries
+// are warned about on stderr, except under the TUI where they would
+// corrupt the screen.
+func newEventBus(cmd *cobra.Command, cfg *config.Config) *events.Bus {
+	urls := cfg.Events.WebhookURLs()
+	hooks := cfg.Hooks.Commands()
+	if len(urls) == 0 && len(hooks) == 0 {
+		return nil
+	}
+
+	types := make([]events.Type, len(cfg.Events.Types))
+	for i, t := range cfg.Events.Types {
+		types[i] = events.Type(t)
+	}
+	timeout := time.Duration(cfg.Events.TimeoutSeconds) * time.Second
+
+	bus := events.NewBus()
+	for _, u := range urls {
+		bus.Add(events.NewWebhookSink(u, types, timeout))
+	}
+	hookTimeout := time.Duration(cfg.Hooks.TimeoutSeconds) * time.Second
+	for _, t := range events.Types {
+		if command, ok := hooks[t]; ok {
+			bus.Add(events.NewHookSink(t, command, hookTimeout))
+		}
+	}
+	if !tuiCommand(cmd) {
+		bus.OnError(func(err error) {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		})
+	}
+	return bus
+}
+
+// tuiAnnotation marks commands that run a TUI for their whole run, whose
+// screen warnings printed to stderr would garble.
+const tuiAnnotation = "samedi/tui"
+
+// runsTUI marks cmd as running a TUI, so event delivery errors aren't
+// printed over it.
+func runsTUI(cmd *cobra.Command) *cobra.Command {
+	if cmd.Annotations == nil {
+		cmd.Annotations = make(map[string]string)
+	}
+	cmd.Annotations[tuiAnnotation] = "true"
+	return cmd
+}
+
+// tuiCommand reports whether cmd runs a TUI: it is marked with runsTUI,
+// or --tui is set.
+func tuiCommand(cmd *cobra.Command) bool {
+	if cmd == nil {
+		return false
+	}
+	if cmd.Annotations[tuiAnnotation] == "true" {
+		return true
+	}
+	tui, err := cmd.Flags().GetBool("tui")
+	return err == nil && tui
+}
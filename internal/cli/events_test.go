@@ -0,0 +1,31 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTUICommand(t *testing.T) {
+	for _, path := range [][]string{{"ui"}, {"focus"}} {
+		cmd, _, err := rootCmd.Find(path)
+		require.NoError(t, err, path)
+		assert.True(t, tuiCommand(cmd), path)
+	}
+
+	stats := statsCmd()
+	assert.False(t, tuiCommand(stats))
+	require.NoError(t, stats.Flags().Set("tui", "true"))
+	assert.True(t, tuiCommand(stats), "--tui runs the dashboard")
+
+	for _, path := range [][]string{{"start"}, {"status"}} {
+		cmd, _, err := rootCmd.Find(path)
+		require.NoError(t, err, path)
+		assert.False(t, tuiCommand(cmd), path)
+	}
+	assert.False(t, tuiCommand(nil))
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/tui"
+	"github.com/spf13/cobra"
+)
+
+// focusCmd creates the `samedi focus` command.
+func focusCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "focus <plan-id> [chunk-id]",
+		Short: "Study with a distraction-free full-screen timer",
+		Long: `Start a session and follow it in a full-screen timer: the elapsed
+time in large digits, the time left on the chunk's planned duration, and
+the chunk's objectives, with nothing else on screen.
+
+Press f to finish: type your notes and press Enter to stop the session.
+Space pauses and resumes. q leaves focus mode with the session still
+running; 'samedi focus' on the same plan returns to it, and 'samedi stop'
+ends it as usual.
+
+Without a chunk ID the plan's next open chunk is used. Finishing marks the
+chunk completed without asking once its logged time reaches the planned
+duration (see learning.auto_advance_chunks). When the planned duration is
+reached, focus mode rings the terminal bell, or sends the alert set by
+learning.chunk_alert.
+
+Examples:
+  samedi focus rust-async
+  samedi focus rust-async chunk-004`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chunkID := ""
+			if len(args) > 1 {
+				chunkID = args[1]
+			}
+			return runFocus(cmd, args[0], chunkID)
+		},
+	}
+}
+
+// runFocus starts a session on the chunk, or picks up the one already
+// running on it, and shows it in the focus timer.
+func runFocus(cmd *cobra.Command, planID, chunkID string) error {
+	ctx := context.Background()
+
+	if !isInteractive(false) {
+		return fmt.Errorf("focus needs an interactive terminal")
+	}
+
+	cfg, err := getConfig(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	planSvc, err := getPlanService(cmd, "")
+	if err != nil {
+		return fmt.Errorf("failed to initialize plan service: %w", err)
+	}
+	p, err := planSvc.Get(ctx, planID)
+	if err != nil {
+		return fmt.Errorf("failed to load plan: %w", err)
+	}
+
+	svc, err := getSessionService(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to initialize: %w", err)
+	}
+	active, err := svc.GetActive(ctx)
+	if err != nil {
+		return err
+	}
+	if err := checkFocusSession(active, planID, chunkID); err != nil {
+		return err
+	}
+
+	if active == nil {
+		if chunkID == "" {
+			if next := p.NextChunk(); next != nil {
+				chunkID = next.ID
+			}
+		}
+		if chunkID != "" {
+			if _, err := planSvc.GetChunk(ctx, planID, chunkID); err != nil {
+				return fmt.Errorf("failed to load chunk: %w", err)
+			}
+		}
+		active, err = svc.Start(ctx, session.StartRequest{PlanID: planID, ChunkID: chunkID})
+		if err != nil {
+			return fmt.Errorf("failed to start session: %w", err)
+		}
+	}
+
+	chunk, logged := focusChunk(ctx, svc, p, active.ChunkID)
+
+	if err := applyTheme(cfg); err != nil {
+		return err
+	}
+	model := tui.NewFocusModel(svc, active, p.Title, chunk, logged)
+	model.SetChunkAlert(chunkAlerter(cmd, cfg))
+	if _, err := tea.NewProgram(model, tea.WithAltScreen()).Run(); err != nil {
+		return fmt.Errorf("failed to run focus mode: %w", err)
+	}
+
+	stopped := model.Stopped()
+	if stopped == nil {
+		fmt.Printf("Session still running on %s. Return with 'samedi focus %s' or stop with 'samedi stop'.\n", active.PlanID, active.PlanID)
+		return nil
+	}
+	reportStopped(cmd, stopped)
+	return nil
+}
+
+// checkFocusSession refuses focus mode while a session runs on another
+// plan or chunk. A session on the same plan, and on chunkID if given, is
+// picked up rather than restarted.
+func checkFocusSession(active *session.Session, planID, chunkID string) error {
+	if active == nil {
+		return nil
+	}
+	if active.PlanID != planID || (chunkID != "" && active.ChunkID != chunkID) {
+		running := active.PlanID
+		if active.ChunkID != "" {
+			running += " " + active.ChunkID
+		}
+		return fmt.Errorf("%w: %s is running; focus on it with 'samedi focus %s'",
+			session.ErrActiveSessionExists, running, running)
+	}
+	return nil
+}
+
+// focusChunk returns the session's chunk, and the time already logged on
+// it in earlier sessions. The time is best effort: zero if it can't be read.
+func focusChunk(ctx context.Context, svc *session.Service, p *plan.Plan, chunkID string) (*plan.Chunk, time.Duration) {
+	if chunkID == "" {
+		return nil, 0
+	}
+	var chunk *plan.Chunk
+	for i := range p.Chunks {
+		if p.Chunks[i].ID == chunkID {
+			chunk = &p.Chunks[i]
+		}
+	}
+	if chunk == nil {
+		return nil, 0
+	}
+
+	stats, err := svc.GetChunkStats(ctx, p.ID, chunkID)
+	if err != nil {
+		return chunk, 0
+	}
+	return chunk, time.Duration(stats.TotalDuration) * time.Minute
+}
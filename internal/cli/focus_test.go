@@ -0,0 +1,24 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckFocusSession(t *testing.T) {
+	assert.NoError(t, checkFocusSession(nil, "rust", ""))
+
+	active := &session.Session{PlanID: "rust", ChunkID: "chunk-002"}
+	assert.NoError(t, checkFocusSession(active, "rust", ""), "picks up the plan's session")
+	assert.NoError(t, checkFocusSession(active, "rust", "chunk-002"))
+
+	err := checkFocusSession(active, "rust", "chunk-003")
+	assert.ErrorIs(t, err, session.ErrActiveSessionExists)
+	assert.ErrorContains(t, err, "samedi focus rust chunk-002")
+	assert.ErrorIs(t, checkFocusSession(active, "go", ""), session.ErrActiveSessionExists)
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+// sqliteHeader starts every SQLite database file.
+var sqliteHeader = []byte("SQLite format 3\x00")
+
+// importCmd creates the `samedi import` command.
+func importCmd() *cobra.Command {
+	var (
+		merge   bool
+		overlap string
+		dryRun  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import sessions recorded on another machine",
+		Long: `Import sessions recorded on another machine. Sync carries plans between
+machines but not the session database, so use this to bring sessions over.
+
+The file is either another machine's sessions.db or the output of
+"samedi session list --json" there. Notes in an encrypted database are
+read with this machine's passphrase.
+
+Sessions are matched by ID first. A session already here unchanged is a
+duplicate and dropped. New sessions that overlap nothing are added.
+
+Without --merge, the import stops if any session conflicts: one already
+here whose notes, artifacts, or times differ, or a new one overlapping a
+session on the same plan. With --merge, copies of the same session are
+combined, and overlaps are resolved by --overlap:
+  skip    keep the sessions here and drop the incoming one (default)
+  merge   fold the incoming session into the ones it overlaps
+  force   add it anyway
+
+Sessions still active on the other machine are skipped.
+
+Examples:
+  samedi import ~/laptop-sessions.db --dry-run
+  samedi import ~/laptop-sessions.db --merge
+  ssh laptop samedi session list --json > laptop.json
+  samedi import laptop.json --merge --overlap merge`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			policy, err := session.ParseOverlapPolicy(overlap)
+			if err != nil {
+				return &usageError{command: cmd.CommandPath(), err: err}
+			}
+			if cmd.Flags().Changed("overlap") && !merge {
+				return &usageError{command: cmd.CommandPath(), err: fmt.Errorf("--overlap needs --merge")}
+			}
+
+			cfg, err := getConfig(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			incoming, err := readImportSessions(cfg, args[0])
+			if err != nil {
+				return err
+			}
+
+			svc, err := getSessionService(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+			ctx := context.Background()
+
+			if !merge {
+				preview, err := svc.Merge(ctx, incoming, session.MergeOptions{DryRun: true})
+				if err != nil {
+					return err
+				}
+				if conflicts := preview.Conflicts(); len(conflicts) > 0 {
+					for i := range conflicts {
+						conflicts[i].Resolution = "" // Nothing is resolved without --merge
+					}
+					printMergeConflicts(os.Stdout, conflicts)
+					return fmt.Errorf("%d session(s) conflict with sessions here; rerun with --merge to reconcile them", len(conflicts))
+				}
+			}
+
+			report, err := svc.Merge(ctx, incoming, session.MergeOptions{Overlap: policy, DryRun: dryRun})
+			if err != nil {
+				return err
+			}
+
+			if jsonOutput, _ := cmd.Flags().GetBool("json"); jsonOutput {
+				if err := printJSON(report); err != nil {
+					return err
+				}
+			} else {
+				printMergeReport(os.Stdout, report)
+			}
+
+			if !dryRun && report.Count(session.MergeAdded)+len(report.Conflicts()) > 0 {
+				autoCommit(cmd, fmt.Sprintf("samedi: imported %d session(s)", len(report.Items)))
+				autoMirror(cmd)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&merge, "merge", false, "reconcile sessions that conflict with sessions here")
+	cmd.Flags().StringVar(&overlap, "overlap", string(session.OverlapSkip), "with --merge, what to do with overlapping sessions: skip, merge, or force")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "report what would be imported without importing")
+
+	return cmd
+}
+
+// readImportSessions reads sessions from a samedi database or a JSON
+// array of sessions.
+func readImportSessions(cfg *config.Config, path string) ([]*session.Session, error) {
+	data, err := os.ReadFile(path) // #nosec G304 - path given by the user
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	if !bytes.HasPrefix(data, sqliteHeader) {
+		var sessions []*session.Session
+		if err := json.Unmarshal(data, &sessions); err != nil {
+			return nil, fmt.Errorf("%s is neither a samedi database nor a JSON list of sessions: %w", path, err)
+		}
+		return sessions, nil
+	}
+
+	db, err := storage.OpenSQLiteReadOnly(path)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	cipher, err := openCipher(cfg)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := session.NewEncryptedSQLiteRepository(db, cipher).List(context.Background(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sessions from %s: %w", path, err)
+	}
+	return sessions, nil
+}
+
+// printMergeReport lists the conflicts in report and totals every action.
+func printMergeReport(w io.Writer, report *session.MergeReport) {
+	if len(report.Items) == 0 {
+		fmt.Fprintln(w, "No sessions to import.")
+		return
+	}
+
+	if conflicts := report.Conflicts(); len(conflicts) > 0 {
+		printMergeConflicts(w, conflicts)
+		fmt.Fprintln(w)
+	}
+
+	verb := "Imported"
+	if report.DryRun {
+		verb = "Would import"
+	}
+	fmt.Fprintf(w, "%s %d session(s): %d added, %d updated, %d overlapping, %d duplicate, %d skipped\n", verb,
+		len(report.Items), report.Count(session.MergeAdded), report.Count(session.MergeUpdated),
+		report.Count(session.MergeOverlap), report.Count(session.MergeDuplicate), report.Count(session.MergeSkipped))
+	if report.DryRun {
+		fmt.Fprintln(w, "Run without --dry-run to import them.")
+	}
+}
+
+// printMergeConflicts lists sessions that differ from or overlap sessions
+// here, with how each was resolved.
+func printMergeConflicts(w io.Writer, conflicts []session.MergeItem) {
+	for _, item := range conflicts {
+		sess := item.Session
+		fmt.Fprintf(w, "%s  %s  %s %s", sess.PlanID, sess.StartTime.Format("2006-01-02 15:04"), shortID(sess.ID), item.Action)
+		if item.Resolution != "" {
+			fmt.Fprintf(w, ", %s", item.Resolution)
+		}
+		fmt.Fprintln(w)
+		for _, other := range item.Overlapping {
+			end := "active"
+			if other.EndTime != nil {
+				end = other.EndTime.Format("15:04")
+			}
+			fmt.Fprintf(w, "  overlaps %s (%s–%s)\n", shortID(other.ID), other.StartTime.Format("15:04"), end)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadImportSessions(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Storage.DataDir = t.TempDir()
+	dir := t.TempDir()
+
+	t.Run("json", func(t *testing.T) {
+		path := filepath.Join(dir, "sessions.json")
+		require.NoError(t, os.WriteFile(path, []byte(`[{"id":"s1","plan_id":"rust",
+			"start_time":"2025-10-13T09:00:00Z","end_time":"2025-10-13T10:00:00Z","notes":"borrowck"}]`), 0o600))
+
+		sessions, err := readImportSessions(cfg, path)
+		require.NoError(t, err)
+		require.Len(t, sessions, 1)
+		assert.Equal(t, "rust", sessions[0].PlanID)
+		assert.Equal(t, "borrowck", sessions[0].Notes)
+	})
+
+	t.Run("database", func(t *testing.T) {
+		path := filepath.Join(dir, "sessions.db")
+		db, err := storage.NewSQLiteDB(path)
+		require.NoError(t, err)
+		require.NoError(t, storage.NewMigrator(db).Migrate())
+		now := time.Now()
+		require.NoError(t, session.NewSQLiteRepository(db).Create(context.Background(), &session.Session{
+			ID: "s1", PlanID: "rust", StartTime: now, Notes: "tokio", CreatedAt: now,
+		}))
+		require.NoError(t, db.Close())
+
+		sessions, err := readImportSessions(cfg, path)
+		require.NoError(t, err)
+		require.Len(t, sessions, 1)
+		assert.Equal(t, "tokio", sessions[0].Notes)
+	})
+
+	t.Run("neither", func(t *testing.T) {
+		path := filepath.Join(dir, "notes.txt")
+		require.NoError(t, os.WriteFile(path, []byte("hello"), 0o600))
+
+		_, err := readImportSessions(cfg, path)
+		assert.ErrorContains(t, err, "neither a samedi database nor a JSON list of sessions")
+	})
+}
+
+func TestPrintMergeReport(t *testing.T) {
+	start := time.Date(2025, 10, 13, 12, 15, 0, 0, time.UTC)
+	end := start.Add(45 * time.Minute)
+	otherEnd := start.Add(15 * time.Minute)
+	report := &session.MergeReport{
+		DryRun: true,
+		Items: []session.MergeItem{
+			{Session: &session.Session{ID: "11111111-a", PlanID: "rust", StartTime: start, EndTime: &end}, Action: session.MergeAdded},
+			{Session: &session.Session{ID: "22222222-b", PlanID: "rust", StartTime: start, EndTime: &end}, Action: session.MergeDuplicate},
+			{
+				Session: &session.Session{ID: "33333333-c", PlanID: "rust", StartTime: start, EndTime: &end},
+				Action:  session.MergeOverlap, Resolution: "skipped",
+				Overlapping: []*session.Session{{ID: "44444444-d", PlanID: "rust", StartTime: start.Add(-15 * time.Minute), EndTime: &otherEnd}},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	printMergeReport(&buf, report)
+	out := buf.String()
+	assert.Contains(t, out, "rust  2025-10-13 12:15  33333333 overlap, skipped\n  overlaps 44444444 (12:00–12:30)")
+	assert.NotContains(t, out, "11111111", "only conflicts are listed")
+	assert.Contains(t, out, "Would import 3 session(s): 1 added, 0 updated, 1 overlapping, 1 duplicate, 0 skipped")
+	assert.Contains(t, out, "Run without --dry-run")
+
+	buf.Reset()
+	printMergeReport(&buf, &session.MergeReport{})
+	assert.Equal(t, "No sessions to import.\n", buf.String())
+}
+
+func TestImportCmd_InvalidOverlapIsUsageError(t *testing.T) {
+	assert.Equal(t, ExitInvalid, executeExitCode(t, importCmd(), "laptop.json", "--overlap", "keep"))
+	assert.Equal(t, ExitInvalid, executeExitCode(t, importCmd(), "laptop.json", "--overlap", "merge"), "--overlap needs --merge")
+}
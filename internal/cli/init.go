@@ -14,22 +14,28 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/jobs"
 	"github.com/pezware/samedi.dev/internal/plan"
-	"github.com/pezware/samedi.dev/internal/storage"
 	"github.com/spf13/cobra"
 )
 
 // initCmd creates the `samedi init` command for plan generation.
 func initCmd() *cobra.Command {
 	var (
-		hours    float64
-		level    string
-		goals    string
-		model    string
-		edit     bool
-		noCards  bool
-		debug    bool
-		noPrompt bool
+		hours      float64
+		level      string
+		goals      string
+		model      string
+		edit       bool
+		noCards    bool
+		debug      bool
+		noPrompt   bool
+		background bool
+		allowMock  bool
+		deadline   string
+		style      string
+		promptFile string
 	)
 
 	cmd := &cobra.Command{
@@ -43,21 +49,44 @@ each with objectives, resources, and deliverables.
 Examples:
   samedi init "french b1"
   samedi init "rust async programming" --hours 20
-  samedi init "music theory basics" --level beginner --goals "read sheet music"`,
+  samedi init "music theory basics" --level beginner --goals "read sheet music"
+  samedi init "linear algebra" --background   # queue generation as a job
+  samedi init "spanish a2" --deadline 2025-06-30
+  samedi init "go web services" --style "project-based" --prompt-file notes.md
+
+--style and --prompt-file add instructions to the generation prompt: a
+teaching style, and free-form notes read from a file. Both are recorded
+under generated_by in the plan's frontmatter, with the template version,
+so the prompt can be reproduced.
+
+On a terminal, samedi offers to start the first chunk right away: it
+shows the chunk briefing and starts a session. --no-prompt (or
+--no-input) skips the offer.
+
+When llm.provider is "auto" and no LLM CLI is installed, init warns and
+scaffolds a plan of placeholder one-hour chunks for you to fill in
+instead of a canned mock plan. --allow-mock keeps the mock output.`,
 		Args: cobra.ExactArgs(1),
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := runInit(cmd, args, initOptions{
-				hours:    &hours,
-				level:    &level,
-				goals:    &goals,
-				model:    model,
-				edit:     edit,
-				noCards:  noCards,
-				debug:    debug,
-				noPrompt: noPrompt,
+				hours:      &hours,
+				level:      &level,
+				goals:      &goals,
+				model:      model,
+				edit:       edit,
+				noCards:    noCards,
+				debug:      debug,
+				noPrompt:   noPrompt,
+				background: background,
+				allowMock:  allowMock,
+				deadline:   deadline,
+				style:      style,
+				promptFile: promptFile,
 			}); err != nil {
-				exitWithError("%v", err)
+				return err
 			}
+
+			return nil
 		},
 	}
 
@@ -70,19 +99,30 @@ Examples:
 	cmd.Flags().BoolVar(&noCards, "no-cards", false, "skip flashcard generation suggestion")
 	cmd.Flags().BoolVar(&debug, "debug", false, "show full LLM prompt and response for debugging")
 	cmd.Flags().BoolVar(&noPrompt, "no-prompt", false, "skip interactive prompts and use flag values")
+	cmd.Flags().BoolVar(&noPrompt, "no-input", false, "alias for --no-prompt")
+	cmd.Flags().BoolVar(&background, "background", false, "queue plan generation as a background job")
+	cmd.Flags().BoolVar(&allowMock, "allow-mock", false, "generate with the mock provider when no LLM is available")
+	cmd.Flags().StringVar(&deadline, "deadline", "", "date to finish by (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&style, "style", "", "teaching style for the plan (e.g. project-based)")
+	cmd.Flags().StringVar(&promptFile, "prompt-file", "", "file of extra instructions for the plan generation prompt")
 
 	return cmd
 }
 
 type initOptions struct {
-	hours    *float64
-	level    *string
-	goals    *string
-	model    string
-	edit     bool
-	noCards  bool
-	debug    bool
-	noPrompt bool
+	hours      *float64
+	level      *string
+	goals      *string
+	model      string
+	edit       bool
+	noCards    bool
+	debug      bool
+	noPrompt   bool
+	background bool
+	allowMock  bool
+	deadline   string
+	style      string
+	promptFile string
 }
 
 func runInit(cmd *cobra.Command, args []string, opts initOptions) error {
@@ -107,6 +147,37 @@ func runInit(cmd *cobra.Command, args []string, opts initOptions) error {
 		return err
 	}
 
+	if opts.deadline != "" {
+		deadline, err := plan.ParseDate(opts.deadline)
+		if err != nil {
+			return err
+		}
+		inputs.deadline = &deadline
+	}
+
+	inputs.style = strings.TrimSpace(opts.style)
+	if opts.promptFile != "" {
+		content, err := os.ReadFile(opts.promptFile) // #nosec G304 - path given by the user
+		if err != nil {
+			return fmt.Errorf("failed to read prompt file: %w", err)
+		}
+		inputs.instructions = strings.TrimSpace(string(content))
+	}
+
+	cfg, err := getConfig(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	scaffold := false
+	if mockFallback(cfg) {
+		warnMockProvider(os.Stderr)
+		scaffold = !opts.allowMock
+	}
+
+	if opts.background && !scaffold {
+		return enqueuePlanGeneration(cmd, topic, inputs, opts.model)
+	}
+
 	svc, err := getPlanService(cmd, opts.model)
 	if err != nil {
 		return fmt.Errorf("failed to initialize: %w", err)
@@ -117,41 +188,61 @@ func runInit(cmd *cobra.Command, args []string, opts initOptions) error {
 	}
 
 	req := plan.CreateRequest{
-		Topic:      topic,
-		TotalHours: inputs.hours,
-		Level:      inputs.level,
-		Goals:      inputs.goals,
-		Debug:      opts.debug,
+		Topic:        topic,
+		TotalHours:   inputs.hours,
+		Level:        inputs.level,
+		Goals:        inputs.goals,
+		Deadline:     inputs.deadline,
+		Style:        inputs.style,
+		Instructions: inputs.instructions,
+		Debug:        opts.debug,
 	}
 
-	fmt.Printf("→ Generating learning plan for \"%s\" (%g hours)...\n", topic, inputs.hours)
-	if inputs.level != "" {
-		fmt.Printf("  Level: %s\n", inputs.level)
-	}
-	if verbose {
-		fmt.Printf("→ Calling LLM...\n")
+	var createdPlan *plan.Plan
+	if scaffold {
+		fmt.Printf("→ Scaffolding a manual plan for \"%s\" (%g hours); pass --allow-mock to use mock output\n", topic, inputs.hours)
+		createdPlan, err = svc.Scaffold(context.Background(), req)
+	} else {
+		fmt.Printf("→ Generating learning plan for \"%s\" (%g hours)...\n", topic, inputs.hours)
+		if inputs.level != "" {
+			fmt.Printf("  Level: %s\n", inputs.level)
+		}
+		if inputs.style != "" {
+			fmt.Printf("  Style: %s\n", inputs.style)
+		}
+		if verbose {
+			fmt.Printf("→ Calling LLM...\n")
+		}
+		createdPlan, err = svc.Create(context.Background(), req)
 	}
-
-	createdPlan, err := svc.Create(context.Background(), req)
 	if err != nil {
 		return fmt.Errorf("failed to create plan: %w", err)
 	}
 
-	if verbose {
+	if verbose && !scaffold {
 		fmt.Printf("→ Successfully parsed %d chunks\n", len(createdPlan.Chunks))
 	}
 
 	fmt.Printf("\n✓ Plan created: %s\n", createdPlan.Title)
 	fmt.Printf("✓ Location: ~/.samedi/plans/%s.md\n", createdPlan.ID)
 	fmt.Printf("✓ Chunks: %d (%.1f hours total)\n", len(createdPlan.Chunks), createdPlan.TotalHours)
+	if createdPlan.Deadline != nil {
+		fmt.Printf("✓ Deadline: %s\n", createdPlan.Deadline)
+	}
+
+	autoCommit(cmd, "samedi: plan created: "+createdPlan.ID)
+	autoMirror(cmd)
 
 	if opts.edit {
-		if err := openPlanInEditor(createdPlan.ID); err != nil {
+		if err := openPlanInEditor(svc, createdPlan.ID); err != nil {
 			fmt.Fprintf(os.Stderr, "Warning: Failed to open editor: %v\n", err)
 		}
 	}
 
 	fmt.Printf("\nNext steps:\n")
+	if scaffold {
+		fmt.Printf("  Fill in:    samedi plan edit %s\n", createdPlan.ID)
+	}
 	fmt.Printf("  View plan:  samedi plan show %s\n", createdPlan.ID)
 	if !opts.noCards {
 		fmt.Printf("  Add cards:  samedi cards generate %s\n", createdPlan.ID)
@@ -161,13 +252,78 @@ func runInit(cmd *cobra.Command, args []string, opts initOptions) error {
 		fmt.Printf("  Start:      samedi start %s %s\n", createdPlan.ID, firstChunk.ID)
 	}
 
+	if isInteractive(opts.noPrompt) {
+		return offerKickoff(cmd, createdPlan, bufio.NewReader(os.Stdin), os.Stdout)
+	}
+
+	return nil
+}
+
+// offerKickoff asks whether to start the first open chunk of a new plan
+// and, if so, starts a session for it (which also shows its briefing).
+func offerKickoff(cmd *cobra.Command, p *plan.Plan, reader *bufio.Reader, writer io.Writer) error {
+	next := nextActiveChunk(p)
+	if next == nil {
+		return nil
+	}
+
+	fmt.Fprintf(writer, "\nFirst chunk: %s — %s (%d min)\n", next.ID, next.Title, next.Duration)
+	start, err := promptYesNo(reader, writer, "Start now?", true)
+	if err != nil {
+		return fmt.Errorf("failed to read answer: %w", err)
+	}
+	if !start {
+		return nil
+	}
+
+	fmt.Fprintln(writer)
+	return executeStart(cmd, []string{p.ID, next.ID}, startOptions{noPrompt: true})
+}
+
+// enqueuePlanGeneration queues plan generation for the job worker instead
+// of blocking on the LLM call.
+func enqueuePlanGeneration(cmd *cobra.Command, topic string, inputs initInputs, model string) error {
+	svc, err := getJobService(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to initialize: %w", err)
+	}
+
+	payload := planGeneratePayload{
+		Topic:        topic,
+		Hours:        inputs.hours,
+		Level:        inputs.level,
+		Goals:        inputs.goals,
+		Model:        model,
+		Style:        inputs.style,
+		Instructions: inputs.instructions,
+	}
+	if inputs.deadline != nil {
+		payload.Deadline = inputs.deadline.String()
+	}
+
+	job, err := svc.Enqueue(context.Background(), jobs.EnqueueRequest{
+		Type:    jobTypePlanGenerate,
+		Payload: payload,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to queue plan generation: %w", err)
+	}
+
+	fmt.Printf("✓ Queued plan generation for \"%s\" (job %s)\n", topic, shortJobID(job.ID))
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  Process:    samedi jobs run --once (or keep 'samedi notify daemon' running)\n")
+	fmt.Printf("  Check:      samedi jobs list\n")
+
 	return nil
 }
 
 type initInputs struct {
-	hours float64
-	level string
-	goals string
+	hours        float64
+	level        string
+	goals        string
+	deadline     *plan.Date
+	style        string
+	instructions string // Contents of --prompt-file
 }
 
 func collectInitInputs(cmd *cobra.Command, inputs *initInputs, noPrompt bool) error {
@@ -241,28 +397,36 @@ func showVerboseInfo(cmd *cobra.Command, modelOverride string) {
 	fmt.Printf("→ Timeout: %d seconds\n", cfg.LLM.TimeoutSeconds)
 }
 
-// openPlanInEditor opens a plan file in the configured editor.
-func openPlanInEditor(planID string) error {
-	editor := os.Getenv("EDITOR")
-	if editor == "" {
-		editor = "vi"
-	}
+// openPlanInEditor opens a plan's file in the configured editor.
+func openPlanInEditor(svc *plan.Service, planID string) error {
+	return svc.EditFile(planID, func(path string) error {
+		return editorCommand(path).Run()
+	})
+}
 
-	// Get plan path
-	paths, err := storage.DefaultPaths()
-	if err != nil {
-		return fmt.Errorf("failed to get paths: %w", err)
+// editorCommand returns a command that opens path in user.editor, else
+// $EDITOR, else vi. The editor may carry arguments, as in "code --wait".
+// A config that fails to load (say, mid-edit) falls back to $EDITOR.
+func editorCommand(path string) *exec.Cmd {
+	editor := resolveEditor(os.Getenv("EDITOR"))
+	if cfg, err := config.Load(); err == nil && cfg.User.Editor != "" {
+		editor = cfg.User.Editor
 	}
 
-	planPath := paths.PlanPath(planID)
-
-	// Open in editor
-	editorCmd := exec.Command(editor, planPath)
+	fields := strings.Fields(editor)
+	editorCmd := exec.Command(fields[0], append(fields[1:], path)...)
 	editorCmd.Stdin = os.Stdin
 	editorCmd.Stdout = os.Stdout
 	editorCmd.Stderr = os.Stderr
+	return editorCmd
+}
 
-	return editorCmd.Run()
+// resolveEditor returns editor, or vi when it is blank.
+func resolveEditor(editor string) string {
+	if strings.TrimSpace(editor) == "" {
+		return "vi"
+	}
+	return editor
 }
 
 // promptForHours asks the user for total hours, enforcing validation rules.
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/pezware/samedi.dev/internal/plan"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -42,6 +43,21 @@ func TestInitCmd_Structure(t *testing.T) {
 	noPrompt := cmd.Flags().Lookup("no-prompt")
 	require.NotNil(t, noPrompt)
 	assert.Equal(t, "false", noPrompt.DefValue)
+
+	noInput := cmd.Flags().Lookup("no-input")
+	require.NotNil(t, noInput)
+	assert.Equal(t, "false", noInput.DefValue)
+
+	background := cmd.Flags().Lookup("background")
+	require.NotNil(t, background)
+	assert.Equal(t, "false", background.DefValue)
+
+	allowMock := cmd.Flags().Lookup("allow-mock")
+	require.NotNil(t, allowMock)
+	assert.Equal(t, "false", allowMock.DefValue)
+
+	assert.NotNil(t, cmd.Flags().Lookup("style"))
+	assert.NotNil(t, cmd.Flags().Lookup("prompt-file"))
 }
 
 func TestInitCmd_RequiresTopicArg(t *testing.T) {
@@ -58,6 +74,33 @@ func TestInitCmd_RequiresTopicArg(t *testing.T) {
 	assert.Error(t, err, "should reject multiple arguments")
 }
 
+func TestOfferKickoff_Declined(t *testing.T) {
+	p := &plan.Plan{
+		ID: "rust-async",
+		Chunks: []plan.Chunk{
+			{ID: "chunk-001", Title: "Futures", Duration: 60, Status: plan.StatusNotStarted},
+		},
+	}
+	var output bytes.Buffer
+
+	err := offerKickoff(initCmd(), p, bufio.NewReader(strings.NewReader("n\n")), &output)
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), "First chunk: chunk-001 — Futures (60 min)")
+	assert.Contains(t, output.String(), "Start now? [Y/n]: ")
+}
+
+func TestOfferKickoff_NoOpenChunks(t *testing.T) {
+	p := &plan.Plan{
+		ID:     "rust-async",
+		Chunks: []plan.Chunk{{ID: "chunk-001", Status: plan.StatusCompleted}},
+	}
+	var output bytes.Buffer
+
+	err := offerKickoff(initCmd(), p, bufio.NewReader(strings.NewReader("")), &output)
+	require.NoError(t, err)
+	assert.Empty(t, output.String())
+}
+
 // Note: Full integration tests with actual plan creation will be in
 // integration test suite to avoid complex mocking of the service layer
 
@@ -0,0 +1,480 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/jobs"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+	"github.com/spf13/cobra"
+)
+
+// Job types handled by the background worker.
+const (
+	jobTypePlanGenerate = "plan.generate"
+	jobTypeReport       = "report.scheduled"
+	jobTypeBackup       = "db.backup"
+	jobTypeSyncCommit   = "sync.commit"
+)
+
+// syncCommitPayload is the payload for sync.commit jobs.
+type syncCommitPayload struct {
+	Message string `json:"message"`
+}
+
+// planGeneratePayload is the payload for plan.generate jobs.
+type planGeneratePayload struct {
+	Topic string  `json:"topic"`
+	Hours float64 `json:"hours"`
+	Level string  `json:"level,omitempty"`
+	Goals string  `json:"goals,omitempty"`
+	Model string  `json:"model,omitempty"`
+
+	Deadline     string `json:"deadline,omitempty"` // YYYY-MM-DD
+	Style        string `json:"style,omitempty"`
+	Instructions string `json:"instructions,omitempty"` // Contents of --prompt-file
+}
+
+// jobsCmd creates the parent `samedi jobs` command.
+func jobsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jobs",
+		Short: "Manage background jobs",
+		Long: `Inspect and control the background job queue.
+
+Background work is queued as a job: plans from 'samedi init
+--background', scheduled reports, scheduled backups, and sync
+auto-commits. A worker ('samedi notify daemon' or 'samedi jobs run')
+processes them; reports, backups, and commits also run at once from the
+command that queues them. Failed jobs are retried automatically with
+exponential backoff until they run out of attempts.
+
+Examples:
+  samedi jobs list                    # Show recent jobs
+  samedi jobs list --status failed    # Only failed jobs
+  samedi jobs retry 3f2a9c1d          # Requeue a failed job
+  samedi jobs cancel 3f2a9c1d         # Cancel a pending job
+  samedi jobs run --once              # Process due jobs and exit`,
+	}
+
+	cmd.AddCommand(jobsListCmd())
+	cmd.AddCommand(mutating(jobsRetryCmd()))
+	cmd.AddCommand(mutating(jobsCancelCmd()))
+	cmd.AddCommand(mutating(jobsRunCmd()))
+
+	return cmd
+}
+
+// jobsListCmd creates the `samedi jobs list` subcommand.
+func jobsListCmd() *cobra.Command {
+	var (
+		statusFilter string
+		limit        int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List background jobs",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			svc, err := getJobService(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			filter := jobs.Filter{Limit: limit}
+			if statusFilter != "" {
+				filter.Statuses = []jobs.Status{jobs.Status(statusFilter)}
+			}
+
+			list, err := svc.List(context.Background(), filter)
+			if err != nil {
+				return fmt.Errorf("failed to list jobs: %w", err)
+			}
+
+			jsonOutput, err := cmd.Flags().GetBool("json")
+			if err != nil {
+				return fmt.Errorf("failed to get json flag: %w", err)
+			}
+			if jsonOutput {
+				data, err := json.MarshalIndent(list, "", "  ")
+				if err != nil {
+					return fmt.Errorf("failed to marshal JSON: %w", err)
+				}
+				fmt.Println(string(data))
+				return nil
+			}
+
+			if len(list) == 0 {
+				fmt.Println("No jobs found.")
+				return nil
+			}
+
+			w := components.NewTabWriter(os.Stdout, 2)
+			fmt.Fprintln(w, "ID\tTYPE\tSTATUS\tATTEMPTS\tNEXT RUN\tLAST ERROR")
+			for _, job := range list {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%d/%d\t%s\t%s\n",
+					shortJobID(job.ID),
+					job.Type,
+					job.Status,
+					job.Attempts,
+					job.MaxAttempts,
+					formatJobNextRun(job, time.Now()),
+					truncate(job.LastError, 40),
+				)
+			}
+			w.Flush()
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&statusFilter, "status", "", "filter by status (pending, running, succeeded, failed, cancelled)")
+	cmd.Flags().IntVar(&limit, "limit", 20, "maximum number of jobs to show (0 for all)")
+
+	return cmd
+}
+
+// jobsRetryCmd creates the `samedi jobs retry` subcommand.
+func jobsRetryCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "retry <job-id>",
+		Short: "Requeue a failed or cancelled job",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			svc, err := getJobService(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			ctx := context.Background()
+			id, err := resolveJobID(ctx, svc, args[0])
+			if err != nil {
+				return err
+			}
+
+			job, err := svc.Retry(ctx, id)
+			if err != nil {
+				return fmt.Errorf("failed to retry job: %w", err)
+			}
+
+			fmt.Printf("✓ Job %s (%s) requeued\n", shortJobID(job.ID), job.Type)
+
+			return nil
+		},
+	}
+}
+
+// jobsCancelCmd creates the `samedi jobs cancel` subcommand.
+func jobsCancelCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel <job-id>",
+		Short: "Cancel a pending job",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			svc, err := getJobService(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			ctx := context.Background()
+			id, err := resolveJobID(ctx, svc, args[0])
+			if err != nil {
+				return err
+			}
+
+			job, err := svc.Cancel(ctx, id)
+			if err != nil {
+				return fmt.Errorf("failed to cancel job: %w", err)
+			}
+
+			fmt.Printf("✓ Job %s (%s) cancelled\n", shortJobID(job.ID), job.Type)
+
+			return nil
+		},
+	}
+}
+
+// jobsRunCmd creates the `samedi jobs run` subcommand.
+func jobsRunCmd() *cobra.Command {
+	var once bool
+
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Process queued jobs in the foreground",
+		Long: `Run a job worker in the foreground.
+
+By default the worker keeps polling for due jobs until interrupted
+with Ctrl+C. Use --once to process everything currently due and exit.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			svc, err := getJobService(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			worker := newJobWorker(cmd, svc)
+
+			if once {
+				processed, err := worker.Drain(context.Background())
+				if err != nil {
+					return fmt.Errorf("worker failed: %w", err)
+				}
+				fmt.Printf("✓ Processed %d job(s)\n", processed)
+				return nil
+			}
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			fmt.Println("→ Job worker running (Ctrl+C to stop)")
+			if err := worker.Run(ctx); err != nil {
+				return fmt.Errorf("worker failed: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&once, "once", false, "process due jobs and exit")
+
+	return cmd
+}
+
+// newJobWorker creates a worker with handlers for every known job type.
+func newJobWorker(cmd *cobra.Command, svc *jobs.Service) *jobs.Worker {
+	worker := jobs.NewWorker(svc)
+	worker.Register(jobTypePlanGenerate, planGenerateHandler(cmd))
+	worker.Register(jobTypeReport, reportHandler(cmd))
+	worker.Register(jobTypeBackup, backupHandler(cmd))
+	worker.Register(jobTypeSyncCommit, syncCommitHandler(cmd))
+	return worker
+}
+
+// runJobNow queues req unless a job of its type is already queued, then
+// runs that job at once, so the work doesn't wait for the daemon. A job
+// still backing off from a failure is left to its retry. Failures only
+// produce a warning; the job retries from the daemon, 'samedi jobs run',
+// or the next command that queues the same work.
+func runJobNow(cmd *cobra.Command, what string, req jobs.EnqueueRequest) {
+	svc, err := getJobService(cmd)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %s skipped: %v\n", what, err)
+		return
+	}
+
+	ctx := context.Background()
+	job, err := svc.EnqueueUnique(ctx, req)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %s skipped: %v\n", what, err)
+		return
+	}
+	ran, err := newJobWorker(cmd, svc).RunJob(ctx, job.ID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %s failed: %v\n", what, err)
+		return
+	}
+	if !ran {
+		return
+	}
+	if job, err = svc.Get(ctx, job.ID); err == nil && job.Status != jobs.StatusSucceeded {
+		fmt.Fprintf(os.Stderr, "Warning: %s failed: %s\n", what, job.LastError)
+	}
+}
+
+// reportHandler writes the scheduled report that is due, if it isn't
+// written yet.
+func reportHandler(cmd *cobra.Command) jobs.Handler {
+	return func(ctx context.Context, _ *jobs.Job) error {
+		cfg, err := getConfig(cmd)
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+		if cfg.Reports.Schedule == config.ReportScheduleOff {
+			return nil
+		}
+		statsService, err := getStatsService(cmd)
+		if err != nil {
+			return fmt.Errorf("failed to initialize stats service: %w", err)
+		}
+
+		now := time.Now().In(cfg.User.Location())
+		path, written, err := writeAutoReport(ctx, cfg, statsService, now)
+		if err != nil {
+			return err
+		}
+		if written {
+			due := dueAutoReport(cfg.Reports.Schedule, cfg.Reports.Weekday(), now)
+			fmt.Fprintf(os.Stderr, "✓ Wrote %s report: %s\n", due.schedule, path)
+		}
+		return nil
+	}
+}
+
+// backupHandler backs up the database once a scheduled backup is due.
+func backupHandler(cmd *cobra.Command) jobs.Handler {
+	return func(_ context.Context, _ *jobs.Job) error {
+		cfg, err := getConfig(cmd)
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+		paths, err := configPaths(cfg)
+		if err != nil {
+			return fmt.Errorf("failed to get paths: %w", err)
+		}
+		db, err := sharedDatabase(paths)
+		if err != nil {
+			return err
+		}
+
+		path, err := db.AutoBackup(backupPolicy(cfg, paths), time.Now())
+		if err != nil {
+			return err
+		}
+		if path != "" {
+			fmt.Fprintf(os.Stderr, "✓ Backed up database: %s\n", path)
+		}
+		return nil
+	}
+}
+
+// syncCommitHandler records changes in the sync repository. A commit
+// picks up every change since the last one, including those of commands
+// that found it already queued.
+func syncCommitHandler(cmd *cobra.Command) jobs.Handler {
+	return func(ctx context.Context, job *jobs.Job) error {
+		var payload syncCommitPayload
+		if err := job.DecodePayload(&payload); err != nil {
+			return err
+		}
+		if cfg, err := getConfig(cmd); err != nil || !cfg.Sync.AutoCommit {
+			return err
+		}
+		repo, err := getSyncRepo()
+		if err != nil {
+			return err
+		}
+		if !repo.IsInitialized() {
+			return nil
+		}
+
+		_, err = repo.Commit(ctx, payload.Message)
+		return err
+	}
+}
+
+// planGenerateHandler generates a plan in the background.
+func planGenerateHandler(cmd *cobra.Command) jobs.Handler {
+	return func(ctx context.Context, job *jobs.Job) error {
+		var payload planGeneratePayload
+		if err := job.DecodePayload(&payload); err != nil {
+			return err
+		}
+
+		req := plan.CreateRequest{
+			Topic:        payload.Topic,
+			TotalHours:   payload.Hours,
+			Level:        payload.Level,
+			Goals:        payload.Goals,
+			Style:        payload.Style,
+			Instructions: payload.Instructions,
+		}
+		if payload.Deadline != "" {
+			deadline, err := plan.ParseDate(payload.Deadline)
+			if err != nil {
+				return err
+			}
+			req.Deadline = &deadline
+		}
+
+		svc, err := getPlanService(cmd, payload.Model)
+		if err != nil {
+			return fmt.Errorf("failed to initialize plan service: %w", err)
+		}
+
+		created, err := svc.Create(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to create plan: %w", err)
+		}
+
+		autoCommit(cmd, "samedi: plan created: "+created.ID)
+		autoMirror(cmd)
+
+		fmt.Printf("✓ Plan created: %s (%s)\n", created.Title, created.ID)
+		return nil
+	}
+}
+
+// getJobService initializes the job service with its database.
+func getJobService(_ *cobra.Command) (*jobs.Service, error) {
+	paths, err := getPaths()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get paths: %w", err)
+	}
+
+	db, err := sharedDatabase(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	return jobs.NewService(jobs.NewSQLiteRepository(db)), nil
+}
+
+// resolveJobID expands a unique ID prefix (as shown by `jobs list`) to a full job ID.
+func resolveJobID(ctx context.Context, svc *jobs.Service, prefix string) (string, error) {
+	all, err := svc.List(ctx, jobs.Filter{})
+	if err != nil {
+		return "", fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	var matches []string
+	for _, job := range all {
+		if job.ID == prefix {
+			return job.ID, nil
+		}
+		if strings.HasPrefix(job.ID, prefix) {
+			matches = append(matches, job.ID)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("%w: %s", jobs.ErrJobNotFound, prefix)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("job ID prefix %q is ambiguous (%d matches)", prefix, len(matches))
+	}
+}
+
+// shortJobID returns the first 8 characters of a job ID for display.
+func shortJobID(id string) string {
+	if len(id) <= 8 {
+		return id
+	}
+	return id[:8]
+}
+
+// formatJobNextRun describes when a job will next run.
+func formatJobNextRun(job *jobs.Job, now time.Time) string {
+	switch job.Status {
+	case jobs.StatusPending:
+		if !job.RunAt.After(now) {
+			return "now"
+		}
+		return "in " + job.RunAt.Sub(now).Round(time.Second).String()
+	case jobs.StatusRunning:
+		return "running"
+	default:
+		return "-"
+	}
+}
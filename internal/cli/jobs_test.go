@@ -0,0 +1,145 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/jobs"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobsCmd_Structure(t *testing.T) {
+	cmd := jobsCmd()
+
+	assert.Equal(t, "jobs", cmd.Use)
+	assert.NotEmpty(t, cmd.Short)
+	assert.NotEmpty(t, cmd.Long)
+
+	names := make(map[string]bool)
+	for _, sub := range cmd.Commands() {
+		names[sub.Name()] = true
+	}
+	assert.True(t, names["list"], "Should have list subcommand")
+	assert.True(t, names["retry"], "Should have retry subcommand")
+	assert.True(t, names["cancel"], "Should have cancel subcommand")
+	assert.True(t, names["run"], "Should have run subcommand")
+}
+
+func TestJobsRetryCmd_RequiresJobID(t *testing.T) {
+	cmd := jobsRetryCmd()
+
+	assert.Error(t, cmd.Args(cmd, []string{}))
+	assert.NoError(t, cmd.Args(cmd, []string{"abc123"}))
+	assert.Error(t, cmd.Args(cmd, []string{"a", "b"}))
+}
+
+func TestJobsListCmd_Flags(t *testing.T) {
+	cmd := jobsListCmd()
+
+	status := cmd.Flags().Lookup("status")
+	require.NotNil(t, status)
+	assert.Equal(t, "", status.DefValue)
+
+	limit := cmd.Flags().Lookup("limit")
+	require.NotNil(t, limit)
+	assert.Equal(t, "20", limit.DefValue)
+}
+
+func TestResolveJobID(t *testing.T) {
+	db, err := storage.NewSQLiteDB(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, storage.NewMigrator(db).Migrate())
+
+	svc := jobs.NewService(jobs.NewSQLiteRepository(db))
+	ctx := context.Background()
+
+	first, err := svc.Enqueue(ctx, jobs.EnqueueRequest{Type: jobTypePlanGenerate})
+	require.NoError(t, err)
+	_, err = svc.Enqueue(ctx, jobs.EnqueueRequest{Type: jobTypePlanGenerate})
+	require.NoError(t, err)
+
+	id, err := resolveJobID(ctx, svc, first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, id)
+
+	id, err = resolveJobID(ctx, svc, shortJobID(first.ID))
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, id)
+
+	_, err = resolveJobID(ctx, svc, "zzzz")
+	assert.ErrorContains(t, err, "job not found")
+
+	_, err = resolveJobID(ctx, svc, "")
+	assert.ErrorContains(t, err, "ambiguous")
+}
+
+func TestFormatJobNextRun(t *testing.T) {
+	now := time.Now()
+
+	assert.Equal(t, "now", formatJobNextRun(&jobs.Job{Status: jobs.StatusPending, RunAt: now.Add(-time.Minute)}, now))
+	assert.Equal(t, "in 30s", formatJobNextRun(&jobs.Job{Status: jobs.StatusPending, RunAt: now.Add(30 * time.Second)}, now))
+	assert.Equal(t, "running", formatJobNextRun(&jobs.Job{Status: jobs.StatusRunning}, now))
+	assert.Equal(t, "-", formatJobNextRun(&jobs.Job{Status: jobs.StatusFailed}, now))
+}
+
+func TestShortJobID(t *testing.T) {
+	assert.Equal(t, "3f2a9c1d", shortJobID("3f2a9c1d-aaaa-bbbb-cccc-dddddddddddd"))
+	assert.Equal(t, "abc", shortJobID("abc"))
+}
+
+func TestRunJobNow_Backup(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	cmd := &cobra.Command{}
+
+	runJobNow(cmd, "scheduled backup", jobs.EnqueueRequest{Type: jobTypeBackup})
+
+	paths, err := getPaths()
+	require.NoError(t, err)
+	backups, err := storage.Backups(paths.BackupDir)
+	require.NoError(t, err)
+	assert.Len(t, backups, 1, "the job runs at once, without a daemon")
+
+	svc, err := getJobService(cmd)
+	require.NoError(t, err)
+	queued, err := svc.List(context.Background(), jobs.Filter{Type: jobTypeBackup})
+	require.NoError(t, err)
+	require.Len(t, queued, 1)
+	assert.Equal(t, jobs.StatusSucceeded, queued[0].Status)
+
+	// The next backup isn't due for a week
+	runJobNow(cmd, "scheduled backup", jobs.EnqueueRequest{Type: jobTypeBackup})
+	backups, err = storage.Backups(paths.BackupDir)
+	require.NoError(t, err)
+	assert.Len(t, backups, 1)
+}
+
+func TestNewJobWorker_HandlesBackgroundWork(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	cmd := &cobra.Command{}
+	svc, err := getJobService(cmd)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	worker := newJobWorker(cmd, svc)
+	// Reports, backups, and commits with nothing to do succeed; plan
+	// generation needs an LLM
+	for _, jobType := range []string{jobTypeReport, jobTypeBackup, jobTypeSyncCommit} {
+		job, err := svc.Enqueue(ctx, jobs.EnqueueRequest{Type: jobType, Payload: syncCommitPayload{Message: "test"}})
+		require.NoError(t, err)
+		ran, err := worker.RunJob(ctx, job.ID)
+		require.NoError(t, err)
+		assert.True(t, ran)
+
+		job, err = svc.Get(ctx, job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, jobs.StatusSucceeded, job.Status, jobType+": "+job.LastError)
+	}
+}
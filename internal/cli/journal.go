@@ -0,0 +1,178 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/journal"
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+// journalExcerptLength is how much of a note stats and reports quote.
+const journalExcerptLength = 120
+
+// journalCmd creates the `samedi journal` command, which opens a day's
+// note in the editor.
+func journalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "journal [date]",
+		Short: "Write in the journal for a day",
+		Long: `Open a day's journal note in your editor (user.editor, else $EDITOR).
+
+Notes are markdown files, one per day, in the journal/ folder of the
+data directory (~/.samedi/journal/2026-10-16.md), so sync carries them
+along with plans. With storage.encrypt on they are encrypted like plan
+files, and your editor gets a temporary plaintext copy. The date defaults
+to today; "yesterday" and YYYY-MM-DD dates work too. A new note closed
+without changes isn't kept.
+
+The daily breakdown of 'samedi stats' quotes each day's note under that
+day's sessions, and weekly reports end with the week's notes.
+
+Examples:
+  samedi journal                   # Today's note
+  samedi journal yesterday         # Catch up on yesterday
+  samedi journal 2026-10-12        # A given day
+  samedi journal list              # This week's notes
+  samedi journal list --range all  # Every note`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := getConfig(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			store, err := getJournalStore(cfg)
+			if err != nil {
+				return err
+			}
+
+			value := ""
+			if len(args) > 0 {
+				value = args[0]
+			}
+			day, err := journal.ParseDay(value, userNow(cmd))
+			if err != nil {
+				return err
+			}
+
+			changed, err := store.Edit(day, func(path string) error {
+				return editorCommand(path).Run()
+			})
+			if err != nil {
+				return err
+			}
+			if !changed {
+				fmt.Println("Journal unchanged.")
+				return nil
+			}
+
+			fmt.Printf("✓ Saved journal: %s\n", store.Path(day))
+			autoCommit(cmd, "samedi: journal "+day.Format(journal.DateLayout))
+			autoMirror(cmd)
+			return nil
+		},
+	}
+
+	cmd.AddCommand(journalListCmd())
+
+	return cmd
+}
+
+// journalListCmd creates the `samedi journal list` subcommand.
+func journalListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List journal notes with an excerpt of each",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := getConfig(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			store, err := getJournalStore(cfg)
+			if err != nil {
+				return err
+			}
+
+			rangeStr, err := cmd.Flags().GetString("range")
+			if err != nil {
+				return fmt.Errorf("failed to get range flag: %w", err)
+			}
+			tr, err := stats.ParseTimeRange(rangeStr, userNow(cmd))
+			if err != nil {
+				return err
+			}
+			entries, err := store.List(tr.Start, tr.End)
+			if err != nil {
+				return err
+			}
+
+			jsonOutput, err := cmd.Flags().GetBool("json")
+			if err != nil {
+				return fmt.Errorf("failed to get json flag: %w", err)
+			}
+			if jsonOutput {
+				if entries == nil {
+					entries = []*journal.Entry{}
+				}
+				return printJSON(entries)
+			}
+
+			printJournalList(os.Stdout, entries)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP("range", "r", "this-week", "Time range: all, today, this-week, this-month")
+
+	return cmd
+}
+
+// printJournalList prints one line per note: its date and an excerpt.
+func printJournalList(w io.Writer, entries []*journal.Entry) {
+	if len(entries) == 0 {
+		fmt.Fprintln(w, "No journal notes in selected time range.")
+		return
+	}
+	for _, entry := range entries {
+		fmt.Fprintf(w, "📓 %s  %s\n", entry.Date.Format("Mon Jan 2, 2006"), entry.Excerpt(journalExcerptLength))
+	}
+}
+
+// getJournalStore returns the journal of the active profile.
+func getJournalStore(cfg *config.Config) (*journal.Store, error) {
+	paths, err := configPaths(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get paths: %w", err)
+	}
+	// Notes are encrypted like plan files when storage.encrypt is on
+	cipher, err := openCipher(cfg)
+	if err != nil {
+		return nil, err
+	}
+	fs := storage.NewFilesystemStorage(paths)
+	fs.SetCipher(cipher)
+	return journal.NewStore(fs, paths), nil
+}
+
+// journalSection renders entries as the "## Journal" section reports end
+// with, one excerpt per day, or "" when there are none.
+func journalSection(entries []*journal.Entry) string {
+	if len(entries) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("## Journal\n\n")
+	for _, entry := range entries {
+		fmt.Fprintf(&b, "- **%s**: %s\n", entry.Date.Format("Monday, January 2"), entry.Excerpt(journalExcerptLength*2))
+	}
+	return b.String()
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/journal"
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournalCmd_Structure(t *testing.T) {
+	cmd := journalCmd()
+
+	assert.Equal(t, "journal [date]", cmd.Use)
+	assert.NotEmpty(t, cmd.Long)
+	list, _, err := cmd.Find([]string{"list"})
+	require.NoError(t, err)
+	assert.Equal(t, "list", list.Name())
+	assert.NotNil(t, list.Flags().Lookup("range"))
+}
+
+func TestJournalSection(t *testing.T) {
+	assert.Empty(t, journalSection(nil))
+
+	entries := []*journal.Entry{
+		{Date: time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), Content: "# Monday\n\nRead the Pin docs.\n"},
+		{Date: time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC), Content: "Built an executor."},
+	}
+	assert.Equal(t, "## Journal\n\n- **Monday, October 12**: Read the Pin docs.\n- **Wednesday, October 14**: Built an executor.\n",
+		journalSection(entries))
+
+	var buf bytes.Buffer
+	printJournalList(&buf, entries)
+	assert.Equal(t, "📓 Mon Oct 12, 2026  Read the Pin docs.\n📓 Wed Oct 14, 2026  Built an executor.\n", buf.String())
+}
+
+func TestPrintDays_Journal(t *testing.T) {
+	paths, err := storage.NewPaths(t.TempDir())
+	require.NoError(t, err)
+	store := journal.NewStore(storage.NewFilesystemStorage(paths), paths)
+	day := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, os.MkdirAll(paths.JournalDir(), 0o755))
+	require.NoError(t, os.WriteFile(store.Path(day), []byte("# Monday\n\nRead the Pin docs.\n"), 0o600))
+
+	daily := []stats.DailyStats{
+		{Date: day, Duration: 60, SessionCount: 1, Plans: []string{"rust"}},
+		{Date: day.AddDate(0, 0, 1), Duration: 30, SessionCount: 1, Plans: []string{"rust"}},
+	}
+	tr := stats.TimeRange{Start: day, End: day.AddDate(0, 0, 2)}
+
+	var buf bytes.Buffer
+	printDays(&buf, daily, tr, store)
+	assert.Contains(t, buf.String(), "  📚 Plans: rust\n  📓 Journal: Read the Pin docs.\n\nTuesday")
+	assert.Equal(t, 1, bytes.Count(buf.Bytes(), []byte("📓")), "only days with a note quote one")
+
+	buf.Reset()
+	printDays(&buf, daily, tr, nil)
+	assert.NotContains(t, buf.String(), "Journal")
+}
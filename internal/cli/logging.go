@@ -0,0 +1,67 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/log"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+// logFile is the log file setupLogging opened; Execute closes it.
+var logFile io.Closer
+
+// setupLogging starts the diagnostic log in the logs directory at
+// log.level, and with --verbose also logs everything from debug up to
+// stderr. Logging never fails a command: problems with it are warnings.
+func setupLogging(cmd *cobra.Command) {
+	opts := logOptions(cmd)
+	closer, err := log.Setup(opts)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: not writing the log file: %v\n", err)
+		closer, _ = log.Setup(log.Options{Verbose: opts.Verbose})
+	}
+	logFile = closer
+	log.Debug("running command", "command", cmd.CommandPath(), "version", Version)
+}
+
+// logOptions returns the log options for cmd. A config that fails to load
+// logs at info level to the default logs directory.
+func logOptions(cmd *cobra.Command) log.Options {
+	opts := log.Options{Level: slog.LevelInfo}
+	if verbose, _ := cmd.Flags().GetBool("verbose"); verbose {
+		opts.Verbose = cmd.ErrOrStderr()
+	}
+
+	cfg, err := getConfig(cmd)
+	if err != nil {
+		cfg = config.DefaultConfig()
+	}
+	if level, err := log.ParseLevel(cfg.Log.Level); err == nil {
+		opts.Level = level
+	}
+	opts.Keep = cfg.Log.Keep
+	if paths, err := rootPaths(cfg); err == nil {
+		opts.Dir = paths.LogsDir()
+	} else if paths, err := storage.DefaultPaths(); err == nil {
+		opts.Dir = paths.LogsDir()
+	}
+	return opts
+}
+
+// closeLogging records how the command ended and closes the log file.
+func closeLogging(err error) {
+	if err != nil {
+		log.Error("command failed", "error", err)
+	}
+	if logFile != nil {
+		_ = logFile.Close()
+		logFile = nil
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/log"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogOptions(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	cmd := &cobra.Command{Use: "stats"}
+	cmd.Flags().BoolP("verbose", "v", false, "")
+	var errOut bytes.Buffer
+	cmd.SetErr(&errOut)
+
+	opts := logOptions(cmd)
+	assert.Equal(t, filepath.Join(home, ".samedi", "logs"), opts.Dir)
+	assert.Equal(t, slog.LevelInfo, opts.Level)
+	assert.Equal(t, 3, opts.Keep)
+	assert.Nil(t, opts.Verbose)
+
+	require.NoError(t, cmd.Flags().Set("verbose", "true"))
+	assert.Same(t, &errOut, logOptions(cmd).Verbose)
+}
+
+func TestSetupLogging(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Cleanup(func() { _, _ = log.Setup(log.Options{}) })
+
+	cmd := &cobra.Command{Use: "stats"}
+	cmd.Flags().BoolP("verbose", "v", false, "")
+	setupLogging(cmd)
+	log.Info("hello")
+	closeLogging(assert.AnError)
+
+	data, err := os.ReadFile(filepath.Join(home, ".samedi", "logs", log.FileName))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "msg=hello")
+	assert.Contains(t, string(data), `level=ERROR msg="command failed"`)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pezware/samedi.dev/internal/mcp"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/spf13/cobra"
+)
+
+// mcpCmd creates the `samedi mcp` command.
+func mcpCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mcp",
+		Short: "Serve samedi to AI assistants over MCP",
+		Long: `Run a Model Context Protocol server on stdin and stdout, so Claude Code and
+other MCP clients can work with your plans through tool calls.
+
+Tools:
+  list_plans      List plans, optionally by status
+  get_plan        A plan with all of its chunks
+  get_chunk       One chunk and the sessions logged against it
+  get_status      The active session, if any
+  start_session   Start timing a session
+  stop_session    Stop the active session with notes and artifacts
+  log_session     Log a session that already happened
+  create_plan     Generate and save a new plan with the configured LLM
+
+In read-only mode the tools that change data are refused. The client
+starts this command itself; register it once, for example:
+
+  claude mcp add samedi -- samedi mcp
+
+Examples:
+  samedi mcp
+  samedi mcp --read-only`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			planService, err := getPlanService(cmd, "")
+			if err != nil {
+				return fmt.Errorf("failed to initialize plan service: %w", err)
+			}
+			sessionService, err := getSessionService(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to initialize session service: %w", err)
+			}
+
+			server := mcp.New(mcp.Options{
+				Plans:    planService,
+				Sessions: sessionService,
+				Version:  Version,
+				ReadOnly: readOnlyMode(cmd),
+				AfterSession: func(sess *session.Session) {
+					autoCommit(cmd, sessionCommitMessage(sess))
+					autoMirror(cmd)
+				},
+				AfterPlan: func(p *plan.Plan) {
+					autoCommit(cmd, "samedi: plan created: "+p.ID)
+					autoMirror(cmd)
+				},
+			})
+
+			// Stdout carries the protocol; anything else goes to stderr
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			return server.Serve(ctx, os.Stdin, os.Stdout)
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,313 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/notify"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/tui"
+	"github.com/spf13/cobra"
+)
+
+// notifyCmd creates the parent `samedi notify` command.
+func notifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "notify",
+		Short: "Desktop reminders for streaks and goals",
+		Long: `Send desktop notifications when your streak is about to break or
+your weekly goal is behind.
+
+Reminders are checked at the times listed in learning.reminder_times
+(default 20:00). Notifications use terminal-notifier or osascript on
+macOS and notify-send on Linux, falling back to a terminal bell.
+
+Examples:
+  samedi notify daemon                # Run reminders + job worker in the foreground
+  samedi notify check                 # Check now and send any due reminders
+  samedi notify check --print         # Show due reminders without notifying
+  samedi notify test                  # Send a test notification
+
+Configure:
+  samedi config set learning.reminder_times "12:30,20:00"
+  samedi config set learning.weekly_goal_hours 6`,
+	}
+
+	cmd.AddCommand(mutating(notifyDaemonCmd()))
+	cmd.AddCommand(mutating(keepsData(notifyCheckCmd())))
+	cmd.AddCommand(notifyTestCmd())
+
+	return cmd
+}
+
+// notifyDaemonCmd creates the `samedi notify daemon` subcommand.
+func notifyDaemonCmd() *cobra.Command {
+	var noJobs bool
+
+	cmd := &cobra.Command{
+		Use:   "daemon",
+		Short: "Run the reminder daemon in the foreground",
+		Long: `Run the reminder daemon until interrupted.
+
+The daemon also runs the background job worker so queued work (such as
+plans from 'samedi init --background') is processed. Use --no-jobs to
+disable it. It watches the active session too, however it was started,
+and sends the learning.chunk_alert alert and the chunk.time_up event
+when the session reaches its chunk's planned time. With
+learning.reminder_enabled off, only the reminders are skipped.
+
+Run it from your login session, a tmux pane, or a user service manager
+(systemd --user, launchd).`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := getConfig(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+
+			var daemon *notify.Daemon
+			var notifier notify.Notifier
+			if cfg.Learning.ReminderEnabled {
+				daemon, notifier, err = newReminderDaemon(cmd, cfg)
+				if err != nil {
+					return fmt.Errorf("failed to initialize: %w", err)
+				}
+				daemon.OnError(func(err error) {
+					fmt.Fprintf(os.Stderr, "Warning: reminder check failed: %v\n", err)
+				})
+			}
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			// Sessions started anywhere, even with 'samedi start', get
+			// the time-up alert
+			watcher, err := newTimeUpWatcher(cmd, cfg)
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+			if watcher != nil {
+				watcher.OnError(func(err error) {
+					fmt.Fprintf(os.Stderr, "Warning: chunk time check failed: %v\n", err)
+				})
+				go func() { _ = watcher.Run(ctx) }()
+			}
+
+			if daemon == nil && watcher == nil && noJobs {
+				return fmt.Errorf("nothing to run: reminders are disabled, there is no chunk alert or event hook, and --no-jobs skips the job worker")
+			}
+
+			errs := make(chan error, 1)
+			if !noJobs {
+				svc, err := getJobService(cmd)
+				if err != nil {
+					return fmt.Errorf("failed to initialize job worker: %w", err)
+				}
+				go func() { errs <- newJobWorker(cmd, svc).Run(ctx) }()
+			}
+
+			if daemon != nil {
+				fmt.Printf("→ Reminder daemon running (checks at %s via %s, Ctrl+C to stop)\n",
+					strings.Join(cfg.Learning.ReminderTimes, ", "), notifier.Name())
+			} else {
+				fmt.Println("→ Daemon running without reminders (learning.reminder_enabled is off, Ctrl+C to stop)")
+			}
+			if watcher != nil {
+				fmt.Println("→ Watching the active session for its chunk's planned time")
+			}
+			if !noJobs {
+				fmt.Println("→ Job worker running")
+			}
+
+			if daemon != nil {
+				if err := daemon.Run(ctx); err != nil {
+					return fmt.Errorf("daemon failed: %w", err)
+				}
+			} else {
+				<-ctx.Done()
+			}
+
+			if !noJobs {
+				if err := <-errs; err != nil {
+					return fmt.Errorf("job worker failed: %w", err)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&noJobs, "no-jobs", false, "do not run the background job worker")
+
+	return cmd
+}
+
+// notifyCheckCmd creates the `samedi notify check` subcommand.
+func notifyCheckCmd() *cobra.Command {
+	var printOnly bool
+
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Check now and send any due reminders",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := getConfig(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+
+			checker, err := newReminderChecker(cmd, cfg)
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			reminders, err := checker.Check(context.Background(), time.Now())
+			if err != nil {
+				return fmt.Errorf("failed to check reminders: %w", err)
+			}
+
+			if len(reminders) == 0 {
+				fmt.Println("✓ Nothing to remind you about - keep it up!")
+				return nil
+			}
+
+			notifier := notify.DetectNotifier(os.Stdout)
+			for _, r := range reminders {
+				if printOnly {
+					fmt.Printf("• %s: %s\n", r.Title, r.Message)
+					continue
+				}
+				if err := notifier.Notify(r.Title, r.Message); err != nil {
+					return fmt.Errorf("failed to send notification: %w", err)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&printOnly, "print", false, "print due reminders instead of sending notifications")
+
+	return cmd
+}
+
+// notifyTestCmd creates the `samedi notify test` subcommand.
+func notifyTestCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "test",
+		Short: "Send a test notification",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			notifier := notify.DetectNotifier(os.Stdout)
+			if err := notifier.Notify("samedi", "Notifications are working."); err != nil {
+				return fmt.Errorf("failed to send notification via %s: %w", notifier.Name(), err)
+			}
+			fmt.Printf("✓ Test notification sent via %s\n", notifier.Name())
+
+			return nil
+		},
+	}
+}
+
+// newReminderChecker builds a reminder checker from config and session history.
+func newReminderChecker(cmd *cobra.Command, cfg *config.Config) (*notify.Checker, error) {
+	sessionService, err := getSessionService(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	return notify.NewChecker(sessionService, reminderSettings(cfg)), nil
+}
+
+// newReminderDaemon builds the reminder daemon and returns the notifier it uses.
+func newReminderDaemon(cmd *cobra.Command, cfg *config.Config) (*notify.Daemon, notify.Notifier, error) {
+	schedule, err := notify.ParseSchedule(cfg.Learning.ReminderTimes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	checker, err := newReminderChecker(cmd, cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	notifier := notify.DetectNotifier(os.Stdout)
+	return notify.NewDaemon(checker, notifier, schedule), notifier, nil
+}
+
+// reminderSettings maps configuration onto reminder settings.
+func reminderSettings(cfg *config.Config) notify.Settings {
+	return notify.Settings{
+		Message:          cfg.Learning.ReminderMessage,
+		StreakTracking:   cfg.Learning.StreakTracking,
+		Streak:           streakRules(cfg),
+		WeeklyGoalHours:  cfg.Learning.WeeklyGoalHours,
+		WeekStartsSunday: cfg.TUI.FirstDayOfWeek == "sunday",
+	}
+}
+
+// timeUpAlert builds what announces a session reaching its chunk's
+// planned duration: the learning.chunk_alert bell or notification, rung
+// on bell, and a chunk.time_up event for webhooks and
+// hooks.chunk_time_up. It returns nil when there is neither.
+func timeUpAlert(cmd *cobra.Command, cfg *config.Config, bell io.Writer) func(context.Context, notify.TimeUp) error {
+	var alert *notify.ChunkAlert
+	if cfg.Learning.ChunkAlert != "" && cfg.Learning.ChunkAlert != config.ChunkAlertOff {
+		alert = notify.NewChunkAlert(cfg.Learning.ChunkAlert, bell)
+	}
+	bus := newEventBus(cmd, cfg)
+	if alert == nil && bus == nil {
+		return nil
+	}
+	return func(ctx context.Context, t notify.TimeUp) error {
+		bus.Emit(ctx, t.Event())
+		if alert == nil {
+			return nil
+		}
+		return alert.Send(ctx, t)
+	}
+}
+
+// chunkAlerter builds the time-up alert for the TUI timers, or nil when
+// there is none. The bell goes to stderr so it stays off the screen a TUI
+// draws on stdout.
+func chunkAlerter(cmd *cobra.Command, cfg *config.Config) tui.ChunkAlerter {
+	alert := timeUpAlert(cmd, cfg, os.Stderr)
+	if alert == nil {
+		return nil
+	}
+	return func(sessionID, planID string, chunk plan.Chunk) error {
+		return alert(context.Background(), notify.TimeUp{
+			SessionID:  sessionID,
+			PlanID:     planID,
+			ChunkID:    chunk.ID,
+			ChunkTitle: chunk.Title,
+			Planned:    chunk.Duration,
+		})
+	}
+}
+
+// newTimeUpWatcher builds the daemon's watch on the active session, or
+// returns nil when there is no time-up alert to send.
+func newTimeUpWatcher(cmd *cobra.Command, cfg *config.Config) (*notify.TimeUpWatcher, error) {
+	alert := timeUpAlert(cmd, cfg, os.Stdout)
+	if alert == nil {
+		return nil, nil
+	}
+	sessionService, err := getSessionService(cmd)
+	if err != nil {
+		return nil, err
+	}
+	planService, err := getPlanService(cmd, "")
+	if err != nil {
+		return nil, err
+	}
+	return notify.NewTimeUpWatcher(sessionService, planService, alert), nil
+}
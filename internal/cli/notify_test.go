@@ -0,0 +1,61 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotifyCmd_Structure(t *testing.T) {
+	cmd := notifyCmd()
+
+	assert.Equal(t, "notify", cmd.Use)
+	assert.NotEmpty(t, cmd.Short)
+	assert.Contains(t, cmd.Long, "reminder_times")
+
+	names := make(map[string]bool)
+	for _, sub := range cmd.Commands() {
+		names[sub.Name()] = true
+	}
+	assert.True(t, names["daemon"], "Should have daemon subcommand")
+	assert.True(t, names["check"], "Should have check subcommand")
+	assert.True(t, names["test"], "Should have test subcommand")
+}
+
+func TestNotifyDaemonCmd_NoJobsFlag(t *testing.T) {
+	flag := notifyDaemonCmd().Flags().Lookup("no-jobs")
+	require.NotNil(t, flag)
+	assert.Equal(t, "false", flag.DefValue)
+}
+
+func TestNotifyDaemonCmd_NothingToRun(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	cfg := config.DefaultConfig()
+	cfg.Learning.ReminderEnabled = false
+	cfg.Learning.ChunkAlert = config.ChunkAlertOff
+	require.NoError(t, config.Save(cfg))
+
+	cmd := notifyDaemonCmd()
+	cmd.SetArgs([]string{"--no-jobs"})
+	cmd.SilenceUsage, cmd.SilenceErrors = true, true
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "nothing to run")
+}
+
+func TestReminderSettings(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Learning.WeeklyGoalHours = 5
+	cfg.TUI.FirstDayOfWeek = "sunday"
+
+	settings := reminderSettings(cfg)
+	assert.Equal(t, cfg.Learning.ReminderMessage, settings.Message)
+	assert.True(t, settings.StreakTracking)
+	assert.Equal(t, 5, settings.WeeklyGoalHours)
+	assert.True(t, settings.WeekStartsSunday)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/export"
+	"github.com/pezware/samedi.dev/internal/obsidian"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/spf13/cobra"
+)
+
+// obsidianCmd creates the `samedi obsidian` command group.
+func obsidianCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "obsidian",
+		Short: "Mirror plans and sessions into an Obsidian vault",
+		Long: `Mirror plans, chunks, and session notes into an Obsidian vault as
+linked markdown notes, so the learning graph can be browsed in Obsidian.
+
+Notes are written under <vault_path>/<folder> in three folders:
+  Plans/      one note per plan, linking its chunks and sessions
+  Chunks/     one note per chunk, linking its plan and sessions
+  Sessions/   one note per completed session with notes and artifacts
+
+Once obsidian.vault_path is set, the vault is refreshed automatically
+after 'init', 'stop', and background plan jobs. Notes samedi wrote for
+deleted plans or sessions are removed; other notes are left alone.
+
+Examples:
+  samedi config set obsidian.vault_path ~/Documents/Vault
+  samedi obsidian sync               # Refresh every note now`,
+	}
+
+	cmd.AddCommand(mutating(keepsData(obsidianSyncCmd())))
+
+	return cmd
+}
+
+// obsidianSyncCmd creates the `samedi obsidian sync` subcommand.
+func obsidianSyncCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "sync",
+		Short: "Write all plans and sessions to the Obsidian vault",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := getConfig(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			if cfg.Obsidian.VaultPath == "" {
+				return fmt.Errorf("no Obsidian vault configured; run 'samedi config set obsidian.vault_path <path>'")
+			}
+
+			vault := obsidian.NewVault(obsidianVaultDir(cfg))
+			result, err := mirrorVault(cmd, vault)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("✓ Mirrored %d plan(s) and %d session(s) to %s\n", result.Plans, result.Sessions, vault.Dir())
+			fmt.Printf("  %d note(s) written, %d removed\n", result.Written, result.Removed)
+			return nil
+		},
+	}
+}
+
+// obsidianVaultDir returns the directory inside the vault owned by samedi.
+func obsidianVaultDir(cfg *config.Config) string {
+	return filepath.Join(export.ExpandHome(cfg.Obsidian.VaultPath), cfg.Obsidian.Folder)
+}
+
+// mirrorVault loads every plan and session and mirrors them into vault.
+// Plans whose files cannot be read are skipped.
+func mirrorVault(cmd *cobra.Command, vault *obsidian.Vault) (*obsidian.Result, error) {
+	ctx := context.Background()
+
+	planService, err := getPlanService(cmd, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize plan service: %w", err)
+	}
+	sessionService, err := getSessionService(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize session service: %w", err)
+	}
+
+	records, err := planService.List(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	plans := make([]*plan.Plan, 0, len(records))
+	for _, record := range records {
+		if p, err := planService.Get(ctx, record.ID); err == nil {
+			plans = append(plans, p)
+		}
+	}
+
+	sessions, err := sessionService.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	return vault.Mirror(plans, sessions)
+}
+
+// autoMirror refreshes the Obsidian vault when obsidian.vault_path is set.
+// Failures are reported as warnings and never fail the command.
+func autoMirror(cmd *cobra.Command) {
+	cfg, err := getConfig(cmd)
+	if err != nil || cfg.Obsidian.VaultPath == "" {
+		return
+	}
+
+	if _, err := mirrorVault(cmd, obsidian.NewVault(obsidianVaultDir(cfg))); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Obsidian mirror failed: %v\n", err)
+	}
+}
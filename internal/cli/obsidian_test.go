@@ -0,0 +1,47 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObsidianCmd_Structure(t *testing.T) {
+	cmd := obsidianCmd()
+
+	names := make([]string, 0)
+	for _, sub := range cmd.Commands() {
+		names = append(names, sub.Name())
+	}
+	assert.ElementsMatch(t, []string{"sync"}, names)
+}
+
+func TestObsidianVaultDir(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skip("no home directory")
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.Obsidian.VaultPath = "~/Vault"
+	assert.Equal(t, filepath.Join(home, "Vault", "Samedi"), obsidianVaultDir(cfg))
+
+	cfg.Obsidian.VaultPath = "/data/vault"
+	cfg.Obsidian.Folder = "Learning/Samedi"
+	assert.Equal(t, filepath.Join("/data/vault", "Learning", "Samedi"), obsidianVaultDir(cfg))
+}
+
+func TestAutoMirror_DisabledWithoutVault(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	autoMirror(obsidianCmd())
+
+	_, err := os.Stat(filepath.Join(os.Getenv("HOME"), "Samedi"))
+	assert.True(t, os.IsNotExist(err))
+}
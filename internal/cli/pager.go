@@ -0,0 +1,130 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
+	"golang.org/x/term"
+)
+
+// pageOutput writes text to stdout, through a pager when stdout is a
+// terminal too short to show it all: $PAGER when set, else the built-in
+// one. With noPager, or off a terminal, text is printed as is.
+func pageOutput(title, text string, noPager bool) error {
+	fd := int(os.Stdout.Fd())
+	if noPager || !term.IsTerminal(fd) || !term.IsTerminal(int(os.Stdin.Fd())) {
+		_, err := io.WriteString(os.Stdout, text)
+		return err
+	}
+	_, height, err := term.GetSize(fd)
+	if err != nil || strings.Count(text, "\n") < height {
+		_, err := io.WriteString(os.Stdout, text)
+		return err
+	}
+
+	if pager := strings.TrimSpace(os.Getenv("PAGER")); pager != "" {
+		return runExternalPager(pager, text)
+	}
+	if _, err := tea.NewProgram(newTextPager(title, text), tea.WithAltScreen()).Run(); err != nil {
+		return fmt.Errorf("failed to run pager: %w", err)
+	}
+	return nil
+}
+
+// runExternalPager pipes text into pager, which may carry arguments, as
+// in "less -S". less is told to pass colors through unless $LESS says
+// otherwise.
+func runExternalPager(pager, text string) error {
+	fields := strings.Fields(pager)
+	cmd := exec.Command(fields[0], fields[1:]...) // #nosec G204 - the user's own $PAGER
+	cmd.Stdin = strings.NewReader(text)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = os.Environ()
+	if os.Getenv("LESS") == "" {
+		cmd.Env = append(cmd.Env, "LESS=FRX")
+	}
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to run pager %q: %w", fields[0], err)
+	}
+	return nil
+}
+
+// textPager is the built-in pager: ↑/↓ (or j/k) scroll a line, space and
+// b (or PgDn/PgUp) a page, g/G jump to the ends, and q or esc quits.
+type textPager struct {
+	title  string
+	lines  []string
+	offset int
+	height int // Terminal rows, one of them the status line
+}
+
+func newTextPager(title, text string) textPager {
+	return textPager{
+		title: title,
+		lines: strings.Split(strings.TrimRight(text, "\n"), "\n"),
+	}
+}
+
+func (m textPager) Init() tea.Cmd {
+	return nil
+}
+
+func (m textPager) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.height = msg.Height
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "esc", "ctrl+c":
+			return m, tea.Quit
+		case "up", "k":
+			m.offset--
+		case "down", "j", "enter":
+			m.offset++
+		case "pgup", "b":
+			m.offset -= m.page()
+		case "pgdown", " ", "f":
+			m.offset += m.page()
+		case "home", "g":
+			m.offset = 0
+		case "end", "G":
+			m.offset = len(m.lines)
+		}
+	}
+	m.offset = max(0, min(m.offset, len(m.lines)-m.page()))
+	return m, nil
+}
+
+// page is how many lines fit above the status line.
+func (m textPager) page() int {
+	return max(1, m.height-1)
+}
+
+func (m textPager) View() string {
+	if m.height == 0 {
+		return ""
+	}
+	end := min(m.offset+m.page(), len(m.lines))
+
+	var b strings.Builder
+	for _, line := range m.lines[m.offset:end] {
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+	for i := end - m.offset; i < m.page(); i++ {
+		b.WriteString("~\n")
+	}
+	status := fmt.Sprintf("%s  lines %d-%d of %d (%d%%)  ↑/↓ scroll · space page · q quit",
+		m.title, m.offset+1, end, len(m.lines), end*100/len(m.lines))
+	b.WriteString(styles.Muted().Render(status))
+	return b.String()
+}
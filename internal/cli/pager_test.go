@@ -0,0 +1,62 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+)
+
+func pagerKey(m textPager, msg tea.Msg) textPager {
+	next, _ := m.Update(msg)
+	return next.(textPager)
+}
+
+func runeKey(key string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+}
+
+func TestTextPager_Scrolls(t *testing.T) {
+	lines := make([]string, 25)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("line %d", i+1)
+	}
+	m := newTextPager("rust/chunk-001", strings.Join(lines, "\n")+"\n")
+	m = pagerKey(m, tea.WindowSizeMsg{Width: 80, Height: 11})
+
+	view := m.View()
+	assert.True(t, strings.HasPrefix(view, "line 1\n"))
+	assert.Contains(t, view, "line 10\n")
+	assert.NotContains(t, view, "line 11\n")
+	assert.Contains(t, view, "rust/chunk-001  lines 1-10 of 25 (40%)")
+
+	m = pagerKey(m, tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, 1, m.offset)
+	m = pagerKey(m, runeKey(" "))
+	assert.Equal(t, 11, m.offset)
+	m = pagerKey(m, runeKey(" "))
+	assert.Equal(t, 15, m.offset, "paging stops at the last full page")
+	assert.Contains(t, m.View(), "lines 16-25 of 25 (100%)")
+
+	m = pagerKey(m, runeKey("g"))
+	assert.Equal(t, 0, m.offset)
+	m = pagerKey(m, tea.KeyMsg{Type: tea.KeyUp})
+	assert.Equal(t, 0, m.offset)
+	m = pagerKey(m, runeKey("G"))
+	assert.Equal(t, 15, m.offset)
+
+	_, cmd := m.Update(runeKey("q"))
+	assert.NotNil(t, cmd)
+}
+
+func TestTextPager_ShortText(t *testing.T) {
+	m := pagerKey(newTextPager("notes", "one\ntwo"), tea.WindowSizeMsg{Width: 80, Height: 5})
+	m = pagerKey(m, runeKey("G"))
+	assert.Equal(t, 0, m.offset)
+	assert.True(t, strings.HasPrefix(m.View(), "one\ntwo\n~\n~\n"), "rows past the end are marked")
+}
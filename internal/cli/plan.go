@@ -7,12 +7,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
-	"text/tabwriter"
 	"time"
 
 	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/stats"
 	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/pezware/samedi.dev/internal/tui/components"
 	"github.com/spf13/cobra"
 )
 
@@ -31,14 +34,33 @@ Examples:
   samedi plan list --status in-progress
   samedi plan show rust-async         # Show plan details
   samedi plan edit rust-async         # Edit in $EDITOR
-  samedi plan archive french-b1       # Archive completed plan`,
+  samedi plan archive french-b1       # Archive completed plan
+  samedi plan reindex                 # Rebuild index from markdown
+  samedi plan validate                # Check files for ignored lines
+  samedi plan recalc rust-async       # Recompute total hours from chunks
+  samedi plan history rust-async      # List saved versions
+  samedi plan diff rust-async --from v1 --to v3
+  samedi plan link backend go-deep-dive  # Nest a plan under another
+  samedi plan week --hours 6          # Commit to this week's chunks`,
 	}
 
 	// Add subcommands
 	cmd.AddCommand(planListCmd())
 	cmd.AddCommand(planShowCmd())
-	cmd.AddCommand(planEditCmd())
-	cmd.AddCommand(planArchiveCmd())
+	cmd.AddCommand(planChunksCmd())
+	cmd.AddCommand(mutating(planEditCmd()))
+	cmd.AddCommand(mutating(planImportCmd()))
+	cmd.AddCommand(planExportCmd())
+	cmd.AddCommand(mutating(planArchiveCmd()))
+	cmd.AddCommand(mutating(planUnarchiveCmd()))
+	cmd.AddCommand(mutating(planReindexCmd()))
+	cmd.AddCommand(planValidateCmd())
+	cmd.AddCommand(mutating(planRecalcCmd()))
+	cmd.AddCommand(planHistoryCmd())
+	cmd.AddCommand(planDiffCmd())
+	cmd.AddCommand(mutating(planLinkCmd()))
+	cmd.AddCommand(mutating(planUnlinkCmd()))
+	cmd.AddCommand(mutating(planWeekCmd()))
 
 	return cmd
 }
@@ -47,9 +69,13 @@ Examples:
 func planListCmd() *cobra.Command {
 	var (
 		statusFilter string
-		tagFilter    string
+		tagFilter    []string
+		tagMode      string
 		sortBy       string
 		showAll      bool
+		plain        bool
+		tree         bool
+		dueSoon      bool
 	)
 
 	cmd := &cobra.Command{
@@ -60,17 +86,37 @@ func planListCmd() *cobra.Command {
 By default, archived plans are hidden. Use --all to show all plans
 including archived ones, or --status archived to show only archived plans.
 
+The table shows progress bars, colored statuses, and when each plan was
+last studied. Use --plain for simple tab-aligned output in scripts, and
+--tree to show sub-plans indented beneath their parent plans.
+
+--due-soon lists unfinished plans whose deadline is within two weeks or
+already past, most urgent first, with the days left on each.
+
+--sort orders the list by progress, hours, activity (last studied),
+deadline, created, updated, title, or status. Add :asc or :desc to pick
+the direction; plans without progress, sessions, or a deadline stay last
+either way. Without --sort, the list uses tui.plan_sort, which the sort
+keys of 'samedi ui' save.
+
 Examples:
   samedi plan list                     # Active plans only
   samedi plan list --all               # Include archived plans
   samedi plan list --status archived   # Only archived plans
   samedi plan list --status in-progress
   samedi plan list --tag language
+  samedi plan list --tag rust --tag async     # Tagged with both
+  samedi plan list --tag rust --tag go --tag-mode or
+  samedi plan list --plain             # Script-friendly table
+  samedi plan list --tree              # Sub-plans under their parents
+  samedi plan list --due-soon          # Deadlines in the next two weeks
+  samedi plan list --sort progress     # Furthest along first
+  samedi plan list --sort hours:asc    # Least time logged first
   samedi plan list --json`,
-		Run: func(cmd *cobra.Command, _ []string) {
+		RunE: func(cmd *cobra.Command, _ []string) error {
 			svc, err := getPlanService(cmd, "")
 			if err != nil {
-				exitWithError("Failed to initialize: %v", err)
+				return fmt.Errorf("failed to initialize: %w", err)
 			}
 
 			// Build filter
@@ -85,65 +131,118 @@ Examples:
 					string(plan.StatusCompleted),
 				}
 			}
-			if tagFilter != "" {
-				filter.Tag = tagFilter
+			if len(tagFilter) > 0 {
+				filter.Tags = tagFilter
+				switch tagMode {
+				case "and":
+				case "or":
+					filter.AnyTag = true
+				default:
+					return &usageError{command: cmd.CommandPath(), err: fmt.Errorf("invalid --tag-mode %q (must be and or or)", tagMode)}
+				}
+			}
+			// Without --sort, the last sort used in the TUI applies
+			if sortBy == "" && !dueSoon {
+				if cfg, err := getConfig(cmd); err == nil {
+					sortBy = cfg.TUI.PlanSort
+				}
+			}
+			listSort, err := plan.ParseListSort(sortBy)
+			if err != nil {
+				return &usageError{command: cmd.CommandPath(), err: fmt.Errorf("invalid --sort: %w", err)}
 			}
-			if sortBy != "" {
-				filter.SortBy = sortBy
+			if dueSoon {
+				applyDueSoon(filter, statusFilter == "", sortBy == "", time.Now())
 			}
 
 			// Get plans
 			plans, err := svc.List(context.Background(), filter)
 			if err != nil {
-				exitWithError("Failed to list plans: %v", err)
+				return fmt.Errorf("failed to list plans: %w", err)
 			}
+			sortPlanRecords(context.Background(), svc, plans, listSort)
 
 			// Check for JSON output
 			jsonOutput, err := cmd.Flags().GetBool("json")
 			if err != nil {
-				exitWithError("Failed to get json flag: %v", err)
+				return fmt.Errorf("failed to get json flag: %w", err)
 			}
 			if jsonOutput {
 				data, err := json.MarshalIndent(plans, "", "  ")
 				if err != nil {
-					exitWithError("Failed to marshal JSON: %v", err)
+					return fmt.Errorf("failed to marshal JSON: %w", err)
 				}
 				fmt.Println(string(data))
-				return
+				return nil
 			}
 
 			// Table output
 			if len(plans) == 0 {
 				fmt.Println("No plans found.")
 				fmt.Println("\nCreate a plan: samedi init <topic>")
-				return
+				return nil
 			}
 
-			// Print table
-			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-			fmt.Fprintln(w, "ID\tTITLE\tSTATUS\tPROGRESS\tHOURS")
+			// Tree order puts sub-plans beneath their parents
+			prefixes := make([]string, len(plans))
+			if tree {
+				treeRows := plan.Tree(plans)
+				plans = make([]*storage.PlanRecord, len(treeRows))
+				prefixes = make([]string, len(treeRows))
+				for i, row := range treeRows {
+					plans[i] = row.Record
+					prefixes[i] = row.Prefix
+				}
+			}
 
-			for _, record := range plans {
-				// Calculate progress by loading full plan
-				progress := calculateProgress(svc, record.ID)
+			if !plain {
+				rows := buildPlanListRows(context.Background(), svc, plans)
+				for i := range rows {
+					rows[i].Prefix = prefixes[i]
+				}
+				renderPlanList(os.Stdout, rows, time.Now(), newPlanListStyles(colorEnabled()))
+				return nil
+			}
+
+			// Print plain table
+			w := components.NewTabWriter(os.Stdout, 2)
+			if dueSoon {
+				fmt.Fprintln(w, "ID\tTITLE\tSTATUS\tPROGRESS\tHOURS\tDUE")
+			} else {
+				fmt.Fprintln(w, "ID\tTITLE\tSTATUS\tPROGRESS\tHOURS")
+			}
 
-				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%.1fh\n",
-					record.ID,
+			now := time.Now()
+			for i, record := range plans {
+				progress := calculateProgress(svc, record)
+
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%.1fh",
+					prefixes[i]+record.ID,
 					truncate(record.Title, 40),
 					formatStatus(record.Status),
 					progress,
 					record.TotalHours,
 				)
+				if dueSoon {
+					fmt.Fprintf(w, "\t%s", formatDue(record.Deadline, now))
+				}
+				fmt.Fprintln(w)
 			}
 
 			w.Flush()
+
+			return nil
 		},
 	}
 
 	cmd.Flags().StringVar(&statusFilter, "status", "", "filter by status (not-started, in-progress, completed, archived)")
-	cmd.Flags().StringVar(&tagFilter, "tag", "", "filter by tag")
-	cmd.Flags().StringVar(&sortBy, "sort", "", "sort by field (created, updated, title, status, hours)")
+	cmd.Flags().StringArrayVar(&tagFilter, "tag", nil, "filter by tag (repeatable)")
+	cmd.Flags().StringVar(&tagMode, "tag-mode", "and", "with several --tag flags: and (every tag) or or (any tag)")
+	cmd.Flags().StringVar(&sortBy, "sort", "", "sort by progress, hours, activity, deadline, created, updated, title, or status, with an optional :asc or :desc")
 	cmd.Flags().BoolVar(&showAll, "all", false, "show all plans including archived")
+	cmd.Flags().BoolVar(&plain, "plain", false, "plain tab-aligned output without bars or colors")
+	cmd.Flags().BoolVar(&tree, "tree", false, "show sub-plans indented beneath their parent plans")
+	cmd.Flags().BoolVar(&dueSoon, "due-soon", false, "only unfinished plans due within two weeks or overdue, most urgent first")
 
 	return cmd
 }
@@ -166,35 +265,23 @@ func formatStatus(status string) string {
 	}
 }
 
-// truncate truncates a string to a maximum length with ellipsis.
+// truncate shortens s to maxLen terminal columns with an ellipsis, so
+// CJK and emoji titles keep their columns aligned.
 func truncate(s string, maxLen int) string {
-	if len(s) <= maxLen {
-		return s
-	}
-	return s[:maxLen-3] + "..."
+	return components.Truncate(s, maxLen)
 }
 
 // calculateProgress computes progress as "percentage (completed/total)".
-// Returns "-" if the plan cannot be loaded or has no chunks.
-func calculateProgress(svc *plan.Service, planID string) string {
-	// Load full plan to get chunks
-	p, err := svc.Get(context.Background(), planID)
-	if err != nil {
+// Returns "-" if the plan cannot be loaded.
+func calculateProgress(svc *plan.Service, record *storage.PlanRecord) string {
+	completedChunks, totalChunks, ok := chunkProgress(context.Background(), svc, record)
+	if !ok {
 		return "-"
 	}
-
-	totalChunks := len(p.Chunks)
 	if totalChunks == 0 {
 		return "0% (0/0)"
 	}
 
-	completedChunks := 0
-	for _, chunk := range p.Chunks {
-		if chunk.Status == plan.StatusCompleted {
-			completedChunks++
-		}
-	}
-
 	percentage := int(float64(completedChunks) / float64(totalChunks) * 100)
 	return fmt.Sprintf("%d%% (%d/%d)", percentage, completedChunks, totalChunks)
 }
@@ -222,25 +309,34 @@ Examples:
   samedi plan show french-b1 --sessions
   samedi plan show french-b1 --cards`,
 		Args: cobra.ExactArgs(1),
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			planID := args[0]
 
 			svc, err := getPlanService(cmd, "")
 			if err != nil {
-				exitWithError("Failed to initialize: %v", err)
+				return fmt.Errorf("failed to initialize: %w", err)
 			}
 
 			// Get plan
-			plan, err := svc.Get(context.Background(), planID)
+			plan, warnings, err := svc.GetWithWarnings(context.Background(), planID)
 			if err != nil {
-				exitWithError("Failed to get plan: %v", err)
+				return fmt.Errorf("failed to get plan: %w", err)
 			}
 
 			// Display plan details
 			displayPlanSummary(plan)
+			displayPlanForecast(os.Stdout, svc, plan)
+			displaySubPlans(os.Stdout, svc, plan)
 			displayPlanExtras(svc, planID, showSessions, showCards)
 			displayPlanChunks(plan, showChunks)
 			displayNextSteps(plan, planID)
+
+			if len(warnings) > 0 {
+				fmt.Fprintln(os.Stderr)
+				printParseWarnings(os.Stderr, planID, warnings)
+			}
+
+			return nil
 		},
 	}
 
@@ -259,45 +355,64 @@ func planEditCmd() *cobra.Command {
 		Long: `Open a plan file in your configured editor.
 
 After editing, the plan is validated and SQLite metadata is updated.
-If validation fails, you'll be prompted to fix the errors.
+If validation fails, you'll be prompted to fix the errors. Lines the
+parser could not understand are listed; the file is then left exactly
+as written (only the index is refreshed) so nothing is lost.
 
 Examples:
   samedi plan edit rust-async
   EDITOR=nano samedi plan edit french-b1`,
 		Args: cobra.ExactArgs(1),
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			planID := args[0]
 
 			svc, err := getPlanService(cmd, "")
 			if err != nil {
-				exitWithError("Failed to initialize: %v", err)
+				return fmt.Errorf("failed to initialize: %w", err)
 			}
 
 			// Verify plan exists
 			exists := svc.Exists(context.Background(), planID)
 			if !exists {
-				exitWithError("Plan not found: %s", planID)
+				return fmt.Errorf("plan not found: %s", planID)
 			}
 
 			// Open in editor
-			if err := openPlanInEditor(planID); err != nil {
-				exitWithError("Failed to edit plan: %v", err)
+			if err := openPlanInEditor(svc, planID); err != nil {
+				return fmt.Errorf("failed to edit plan: %w", err)
 			}
 
 			// Reload and validate
-			plan, err := svc.Get(context.Background(), planID)
+			plan, warnings, err := svc.GetWithWarnings(context.Background(), planID)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Warning: Failed to reload plan: %v\n", err)
 				fmt.Fprintf(os.Stderr, "Please check the plan file for errors.\n")
-				return
+				return nil
+			}
+
+			// Rewriting would drop the ignored lines, so only refresh the index
+			if len(warnings) > 0 {
+				printParseWarnings(os.Stderr, planID, warnings)
+				if err := svc.RefreshIndex(context.Background(), planID); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: Failed to update plan metadata: %v\n", err)
+					return nil
+				}
+				fmt.Printf("✓ Plan indexed: %s (file left unchanged; fix with 'samedi plan edit %s')\n", plan.Title, planID)
+				return nil
 			}
 
 			// Update metadata
+			previousHours := plan.TotalHours
 			if err := svc.Update(context.Background(), plan); err != nil {
 				fmt.Fprintf(os.Stderr, "Warning: Failed to update plan metadata: %v\n", err)
 			} else {
 				fmt.Printf("✓ Plan updated: %s\n", plan.Title)
+				if plan.TotalHours != previousHours {
+					fmt.Printf("  Total hours recalculated from chunks: %g → %g\n", previousHours, plan.TotalHours)
+				}
 			}
+
+			return nil
 		},
 	}
 
@@ -363,6 +478,36 @@ func displayPlanSummary(plan *plan.Plan) {
 		fmt.Printf(" | Tags: %v", plan.Tags)
 	}
 	fmt.Println()
+	if done, total := plan.ReadingProgress(); total > 0 {
+		fmt.Printf("Reading list: %d/%d done (samedi resources %s)\n", done, total, plan.ID)
+	}
+	if plan.Provenance != nil {
+		fmt.Printf("Generated by: %s\n", plan.Provenance)
+		if plan.Provenance.Style != "" {
+			fmt.Printf("Style: %s\n", plan.Provenance.Style)
+		}
+	}
+}
+
+// displayPlanForecast prints the projected completion of p for
+// `plan show`. Nothing is printed for finished plans.
+func displayPlanForecast(w io.Writer, svc *plan.Service, p *plan.Plan) {
+	sessions, err := svc.PlanSessions(context.Background(), p.ID)
+	if err != nil {
+		return
+	}
+	forecast := stats.CalculateForecast(p, sessions, time.Now())
+	if forecast == nil {
+		return
+	}
+
+	fmt.Fprintf(w, "Est. completion: %s\n", forecast.Summary())
+	if deadline := forecast.DeadlineSummary(); deadline != "" {
+		fmt.Fprintf(w, "Deadline: %s\n", deadline)
+	}
+	if warning := forecast.Warning(); warning != "" {
+		fmt.Fprintln(w, warning)
+	}
 }
 
 // displaySessionSummary formats and displays a single session from the session map.
@@ -401,6 +546,9 @@ func displaySessionSummary(sess map[string]interface{}) {
 	if notes, ok := sess["notes"].(string); ok && notes != "" {
 		fmt.Printf("    Notes: %s\n", notes)
 	}
+	if reflection, ok := sess["reflection"].(*session.Reflection); ok {
+		writeReflection(os.Stdout, "    ", reflection)
+	}
 }
 
 // displayPlanExtras shows session history and flashcard count.
@@ -485,7 +633,10 @@ func displayNextSteps(p *plan.Plan, planID string) {
 
 // planArchiveCmd creates the `samedi plan archive` subcommand.
 func planArchiveCmd() *cobra.Command {
-	var skipConfirm bool
+	var (
+		skipConfirm bool
+		move        bool
+	)
 
 	cmd := &cobra.Command{
 		Use:   "archive <plan-id>",
@@ -495,23 +646,26 @@ func planArchiveCmd() *cobra.Command {
 Archived plans are hidden from default listings but can still be viewed
 with 'samedi plan list --status archived'.
 
+With --move (or storage.archive_plan_files = true) the plan file is also
+moved to ~/.samedi/plans/archive/. Restore it with 'samedi plan unarchive'.
+
 Examples:
   samedi plan archive french-b1
-  samedi plan archive rust-async
-  samedi plan archive french-b1 --yes  # Skip confirmation`,
+  samedi plan archive rust-async --move  # Move the file to plans/archive
+  samedi plan archive french-b1 --yes    # Skip confirmation`,
 		Args: cobra.ExactArgs(1),
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			planID := args[0]
 
 			svc, err := getPlanService(cmd, "")
 			if err != nil {
-				exitWithError("Failed to initialize: %v", err)
+				return fmt.Errorf("failed to initialize: %w", err)
 			}
 
 			// Get plan
 			p, err := svc.Get(context.Background(), planID)
 			if err != nil {
-				exitWithError("Failed to get plan: %v", err)
+				return fmt.Errorf("failed to get plan: %w", err)
 			}
 
 			// Confirmation prompt (unless --yes flag)
@@ -523,22 +677,155 @@ Examples:
 				var input string
 				if _, err := fmt.Scanln(&input); err != nil || input != planID {
 					fmt.Println("✗ Archive canceled")
-					os.Exit(0)
+					return nil
+				}
+			}
+
+			if !cmd.Flags().Changed("move") {
+				if cfg, err := getConfig(cmd); err == nil {
+					move = cfg.Storage.ArchivePlanFiles
 				}
 			}
 
 			// Update status to archived
-			p.Status = plan.StatusArchived
-			if err := svc.Update(context.Background(), p); err != nil {
-				exitWithError("Failed to archive plan: %v", err)
+			p, err = svc.Archive(context.Background(), planID, move)
+			if err != nil {
+				return fmt.Errorf("failed to archive plan: %w", err)
 			}
 
 			fmt.Printf("✓ Plan archived: %s\n", p.Title)
+			if move {
+				fmt.Printf("  Moved to: %s\n", svc.FilePath(planID))
+			}
 			fmt.Printf("  View archived plans: samedi plan list --status archived\n")
+
+			autoCommit(cmd, "samedi: plan archived: "+planID)
+			autoMirror(cmd)
+
+			return nil
 		},
 	}
 
 	cmd.Flags().BoolVar(&skipConfirm, "yes", false, "skip confirmation prompt")
+	cmd.Flags().BoolVar(&move, "move", false, "move the plan file to plans/archive (default from storage.archive_plan_files)")
 
 	return cmd
 }
+
+// planUnarchiveCmd creates the `samedi plan unarchive` subcommand.
+func planUnarchiveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "unarchive <plan-id>",
+		Short: "Restore an archived plan",
+		Long: `Restore an archived plan. Its file is moved back from
+~/.samedi/plans/archive/ if it was moved there, and its status is set
+from its chunks again (not-started, in-progress, or completed).
+
+Examples:
+  samedi plan unarchive french-b1`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			planID := args[0]
+
+			svc, err := getPlanService(cmd, "")
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			p, err := svc.Unarchive(context.Background(), planID)
+			if err != nil {
+				return fmt.Errorf("failed to unarchive plan: %w", err)
+			}
+
+			fmt.Printf("✓ Plan restored: %s (%s)\n", p.Title, p.Status)
+
+			autoCommit(cmd, "samedi: plan unarchived: "+planID)
+			autoMirror(cmd)
+
+			return nil
+		},
+	}
+}
+
+// planReindexCmd creates the `samedi plan reindex` subcommand.
+func planReindexCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "reindex",
+		Short: "Rebuild the plan index from markdown files",
+		Long: `Rebuild the SQLite plan index from the markdown files in ~/.samedi/plans/.
+
+Markdown is the source of truth. Reindex inserts plans missing from the
+index, updates metadata that drifted (e.g., after editing a file by hand,
+syncing, or restoring a backup), and removes entries whose file is gone.
+Session history is never deleted.
+
+Examples:
+  samedi plan reindex
+  samedi plan reindex --json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			svc, err := getPlanService(cmd, "")
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			result, err := svc.Reindex(context.Background())
+			if err != nil {
+				return fmt.Errorf("failed to reindex plans: %w", err)
+			}
+
+			jsonOutput, err := cmd.Flags().GetBool("json")
+			if err != nil {
+				return fmt.Errorf("failed to get json flag: %w", err)
+			}
+			if jsonOutput {
+				data, err := json.MarshalIndent(result, "", "  ")
+				if err != nil {
+					return fmt.Errorf("failed to marshal JSON: %w", err)
+				}
+				fmt.Println(string(data))
+			} else {
+				displayReindexResult(result)
+			}
+
+			if len(result.Removed) > 0 {
+				if cfg, err := getConfig(cmd); err == nil {
+					autoVacuum(cfg)
+				}
+			}
+
+			if len(result.Failed) > 0 {
+				return &reportedError{code: ExitError, msg: "some plan files failed to index"}
+			}
+
+			return nil
+		},
+	}
+}
+
+// displayReindexResult prints the changes made by a reindex.
+func displayReindexResult(result *plan.ReindexResult) {
+	for _, id := range result.Added {
+		fmt.Printf("  + %s\n", id)
+	}
+	for _, id := range result.Updated {
+		fmt.Printf("  ~ %s\n", id)
+	}
+	for _, id := range result.Removed {
+		fmt.Printf("  - %s\n", id)
+	}
+	for _, failure := range result.Failed {
+		fmt.Fprintf(os.Stderr, "  ✗ %s: %s\n", failure.ID, failure.Err)
+	}
+
+	fmt.Printf("✓ Reindex complete: %d added, %d updated, %d removed, %d unchanged",
+		len(result.Added), len(result.Updated), len(result.Removed), len(result.Unchanged))
+	if len(result.Failed) > 0 {
+		fmt.Printf(", %d failed", len(result.Failed))
+	}
+	fmt.Println()
+
+	if len(result.Failed) > 0 {
+		fmt.Fprintln(os.Stderr, "\nFix the files above and run 'samedi plan reindex' again.")
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+	"github.com/spf13/cobra"
+)
+
+// planChunksCmd creates the `samedi plan chunks` subcommand.
+func planChunksCmd() *cobra.Command {
+	var (
+		statuses []string
+		planIDs  []string
+		showAll  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "chunks",
+		Short: "List chunks across plans",
+		Long: `List chunks from every plan, read from the SQLite index rather than the
+plan files. Chunks of archived plans are hidden unless --all is given.
+
+Plans indexed before chunks were tracked are missing until
+'samedi plan reindex' has run once.
+
+Examples:
+  samedi plan chunks --status in-progress
+  samedi plan chunks --plan rust-async --plan go-basics
+  samedi plan chunks --status not-started --json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			filter := &plan.ChunkFilter{PlanIDs: planIDs, IncludeArchived: showAll}
+			for _, status := range statuses {
+				s := plan.Status(status)
+				if !s.IsValid() || s == plan.StatusArchived {
+					return &usageError{command: cmd.CommandPath(), err: fmt.Errorf("invalid --status %q (must be not-started, in-progress, completed, or skipped)", status)}
+				}
+				filter.Statuses = append(filter.Statuses, s)
+			}
+
+			svc, err := getPlanService(cmd, "")
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+			chunks, err := svc.ListChunks(context.Background(), filter)
+			if err != nil {
+				return fmt.Errorf("failed to list chunks: %w", err)
+			}
+
+			jsonOutput, err := cmd.Flags().GetBool("json")
+			if err != nil {
+				return fmt.Errorf("failed to get json flag: %w", err)
+			}
+			if jsonOutput {
+				if err := printJSON(chunks); err != nil {
+					return err
+				}
+				return nil
+			}
+
+			if len(chunks) == 0 {
+				fmt.Println("No chunks found.")
+				return nil
+			}
+			printChunkList(os.Stdout, chunks)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&statuses, "status", nil, "only chunks with this status (repeatable)")
+	cmd.Flags().StringArrayVar(&planIDs, "plan", nil, "only chunks of this plan (repeatable)")
+	cmd.Flags().BoolVar(&showAll, "all", false, "include chunks of archived plans")
+
+	return cmd
+}
+
+// printChunkList writes chunks as a tab-aligned table.
+func printChunkList(w io.Writer, chunks []*plan.IndexedChunk) {
+	tw := components.NewTabWriter(w, 2)
+	fmt.Fprintln(tw, "PLAN\tCHUNK\tTITLE\tSTATUS\tDURATION")
+	for _, c := range chunks {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n",
+			c.PlanID, c.ID, truncate(c.Title, 40), formatStatus(string(c.Status)), formatDuration(c.Duration))
+	}
+	tw.Flush()
+}
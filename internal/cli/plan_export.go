@@ -0,0 +1,139 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/export"
+	"github.com/spf13/cobra"
+)
+
+// planExportCmd creates the `samedi plan export` subcommand.
+func planExportCmd() *cobra.Command {
+	var (
+		format string
+		output string
+		save   bool
+		force  bool
+		notes  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export <plan-id>",
+		Short: "Export a plan as a document to share",
+		Long: `Export a plan as a standalone document to share with a mentor or peers.
+
+The export leaves out the frontmatter samedi keeps for itself and adds
+progress: the plan's completed chunks and logged hours up top, and each
+chunk's status and time logged next to it. Notes gathered from sessions
+are personal, so they are left out unless --notes is given.
+
+Formats:
+  md    Markdown, printed to stdout unless --output or --save is given
+        (default)
+  pdf   PDF, saved like --save unless --output is given; needs pandoc
+        (https://pandoc.org) and a LaTeX engine on PATH
+
+Files are written the way 'samedi report' writes them: --save and
+directories get a name from export.export_filename in export.dir (or the
+directory), and an existing --output file is only replaced after asking,
+or with --force.
+
+Examples:
+  samedi plan export rust-async > rust-async.md
+  samedi plan export rust-async --save
+  samedi plan export rust-async --format pdf
+  samedi plan export rust-async --format pdf -o ~/Desktop/plan.pdf --notes`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			planID := args[0]
+			if format != "md" && format != "pdf" {
+				return &usageError{command: cmd.CommandPath(), err: fmt.Errorf("invalid --format %q (must be md or pdf)", format)}
+			}
+
+			svc, err := getPlanService(cmd, "")
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+			doc, err := svc.Export(context.Background(), planID, notes)
+			if err != nil {
+				return fmt.Errorf("failed to export plan: %w", err)
+			}
+
+			data := []byte(doc)
+			if format == "pdf" {
+				if data, err = markdownToPDF(doc); err != nil {
+					return err
+				}
+			} else if output == "" && !save {
+				fmt.Print(doc)
+				return nil
+			}
+
+			cfg, err := getConfig(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			vars := export.Vars{Type: "plan", Plan: planID, Ext: format}
+			path, err := writeOutput(cfg, output, vars, data, cfg.Export.ExportFilename, func(path string) (bool, error) {
+				return confirmOverwrite(path, force)
+			})
+			if err != nil {
+				return err
+			}
+			if path == "" {
+				fmt.Println("✗ Plan not exported")
+				return nil
+			}
+			fmt.Printf("✓ Exported %s to %s\n", planID, path)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "f", "md", "export format: md or pdf")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "output file path or directory")
+	cmd.Flags().BoolVar(&save, "save", false, "save to export.dir using export.export_filename")
+	cmd.Flags().BoolVar(&force, "force", false, "overwrite the --output file without asking")
+	cmd.Flags().BoolVar(&notes, "notes", false, "include the notes gathered from sessions")
+
+	return cmd
+}
+
+// markdownToPDF converts markdown to a PDF with pandoc. It is read as
+// GitHub-flavored markdown, which, like plan files, lets lists follow
+// their label without a blank line.
+func markdownToPDF(markdown string) ([]byte, error) {
+	pandoc, err := exec.LookPath("pandoc")
+	if err != nil {
+		return nil, fmt.Errorf("PDF export needs pandoc (https://pandoc.org) on PATH; use --format md instead")
+	}
+
+	dir, err := os.MkdirTemp("", "samedi-pdf-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "plan.pdf")
+
+	var stderr bytes.Buffer
+	cmd := exec.Command(pandoc, "--from", "gfm", "--output", path, "--variable", "geometry:margin=2.5cm") // #nosec G204 - fixed arguments and a temp path
+	cmd.Stdin = strings.NewReader(markdown)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("pandoc failed: %w\n%s", err, strings.TrimSpace(stderr.String()))
+	}
+	pdf, err := os.ReadFile(path) // #nosec G304 - path is in our temp directory
+	if err != nil {
+		return nil, fmt.Errorf("failed to read PDF: %w", err)
+	}
+	return pdf, nil
+}
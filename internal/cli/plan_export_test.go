@@ -0,0 +1,83 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanExportCmd_Structure(t *testing.T) {
+	cmd := planExportCmd()
+
+	assert.Equal(t, "export <plan-id>", cmd.Use)
+	assert.Equal(t, "md", cmd.Flags().Lookup("format").DefValue)
+	assert.NotNil(t, cmd.Flags().Lookup("output"))
+	assert.Equal(t, "false", cmd.Flags().Lookup("notes").DefValue, "notes are personal")
+	assert.NotNil(t, cmd.Flags().Lookup("save"))
+	assert.NotNil(t, cmd.Flags().Lookup("force"))
+}
+
+func TestPlanExportCmd_InvalidFormatIsUsageError(t *testing.T) {
+	assert.Equal(t, ExitInvalid, executeExitCode(t, planExportCmd(), "rust", "--format", "docx"))
+}
+
+func TestPlanExportCmd_WritesLikeOtherExports(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	svc, err := getPlanService(planExportCmd(), "")
+	require.NoError(t, err)
+	_, _, err = svc.Import(context.Background(), `---
+id: rust
+title: Rust
+created: 2026-10-01T00:00:00Z
+updated: 2026-10-01T00:00:00Z
+total_hours: 1
+status: in-progress
+---
+
+# Rust
+
+## Chunk 1: Ownership {#chunk-001}
+
+**Duration**: 1 hour
+**Status**: in-progress
+
+**Notes**:
+- 2026-10-16: private thought
+`, "")
+	require.NoError(t, err)
+
+	run := func(args ...string) error {
+		cmd := planExportCmd()
+		cmd.SetArgs(args)
+		cmd.SilenceUsage, cmd.SilenceErrors = true, true
+		return cmd.Execute()
+	}
+
+	dir := t.TempDir()
+	require.NoError(t, run("rust", "-o", dir+string(filepath.Separator)))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Contains(t, entries[0].Name(), "-rust-plan.md", "named from export.export_filename")
+	data, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "private thought", "notes are left out by default")
+
+	path := filepath.Join(dir, "rust.md")
+	require.NoError(t, os.WriteFile(path, []byte("mine"), 0o600))
+	err = run("rust", "-o", path)
+	require.Error(t, err, "tests don't run in a terminal, so there is no one to ask")
+	assert.Contains(t, err.Error(), "--force")
+
+	require.NoError(t, run("rust", "-o", path, "--force", "--notes"))
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "private thought")
+}
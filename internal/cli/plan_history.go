@@ -0,0 +1,123 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+	"github.com/spf13/cobra"
+)
+
+// planHistoryCmd creates the `samedi plan history` subcommand.
+func planHistoryCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "history <plan-id>",
+		Short: "List saved versions of a plan",
+		Long: `List the versions of a plan. samedi snapshots the plan markdown every
+time it saves the plan (creation, edits, chunk status changes) and when
+it picks up an edit made outside samedi.
+
+Compare two versions with 'samedi plan diff'.
+
+Examples:
+  samedi plan history rust-async
+  samedi plan history rust-async --json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			svc, err := getPlanService(cmd, "")
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			versions, err := svc.History(context.Background(), args[0])
+			if err != nil {
+				return err
+			}
+
+			if jsonOutput, _ := cmd.Flags().GetBool("json"); jsonOutput {
+				return printJSON(versions)
+			}
+
+			if len(versions) == 0 {
+				fmt.Printf("No versions recorded for %s yet. One is saved the next time the plan changes.\n", args[0])
+				return nil
+			}
+			return printHistory(os.Stdout, versions)
+		},
+	}
+}
+
+// printHistory prints versions newest first with the lines each changed
+// relative to the version before it.
+func printHistory(w io.Writer, versions []*plan.Version) error {
+	tw := components.NewTabWriter(w, 2)
+	fmt.Fprintln(tw, "VERSION\tSAVED\tCHANGES")
+	for i, v := range versions {
+		changes := "created"
+		if i+1 < len(versions) {
+			added, removed := plan.DiffStats(versions[i+1].Content, v.Content)
+			changes = fmt.Sprintf("+%d -%d", added, removed)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", v.Label(), v.CreatedAt.Local().Format("2006-01-02 15:04"), changes)
+	}
+	return tw.Flush()
+}
+
+// planDiffCmd creates the `samedi plan diff` subcommand.
+func planDiffCmd() *cobra.Command {
+	var from, to string
+
+	cmd := &cobra.Command{
+		Use:   "diff <plan-id>",
+		Short: "Show changes between two versions of a plan",
+		Long: `Print a unified diff between two saved versions of a plan. Without
+--to the latest version is used; without --from, the version before --to.
+
+Examples:
+  samedi plan diff rust-async                  # Latest change
+  samedi plan diff rust-async --from v3 --to v5
+  samedi plan diff rust-async --from v1        # First version to latest`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var fromVersion, toVersion int
+			var err error
+			if from != "" {
+				if fromVersion, err = plan.ParseVersion(from); err != nil {
+					return err
+				}
+			}
+			if to != "" {
+				if toVersion, err = plan.ParseVersion(to); err != nil {
+					return err
+				}
+			}
+
+			svc, err := getPlanService(cmd, "")
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			diff, err := svc.DiffVersions(context.Background(), args[0], fromVersion, toVersion)
+			if err != nil {
+				return err
+			}
+			if diff == "" {
+				fmt.Println("No differences.")
+				return nil
+			}
+			fmt.Print(diff)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&from, "from", "", "older version, e.g. v3 (default: the version before --to)")
+	cmd.Flags().StringVar(&to, "to", "", "newer version, e.g. v5 (default: latest)")
+
+	return cmd
+}
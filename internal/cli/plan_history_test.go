@@ -0,0 +1,37 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanDiffCmd_Structure(t *testing.T) {
+	cmd := planDiffCmd()
+
+	assert.Equal(t, "diff <plan-id>", cmd.Use)
+	assert.NotNil(t, cmd.Flags().Lookup("from"))
+	assert.NotNil(t, cmd.Flags().Lookup("to"))
+}
+
+func TestPrintHistory(t *testing.T) {
+	saved := time.Date(2025, 10, 13, 9, 30, 0, 0, time.Local)
+	versions := []*plan.Version{
+		{PlanID: "rust", Number: 2, Content: "title\nStatus: done\n", CreatedAt: saved},
+		{PlanID: "rust", Number: 1, Content: "title\nStatus: todo\n", CreatedAt: saved},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, printHistory(&buf, versions))
+
+	out := buf.String()
+	assert.Contains(t, out, "VERSION  SAVED")
+	assert.Contains(t, out, "v2       2025-10-13 09:30  +1 -1")
+	assert.Contains(t, out, "v1       2025-10-13 09:30  created")
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/spf13/cobra"
+)
+
+// planImportCmd creates the `samedi plan import` subcommand.
+func planImportCmd() *cobra.Command {
+	var (
+		id      string
+		convert bool
+		title   string
+		hours   float64
+		model   string
+		debug   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import a plan file, or convert a syllabus into a plan",
+		Long: `Import a plan written outside samedi, such as one shared by someone else
+or written by hand, into the plans directory and the index.
+
+The file must be in samedi's plan format: YAML frontmatter with at least a
+title, then "## Chunk N: Title {#chunk-NNN}" sections. The ID comes from
+--id, else the frontmatter, else the title, and gets a numeric suffix if a
+plan already has it. Missing dates and status are filled in, total_hours
+defaults to the sum of the chunks, and lines the parser can't read are
+listed and left out.
+
+With --convert, the file can be anything: a course syllabus, a table of
+contents, a bulleted outline. The LLM rewrites it as a plan, keeping its
+topics and order. The title defaults to the file name.
+
+Use - as the file to read from standard input.
+
+Examples:
+  samedi plan import ~/Downloads/rust-async.md
+  samedi plan import shared.md --id rust-async-team
+  samedi plan import cs61a-syllabus.txt --convert --hours 60
+  pbpaste | samedi plan import - --convert --title "Linear algebra"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			content, err := readImportFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			svc, err := getPlanService(cmd, model)
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+			ctx := context.Background()
+
+			var imported *plan.Plan
+			if convert {
+				if title == "" {
+					title = importTitle(args[0])
+				}
+				if title == "" {
+					return &usageError{command: cmd.CommandPath(), err: fmt.Errorf("--title is required when converting from standard input")}
+				}
+				fmt.Printf("→ Converting %s into a plan for \"%s\"...\n", args[0], title)
+				imported, err = svc.Convert(ctx, plan.ConvertRequest{
+					Outline:    content,
+					Title:      title,
+					TotalHours: hours,
+					Debug:      debug,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to convert: %w", err)
+				}
+			} else {
+				var warnings []plan.Warning
+				imported, warnings, err = svc.Import(ctx, content, id)
+				if err != nil {
+					return fmt.Errorf("failed to import: %w\n(Not a samedi plan? Use --convert to have the LLM convert it.)", err)
+				}
+				printParseWarnings(os.Stdout, imported.ID, warnings)
+			}
+
+			fmt.Printf("✓ Plan imported: %s\n", imported.Title)
+			fmt.Printf("✓ Location: %s\n", svc.FilePath(imported.ID))
+			fmt.Printf("✓ Chunks: %d (%.1f hours total)\n", len(imported.Chunks), imported.TotalHours)
+
+			autoCommit(cmd, "samedi: plan imported: "+imported.ID)
+			autoMirror(cmd)
+
+			fmt.Printf("\nNext: samedi plan show %s\n", imported.ID)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&id, "id", "", "plan ID to import as (default from the file)")
+	cmd.Flags().BoolVar(&convert, "convert", false, "convert a syllabus or outline with the LLM")
+	cmd.Flags().StringVar(&title, "title", "", "plan title when converting (default from the file name)")
+	cmd.Flags().Float64Var(&hours, "hours", 0, "total hours when converting (default from the outline)")
+	cmd.Flags().StringVar(&model, "model", "", "LLM model override")
+	cmd.Flags().BoolVar(&debug, "debug", false, "show full LLM prompt and response for debugging")
+
+	return cmd
+}
+
+// readImportFile returns the contents of path, or of stdin for "-".
+func readImportFile(path string) (string, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path) // #nosec G304 - path given by the user
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return string(data), nil
+}
+
+// importTitle derives a plan title from a file name:
+// "linear-algebra_syllabus.md" becomes "linear algebra syllabus".
+func importTitle(path string) string {
+	if path == "-" {
+		return ""
+	}
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	return strings.Join(strings.FieldsFunc(name, func(r rune) bool {
+		return r == '-' || r == '_' || r == ' ' || r == '.'
+	}), " ")
+}
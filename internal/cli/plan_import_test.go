@@ -0,0 +1,41 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanImportCmd_Structure(t *testing.T) {
+	cmd := planImportCmd()
+
+	assert.Equal(t, "import <file>", cmd.Use)
+	for _, flag := range []string{"id", "convert", "title", "hours", "model", "debug"} {
+		assert.NotNil(t, cmd.Flags().Lookup(flag), flag)
+	}
+}
+
+func TestImportTitle(t *testing.T) {
+	assert.Equal(t, "linear algebra syllabus", importTitle("/tmp/linear-algebra_syllabus.md"))
+	assert.Equal(t, "cs61a", importTitle("cs61a.txt"))
+	assert.Equal(t, "", importTitle("-"))
+}
+
+func TestPlanImportCmd_ConvertStdinNeedsTitle(t *testing.T) {
+	stdin, err := os.CreateTemp(t.TempDir(), "outline")
+	require.NoError(t, err)
+	_, err = stdin.WriteString("Week 1: vectors\n")
+	require.NoError(t, err)
+	_, err = stdin.Seek(0, 0)
+	require.NoError(t, err)
+	orig := os.Stdin
+	os.Stdin = stdin
+	t.Cleanup(func() { os.Stdin = orig; stdin.Close() })
+
+	assert.Equal(t, ExitInvalid, executeExitCode(t, planImportCmd(), "-", "--convert"))
+}
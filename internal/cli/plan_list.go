@@ -0,0 +1,290 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"golang.org/x/term"
+)
+
+// planListBarWidth is the width of the mini progress bar in `plan list`.
+const planListBarWidth = 10
+
+// dueSoonDays is how far ahead `plan list --due-soon` looks for deadlines.
+const dueSoonDays = 14
+
+// planListRow holds everything displayed for one plan in `plan list`.
+// Last-studied and next-chunk details come from the record itself.
+type planListRow struct {
+	Record    *storage.PlanRecord
+	Completed int
+	Total     int
+	Prefix    string // Tree branch drawn before the ID with --tree
+}
+
+// planListStyles colors the `plan list` table. Zero-value styles render plain text.
+type planListStyles struct {
+	header     lipgloss.Style
+	completed  lipgloss.Style
+	inProgress lipgloss.Style
+	notStarted lipgloss.Style
+	archived   lipgloss.Style
+	barFilled  lipgloss.Style
+	barEmpty   lipgloss.Style
+	stale      lipgloss.Style
+	overdue    lipgloss.Style
+}
+
+// newPlanListStyles returns colored styles, or plain ones when color is false.
+func newPlanListStyles(color bool) planListStyles {
+	if !color {
+		return planListStyles{}
+	}
+
+	return planListStyles{
+		header:     lipgloss.NewStyle().Bold(true),
+		completed:  lipgloss.NewStyle().Foreground(lipgloss.Color("2")),
+		inProgress: lipgloss.NewStyle().Foreground(lipgloss.Color("3")),
+		notStarted: lipgloss.NewStyle().Foreground(lipgloss.Color("7")),
+		archived:   lipgloss.NewStyle().Foreground(lipgloss.Color("8")),
+		barFilled:  lipgloss.NewStyle().Foreground(lipgloss.Color("2")),
+		barEmpty:   lipgloss.NewStyle().Foreground(lipgloss.Color("8")),
+		stale:      lipgloss.NewStyle().Foreground(lipgloss.Color("8")),
+		overdue:    lipgloss.NewStyle().Foreground(lipgloss.Color("1")),
+	}
+}
+
+// colorEnabled reports whether stdout should get ANSI colors.
+// Honors the NO_COLOR convention (https://no-color.org).
+func colorEnabled() bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	return term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// buildPlanListRows gathers chunk progress for each plan.
+func buildPlanListRows(ctx context.Context, svc *plan.Service, records []*storage.PlanRecord) []planListRow {
+	rows := make([]planListRow, 0, len(records))
+	for _, record := range records {
+		row := planListRow{Record: record}
+		row.Completed, row.Total, _ = chunkProgress(ctx, svc, record)
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+// sortPlanRecords orders records by s. Plans saved before chunk counts
+// were indexed get their progress from markdown, as the table does.
+func sortPlanRecords(ctx context.Context, svc *plan.Service, records []*storage.PlanRecord, s plan.ListSort) {
+	if s.Field == "" {
+		return
+	}
+	keys := make(map[*storage.PlanRecord]plan.SortKeys, len(records))
+	for _, record := range records {
+		k := plan.RecordSortKeys(record)
+		if s.Field == plan.SortProgress && !record.ChunksIndexed {
+			if completed, total, ok := chunkProgress(ctx, svc, record); ok && total > 0 {
+				k.Progress = float64(completed) / float64(total)
+			}
+		}
+		keys[record] = k
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return s.Less(keys[records[i]], keys[records[j]])
+	})
+}
+
+// chunkProgress returns the completed and total chunks of record's plan,
+// from the counts saved with its metadata. Plans saved before the counts
+// existed are loaded from markdown instead. ok is false if that fails.
+func chunkProgress(ctx context.Context, svc *plan.Service, record *storage.PlanRecord) (completed, total int, ok bool) {
+	if record.ChunksIndexed {
+		return record.ChunksCompleted, record.ChunksTotal, true
+	}
+	p, err := svc.Get(ctx, record.ID)
+	if err != nil {
+		return 0, 0, false
+	}
+	return p.CompletedChunks(), len(p.Chunks), true
+}
+
+// renderPlanList writes an aligned table with progress bars, last-studied
+// times, and the next chunk. A due column is added when any plan has a
+// deadline. Widths are measured after styling so colored cells stay
+// aligned.
+func renderPlanList(w io.Writer, rows []planListRow, now time.Time, styles planListStyles) {
+	showDue := false
+	for _, row := range rows {
+		if row.Record.Deadline != "" {
+			showDue = true
+			break
+		}
+	}
+
+	header := []string{
+		styles.header.Render("ID"),
+		styles.header.Render("TITLE"),
+		styles.header.Render("STATUS"),
+		styles.header.Render("PROGRESS"),
+		styles.header.Render("HOURS"),
+	}
+	if showDue {
+		header = append(header, styles.header.Render("DUE"))
+	}
+	header = append(header,
+		styles.header.Render("LAST STUDIED"),
+		styles.header.Render("NEXT CHUNK"),
+	)
+	table := [][]string{header}
+
+	for _, row := range rows {
+		cells := []string{
+			row.Prefix + row.Record.ID,
+			truncate(row.Record.Title, 40),
+			styleStatus(row.Record.Status, styles),
+			renderMiniProgress(row.Completed, row.Total, styles),
+			fmt.Sprintf("%.1fh", row.Record.TotalHours),
+		}
+		if showDue {
+			cells = append(cells, renderDue(row.Record, now, styles))
+		}
+		cells = append(cells,
+			renderLastStudied(row.Record.LastSession, now, styles),
+			formatNextChunk(row.Record),
+		)
+		table = append(table, cells)
+	}
+
+	widths := make([]int, len(table[0]))
+	for _, cells := range table {
+		for i, cell := range cells {
+			if width := lipgloss.Width(cell); width > widths[i] {
+				widths[i] = width
+			}
+		}
+	}
+
+	for _, cells := range table {
+		var line strings.Builder
+		for i, cell := range cells {
+			line.WriteString(cell)
+			if i < len(cells)-1 {
+				line.WriteString(strings.Repeat(" ", widths[i]-lipgloss.Width(cell)+2))
+			}
+		}
+		fmt.Fprintln(w, line.String())
+	}
+}
+
+// styleStatus colors a formatted plan status.
+//
+//nolint:goconst // Status strings used in switch statement
+func styleStatus(status string, styles planListStyles) string {
+	text := formatStatus(status)
+	switch status {
+	case "completed":
+		return styles.completed.Render(text)
+	case "in-progress":
+		return styles.inProgress.Render(text)
+	case "not-started":
+		return styles.notStarted.Render(text)
+	case "archived":
+		return styles.archived.Render(text)
+	default:
+		return text
+	}
+}
+
+// renderMiniProgress renders a bar like "███░░░░░░░  30%".
+func renderMiniProgress(completed, total int, styles planListStyles) string {
+	if total == 0 {
+		return styles.barEmpty.Render(strings.Repeat("░", planListBarWidth)) + "    -"
+	}
+
+	filled := completed * planListBarWidth / total
+	percent := completed * 100 / total
+
+	return styles.barFilled.Render(strings.Repeat("█", filled)) +
+		styles.barEmpty.Render(strings.Repeat("░", planListBarWidth-filled)) +
+		fmt.Sprintf(" %3d%%", percent)
+}
+
+// renderLastStudied shows how long ago a plan was last studied.
+// Plans untouched for over a week are dimmed.
+func renderLastStudied(last *time.Time, now time.Time, styles planListStyles) string {
+	if last == nil {
+		return styles.stale.Render("never")
+	}
+
+	text := stats.FormatRelativeTime(*last, now)
+	if now.Sub(*last) > 7*24*time.Hour {
+		return styles.stale.Render(text)
+	}
+	return text
+}
+
+// formatNextChunk shows the next chunk title, or "-" when nothing is left.
+func formatNextChunk(record *storage.PlanRecord) string {
+	if record.NextChunkID == "" {
+		return "-"
+	}
+	if record.NextChunkTitle == "" {
+		return record.NextChunkID
+	}
+	return truncate(record.NextChunkTitle, 30)
+}
+
+// applyDueSoon narrows filter to plans due within dueSoonDays of now or
+// overdue. Unless a status or sort was given, finished plans are left out
+// and the soonest deadline comes first.
+func applyDueSoon(filter *storage.PlanFilter, defaultStatuses, defaultSort bool, now time.Time) {
+	filter.DueBefore = now.AddDate(0, 0, dueSoonDays).Format(plan.DateFormat)
+	if defaultStatuses {
+		filter.Statuses = []string{string(plan.StatusNotStarted), string(plan.StatusInProgress)}
+	}
+	if defaultSort {
+		filter.SortBy = "deadline"
+	}
+}
+
+// formatDue describes a YYYY-MM-DD deadline relative to now, such as
+// "Jun 30 (5d)", "Jun 30 (today)" or "Jun 30 (3d overdue)"; "-" without one.
+func formatDue(deadline string, now time.Time) string {
+	date, err := plan.ParseDate(deadline)
+	if err != nil {
+		return "-"
+	}
+	label := date.Format("Jan 2")
+	switch days := date.DaysFrom(now); {
+	case days < 0:
+		return fmt.Sprintf("%s (%dd overdue)", label, -days)
+	case days == 0:
+		return label + " (today)"
+	default:
+		return fmt.Sprintf("%s (%dd)", label, days)
+	}
+}
+
+// renderDue shows a plan's deadline, highlighting overdue unfinished plans.
+func renderDue(record *storage.PlanRecord, now time.Time, styles planListStyles) string {
+	text := formatDue(record.Deadline, now)
+	date, err := plan.ParseDate(record.Deadline)
+	if err == nil && date.DaysFrom(now) < 0 && record.Status != string(plan.StatusCompleted) {
+		return styles.overdue.Render(text)
+	}
+	return text
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderPlanList_Aligned(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	studied := now.Add(-72 * time.Hour)
+
+	rows := []planListRow{
+		{
+			Record: &storage.PlanRecord{
+				ID: "rust-async", Title: "Rust Async", Status: "in-progress", TotalHours: 20,
+				LastSession: &studied, NextChunkID: "chunk-004", NextChunkTitle: "Tokio Runtime",
+			},
+			Completed: 3,
+			Total:     10,
+		},
+		{
+			Record: &storage.PlanRecord{ID: "go", Title: "Go", Status: "not-started", TotalHours: 5},
+		},
+	}
+
+	var buf bytes.Buffer
+	renderPlanList(&buf, rows, now, newPlanListStyles(false))
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 3)
+
+	assert.True(t, strings.HasPrefix(lines[0], "ID"))
+	assert.Contains(t, lines[0], "LAST STUDIED")
+	assert.Contains(t, lines[0], "NEXT CHUNK")
+	assert.Contains(t, lines[1], "███░░░░░░░  30%")
+	assert.Contains(t, lines[1], "3d ago")
+	assert.True(t, strings.HasSuffix(lines[1], "Tokio Runtime"))
+	assert.Contains(t, lines[2], "░░░░░░░░░░    -")
+	assert.Contains(t, lines[2], "never")
+	assert.True(t, strings.HasSuffix(lines[2], "-"))
+
+	// Columns line up across rows
+	statusCol := strings.Index(lines[0], "STATUS")
+	assert.Equal(t, statusCol, strings.Index(lines[1], "→ in-progress"))
+	assert.Equal(t, statusCol, strings.Index(lines[2], "○ not-started"))
+}
+
+func TestRenderMiniProgress(t *testing.T) {
+	styles := newPlanListStyles(false)
+
+	assert.Equal(t, "░░░░░░░░░░   0%", renderMiniProgress(0, 4, styles))
+	assert.Equal(t, "█████░░░░░  50%", renderMiniProgress(2, 4, styles))
+	assert.Equal(t, "██████████ 100%", renderMiniProgress(4, 4, styles))
+}
+
+func TestRenderPlanList_CJKTitles(t *testing.T) {
+	rows := []planListRow{
+		{Record: &storage.PlanRecord{ID: "japanese", Title: "日本語の文法", Status: "in-progress"}},
+		{Record: &storage.PlanRecord{ID: "rust", Title: "🚀 Rust", Status: "not-started"}},
+		{Record: &storage.PlanRecord{ID: "go", Title: "Go", Status: "completed"}},
+	}
+
+	var buf bytes.Buffer
+	renderPlanList(&buf, rows, time.Now(), newPlanListStyles(false))
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 4)
+	statusCol := components.Width(lines[0][:strings.Index(lines[0], "STATUS")])
+	for i, status := range []string{"in-progress", "not-started", "completed"} {
+		line, text := lines[i+1], formatStatus(status)
+		require.Contains(t, line, text)
+		assert.Equal(t, statusCol, components.Width(line[:strings.Index(line, text)]), "row %d", i+1)
+	}
+}
+
+func TestRenderPlanList_TreePrefix(t *testing.T) {
+	rows := []planListRow{
+		{Record: &storage.PlanRecord{ID: "backend", Title: "Backend", Status: "in-progress"}},
+		{Record: &storage.PlanRecord{ID: "go", Title: "Go", Status: "not-started"}, Prefix: "└─ "},
+	}
+
+	var buf bytes.Buffer
+	renderPlanList(&buf, rows, time.Now(), newPlanListStyles(false))
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 3)
+	assert.True(t, strings.HasPrefix(lines[2], "└─ go"))
+	titleCol := utf8.RuneCountInString(lines[0][:strings.Index(lines[0], "TITLE")])
+	assert.Equal(t, titleCol, utf8.RuneCountInString(lines[2][:strings.Index(lines[2], "Go")]), "prefix is part of the aligned ID column")
+}
+
+func TestRenderPlanList_DueColumn(t *testing.T) {
+	now := time.Date(2025, 6, 25, 12, 0, 0, 0, time.Local)
+	rows := []planListRow{
+		{Record: &storage.PlanRecord{ID: "rust", Title: "Rust", Status: "in-progress", Deadline: "2025-06-30"}},
+		{Record: &storage.PlanRecord{ID: "go", Title: "Go", Status: "not-started", Deadline: "2025-06-22"}},
+		{Record: &storage.PlanRecord{ID: "zig", Title: "Zig", Status: "not-started"}},
+	}
+
+	var buf bytes.Buffer
+	renderPlanList(&buf, rows, now, newPlanListStyles(false))
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 4)
+	assert.Less(t, strings.Index(lines[0], "HOURS"), strings.Index(lines[0], "DUE"))
+	assert.Less(t, strings.Index(lines[0], "DUE"), strings.Index(lines[0], "LAST STUDIED"))
+	assert.Contains(t, lines[1], "Jun 30 (5d)")
+	assert.Contains(t, lines[2], "Jun 22 (3d overdue)")
+
+	// Without any deadlines the column is left out
+	buf.Reset()
+	renderPlanList(&buf, rows[2:], now, newPlanListStyles(false))
+	assert.NotContains(t, buf.String(), "DUE")
+}
+
+func TestFormatDue(t *testing.T) {
+	now := time.Date(2025, 6, 25, 12, 0, 0, 0, time.Local)
+
+	assert.Equal(t, "Jun 25 (today)", formatDue("2025-06-25", now))
+	assert.Equal(t, "Jul 1 (6d)", formatDue("2025-07-01", now))
+	assert.Equal(t, "Jun 24 (1d overdue)", formatDue("2025-06-24", now))
+	assert.Equal(t, "-", formatDue("", now))
+}
+
+func TestApplyDueSoon(t *testing.T) {
+	now := time.Date(2025, 6, 25, 12, 0, 0, 0, time.Local)
+
+	filter := &storage.PlanFilter{}
+	applyDueSoon(filter, true, true, now)
+	assert.Equal(t, "2025-07-09", filter.DueBefore)
+	assert.Equal(t, []string{"not-started", "in-progress"}, filter.Statuses)
+	assert.Equal(t, "deadline", filter.SortBy)
+
+	filter = &storage.PlanFilter{Statuses: []string{"completed"}, SortBy: "title"}
+	applyDueSoon(filter, false, false, now)
+	assert.Equal(t, []string{"completed"}, filter.Statuses)
+	assert.Equal(t, "title", filter.SortBy)
+}
+
+func TestChunkProgress_UsesIndexedCounts(t *testing.T) {
+	// A nil service proves the markdown is never loaded
+	record := &storage.PlanRecord{ID: "rust", ChunksIndexed: true, ChunksTotal: 8, ChunksCompleted: 3}
+	completed, total, ok := chunkProgress(context.Background(), nil, record)
+	require.True(t, ok)
+	assert.Equal(t, 3, completed)
+	assert.Equal(t, 8, total)
+	assert.Equal(t, "37% (3/8)", calculateProgress(nil, record))
+}
+
+func TestSortPlanRecords(t *testing.T) {
+	// Indexed counts only, so a nil service is never asked for markdown
+	records := []*storage.PlanRecord{
+		{ID: "empty", TotalHours: 4, ChunksIndexed: true},
+		{ID: "half", TotalHours: 2, ChunksIndexed: true, ChunksTotal: 4, ChunksCompleted: 2},
+		{ID: "most", TotalHours: 1, ChunksIndexed: true, ChunksTotal: 4, ChunksCompleted: 3},
+	}
+	ids := func() []string {
+		out := make([]string, len(records))
+		for i, record := range records {
+			out[i] = record.ID
+		}
+		return out
+	}
+
+	sortPlanRecords(context.Background(), nil, records, plan.ListSort{Field: plan.SortProgress, Desc: true})
+	assert.Equal(t, []string{"most", "half", "empty"}, ids(), "plans without chunks last")
+
+	sortPlanRecords(context.Background(), nil, records, plan.ListSort{Field: plan.SortHours})
+	assert.Equal(t, []string{"most", "half", "empty"}, ids())
+
+	sortPlanRecords(context.Background(), nil, records, plan.ListSort{Field: plan.SortHours, Desc: true})
+	assert.Equal(t, []string{"empty", "half", "most"}, ids())
+}
+
+func TestPlanListCmd_InvalidFlagsAreUsageErrors(t *testing.T) {
+	assert.Equal(t, ExitInvalid, executeExitCode(t, planListCmd(), "--sort", "bogus"))
+	assert.Equal(t, ExitInvalid, executeExitCode(t, planListCmd(), "--tag", "go", "--tag-mode", "xor"))
+}
+
+func TestPlanChunksCmd_InvalidStatusIsUsageError(t *testing.T) {
+	assert.Equal(t, ExitInvalid, executeExitCode(t, planChunksCmd(), "--status", "bogus"))
+}
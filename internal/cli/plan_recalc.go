@@ -0,0 +1,91 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"os"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/spf13/cobra"
+)
+
+// planRecalcCmd creates the `samedi plan recalc` subcommand.
+func planRecalcCmd() *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "recalc <plan-id>",
+		Short: "Recompute a plan's total hours from its chunks",
+		Long: `Compare a plan's total_hours with the sum of its chunk durations and
+fix the total when they disagree.
+
+Which value wins is set by learning.total_hours_source:
+  chunks   total_hours follows the chunks (default); samedi also
+           recomputes it whenever it saves the plan
+  plan     total_hours is a fixed budget; recalc only reports the
+           mismatch (exit status 2) unless --force is given
+
+'samedi plan validate' warns about mismatches under either policy.
+
+Examples:
+  samedi plan recalc rust-async
+  samedi plan recalc rust-async --force
+  samedi config set learning.total_hours_source plan`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			planID := args[0]
+			ctx := context.Background()
+
+			cfg, err := getConfig(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			svc, err := getPlanService(cmd, "")
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			p, err := svc.Get(ctx, planID)
+			if err != nil {
+				return fmt.Errorf("failed to load plan: %w", err)
+			}
+			if !p.HoursMismatch() {
+				fmt.Printf("✓ %s: total_hours %g matches its chunks\n", planID, p.TotalHours)
+				return nil
+			}
+
+			if cfg.Learning.TotalHoursSource == config.TotalHoursSourcePlan && !force {
+				printHoursMismatch(os.Stdout, p)
+				return &reportedError{code: ExitInvalid, msg: "total_hours does not match the chunks"}
+			}
+
+			updated, previous, err := svc.RecalcHours(ctx, planID)
+			if err != nil {
+				return fmt.Errorf("failed to update plan: %w", err)
+			}
+			fmt.Printf("✓ %s: total_hours %g → %g (from %d chunks)\n", planID, previous, updated.TotalHours, len(updated.Chunks))
+
+			autoCommit(cmd, "samedi: plan hours recalculated: "+planID)
+			autoMirror(cmd)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "recompute even when learning.total_hours_source is plan")
+
+	return cmd
+}
+
+// printHoursMismatch explains a total_hours mismatch kept by the "plan" policy.
+func printHoursMismatch(w io.Writer, p *plan.Plan) {
+	chunkHours := math.Round(p.ChunkHours()*100) / 100
+	fmt.Fprintf(w, "⚠ %s: total_hours is %g but chunks add up to %gh\n", p.ID, p.TotalHours, chunkHours)
+	fmt.Fprintf(w, "  learning.total_hours_source is \"plan\": adjust chunk durations, or rerun with --force\n")
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanRecalcCmd_Structure(t *testing.T) {
+	cmd := planRecalcCmd()
+
+	assert.Equal(t, "recalc <plan-id>", cmd.Use)
+	assert.NotNil(t, cmd.Flags().Lookup("force"))
+
+	var found bool
+	for _, sub := range planCmd().Commands() {
+		if sub.Name() == "recalc" {
+			found = true
+		}
+	}
+	assert.True(t, found, "plan should have recalc subcommand")
+}
+
+func TestPrintHoursMismatch(t *testing.T) {
+	p := &plan.Plan{
+		ID:         "rust-async",
+		TotalHours: 40,
+		Chunks:     []plan.Chunk{{ID: "chunk-001", Duration: 90}},
+	}
+
+	var buf bytes.Buffer
+	printHoursMismatch(&buf, p)
+
+	assert.Contains(t, buf.String(), "⚠ rust-async: total_hours is 40 but chunks add up to 1.5h")
+	assert.Contains(t, buf.String(), "--force")
+}
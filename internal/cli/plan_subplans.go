@@ -0,0 +1,126 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/spf13/cobra"
+)
+
+// planLinkCmd creates the `samedi plan link` subcommand.
+func planLinkCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "link <parent-id> <child-id>...",
+		Short: "Make plans sub-plans of another plan",
+		Long: `Add plans as sub-plans of a parent plan, such as "go-deep-dive" and
+"sql-mastery" under "backend-engineer". The parent's stats then roll up
+the progress and hours of everything beneath it, and 'plan list --tree'
+shows the hierarchy.
+
+Sub-plans are listed in the parent's frontmatter as children, so they
+can also be edited by hand. A plan cannot end up beneath itself.
+
+Examples:
+  samedi plan link backend-engineer go-deep-dive sql-mastery
+  samedi plan list --tree`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parentID := args[0]
+
+			svc, err := getPlanService(cmd, "")
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			ctx := context.Background()
+			var parent *plan.Plan
+			for _, childID := range args[1:] {
+				parent, err = svc.AddSubPlan(ctx, parentID, childID)
+				if err != nil {
+					return fmt.Errorf("failed to link %s: %w", childID, err)
+				}
+			}
+
+			fmt.Printf("✓ %s sub-plans: %s\n", parent.ID, strings.Join(parent.Children, ", "))
+
+			autoCommit(cmd, fmt.Sprintf("samedi: sub-plans linked to %s", parentID))
+			autoMirror(cmd)
+
+			return nil
+		},
+	}
+}
+
+// planUnlinkCmd creates the `samedi plan unlink` subcommand.
+func planUnlinkCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "unlink <parent-id> <child-id>...",
+		Short: "Detach sub-plans from a plan",
+		Long: `Remove plans from a parent plan's sub-plans. The sub-plans themselves
+are kept and become top-level plans again.
+
+Examples:
+  samedi plan unlink backend-engineer sql-mastery`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parentID := args[0]
+
+			svc, err := getPlanService(cmd, "")
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			ctx := context.Background()
+			var parent *plan.Plan
+			for _, childID := range args[1:] {
+				parent, err = svc.RemoveSubPlan(ctx, parentID, childID)
+				if err != nil {
+					return fmt.Errorf("failed to unlink %s: %w", childID, err)
+				}
+			}
+
+			if len(parent.Children) == 0 {
+				fmt.Printf("✓ %s has no sub-plans\n", parent.ID)
+			} else {
+				fmt.Printf("✓ %s sub-plans: %s\n", parent.ID, strings.Join(parent.Children, ", "))
+			}
+
+			autoCommit(cmd, fmt.Sprintf("samedi: sub-plans unlinked from %s", parentID))
+			autoMirror(cmd)
+
+			return nil
+		},
+	}
+}
+
+// displaySubPlans shows a plan's parents and its sub-plans with their
+// progress, for `plan show`.
+func displaySubPlans(w io.Writer, svc *plan.Service, p *plan.Plan) {
+	ctx := context.Background()
+
+	if records, err := svc.List(ctx, nil); err == nil {
+		if parents := plan.Parents(records, p.ID); len(parents) > 0 {
+			fmt.Fprintf(w, "Part of: %s\n", strings.Join(parents, ", "))
+		}
+	}
+
+	if len(p.Children) == 0 {
+		return
+	}
+	fmt.Fprintln(w, "\nSub-plans:")
+	for _, id := range p.Children {
+		child, err := svc.Get(ctx, id)
+		if err != nil {
+			fmt.Fprintf(w, "  %s (missing)\n", id)
+			continue
+		}
+		fmt.Fprintf(w, "  %s  %s  %s  %s\n", id, truncate(child.Title, 40),
+			formatStatus(string(child.Status)), formatProgress(child))
+	}
+}
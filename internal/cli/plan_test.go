@@ -5,7 +5,9 @@ package cli
 
 import (
 	"testing"
+	"unicode/utf8"
 
+	"github.com/pezware/samedi.dev/internal/tui/components"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -38,6 +40,10 @@ func TestPlanListCmd_Structure(t *testing.T) {
 	sort := cmd.Flags().Lookup("sort")
 	require.NotNil(t, sort)
 	assert.Equal(t, "", sort.DefValue) // Empty means use default (created_at DESC)
+
+	plain := cmd.Flags().Lookup("plain")
+	require.NotNil(t, plain)
+	assert.Equal(t, "false", plain.DefValue)
 }
 
 func TestFormatStatus(t *testing.T) {
@@ -70,13 +76,17 @@ func TestTruncate(t *testing.T) {
 		{"exactly ten", 11, "exactly ten"},
 		{"this is a very long title that should be truncated", 20, "this is a very lo..."},
 		{"", 10, ""},
+		{"日本語の文法を学ぶ", 10, "日本語..."},
+		{"🚀 Rust 非同期", 12, "🚀 Rust ..."},
+		{"中文", 4, "中文"},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.input, func(t *testing.T) {
 			result := truncate(tt.input, tt.maxLen)
 			assert.Equal(t, tt.expected, result)
-			assert.LessOrEqual(t, len(result), tt.maxLen)
+			assert.True(t, utf8.ValidString(result), "runes are never split")
+			assert.LessOrEqual(t, components.Width(result), tt.maxLen)
 		})
 	}
 }
@@ -147,3 +157,22 @@ func TestFormatDuration(t *testing.T) {
 		})
 	}
 }
+
+func TestPlanReindexCmd_Structure(t *testing.T) {
+	cmd := planReindexCmd()
+
+	assert.Equal(t, "reindex", cmd.Use)
+	assert.NotEmpty(t, cmd.Short)
+	assert.Contains(t, cmd.Long, "source of truth")
+
+	assert.NoError(t, cmd.Args(cmd, []string{}))
+	assert.Error(t, cmd.Args(cmd, []string{"extra"}))
+
+	var found bool
+	for _, sub := range planCmd().Commands() {
+		if sub.Name() == "reindex" {
+			found = true
+		}
+	}
+	assert.True(t, found, "plan should have reindex subcommand")
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/spf13/cobra"
+)
+
+// planValidateCmd creates the `samedi plan validate` subcommand.
+func planValidateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate [plan-id...]",
+		Short: "Check plan files for errors and ignored content",
+		Long: `Parse plan markdown files and report problems without changing anything.
+
+Errors stop a plan from loading (bad frontmatter, invalid status, ...).
+Warnings list lines the parser skipped, such as malformed chunk headers,
+unknown fields, or text outside the recognized sections. Skipped content
+is dropped the next time samedi rewrites the plan, so fix warnings after
+editing plans by hand.
+
+With no arguments, every plan in ~/.samedi/plans/ is checked. Exits with
+status 2 if any plan has errors or warnings.
+
+Examples:
+  samedi plan validate
+  samedi plan validate rust-async
+  samedi plan validate --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			svc, err := getPlanService(cmd, "")
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			results, err := svc.Check(context.Background(), args...)
+			if err != nil {
+				return fmt.Errorf("failed to validate plans: %w", err)
+			}
+
+			jsonOutput, err := cmd.Flags().GetBool("json")
+			if err != nil {
+				return fmt.Errorf("failed to get json flag: %w", err)
+			}
+			if jsonOutput {
+				data, err := json.MarshalIndent(results, "", "  ")
+				if err != nil {
+					return fmt.Errorf("failed to marshal JSON: %w", err)
+				}
+				fmt.Println(string(data))
+			} else {
+				displayCheckResults(os.Stdout, results)
+			}
+
+			for _, result := range results {
+				if !result.OK() {
+					return &reportedError{code: ExitInvalid, msg: "plans failed validation"}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// displayCheckResults prints one line per plan, followed by its problems.
+func displayCheckResults(w io.Writer, results []plan.CheckResult) {
+	if len(results) == 0 {
+		fmt.Fprintln(w, "No plans found.")
+		return
+	}
+
+	problems := 0
+	for _, result := range results {
+		switch {
+		case result.Err != "":
+			fmt.Fprintf(w, "✗ %s: %s\n", result.ID, result.Err)
+		case len(result.Warnings) > 0:
+			fmt.Fprintf(w, "⚠ %s\n", result.ID)
+		default:
+			fmt.Fprintf(w, "✓ %s\n", result.ID)
+		}
+		for _, warning := range result.Warnings {
+			fmt.Fprintf(w, "    %s\n", warning)
+		}
+		if !result.OK() {
+			problems++
+		}
+	}
+
+	if problems == 0 {
+		fmt.Fprintf(w, "\n✓ %d plan(s) OK\n", len(results))
+	} else {
+		fmt.Fprintf(w, "\n%d of %d plan(s) have problems\n", problems, len(results))
+	}
+}
+
+// printParseWarnings lists content the parser skipped in a plan file.
+func printParseWarnings(w io.Writer, planID string, warnings []plan.Warning) {
+	if len(warnings) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "⚠ %d line(s) in %s were ignored:\n", len(warnings), planID)
+	for _, warning := range warnings {
+		fmt.Fprintf(w, "    %s\n", warning)
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanValidateCmd_Structure(t *testing.T) {
+	cmd := planValidateCmd()
+
+	assert.Equal(t, "validate [plan-id...]", cmd.Use)
+	assert.NotEmpty(t, cmd.Short)
+
+	var found bool
+	for _, sub := range planCmd().Commands() {
+		if sub.Name() == "validate" {
+			found = true
+		}
+	}
+	assert.True(t, found, "plan should have validate subcommand")
+}
+
+func TestDisplayCheckResults(t *testing.T) {
+	var buf bytes.Buffer
+	displayCheckResults(&buf, []plan.CheckResult{
+		{ID: "clean"},
+		{ID: "sloppy", Warnings: []plan.Warning{{Line: 14, Message: "text in chunk-001 ignored"}}},
+		{ID: "broken", Err: "failed to parse plan: missing frontmatter delimiter at start"},
+	})
+
+	out := buf.String()
+	assert.Contains(t, out, "✓ clean")
+	assert.Contains(t, out, "⚠ sloppy\n    line 14: text in chunk-001 ignored")
+	assert.Contains(t, out, "✗ broken: failed to parse plan")
+	assert.Contains(t, out, "2 of 3 plan(s) have problems")
+}
+
+func TestPrintParseWarnings(t *testing.T) {
+	var buf bytes.Buffer
+	printParseWarnings(&buf, "rust", nil)
+	assert.Empty(t, buf.String())
+
+	printParseWarnings(&buf, "rust", []plan.Warning{{Line: 3, Message: "duplicate chunk ID \"chunk-001\""}})
+	assert.Equal(t, "⚠ 1 line(s) in rust were ignored:\n    line 3: duplicate chunk ID \"chunk-001\"\n", buf.String())
+}
@@ -0,0 +1,377 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+	"github.com/pezware/samedi.dev/internal/week"
+	"github.com/spf13/cobra"
+)
+
+// planWeekCmd creates the `samedi plan week` subcommand.
+func planWeekCmd() *cobra.Command {
+	var (
+		hours    float64
+		goals    string
+		planIDs  []string
+		useLLM   bool
+		model    string
+		next     bool
+		dryRun   bool
+		noPrompt bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "week",
+		Short: "Plan this week's chunks and commit to them",
+		Long: `Propose a concrete set of chunks for the week from your active plans
+and save it as the week's commitment.
+
+The built-in planner fills your available time round-robin across plans,
+taking each plan's chunks in order. With --llm, the configured LLM picks
+from the same open chunks, weighing your goals for the week.
+
+Availability defaults to learning.weekly_goal_hours. Saving replaces any
+earlier commitment for the same week. Track adherence with
+'samedi plan week review'.
+
+Examples:
+  samedi plan week --hours 6
+  samedi plan week --hours 8 --goals "finish the async chapter" --llm
+  samedi plan week --plan rust-async --plan french-b1
+  samedi plan week --next --dry-run       # Preview next week
+  samedi plan week show                   # This week's commitment
+  samedi plan week review --last          # How last week went`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := runPlanWeek(cmd, planWeekOptions{
+				hours:    hours,
+				goals:    goals,
+				planIDs:  planIDs,
+				useLLM:   useLLM,
+				model:    model,
+				next:     next,
+				dryRun:   dryRun,
+				noPrompt: noPrompt,
+			}); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Float64Var(&hours, "hours", 0, "hours available this week (default: learning.weekly_goal_hours)")
+	cmd.Flags().StringVar(&goals, "goals", "", "what you want to get done this week")
+	cmd.Flags().StringArrayVar(&planIDs, "plan", nil, "only schedule these plans (repeatable; default: all active plans)")
+	cmd.Flags().BoolVar(&useLLM, "llm", false, "let the configured LLM choose the chunks")
+	cmd.Flags().StringVar(&model, "model", "", "LLM model override (with --llm)")
+	cmd.Flags().BoolVar(&next, "next", false, "plan next week instead of the current one")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "show the proposal without saving it")
+	cmd.Flags().BoolVar(&noPrompt, "no-prompt", false, "save without asking for confirmation")
+
+	cmd.AddCommand(planWeekShowCmd())
+	cmd.AddCommand(planWeekReviewCmd())
+
+	return cmd
+}
+
+// planWeekShowCmd creates the `samedi plan week show` subcommand.
+func planWeekShowCmd() *cobra.Command {
+	var next bool
+
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Show the week's committed chunks",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := getConfig(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			svc, err := getWeekService(cmd, cfg, false, "")
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			weekStart := targetWeek(cfg, time.Now(), next, false)
+			commitment, err := svc.Get(context.Background(), weekStart)
+			if err != nil {
+				return err
+			}
+			if commitment == nil {
+				fmt.Printf("No commitment for the week of %s.\n", week.Label(weekStart))
+				fmt.Println("\nPlan one: samedi plan week")
+				return nil
+			}
+
+			jsonOutput, _ := cmd.Flags().GetBool("json")
+			if jsonOutput {
+				if err := printJSON(commitment); err != nil {
+					return err
+				}
+				return nil
+			}
+			renderCommitment(os.Stdout, commitment)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&next, "next", false, "show next week's commitment")
+
+	return cmd
+}
+
+// planWeekReviewCmd creates the `samedi plan week review` subcommand.
+func planWeekReviewCmd() *cobra.Command {
+	var last bool
+
+	cmd := &cobra.Command{
+		Use:   "review",
+		Short: "Compare the week's commitment with what you studied",
+		Long: `Show which committed chunks are done, the time logged on them during
+the week, and time spent on anything else.
+
+Examples:
+  samedi plan week review           # This week so far
+  samedi plan week review --last    # Last week`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := getConfig(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			svc, err := getWeekService(cmd, cfg, false, "")
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			weekStart := targetWeek(cfg, time.Now(), false, last)
+			review, err := svc.Review(context.Background(), weekStart)
+			if err != nil {
+				return fmt.Errorf("failed to review week: %w", err)
+			}
+			if review == nil {
+				fmt.Printf("No commitment for the week of %s.\n", week.Label(weekStart))
+				return nil
+			}
+
+			jsonOutput, _ := cmd.Flags().GetBool("json")
+			if jsonOutput {
+				output := map[string]interface{}{
+					"review":    review,
+					"adherence": review.Adherence(),
+				}
+				if err := printJSON(output); err != nil {
+					return err
+				}
+				return nil
+			}
+			renderWeekReview(os.Stdout, review)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&last, "last", false, "review last week")
+
+	return cmd
+}
+
+type planWeekOptions struct {
+	hours    float64
+	goals    string
+	planIDs  []string
+	useLLM   bool
+	model    string
+	next     bool
+	dryRun   bool
+	noPrompt bool
+}
+
+func runPlanWeek(cmd *cobra.Command, opts planWeekOptions) error {
+	cfg, err := getConfig(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	hours := opts.hours
+	if hours == 0 {
+		hours = float64(cfg.Learning.WeeklyGoalHours)
+	}
+	if hours <= 0 {
+		return &usageError{command: cmd.CommandPath(), err: fmt.Errorf("how much time do you have? pass --hours or set learning.weekly_goal_hours")}
+	}
+
+	svc, err := getWeekService(cmd, cfg, opts.useLLM, opts.model)
+	if err != nil {
+		return fmt.Errorf("failed to initialize: %w", err)
+	}
+
+	ctx := context.Background()
+	weekStart := targetWeek(cfg, time.Now(), opts.next, false)
+
+	if opts.useLLM {
+		fmt.Println("→ Asking the LLM to plan your week...")
+	}
+	proposal, err := svc.Propose(ctx, week.ProposeRequest{
+		WeekStart:        weekStart,
+		AvailableMinutes: int(hours * 60),
+		Goals:            opts.goals,
+		PlanIDs:          opts.planIDs,
+		UseLLM:           opts.useLLM,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to plan week: %w", err)
+	}
+	if proposal.Warning != "" {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", proposal.Warning)
+	}
+
+	renderCommitment(os.Stdout, proposal.Commitment)
+
+	if opts.dryRun {
+		return nil
+	}
+
+	existing, err := svc.Get(ctx, weekStart)
+	if err != nil {
+		return err
+	}
+
+	if isInteractive(opts.noPrompt) {
+		question := "\nCommit to this plan?"
+		if existing != nil {
+			question = "\nReplace this week's existing commitment?"
+		}
+		confirmed, err := promptYesNo(bufio.NewReader(os.Stdin), os.Stdout, question, true)
+		if err != nil {
+			return fmt.Errorf("failed to read answer: %w", err)
+		}
+		if !confirmed {
+			fmt.Println("Not saved.")
+			return nil
+		}
+	}
+
+	if err := svc.Commit(ctx, proposal.Commitment); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n✓ Committed to %d chunk(s) for the week of %s\n", len(proposal.Commitment.Items), week.Label(weekStart))
+	fmt.Printf("  Track it: samedi plan week review\n")
+	return nil
+}
+
+// targetWeek returns the start of the current week, or the next or previous one.
+func targetWeek(cfg *config.Config, now time.Time, next, last bool) time.Time {
+	start := week.StartOf(now, cfg.TUI.FirstDayOfWeek == "sunday")
+	switch {
+	case next:
+		return start.AddDate(0, 0, 7)
+	case last:
+		return start.AddDate(0, 0, -7)
+	default:
+		return start
+	}
+}
+
+// renderCommitment prints the committed chunks with their estimated time.
+func renderCommitment(w io.Writer, commitment *week.Commitment) {
+	fmt.Fprintf(w, "Week of %s — %s available\n", week.Label(commitment.WeekStart), formatWeekHours(commitment.AvailableMinutes))
+	if commitment.Goals != "" {
+		fmt.Fprintf(w, "Goals: %s\n", commitment.Goals)
+	}
+	fmt.Fprintln(w)
+
+	tw := components.NewTabWriter(w, 2)
+	for i, item := range commitment.Items {
+		fmt.Fprintf(tw, "  %d.\t%s/%s\t%s\t%d min\n", i+1, item.PlanID, item.ChunkID, truncate(item.Title, 40), item.Minutes)
+	}
+	tw.Flush()
+
+	source := ""
+	if commitment.LLMAssisted {
+		source = " (LLM-assisted)"
+	}
+	fmt.Fprintf(w, "\nCommitted: %s of %s%s\n",
+		formatWeekHours(commitment.CommittedMinutes()), formatWeekHours(commitment.AvailableMinutes), source)
+}
+
+// renderWeekReview prints each committed chunk's outcome and the time split.
+func renderWeekReview(w io.Writer, review *week.Review) {
+	commitment := review.Commitment
+	fmt.Fprintf(w, "Week of %s — %d/%d chunks done (%d%%)\n\n",
+		week.Label(commitment.WeekStart), review.CompletedItems, len(review.Items), review.Adherence())
+
+	tw := components.NewTabWriter(w, 2)
+	for _, item := range review.Items {
+		status := string(item.Status)
+		if status == "" {
+			status = "missing"
+		}
+		fmt.Fprintf(tw, "  %s\t%s/%s\t%s\t%s\t%d min logged\n",
+			getStatusIcon(item.Status), item.PlanID, item.ChunkID, truncate(item.Title, 40), status, item.MinutesLogged)
+	}
+	tw.Flush()
+
+	fmt.Fprintf(w, "\nTime: %s on committed chunks, %s unplanned (%s committed)\n",
+		formatWeekHours(review.LoggedMinutes),
+		formatWeekHours(review.UnplannedMinutes),
+		formatWeekHours(commitment.CommittedMinutes()))
+}
+
+// formatWeekHours renders minutes as hours, e.g. "5.5h".
+func formatWeekHours(minutes int) string {
+	return fmt.Sprintf("%.1fh", float64(minutes)/60)
+}
+
+// getWeekService initializes the week service. With useLLM, the configured
+// LLM provider (metered in the cost ledger) is attached for proposals.
+func getWeekService(cmd *cobra.Command, cfg *config.Config, useLLM bool, model string) (*week.Service, error) {
+	planSvc, err := getPlanService(cmd, model)
+	if err != nil {
+		return nil, err
+	}
+	sessionSvc, err := getSessionService(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	paths, err := getPaths()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get paths: %w", err)
+	}
+	db, err := sharedDatabase(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := week.NewService(week.NewSQLiteRepository(db), planSvc, sessionSvc)
+
+	if useLLM {
+		if model == "" {
+			model = cfg.LLM.DefaultModel
+		}
+		provider, llmConfig, err := newLLMProvider(cfg, model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create LLM provider: %w", err)
+		}
+		if mockFallback(cfg) {
+			warnMockProvider(os.Stderr)
+		}
+		svc.SetLLMProvider(meterLLMProvider(provider, llmConfig, db))
+	}
+
+	return svc, nil
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/week"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanWeekCmd_Structure(t *testing.T) {
+	cmd := planWeekCmd()
+
+	assert.Equal(t, "week", cmd.Use)
+	for _, name := range []string{"hours", "goals", "plan", "llm", "model", "next", "dry-run", "no-prompt"} {
+		assert.NotNil(t, cmd.Flags().Lookup(name), "missing flag %s", name)
+	}
+
+	names := make([]string, 0)
+	for _, sub := range cmd.Commands() {
+		names = append(names, sub.Name())
+	}
+	assert.ElementsMatch(t, []string{"show", "review"}, names)
+}
+
+func TestTargetWeek(t *testing.T) {
+	cfg := config.DefaultConfig()
+	wednesday := time.Date(2025, 10, 15, 12, 0, 0, 0, time.Local)
+
+	assert.Equal(t, time.Date(2025, 10, 13, 0, 0, 0, 0, time.Local), targetWeek(cfg, wednesday, false, false))
+	assert.Equal(t, time.Date(2025, 10, 20, 0, 0, 0, 0, time.Local), targetWeek(cfg, wednesday, true, false))
+	assert.Equal(t, time.Date(2025, 10, 6, 0, 0, 0, 0, time.Local), targetWeek(cfg, wednesday, false, true))
+
+	cfg.TUI.FirstDayOfWeek = "sunday"
+	assert.Equal(t, time.Date(2025, 10, 12, 0, 0, 0, 0, time.Local), targetWeek(cfg, wednesday, false, false))
+}
+
+func TestRenderCommitment(t *testing.T) {
+	commitment := &week.Commitment{
+		WeekStart:        time.Date(2025, 10, 13, 0, 0, 0, 0, time.Local),
+		Goals:            "finish pinning",
+		AvailableMinutes: 360,
+		LLMAssisted:      true,
+		Items: []week.Item{
+			{PlanID: "rust", ChunkID: "chunk-002", Title: "Pinning", Minutes: 60},
+			{PlanID: "french", ChunkID: "chunk-001", Title: "Subjunctive", Minutes: 45},
+		},
+	}
+
+	var out bytes.Buffer
+	renderCommitment(&out, commitment)
+	text := out.String()
+
+	assert.Contains(t, text, "Week of 2025-10-13 — 6.0h available")
+	assert.Contains(t, text, "Goals: finish pinning")
+	assert.Contains(t, text, "rust/chunk-002")
+	assert.Contains(t, text, "45 min")
+	assert.Contains(t, text, "Committed: 1.8h of 6.0h (LLM-assisted)")
+}
+
+func TestRenderWeekReview(t *testing.T) {
+	review := &week.Review{
+		Commitment: &week.Commitment{
+			WeekStart: time.Date(2025, 10, 13, 0, 0, 0, 0, time.Local),
+			Items:     []week.Item{{Minutes: 60}, {Minutes: 60}},
+		},
+		Items: []week.ItemProgress{
+			{Item: week.Item{PlanID: "rust", ChunkID: "chunk-002", Title: "Pinning", Minutes: 60}, Status: plan.StatusCompleted, MinutesLogged: 70, Done: true},
+			{Item: week.Item{PlanID: "gone", ChunkID: "chunk-001", Minutes: 60}},
+		},
+		CompletedItems:   1,
+		LoggedMinutes:    70,
+		UnplannedMinutes: 30,
+	}
+
+	var out bytes.Buffer
+	renderWeekReview(&out, review)
+	text := out.String()
+
+	require.Contains(t, text, "1/2 chunks done (50%)")
+	assert.Contains(t, text, "70 min logged")
+	assert.Contains(t, text, "missing")
+	assert.Contains(t, text, "Time: 1.2h on committed chunks, 0.5h unplanned (2.0h committed)")
+}
+
+func TestPlanWeekCmd_NoHoursIsUsageError(t *testing.T) {
+	assert.Equal(t, ExitInvalid, executeExitCode(t, planWeekCmd()))
+}
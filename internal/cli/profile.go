@@ -0,0 +1,271 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+	"github.com/spf13/cobra"
+)
+
+// defaultProfile names the storage root at storage.data_dir itself.
+const defaultProfile = "default"
+
+// profileEnv selects a profile for one shell, like --profile.
+const profileEnv = "SAMEDI_PROFILE"
+
+// profileFlag holds the global --profile flag.
+var profileFlag string
+
+// activeProfile returns the profile in use: --profile, then
+// SAMEDI_PROFILE, then storage.profile, then the default profile.
+func activeProfile(cfg *config.Config) (string, error) {
+	name := profileFlag
+	if name == "" {
+		name = os.Getenv(profileEnv)
+	}
+	if name == "" {
+		name = cfg.Storage.Profile
+	}
+	if name == "" {
+		return defaultProfile, nil
+	}
+	if err := config.ValidateProfileName(name); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+func profileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "profile",
+		Short: "Manage separate learning profiles",
+		Long: `Keep separate learning contexts, such as work and personal, each with
+its own plans, flashcards, sessions, and stats.
+
+The default profile is the data directory itself; named profiles live in
+its profiles/ folder. Pick one per command with --profile, per shell with
+SAMEDI_PROFILE, or for every command with 'samedi profile switch'. The
+config file is shared by all profiles.
+
+Examples:
+  samedi profile create work
+  samedi profile switch work
+  samedi profile list
+  samedi --profile personal plan list
+  SAMEDI_PROFILE=work samedi status`,
+	}
+
+	cmd.AddCommand(profileListCmd())
+	cmd.AddCommand(mutating(keepsData(profileCreateCmd())))
+	cmd.AddCommand(profileSwitchCmd())
+
+	return cmd
+}
+
+// profileInfo is one row of `samedi profile list`.
+type profileInfo struct {
+	Name   string `json:"name"`
+	Path   string `json:"path"`
+	Active bool   `json:"active"`
+}
+
+func profileListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List profiles, marking the active one",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := config.Load()
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			profiles, err := listProfiles(cfg)
+			if err != nil {
+				return err
+			}
+
+			jsonOutput, err := cmd.Flags().GetBool("json")
+			if err != nil {
+				return fmt.Errorf("failed to get json flag: %w", err)
+			}
+			if jsonOutput {
+				return printJSON(profiles)
+			}
+
+			renderProfileList(os.Stdout, profiles)
+			return nil
+		},
+	}
+}
+
+// listProfiles returns the default profile followed by the named ones.
+// The active profile is included even before it has any data.
+func listProfiles(cfg *config.Config) ([]profileInfo, error) {
+	root, err := rootPaths(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get paths: %w", err)
+	}
+	active, err := activeProfile(cfg)
+	if err != nil {
+		return nil, err
+	}
+	names, err := root.Profiles()
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := []profileInfo{{Name: defaultProfile, Path: root.BaseDir, Active: active == defaultProfile}}
+	seen := active == defaultProfile
+	for _, name := range names {
+		profiles = append(profiles, profileInfo{Name: name, Path: root.Profile(name).BaseDir, Active: name == active})
+		seen = seen || name == active
+	}
+	if !seen {
+		profiles = append(profiles, profileInfo{Name: active, Path: root.Profile(active).BaseDir, Active: true})
+	}
+	return profiles, nil
+}
+
+// renderProfileList writes the profiles with "*" beside the active one.
+func renderProfileList(w io.Writer, profiles []profileInfo) {
+	tw := components.NewTabWriter(w, 2)
+	fmt.Fprintln(tw, "  PROFILE\tPATH")
+	for _, p := range profiles {
+		marker := " "
+		if p.Active {
+			marker = "*"
+		}
+		fmt.Fprintf(tw, "%s %s\t%s\n", marker, p.Name, p.Path)
+	}
+	_ = tw.Flush()
+}
+
+func profileCreateCmd() *cobra.Command {
+	var switchTo bool
+
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create an empty profile",
+		Long: `Create a profile with its own empty plans, cards, and session database.
+
+Examples:
+  samedi profile create work
+  samedi profile create work --switch   # and make it the active profile`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			name := args[0]
+			if err := config.ValidateProfileName(name); err != nil {
+				return err
+			}
+			if name == defaultProfile {
+				return fmt.Errorf("the %s profile always exists", defaultProfile)
+			}
+
+			cfg, err := config.Load()
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			root, err := rootPaths(cfg)
+			if err != nil {
+				return fmt.Errorf("failed to get paths: %w", err)
+			}
+			paths := root.Profile(name)
+			if _, err := os.Stat(paths.DatabasePath); err == nil {
+				return fmt.Errorf("profile %s already exists", name)
+			}
+			if err := createProfile(paths); err != nil {
+				return err
+			}
+			fmt.Printf("✓ Created profile %s at %s\n", name, paths.BaseDir)
+
+			if !switchTo {
+				fmt.Printf("\nUse it: samedi profile switch %s (or --profile %s)\n", name, name)
+				return nil
+			}
+			return switchProfile(cfg, name)
+		},
+	}
+
+	cmd.Flags().BoolVar(&switchTo, "switch", false, "make the new profile active")
+
+	return cmd
+}
+
+// createProfile lays out a profile's directories and migrated database.
+func createProfile(paths *storage.Paths) error {
+	if err := paths.EnsureDirectories(); err != nil {
+		return fmt.Errorf("failed to create directories: %w", err)
+	}
+
+	db, err := storage.NewSQLiteDB(paths.DatabasePath)
+	if err != nil {
+		return fmt.Errorf("failed to initialize database: %w", err)
+	}
+	defer db.Close()
+
+	if err := storage.NewMigrator(db).Migrate(); err != nil {
+		return fmt.Errorf("failed to run migrations: %w", err)
+	}
+	return nil
+}
+
+func profileSwitchCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "switch <name>",
+		Short: "Make a profile active for every command",
+		Long: `Make a profile active by saving it as storage.profile.
+
+--profile and SAMEDI_PROFILE still take precedence for a single command
+or shell. Switch to "default" to go back to the data directory itself.
+
+Examples:
+  samedi profile switch work
+  samedi profile switch default`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			name := args[0]
+			if err := config.ValidateProfileName(name); err != nil {
+				return err
+			}
+
+			cfg, err := config.Load()
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			if name != defaultProfile {
+				root, err := rootPaths(cfg)
+				if err != nil {
+					return fmt.Errorf("failed to get paths: %w", err)
+				}
+				if _, err := os.Stat(root.Profile(name).DatabasePath); err != nil {
+					return fmt.Errorf("profile %s not found (create it with 'samedi profile create %s')", name, name)
+				}
+			}
+			return switchProfile(cfg, name)
+		},
+	}
+}
+
+// switchProfile saves name as the active profile.
+func switchProfile(cfg *config.Config, name string) error {
+	cfg.Storage.Profile = name
+	if name == defaultProfile {
+		cfg.Storage.Profile = ""
+	}
+	if err := config.Save(cfg); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	fmt.Printf("✓ Switched to profile %s\n", name)
+	if env := os.Getenv(profileEnv); env != "" && env != name {
+		fmt.Printf("  Note: %s=%s overrides this in the current shell\n", profileEnv, env)
+	}
+	return nil
+}
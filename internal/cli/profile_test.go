@@ -0,0 +1,97 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setProfileFlag(t *testing.T, name string) {
+	t.Helper()
+	original := profileFlag
+	t.Cleanup(func() { profileFlag = original })
+	profileFlag = name
+}
+
+func TestActiveProfile_Precedence(t *testing.T) {
+	setProfileFlag(t, "")
+	t.Setenv(profileEnv, "")
+	cfg := config.DefaultConfig()
+
+	name, err := activeProfile(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, defaultProfile, name)
+
+	cfg.Storage.Profile = "personal"
+	name, _ = activeProfile(cfg)
+	assert.Equal(t, "personal", name)
+
+	t.Setenv(profileEnv, "work")
+	name, _ = activeProfile(cfg)
+	assert.Equal(t, "work", name, "the environment beats the config")
+
+	profileFlag = "demo"
+	name, _ = activeProfile(cfg)
+	assert.Equal(t, "demo", name, "the flag beats the environment")
+
+	profileFlag = "../escape"
+	_, err = activeProfile(cfg)
+	assert.Error(t, err)
+}
+
+func TestConfigPaths_Profile(t *testing.T) {
+	setProfileFlag(t, "")
+	t.Setenv(profileEnv, "")
+	cfg := config.DefaultConfig()
+	cfg.Storage.DataDir = t.TempDir()
+
+	paths, err := configPaths(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, cfg.Storage.DataDir, paths.BaseDir)
+
+	cfg.Storage.Profile = "work"
+	paths, err = configPaths(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(cfg.Storage.DataDir, "profiles", "work"), paths.BaseDir)
+	assert.Equal(t, filepath.Join(cfg.Storage.DataDir, "profiles", "work", "sessions.db"), paths.DatabasePath)
+}
+
+func TestListProfiles(t *testing.T) {
+	setProfileFlag(t, "")
+	t.Setenv(profileEnv, "")
+	cfg := config.DefaultConfig()
+	cfg.Storage.DataDir = t.TempDir()
+
+	root, err := rootPaths(cfg)
+	require.NoError(t, err)
+	require.NoError(t, createProfile(root.Profile("work")))
+	require.NoError(t, createProfile(root.Profile("personal")))
+
+	cfg.Storage.Profile = "work"
+	profiles, err := listProfiles(cfg)
+	require.NoError(t, err)
+	require.Len(t, profiles, 3)
+	assert.Equal(t, []string{"default", "personal", "work"}, []string{profiles[0].Name, profiles[1].Name, profiles[2].Name})
+	assert.False(t, profiles[0].Active)
+	assert.True(t, profiles[2].Active)
+
+	var buf bytes.Buffer
+	renderProfileList(&buf, profiles)
+	assert.Contains(t, buf.String(), "* work")
+	assert.Contains(t, buf.String(), "  default")
+
+	// A profile selected before it has data still shows as active
+	profileFlag = "scratch"
+	profiles, err = listProfiles(cfg)
+	require.NoError(t, err)
+	require.Len(t, profiles, 4)
+	assert.Equal(t, "scratch", profiles[3].Name)
+	assert.True(t, profiles[3].Active)
+}
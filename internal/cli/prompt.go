@@ -1,7 +1,12 @@
 package cli
 
 import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
 	"os"
+	"strings"
 
 	"golang.org/x/term"
 )
@@ -13,3 +18,34 @@ func isInteractive(noPrompt bool) bool {
 	}
 	return term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd()))
 }
+
+// promptYesNo asks a yes/no question. Blank input or EOF picks defaultYes.
+func promptYesNo(reader *bufio.Reader, writer io.Writer, question string, defaultYes bool) (bool, error) {
+	hint := "[y/N]"
+	if defaultYes {
+		hint = "[Y/n]"
+	}
+
+	for {
+		fmt.Fprintf(writer, "%s %s: ", question, hint)
+
+		line, err := reader.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return false, err
+		}
+
+		switch strings.TrimSpace(strings.ToLower(line)) {
+		case "":
+			return defaultYes, nil
+		case "y", "yes":
+			return true, nil
+		case "n", "no":
+			return false, nil
+		}
+
+		fmt.Fprintln(writer, "Please answer y or n.")
+		if errors.Is(err, io.EOF) {
+			return defaultYes, nil
+		}
+	}
+}
@@ -4,10 +4,14 @@
 package cli
 
 import (
+	"bufio"
+	"bytes"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsInteractive_WithNoPromptFlag(t *testing.T) {
@@ -123,3 +127,36 @@ func TestIsInteractive_UsageScenarios(t *testing.T) {
 		})
 	}
 }
+
+func TestPromptYesNo(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		defaultYes bool
+		want       bool
+	}{
+		{name: "blank takes default yes", input: "\n", defaultYes: true, want: true},
+		{name: "blank takes default no", input: "\n", defaultYes: false, want: false},
+		{name: "EOF takes default", input: "", defaultYes: true, want: true},
+		{name: "explicit no", input: "n\n", defaultYes: true, want: false},
+		{name: "explicit yes", input: "YES\n", defaultYes: false, want: true},
+		{name: "invalid then no", input: "maybe\nno\n", defaultYes: true, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var output bytes.Buffer
+			got, err := promptYesNo(bufio.NewReader(strings.NewReader(tt.input)), &output, "Start now?", tt.defaultYes)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPromptYesNo_Hint(t *testing.T) {
+	var output bytes.Buffer
+	_, err := promptYesNo(bufio.NewReader(strings.NewReader("maybe\n")), &output, "Start now?", true)
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), "Start now? [Y/n]: ")
+	assert.Contains(t, output.String(), "Please answer y or n.")
+}
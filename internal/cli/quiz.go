@@ -0,0 +1,181 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/quiz"
+	"github.com/pezware/samedi.dev/internal/tui"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+	"github.com/spf13/cobra"
+)
+
+// quizCmd creates the `samedi quiz` command.
+func quizCmd() *cobra.Command {
+	var (
+		questions int
+		model     string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "quiz <plan-id> <chunk-id>",
+		Short: "Test yourself on a chunk",
+		Long: `Generate comprehension questions from a chunk's objectives and
+resources, answer them in an interactive quiz, and have the LLM grade
+your free-text answers.
+
+Each graded quiz is recorded on the chunk. Latest scores appear in
+'samedi stats <plan-id>'. Abandoning the quiz (Esc) records nothing.
+
+Examples:
+  samedi quiz rust-async chunk-003
+  samedi quiz rust-async chunk-003 --questions 3
+  samedi quiz french-b1 chunk-010 --model claude-haiku`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runQuiz(cmd, args[0], args[1], questions, model)
+		},
+	}
+
+	cmd.Flags().IntVarP(&questions, "questions", "n", quiz.DefaultQuestionCount, "number of questions")
+	cmd.Flags().StringVar(&model, "model", "", "LLM model override")
+
+	return cmd
+}
+
+// runQuiz generates a quiz, runs it in the TUI, and records the result.
+func runQuiz(cmd *cobra.Command, planID, chunkID string, questions int, model string) error {
+	ctx := context.Background()
+
+	if !isInteractive(false) {
+		return fmt.Errorf("quiz needs an interactive terminal")
+	}
+
+	cfg, err := getConfig(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	planSvc, err := getPlanService(cmd, model)
+	if err != nil {
+		return fmt.Errorf("failed to initialize plan service: %w", err)
+	}
+	p, err := planSvc.Get(ctx, planID)
+	if err != nil {
+		return fmt.Errorf("failed to load plan: %w", err)
+	}
+
+	svc, err := getQuizService(cfg, true, model)
+	if err != nil {
+		return fmt.Errorf("failed to initialize quiz service: %w", err)
+	}
+
+	fmt.Printf("Generating %d questions for %s/%s…\n", questions, planID, chunkID)
+	q, err := svc.Generate(ctx, p, chunkID, questions)
+	if err != nil {
+		return fmt.Errorf("failed to generate quiz: %w", err)
+	}
+
+	if err := applyTheme(cfg); err != nil {
+		return err
+	}
+	runner := tui.NewQuizModel(q, func(ctx context.Context, answers []string) (*quiz.Attempt, error) {
+		return svc.Grade(ctx, q, answers)
+	})
+	if _, err := tea.NewProgram(runner).Run(); err != nil {
+		return fmt.Errorf("failed to run quiz: %w", err)
+	}
+
+	attempt := runner.Attempt()
+	if attempt == nil {
+		fmt.Println("Quiz abandoned; nothing recorded.")
+		return nil
+	}
+
+	if err := svc.Record(ctx, attempt); err != nil {
+		return err
+	}
+	fmt.Printf("✓ Scored %d%% on %s/%s\n", attempt.Score, planID, chunkID)
+	return nil
+}
+
+// printQuizScores writes the latest quiz score of each quizzed chunk.
+// Nothing is written if the plan has no quizzes.
+func printQuizScores(ctx context.Context, w io.Writer, planID string) error {
+	svc, err := getQuizService(nil, false, "")
+	if err != nil {
+		return fmt.Errorf("failed to initialize quiz service: %w", err)
+	}
+
+	latest, err := svc.Latest(ctx, planID)
+	if err != nil {
+		return err
+	}
+
+	renderQuizScores(w, latest)
+	return nil
+}
+
+// renderQuizScores writes latest scores by chunk, with their average.
+func renderQuizScores(w io.Writer, latest map[string]*quiz.Attempt) {
+	if len(latest) == 0 {
+		return
+	}
+
+	chunkIDs := make([]string, 0, len(latest))
+	total := 0
+	for chunkID, attempt := range latest {
+		chunkIDs = append(chunkIDs, chunkID)
+		total += attempt.Score
+	}
+	sort.Strings(chunkIDs)
+
+	fmt.Fprintf(w, "🧠 Quiz Scores:\n")
+	tw := components.NewTabWriter(w, 2)
+	for _, chunkID := range chunkIDs {
+		attempt := latest[chunkID]
+		fmt.Fprintf(tw, "   %s\t%d%%\t%s\n", chunkID, attempt.Score, attempt.TakenAt.Local().Format("Jan 2, 2006"))
+	}
+	tw.Flush()
+	fmt.Fprintf(w, "   Average:  %d%% across %d chunk(s)\n\n", total/len(latest), len(latest))
+}
+
+// getQuizService initializes the quiz service. With withLLM, it uses the
+// configured LLM (metered in the cost ledger); otherwise it can only read
+// attempt history and cfg may be nil.
+func getQuizService(cfg *config.Config, withLLM bool, model string) (*quiz.Service, error) {
+	paths, err := getPaths()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get paths: %w", err)
+	}
+
+	db, err := sharedDatabase(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	repo := quiz.NewSQLiteRepository(db)
+	if !withLLM {
+		return quiz.NewService(repo, nil), nil
+	}
+
+	if model == "" {
+		model = cfg.LLM.DefaultModel
+	}
+	provider, llmConfig, err := newLLMProvider(cfg, model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create LLM provider: %w", err)
+	}
+	if mockFallback(cfg) {
+		warnMockProvider(os.Stderr)
+	}
+	return quiz.NewService(repo, meterLLMProvider(provider, llmConfig, db)), nil
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/quiz"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuizCmd_Structure(t *testing.T) {
+	cmd := quizCmd()
+
+	assert.Equal(t, "quiz <plan-id> <chunk-id>", cmd.Use)
+	flag := cmd.Flags().Lookup("questions")
+	if assert.NotNil(t, flag) {
+		assert.Equal(t, "5", flag.DefValue)
+		assert.Equal(t, "n", flag.Shorthand)
+	}
+	assert.NotNil(t, cmd.Flags().Lookup("model"))
+	assert.Error(t, cmd.Args(cmd, []string{"rust-async"}))
+}
+
+func TestRenderQuizScores(t *testing.T) {
+	takenAt := time.Date(2025, 10, 14, 12, 0, 0, 0, time.Local)
+	latest := map[string]*quiz.Attempt{
+		"chunk-002": {ChunkID: "chunk-002", Score: 60, TakenAt: takenAt},
+		"chunk-001": {ChunkID: "chunk-001", Score: 90, TakenAt: takenAt},
+	}
+
+	var out bytes.Buffer
+	renderQuizScores(&out, latest)
+	text := out.String()
+
+	assert.Contains(t, text, "Quiz Scores")
+	assert.Less(t, bytes.Index(out.Bytes(), []byte("chunk-001")), bytes.Index(out.Bytes(), []byte("chunk-002")))
+	assert.Contains(t, text, "90%")
+	assert.Contains(t, text, "Oct 14, 2025")
+	assert.Contains(t, text, "Average:  75% across 2 chunk(s)")
+}
+
+func TestRenderQuizScores_Empty(t *testing.T) {
+	var out bytes.Buffer
+	renderQuizScores(&out, nil)
+	assert.Empty(t, out.String())
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// mutatesAnnotation marks commands that write sessions or plans, call an
+// LLM, or otherwise change samedi data. Read-only mode refuses them.
+const mutatesAnnotation = "samedi/mutates"
+
+// keepsDataAnnotation marks mutating commands that leave the data in the
+// database alone, writing only config, files, or a git repository.
+// Scheduled backups don't follow them.
+const keepsDataAnnotation = "samedi/keeps-data"
+
+// ErrReadOnly is returned for commands refused in read-only mode.
+var ErrReadOnly = errors.New("samedi is in read-only mode")
+
+// mutating marks cmd as changing data, so read-only mode refuses it.
+// Only cmd itself is marked; its subcommands keep their own setting.
+func mutating(cmd *cobra.Command) *cobra.Command {
+	if cmd.Annotations == nil {
+		cmd.Annotations = make(map[string]string)
+	}
+	cmd.Annotations[mutatesAnnotation] = "true"
+	return cmd
+}
+
+// keepsData marks cmd as leaving the data in the database alone.
+func keepsData(cmd *cobra.Command) *cobra.Command {
+	if cmd.Annotations == nil {
+		cmd.Annotations = make(map[string]string)
+	}
+	cmd.Annotations[keepsDataAnnotation] = "true"
+	return cmd
+}
+
+// writesData reports whether cmd changes the data in the database: it is
+// mutating and not marked with keepsData. `db migrate` only changes the
+// schema.
+func writesData(cmd *cobra.Command) bool {
+	return cmd.Annotations[mutatesAnnotation] == "true" &&
+		cmd.Annotations[keepsDataAnnotation] != "true" && !setsSchema(cmd)
+}
+
+// readOnlyMode reports whether --read-only or storage.read_only is set.
+// A config that fails to load counts as writable; the command itself will
+// report the broken config.
+func readOnlyMode(cmd *cobra.Command) bool {
+	if flag, err := cmd.Flags().GetBool("read-only"); err == nil && flag {
+		return true
+	}
+	cfg, err := getConfig(cmd)
+	if err != nil {
+		return false
+	}
+	return cfg.Storage.ReadOnly
+}
+
+// checkReadOnly refuses mutating commands in read-only mode. Browsing
+// commands (plan list/show, stats, status, report) and config commands,
+// which are needed to turn the mode off, always run.
+func checkReadOnly(cmd *cobra.Command, _ []string) error {
+	if cmd.Annotations[mutatesAnnotation] != "true" || !readOnlyMode(cmd) {
+		return nil
+	}
+	return refuseReadOnly(cmd, cmd.CommandPath())
+}
+
+// refuseReadOnly returns the ErrReadOnly error for what, a command or a
+// command and the flag that makes it write.
+func refuseReadOnly(cmd *cobra.Command, what string) error {
+	cmd.SilenceUsage = true
+	return fmt.Errorf("%w: '%s' is disabled (drop --read-only or run 'samedi config set storage.read_only false')",
+		ErrReadOnly, what)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runReadOnlyTestCmd runs args against a root with the read-only check,
+// one mutating command (stop), and one browsing command (status).
+func runReadOnlyTestCmd(args ...string) error {
+	root := &cobra.Command{Use: "samedi", PersistentPreRunE: checkReadOnly, SilenceErrors: true}
+	root.PersistentFlags().Bool("read-only", false, "")
+	noop := func(*cobra.Command, []string) {}
+	root.AddCommand(mutating(&cobra.Command{Use: "stop", Run: noop}), &cobra.Command{Use: "status", Run: noop})
+	root.SetArgs(args)
+	return root.Execute()
+}
+
+func TestCheckReadOnly_Flag(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	assert.NoError(t, runReadOnlyTestCmd("stop"), "writable by default")
+
+	err := runReadOnlyTestCmd("stop", "--read-only")
+	assert.ErrorIs(t, err, ErrReadOnly)
+	assert.Contains(t, err.Error(), "'samedi stop'")
+	assert.NoError(t, runReadOnlyTestCmd("status", "--read-only"))
+}
+
+func TestCheckReadOnly_Config(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	cfg := config.DefaultConfig()
+	cfg.Storage.ReadOnly = true
+	require.NoError(t, config.Save(cfg))
+
+	assert.ErrorIs(t, runReadOnlyTestCmd("stop"), ErrReadOnly)
+	assert.NoError(t, runReadOnlyTestCmd("status"))
+}
+
+func TestMutatingCommands(t *testing.T) {
+	for _, path := range [][]string{
+		{"init"}, {"start"}, {"stop"}, {"quiz"}, {"sync"},
+		{"plan", "edit"}, {"plan", "archive"}, {"plan", "unarchive"}, {"plan", "week"},
+		{"plan", "link"}, {"plan", "unlink"},
+		{"db", "vacuum"}, {"jobs", "run"}, {"obsidian", "sync"}, {"session", "dedupe"},
+		{"profile", "create"}, {"encryption", "enable"}, {"encryption", "disable"},
+	} {
+		cmd, _, err := rootCmd.Find(path)
+		require.NoError(t, err, path)
+		assert.Equal(t, "true", cmd.Annotations[mutatesAnnotation], path)
+	}
+
+	for _, path := range [][]string{
+		{"status"}, {"stats"}, {"report"}, {"plan", "list"}, {"plan", "show"},
+		{"plan", "week", "show"}, {"sync", "status"}, {"config", "set"},
+	} {
+		cmd, _, err := rootCmd.Find(path)
+		require.NoError(t, err, path)
+		assert.Empty(t, cmd.Annotations[mutatesAnnotation], path)
+	}
+}
+
+func TestWritesData(t *testing.T) {
+	for _, path := range [][]string{
+		{"init"}, {"start"}, {"stop"}, {"plan", "archive"}, {"session", "dedupe"},
+		{"tag", "rename"}, {"jobs", "run"}, {"sync"},
+	} {
+		cmd, _, err := rootCmd.Find(path)
+		require.NoError(t, err, path)
+		assert.True(t, writesData(cmd), path)
+	}
+
+	for _, path := range [][]string{
+		{"profile", "create"}, {"sync", "init"}, {"obsidian", "sync"}, {"notify", "check"},
+		{"db", "vacuum"}, {"db", "migrate"}, {"config", "set"}, {"status"},
+	} {
+		cmd, _, err := rootCmd.Find(path)
+		require.NoError(t, err, path)
+		assert.False(t, writesData(cmd), path)
+	}
+}
@@ -4,12 +4,17 @@
 package cli
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/export"
 	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/pezware/samedi.dev/internal/tui"
 	"github.com/spf13/cobra"
 )
 
@@ -24,16 +29,43 @@ The report includes:
   - Summary statistics (hours, sessions, streaks)
   - Plan-specific progress and completion
   - Daily breakdown with activity details
+  - Journal excerpts, in this-week and scheduled weekly reports
+  - Session reflections (learning.prompt_reflection), in full and plan reports
 
 Output formats:
   - Markdown (default): formatted markdown file
 
+Saved reports:
+  The report is printed to stdout (--stdout) unless -o or --save is given.
+  -o path.md writes that file, creating missing directories; if it exists,
+  samedi asks before overwriting it (--force skips the question, and is
+  required when not running in a terminal). --save writes to export.dir
+  using the export.report_filename template (default
+  "{{date}}-{{plan}}-{{type}}.md"). Passing a directory to -o uses the same
+  template inside that directory. Generated names never overwrite an
+  existing file; "-2", "-3", ... is appended instead. --dry-run shows where
+  the report would go without writing it.
+
+Scheduled reports:
+  With reports.schedule set to daily, weekly, or monthly, the first samedi
+  command run once a report is due writes it to reports.dir as
+  "<date>-<schedule>.md" (type reports.type). Daily reports cover
+  yesterday, weekly ones the seven days before reports.day (default
+  sunday), and monthly ones the previous month. Only the newest
+  reports.keep scheduled reports are kept (0 keeps all).
+  --auto writes the due report right away (weekly if the schedule is off).
+
 Examples:
   samedi report                          # Generate full report
   samedi report -o stats-2025.md         # Save to specific file
+  samedi report -o notes/q1.md --force   # Overwrite without asking
+  samedi report --save --dry-run         # Show where --save would write
+  samedi report --save                   # Save to ~/samedi-exports/2025-01-15-all-full.md
+  samedi report -o ~/notes/ rust-async   # Save into a directory
   samedi report rust-async               # Generate plan-specific report
   samedi report --range this-week        # Report for current week
-  samedi report --type summary           # Summary only (no daily breakdown)`,
+  samedi report --type summary           # Summary only (no daily breakdown)
+  samedi report --auto                   # Write this week's scheduled report`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
@@ -44,6 +76,11 @@ Examples:
 				return fmt.Errorf("failed to get output flag: %w", err)
 			}
 
+			save, err := cmd.Flags().GetBool("save")
+			if err != nil {
+				return fmt.Errorf("failed to get save flag: %w", err)
+			}
+
 			reportType, err := cmd.Flags().GetString("type")
 			if err != nil {
 				return fmt.Errorf("failed to get type flag: %w", err)
@@ -54,120 +91,367 @@ Examples:
 				return fmt.Errorf("failed to get range flag: %w", err)
 			}
 
-			// Get stats service
-			statsService, err := getStatsService(cmd)
+			toStdout, err := cmd.Flags().GetBool("stdout")
 			if err != nil {
-				return fmt.Errorf("failed to initialize stats service: %w", err)
+				return fmt.Errorf("failed to get stdout flag: %w", err)
+			}
+			if toStdout && (outputFile != "" || save) {
+				return &usageError{command: cmd.CommandPath(), err: fmt.Errorf("--stdout cannot be combined with --output or --save")}
 			}
 
-			// Parse time range
-			var tr stats.TimeRange
-			switch timeRange {
-			case "all":
-				tr = stats.NewTimeRangeAll()
-			case "today":
-				tr = stats.NewTimeRangeToday()
-			case "this-week":
-				tr = stats.NewTimeRangeThisWeek()
-			case "this-month":
-				tr = stats.NewTimeRangeThisMonth()
-			default:
-				return fmt.Errorf("invalid time range: %s (supported: all, today, this-week, this-month)", timeRange)
+			force, err := cmd.Flags().GetBool("force")
+			if err != nil {
+				return fmt.Errorf("failed to get force flag: %w", err)
 			}
 
-			// Generate report based on type
-			var report string
-			exporter := stats.NewExporter()
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				return fmt.Errorf("failed to get dry-run flag: %w", err)
+			}
 
-			if len(args) > 0 {
-				// Plan-specific report
-				planID := args[0]
-				planStats, err := statsService.GetPlanStats(ctx, planID, tr)
-				if err != nil {
-					return fmt.Errorf("failed to get plan stats: %w", err)
+			auto, err := cmd.Flags().GetBool("auto")
+			if err != nil {
+				return fmt.Errorf("failed to get auto flag: %w", err)
+			}
+			if auto {
+				if len(args) > 0 || outputFile != "" || save || toStdout || dryRun {
+					return &usageError{command: cmd.CommandPath(), err: fmt.Errorf("--auto writes the scheduled report to reports.dir and takes no plan ID, --output, --save, --stdout, or --dry-run")}
 				}
+				return runAutoReport(cmd)
+			}
 
-				report, err = exporter.ExportPlanStats(planStats)
-				if err != nil {
-					return fmt.Errorf("failed to export plan stats: %w", err)
-				}
-			} else {
-				// Full or summary report
-				totalStats, err := statsService.GetTotalStats(ctx, tr)
-				if err != nil {
-					return fmt.Errorf("failed to get total stats: %w", err)
-				}
+			// Get stats service
+			statsService, err := getStatsService(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to initialize stats service: %w", err)
+			}
 
-				// Get global streak info (not scoped to time range)
-				currentStreak, longestStreak, err := statsService.GetStreakInfo(ctx)
+			// Parse time range in the user's time zone
+			tr, err := stats.ParseTimeRange(timeRange, userNow(cmd))
+			if err != nil {
+				return err
+			}
+
+			planID := ""
+			if len(args) > 0 {
+				planID = args[0]
+			}
+			report, err := buildReport(ctx, statsService, planID, reportType, tr)
+			if err != nil {
+				return err
+			}
+			if planID == "" && timeRange == "this-week" {
+				cfg, err := getConfig(cmd)
 				if err != nil {
-					return fmt.Errorf("failed to get streak info: %w", err)
+					return fmt.Errorf("failed to load config: %w", err)
 				}
-				totalStats.CurrentStreak = currentStreak
-				totalStats.LongestStreak = longestStreak
-
-				switch reportType {
-				case "summary":
-					// Summary only
-					report, err = exporter.ExportTotalStats(totalStats)
-					if err != nil {
-						return fmt.Errorf("failed to export total stats: %w", err)
-					}
-
-				case "full":
-					// Full report with plans and daily breakdown
-					planStatsMap, err := statsService.GetAllPlanStats(ctx, tr)
-					if err != nil {
-						return fmt.Errorf("failed to get plan stats: %w", err)
-					}
-
-					// Convert map to slice for exporter
-					planStats := make([]stats.PlanStats, 0, len(planStatsMap))
-					for _, ps := range planStatsMap {
-						planStats = append(planStats, ps)
-					}
-
-					dailyStats, err := statsService.GetDailyStats(ctx, tr)
-					if err != nil {
-						return fmt.Errorf("failed to get daily stats: %w", err)
-					}
-
-					report, err = exporter.ExportFullReport(totalStats, planStats, dailyStats)
-					if err != nil {
-						return fmt.Errorf("failed to export full report: %w", err)
-					}
-
-				default:
-					return fmt.Errorf("invalid report type: %s (supported: summary, full)", reportType)
+				if report, err = withJournal(report, cfg, tr); err != nil {
+					return err
 				}
 			}
 
 			// Output report
-			if outputFile != "" {
-				// Save to file
-				absPath, err := filepath.Abs(outputFile)
-				if err != nil {
-					return fmt.Errorf("failed to resolve output path: %w", err)
+			if outputFile == "" && !save {
+				if dryRun {
+					fmt.Printf("Would print a %d-byte report to stdout\n", len(report))
+					return nil
 				}
+				fmt.Println(report)
+				return nil
+			}
 
-				if err := os.WriteFile(absPath, []byte(report), 0o600); err != nil {
-					return fmt.Errorf("failed to write report: %w", err)
-				}
+			vars := export.Vars{Type: reportType, Plan: "all", Range: timeRange, Ext: "md"}
+			if len(args) > 0 {
+				vars.Type = "plan"
+				vars.Plan = args[0]
+			}
 
-				fmt.Printf("Report exported to: %s\n", absPath)
-			} else {
-				// Print to stdout
-				fmt.Println(report)
+			if dryRun {
+				return printReportTarget(cmd, outputFile, vars, len(report))
 			}
 
+			cfg, err := getConfig(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			path, err := writeReport(cfg, outputFile, vars, report, func(path string) (bool, error) {
+				return confirmOverwrite(path, force)
+			})
+			if err != nil {
+				return err
+			}
+			if path == "" {
+				fmt.Println("✗ Report not written")
+				return nil
+			}
+
+			fmt.Printf("Report exported to: %s\n", path)
 			return nil
 		},
 	}
 
 	// Flags
-	cmd.Flags().StringP("output", "o", "", "Output file path (default: stdout)")
+	cmd.Flags().StringP("output", "o", "", "Output file path or directory (default: stdout)")
+	cmd.Flags().Bool("stdout", false, "Print the report to stdout (the default)")
+	cmd.Flags().Bool("save", false, "Save to export.dir using export.report_filename")
+	cmd.Flags().Bool("force", false, "Overwrite the --output file without asking")
+	cmd.Flags().Bool("dry-run", false, "Show where the report would be written without writing it")
 	cmd.Flags().StringP("type", "t", "full", "Report type: summary, full")
 	cmd.Flags().StringP("range", "r", "all", "Time range: all, today, this-week, this-month")
+	cmd.Flags().Bool("auto", false, "Write the scheduled report (reports.schedule) to reports.dir if not written yet")
 
 	return cmd
 }
+
+// buildReport renders a markdown report for tr: the plan's stats when
+// planID is set, otherwise a summary or full report of every plan.
+func buildReport(ctx context.Context, statsService *stats.Service, planID, reportType string, tr stats.TimeRange) (string, error) {
+	exporter := stats.NewExporter()
+	exporter.SetLocation(statsService.Location())
+
+	if planID != "" {
+		planStats, err := statsService.GetPlanStats(ctx, planID, tr)
+		if err != nil {
+			return "", fmt.Errorf("failed to get plan stats: %w", err)
+		}
+		report, err := exporter.ExportPlanStats(planStats)
+		if err != nil {
+			return "", fmt.Errorf("failed to export plan stats: %w", err)
+		}
+		return withReflections(ctx, statsService, exporter, planID, tr, report)
+	}
+
+	totalStats, err := statsService.GetTotalStats(ctx, tr)
+	if err != nil {
+		return "", fmt.Errorf("failed to get total stats: %w", err)
+	}
+
+	// Get global streak info (not scoped to time range)
+	currentStreak, longestStreak, err := statsService.GetStreakInfo(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get streak info: %w", err)
+	}
+	totalStats.CurrentStreak = currentStreak
+	totalStats.LongestStreak = longestStreak
+
+	switch reportType {
+	case "summary":
+		report, err := exporter.ExportTotalStats(totalStats)
+		if err != nil {
+			return "", fmt.Errorf("failed to export total stats: %w", err)
+		}
+		return report, nil
+
+	case "full":
+		// Full report with plans and daily breakdown
+		planStatsMap, err := statsService.GetAllPlanStats(ctx, tr)
+		if err != nil {
+			return "", fmt.Errorf("failed to get plan stats: %w", err)
+		}
+
+		// Convert map to slice for exporter
+		planStats := make([]stats.PlanStats, 0, len(planStatsMap))
+		for _, ps := range planStatsMap {
+			planStats = append(planStats, ps)
+		}
+
+		dailyStats, err := statsService.GetDailyStats(ctx, tr)
+		if err != nil {
+			return "", fmt.Errorf("failed to get daily stats: %w", err)
+		}
+
+		report, err := exporter.ExportFullReport(totalStats, planStats, dailyStats)
+		if err != nil {
+			return "", fmt.Errorf("failed to export full report: %w", err)
+		}
+		return withReflections(ctx, statsService, exporter, "", tr, report)
+
+	default:
+		return "", fmt.Errorf("invalid report type: %s (supported: summary, full)", reportType)
+	}
+}
+
+// tuiReportWriter saves the export dialog's reports of tr the way
+// 'samedi report -o name' does, or '--save' when the name is blank. The
+// dialog has no way to ask, so an existing file is never overwritten.
+func tuiReportWriter(cfg *config.Config, statsService *stats.Service, tr stats.TimeRange) tui.ReportWriter {
+	return func(reportType, name string) (string, error) {
+		report, err := buildReport(context.Background(), statsService, "", reportType, tr)
+		if err != nil {
+			return "", err
+		}
+		vars := export.Vars{Type: reportType, Plan: "all", Ext: "md"}
+		return writeReport(cfg, name, vars, report, keepExisting)
+	}
+}
+
+// keepExisting refuses to overwrite path, for writers that can't ask.
+func keepExisting(path string) (bool, error) {
+	return false, fmt.Errorf("%s already exists; choose another name", path)
+}
+
+// withJournal ends a weekly report with excerpts of the journal notes
+// written during tr, if there are any.
+func withJournal(report string, cfg *config.Config, tr stats.TimeRange) (string, error) {
+	store, err := getJournalStore(cfg)
+	if err != nil {
+		return "", err
+	}
+	entries, err := store.List(tr.Start, tr.End)
+	if err != nil {
+		return "", err
+	}
+	return addReportSection(report, journalSection(entries)), nil
+}
+
+// withReflections adds the reflections of planID's sessions during tr,
+// or every plan's when planID is empty, to a report.
+func withReflections(ctx context.Context, statsService *stats.Service, exporter *stats.Exporter, planID string, tr stats.TimeRange, report string) (string, error) {
+	sessions, err := statsService.GetReflections(ctx, planID, tr)
+	if err != nil {
+		return "", fmt.Errorf("failed to get reflections: %w", err)
+	}
+	return addReportSection(report, exporter.ExportReflections(sessions)), nil
+}
+
+// reportFooter closes full reports; sections added later go above it.
+const reportFooter = "---\n*Report generated by Samedi*\n"
+
+// addReportSection adds a markdown section to the end of report, above
+// the footer if it has one. An empty section leaves report as it is.
+func addReportSection(report, section string) string {
+	if section == "" {
+		return report
+	}
+	if body, found := strings.CutSuffix(report, reportFooter); found {
+		return body + strings.TrimRight(section, "\n") + "\n\n" + reportFooter
+	}
+	return strings.TrimRight(report, "\n") + "\n\n" + section
+}
+
+// writeReport saves a report. An explicit file path is written as given;
+// a directory (or --save with no path) gets a name from the
+// export.report_filename template and never overwrites an existing file.
+func writeReport(cfg *config.Config, outputFile string, vars export.Vars, report string, overwrite func(path string) (bool, error)) (string, error) {
+	return writeOutput(cfg, outputFile, vars, []byte(report), cfg.Export.ReportFilename, overwrite)
+}
+
+// writeOutput writes data to outputFile, creating its directory, or into a
+// directory (export.dir when outputFile is empty) under a name from
+// template. Templated names never overwrite an existing file. An existing
+// outputFile is replaced once overwrite, unless it is nil, agrees; when it
+// declines nothing is written and the path is empty.
+func writeOutput(cfg *config.Config, outputFile string, vars export.Vars, data []byte, template string, overwrite func(path string) (bool, error)) (string, error) {
+	if outputFile != "" && !isDirTarget(outputFile) {
+		absPath, err := outputPath(outputFile)
+		if err != nil {
+			return "", err
+		}
+		if _, err := os.Stat(absPath); err == nil && overwrite != nil {
+			write, err := overwrite(absPath)
+			if err != nil || !write {
+				return "", err
+			}
+		}
+		if err := os.MkdirAll(filepath.Dir(absPath), 0o755); err != nil {
+			return "", fmt.Errorf("failed to create output directory: %w", err)
+		}
+		if err := os.WriteFile(absPath, data, 0o600); err != nil {
+			return "", fmt.Errorf("failed to write %s: %w", absPath, err)
+		}
+		return absPath, nil
+	}
+
+	dir := cfg.Export.Dir
+	if outputFile != "" {
+		dir = outputFile
+	}
+	return saveOutput(dir, template, vars, data)
+}
+
+// saveOutput writes data into dir under a name from template, never
+// overwriting an existing file.
+func saveOutput(dir, template string, vars export.Vars, data []byte) (string, error) {
+	dir, err := filepath.Abs(export.ExpandHome(dir))
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve output directory: %w", err)
+	}
+
+	path, err := export.Save(dir, template, vars, data)
+	if err != nil {
+		return "", fmt.Errorf("failed to write output: %w", err)
+	}
+	return path, nil
+}
+
+// outputPath resolves an explicit output file path.
+func outputPath(outputFile string) (string, error) {
+	absPath, err := filepath.Abs(export.ExpandHome(outputFile))
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve output path: %w", err)
+	}
+	return absPath, nil
+}
+
+// confirmOverwrite reports whether the report may be written to
+// outputFile. An existing file is only replaced with force or once the
+// user agrees; without a terminal to ask on, it is an error.
+func confirmOverwrite(outputFile string, force bool) (bool, error) {
+	path, err := outputPath(outputFile)
+	if err != nil {
+		return false, err
+	}
+	if _, err := os.Stat(path); err != nil || force {
+		return true, nil
+	}
+	if !isInteractive(false) {
+		return false, fmt.Errorf("%s already exists; pass --force to overwrite it", path)
+	}
+	return promptYesNo(bufio.NewReader(os.Stdin), os.Stdout, fmt.Sprintf("%s already exists. Overwrite it?", path), false)
+}
+
+// printReportTarget prints where a report of size bytes would be written,
+// for --dry-run.
+func printReportTarget(cmd *cobra.Command, outputFile string, vars export.Vars, size int) error {
+	if outputFile != "" && !isDirTarget(outputFile) {
+		path, err := outputPath(outputFile)
+		if err != nil {
+			return err
+		}
+		note := ""
+		if _, err := os.Stat(path); err == nil {
+			note = " (overwriting it)"
+		}
+		fmt.Printf("Would write a %d-byte report to: %s%s\n", size, path, note)
+		return nil
+	}
+
+	cfg, err := getConfig(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	dir := cfg.Export.Dir
+	if outputFile != "" {
+		dir = outputFile
+	}
+	name, err := export.Filename(cfg.Export.ReportFilename, vars)
+	if err != nil {
+		return err
+	}
+	path, err := filepath.Abs(filepath.Join(export.ExpandHome(dir), name))
+	if err != nil {
+		return fmt.Errorf("failed to resolve output directory: %w", err)
+	}
+	fmt.Printf("Would write a %d-byte report to: %s (or a \"-N\" variant if taken)\n", size, path)
+	return nil
+}
+
+// isDirTarget reports whether an output path names a directory: either an
+// existing one or a path ending in a separator.
+func isDirTarget(path string) bool {
+	if strings.HasSuffix(path, "/") || strings.HasSuffix(path, string(filepath.Separator)) {
+		return true
+	}
+	info, err := os.Stat(export.ExpandHome(path))
+	return err == nil && info.IsDir()
+}
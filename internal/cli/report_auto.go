@@ -0,0 +1,216 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/export"
+	"github.com/pezware/samedi.dev/internal/jobs"
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/spf13/cobra"
+)
+
+// autoReportPattern matches the names of scheduled reports, so pruning
+// never touches other files in reports.dir.
+var autoReportPattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}-(daily|weekly|monthly)\.md$`)
+
+// autoReport is the scheduled report most recently due.
+type autoReport struct {
+	schedule string
+	date     time.Time       // Day the report falls on, at midnight
+	period   stats.TimeRange // The day, week, or month before date
+}
+
+// dueAutoReport returns the latest report schedule calls for at now.
+// Daily reports cover yesterday, weekly reports the seven days before
+// the last day, and monthly reports the previous month. An "off" schedule
+// is treated as weekly.
+func dueAutoReport(schedule string, day time.Weekday, now time.Time) autoReport {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	var date, start time.Time
+	switch schedule {
+	case config.ReportScheduleDaily:
+		date = today
+		start = date.AddDate(0, 0, -1)
+	case config.ReportScheduleMonthly:
+		date = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		start = date.AddDate(0, -1, 0)
+	default:
+		schedule = config.ReportScheduleWeekly
+		date = today.AddDate(0, 0, -((int(today.Weekday()) - int(day) + 7) % 7))
+		start = date.AddDate(0, 0, -7)
+	}
+
+	return autoReport{
+		schedule: schedule,
+		date:     date,
+		period:   stats.TimeRange{Start: start, End: date.Add(-time.Nanosecond)},
+	}
+}
+
+// filename returns the report's name, e.g. "2026-10-11-weekly.md".
+func (r autoReport) filename() string {
+	return fmt.Sprintf("%s-%s.md", r.date.Format("2006-01-02"), r.schedule)
+}
+
+// heading describes the period the report covers.
+func (r autoReport) heading() string {
+	schedule := strings.ToUpper(r.schedule[:1]) + r.schedule[1:]
+	return fmt.Sprintf("_%s report: %s to %s_", schedule,
+		r.period.Start.Format("2006-01-02"), r.period.End.Format("2006-01-02"))
+}
+
+// writeAutoReport writes the report due at now into reports.dir unless it
+// already exists, then prunes old reports. It returns the report's path
+// and whether it was written.
+func writeAutoReport(ctx context.Context, cfg *config.Config, statsService *stats.Service, now time.Time) (string, bool, error) {
+	due := dueAutoReport(cfg.Reports.Schedule, cfg.Reports.Weekday(), now)
+
+	dir, err := filepath.Abs(export.ExpandHome(cfg.Reports.Dir))
+	if err != nil {
+		return "", false, fmt.Errorf("failed to resolve reports directory: %w", err)
+	}
+	path := filepath.Join(dir, due.filename())
+	if _, err := os.Stat(path); err == nil {
+		return path, false, nil
+	}
+
+	report, err := buildReport(ctx, statsService, "", cfg.Reports.Type, due.period)
+	if err != nil {
+		return "", false, err
+	}
+	// The period goes under the report's title
+	title, body, _ := strings.Cut(report, "\n")
+	report = title + "\n\n" + due.heading() + "\n" + body
+	if due.schedule == config.ReportScheduleWeekly {
+		if report, err = withJournal(report, cfg, due.period); err != nil {
+			return "", false, err
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", false, fmt.Errorf("failed to create reports directory: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(report), 0o600); err != nil {
+		return "", false, fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	if _, err := pruneAutoReports(dir, cfg.Reports.Keep); err != nil {
+		return path, true, err
+	}
+	return path, true, nil
+}
+
+// pruneAutoReports deletes all but the keep newest scheduled reports in
+// dir and returns the paths removed. keep 0 keeps every report.
+func pruneAutoReports(dir string, keep int) ([]string, error) {
+	if keep <= 0 {
+		return nil, nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read reports directory: %w", err)
+	}
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && autoReportPattern.MatchString(entry.Name()) {
+			names = append(names, entry.Name())
+		}
+	}
+	if len(names) <= keep {
+		return nil, nil
+	}
+
+	// Names start with the date, so they sort oldest first
+	sort.Strings(names)
+	var removed []string
+	for _, name := range names[:len(names)-keep] {
+		path := filepath.Join(dir, name)
+		if err := os.Remove(path); err != nil {
+			return removed, fmt.Errorf("failed to remove old report: %w", err)
+		}
+		removed = append(removed, path)
+	}
+	return removed, nil
+}
+
+// runAutoReport writes the due scheduled report for `samedi report --auto`.
+// Read-only mode refuses it, as it does the report written after other
+// commands.
+func runAutoReport(cmd *cobra.Command) error {
+	if readOnlyMode(cmd) {
+		return refuseReadOnly(cmd, cmd.CommandPath()+" --auto")
+	}
+	cfg, err := getConfig(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	statsService, err := getStatsService(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to initialize stats service: %w", err)
+	}
+
+	path, written, err := writeAutoReport(context.Background(), cfg, statsService, time.Now().In(cfg.User.Location()))
+	if err != nil {
+		return err
+	}
+	if !written {
+		fmt.Printf("Report already written: %s\n", path)
+		return nil
+	}
+	fmt.Printf("Report exported to: %s\n", path)
+	return nil
+}
+
+// autoReportAfter writes a scheduled report once one is due, through the
+// job queue. It runs after every command; failures only produce a
+// warning.
+func autoReportAfter(cmd *cobra.Command) {
+	if !autoReportCommand(cmd) || readOnlyMode(cmd) {
+		return
+	}
+	cfg, err := getConfig(cmd)
+	if err != nil || cfg.Reports.Schedule == config.ReportScheduleOff {
+		return
+	}
+
+	// Most commands find the report already written; check before
+	// opening the database
+	due := dueAutoReport(cfg.Reports.Schedule, cfg.Reports.Weekday(), time.Now().In(cfg.User.Location()))
+	if _, err := os.Stat(filepath.Join(export.ExpandHome(cfg.Reports.Dir), due.filename())); err == nil {
+		return
+	}
+
+	runJobNow(cmd, "scheduled report", jobs.EnqueueRequest{Type: jobTypeReport})
+}
+
+// autoReportCommand reports whether cmd may write a scheduled report.
+// Help, version, and shell completion never do, nor does the status line
+// that prompts and status bars poll, which must not wait on the database,
+// nor `db migrate`, whose schema opening the database would undo.
+func autoReportCommand(cmd *cobra.Command) bool {
+	if setsSchema(cmd) {
+		return false
+	}
+	switch cmd.Name() {
+	case "help", "version", "completion", cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd:
+		return false
+	case "status":
+		if minimal, err := cmd.Flags().GetBool("minimal"); err == nil && minimal {
+			return false
+		}
+	}
+	return cmd.Parent() == nil || cmd.Parent().Name() != "completion"
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// emptyStatsSource has no plans and no sessions.
+type emptyStatsSource struct{}
+
+func (emptyStatsSource) Get(_ context.Context, id string) (*plan.Plan, error) {
+	return nil, fmt.Errorf("plan not found: %s", id)
+}
+
+func (emptyStatsSource) List(_ context.Context, _ *storage.PlanFilter) ([]*storage.PlanRecord, error) {
+	return nil, nil
+}
+
+type emptySessionSource struct{}
+
+func (emptySessionSource) List(_ context.Context, _ string, _ int) ([]*session.Session, error) {
+	return nil, nil
+}
+
+func (emptySessionSource) ListAll(_ context.Context) ([]*session.Session, error) {
+	return nil, nil
+}
+
+func TestDueAutoReport(t *testing.T) {
+	// A Wednesday afternoon
+	now := time.Date(2026, 10, 14, 15, 30, 0, 0, time.Local)
+	day := func(d int) time.Time { return time.Date(2026, 10, d, 0, 0, 0, 0, time.Local) }
+
+	weekly := dueAutoReport(config.ReportScheduleWeekly, time.Sunday, now)
+	assert.Equal(t, day(11), weekly.date)
+	assert.Equal(t, day(4), weekly.period.Start)
+	assert.True(t, weekly.period.End.Before(day(11)))
+	assert.Equal(t, "2026-10-11-weekly.md", weekly.filename())
+	assert.Equal(t, "_Weekly report: 2026-10-04 to 2026-10-10_", weekly.heading())
+
+	onTheDay := dueAutoReport(config.ReportScheduleWeekly, time.Wednesday, now)
+	assert.Equal(t, day(14), onTheDay.date)
+
+	daily := dueAutoReport(config.ReportScheduleDaily, time.Sunday, now)
+	assert.Equal(t, day(14), daily.date)
+	assert.Equal(t, day(13), daily.period.Start)
+
+	monthly := dueAutoReport(config.ReportScheduleMonthly, time.Sunday, now)
+	assert.Equal(t, day(1), monthly.date)
+	assert.Equal(t, time.Date(2026, 9, 1, 0, 0, 0, 0, time.Local), monthly.period.Start)
+	assert.Equal(t, "2026-10-01-monthly.md", monthly.filename())
+
+	off := dueAutoReport(config.ReportScheduleOff, time.Sunday, now)
+	assert.Equal(t, "2026-10-11-weekly.md", off.filename(), "--auto falls back to weekly")
+}
+
+func TestPruneAutoReports(t *testing.T) {
+	dir := t.TempDir()
+	names := []string{
+		"2026-09-20-weekly.md",
+		"2026-09-27-weekly.md",
+		"2026-10-01-monthly.md",
+		"2026-10-04-weekly.md",
+		"notes.md",
+		"2025-01-01-all-full.md",
+	}
+	for _, name := range names {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("x"), 0o600))
+	}
+
+	removed, err := pruneAutoReports(dir, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "2026-09-20-weekly.md"),
+		filepath.Join(dir, "2026-09-27-weekly.md"),
+	}, removed)
+	assert.FileExists(t, filepath.Join(dir, "notes.md"), "other files are left alone")
+	assert.FileExists(t, filepath.Join(dir, "2025-01-01-all-full.md"))
+
+	removed, err = pruneAutoReports(dir, 0)
+	require.NoError(t, err)
+	assert.Empty(t, removed, "keep 0 keeps every report")
+}
+
+func TestWriteAutoReport(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Reports.Schedule = config.ReportScheduleWeekly
+	cfg.Reports.Dir = t.TempDir()
+	cfg.Reports.Type = "summary"
+	cfg.Reports.Keep = 1
+	require.NoError(t, os.WriteFile(filepath.Join(cfg.Reports.Dir, "2026-10-04-weekly.md"), []byte("old"), 0o600))
+
+	svc := stats.NewService(emptyStatsSource{}, emptySessionSource{})
+	now := time.Date(2026, 10, 14, 15, 30, 0, 0, time.Local)
+
+	path, written, err := writeAutoReport(context.Background(), cfg, svc, now)
+	require.NoError(t, err)
+	assert.True(t, written)
+	assert.Equal(t, filepath.Join(cfg.Reports.Dir, "2026-10-11-weekly.md"), path)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "# Learning Statistics\n\n_Weekly report: 2026-10-04 to 2026-10-10_\n")
+	assert.NoFileExists(t, filepath.Join(cfg.Reports.Dir, "2026-10-04-weekly.md"), "older reports are pruned")
+
+	_, written, err = writeAutoReport(context.Background(), cfg, svc, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.False(t, written, "a report is written once")
+}
+
+func TestWriteAutoReport_Journal(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Storage.DataDir = t.TempDir()
+	cfg.Reports.Dir = t.TempDir()
+	cfg.Reports.Type = "summary"
+	journalDir := filepath.Join(cfg.Storage.DataDir, "journal")
+	require.NoError(t, os.MkdirAll(journalDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(journalDir, "2026-10-06.md"),
+		[]byte("# Tuesday, October 6, 2026\n\nPinning finally clicked.\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(journalDir, "2026-10-12.md"), []byte("Too late.\n"), 0o600))
+
+	svc := stats.NewService(emptyStatsSource{}, emptySessionSource{})
+	now := time.Date(2026, 10, 14, 15, 30, 0, 0, time.Local)
+
+	cfg.Reports.Schedule = config.ReportScheduleWeekly
+	path, _, err := writeAutoReport(context.Background(), cfg, svc, now)
+	require.NoError(t, err)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "## Journal\n\n- **Tuesday, October 6**: Pinning finally clicked.\n")
+	assert.NotContains(t, string(data), "Too late.", "notes after the week are left out")
+
+	cfg.Reports.Schedule = config.ReportScheduleMonthly
+	path, _, err = writeAutoReport(context.Background(), cfg, svc, now)
+	require.NoError(t, err)
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "## Journal", "only weekly reports quote the journal")
+}
+
+func TestAutoReportCommand(t *testing.T) {
+	assert.True(t, autoReportCommand(&cobra.Command{Use: "status"}))
+	minimal := statusCmd()
+	require.NoError(t, minimal.Flags().Set("minimal", "true"))
+	assert.False(t, autoReportCommand(minimal), "status lines stay quick")
+	assert.False(t, autoReportCommand(&cobra.Command{Use: "version"}))
+	assert.False(t, autoReportCommand(&cobra.Command{Use: cobra.ShellCompRequestCmd}))
+
+	completion := &cobra.Command{Use: "completion"}
+	bash := &cobra.Command{Use: "bash"}
+	completion.AddCommand(bash)
+	assert.False(t, autoReportCommand(bash))
+}
+
+func TestRunAutoReport_ReadOnly(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	cfg := config.DefaultConfig()
+	cfg.Reports.Schedule = config.ReportScheduleWeekly
+	cfg.Reports.Dir = filepath.Join(t.TempDir(), "reports")
+	cfg.Storage.ReadOnly = true
+	require.NoError(t, config.Save(cfg))
+
+	cmd := reportCmd()
+	cmd.SetArgs([]string{"--auto"})
+	cmd.SilenceErrors = true
+	err := cmd.Execute()
+	assert.ErrorIs(t, err, ErrReadOnly)
+	assert.Contains(t, err.Error(), "'report --auto'")
+	assert.NoDirExists(t, cfg.Reports.Dir, "nothing is written")
+}
@@ -4,8 +4,13 @@
 package cli
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/export"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -156,10 +161,124 @@ func TestReportCmd_AllFlagsRegistered(t *testing.T) {
 	cmd := reportCmd()
 
 	// Verify all expected flags are registered
-	flags := []string{"output", "type", "range"}
+	flags := []string{"output", "stdout", "save", "force", "dry-run", "type", "range"}
 
 	for _, flagName := range flags {
 		flag := cmd.Flags().Lookup(flagName)
 		assert.NotNil(t, flag, "flag %s should be registered", flagName)
 	}
 }
+
+func TestIsDirTarget(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "report.md")
+	require.NoError(t, os.WriteFile(file, []byte("x"), 0o600))
+
+	assert.True(t, isDirTarget(dir))
+	assert.True(t, isDirTarget("reports/"))
+	assert.False(t, isDirTarget(file))
+	assert.False(t, isDirTarget(filepath.Join(dir, "new.md")))
+}
+
+func TestWriteReport_DirectoryUsesTemplateWithoutOverwriting(t *testing.T) {
+	cfg := config.DefaultConfig()
+	dir := t.TempDir()
+	vars := export.Vars{Type: "summary", Plan: "all", Ext: "md"}
+
+	first, err := writeReport(cfg, dir+string(filepath.Separator), vars, "one", nil)
+	require.NoError(t, err)
+	second, err := writeReport(cfg, dir, vars, "two", nil)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first, second)
+	assert.Equal(t, dir, filepath.Dir(first))
+	assert.Contains(t, filepath.Base(first), "-all-summary.md")
+	assert.Contains(t, filepath.Base(second), "-all-summary-2.md")
+
+	data, err := os.ReadFile(first)
+	require.NoError(t, err)
+	assert.Equal(t, "one", string(data))
+}
+
+func TestWriteReport_CreatesDirectories(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notes", "2025", "q1.md")
+
+	written, err := writeReport(config.DefaultConfig(), path, export.Vars{Ext: "md"}, "report", nil)
+	require.NoError(t, err)
+	assert.Equal(t, path, written)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "report", string(data))
+}
+
+func TestWriteReport_Overwrite(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Export.Dir = t.TempDir()
+	path := filepath.Join(t.TempDir(), "report.md")
+	require.NoError(t, os.WriteFile(path, []byte("old"), 0o600))
+	vars := export.Vars{Ext: "md"}
+
+	written, err := writeReport(cfg, path, vars, "new", func(string) (bool, error) { return false, nil })
+	require.NoError(t, err)
+	assert.Empty(t, written, "declined")
+
+	_, err = writeReport(cfg, path, vars, "new", keepExisting)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already exists")
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "old", string(data))
+
+	written, err = writeReport(cfg, path, vars, "new", func(string) (bool, error) { return true, nil })
+	require.NoError(t, err)
+	assert.Equal(t, path, written)
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(data))
+
+	// A blank name saves like --save
+	saved, err := writeReport(cfg, "", export.Vars{Type: "full", Plan: "all", Ext: "md"}, "saved", keepExisting)
+	require.NoError(t, err)
+	assert.Equal(t, cfg.Export.Dir, filepath.Dir(saved))
+}
+
+func TestConfirmOverwrite(t *testing.T) {
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "report.md")
+	require.NoError(t, os.WriteFile(existing, []byte("old"), 0o600))
+
+	write, err := confirmOverwrite(filepath.Join(dir, "new.md"), false)
+	require.NoError(t, err)
+	assert.True(t, write, "new files need no confirmation")
+
+	write, err = confirmOverwrite(existing, true)
+	require.NoError(t, err)
+	assert.True(t, write, "--force overwrites")
+
+	// Tests don't run in a terminal, so there is no one to ask
+	_, err = confirmOverwrite(existing, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--force")
+}
+
+func TestReportCmd_StdoutConflictsWithOutput(t *testing.T) {
+	cmd := reportCmd()
+	cmd.SetArgs([]string{"--stdout", "-o", "report.md"})
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Equal(t, ExitInvalid, ExitCode(err))
+}
+
+func TestAddReportSection(t *testing.T) {
+	section := "## Reflections\n\n- Pin\n"
+
+	assert.Equal(t, "# Plan\n", addReportSection("# Plan\n", ""))
+	assert.Equal(t, "# Plan\n\n## Reflections\n\n- Pin\n", addReportSection("# Plan\n", section))
+	assert.Equal(t, "# Report\n\n## Reflections\n\n- Pin\n\n"+reportFooter,
+		addReportSection("# Report\n\n"+reportFooter, section), "sections go above the footer")
+}
@@ -0,0 +1,217 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+	"github.com/spf13/cobra"
+)
+
+// resourcesCmd creates the `samedi resources` command, which lists a
+// plan's reading list, with subcommands to check items off and open them.
+func resourcesCmd() *cobra.Command {
+	var todoOnly bool
+
+	cmd := &cobra.Command{
+		Use:   "resources <plan-id>",
+		Short: "Show a plan's reading list",
+		Long: `Show every resource of a plan's chunks as one reading list. A resource
+listed by several chunks (the same URL, or the same text) appears once.
+
+Checking an item off sets its checkbox in every chunk that lists it, so
+the state is kept in the plan file, and chunk views show it too.
+
+Examples:
+  samedi resources rust-async             # The whole reading list
+  samedi resources rust-async --todo      # Only what's left to read
+  samedi resources done rust-async 3      # Check off the third item
+  samedi resources undone rust-async 3    # Uncheck it again
+  samedi resources open rust-async 3      # Open its link in the browser`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			svc, err := getPlanService(cmd, "")
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			items, err := svc.ReadingList(context.Background(), args[0])
+			if err != nil {
+				return err
+			}
+			entries := readingEntries(items, todoOnly)
+
+			jsonOutput, err := cmd.Flags().GetBool("json")
+			if err != nil {
+				return fmt.Errorf("failed to get json flag: %w", err)
+			}
+			if jsonOutput {
+				return printJSON(entries)
+			}
+
+			renderReadingList(os.Stdout, args[0], items, entries)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&todoOnly, "todo", false, "only show resources not yet done")
+
+	cmd.AddCommand(mutating(resourcesMarkCmd("done", true)))
+	cmd.AddCommand(mutating(resourcesMarkCmd("undone", false)))
+	cmd.AddCommand(resourcesOpenCmd())
+
+	return cmd
+}
+
+// resourcesMarkCmd creates `samedi resources done` or `undone`.
+func resourcesMarkCmd(name string, done bool) *cobra.Command {
+	short := "Check off reading list items"
+	if !done {
+		short = "Uncheck reading list items"
+	}
+
+	return &cobra.Command{
+		Use:   name + " <plan-id> <number>...",
+		Short: short,
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			svc, err := getPlanService(cmd, "")
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			planID := args[0]
+			numbers, err := parseResourceNumbers(args[1:])
+			if err != nil {
+				return err
+			}
+			for _, number := range numbers {
+				item, err := svc.SetResourceDone(context.Background(), planID, number, done)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("✓ Marked %s: %s\n", name, item.Text)
+			}
+
+			autoCommit(cmd, fmt.Sprintf("samedi: resources %s: %s", name, planID))
+			autoMirror(cmd)
+			return nil
+		},
+	}
+}
+
+// resourcesOpenCmd creates the `samedi resources open` subcommand.
+func resourcesOpenCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "open <plan-id> <number>",
+		Short: "Open a reading list item's link in the browser",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			svc, err := getPlanService(cmd, "")
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			items, err := svc.ReadingList(context.Background(), args[0])
+			if err != nil {
+				return err
+			}
+			numbers, err := parseResourceNumbers(args[1:])
+			if err != nil {
+				return err
+			}
+			if numbers[0] > len(items) {
+				return fmt.Errorf("resource number must be between 1 and %d, got %d", len(items), numbers[0])
+			}
+
+			item := items[numbers[0]-1]
+			if item.URL == "" {
+				return fmt.Errorf("resource %d has no link: %s", numbers[0], item.Text)
+			}
+			if err := session.OpenArtifact(session.ParseArtifact(item.URL)); err != nil {
+				return err
+			}
+			fmt.Printf("Opened %s\n", item.URL)
+			return nil
+		},
+	}
+}
+
+// readingEntry is a reading list item with its number, which the done,
+// undone, and open subcommands take.
+type readingEntry struct {
+	Number int `json:"number"` // 1-based position in the whole list
+	plan.ReadingItem
+}
+
+// readingEntries numbers items, keeping only unfinished ones with todoOnly.
+func readingEntries(items []plan.ReadingItem, todoOnly bool) []readingEntry {
+	entries := make([]readingEntry, 0, len(items))
+	for i, item := range items {
+		if todoOnly && item.Done {
+			continue
+		}
+		entries = append(entries, readingEntry{Number: i + 1, ReadingItem: item})
+	}
+	return entries
+}
+
+// parseResourceNumbers parses 1-based reading list numbers.
+func parseResourceNumbers(args []string) ([]int, error) {
+	numbers := make([]int, len(args))
+	for i, arg := range args {
+		number, err := strconv.Atoi(arg)
+		if err != nil || number < 1 {
+			return nil, fmt.Errorf("resource number must be a positive integer, got %q", arg)
+		}
+		numbers[i] = number
+	}
+	return numbers, nil
+}
+
+// renderReadingList prints entries as a table, followed by progress over
+// the whole list.
+func renderReadingList(w io.Writer, planID string, items []plan.ReadingItem, entries []readingEntry) {
+	if len(items) == 0 {
+		fmt.Fprintf(w, "Plan %s has no resources.\n", planID)
+		return
+	}
+
+	done := 0
+	for _, item := range items {
+		if item.Done {
+			done++
+		}
+	}
+
+	if len(entries) == 0 {
+		fmt.Fprintln(w, "Everything on the reading list is done.")
+	} else {
+		tw := components.NewTabWriter(w, 2)
+		fmt.Fprintln(tw, "#\tDONE\tRESOURCE\tCHUNKS")
+		for _, entry := range entries {
+			box := "[ ]"
+			if entry.Done {
+				box = "[x]"
+			}
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%s\n",
+				entry.Number,
+				box,
+				components.Truncate(entry.Text, 60),
+				strings.Join(entry.ChunkIDs, ", "),
+			)
+		}
+		_ = tw.Flush()
+	}
+
+	fmt.Fprintf(w, "\n%d/%d done\n", done, len(items))
+}
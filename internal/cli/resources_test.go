@@ -0,0 +1,61 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readingTestItems() []plan.ReadingItem {
+	return []plan.ReadingItem{
+		{Key: "https://go.dev/tour", Text: "https://go.dev/tour", URL: "https://go.dev/tour", ChunkIDs: []string{"chunk-001"}, Done: true},
+		{Key: "effective go", Text: "Effective Go", ChunkIDs: []string{"chunk-001", "chunk-003"}},
+	}
+}
+
+func TestReadingEntries(t *testing.T) {
+	all := readingEntries(readingTestItems(), false)
+	require.Len(t, all, 2)
+	assert.Equal(t, 1, all[0].Number)
+
+	todo := readingEntries(readingTestItems(), true)
+	require.Len(t, todo, 1)
+	assert.Equal(t, 2, todo[0].Number, "numbers stay those of the whole list")
+}
+
+func TestRenderReadingList(t *testing.T) {
+	items := readingTestItems()
+
+	var buf bytes.Buffer
+	renderReadingList(&buf, "go", items, readingEntries(items, false))
+	output := buf.String()
+	assert.Contains(t, output, "1  [x]   https://go.dev/tour")
+	assert.Contains(t, output, "Effective Go")
+	assert.Contains(t, output, "chunk-001, chunk-003")
+	assert.Contains(t, output, "1/2 done")
+
+	buf.Reset()
+	renderReadingList(&buf, "go", items, nil)
+	assert.Contains(t, buf.String(), "Everything on the reading list is done.")
+
+	buf.Reset()
+	renderReadingList(&buf, "go", nil, nil)
+	assert.Contains(t, buf.String(), "Plan go has no resources.")
+}
+
+func TestParseResourceNumbers(t *testing.T) {
+	numbers, err := parseResourceNumbers([]string{"3", "1"})
+	require.NoError(t, err)
+	assert.Equal(t, []int{3, 1}, numbers)
+
+	_, err = parseResourceNumbers([]string{"0"})
+	assert.Error(t, err)
+	_, err = parseResourceNumbers([]string{"two"})
+	assert.Error(t, err)
+}
@@ -6,14 +6,20 @@ package cli
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/export"
+	"github.com/pezware/samedi.dev/internal/ledger"
 	"github.com/pezware/samedi.dev/internal/llm"
+	"github.com/pezware/samedi.dev/internal/log"
 	"github.com/pezware/samedi.dev/internal/plan"
 	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/stats"
 	"github.com/pezware/samedi.dev/internal/storage"
 	"github.com/spf13/cobra"
 )
@@ -44,9 +50,15 @@ Core workflows:
 Global flags:
   -c, --config PATH   override config file (default $HOME/.samedi/config.toml)
   --json              machine-readable output where supported (plan list/show, stats, report)
-  -v, --verbose       emit extra diagnostics
+  -v, --verbose       log diagnostics to stderr (always logged to ~/.samedi/logs)
+  --read-only         browse only: refuse session writes, plan edits, and LLM calls
+  --profile NAME      use a separate set of plans and sessions (also SAMEDI_PROFILE)
 
 Use 'samedi <command> --help' for per-command details.`,
+	PersistentPreRunE: preRun,
+	PersistentPostRun: postRun,
+	SilenceErrors:     true, // Execute renders them with hints
+	SilenceUsage:      true, // Usage errors hint at --help instead
 	Run: func(cmd *cobra.Command, _ []string) {
 		// If no subcommand, show help or launch TUI (future)
 		if err := cmd.Help(); err != nil {
@@ -55,16 +67,30 @@ Use 'samedi <command> --help' for per-command details.`,
 	},
 }
 
-// Execute adds all child commands to the root command and sets flags appropriately.
+// Execute adds all child commands to the root command and sets flags
+// appropriately. A failure is printed with a hint when one is known; see
+// ExitCode for the matching exit code.
 func Execute() error {
-	return rootCmd.Execute()
+	markUsageErrorsOnce.Do(func() { markUsageErrors(rootCmd) })
+	err := rootCmd.Execute()
+	closeLogging(err)
+	if err != nil {
+		renderError(os.Stderr, err)
+	}
+	return err
 }
 
+// markUsageErrorsOnce wraps the commands' argument checks once all of
+// them are registered.
+var markUsageErrorsOnce sync.Once
+
 func init() {
 	// Global flags
 	rootCmd.PersistentFlags().StringP("config", "c", "", "config file (default is $HOME/.samedi/config.toml)")
 	rootCmd.PersistentFlags().Bool("json", false, "output in JSON format")
-	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "verbose output")
+	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "verbose output: log diagnostics to stderr")
+	rootCmd.PersistentFlags().Bool("read-only", false, "refuse commands that change data or call an LLM (also storage.read_only)")
+	rootCmd.PersistentFlags().StringVar(&profileFlag, "profile", "", "use a named profile's plans, sessions, and stats (also SAMEDI_PROFILE)")
 
 	// Version command
 	rootCmd.AddCommand(&cobra.Command{
@@ -79,15 +105,40 @@ func init() {
 
 	// Add subcommands
 	rootCmd.AddCommand(configCmd())
-	rootCmd.AddCommand(initCmd())
+	rootCmd.AddCommand(mutating(initCmd()))
 	rootCmd.AddCommand(planCmd())
-	rootCmd.AddCommand(startCmd())
-	rootCmd.AddCommand(stopCmd())
+	rootCmd.AddCommand(mutating(startCmd()))
+	rootCmd.AddCommand(mutating(stopCmd()))
+	rootCmd.AddCommand(mutating(runsTUI(focusCmd())))
+	rootCmd.AddCommand(mutating(doneCmd()))
+	rootCmd.AddCommand(mutating(importCmd()))
 	rootCmd.AddCommand(statusCmd())
 	rootCmd.AddCommand(showCmd())
 	rootCmd.AddCommand(statsCmd())
 	rootCmd.AddCommand(reportCmd())
-	rootCmd.AddCommand(uiCmd())
+	rootCmd.AddCommand(badgeCmd())
+	rootCmd.AddCommand(runsTUI(uiCmd()))
+	rootCmd.AddCommand(serveCmd())
+	rootCmd.AddCommand(mcpCmd())
+	rootCmd.AddCommand(jobsCmd())
+	rootCmd.AddCommand(notifyCmd())
+	rootCmd.AddCommand(mutating(syncCmd()))
+	rootCmd.AddCommand(mutating(quizCmd()))
+	rootCmd.AddCommand(dbCmd())
+	rootCmd.AddCommand(artifactsCmd())
+	rootCmd.AddCommand(resourcesCmd())
+	rootCmd.AddCommand(mutating(journalCmd()))
+	rootCmd.AddCommand(obsidianCmd())
+	rootCmd.AddCommand(cardsCmd())
+	rootCmd.AddCommand(tagCmd())
+	rootCmd.AddCommand(sessionCmd())
+	rootCmd.AddCommand(doctorCmd())
+	rootCmd.AddCommand(setupCmd())
+	rootCmd.AddCommand(profileCmd())
+	rootCmd.AddCommand(encryptionCmd())
+
+	// Deprecated flags and commands, added last so their targets exist
+	installCompat(rootCmd)
 }
 
 // getConfig loads configuration from file or returns defaults.
@@ -95,66 +146,142 @@ func getConfig(_ *cobra.Command) (*config.Config, error) {
 	return config.Load()
 }
 
-// getPlanService initializes the plan service with all dependencies.
-// This includes: config, storage (SQLite + filesystem), LLM provider, and repositories.
-// modelOverride, if non-empty, overrides the configured default model.
-func getPlanService(_ *cobra.Command, modelOverride string) (*plan.Service, error) {
-	// Get configuration
+// getPaths returns the data paths of the active profile.
+func getPaths() (*storage.Paths, error) {
 	cfg, err := config.Load()
 	if err != nil {
 		return nil, fmt.Errorf("failed to load config: %w", err)
 	}
+	return configPaths(cfg)
+}
 
-	// Get default paths
-	paths, err := storage.DefaultPaths()
+// configPaths returns the data paths of the active profile, which lives
+// under cfg's storage.data_dir.
+func configPaths(cfg *config.Config) (*storage.Paths, error) {
+	paths, err := rootPaths(cfg)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get paths: %w", err)
+		return nil, err
+	}
+	name, err := activeProfile(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if name == defaultProfile {
+		return paths, nil
+	}
+	return paths.Profile(name), nil
+}
+
+// rootPaths returns the data paths at cfg's storage.data_dir itself, which
+// may start with "~". It holds the default profile and all named ones.
+// Backups go to storage.backup_dir.
+func rootPaths(cfg *config.Config) (*storage.Paths, error) {
+	paths, err := storage.NewPaths(export.ExpandHome(cfg.Storage.DataDir))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Storage.BackupDir != "" {
+		paths.BackupDir = export.ExpandHome(cfg.Storage.BackupDir)
+	}
+	return paths, nil
+}
+
+// databases holds the databases this process has opened, by path.
+var (
+	databasesMu sync.Mutex
+	databases   = make(map[string]*storage.SQLiteDB)
+)
+
+// sharedDatabase opens and migrates the database of paths, creating its
+// directories first. A command that builds several services shares one
+// connection, so each process opens and checks the schema only once.
+func sharedDatabase(paths *storage.Paths) (*storage.SQLiteDB, error) {
+	databasesMu.Lock()
+	defer databasesMu.Unlock()
+	if db, ok := databases[paths.DatabasePath]; ok {
+		return db, nil
 	}
 
-	// Ensure directories exist
 	if err := paths.EnsureDirectories(); err != nil {
 		return nil, fmt.Errorf("failed to create directories: %w", err)
 	}
-
-	// Initialize SQLite database
 	db, err := storage.NewSQLiteDB(paths.DatabasePath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize database: %w", err)
 	}
-
-	// Run migrations
-	migrator := storage.NewMigrator(db)
-	if err := migrator.Migrate(); err != nil {
+	if err := newMigrator(db, paths).Migrate(); err != nil {
+		_ = db.Close()
 		return nil, fmt.Errorf("failed to run migrations: %w", err)
 	}
 
-	// Initialize filesystem storage
+	databases[paths.DatabasePath] = db
+	return db, nil
+}
+
+// getPlanService initializes the plan service with all dependencies.
+// This includes: config, storage (SQLite + filesystem), LLM provider, and repositories.
+// modelOverride, if non-empty, overrides the configured default model.
+func getPlanService(cmd *cobra.Command, modelOverride string) (*plan.Service, error) {
+	// Get configuration
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	// Get data paths
+	paths, err := configPaths(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get paths: %w", err)
+	}
+
+	db, err := sharedDatabase(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize filesystem storage, encrypted when storage.encrypt is on
+	cipher, err := openCipher(cfg)
+	if err != nil {
+		return nil, err
+	}
 	fs := storage.NewFilesystemStorage(paths)
+	fs.SetCipher(cipher)
 
 	// Ensure template exists
 	if err := ensureTemplate(fs, paths); err != nil {
 		return nil, fmt.Errorf("failed to ensure template: %w", err)
 	}
 
-	// Create LLM provider based on config
+	// Create repositories
+	sqliteRepo := plan.NewSQLiteRepository(db)
+	sqliteRepo.SetCipher(cipher)
+	filesystemRepo := plan.NewFilesystemRepository(fs, paths)
+
+	// Create plan service. The LLM provider is only built when a plan is
+	// generated, so listing and showing plans skip CLI detection and API
+	// key checks.
 	modelToUse := cfg.LLM.DefaultModel
 	if modelOverride != "" {
 		modelToUse = modelOverride
 	}
-	llmProvider, err := createLLMProvider(cfg, modelToUse)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create LLM provider: %w", err)
+	var planService *plan.Service
+	llmProvider := &lazyProvider{build: func() (llm.Provider, error) {
+		provider, llmConfig, err := newLLMProvider(cfg, modelToUse)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create LLM provider: %w", err)
+		}
+		planService.SetGenerator(llmConfig.Provider, llmConfig.Model)
+		return meterLLMProvider(provider, llmConfig, db), nil
+	}}
+	planService = plan.NewService(sqliteRepo, filesystemRepo, llmProvider, fs, paths)
+	planService.SetHoursSource(cfg.Learning.TotalHoursSource)
+	planService.SetRepairAttempts(cfg.LLM.RepairAttempts)
+	if bus := newEventBus(cmd, cfg); bus != nil {
+		planService.SetEmitter(bus)
 	}
 
-	// Create repositories
-	sqliteRepo := plan.NewSQLiteRepository(db)
-	filesystemRepo := plan.NewFilesystemRepository(fs, paths)
-
-	// Create plan service
-	planService := plan.NewService(sqliteRepo, filesystemRepo, llmProvider, fs, paths)
-
 	// Optionally integrate session service for plan history
-	sessionRepo := session.NewSQLiteRepository(db)
+	sessionRepo := session.NewEncryptedSQLiteRepository(db, cipher)
 	sessionService := session.NewService(sessionRepo, nil)
 	planService.SetSessionService(sessionService)
 
@@ -163,18 +290,52 @@ func getPlanService(_ *cobra.Command, modelOverride string) (*plan.Service, erro
 
 // createLLMProvider creates an LLM provider based on configuration.
 func createLLMProvider(cfg *config.Config, model string) (llm.Provider, error) {
+	provider, _, err := newLLMProvider(cfg, model)
+	return provider, err
+}
+
+// detectCLI is swapped out in tests.
+var detectCLI = llm.DetectCLI
+
+// mockFallback reports whether the provider is "auto" and no LLM CLI is
+// installed, so LLM calls would quietly return canned mock output.
+func mockFallback(cfg *config.Config) bool {
+	return strings.EqualFold(cfg.LLM.Provider, "auto") && !detectCLI().Found
+}
+
+// warnMockProvider prints a prominent warning with setup instructions for
+// when LLM calls fall back to the mock provider.
+func warnMockProvider(w io.Writer) {
+	fmt.Fprintln(w, "⚠ No LLM provider available: llm.provider is \"auto\" and none of")
+	fmt.Fprintln(w, "  claude, codex, gemini or llm is on your PATH. LLM output would be")
+	fmt.Fprintln(w, "  canned placeholder text.")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "  To set one up, either:")
+	fmt.Fprintln(w, "    • install a CLI, e.g. npm install -g @anthropic-ai/claude-code")
+	fmt.Fprintln(w, "    • or use an API: samedi config set llm.provider anthropic")
+	fmt.Fprintln(w, "      (the key is read from ANTHROPIC_API_KEY)")
+	fmt.Fprintln(w)
+}
+
+// newLLMProvider creates an LLM provider and returns the resolved provider
+// config: Provider is the detected name when configured as "auto", and
+// HTTP providers fill in their default Model.
+func newLLMProvider(cfg *config.Config, model string) (llm.Provider, *llm.Config, error) {
 	llmConfig := &llm.Config{
-		Provider: cfg.LLM.Provider,
-		Command:  cfg.LLM.CLICommand,
-		Model:    model,
-		Timeout:  time.Duration(cfg.LLM.TimeoutSeconds) * time.Second,
+		Provider:   cfg.LLM.Provider,
+		Command:    cfg.LLM.CLICommand,
+		Model:      model,
+		Timeout:    time.Duration(cfg.LLM.TimeoutSeconds) * time.Second,
+		MaxRetries: cfg.LLM.MaxRetries,
+		BaseURL:    cfg.LLM.BaseURL,
+		MaxTokens:  cfg.LLM.MaxTokens,
 	}
 
 	providerName := strings.ToLower(cfg.LLM.Provider)
 
 	// Auto-detect if provider is "auto"
 	if providerName == "auto" {
-		detected := llm.DetectCLI()
+		detected := detectCLI()
 		if detected.Found {
 			providerName = detected.Name
 			llmConfig.Command = detected.Command
@@ -182,40 +343,114 @@ func createLLMProvider(cfg *config.Config, model string) (llm.Provider, error) {
 			if model == "" {
 				llmConfig.Model = detected.Model
 			}
-			// NOTE: Could add logging here if verbose mode is enabled
-			// fmt.Printf("Auto-detected %s CLI\n", detected.Name)
+			log.Info("auto-detected LLM CLI", "provider", detected.Name, "command", detected.Command)
 		} else {
 			// No CLI found, fall back to mock
 			providerName = "mock"
 		}
 	}
 
+	llmConfig.Provider = providerName
+
 	switch providerName {
 	case "mock":
-		return llm.NewMockProvider(), nil
+		return llm.NewMockProvider(), llmConfig, nil
 	case "claude":
 		// Claude Code CLI (https://claude.com/claude-code)
 		// Installation: npm install -g @anthropic/claude-code
-		return llm.NewClaudeCodeProvider(llmConfig), nil
+		return llm.NewClaudeCodeProvider(llmConfig), llmConfig, nil
 	case "codex":
 		// Codex CLI (https://codex.dev)
 		// Installation: npm install -g @codex/cli
-		return llm.NewCodexProvider(llmConfig), nil
+		return llm.NewCodexProvider(llmConfig), llmConfig, nil
 	case "gemini":
 		// Gemini CLI (https://github.com/google/gemini-cli)
 		// Installation: npm install -g @google/gemini-cli
-		return llm.NewGeminiCLIProvider(llmConfig), nil
+		return llm.NewGeminiCLIProvider(llmConfig), llmConfig, nil
 	case "llm":
 		// Simon Willison's llm CLI tool (universal fallback)
 		// Installation: uv pip install llm && llm install llm-claude-3
-		return llm.NewCLIProvider(llmConfig), nil
+		return llm.NewCLIProvider(llmConfig), llmConfig, nil
 	case "stdin":
 		// Generic stdin-based provider for custom CLIs
 		// Requires llm.cli_command to be set in config
-		return llm.NewStdinProvider(llmConfig), nil
+		return llm.NewStdinProvider(llmConfig), llmConfig, nil
+	case "anthropic":
+		// Anthropic Messages API (key from ANTHROPIC_API_KEY by default)
+		key, err := resolveAPIKey(cfg, true)
+		if err != nil {
+			return nil, nil, err
+		}
+		llmConfig.APIKey = key
+		return llm.NewAnthropicProvider(llmConfig), llmConfig, nil
+	case "openai":
+		// OpenAI or any compatible endpoint; local servers may not need a key
+		key, err := resolveAPIKey(cfg, cfg.LLM.BaseURL == "")
+		if err != nil {
+			return nil, nil, err
+		}
+		llmConfig.APIKey = key
+		return llm.NewOpenAIProvider(llmConfig), llmConfig, nil
+	case "ollama":
+		// Local Ollama server (http://localhost:11434 by default)
+		return llm.NewOllamaProvider(llmConfig), llmConfig, nil
 	default:
-		return nil, fmt.Errorf("unsupported LLM provider: %s (supported: auto, claude, codex, gemini, llm, stdin, mock)", cfg.LLM.Provider)
+		return nil, nil, fmt.Errorf("unsupported LLM provider: %s (supported: auto, claude, codex, gemini, llm, stdin, anthropic, openai, ollama, mock)", cfg.LLM.Provider)
+	}
+}
+
+// meterLLMProvider records every call of a real provider in the LLM cost
+// ledger. Recording failures are reported as warnings and never fail the call.
+func meterLLMProvider(provider llm.Provider, llmConfig *llm.Config, db *storage.SQLiteDB) llm.Provider {
+	if llmConfig.Provider == "mock" {
+		return provider
+	}
+
+	ledgerService := ledger.NewService(ledger.NewSQLiteRepository(db))
+	return llm.NewMeteredProvider(provider, llmConfig.Provider, llmConfig.Model, func(ctx context.Context, call llm.CallRecord) {
+		if err := ledgerService.Record(ctx, call); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to record LLM usage: %v\n", err)
+		}
+	})
+}
+
+// lazyProvider builds its LLM provider on the first call and passes every
+// call through to it. A failed build is reported on each call.
+type lazyProvider struct {
+	build    func() (llm.Provider, error)
+	once     sync.Once
+	provider llm.Provider
+	err      error
+}
+
+// Call implements llm.Provider.
+func (p *lazyProvider) Call(ctx context.Context, prompt string) (string, error) {
+	p.once.Do(func() {
+		p.provider, p.err = p.build()
+	})
+	if p.err != nil {
+		return "", p.err
+	}
+	return p.provider.Call(ctx, prompt)
+}
+
+// resolveAPIKey reads the provider's API key from the environment variable
+// named by llm.api_key_env (or the provider's default). Keys are never read
+// from config.toml.
+func resolveAPIKey(cfg *config.Config, required bool) (string, error) {
+	envVar := cfg.LLM.APIKeyEnv
+	if envVar == "" {
+		envVar = config.DefaultAPIKeyEnv(cfg.LLM.Provider)
+	}
+	if envVar == "" {
+		return "", nil
+	}
+
+	key := strings.TrimSpace(os.Getenv(envVar))
+	if key == "" && required {
+		return "", fmt.Errorf("%w: %s provider needs an API key: set the %s environment variable (or llm.api_key_env)", llm.ErrLLMUnavailable, cfg.LLM.Provider, envVar)
 	}
+	return key, nil
 }
 
 // ensureTemplate copies the plan generation template to ~/.samedi/templates if it doesn't exist.
@@ -250,49 +485,62 @@ func ensureTemplate(fs *storage.FilesystemStorage, paths *storage.Paths) error {
 
 // getSessionService initializes the session service with all dependencies.
 // This includes: database, session repository, and optional plan service.
-func getSessionService(_ *cobra.Command) (*session.Service, error) {
-	// Get default paths
-	paths, err := storage.DefaultPaths()
+func getSessionService(cmd *cobra.Command) (*session.Service, error) {
+	// Get configuration for session behavior
+	cfg, err := config.Load()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get paths: %w", err)
-	}
-
-	// Ensure directories exist
-	if err := paths.EnsureDirectories(); err != nil {
-		return nil, fmt.Errorf("failed to create directories: %w", err)
+		return nil, fmt.Errorf("failed to load config: %w", err)
 	}
 
-	// Initialize SQLite database
-	db, err := storage.NewSQLiteDB(paths.DatabasePath)
+	// Get data paths
+	paths, err := configPaths(cfg)
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize database: %w", err)
+		return nil, fmt.Errorf("failed to get paths: %w", err)
 	}
 
-	// Run migrations
-	migrator := storage.NewMigrator(db)
-	if err := migrator.Migrate(); err != nil {
-		return nil, fmt.Errorf("failed to run migrations: %w", err)
+	db, err := sharedDatabase(paths)
+	if err != nil {
+		return nil, err
 	}
 
 	// Initialize filesystem storage for plan service
+	cipher, err := openCipher(cfg)
+	if err != nil {
+		return nil, err
+	}
 	fs := storage.NewFilesystemStorage(paths)
+	fs.SetCipher(cipher)
 
 	// Create plan repositories
 	planSQLiteRepo := plan.NewSQLiteRepository(db)
+	planSQLiteRepo.SetCipher(cipher)
 	planFilesystemRepo := plan.NewFilesystemRepository(fs, paths)
 
 	// Create plan service without LLM provider (we only need to read plans)
 	// Pass nil for LLM provider since session commands don't generate plans
 	planService := plan.NewService(planSQLiteRepo, planFilesystemRepo, nil, fs, paths)
+	planService.SetHoursSource(cfg.Learning.TotalHoursSource)
 
 	// Wrap plan service in adapter to match session.PlanService interface
 	adapter := &planServiceAdapter{planService: planService}
 
 	// Create session repository
-	sessionRepo := session.NewSQLiteRepository(db)
+	sessionRepo := session.NewEncryptedSQLiteRepository(db, cipher)
 
 	// Create session service with plan service for validation
-	return session.NewService(sessionRepo, adapter), nil
+	svc := session.NewService(sessionRepo, adapter)
+	svc.SetAutoAdvanceChunks(cfg.Learning.AutoAdvanceChunks)
+	svc.SetSnapshotFile(statusSnapshot(paths))
+
+	// Send learning events to webhooks, including streak milestones
+	if bus := newEventBus(cmd, cfg); bus != nil {
+		statsService := stats.NewService(planService, &statsSessionServiceAdapter{repo: sessionRepo})
+		statsService.SetStreakRules(streakRules(cfg))
+		bus.Add(stats.NewMilestoneWatcher(statsService, bus))
+		planService.SetEmitter(bus)
+		svc.SetEmitter(bus)
+	}
+	return svc, nil
 }
 
 // planServiceAdapter adapts plan.Service to session.PlanService interface.
@@ -318,6 +566,10 @@ func (a *planServiceAdapter) GetChunk(ctx context.Context, planID, chunkID strin
 	}, nil
 }
 
+func (a *planServiceAdapter) AddChunkNote(ctx context.Context, planID, chunkID, note string, at time.Time) error {
+	return a.planService.AddChunkNote(ctx, planID, chunkID, note, at)
+}
+
 func (a *planServiceAdapter) UpdateChunkStatus(ctx context.Context, planID, chunkID, newStatus string) error {
 	// Convert string status to plan.Status type
 	var status plan.Status
@@ -331,14 +583,8 @@ func (a *planServiceAdapter) UpdateChunkStatus(ctx context.Context, planID, chun
 	case "skipped":
 		status = plan.StatusSkipped
 	default:
-		return fmt.Errorf("invalid status: %s", newStatus)
+		return fmt.Errorf("%w: %s", plan.ErrInvalidStatus, newStatus)
 	}
 
 	return a.planService.UpdateChunkStatus(ctx, planID, chunkID, status)
 }
-
-// exitWithError prints an error and exits.
-func exitWithError(format string, args ...interface{}) {
-	fmt.Fprintf(os.Stderr, "Error: "+format+"\n", args...)
-	os.Exit(1)
-}
@@ -4,11 +4,16 @@
 package cli
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/llm"
 	"github.com/pezware/samedi.dev/internal/plan"
 	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/storage"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -57,11 +62,29 @@ func TestRootCmd_HasSubcommands(t *testing.T) {
 	assert.True(t, commandNames["plan"], "Should have plan command")
 	assert.True(t, commandNames["start"], "Should have start command")
 	assert.True(t, commandNames["stop"], "Should have stop command")
+	assert.True(t, commandNames["done"], "Should have done command")
+	assert.True(t, commandNames["import"], "Should have import command")
 	assert.True(t, commandNames["status"], "Should have status command")
 	assert.True(t, commandNames["show"], "Should have show command")
 	assert.True(t, commandNames["stats"], "Should have stats command")
 	assert.True(t, commandNames["report"], "Should have report command")
 	assert.True(t, commandNames["ui"], "Should have ui command")
+	assert.True(t, commandNames["jobs"], "Should have jobs command")
+	assert.True(t, commandNames["notify"], "Should have notify command")
+	assert.True(t, commandNames["sync"], "Should have sync command")
+	assert.True(t, commandNames["quiz"], "Should have quiz command")
+	assert.True(t, commandNames["db"], "Should have db command")
+	assert.True(t, commandNames["artifacts"], "Should have artifacts command")
+	assert.True(t, commandNames["resources"], "Should have resources command")
+	assert.True(t, commandNames["journal"], "Should have journal command")
+	assert.True(t, commandNames["obsidian"], "Should have obsidian command")
+	assert.True(t, commandNames["cards"], "Should have cards command")
+	assert.True(t, commandNames["tag"], "Should have tag command")
+	assert.True(t, commandNames["session"], "Should have session command")
+	assert.True(t, commandNames["doctor"], "Should have doctor command")
+	assert.True(t, commandNames["setup"], "Should have setup command")
+	assert.True(t, commandNames["profile"], "Should have profile command")
+	assert.True(t, commandNames["encryption"], "Should have encryption command")
 }
 
 func TestCreateLLMProvider_MockProvider(t *testing.T) {
@@ -133,6 +156,57 @@ func TestCreateLLMProvider_LLMProvider(t *testing.T) {
 	assert.NotNil(t, provider)
 }
 
+func TestCreateLLMProvider_AnthropicProvider(t *testing.T) {
+	cfg := &config.Config{
+		LLM: config.LLMConfig{
+			Provider: "anthropic",
+		},
+	}
+
+	t.Setenv("ANTHROPIC_API_KEY", "")
+	_, err := createLLMProvider(cfg, "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ANTHROPIC_API_KEY")
+
+	t.Setenv("ANTHROPIC_API_KEY", "sk-ant-test")
+	provider, err := createLLMProvider(cfg, "")
+	require.NoError(t, err)
+	assert.IsType(t, &llm.AnthropicProvider{}, provider)
+}
+
+func TestCreateLLMProvider_OpenAIProvider_CustomKeyEnv(t *testing.T) {
+	cfg := &config.Config{
+		LLM: config.LLMConfig{
+			Provider:  "openai",
+			APIKeyEnv: "SAMEDI_TEST_OPENAI_KEY",
+		},
+	}
+
+	t.Setenv("SAMEDI_TEST_OPENAI_KEY", "")
+	_, err := createLLMProvider(cfg, "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "SAMEDI_TEST_OPENAI_KEY")
+
+	// A custom endpoint (e.g. a local server) doesn't require a key
+	cfg.LLM.BaseURL = "http://localhost:1234/v1"
+	provider, err := createLLMProvider(cfg, "")
+	require.NoError(t, err)
+	assert.IsType(t, &llm.OpenAIProvider{}, provider)
+}
+
+func TestCreateLLMProvider_OllamaProvider(t *testing.T) {
+	cfg := &config.Config{
+		LLM: config.LLMConfig{
+			Provider: "ollama",
+		},
+	}
+
+	provider, err := createLLMProvider(cfg, "llama3.1")
+
+	require.NoError(t, err)
+	assert.IsType(t, &llm.OllamaProvider{}, provider)
+}
+
 func TestCreateLLMProvider_StdinProvider(t *testing.T) {
 	cfg := &config.Config{
 		LLM: config.LLMConfig{
@@ -161,6 +235,29 @@ func TestCreateLLMProvider_AutoDetection_FallsBackToMock(t *testing.T) {
 	// Should fall back to mock if no CLI is detected
 }
 
+func TestMockFallback(t *testing.T) {
+	original := detectCLI
+	t.Cleanup(func() { detectCLI = original })
+
+	auto := &config.Config{LLM: config.LLMConfig{Provider: "auto"}}
+	explicit := &config.Config{LLM: config.LLMConfig{Provider: "mock"}}
+
+	detectCLI = func() llm.CLIInfo { return llm.CLIInfo{} }
+	assert.True(t, mockFallback(auto))
+	assert.False(t, mockFallback(explicit), "an explicit mock provider is intentional")
+
+	detectCLI = func() llm.CLIInfo { return llm.CLIInfo{Name: "claude", Command: "claude", Found: true} }
+	assert.False(t, mockFallback(auto))
+}
+
+func TestWarnMockProvider(t *testing.T) {
+	var buf bytes.Buffer
+	warnMockProvider(&buf)
+
+	assert.Contains(t, buf.String(), "No LLM provider available")
+	assert.Contains(t, buf.String(), "samedi config set llm.provider")
+}
+
 func TestCreateLLMProvider_UnsupportedProvider_ReturnsError(t *testing.T) {
 	cfg := &config.Config{
 		LLM: config.LLMConfig{
@@ -204,6 +301,42 @@ func TestCreateLLMProvider_TimeoutConfiguration(t *testing.T) {
 	assert.NotNil(t, provider)
 }
 
+func TestLazyProvider_BuildsOnFirstCall(t *testing.T) {
+	builds := 0
+	provider := &lazyProvider{build: func() (llm.Provider, error) {
+		builds++
+		return llm.NewMockProvider(), nil
+	}}
+	assert.Zero(t, builds, "nothing is built until a call")
+
+	for range 2 {
+		_, err := provider.Call(context.Background(), "prompt")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, builds)
+
+	failing := &lazyProvider{build: func() (llm.Provider, error) {
+		return nil, errors.New("unsupported LLM provider: nope")
+	}}
+	_, err := failing.Call(context.Background(), "prompt")
+	assert.ErrorContains(t, err, "unsupported LLM provider")
+}
+
+func TestSharedDatabase_OpensOnce(t *testing.T) {
+	paths, err := storage.NewPaths(t.TempDir())
+	require.NoError(t, err)
+
+	first, err := sharedDatabase(paths)
+	require.NoError(t, err)
+	second, err := sharedDatabase(paths)
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+
+	pending, err := storage.NewMigrator(first).Pending()
+	require.NoError(t, err)
+	assert.Zero(t, pending)
+}
+
 func TestPlanServiceAdapter_Get(t *testing.T) {
 	// This is a simple test to verify the adapter structure
 	// In a real scenario, we'd need to mock the plan service
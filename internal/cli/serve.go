@@ -0,0 +1,150 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/server"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/spf13/cobra"
+)
+
+// apiTokenPath is where `samedi serve` keeps its token.
+func apiTokenPath() string {
+	return filepath.Join(filepath.Dir(config.Path()), "api-token")
+}
+
+// serveCmd creates the `samedi serve` command.
+func serveCmd() *cobra.Command {
+	var addr string
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve a local REST/JSON API",
+		Long: `Serve plans, sessions, and stats over a local REST/JSON API, so launcher
+extensions, status bar widgets, and scripts can read progress and start or
+stop sessions without shelling out.
+
+Every request needs the header "Authorization: Bearer <token>". The token
+is read from $SAMEDI_API_TOKEN, or else from ~/.samedi/api-token, which is
+created with a random token on first run and readable only by you.
+
+Endpoints:
+  GET  /api/v1/status               Active session and elapsed minutes
+  GET  /api/v1/plans                Plans (?status=, ?tag=)
+  GET  /api/v1/plans/{id}           One plan with its chunks
+  GET  /api/v1/sessions             Recent sessions (?plan=, ?limit=)
+  POST /api/v1/sessions/start       {"plan_id", "chunk_id", "notes"}
+  POST /api/v1/sessions/stop        {"notes", "artifacts", "chunk_id"}
+  GET  /api/v1/stats                Totals (?range=all|today|this-week|this-month)
+  GET  /api/v1/stats/{id}           One plan's stats (?range=)
+
+In read-only mode the start and stop endpoints are refused.
+
+Examples:
+  samedi serve
+  samedi serve --addr 127.0.0.1:9000
+  curl -H "Authorization: Bearer $(cat ~/.samedi/api-token)" localhost:7878/api/v1/status`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runServe(cmd, addr)
+		},
+	}
+
+	cmd.Flags().StringVar(&addr, "addr", "127.0.0.1:7878", "address to listen on")
+
+	return cmd
+}
+
+func runServe(cmd *cobra.Command, addr string) error {
+	planService, err := getPlanService(cmd, "")
+	if err != nil {
+		return fmt.Errorf("failed to initialize plan service: %w", err)
+	}
+	sessionService, err := getSessionService(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to initialize session service: %w", err)
+	}
+	statsService, err := getStatsService(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to initialize stats service: %w", err)
+	}
+
+	cfg, err := getConfig(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	token, err := server.LoadToken(apiTokenPath())
+	if err != nil {
+		return err
+	}
+
+	api, err := server.New(server.Options{
+		Plans:    planService,
+		Sessions: sessionService,
+		Stats:    statsService,
+		Token:    token,
+		ReadOnly: readOnlyMode(cmd),
+		Location: cfg.User.Location(),
+		AfterStop: func(sess *session.Session) {
+			autoCommit(cmd, sessionCommitMessage(sess))
+			autoMirror(cmd)
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+	if !loopbackAddr(listener.Addr()) {
+		fmt.Fprintf(os.Stderr, "Warning: %s is reachable from other machines; anyone with the token can use it\n", listener.Addr())
+	}
+
+	tokenSource := apiTokenPath()
+	if os.Getenv(server.TokenEnv) != "" {
+		tokenSource = "$" + server.TokenEnv
+	}
+	fmt.Printf("Serving the samedi API on http://%s (token: %s)\n", listener.Addr(), tokenSource)
+	fmt.Println("Press Ctrl+C to stop.")
+
+	httpServer := &http.Server{
+		Handler:           api,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = httpServer.Shutdown(shutdownCtx)
+	}()
+
+	if err := httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("server failed: %w", err)
+	}
+	return nil
+}
+
+// loopbackAddr reports whether addr only accepts local connections.
+func loopbackAddr(addr net.Addr) bool {
+	tcp, ok := addr.(*net.TCPAddr)
+	return ok && tcp.IP.IsLoopback()
+}
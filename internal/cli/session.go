@@ -0,0 +1,231 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+	"github.com/spf13/cobra"
+)
+
+// sessionCmd creates the `samedi session` command group.
+func sessionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "session",
+		Short: "List and maintain recorded sessions",
+		Long: `Commands for recorded learning sessions.
+
+Examples:
+  samedi session list --since 2025-01-01 --chunk chunk-003
+  samedi session dedupe --dry-run   # List overlapping sessions
+  samedi session dedupe             # Merge them`,
+	}
+
+	cmd.AddCommand(sessionListCmd())
+	cmd.AddCommand(mutating(sessionDedupeCmd()))
+
+	return cmd
+}
+
+// sessionListCmd creates the `samedi session list` subcommand.
+func sessionListCmd() *cobra.Command {
+	var (
+		filter     session.Filter
+		since      string
+		until      string
+		minMinutes int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List recorded sessions",
+		Long: `List recorded sessions across all plans, newest first.
+
+--since and --until take dates (YYYY-MM-DD) in your configured timezone;
+both days are included. --grep matches notes ignoring case, and works on
+encrypted notes too.
+
+Examples:
+  samedi session list --since 2025-01-01 --chunk chunk-003 --grep tokio
+  samedi session list --plan rust-async --limit 10
+  samedi session list --min-minutes 45 --json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := getConfig(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			loc := cfg.User.Location()
+			if filter.Since, err = parseDayFlag("since", since, loc); err != nil {
+				return err
+			}
+			if filter.Until, err = parseDayFlag("until", until, loc); err != nil {
+				return err
+			}
+			if !filter.Until.IsZero() {
+				filter.Until = filter.Until.AddDate(0, 0, 1)
+			}
+			if minMinutes < 0 || filter.Limit < 0 {
+				return &usageError{command: cmd.CommandPath(), err: fmt.Errorf("--min-minutes and --limit cannot be negative")}
+			}
+			filter.MinMinutes = minMinutes
+
+			svc, err := getSessionService(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+			sessions, err := svc.Query(context.Background(), &filter)
+			if err != nil {
+				return err
+			}
+
+			if jsonOutput, _ := cmd.Flags().GetBool("json"); jsonOutput {
+				return printJSON(sessions)
+			}
+			printSessionList(os.Stdout, sessions)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&filter.PlanID, "plan", "", "only sessions on this plan")
+	cmd.Flags().StringVar(&filter.ChunkID, "chunk", "", "only sessions on this chunk")
+	cmd.Flags().StringVar(&since, "since", "", "only sessions started on or after this date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&until, "until", "", "only sessions started on or before this date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&filter.NoteContains, "grep", "", "only sessions whose notes contain this text")
+	cmd.Flags().IntVar(&minMinutes, "min-minutes", 0, "only completed sessions at least this long")
+	cmd.Flags().IntVar(&filter.Limit, "limit", 0, "show at most this many sessions (0 for all)")
+
+	return cmd
+}
+
+// parseDayFlag parses a YYYY-MM-DD flag value as midnight in loc. An empty
+// value gives the zero time.
+func parseDayFlag(name, value string, loc *time.Location) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	day, err := time.ParseInLocation(plan.DateFormat, value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --%s %q (expected YYYY-MM-DD)", name, value)
+	}
+	return day, nil
+}
+
+// printSessionList writes sessions as a tab-aligned table.
+func printSessionList(w io.Writer, sessions []*session.Session) {
+	if len(sessions) == 0 {
+		fmt.Fprintln(w, "No sessions found.")
+		return
+	}
+
+	tw := components.NewTabWriter(w, 2)
+	fmt.Fprintln(tw, "ID\tSTARTED\tPLAN\tCHUNK\tDURATION\tNOTES")
+	total := 0
+	for _, sess := range sessions {
+		duration := "active"
+		if !sess.IsActive() {
+			duration = formatDuration(sess.Duration)
+			total += sess.Duration
+		}
+		chunk := sess.ChunkID
+		if chunk == "" {
+			chunk = "-"
+		}
+		notes := strings.Join(strings.Fields(sess.Notes), " ")
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", shortID(sess.ID),
+			sess.StartTime.Format("2006-01-02 15:04"), sess.PlanID, chunk, duration, truncate(notes, 40))
+	}
+	tw.Flush()
+	fmt.Fprintf(w, "\n%d session(s), %s\n", len(sessions), formatDuration(total))
+}
+
+// sessionDedupeCmd creates the `samedi session dedupe` subcommand.
+func sessionDedupeCmd() *cobra.Command {
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "dedupe",
+		Short: "Merge duplicate and overlapping sessions",
+		Long: `Find sessions on the same plan whose time windows overlap, such as a
+session imported twice, and merge each group into its earliest session.
+The merged session covers the whole window and keeps the notes and
+artifacts of every session in the group; the others are deleted.
+
+Sessions that only touch (one ends as the next starts) are not merged,
+and the active session is left alone.
+
+Examples:
+  samedi session dedupe --dry-run
+  samedi session dedupe`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			svc, err := getSessionService(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			ctx := context.Background()
+			var groups []session.DuplicateGroup
+			if dryRun {
+				groups, err = svc.FindDuplicates(ctx)
+			} else {
+				groups, err = svc.Dedupe(ctx)
+			}
+			if err != nil {
+				return err
+			}
+
+			if jsonOutput, _ := cmd.Flags().GetBool("json"); jsonOutput {
+				return printJSON(groups)
+			}
+
+			printDuplicateGroups(os.Stdout, groups, dryRun)
+			if !dryRun && len(groups) > 0 {
+				autoCommit(cmd, fmt.Sprintf("samedi: merged %d overlapping session group(s)", len(groups)))
+				autoMirror(cmd)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "list overlapping sessions without merging them")
+
+	return cmd
+}
+
+// printDuplicateGroups prints each overlapping group and what was (or
+// would be) merged.
+func printDuplicateGroups(w io.Writer, groups []session.DuplicateGroup, dryRun bool) {
+	if len(groups) == 0 {
+		fmt.Fprintln(w, "✓ No overlapping sessions")
+		return
+	}
+
+	verb := "Merged"
+	if dryRun {
+		verb = "Would merge"
+	}
+	removed := 0
+	for _, group := range groups {
+		kept := group.Kept
+		fmt.Fprintf(w, "%s  %s  keep %s\n", kept.PlanID, kept.StartTime.Format("2006-01-02 15:04"), shortID(kept.ID))
+		for _, other := range group.Removed {
+			fmt.Fprintf(w, "  %s %s (%s–%s, %s)\n", verb, shortID(other.ID),
+				other.StartTime.Format("15:04"), other.EndTime.Format("15:04"), other.ElapsedTime())
+		}
+		removed += len(group.Removed)
+	}
+	fmt.Fprintf(w, "\n%s %d session(s) into %d\n", verb, removed, len(groups))
+	if dryRun {
+		fmt.Fprintln(w, "Run without --dry-run to merge them.")
+	}
+}
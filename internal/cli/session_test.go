@@ -9,8 +9,10 @@ import (
 	"io"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -61,6 +63,10 @@ func TestStartCmd_Flags(t *testing.T) {
 	require.NotNil(t, showChunks)
 	assert.Equal(t, "false", showChunks.DefValue)
 	assert.Equal(t, "display chunk details before prompting", showChunks.Usage)
+
+	noChunk := cmd.Flags().Lookup("no-chunk")
+	require.NotNil(t, noChunk)
+	assert.Equal(t, "false", noChunk.DefValue)
 }
 
 func TestStopCmd_Structure(t *testing.T) {
@@ -98,6 +104,10 @@ func TestStopCmd_Flags(t *testing.T) {
 	assert.Equal(t, "[]", artifact.DefValue)
 	assert.Equal(t, "learning artifacts (URLs or file paths)", artifact.Usage)
 
+	chunk := cmd.Flags().Lookup("chunk")
+	require.NotNil(t, chunk)
+	assert.Equal(t, "", chunk.DefValue)
+
 	auto := cmd.Flags().Lookup("auto")
 	require.NotNil(t, auto)
 	assert.Equal(t, "false", auto.DefValue)
@@ -211,3 +221,65 @@ func TestPrintAllChunkDetails(t *testing.T) {
 	assert.Contains(t, output, "Resources:")
 	assert.Contains(t, output, "Summary notes")
 }
+
+func TestPrintDuplicateGroups(t *testing.T) {
+	start := time.Date(2025, 10, 13, 9, 0, 0, 0, time.UTC)
+	end := start.Add(45 * time.Minute)
+	groups := []session.DuplicateGroup{{
+		Kept: &session.Session{ID: "aaaaaaaa-1111", PlanID: "rust-async", StartTime: start, EndTime: &end, Duration: 45},
+		Removed: []*session.Session{
+			{ID: "bbbbbbbb-2222", PlanID: "rust-async", StartTime: start, EndTime: &end, Duration: 45},
+		},
+	}}
+
+	var buf bytes.Buffer
+	printDuplicateGroups(&buf, groups, true)
+	out := buf.String()
+	assert.Contains(t, out, "rust-async  2025-10-13 09:00  keep aaaaaaaa")
+	assert.Contains(t, out, "Would merge bbbbbbbb (09:00–09:45, 45m)")
+	assert.Contains(t, out, "--dry-run")
+
+	buf.Reset()
+	printDuplicateGroups(&buf, nil, false)
+	assert.Equal(t, "✓ No overlapping sessions\n", buf.String())
+}
+
+func TestParseDayFlag(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*3600)
+
+	day, err := parseDayFlag("since", "2025-01-01", tokyo)
+	require.NoError(t, err)
+	assert.True(t, day.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, tokyo)))
+
+	day, err = parseDayFlag("since", "", tokyo)
+	require.NoError(t, err)
+	assert.True(t, day.IsZero())
+
+	_, err = parseDayFlag("until", "01/02/2025", tokyo)
+	assert.ErrorContains(t, err, "invalid --until")
+}
+
+func TestPrintSessionList(t *testing.T) {
+	start := time.Date(2025, 1, 15, 19, 0, 0, 0, time.UTC)
+	end := start.Add(90 * time.Minute)
+	sessions := []*session.Session{
+		{ID: "cccccccc-3333", PlanID: "rust-async", StartTime: start.Add(48 * time.Hour)},
+		{ID: "aaaaaaaa-1111", PlanID: "rust-async", ChunkID: "chunk-003", StartTime: start, EndTime: &end, Duration: 90, Notes: "Tokio\nruntime internals"},
+	}
+
+	var buf bytes.Buffer
+	printSessionList(&buf, sessions)
+	out := buf.String()
+	assert.Contains(t, out, "cccccccc  2025-01-17 19:00  rust-async  -          active")
+	assert.Contains(t, out, "aaaaaaaa  2025-01-15 19:00  rust-async  chunk-003  1.5h      Tokio runtime internals")
+	assert.Contains(t, out, "2 session(s), 1.5h")
+
+	buf.Reset()
+	printSessionList(&buf, nil)
+	assert.Equal(t, "No sessions found.\n", buf.String())
+}
+
+func TestSessionListCmd_NegativeFlagsAreUsageErrors(t *testing.T) {
+	assert.Equal(t, ExitInvalid, executeExitCode(t, sessionListCmd(), "--limit", "-1"))
+	assert.Equal(t, ExitInvalid, executeExitCode(t, sessionListCmd(), "--min-minutes", "-5"))
+}
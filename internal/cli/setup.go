@@ -0,0 +1,309 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/export"
+	"github.com/pezware/samedi.dev/internal/llm"
+	"github.com/spf13/cobra"
+)
+
+// setupProviders are the llm.provider choices offered by `samedi setup`.
+// The rest (stdin, mock, amazonq, custom) are for advanced use through
+// `samedi config set`.
+var setupProviders = []string{"auto", "claude", "codex", "gemini", "llm", "anthropic", "openai", "ollama"}
+
+// detectCLIs is swapped out in tests.
+var detectCLIs = llm.DetectCLIs
+
+// setupCmd creates the `samedi setup` onboarding wizard.
+func setupCmd() *cobra.Command {
+	var noPlan bool
+
+	cmd := &cobra.Command{
+		Use:   "setup",
+		Short: "Configure samedi step by step",
+		Long: `Walk through first-time setup instead of editing config.toml by hand.
+
+Setup shows which LLM CLIs are installed, then asks for the LLM provider,
+the data directory, and the editor used by 'plan edit' and 'config edit'.
+Each question shows the current value in brackets; press Enter to keep it.
+Answers are checked as you go, and the result is validated and written to
+~/.samedi/config.toml, keeping any other settings already there.
+
+API keys are never written to the config. For anthropic and openai, setup
+asks which environment variable holds the key and warns if it is unset.
+
+Finally, setup offers to create your first plan (skip with --no-plan).
+
+Examples:
+  samedi setup
+  samedi setup --no-plan`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runSetup(cmd, noPlan)
+		},
+	}
+
+	cmd.Flags().BoolVar(&noPlan, "no-plan", false, "don't offer to create a first plan")
+
+	return cmd
+}
+
+func runSetup(cmd *cobra.Command, noPlan bool) error {
+	if !isInteractive(false) {
+		return fmt.Errorf("setup needs an interactive terminal; use 'samedi config set' in scripts")
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: existing config is invalid (%v); starting from defaults\n", err)
+		cfg = config.DefaultConfig()
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	wizard := &setupWizard{
+		reader:   reader,
+		writer:   os.Stdout,
+		detect:   detectCLIs,
+		lookPath: exec.LookPath,
+		getenv:   os.Getenv,
+	}
+	if err := wizard.run(cfg); err != nil {
+		return err
+	}
+
+	if err := config.Save(cfg); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+	fmt.Printf("\n✓ Configuration saved to %s\n", config.Path())
+
+	if !setupOffersPlan(cmd, noPlan) {
+		fmt.Println("\nNext: samedi init \"<topic>\" to create a plan")
+		return nil
+	}
+
+	fmt.Println()
+	create, err := promptYesNo(reader, os.Stdout, "Create your first plan now?", true)
+	if err != nil {
+		return fmt.Errorf("failed to read answer: %w", err)
+	}
+	if !create {
+		fmt.Println("\nNext: samedi init \"<topic>\" to create a plan")
+		return nil
+	}
+
+	topic, err := wizard.ask("What do you want to learn?", "", func(value string) error {
+		if value == "" {
+			return errors.New("please enter a topic")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	hours, level, goals := 40.0, "", ""
+	return runInit(cmd, []string{topic}, initOptions{hours: &hours, level: &level, goals: &goals})
+}
+
+// setupWizard asks the setup questions. Its lookups are fields so tests
+// can script the answers and fake the environment.
+type setupWizard struct {
+	reader   *bufio.Reader
+	writer   io.Writer
+	detect   func() []llm.CLIInfo
+	lookPath func(string) (string, error)
+	getenv   func(string) string
+}
+
+// run asks for the LLM provider, data directory, and editor, updating cfg
+// with each answer once it checks out.
+func (w *setupWizard) run(cfg *config.Config) error {
+	fmt.Fprintln(w.writer, "Welcome to samedi! Press Enter to keep the value in brackets.")
+
+	if err := w.askProvider(cfg); err != nil {
+		return err
+	}
+	if err := w.askDataDir(cfg); err != nil {
+		return err
+	}
+	return w.askEditor(cfg)
+}
+
+func (w *setupWizard) askProvider(cfg *config.Config) error {
+	fmt.Fprintln(w.writer, "\n1. LLM provider")
+	fmt.Fprintln(w.writer, "   Installed CLIs (auto uses the first found):")
+	var found *llm.CLIInfo
+	for _, cli := range w.detect() {
+		if cli.Found {
+			if found == nil {
+				found = &cli
+			}
+			fmt.Fprintf(w.writer, "     ✓ %s\n", cli.Name)
+		} else {
+			fmt.Fprintf(w.writer, "     ✗ %s (not found)\n", cli.Name)
+		}
+	}
+	fmt.Fprintf(w.writer, "   Choices: %s\n", strings.Join(setupProviders, ", "))
+
+	provider, err := w.ask("Provider", cfg.LLM.Provider, func(value string) error {
+		for _, name := range setupProviders {
+			if value == name {
+				return nil
+			}
+		}
+		if value == cfg.LLM.Provider {
+			return nil // Keep an advanced provider set by hand
+		}
+		return fmt.Errorf("unknown provider %q (choose one of: %s)", value, strings.Join(setupProviders, ", "))
+	})
+	if err != nil {
+		return err
+	}
+
+	if provider != cfg.LLM.Provider {
+		// The old command belongs to the old provider
+		cfg.LLM.CLICommand = ""
+		cfg.LLM.APIKeyEnv = ""
+	}
+	cfg.LLM.Provider = provider
+
+	switch provider {
+	case "auto":
+		if found == nil {
+			fmt.Fprintln(w.writer, "   ⚠ No LLM CLI is installed yet; init will scaffold plans for you to fill in.")
+		} else {
+			fmt.Fprintf(w.writer, "   → auto will use %s\n", found.Name)
+		}
+	case "claude", "codex", "gemini", "llm":
+		if _, err := w.lookPath(provider); err != nil {
+			fmt.Fprintf(w.writer, "   ⚠ %s is not on your PATH yet; install it before creating plans.\n", provider)
+		}
+	}
+
+	defaultEnv := config.DefaultAPIKeyEnv(provider)
+	if defaultEnv == "" {
+		return nil
+	}
+	current := cfg.LLM.APIKeyEnv
+	if current == "" {
+		current = defaultEnv
+	}
+	envName, err := w.ask("Environment variable holding the API key", current, func(value string) error {
+		if strings.ContainsAny(value, " =$") {
+			return errors.New("enter the variable's name, not its value (keys are never stored)")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	cfg.LLM.APIKeyEnv = envName
+	if envName == defaultEnv {
+		cfg.LLM.APIKeyEnv = "" // The provider's default
+	}
+	if w.getenv(envName) == "" {
+		fmt.Fprintf(w.writer, "   ⚠ $%s is not set; export it before creating plans.\n", envName)
+	}
+	return nil
+}
+
+func (w *setupWizard) askDataDir(cfg *config.Config) error {
+	fmt.Fprintln(w.writer, "\n2. Data directory (plans, cards, and the session database)")
+
+	dir, err := w.ask("Directory", cfg.Storage.DataDir, func(value string) error {
+		path := export.ExpandHome(value)
+		if !filepath.IsAbs(path) {
+			return errors.New("use an absolute path or one starting with ~")
+		}
+		if err := os.MkdirAll(path, 0o755); err != nil {
+			return fmt.Errorf("cannot use %s: %w", path, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	cfg.Storage.DataDir = dir
+	return nil
+}
+
+func (w *setupWizard) askEditor(cfg *config.Config) error {
+	fmt.Fprintln(w.writer, "\n3. Editor for 'samedi plan edit' and 'samedi config edit'")
+
+	current := cfg.User.Editor
+	if current == "" {
+		current = resolveEditor(w.getenv("EDITOR"))
+	}
+	editor, err := w.ask("Editor command", current, func(value string) error {
+		fields := strings.Fields(value)
+		if len(fields) == 0 {
+			return errors.New("please enter a command")
+		}
+		if _, err := w.lookPath(fields[0]); err != nil {
+			return fmt.Errorf("%s is not on your PATH", fields[0])
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Leave $EDITOR in charge when the answer matches it
+	if editor == resolveEditor(w.getenv("EDITOR")) && cfg.User.Editor == "" {
+		return nil
+	}
+	cfg.User.Editor = editor
+	return nil
+}
+
+// ask prompts until check accepts the answer; blank input takes
+// defaultValue. When input runs out on a rejected answer, the rejection
+// is returned.
+func (w *setupWizard) ask(question, defaultValue string, check func(string) error) (string, error) {
+	for {
+		if defaultValue != "" {
+			fmt.Fprintf(w.writer, "%s [%s]: ", question, defaultValue)
+		} else {
+			fmt.Fprintf(w.writer, "%s: ", question)
+		}
+
+		line, err := w.reader.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return "", err
+		}
+
+		answer := strings.TrimSpace(line)
+		if answer == "" {
+			answer = defaultValue
+		}
+		checkErr := check(answer)
+		if checkErr == nil {
+			return answer, nil
+		}
+		if errors.Is(err, io.EOF) {
+			fmt.Fprintln(w.writer)
+			return "", checkErr
+		}
+		fmt.Fprintf(w.writer, "   ✗ %v\n", checkErr)
+	}
+}
+
+// setupOffersPlan reports whether setup should offer to create a first
+// plan: not with --no-plan, and not in read-only mode, whether it comes
+// from --read-only or storage.read_only.
+func setupOffersPlan(cmd *cobra.Command, noPlan bool) bool {
+	return !noPlan && !readOnlyMode(cmd)
+}
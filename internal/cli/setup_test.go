@@ -0,0 +1,130 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/llm"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWizard(input string, env map[string]string) (*setupWizard, *bytes.Buffer) {
+	var out bytes.Buffer
+	return &setupWizard{
+		reader: bufio.NewReader(strings.NewReader(input)),
+		writer: &out,
+		detect: func() []llm.CLIInfo {
+			return []llm.CLIInfo{{Name: "claude"}, {Name: "codex", Found: true}, {Name: "gemini"}, {Name: "llm"}}
+		},
+		lookPath: func(name string) (string, error) {
+			if name == "codex" || name == "nano" || name == "code" || name == "vi" {
+				return "/usr/bin/" + name, nil
+			}
+			return "", errors.New("not found")
+		},
+		getenv: func(key string) string { return env[key] },
+	}, &out
+}
+
+func TestSetupWizard_AcceptsDefaults(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Storage.DataDir = t.TempDir()
+	wizard, out := newTestWizard("\n\n\n", map[string]string{"EDITOR": "nano"})
+
+	require.NoError(t, wizard.run(cfg))
+	assert.Equal(t, "auto", cfg.LLM.Provider)
+	assert.Empty(t, cfg.User.Editor, "an editor matching $EDITOR is left to $EDITOR")
+	assert.NoError(t, cfg.Validate())
+
+	output := out.String()
+	assert.Contains(t, output, "✗ claude (not found)")
+	assert.Contains(t, output, "✓ codex")
+	assert.Contains(t, output, "→ auto will use codex")
+	assert.Contains(t, output, "Editor command [nano]: ")
+}
+
+func TestSetupWizard_APIProvider(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.LLM.CLICommand = "claude"
+	dataDir := filepath.Join(t.TempDir(), "data")
+	input := strings.Join([]string{
+		"gpt",        // Rejected
+		"openai",     //
+		"sk-abc def", // A key, not a variable name
+		"MY_OPENAI_KEY",
+		"relative/dir", // Rejected
+		dataDir,
+		"vim", // Not on PATH
+		"code --wait",
+	}, "\n") + "\n"
+	wizard, out := newTestWizard(input, nil)
+
+	require.NoError(t, wizard.run(cfg))
+	assert.Equal(t, "openai", cfg.LLM.Provider)
+	assert.Empty(t, cfg.LLM.CLICommand, "the old provider's command is dropped")
+	assert.Equal(t, "MY_OPENAI_KEY", cfg.LLM.APIKeyEnv)
+	assert.Equal(t, dataDir, cfg.Storage.DataDir)
+	assert.DirExists(t, dataDir)
+	assert.Equal(t, "code --wait", cfg.User.Editor)
+	assert.NoError(t, cfg.Validate())
+
+	output := out.String()
+	assert.Contains(t, output, `unknown provider "gpt"`)
+	assert.Contains(t, output, "keys are never stored")
+	assert.Contains(t, output, "$MY_OPENAI_KEY is not set")
+	assert.Contains(t, output, "use an absolute path")
+	assert.Contains(t, output, "vim is not on your PATH")
+}
+
+func TestSetupWizard_DefaultKeyEnvNotStored(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Storage.DataDir = t.TempDir()
+	wizard, out := newTestWizard("anthropic\n\n\n\n", map[string]string{"ANTHROPIC_API_KEY": "set"})
+
+	require.NoError(t, wizard.run(cfg))
+	assert.Equal(t, "anthropic", cfg.LLM.Provider)
+	assert.Empty(t, cfg.LLM.APIKeyEnv)
+	assert.Contains(t, out.String(), "[ANTHROPIC_API_KEY]")
+	assert.NotContains(t, out.String(), "is not set")
+}
+
+func TestSetupWizard_InputRunsOut(t *testing.T) {
+	cfg := config.DefaultConfig()
+	wizard, _ := newTestWizard("bogus", nil)
+
+	err := wizard.run(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown provider")
+	assert.Equal(t, "auto", cfg.LLM.Provider, "config is untouched")
+}
+
+func TestSetupOffersPlan_ReadOnly(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	newSetup := func(args ...string) *cobra.Command {
+		root := &cobra.Command{Use: "samedi"}
+		root.PersistentFlags().Bool("read-only", false, "")
+		setup := setupCmd()
+		root.AddCommand(setup)
+		require.NoError(t, setup.ParseFlags(args))
+		return setup
+	}
+
+	assert.True(t, setupOffersPlan(newSetup(), false))
+	assert.False(t, setupOffersPlan(newSetup(), true), "--no-plan")
+	assert.False(t, setupOffersPlan(newSetup("--read-only"), false), "--read-only")
+
+	cfg := config.DefaultConfig()
+	cfg.Storage.ReadOnly = true
+	require.NoError(t, config.Save(cfg))
+	assert.False(t, setupOffersPlan(newSetup(), false), "storage.read_only")
+}
@@ -4,11 +4,16 @@
 package cli
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/spf13/cobra"
 )
 
 // showCmd creates the `samedi show` command for displaying chunk details.
 func showCmd() *cobra.Command {
+	var noPager bool
+
 	cmd := &cobra.Command{
 		Use:   "show <plan-id> <chunk-id>",
 		Short: "Show detailed information about a chunk",
@@ -16,28 +21,37 @@ func showCmd() *cobra.Command {
 
 Shows:
   - Chunk title, status, and progress
-  - Learning objectives
-  - Resources and deliverables
+  - Objectives, resources, deliverable, and any notes, rendered from
+    the chunk's markdown with code examples highlighted
+  - The chunk's Notes: each stopped session's note, kept in the plan
   - Session history and time spent
 
+Output taller than the terminal opens in $PAGER, or a built-in pager
+when it is unset (↑/↓ scroll, space pages, q quits).
+
 Examples:
   samedi show rust-async chunk-001
-  samedi show french-b1 chunk-015`,
+  samedi show french-b1 chunk-015
+  samedi show french-b1 chunk-015 --no-pager`,
 		Args: cobra.ExactArgs(2),
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			planID := args[0]
 			chunkID := args[1]
 
 			// Get chunk display information
 			info, err := getChunkDisplayInfo(cmd, planID, chunkID)
 			if err != nil {
-				exitWithError("Failed to get chunk information: %v", err)
+				return fmt.Errorf("failed to get chunk information: %w", err)
 			}
 
 			// Display comprehensive chunk details
-			displayChunkDetails(info)
+			var out strings.Builder
+			writeChunkDetails(&out, info)
+			return pageOutput(planID+"/"+chunkID, out.String(), noPager)
 		},
 	}
 
+	cmd.Flags().BoolVar(&noPager, "no-pager", false, "print everything instead of paging long output")
+
 	return cmd
 }
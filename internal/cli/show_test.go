@@ -15,7 +15,7 @@ func TestShowCmd_Structure(t *testing.T) {
 	assert.Equal(t, "show <plan-id> <chunk-id>", cmd.Use)
 	assert.NotEmpty(t, cmd.Short)
 	assert.NotEmpty(t, cmd.Long)
-	assert.NotNil(t, cmd.Run)
+	assert.NotNil(t, cmd.RunE)
 }
 
 func TestShowCmd_RequiresExactlyTwoArgs(t *testing.T) {
@@ -12,6 +12,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/pezware/samedi.dev/internal/config"
 	"github.com/pezware/samedi.dev/internal/plan"
 	"github.com/pezware/samedi.dev/internal/session"
 	"github.com/spf13/cobra"
@@ -23,6 +24,7 @@ func startCmd() *cobra.Command {
 		notes      string
 		noPrompt   bool
 		showChunks bool
+		noChunk    bool
 	)
 
 	cmd := &cobra.Command{
@@ -36,18 +38,34 @@ Only one session can be active at a time.
 Examples:
   samedi start french-b1
   samedi start french-b1 chunk-003
-  samedi start rust-async chunk-015 --note "Working on tokio tutorial"`,
+  samedi start rust-async chunk-015 --note "Working on tokio tutorial"
+
+Without a chunk ID, samedi suggests the next open chunk and asks. Set
+learning.chunk_selection to "next" to pick it without asking. Without a
+terminal (or with --no-prompt) the next chunk is always picked, so the
+session keeps per-chunk attribution; --no-chunk starts a session that is
+not linked to any chunk. If the session covered another chunk, reassign
+it with 'samedi stop --chunk <chunk-id>'.`,
 		Args: cobra.RangeArgs(1, 2),
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := getConfig(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+
 			noteFlagSet := cmd.Flags().Changed("note")
 			if err := executeStart(cmd, args, startOptions{
-				note:         &notes,
-				noteFlagSet:  noteFlagSet,
-				noPrompt:     noPrompt,
-				showAllChunk: showChunks,
+				note:           &notes,
+				noteFlagSet:    noteFlagSet,
+				noPrompt:       noPrompt,
+				showAllChunk:   showChunks,
+				noChunk:        noChunk,
+				chunkSelection: cfg.Learning.ChunkSelection,
 			}); err != nil {
-				exitWithError("%v", err)
+				return err
 			}
+
+			return nil
 		},
 	}
 
@@ -55,15 +73,18 @@ Examples:
 	cmd.Flags().StringVar(&notes, "note", "", "initial notes for the session")
 	cmd.Flags().BoolVar(&noPrompt, "no-prompt", false, "skip interactive prompts")
 	cmd.Flags().BoolVar(&showChunks, "show-chunks", false, "display chunk details before prompting")
+	cmd.Flags().BoolVar(&noChunk, "no-chunk", false, "don't link the session to a chunk")
 
 	return cmd
 }
 
 type startOptions struct {
-	note         *string
-	noteFlagSet  bool
-	noPrompt     bool
-	showAllChunk bool
+	note           *string
+	noteFlagSet    bool
+	noPrompt       bool
+	showAllChunk   bool
+	noChunk        bool   // start a session not linked to any chunk
+	chunkSelection string // config.ChunkSelectionAsk (default) or config.ChunkSelectionNext
 }
 
 func executeStart(cmd *cobra.Command, args []string, opts startOptions) error {
@@ -133,6 +154,21 @@ func gatherStartInputs(cmd *cobra.Command, args []string, opts startOptions) (st
 		note = *opts.note
 	}
 
+	if chunkID == "" && !opts.noChunk {
+		switch {
+		case opts.chunkSelection == config.ChunkSelectionNext:
+			selected, err := selectNextChunk(cmd, planID, os.Stdout)
+			if err != nil {
+				return "", "", "", fmt.Errorf("failed to choose chunk: %w", err)
+			}
+			chunkID = selected
+		case !isInteractive(opts.noPrompt):
+			// Nobody to ask: infer the next chunk so the session keeps
+			// per-chunk attribution. Best effort; Start reports a missing plan.
+			chunkID, _ = selectNextChunk(cmd, planID, os.Stdout)
+		}
+	}
+
 	if !isInteractive(opts.noPrompt) {
 		return planID, chunkID, note, nil
 	}
@@ -140,7 +176,7 @@ func gatherStartInputs(cmd *cobra.Command, args []string, opts startOptions) (st
 	reader := bufio.NewReader(os.Stdin)
 	writer := os.Stdout
 
-	if chunkID == "" {
+	if chunkID == "" && !opts.noChunk {
 		selected, err := promptForChunkSelection(cmd, planID, reader, writer, chunkPromptOptions{
 			showAll: opts.showAllChunk,
 		})
@@ -198,6 +234,28 @@ func promptForChunkSelection(cmd *cobra.Command, planID string, reader *bufio.Re
 	return promptManualChunkSelection(p, reader, writer)
 }
 
+// selectNextChunk picks the plan's next chunk (in progress, else first not
+// started) without prompting. Returns "" if every chunk is completed or skipped.
+func selectNextChunk(cmd *cobra.Command, planID string, writer io.Writer) (string, error) {
+	planSvc, err := getPlanService(cmd, "")
+	if err != nil {
+		return "", fmt.Errorf("failed to load plan: %w", err)
+	}
+
+	p, err := planSvc.Get(context.Background(), planID)
+	if err != nil {
+		return "", fmt.Errorf("failed to read plan: %w", err)
+	}
+
+	next := p.NextChunk()
+	if next == nil {
+		return "", nil
+	}
+
+	fmt.Fprintf(writer, "→ Next chunk: %s — %s (%d min)\n", next.ID, next.Title, next.Duration)
+	return next.ID, nil
+}
+
 func promptForInitialNote(reader *bufio.Reader, writer io.Writer) (string, error) {
 	fmt.Fprint(writer, "Initial note (optional): ")
 	line, err := reader.ReadString('\n')
@@ -244,8 +302,8 @@ func printAllChunkDetails(writer io.Writer, p *plan.Plan) {
 		}
 		if len(chunk.Resources) > 0 {
 			fmt.Fprintln(writer, "  Resources:")
-			for _, res := range chunk.Resources {
-				fmt.Fprintf(writer, "    • %s\n", res)
+			for _, res := range chunk.ParsedResources() {
+				fmt.Fprintf(writer, "    %s %s\n", resourceIcon(res), res.Text)
 			}
 		}
 		if chunk.Deliverable != "" {
@@ -4,7 +4,10 @@
 package cli
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/pezware/samedi.dev/internal/plan"
 	"github.com/pezware/samedi.dev/internal/testutil"
@@ -51,6 +54,9 @@ func TestGatherStartInputs_ValidInputs_Success(t *testing.T) {
 		},
 	}
 
+	// No plans exist, so there is no chunk to infer
+	t.Setenv("HOME", t.TempDir())
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			planID, chunkID, note, err := gatherStartInputs(nil, tt.args, tt.opts)
@@ -63,6 +69,34 @@ func TestGatherStartInputs_ValidInputs_Success(t *testing.T) {
 	}
 }
 
+func TestGatherStartInputs_InfersNextChunk(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	now := time.Now()
+	p := &plan.Plan{
+		ID: "rust-async", Title: "Rust Async", CreatedAt: now, UpdatedAt: now,
+		TotalHours: 2, Status: plan.StatusInProgress,
+		Chunks: []plan.Chunk{
+			{ID: "chunk-001", Title: "Futures", Duration: 60, Status: plan.StatusCompleted},
+			{ID: "chunk-002", Title: "Tokio", Duration: 60, Status: plan.StatusNotStarted},
+		},
+	}
+	content, err := plan.Format(p)
+	require.NoError(t, err)
+	plansDir := filepath.Join(home, ".samedi", "plans")
+	require.NoError(t, os.MkdirAll(plansDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(plansDir, "rust-async.md"), []byte(content), 0o600))
+
+	_, chunkID, _, err := gatherStartInputs(nil, []string{"rust-async"}, startOptions{noPrompt: true})
+	require.NoError(t, err)
+	assert.Equal(t, "chunk-002", chunkID)
+
+	_, chunkID, _, err = gatherStartInputs(nil, []string{"rust-async"}, startOptions{noPrompt: true, noChunk: true})
+	require.NoError(t, err)
+	assert.Empty(t, chunkID)
+}
+
 func TestGatherStartInputs_EmptyPlanID_ReturnsError(t *testing.T) {
 	_, _, _, err := gatherStartInputs(nil, []string{}, startOptions{noPrompt: true})
 	assert.Error(t, err)
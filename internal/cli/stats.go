@@ -7,17 +7,23 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"math"
 	"os"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pezware/samedi.dev/internal/config"
 	"github.com/pezware/samedi.dev/internal/plan"
 	"github.com/pezware/samedi.dev/internal/session"
 	"github.com/pezware/samedi.dev/internal/stats"
 	"github.com/pezware/samedi.dev/internal/storage"
 	"github.com/pezware/samedi.dev/internal/tui"
 	"github.com/pezware/samedi.dev/internal/tui/app"
+	"github.com/pezware/samedi.dev/internal/tui/components"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 )
 
 func statsCmd() *cobra.Command {
@@ -34,10 +40,21 @@ func statsCmd() *cobra.Command {
 Examples:
   samedi stats                    # Show overall statistics
   samedi stats rust-async         # Show stats for specific plan
+  samedi stats rust-async --chunks  # Planned vs actual time per chunk
+  samedi stats --trend            # Week-over-week velocity per active plan
+  samedi stats --insights         # Your most productive hours and weekdays
   samedi stats --json             # Output in JSON format
   samedi stats --tui              # Interactive TUI dashboard
-  samedi stats --range this-week  # Stats for current week`,
-		Args: cobra.MaximumNArgs(1),
+  samedi stats --interactive      # Drill plan → week → day inline
+  samedi stats --range this-week  # Stats for current week
+  samedi stats --breakdown weekly # Hours per week (also daily, monthly)
+  samedi stats --llm              # LLM calls, tokens, and cost per month
+  samedi stats --all-profiles     # Merged totals across every profile`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			level, _ := cmd.Flags().GetString("breakdown")
+			_, args = splitBreakdownArgs(level, args)
+			return cobra.MaximumNArgs(1)(cmd, args)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
 
@@ -57,24 +74,89 @@ Examples:
 				return fmt.Errorf("failed to get range flag: %w", err)
 			}
 
-			breakdown, err := cmd.Flags().GetBool("breakdown")
+			level, err := cmd.Flags().GetString("breakdown")
 			if err != nil {
 				return fmt.Errorf("failed to get breakdown flag: %w", err)
 			}
+			level, args = splitBreakdownArgs(level, args)
+			if level != "" && !isBreakdownLevel(level) {
+				return &usageError{command: cmd.CommandPath(), err: fmt.Errorf("invalid breakdown: %s (supported: %s)", level, strings.Join(breakdownLevels, ", "))}
+			}
+			breakdown := statsBreakdown{level: level}
+			if cfg, err := getConfig(cmd); err == nil {
+				breakdown.startsSunday = cfg.TUI.FirstDayOfWeek == "sunday"
+				breakdown.journal, _ = getJournalStore(cfg)
+			}
+
+			// Parse time range, with days starting at midnight in the
+			// user's time zone
+			tr, err := stats.ParseTimeRange(timeRangeStr, userNow(cmd))
+			if err != nil {
+				return err
+			}
+
+			llmUsage, err := cmd.Flags().GetBool("llm")
+			if err != nil {
+				return fmt.Errorf("failed to get llm flag: %w", err)
+			}
+			if llmUsage {
+				return displayLLMUsage(ctx, tr, jsonOutput)
+			}
+
+			allProfiles, err := cmd.Flags().GetBool("all-profiles")
+			if err != nil {
+				return fmt.Errorf("failed to get all-profiles flag: %w", err)
+			}
+			interactive, err := cmd.Flags().GetBool("interactive")
+			if err != nil {
+				return fmt.Errorf("failed to get interactive flag: %w", err)
+			}
+			if interactive && (jsonOutput || tuiMode || allProfiles) {
+				return &usageError{command: cmd.CommandPath(), err: fmt.Errorf("--interactive cannot be combined with --json, --tui, or --all-profiles")}
+			}
+			if interactive && !term.IsTerminal(int(os.Stdin.Fd())) {
+				return &usageError{command: cmd.CommandPath(), err: fmt.Errorf("--interactive needs a terminal; use 'samedi stats <plan-id> --breakdown' instead")}
+			}
+
+			chunks, err := cmd.Flags().GetBool("chunks")
+			if err != nil {
+				return fmt.Errorf("failed to get chunks flag: %w", err)
+			}
+			if chunks {
+				if len(args) == 0 {
+					return &usageError{command: cmd.CommandPath(), err: fmt.Errorf("--chunks requires a plan ID")}
+				}
+				if tuiMode || interactive || allProfiles || breakdown.level != "" {
+					return &usageError{command: cmd.CommandPath(), err: fmt.Errorf("--chunks cannot be combined with --tui, --interactive, --all-profiles, or --breakdown")}
+				}
+			}
+
+			trend, err := cmd.Flags().GetBool("trend")
+			if err != nil {
+				return fmt.Errorf("failed to get trend flag: %w", err)
+			}
+			if trend {
+				if len(args) > 0 || tuiMode || interactive || allProfiles || breakdown.level != "" || chunks {
+					return &usageError{command: cmd.CommandPath(), err: fmt.Errorf("--trend cannot be combined with a plan ID, --tui, --interactive, --all-profiles, --breakdown, or --chunks")}
+				}
+				if cmd.Flags().Changed("range") {
+					return &usageError{command: cmd.CommandPath(), err: fmt.Errorf("--trend always compares the last two weeks and cannot be combined with --range")}
+				}
+			}
+
+			insights, err := cmd.Flags().GetBool("insights")
+			if err != nil {
+				return fmt.Errorf("failed to get insights flag: %w", err)
+			}
+			if insights && (len(args) > 0 || tuiMode || interactive || allProfiles || breakdown.level != "" || chunks || trend) {
+				return &usageError{command: cmd.CommandPath(), err: fmt.Errorf("--insights cannot be combined with a plan ID, --tui, --interactive, --all-profiles, --breakdown, --chunks, or --trend")}
+			}
 
-			// Parse time range
-			var tr stats.TimeRange
-			switch timeRangeStr {
-			case "all":
-				tr = stats.NewTimeRangeAll()
-			case "today":
-				tr = stats.NewTimeRangeToday()
-			case "this-week":
-				tr = stats.NewTimeRangeThisWeek()
-			case "this-month":
-				tr = stats.NewTimeRangeThisMonth()
-			default:
-				return fmt.Errorf("invalid time range: %s (supported: all, today, this-week, this-month)", timeRangeStr)
+			if allProfiles {
+				if len(args) > 0 || tuiMode {
+					return &usageError{command: cmd.CommandPath(), err: fmt.Errorf("--all-profiles cannot be combined with a plan ID or --tui")}
+				}
+				return displayAllProfileStats(ctx, tr, jsonOutput)
 			}
 
 			// Initialize stats service
@@ -83,27 +165,63 @@ Examples:
 				return fmt.Errorf("failed to initialize stats service: %w", err)
 			}
 
+			// The dashboard loads its own data once it is running
+			if tuiMode && !jsonOutput {
+				return launchTUI(statsService, tr, readOnlyMode(cmd))
+			}
+
+			if interactive {
+				planID := ""
+				if len(args) > 0 {
+					planID = args[0]
+				}
+				startsSunday := false
+				if cfg, err := getConfig(cmd); err == nil {
+					startsSunday = cfg.TUI.FirstDayOfWeek == "sunday"
+				}
+				return runStatsDrill(ctx, statsService, planID, tr, startsSunday)
+			}
+
+			if trend {
+				return displayTrends(ctx, statsService, jsonOutput)
+			}
+
+			if insights {
+				return displayInsights(ctx, statsService, tr, jsonOutput)
+			}
+
+			if chunks {
+				return displayChunkStats(ctx, statsService, args[0], tr, jsonOutput)
+			}
+
 			// If plan ID provided, show plan stats
 			if len(args) > 0 {
 				planID := args[0]
-				return displayPlanStats(ctx, statsService, planID, tr, jsonOutput, tuiMode, breakdown)
+				return displayPlanStats(ctx, statsService, planID, tr, jsonOutput, breakdown)
 			}
 
 			// Otherwise show total stats
-			return displayTotalStats(ctx, statsService, tr, jsonOutput, tuiMode, breakdown)
+			return displayTotalStats(ctx, statsService, tr, jsonOutput, breakdown)
 		},
 	}
 
 	// Add flags
 	cmd.Flags().StringP("range", "r", "all", "Time range: all, today, this-week, this-month")
-	cmd.Flags().Bool("breakdown", false, "Show daily breakdown")
+	cmd.Flags().String("breakdown", "", "Show a daily, weekly, or monthly breakdown (bare --breakdown is daily)")
+	cmd.Flags().Lookup("breakdown").NoOptDefVal = "daily"
 	cmd.Flags().Bool("tui", false, "Launch interactive TUI dashboard")
+	cmd.Flags().BoolP("interactive", "i", false, "Drill down plan → week → day with inline selectors")
+	cmd.Flags().Bool("llm", false, "Show LLM calls, tokens, and estimated cost per month")
+	cmd.Flags().Bool("all-profiles", false, "Merge totals across all profiles (read-only)")
+	cmd.Flags().Bool("chunks", false, "Compare planned and actual time per chunk (requires a plan ID)")
+	cmd.Flags().Bool("trend", false, "Compare this week's velocity with last week's for each active plan")
+	cmd.Flags().Bool("insights", false, "Show the hours and weekdays you learn most in")
 
 	return cmd
 }
 
 // displayTotalStats shows aggregate statistics across all learning.
-func displayTotalStats(ctx context.Context, service *stats.Service, timeRange stats.TimeRange, jsonOutput, tuiMode, breakdown bool) error {
+func displayTotalStats(ctx context.Context, service *stats.Service, timeRange stats.TimeRange, jsonOutput bool, breakdown statsBreakdown) error {
 	// Get total stats with time range filtering
 	totalStats, err := service.GetTotalStats(ctx, timeRange)
 	if err != nil {
@@ -121,60 +239,36 @@ func displayTotalStats(ctx context.Context, service *stats.Service, timeRange st
 	totalStats.LongestStreak = longestStreak
 
 	if jsonOutput {
-		// If breakdown requested, include daily stats in JSON output
-		if breakdown {
-			dailyStats, err := service.GetDailyStats(ctx, timeRange)
+		// If breakdown requested, include it in JSON output
+		if breakdown.level != "" {
+			rows, err := breakdown.load(ctx, service, "", timeRange)
 			if err != nil {
-				return fmt.Errorf("failed to get daily stats: %w", err)
+				return err
 			}
 			output := map[string]interface{}{
-				"total": totalStats,
-				"daily": dailyStats,
+				"total":         totalStats,
+				breakdown.level: rows,
 			}
 			return printJSON(output)
 		}
 		return printJSON(totalStats)
 	}
 
-	if tuiMode {
-		return launchTUI(service, timeRange)
-	}
-
 	// Print total stats
 	if err := printTotalStatsText(totalStats); err != nil {
 		return err
 	}
 
-	// If breakdown requested, print daily stats
-	if breakdown {
-		fmt.Println("\n📅 Daily Breakdown")
-		fmt.Println(strings.Repeat("─", 50))
-
-		dailyStats, err := service.GetDailyStats(ctx, timeRange)
-		if err != nil {
-			return fmt.Errorf("failed to get daily stats: %w", err)
-		}
-
-		if len(dailyStats) == 0 {
-			fmt.Println("No activity in selected time range.")
-		} else {
-			for _, ds := range dailyStats {
-				fmt.Printf("\n%s:\n", ds.Date.Format("Monday, January 2, 2006"))
-				fmt.Printf("  ⏱️  Duration: %.1f hours (%d minutes)\n", ds.Hours(), ds.Duration)
-				fmt.Printf("  📊 Sessions: %d\n", ds.SessionCount)
-				if len(ds.Plans) > 0 {
-					fmt.Printf("  📚 Plans: %s\n", strings.Join(ds.Plans, ", "))
-				}
-			}
-		}
-		fmt.Println()
+	// If breakdown requested, print it with a chart
+	if breakdown.level != "" {
+		return printBreakdown(ctx, os.Stdout, service, breakdown, "", timeRange)
 	}
 
 	return nil
 }
 
 // displayPlanStats shows statistics for a specific plan.
-func displayPlanStats(ctx context.Context, service *stats.Service, planID string, timeRange stats.TimeRange, jsonOutput, tuiMode, breakdown bool) error {
+func displayPlanStats(ctx context.Context, service *stats.Service, planID string, timeRange stats.TimeRange, jsonOutput bool, breakdown statsBreakdown) error {
 	// Get plan stats with time range filtering
 	planStats, err := service.GetPlanStats(ctx, planID, timeRange)
 	if err != nil {
@@ -185,83 +279,83 @@ func displayPlanStats(ctx context.Context, service *stats.Service, planID string
 		return printPlanStatsJSON(ctx, service, planID, timeRange, planStats, breakdown)
 	}
 
-	if tuiMode {
-		return launchTUI(service, timeRange)
-	}
-
 	// Print plan stats
 	if err := printPlanStatsText(planStats); err != nil {
 		return err
 	}
 
-	// If breakdown requested, print daily stats for this plan
-	if breakdown {
-		return printPlanBreakdown(ctx, service, planID, timeRange)
+	if err := printQuizScores(ctx, os.Stdout, planID); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+
+	// If breakdown requested, print this plan's days, weeks, or months
+	if breakdown.level != "" {
+		return printBreakdown(ctx, os.Stdout, service, breakdown, planID, timeRange)
 	}
 
 	return nil
 }
 
 // printPlanStatsJSON outputs plan stats in JSON format with optional breakdown.
-func printPlanStatsJSON(ctx context.Context, service *stats.Service, planID string, timeRange stats.TimeRange, planStats *stats.PlanStats, breakdown bool) error {
-	if !breakdown {
+func printPlanStatsJSON(ctx context.Context, service *stats.Service, planID string, timeRange stats.TimeRange, planStats *stats.PlanStats, breakdown statsBreakdown) error {
+	if breakdown.level == "" {
 		return printJSON(planStats)
 	}
 
-	dailyStats, err := service.GetDailyStats(ctx, timeRange)
+	rows, err := breakdown.load(ctx, service, planID, timeRange)
 	if err != nil {
-		return fmt.Errorf("failed to get daily stats: %w", err)
-	}
-
-	// Filter daily stats for this plan only
-	planDaily := []stats.DailyStats{}
-	for _, ds := range dailyStats {
-		for _, pid := range ds.Plans {
-			if pid == planID {
-				planDaily = append(planDaily, ds)
-				break
-			}
-		}
+		return err
 	}
 
 	output := map[string]interface{}{
-		"plan":  planStats,
-		"daily": planDaily,
+		"plan":          planStats,
+		breakdown.level: rows,
 	}
 	return printJSON(output)
 }
 
-// printPlanBreakdown prints daily breakdown for a specific plan.
-func printPlanBreakdown(ctx context.Context, service *stats.Service, planID string, timeRange stats.TimeRange) error {
-	fmt.Println("\n📅 Daily Breakdown")
-	fmt.Println(strings.Repeat("─", 50))
+// maxBarChartDays is the longest range charted one bar per day; longer
+// ranges get a sparkline.
+const maxBarChartDays = 31
 
-	dailyStats, err := service.GetDailyStats(ctx, timeRange)
-	if err != nil {
-		return fmt.Errorf("failed to get daily stats: %w", err)
+// printDailyChart charts hours per day across the range, idle days included.
+func printDailyChart(w io.Writer, daily []stats.DailyStats, timeRange stats.TimeRange) {
+	days := stats.FillDays(daily, timeRange)
+	if len(days) == 0 {
+		return
 	}
 
-	// Filter for this plan
-	found := false
-	for _, ds := range dailyStats {
-		for _, pid := range ds.Plans {
-			if pid != planID {
-				continue
-			}
-			fmt.Printf("\n%s:\n", ds.Date.Format("Monday, January 2, 2006"))
-			fmt.Printf("  ⏱️  Duration: %.1f hours (%d minutes)\n", ds.Hours(), ds.Duration)
-			fmt.Printf("  📊 Sessions: %d\n", ds.SessionCount)
-			found = true
-			break
-		}
+	peak := 0.0
+	for _, ds := range days {
+		peak = math.Max(peak, ds.Hours())
 	}
+	first, last := days[0].Date, days[len(days)-1].Date
+	fmt.Fprintf(w, "Hours per day, %s – %s (peak %.1fh):\n", first.Format("Jan 2"), last.Format("Jan 2, 2006"), peak)
 
-	if !found {
-		fmt.Println("No activity in selected time range.")
+	if len(days) > maxBarChartDays {
+		fmt.Fprintf(w, "  %s\n", components.Sparkline(dailyHours(days), 60))
+		return
 	}
-	fmt.Println()
 
-	return nil
+	bars := make([]components.Bar, len(days))
+	for i, ds := range days {
+		bars[i] = components.Bar{Label: ds.Date.Format("Mon Jan 2"), Value: ds.Hours()}
+		if ds.Duration > 0 {
+			bars[i].Note = fmt.Sprintf("%.1fh", ds.Hours())
+		}
+	}
+	for _, line := range strings.Split(components.NewBarChart(bars, 30).View(), "\n") {
+		fmt.Fprintf(w, "  %s\n", line)
+	}
+}
+
+// dailyHours returns each day's hours, for charting.
+func dailyHours(days []stats.DailyStats) []float64 {
+	hours := make([]float64, len(days))
+	for i, ds := range days {
+		hours[i] = ds.Hours()
+	}
+	return hours
 }
 
 // printTotalStatsText formats total stats as human-readable text.
@@ -317,6 +411,38 @@ func printPlanStatsText(s *stats.PlanStats) error {
 		avgMinutes := (s.TotalHours * 60) / float64(s.SessionCount)
 		fmt.Printf("   Average session:  %.0f minutes\n", avgMinutes)
 	}
+	if s.AllTimeSessions != s.SessionCount {
+		fmt.Printf("   All time:         %.1f hours in %d sessions\n", s.AllTimeHours, s.AllTimeSessions)
+	}
+
+	// Forecast
+	if s.Forecast != nil {
+		fmt.Printf("\n🏁 Forecast:\n")
+		fmt.Printf("   Est. completion:  %s\n", s.Forecast.Summary())
+		if deadline := s.Forecast.DeadlineSummary(); deadline != "" {
+			fmt.Printf("   Deadline:         %s\n", deadline)
+		}
+		if warning := s.Forecast.Warning(); warning != "" {
+			fmt.Printf("   %s\n", warning)
+		}
+	}
+
+	// Velocity
+	if v := s.Velocity; v != nil && (v.ThisWeekHours > 0 || v.LastWeekHours > 0) {
+		fmt.Printf("\n🚀 Velocity:\n")
+		fmt.Printf("   This week:        %s %s\n", v.ThisWeek(), v.Trend.Arrow())
+		fmt.Printf("   Last week:        %s\n", v.LastWeek())
+	}
+
+	// Sub-plans
+	if s.Rollup != nil {
+		r := s.Rollup
+		fmt.Printf("\n🌳 Including %d sub-plan(s):\n", r.SubPlans)
+		fmt.Printf("   %s %.0f%%\n", buildProgressBar(r.Progress, 30), r.Progress*100)
+		fmt.Printf("   Completed chunks: %d / %d\n", r.CompletedChunks, r.TotalChunks)
+		fmt.Printf("   Total hours:      %.1f / %.1f hours\n", r.TotalHours, r.PlannedHours)
+		fmt.Printf("   Sessions:         %d\n", r.SessionCount)
+	}
 
 	// Status
 	fmt.Printf("\n📊 Status:\n")
@@ -385,41 +511,40 @@ func printJSON(data interface{}) error {
 
 // getStatsService initializes the stats service with all dependencies.
 func getStatsService(_ *cobra.Command) (*stats.Service, error) {
-	// Get default paths
-	paths, err := storage.DefaultPaths()
+	cfg, err := config.Load()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get paths: %w", err)
+		return nil, fmt.Errorf("failed to load config: %w", err)
 	}
 
-	// Ensure directories exist
-	if err := paths.EnsureDirectories(); err != nil {
-		return nil, fmt.Errorf("failed to create directories: %w", err)
+	// Get the active profile's paths
+	paths, err := configPaths(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get paths: %w", err)
 	}
 
-	// Initialize SQLite database
-	db, err := storage.NewSQLiteDB(paths.DatabasePath)
+	db, err := sharedDatabase(paths)
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize database: %w", err)
+		return nil, err
 	}
 
-	// Run migrations
-	migrator := storage.NewMigrator(db)
-	if err := migrator.Migrate(); err != nil {
-		return nil, fmt.Errorf("failed to run migrations: %w", err)
+	// Initialize filesystem storage, encrypted when storage.encrypt is on
+	cipher, err := openCipher(cfg)
+	if err != nil {
+		return nil, err
 	}
-
-	// Initialize filesystem storage
 	fs := storage.NewFilesystemStorage(paths)
+	fs.SetCipher(cipher)
 
 	// Create plan repositories
 	planSQLiteRepo := plan.NewSQLiteRepository(db)
+	planSQLiteRepo.SetCipher(cipher)
 	planFilesystemRepo := plan.NewFilesystemRepository(fs, paths)
 
 	// Create plan service (nil LLM provider - we only read plans)
 	planService := plan.NewService(planSQLiteRepo, planFilesystemRepo, nil, fs, paths)
 
 	// Create session repository
-	sessionRepo := session.NewSQLiteRepository(db)
+	sessionRepo := session.NewEncryptedSQLiteRepository(db, cipher)
 
 	// Create session service adapter
 	sessionService := &statsSessionServiceAdapter{
@@ -427,7 +552,29 @@ func getStatsService(_ *cobra.Command) (*stats.Service, error) {
 	}
 
 	// Create stats service
-	return stats.NewService(planService, sessionService), nil
+	statsService := stats.NewService(planService, sessionService)
+	statsService.SetStreakRules(streakRules(cfg))
+	statsService.SetLocation(cfg.User.Location())
+	return statsService, nil
+}
+
+// userNow returns the current time in the user's time zone, or the local
+// one when the config can't be read.
+func userNow(cmd *cobra.Command) time.Time {
+	cfg, err := getConfig(cmd)
+	if err != nil {
+		return time.Now()
+	}
+	return time.Now().In(cfg.User.Location())
+}
+
+// streakRules maps configuration onto the rules streaks are counted by.
+func streakRules(cfg *config.Config) stats.StreakRules {
+	return stats.StreakRules{
+		MinMinutes: cfg.Learning.StreakMinMinutes,
+		RestDays:   cfg.Learning.RestDays(),
+		Location:   cfg.User.Location(),
+	}
 }
 
 // statsSessionServiceAdapter adapts session.Repository to stats.SessionService interface.
@@ -436,16 +583,31 @@ type statsSessionServiceAdapter struct {
 }
 
 func (a *statsSessionServiceAdapter) List(ctx context.Context, planID string, limit int) ([]*session.Session, error) {
-	return a.repo.List(ctx, planID, limit)
+	return a.repo.List(ctx, &session.Filter{PlanID: planID, Limit: limit})
 }
 
 func (a *statsSessionServiceAdapter) ListAll(ctx context.Context) ([]*session.Session, error) {
-	// Empty planID means all sessions
-	return a.repo.List(ctx, "", 0)
+	return a.repo.List(ctx, nil)
+}
+
+func (a *statsSessionServiceAdapter) Totals(ctx context.Context, filter *session.Filter) (*session.Totals, error) {
+	return session.SumSessions(ctx, a.repo, filter)
+}
+
+func (a *statsSessionServiceAdapter) DailyTotals(ctx context.Context, filter *session.Filter, loc *time.Location) ([]session.DayTotal, error) {
+	return session.SumSessionsByDay(ctx, a.repo, filter, loc)
+}
+
+func (a *statsSessionServiceAdapter) Query(ctx context.Context, filter *session.Filter) ([]*session.Session, error) {
+	return a.repo.List(ctx, filter)
+}
+
+func (a *statsSessionServiceAdapter) UpdateNotes(ctx context.Context, id, notes string) (*session.Session, error) {
+	return session.NewService(a.repo, nil).UpdateNotes(ctx, id, notes)
 }
 
 // launchTUI starts the Bubble Tea program with the stats module only.
-func launchTUI(service *stats.Service, timeRange stats.TimeRange) error {
+func launchTUI(service *stats.Service, timeRange stats.TimeRange, readOnly bool) error {
 	sessionRepo, err := getSessionRepo()
 	if err != nil {
 		return fmt.Errorf("failed to initialize session repository: %w", err)
@@ -453,36 +615,58 @@ func launchTUI(service *stats.Service, timeRange stats.TimeRange) error {
 
 	sessionAdapter := &statsSessionServiceAdapter{repo: sessionRepo}
 
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
 	module := tui.NewStatsModule(service, sessionAdapter, timeRange)
+	module.SetReadOnly(readOnly)
+	module.SetReportWriter(tuiReportWriter(cfg, service, timeRange))
+	module.SetPlanSort(planSortSetting(cfg, readOnly))
 
 	shell, err := app.New([]app.Module{module})
 	if err != nil {
 		return fmt.Errorf("failed to create stats TUI: %w", err)
 	}
-
-	program := tea.NewProgram(shell)
-	if _, err := program.Run(); err != nil {
-		return fmt.Errorf("failed to run TUI: %w", err)
+	keys, err := loadKeymap(cfg)
+	if err != nil {
+		return err
+	}
+	shell.SetKeymap(keys)
+	shell.SetAutoRefresh(time.Duration(cfg.TUI.RefreshSeconds) * time.Second)
+	setCrashReports(shell, cfg)
+	if err := applyTheme(cfg); err != nil {
+		return err
 	}
 
-	return nil
+	return runShell(tea.NewProgram(shell), shell)
 }
 
 // getSessionRepo creates a session repository for accessing session data.
 // This is a helper to avoid circular dependencies and reuse the database connection.
 func getSessionRepo() (session.Repository, error) {
-	// Get default paths
-	paths, err := storage.DefaultPaths()
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	// Get the active profile's paths
+	paths, err := configPaths(cfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get paths: %w", err)
 	}
 
-	// Initialize SQLite database
-	db, err := storage.NewSQLiteDB(paths.DatabasePath)
+	cipher, err := openCipher(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := sharedDatabase(paths)
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize database: %w", err)
+		return nil, err
 	}
 
 	// Create session repository
-	return session.NewSQLiteRepository(db), nil
+	return session.NewEncryptedSQLiteRepository(db, cipher), nil
 }
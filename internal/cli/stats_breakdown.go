@@ -0,0 +1,186 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/journal"
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+)
+
+// breakdownLevels are the granularities --breakdown accepts.
+var breakdownLevels = []string{"daily", "weekly", "monthly"}
+
+// statsBreakdown is the --breakdown level, empty for none, the week start
+// weekly breakdowns use, and the journal daily breakdowns quote, if any.
+type statsBreakdown struct {
+	level        string
+	startsSunday bool
+	journal      *journal.Store
+}
+
+func isBreakdownLevel(level string) bool {
+	for _, l := range breakdownLevels {
+		if l == level {
+			return true
+		}
+	}
+	return false
+}
+
+// splitBreakdownArgs lets "--breakdown weekly" work without "=". A bare
+// --breakdown means daily, so pflag leaves the level among the arguments;
+// the first argument naming a level is taken as the level instead.
+func splitBreakdownArgs(level string, args []string) (string, []string) {
+	if level != "daily" {
+		return level, args
+	}
+	for i, arg := range args {
+		if isBreakdownLevel(arg) {
+			rest := append(append([]string{}, args[:i]...), args[i+1:]...)
+			return arg, rest
+		}
+	}
+	return level, args
+}
+
+// load returns the breakdown rows for timeRange: days as
+// []stats.DailyStats, or weeks and months as []stats.PeriodStats. With a
+// plan ID only the days that plan was worked on count.
+func (b statsBreakdown) load(ctx context.Context, service *stats.Service, planID string, timeRange stats.TimeRange) (interface{}, error) {
+	daily, err := service.GetDailyStats(ctx, timeRange)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily stats: %w", err)
+	}
+	if planID != "" {
+		daily = planDays(daily, planID)
+	}
+
+	switch b.level {
+	case "weekly":
+		return stats.CalculateWeeklyStats(daily, b.startsSunday), nil
+	case "monthly":
+		return stats.CalculateMonthlyStats(daily), nil
+	default:
+		return daily, nil
+	}
+}
+
+// planDays keeps the days planID was worked on.
+func planDays(daily []stats.DailyStats, planID string) []stats.DailyStats {
+	kept := []stats.DailyStats{}
+	for _, ds := range daily {
+		for _, pid := range ds.Plans {
+			if pid == planID {
+				kept = append(kept, ds)
+				break
+			}
+		}
+	}
+	return kept
+}
+
+// printBreakdown prints the breakdown for timeRange, led by a chart of
+// hours per day, week, or month.
+func printBreakdown(ctx context.Context, w io.Writer, service *stats.Service, b statsBreakdown, planID string, timeRange stats.TimeRange) error {
+	rows, err := b.load(ctx, service, planID, timeRange)
+	if err != nil {
+		return err
+	}
+
+	title := strings.ToUpper(b.level[:1]) + b.level[1:]
+	fmt.Fprintf(w, "\n📅 %s Breakdown\n", title)
+	fmt.Fprintln(w, strings.Repeat("─", 50))
+
+	switch rows := rows.(type) {
+	case []stats.DailyStats:
+		printDays(w, rows, timeRange, b.journal)
+	case []stats.PeriodStats:
+		printPeriods(w, rows, b.level == "monthly")
+	}
+	fmt.Fprintln(w)
+	return nil
+}
+
+// printDays prints a chart of hours per day, then each active day, with
+// an excerpt of its journal note when notes is set and the day has one.
+func printDays(w io.Writer, daily []stats.DailyStats, timeRange stats.TimeRange, notes *journal.Store) {
+	if len(daily) == 0 {
+		fmt.Fprintln(w, "No activity in selected time range.")
+		return
+	}
+
+	fmt.Fprintln(w)
+	printDailyChart(w, daily, timeRange)
+	for _, ds := range daily {
+		fmt.Fprintf(w, "\n%s:\n", ds.Date.Format("Monday, January 2, 2006"))
+		fmt.Fprintf(w, "  ⏱️  Duration: %.1f hours (%d minutes)\n", ds.Hours(), ds.Duration)
+		fmt.Fprintf(w, "  📊 Sessions: %d\n", ds.SessionCount)
+		if len(ds.Plans) > 0 {
+			fmt.Fprintf(w, "  📚 Plans: %s\n", strings.Join(ds.Plans, ", "))
+		}
+		if notes == nil {
+			continue
+		}
+		if entry, err := notes.Get(ds.Date); err == nil && entry != nil {
+			if excerpt := entry.Excerpt(journalExcerptLength); excerpt != "" {
+				fmt.Fprintf(w, "  📓 Journal: %s\n", excerpt)
+			}
+		}
+	}
+}
+
+// printPeriods prints a chart of hours per week or month, then each
+// active one.
+func printPeriods(w io.Writer, periods []stats.PeriodStats, monthly bool) {
+	if len(periods) == 0 {
+		fmt.Fprintln(w, "No activity in selected time range.")
+		return
+	}
+
+	unit, label, barLabel := "week", "Week of Monday, January 2, 2006", "Jan 2"
+	if monthly {
+		unit, label, barLabel = "month", "January 2006", "Jan 2006"
+	}
+
+	peak := 0.0
+	hours := make([]float64, len(periods))
+	bars := make([]components.Bar, len(periods))
+	for i, ps := range periods {
+		peak = math.Max(peak, ps.Hours())
+		hours[i] = ps.Hours()
+		bars[i] = components.Bar{Label: ps.Start.Format(barLabel), Value: ps.Hours()}
+		if ps.Duration > 0 {
+			bars[i].Note = fmt.Sprintf("%.1fh", ps.Hours())
+		}
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "Hours per %s (peak %.1fh):\n", unit, peak)
+	if len(periods) > maxBarChartDays {
+		fmt.Fprintf(w, "  %s\n", components.Sparkline(hours, 60))
+	} else {
+		for _, line := range strings.Split(components.NewBarChart(bars, 30).View(), "\n") {
+			fmt.Fprintf(w, "  %s\n", line)
+		}
+	}
+
+	for _, ps := range periods {
+		if ps.SessionCount == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "\n%s:\n", ps.Start.Format(label))
+		fmt.Fprintf(w, "  ⏱️  Duration: %.1f hours (%d minutes)\n", ps.Hours(), ps.Duration)
+		fmt.Fprintf(w, "  📊 Sessions: %d on %d days\n", ps.SessionCount, ps.ActiveDays)
+		if len(ps.Plans) > 0 {
+			fmt.Fprintf(w, "  📚 Plans: %s\n", strings.Join(ps.Plans, ", "))
+		}
+	}
+}
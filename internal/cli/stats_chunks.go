@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+)
+
+// displayChunkStats shows planned against actual time for each of a plan's
+// chunks.
+func displayChunkStats(ctx context.Context, service *stats.Service, planID string, timeRange stats.TimeRange, jsonOutput bool) error {
+	breakdown, err := service.GetChunkStats(ctx, planID, timeRange)
+	if err != nil {
+		return fmt.Errorf("failed to get chunk stats: %w", err)
+	}
+
+	if jsonOutput {
+		return printJSON(breakdown)
+	}
+
+	renderChunkStats(os.Stdout, breakdown)
+	return nil
+}
+
+// renderChunkStats writes the chunk variance table. Chunks that ran
+// stats.OverrunThreshold or more over plan are marked with ⚠ and listed
+// after the table.
+func renderChunkStats(w io.Writer, b *stats.ChunkBreakdown) {
+	fmt.Fprintf(w, "⏱️  Chunk Time for: %s\n", b.PlanTitle)
+	fmt.Fprintln(w, strings.Repeat("─", 50))
+
+	if len(b.Chunks) == 0 {
+		fmt.Fprintln(w, "This plan has no chunks.")
+		return
+	}
+
+	tw := components.NewTabWriter(w, 2)
+	fmt.Fprintln(tw, "CHUNK\tTITLE\tSTATUS\tPLANNED\tACTUAL\tVARIANCE\t")
+	for _, c := range b.Chunks {
+		actual, variance := "-", "-"
+		if c.SessionCount > 0 {
+			actual = fmt.Sprintf("%d min", c.ActualMinutes)
+			variance = formatChunkVariance(c)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d min\t%s\t%s\t\n",
+			c.ChunkID, truncate(c.Title, 30), c.Status, c.PlannedMinutes, actual, variance)
+	}
+	_ = tw.Flush()
+
+	if b.UnassignedMinutes > 0 {
+		fmt.Fprintf(w, "\n%d min logged without a chunk\n", b.UnassignedMinutes)
+	}
+
+	overruns := b.Overruns()
+	if len(overruns) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "\n⚠ %d chunk(s) ran %.0f%%+ over plan:\n", len(overruns), stats.OverrunThreshold*100)
+	for _, c := range overruns {
+		fmt.Fprintf(w, "   %s  %s  %d min planned, %d min actual\n",
+			c.ChunkID, truncate(c.Title, 30), c.PlannedMinutes, c.ActualMinutes)
+	}
+}
+
+// formatChunkVariance renders a chunk's variance as "+35 min (+58%)",
+// adding ⚠ to overruns.
+func formatChunkVariance(c stats.ChunkStats) string {
+	text := fmt.Sprintf("%+d min", c.VarianceMinutes)
+	if c.PlannedMinutes > 0 {
+		text += fmt.Sprintf(" (%+.0f%%)", c.VariancePercent*100)
+	}
+	if c.Overrun {
+		text += " ⚠"
+	}
+	return text
+}
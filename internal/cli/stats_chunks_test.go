@@ -0,0 +1,50 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderChunkStats(t *testing.T) {
+	breakdown := &stats.ChunkBreakdown{
+		PlanID:    "rust-async",
+		PlanTitle: "Async Rust",
+		Chunks: []stats.ChunkStats{
+			{ChunkID: "chunk-001", Title: "Futures", Status: "completed", PlannedMinutes: 60, ActualMinutes: 50, SessionCount: 1, VarianceMinutes: -10, VariancePercent: -10.0 / 60},
+			{ChunkID: "chunk-002", Title: "Pinning", Status: "in-progress", PlannedMinutes: 60, ActualMinutes: 95, SessionCount: 2, VarianceMinutes: 35, VariancePercent: 35.0 / 60, Overrun: true},
+			{ChunkID: "chunk-003", Title: "Tokio", Status: "not-started", PlannedMinutes: 90},
+		},
+		UnassignedMinutes: 20,
+	}
+
+	var out bytes.Buffer
+	renderChunkStats(&out, breakdown)
+	text := out.String()
+
+	assert.Contains(t, text, "Chunk Time for: Async Rust")
+	assert.Contains(t, text, "VARIANCE")
+	assert.Contains(t, text, "-10 min (-17%)")
+	assert.Contains(t, text, "+35 min (+58%) ⚠")
+	assert.Contains(t, text, "20 min logged without a chunk")
+	assert.Contains(t, text, "1 chunk(s) ran 50%+ over plan")
+	assert.Contains(t, text, "chunk-002  Pinning  60 min planned, 95 min actual")
+}
+
+func TestRenderChunkStats_NoChunks(t *testing.T) {
+	var out bytes.Buffer
+	renderChunkStats(&out, &stats.ChunkBreakdown{PlanTitle: "Empty"})
+	assert.Contains(t, out.String(), "This plan has no chunks.")
+}
+
+func TestStatsCmd_ChunksFlag(t *testing.T) {
+	flag := statsCmd().Flags().Lookup("chunks")
+	if assert.NotNil(t, flag) {
+		assert.Equal(t, "false", flag.DefValue)
+	}
+}
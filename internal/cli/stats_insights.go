@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+)
+
+// displayInsights shows the hours and weekdays the user learns most in.
+func displayInsights(ctx context.Context, service *stats.Service, timeRange stats.TimeRange, jsonOutput bool) error {
+	insights, err := service.GetInsights(ctx, timeRange)
+	if err != nil {
+		return fmt.Errorf("failed to get insights: %w", err)
+	}
+
+	if jsonOutput {
+		return printJSON(insights)
+	}
+
+	renderInsights(os.Stdout, insights)
+	return nil
+}
+
+// renderInsights writes the best time of day, hour, and weekday, then
+// charts of the time logged by weekday and by start hour.
+func renderInsights(w io.Writer, insights *stats.Insights) {
+	fmt.Fprintln(w, "💡 Learning Insights")
+	fmt.Fprintln(w, strings.Repeat("─", 50))
+
+	if insights.Sessions == 0 {
+		fmt.Fprintln(w, "No activity in selected time range.")
+		return
+	}
+
+	if insights.BestHour == nil {
+		fmt.Fprintf(w, "Log at least %d sessions to see your best times (%d so far).\n",
+			stats.InsightMinSessions, insights.Sessions)
+	} else {
+		fmt.Fprintf(w, "Best time of day: %-9s %s\n", insights.BestPeriod.Label, insights.BestPeriod.Summary())
+		fmt.Fprintf(w, "Best hour:        %-9s %s\n", insights.BestHour.Label, insights.BestHour.Summary())
+		fmt.Fprintf(w, "Best weekday:     %-9s %s\n", insights.BestWeekday.Label, insights.BestWeekday.Summary())
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Hours by weekday:")
+	printInsightChart(w, insights.Weekdays)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Hours by start time:")
+	printInsightChart(w, insights.ActiveHours())
+}
+
+// printInsightChart charts the hours in each slot.
+func printInsightChart(w io.Writer, slots []stats.InsightSlot) {
+	bars := make([]components.Bar, len(slots))
+	for i, slot := range slots {
+		hours := float64(slot.Minutes) / 60
+		bars[i] = components.Bar{Label: slot.Label, Value: hours}
+		if slot.Minutes > 0 {
+			bars[i].Note = fmt.Sprintf("%.1fh", hours)
+		}
+	}
+	for _, line := range strings.Split(components.NewBarChart(bars, 30).View(), "\n") {
+		fmt.Fprintf(w, "  %s\n", line)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/stretchr/testify/assert"
+)
+
+func insightSessions(starts ...time.Time) []session.Session {
+	sessions := make([]session.Session, len(starts))
+	for i, start := range starts {
+		end := start.Add(time.Hour)
+		sessions[i] = session.Session{ID: start.String(), PlanID: "rust", StartTime: start, EndTime: &end, Duration: 60}
+	}
+	return sessions
+}
+
+func TestRenderInsights(t *testing.T) {
+	monday := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	insights := stats.CalculateInsights(insightSessions(
+		monday, monday.Add(time.Hour), monday.AddDate(0, 0, 7),
+		monday.AddDate(0, 0, 1).Add(10*time.Hour), monday.AddDate(0, 0, 2),
+	), stats.NewTimeRangeAll(), nil)
+
+	var out bytes.Buffer
+	renderInsights(&out, insights)
+	text := out.String()
+
+	assert.Contains(t, text, "Best time of day: morning")
+	assert.Contains(t, text, "Best hour:        09:00     3.0h over 3 sessions, 60 min on average")
+	assert.Contains(t, text, "Best weekday:     Monday    3.0h over 3 sessions")
+	assert.Contains(t, text, "Hours by weekday:")
+	assert.Contains(t, text, "Sunday")
+	assert.Contains(t, text, "19:00", "the chart runs to the latest start hour")
+	assert.NotContains(t, text, "20:00")
+}
+
+func TestRenderInsights_FewSessions(t *testing.T) {
+	insights := stats.CalculateInsights(insightSessions(time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)), stats.NewTimeRangeAll(), nil)
+
+	var out bytes.Buffer
+	renderInsights(&out, insights)
+	assert.Contains(t, out.String(), "Log at least 5 sessions to see your best times (1 so far).")
+	assert.Contains(t, out.String(), "09:00")
+
+	out.Reset()
+	renderInsights(&out, stats.CalculateInsights(nil, stats.NewTimeRangeAll(), nil))
+	assert.Contains(t, out.String(), "No activity in selected time range.")
+}
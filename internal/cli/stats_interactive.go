@@ -0,0 +1,291 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/pezware/samedi.dev/internal/week"
+)
+
+// drillAction is how the user left an inline selector.
+type drillAction int
+
+const (
+	drillPending drillAction = iota
+	drillPicked
+	drillBack
+	drillQuit
+)
+
+// drillPageSize is how many selector rows are shown at once.
+const drillPageSize = 10
+
+// drillSelect is a minimal inline list picker for `samedi stats
+// --interactive`: ↑/↓ (or j/k) move, enter picks, esc goes back a level,
+// and q quits. It renders in place instead of taking over the screen.
+type drillSelect struct {
+	title  string
+	items  []string
+	cursor int
+	offset int
+	action drillAction
+}
+
+func (m drillSelect) Init() tea.Cmd {
+	return nil
+}
+
+func (m drillSelect) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch key.String() {
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(m.items)-1 {
+			m.cursor++
+		}
+	case "enter":
+		m.action = drillPicked
+		return m, tea.Quit
+	case "esc", "backspace", "left", "h":
+		m.action = drillBack
+		return m, tea.Quit
+	case "q", "ctrl+c":
+		m.action = drillQuit
+		return m, tea.Quit
+	}
+
+	// Keep the cursor inside the visible page
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	} else if m.cursor >= m.offset+drillPageSize {
+		m.offset = m.cursor - drillPageSize + 1
+	}
+	return m, nil
+}
+
+func (m drillSelect) View() string {
+	switch m.action {
+	case drillPicked:
+		return fmt.Sprintf("✔ %s: %s\n", m.title, strings.TrimSpace(m.items[m.cursor]))
+	case drillBack, drillQuit:
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "? %s  (↑/↓ move · enter select · esc back · q quit)\n", m.title)
+	end := min(m.offset+drillPageSize, len(m.items))
+	for i := m.offset; i < end; i++ {
+		marker := "  "
+		if i == m.cursor {
+			marker = "▸ "
+		}
+		fmt.Fprintf(&b, "  %s%s\n", marker, m.items[i])
+	}
+	if len(m.items) > drillPageSize {
+		fmt.Fprintf(&b, "  (%d/%d)\n", m.cursor+1, len(m.items))
+	}
+	return b.String()
+}
+
+// pickDrill shows an inline selector and returns how it was left and the
+// picked index.
+func pickDrill(title string, items []string) (drillAction, int, error) {
+	final, err := tea.NewProgram(drillSelect{title: title, items: items}).Run()
+	if err != nil {
+		return drillQuit, 0, fmt.Errorf("failed to run selector: %w", err)
+	}
+	m := final.(drillSelect)
+	return m.action, m.cursor, nil
+}
+
+// drillWeek is one week of a plan's sessions.
+type drillWeek struct {
+	Start    time.Time
+	Minutes  int
+	Sessions int
+	Days     []drillDay
+}
+
+// drillDay is one day of a plan's sessions.
+type drillDay struct {
+	Date     time.Time
+	Minutes  int
+	Sessions []session.Session
+}
+
+// groupDrillWeeks groups sessions (oldest first) into weeks, newest week
+// first, with each week's days in date order.
+func groupDrillWeeks(sessions []session.Session, startsSunday bool) []drillWeek {
+	var weeks []drillWeek
+	for i := range sessions {
+		sess := sessions[i]
+		start := week.StartOf(sess.StartTime, startsSunday)
+		day := time.Date(sess.StartTime.Year(), sess.StartTime.Month(), sess.StartTime.Day(), 0, 0, 0, 0, sess.StartTime.Location())
+
+		if len(weeks) == 0 || !weeks[len(weeks)-1].Start.Equal(start) {
+			weeks = append(weeks, drillWeek{Start: start})
+		}
+		w := &weeks[len(weeks)-1]
+		w.Minutes += sess.Duration
+		w.Sessions++
+
+		if len(w.Days) == 0 || !w.Days[len(w.Days)-1].Date.Equal(day) {
+			w.Days = append(w.Days, drillDay{Date: day})
+		}
+		d := &w.Days[len(w.Days)-1]
+		d.Minutes += sess.Duration
+		d.Sessions = append(d.Sessions, sess)
+	}
+
+	sort.SliceStable(weeks, func(i, j int) bool {
+		return weeks[i].Start.After(weeks[j].Start)
+	})
+	return weeks
+}
+
+// drillPlans returns the plans with sessions in range, most hours first.
+func drillPlans(all map[string]stats.PlanStats) []stats.PlanStats {
+	plans := make([]stats.PlanStats, 0, len(all))
+	for _, ps := range all {
+		if ps.SessionCount > 0 {
+			plans = append(plans, ps)
+		}
+	}
+	sort.Slice(plans, func(i, j int) bool {
+		if plans[i].TotalHours != plans[j].TotalHours {
+			return plans[i].TotalHours > plans[j].TotalHours
+		}
+		return plans[i].PlanID < plans[j].PlanID
+	})
+	return plans
+}
+
+// runStatsDrill drills from plans to weeks to days with inline selectors.
+// With planID set it starts at that plan's weeks.
+func runStatsDrill(ctx context.Context, service *stats.Service, planID string, timeRange stats.TimeRange, startsSunday bool) error {
+	if planID != "" {
+		planStats, err := service.GetPlanStats(ctx, planID, timeRange)
+		if err != nil {
+			return fmt.Errorf("failed to get plan stats: %w", err)
+		}
+		_, err = drillPlanWeeks(ctx, service, *planStats, timeRange, startsSunday)
+		return err
+	}
+
+	all, err := service.GetAllPlanStats(ctx, timeRange)
+	if err != nil {
+		return fmt.Errorf("failed to get plan stats: %w", err)
+	}
+	plans := drillPlans(all)
+	if len(plans) == 0 {
+		fmt.Println("No learning sessions in selected time range.")
+		return nil
+	}
+
+	labels := make([]string, len(plans))
+	for i, ps := range plans {
+		labels[i] = fmt.Sprintf("%-32s %6.1fh  %3d%%", truncate(ps.PlanTitle, 32), ps.TotalHours, ps.ProgressPercent())
+	}
+
+	for {
+		action, i, err := pickDrill("Plan", labels)
+		if err != nil || action != drillPicked {
+			return err
+		}
+		quit, err := drillPlanWeeks(ctx, service, plans[i], timeRange, startsSunday)
+		if err != nil || quit {
+			return err
+		}
+	}
+}
+
+// drillPlanWeeks lets the user pick a week of a plan. It reports whether
+// the user quit rather than going back.
+func drillPlanWeeks(ctx context.Context, service *stats.Service, ps stats.PlanStats, timeRange stats.TimeRange, startsSunday bool) (bool, error) {
+	sessions, err := service.GetPlanSessions(ctx, ps.PlanID, timeRange)
+	if err != nil {
+		return true, err
+	}
+	weeks := groupDrillWeeks(sessions, startsSunday)
+	if len(weeks) == 0 {
+		fmt.Printf("No sessions for %s in selected time range.\n", ps.PlanTitle)
+		return false, nil
+	}
+
+	labels := make([]string, len(weeks))
+	for i, w := range weeks {
+		labels[i] = fmt.Sprintf("Week of %-12s %6.1fh  %d session(s)", w.Start.Format("Jan 2, 2006"), float64(w.Minutes)/60, w.Sessions)
+	}
+
+	for {
+		action, i, err := pickDrill(ps.PlanTitle+" › Week", labels)
+		if err != nil || action == drillQuit {
+			return true, err
+		}
+		if action == drillBack {
+			return false, nil
+		}
+		quit, err := drillWeekDays(ps, weeks[i])
+		if err != nil || quit {
+			return true, err
+		}
+	}
+}
+
+// drillWeekDays lets the user pick a day of a week and prints its
+// sessions. It reports whether the user quit rather than going back.
+func drillWeekDays(ps stats.PlanStats, w drillWeek) (bool, error) {
+	labels := make([]string, len(w.Days))
+	for i, d := range w.Days {
+		labels[i] = fmt.Sprintf("%-12s %6.1fh  %d session(s)", d.Date.Format("Mon Jan 2"), float64(d.Minutes)/60, len(d.Sessions))
+	}
+
+	for {
+		action, i, err := pickDrill(ps.PlanTitle+" › Day", labels)
+		if err != nil || action == drillQuit {
+			return true, err
+		}
+		if action == drillBack {
+			return false, nil
+		}
+		printDrillDay(ps, w.Days[i])
+	}
+}
+
+// printDrillDay prints the sessions of one day.
+func printDrillDay(ps stats.PlanStats, d drillDay) {
+	fmt.Printf("\n📅 %s — %s\n", d.Date.Format("Monday, January 2, 2006"), ps.PlanTitle)
+	fmt.Println(strings.Repeat("─", 50))
+	for i := range d.Sessions {
+		sess := d.Sessions[i]
+		end := "active"
+		if sess.EndTime != nil {
+			end = sess.EndTime.Format("15:04")
+		}
+		chunk := sess.ChunkID
+		if chunk == "" {
+			chunk = "-"
+		}
+		fmt.Printf("  %s–%-6s %4d min  %s\n", sess.StartTime.Format("15:04"), end, sess.Duration, chunk)
+		if sess.Notes != "" {
+			fmt.Printf("    %s\n", truncate(strings.Join(strings.Fields(sess.Notes), " "), 70))
+		}
+	}
+	fmt.Printf("  Total: %.1f hours\n\n", float64(d.Minutes)/60)
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func drillKey(m drillSelect, key string) drillSelect {
+	var msg tea.KeyMsg
+	switch key {
+	case "down":
+		msg = tea.KeyMsg{Type: tea.KeyDown}
+	case "up":
+		msg = tea.KeyMsg{Type: tea.KeyUp}
+	case "enter":
+		msg = tea.KeyMsg{Type: tea.KeyEnter}
+	case "esc":
+		msg = tea.KeyMsg{Type: tea.KeyEsc}
+	default:
+		msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+	}
+	next, _ := m.Update(msg)
+	return next.(drillSelect)
+}
+
+func TestDrillSelect_Navigation(t *testing.T) {
+	items := make([]string, 15)
+	for i := range items {
+		items[i] = string(rune('a' + i))
+	}
+	m := drillSelect{title: "Plan", items: items}
+
+	m = drillKey(m, "up")
+	assert.Equal(t, 0, m.cursor, "cursor stops at the top")
+
+	for range 12 {
+		m = drillKey(m, "j")
+	}
+	assert.Equal(t, 12, m.cursor)
+	assert.Equal(t, 3, m.offset, "the page scrolls with the cursor")
+	assert.Contains(t, m.View(), "▸ m")
+	assert.NotContains(t, m.View(), "  a\n")
+
+	picked := drillKey(m, "enter")
+	assert.Equal(t, drillPicked, picked.action)
+	assert.Equal(t, "✔ Plan: m\n", picked.View())
+
+	assert.Equal(t, drillBack, drillKey(m, "esc").action)
+	assert.Equal(t, drillQuit, drillKey(m, "q").action)
+}
+
+func TestGroupDrillWeeks(t *testing.T) {
+	at := func(day, hour int) time.Time {
+		return time.Date(2025, 10, day, hour, 0, 0, 0, time.Local)
+	}
+	// Oct 12, 2025 is a Sunday
+	sessions := []session.Session{
+		{ID: "s1", StartTime: at(10, 9), Duration: 30},
+		{ID: "s2", StartTime: at(12, 9), Duration: 60},
+		{ID: "s3", StartTime: at(13, 9), Duration: 45},
+		{ID: "s4", StartTime: at(13, 18), Duration: 15},
+	}
+
+	weeks := groupDrillWeeks(sessions, false)
+	require.Len(t, weeks, 2)
+	assert.Equal(t, at(13, 0), weeks[0].Start, "newest week first")
+	assert.Equal(t, 60, weeks[0].Minutes)
+	require.Len(t, weeks[0].Days, 1)
+	assert.Len(t, weeks[0].Days[0].Sessions, 2)
+
+	assert.Equal(t, at(6, 0), weeks[1].Start)
+	assert.Equal(t, 90, weeks[1].Minutes)
+	assert.Len(t, weeks[1].Days, 2)
+
+	// With Sunday-first weeks the Sunday session joins the later week
+	weeks = groupDrillWeeks(sessions, true)
+	require.Len(t, weeks, 2)
+	assert.Equal(t, at(12, 0), weeks[0].Start)
+	assert.Equal(t, 3, weeks[0].Sessions)
+}
+
+func TestDrillPlans(t *testing.T) {
+	plans := drillPlans(map[string]stats.PlanStats{
+		"idle":  {PlanID: "idle"},
+		"rust":  {PlanID: "rust", TotalHours: 2, SessionCount: 3},
+		"go":    {PlanID: "go", TotalHours: 5, SessionCount: 4},
+		"elixr": {PlanID: "elixr", TotalHours: 2, SessionCount: 1},
+	})
+
+	ids := make([]string, len(plans))
+	for i, ps := range plans {
+		ids[i] = ps.PlanID
+	}
+	assert.Equal(t, []string{"go", "elixr", "rust"}, ids, "plans without sessions are skipped")
+}
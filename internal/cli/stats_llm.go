@@ -0,0 +1,143 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/ledger"
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+)
+
+// llmUsageReport is the JSON shape of `samedi stats --llm`.
+type llmUsageReport struct {
+	Months []ledger.Summary `json:"months"`
+	Total  ledger.Summary   `json:"total"`
+}
+
+// displayLLMUsage shows LLM calls, tokens, and estimated cost per month.
+func displayLLMUsage(ctx context.Context, timeRange stats.TimeRange, jsonOutput bool) error {
+	svc, err := getLedgerService()
+	if err != nil {
+		return fmt.Errorf("failed to initialize ledger: %w", err)
+	}
+
+	summaries, err := svc.Monthly(ctx, ledger.Filter{Since: timeRange.Start})
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		return printJSON(llmUsageReport{Months: summaries, Total: totalLLMUsage(summaries)})
+	}
+
+	renderLLMUsage(os.Stdout, summaries)
+	return nil
+}
+
+// totalLLMUsage sums monthly summaries into one.
+func totalLLMUsage(summaries []ledger.Summary) ledger.Summary {
+	total := ledger.Summary{Month: "total", Operation: "all"}
+	for _, s := range summaries {
+		total.Calls += s.Calls
+		total.InputTokens += s.InputTokens
+		total.OutputTokens += s.OutputTokens
+		total.CostUSD += s.CostUSD
+		total.EstimatedCalls += s.EstimatedCalls
+		total.UnpricedCalls += s.UnpricedCalls
+	}
+	return total
+}
+
+// renderLLMUsage writes the monthly usage table. Token counts that include
+// estimates are prefixed with "~"; costs missing unknown-model calls get "+".
+func renderLLMUsage(w io.Writer, summaries []ledger.Summary) {
+	fmt.Fprintln(w, "🤖 LLM Usage")
+	fmt.Fprintln(w, strings.Repeat("─", 50))
+
+	if len(summaries) == 0 {
+		fmt.Fprintln(w, "No LLM calls recorded in selected time range.")
+		return
+	}
+
+	tw := components.NewTabWriter(w, 2)
+	fmt.Fprintln(tw, "MONTH\tOPERATION\tCALLS\tINPUT\tOUTPUT\tCOST")
+
+	month := ""
+	for _, s := range summaries {
+		label := s.Month
+		if label == month {
+			label = ""
+		}
+		month = s.Month
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%s\t%s\n",
+			label, s.Operation, s.Calls,
+			formatTokens(s.InputTokens, s.EstimatedCalls > 0),
+			formatTokens(s.OutputTokens, s.EstimatedCalls > 0),
+			formatCost(s.CostUSD, s.UnpricedCalls > 0),
+		)
+	}
+
+	total := totalLLMUsage(summaries)
+	fmt.Fprintf(tw, "\t%s\t%d\t%s\t%s\t%s\n",
+		"total", total.Calls,
+		formatTokens(total.InputTokens, total.EstimatedCalls > 0),
+		formatTokens(total.OutputTokens, total.EstimatedCalls > 0),
+		formatCost(total.CostUSD, total.UnpricedCalls > 0),
+	)
+	tw.Flush()
+
+	if total.EstimatedCalls > 0 {
+		fmt.Fprintf(w, "\n~ %d call(s) used estimated token counts (CLI providers don't report usage)\n", total.EstimatedCalls)
+	}
+	if total.UnpricedCalls > 0 {
+		fmt.Fprintf(w, "+ %d call(s) used a model with unknown pricing and are not included in cost\n", total.UnpricedCalls)
+	}
+}
+
+// formatTokens renders a token count compactly (e.g. 950, 12.4k, 1.2M).
+func formatTokens(n int, estimated bool) string {
+	var text string
+	switch {
+	case n >= 1_000_000:
+		text = fmt.Sprintf("%.1fM", float64(n)/1_000_000)
+	case n >= 1_000:
+		text = fmt.Sprintf("%.1fk", float64(n)/1_000)
+	default:
+		text = fmt.Sprintf("%d", n)
+	}
+	if estimated {
+		return "~" + text
+	}
+	return text
+}
+
+// formatCost renders a dollar amount, marking totals that exclude unpriced calls.
+func formatCost(cost float64, partial bool) string {
+	text := fmt.Sprintf("$%.2f", cost)
+	if partial {
+		return text + "+"
+	}
+	return text
+}
+
+// getLedgerService initializes the LLM cost ledger with its database.
+func getLedgerService() (*ledger.Service, error) {
+	paths, err := getPaths()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get paths: %w", err)
+	}
+
+	db, err := sharedDatabase(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	return ledger.NewService(ledger.NewSQLiteRepository(db)), nil
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/ledger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderLLMUsage(t *testing.T) {
+	summaries := []ledger.Summary{
+		{Month: "2025-10", Operation: "cards.generate", Calls: 1, InputTokens: 500, OutputTokens: 800, EstimatedCalls: 1, UnpricedCalls: 1},
+		{Month: "2025-10", Operation: "plan.generate", Calls: 2, InputTokens: 2400, OutputTokens: 12_500, CostUSD: 0.19},
+		{Month: "2025-09", Operation: "plan.generate", Calls: 1, InputTokens: 1200, OutputTokens: 6000, CostUSD: 0.09},
+	}
+
+	var out bytes.Buffer
+	renderLLMUsage(&out, summaries)
+	text := out.String()
+
+	assert.Contains(t, text, "MONTH")
+	assert.Contains(t, text, "2025-10")
+	assert.Contains(t, text, "12.5k")
+	assert.Contains(t, text, "~500")
+	assert.Contains(t, text, "$0.28+") // total excludes the unpriced call
+	assert.Equal(t, 1, bytes.Count(out.Bytes(), []byte("2025-10")), "month label printed once per group")
+	assert.Contains(t, text, "1 call(s) used estimated token counts")
+	assert.Contains(t, text, "1 call(s) used a model with unknown pricing")
+}
+
+func TestRenderLLMUsage_Empty(t *testing.T) {
+	var out bytes.Buffer
+	renderLLMUsage(&out, nil)
+	assert.Contains(t, out.String(), "No LLM calls recorded")
+}
+
+func TestFormatTokens(t *testing.T) {
+	assert.Equal(t, "950", formatTokens(950, false))
+	assert.Equal(t, "12.4k", formatTokens(12_400, false))
+	assert.Equal(t, "~1.2M", formatTokens(1_200_000, true))
+}
+
+func TestStatsCmd_LLMFlag(t *testing.T) {
+	flag := statsCmd().Flags().Lookup("llm")
+	if assert.NotNil(t, flag) {
+		assert.Equal(t, "false", flag.DefValue)
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+)
+
+// displayAllProfileStats shows merged totals across every profile with a
+// per-profile breakdown. Each profile's database is opened read-only and
+// only computed totals are combined.
+func displayAllProfileStats(ctx context.Context, timeRange stats.TimeRange, jsonOutput bool) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	paths, err := rootPaths(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to get paths: %w", err)
+	}
+
+	names, err := paths.Profiles()
+	if err != nil {
+		return err
+	}
+	cipher, err := openCipher(cfg)
+	if err != nil {
+		return err
+	}
+
+	rules := streakRules(cfg)
+	profiles := make([]stats.ProfileStats, 0, len(names)+1)
+	if _, err := os.Stat(paths.DatabasePath); err == nil {
+		profiles = append(profiles, readProfileStats(ctx, defaultProfile, paths, cipher, rules, timeRange))
+	}
+	for _, name := range names {
+		profiles = append(profiles, readProfileStats(ctx, name, paths.Profile(name), cipher, rules, timeRange))
+	}
+
+	merged := stats.MergeProfileStats(profiles, rules)
+	if jsonOutput {
+		return printJSON(merged)
+	}
+
+	renderProfileStats(os.Stdout, merged)
+	return nil
+}
+
+// readProfileStats computes one profile's totals. Failures are recorded on
+// the result so the other profiles can still be shown.
+func readProfileStats(ctx context.Context, name string, paths *storage.Paths, cipher *storage.Cipher, rules stats.StreakRules, timeRange stats.TimeRange) stats.ProfileStats {
+	svc, db, err := openProfileStatsService(paths, cipher)
+	if err != nil {
+		return stats.ProfileStats{Profile: name, Error: err.Error()}
+	}
+	defer db.Close()
+	svc.SetStreakRules(rules)
+	svc.SetLocation(rules.Location)
+
+	result, err := svc.GetProfileStats(ctx, name, timeRange)
+	if err != nil {
+		return stats.ProfileStats{Profile: name, Error: err.Error()}
+	}
+	return result
+}
+
+// openProfileStatsService builds a stats service over a profile's storage
+// without creating directories or running migrations. The caller closes
+// the returned database. A nil cipher reads unencrypted profiles only.
+func openProfileStatsService(paths *storage.Paths, cipher *storage.Cipher) (*stats.Service, *storage.SQLiteDB, error) {
+	db, err := storage.OpenSQLiteReadOnly(paths.DatabasePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pending, err := storage.NewMigrator(db).Pending()
+	if err != nil {
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("failed to check schema: %w", err)
+	}
+	if pending > 0 {
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("database schema is out of date (run samedi against this profile once to upgrade it)")
+	}
+
+	fs := storage.NewFilesystemStorage(paths)
+	fs.SetCipher(cipher)
+	planRepo := plan.NewSQLiteRepository(db)
+	planRepo.SetCipher(cipher)
+	planService := plan.NewService(planRepo, plan.NewFilesystemRepository(fs, paths), nil, fs, paths)
+	sessionService := &statsSessionServiceAdapter{repo: session.NewEncryptedSQLiteRepository(db, cipher)}
+
+	return stats.NewService(planService, sessionService), db, nil
+}
+
+// renderProfileStats writes the per-profile table followed by merged totals.
+func renderProfileStats(w io.Writer, merged *stats.MultiProfileStats) {
+	fmt.Fprintln(w, "📊 Learning Statistics — All Profiles")
+	fmt.Fprintln(w, strings.Repeat("─", 50))
+
+	if len(merged.Profiles) == 0 {
+		fmt.Fprintln(w, "No profiles found. Run 'samedi init' to create a plan.")
+		return
+	}
+
+	tw := components.NewTabWriter(w, 2)
+	fmt.Fprintln(tw, "PROFILE\tHOURS\tSESSIONS\tACTIVE\tCOMPLETED\tSTREAK")
+	for _, p := range merged.Profiles {
+		if p.Stats == nil {
+			fmt.Fprintf(tw, "%s\t—\t—\t—\t—\t—\n", p.Profile)
+			continue
+		}
+		fmt.Fprintf(tw, "%s\t%.1f\t%d\t%d\t%d\t%d\n",
+			p.Profile, p.Stats.TotalHours, p.Stats.TotalSessions,
+			p.Stats.ActivePlans, p.Stats.CompletedPlans, p.Stats.CurrentStreak)
+	}
+	t := merged.Totals
+	fmt.Fprintf(tw, "total\t%.1f\t%d\t%d\t%d\t%d\n",
+		t.TotalHours, t.TotalSessions, t.ActivePlans, t.CompletedPlans, t.CurrentStreak)
+	tw.Flush()
+
+	if t.TotalSessions > 0 {
+		fmt.Fprintf(w, "\nAverage session:  %.0f minutes\n", t.AverageSession)
+	}
+	fmt.Fprintf(w, "Longest streak:   %d days (days count once across profiles)\n", t.LongestStreak)
+	if t.LastSessionDate != nil {
+		fmt.Fprintf(w, "Last session:     %s\n", t.LastSessionDate.Format("Monday, January 2, 2006 at 3:04 PM"))
+	}
+
+	for _, p := range merged.Profiles {
+		if p.Error != "" {
+			fmt.Fprintf(w, "\n⚠️  Skipped %s: %s\n", p.Profile, p.Error)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderProfileStats(t *testing.T) {
+	merged := stats.MergeProfileStats([]stats.ProfileStats{
+		{Profile: "default", Stats: &stats.TotalStats{TotalHours: 3, TotalSessions: 4, ActivePlans: 1}},
+		{Profile: "work", Stats: &stats.TotalStats{TotalHours: 2.5, TotalSessions: 2, CompletedPlans: 1}},
+		{Profile: "old", Error: "database schema is out of date"},
+	}, stats.StreakRules{})
+
+	var out bytes.Buffer
+	renderProfileStats(&out, merged)
+	text := out.String()
+
+	assert.Contains(t, text, "PROFILE")
+	assert.Contains(t, text, "work")
+	assert.Contains(t, text, "5.5") // merged hours
+	assert.Contains(t, text, "Skipped old: database schema is out of date")
+}
+
+func TestRenderProfileStats_NoProfiles(t *testing.T) {
+	var out bytes.Buffer
+	renderProfileStats(&out, stats.MergeProfileStats(nil, stats.StreakRules{}))
+	assert.Contains(t, out.String(), "No profiles found")
+}
+
+func TestOpenProfileStatsService(t *testing.T) {
+	root := &storage.Paths{BaseDir: t.TempDir()}
+	paths := root.Profile("work")
+	require.NoError(t, paths.EnsureDirectories())
+
+	db, err := storage.NewSQLiteDB(paths.DatabasePath)
+	require.NoError(t, err)
+	require.NoError(t, storage.NewMigrator(db).Migrate())
+	require.NoError(t, db.Close())
+
+	result := readProfileStats(context.Background(), "work", paths, nil, stats.StreakRules{}, stats.NewTimeRangeAll())
+	assert.Empty(t, result.Error)
+	require.NotNil(t, result.Stats)
+	assert.Zero(t, result.Stats.TotalSessions)
+}
+
+func TestOpenProfileStatsService_OutdatedSchema(t *testing.T) {
+	paths := (&storage.Paths{BaseDir: t.TempDir()}).Profile("old")
+	require.NoError(t, paths.EnsureDirectories())
+
+	db, err := storage.NewSQLiteDB(paths.DatabasePath)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	_, _, err = openProfileStatsService(paths, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "out of date")
+}
+
+func TestOpenProfileStatsService_MissingDatabase(t *testing.T) {
+	paths := (&storage.Paths{BaseDir: t.TempDir()}).Profile("none")
+
+	_, _, err := openProfileStatsService(paths, nil)
+	assert.Error(t, err)
+	assert.NoFileExists(t, filepath.Join(paths.BaseDir, "sessions.db"))
+}
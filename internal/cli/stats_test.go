@@ -4,8 +4,12 @@
 package cli
 
 import (
+	"bytes"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/pezware/samedi.dev/internal/stats"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -58,8 +62,67 @@ func TestStatsCmd_BreakdownFlag(t *testing.T) {
 	// Check --breakdown flag exists
 	breakdownFlag := cmd.Flags().Lookup("breakdown")
 	require.NotNil(t, breakdownFlag)
-	assert.Equal(t, "false", breakdownFlag.DefValue)
-	assert.Contains(t, breakdownFlag.Usage, "daily breakdown")
+	assert.Equal(t, "", breakdownFlag.DefValue)
+	assert.Equal(t, "daily", breakdownFlag.NoOptDefVal, "a bare --breakdown is daily")
+	assert.Contains(t, breakdownFlag.Usage, "weekly, or monthly breakdown")
+}
+
+func TestStatsCmd_InvalidFlagsAreUsageErrors(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	for _, args := range [][]string{
+		{"--range", "bogus"},
+		{"--breakdown=hourly"},
+		{"--interactive", "--json"},
+		{"--interactive"}, // Tests don't run in a terminal
+		{"--chunks"},
+		{"--trend", "--range", "today"},
+		{"--insights", "--chunks", "rust"},
+	} {
+		cmd := statsCmd()
+		cmd.Flags().Bool("json", false, "") // A persistent flag of the root
+		cmd.SetArgs(args)
+		cmd.SilenceUsage, cmd.SilenceErrors = true, true
+		err := cmd.Execute()
+		require.Error(t, err, args)
+		assert.Equal(t, ExitInvalid, ExitCode(err), args)
+	}
+}
+
+func TestSplitBreakdownArgs(t *testing.T) {
+	level, args := splitBreakdownArgs("daily", []string{"rust", "weekly"})
+	assert.Equal(t, "weekly", level)
+	assert.Equal(t, []string{"rust"}, args)
+
+	level, args = splitBreakdownArgs("daily", []string{"rust"})
+	assert.Equal(t, "daily", level)
+	assert.Equal(t, []string{"rust"}, args)
+
+	level, args = splitBreakdownArgs("monthly", []string{"weekly"})
+	assert.Equal(t, "monthly", level, "an explicit --breakdown=level keeps a plan named like a level")
+	assert.Equal(t, []string{"weekly"}, args)
+}
+
+func TestPrintPeriods(t *testing.T) {
+	day := func(m time.Month, d int) time.Time { return time.Date(2024, m, d, 0, 0, 0, 0, time.UTC) }
+	daily := []stats.DailyStats{
+		{Date: day(10, 1), Duration: 120, SessionCount: 2, Plans: []string{"rust"}},
+		{Date: day(10, 16), Duration: 30, SessionCount: 1, Plans: []string{"go"}},
+	}
+
+	var buf bytes.Buffer
+	printPeriods(&buf, stats.CalculateWeeklyStats(daily, false), false)
+	out := buf.String()
+	assert.Contains(t, out, "Hours per week (peak 2.0h)")
+	assert.Contains(t, out, "  Oct 7\n", "idle weeks get an empty bar")
+	assert.Contains(t, out, "Week of Monday, September 30, 2024:")
+	assert.Contains(t, out, "  📊 Sessions: 2 on 1 days")
+	assert.NotContains(t, out, "Week of Monday, October 7", "idle weeks are charted but not listed")
+
+	buf.Reset()
+	printPeriods(&buf, stats.CalculateMonthlyStats(daily), true)
+	assert.Contains(t, buf.String(), "October 2024:")
+	assert.Contains(t, buf.String(), "  📚 Plans: go, rust")
 }
 
 func TestStatsCmd_TUIFlag(t *testing.T) {
@@ -240,3 +303,23 @@ func TestLaunchTUI_FunctionExists(t *testing.T) {
 	// but we can verify it compiles
 	assert.NotNil(t, launchTUI)
 }
+
+func TestPrintDailyChart(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 10, d, 0, 0, 0, 0, time.UTC) }
+	daily := []stats.DailyStats{
+		{Date: day(1), Duration: 120, SessionCount: 1},
+		{Date: day(3), Duration: 30, SessionCount: 1},
+	}
+
+	var buf bytes.Buffer
+	printDailyChart(&buf, daily, stats.TimeRange{Start: day(1), End: day(3)})
+	out := buf.String()
+	assert.Contains(t, out, "Hours per day, Oct 1 – Oct 3, 2024 (peak 2.0h)")
+	assert.Contains(t, out, "Tue Oct 1 "+strings.Repeat("█", 30)+" 2.0h")
+	assert.Contains(t, out, "  Wed Oct 2\n", "idle days get an empty bar")
+
+	buf.Reset()
+	printDailyChart(&buf, daily, stats.TimeRange{Start: day(1), End: day(1).AddDate(0, 2, 0)})
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 2, "long ranges get a one-line sparkline")
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+)
+
+// displayTrends shows this week's velocity against last week's for each
+// active plan.
+func displayTrends(ctx context.Context, service *stats.Service, jsonOutput bool) error {
+	trends, err := service.GetTrends(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to get trends: %w", err)
+	}
+
+	if jsonOutput {
+		return printJSON(trends)
+	}
+
+	renderTrends(os.Stdout, trends)
+	return nil
+}
+
+// renderTrends writes one row per plan with its hours and chunks for both
+// weeks, then a line counting plans that are speeding up or slowing down.
+func renderTrends(w io.Writer, trends []stats.PlanVelocity) {
+	fmt.Fprintln(w, "🚀 Learning Velocity (last 7 days vs the 7 before)")
+	fmt.Fprintln(w, strings.Repeat("─", 50))
+
+	if len(trends) == 0 {
+		fmt.Fprintln(w, "No active plans.")
+		return
+	}
+
+	tw := components.NewTabWriter(w, 2)
+	fmt.Fprintln(tw, "PLAN\tTHIS WEEK\tLAST WEEK\tTREND\t")
+	up, down := 0, 0
+	for _, t := range trends {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s %s\t\n",
+			truncate(t.PlanTitle, 30), t.ThisWeek(), t.LastWeek(), t.Trend.Arrow(), t.Trend)
+		switch t.Trend {
+		case stats.TrendUp:
+			up++
+		case stats.TrendDown:
+			down++
+		}
+	}
+	_ = tw.Flush()
+
+	fmt.Fprintf(w, "\n%d accelerating, %d slowing down, %d steady\n", up, down, len(trends)-up-down)
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderTrends(t *testing.T) {
+	trends := []stats.PlanVelocity{
+		{PlanID: "rust-async", PlanTitle: "Async Rust", Velocity: stats.Velocity{
+			ThisWeekHours: 4, LastWeekHours: 2, ThisWeekChunks: 2, LastWeekChunks: 1, Trend: stats.TrendUp,
+		}},
+		{PlanID: "go-basics", PlanTitle: "Go Basics", Velocity: stats.Velocity{
+			LastWeekHours: 3, Trend: stats.TrendDown,
+		}},
+	}
+
+	var out bytes.Buffer
+	renderTrends(&out, trends)
+	text := out.String()
+
+	assert.Contains(t, text, "TREND")
+	assert.Contains(t, text, "4.0h, 2 chunks")
+	assert.Contains(t, text, "2.0h, 1 chunk")
+	assert.Contains(t, text, "↑ accelerating")
+	assert.Contains(t, text, "↓ slowing")
+	assert.Contains(t, text, "1 accelerating, 1 slowing down, 0 steady")
+}
+
+func TestRenderTrends_NoPlans(t *testing.T) {
+	var out bytes.Buffer
+	renderTrends(&out, nil)
+	assert.Contains(t, out.String(), "No active plans.")
+}
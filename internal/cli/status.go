@@ -6,14 +6,25 @@ package cli
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/storage"
 	"github.com/spf13/cobra"
 )
 
 // statusCmd creates the `samedi status` command for checking active session.
 func statusCmd() *cobra.Command {
+	var (
+		minimal bool
+		format  string
+	)
+
 	cmd := &cobra.Command{
 		Use:   "status",
 		Short: "Check active session status",
@@ -26,20 +37,41 @@ If there is an active session, shows:
 
 If there is no active session, shows recent sessions instead.
 
+With --minimal, prints one plain line for tmux status bars and shell
+prompts, read from a small status file kept up to date as sessions start
+and stop, without opening the database. Nothing is printed when no session
+is active. The line follows --format, or status.format in the config:
+
+  {plan}     plan ID                 {chunk}    chunk ID (may be empty)
+  {elapsed}  e.g. 45m or 1h05m       {minutes}  elapsed minutes
+  {start}    start time, HH:MM       {state}    running or paused
+
+Empty placeholders leave no double spaces behind.
+
 Examples:
-  samedi status`,
+  samedi status
+  samedi status --minimal
+  samedi status --minimal --format "{plan}:{chunk} {elapsed}"
+  set -g status-right '#(samedi status --minimal)'   # tmux`,
 		Args: cobra.NoArgs,
-		Run: func(cmd *cobra.Command, _ []string) {
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if minimal {
+				if err := printMinimalStatus(cmd, os.Stdout, format); err != nil {
+					return fmt.Errorf("failed to get status: %w", err)
+				}
+				return nil
+			}
+
 			// Initialize session service
 			svc, err := getSessionService(cmd)
 			if err != nil {
-				exitWithError("Failed to initialize: %v", err)
+				return fmt.Errorf("failed to initialize: %w", err)
 			}
 
 			// Get status
 			status, err := svc.GetStatus(context.Background())
 			if err != nil {
-				exitWithError("Failed to get status: %v", err)
+				return fmt.Errorf("failed to get status: %w", err)
 			}
 
 			// Check if there's an active session
@@ -48,12 +80,89 @@ Examples:
 			} else {
 				displayNoActiveSession(status.Recent)
 			}
+
+			return nil
 		},
 	}
 
+	cmd.Flags().BoolVar(&minimal, "minimal", false, "print one plain line for status bars and prompts")
+	cmd.Flags().StringVar(&format, "format", "", "format of the --minimal line (default: status.format)")
+
 	return cmd
 }
 
+// statusSnapshot returns the cache of the active session kept in the
+// state directory of paths.
+func statusSnapshot(paths *storage.Paths) *session.SnapshotFile {
+	return session.NewSnapshotFile(filepath.Join(paths.BaseDir, "state", "status.json"))
+}
+
+// printMinimalStatus writes the --minimal status line to w. The snapshot
+// file is read when present; without one, the database is asked once and
+// the snapshot written for next time.
+func printMinimalStatus(cmd *cobra.Command, w io.Writer, format string) error {
+	cfg, err := getConfig(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if format == "" {
+		format = cfg.Status.Format
+	}
+	paths, err := configPaths(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to get paths: %w", err)
+	}
+
+	snapshot := statusSnapshot(paths)
+	var active *session.Session
+	if snap, err := snapshot.Read(); err == nil {
+		active = snap.Session()
+	} else {
+		svc, err := getSessionService(cmd)
+		if err != nil {
+			return fmt.Errorf("failed to initialize: %w", err)
+		}
+		if active, err = svc.GetActive(context.Background()); err != nil {
+			return err
+		}
+		_ = snapshot.Write(active)
+	}
+
+	if active == nil {
+		return nil
+	}
+	_, err = fmt.Fprintln(w, formatStatusLine(format, active, time.Now()))
+	return err
+}
+
+// formatStatusLine fills the placeholders of format from sess.
+func formatStatusLine(format string, sess *session.Session, now time.Time) string {
+	elapsed := sess.Elapsed(now)
+	state := "running"
+	if sess.IsPaused() {
+		state = "paused"
+	}
+
+	line := strings.NewReplacer(
+		"{plan}", sess.PlanID,
+		"{chunk}", sess.ChunkID,
+		"{elapsed}", compactDuration(elapsed),
+		"{minutes}", strconv.Itoa(int(elapsed.Minutes())),
+		"{start}", sess.StartTime.Format("15:04"),
+		"{state}", state,
+	).Replace(format)
+	return strings.Join(strings.Fields(line), " ")
+}
+
+// compactDuration formats d as "45m" or "1h05m".
+func compactDuration(d time.Duration) string {
+	minutes := int(d.Minutes())
+	if minutes < 60 {
+		return fmt.Sprintf("%dm", minutes)
+	}
+	return fmt.Sprintf("%dh%02dm", minutes/60, minutes%60)
+}
+
 // displayActiveSession shows information about the active session.
 func displayActiveSession(cmd *cobra.Command, sess *session.Session) {
 	fmt.Printf("→ Active session: %s", sess.PlanID)
@@ -63,7 +172,11 @@ func displayActiveSession(cmd *cobra.Command, sess *session.Session) {
 	fmt.Println()
 
 	fmt.Printf("  Started: %s\n", sess.StartTime.Format("15:04"))
-	fmt.Printf("  Elapsed: %s\n", sess.ElapsedTime())
+	if sess.IsPaused() {
+		fmt.Printf("  Elapsed: %s (paused)\n", sess.ElapsedTime())
+	} else {
+		fmt.Printf("  Elapsed: %s\n", sess.ElapsedTime())
+	}
 
 	if sess.Notes != "" {
 		fmt.Printf("  Notes: %s\n", sess.Notes)
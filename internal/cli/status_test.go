@@ -0,0 +1,75 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatStatusLine(t *testing.T) {
+	start := time.Date(2025, 1, 15, 14, 0, 0, 0, time.Local)
+	sess := &session.Session{PlanID: "rust", ChunkID: "chunk-003", StartTime: start}
+
+	now := start.Add(65 * time.Minute)
+	assert.Equal(t, "rust chunk-003 1h05m", formatStatusLine(config.DefaultStatusFormat, sess, now))
+	assert.Equal(t, "rust:chunk-003 65 14:00 running", formatStatusLine("{plan}:{chunk} {minutes} {start} {state}", sess, now))
+
+	sess.ChunkID = ""
+	assert.Equal(t, "rust 1h05m", formatStatusLine(config.DefaultStatusFormat, sess, now), "no double space for a missing chunk")
+
+	paused := start.Add(20 * time.Minute)
+	sess.PausedAt = &paused
+	assert.Equal(t, "20m paused", formatStatusLine("{elapsed} {state}", sess, now))
+}
+
+func TestPrintMinimalStatus(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	cmd := statusCmd()
+
+	cfg, err := getConfig(cmd)
+	require.NoError(t, err)
+	paths, err := configPaths(cfg)
+	require.NoError(t, err)
+	snapshot := statusSnapshot(paths)
+
+	// Without a snapshot, the database is asked and the snapshot written
+	var out bytes.Buffer
+	require.NoError(t, printMinimalStatus(cmd, &out, ""))
+	assert.Empty(t, out.String(), "nothing is printed when idle")
+	_, err = snapshot.Read()
+	require.NoError(t, err)
+
+	require.NoError(t, snapshot.Write(&session.Session{
+		ID:        "s1",
+		PlanID:    "rust",
+		ChunkID:   "chunk-003",
+		StartTime: time.Now().Add(-45 * time.Minute),
+	}))
+	out.Reset()
+	require.NoError(t, printMinimalStatus(cmd, &out, "{plan}/{chunk} {elapsed}"))
+	assert.Equal(t, "rust/chunk-003 45m\n", out.String())
+}
+
+func TestPrintMinimalStatus_SkipsDatabase(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	cmd := statusCmd()
+
+	cfg, err := getConfig(cmd)
+	require.NoError(t, err)
+	paths, err := configPaths(cfg)
+	require.NoError(t, err)
+	require.NoError(t, statusSnapshot(paths).Write(nil))
+
+	var out bytes.Buffer
+	require.NoError(t, printMinimalStatus(cmd, &out, ""))
+	assert.Empty(t, out.String())
+	assert.NoFileExists(t, paths.DatabasePath, "a snapshot answers without opening the database")
+}
@@ -19,9 +19,11 @@ import (
 // stopCmd creates the `samedi stop` command for stopping an active session.
 func stopCmd() *cobra.Command {
 	var (
-		notes     string
-		artifacts []string
-		auto      bool
+		notes      string
+		artifacts  []string
+		chunkID    string
+		auto       bool
+		reflection session.Reflection
 	)
 
 	cmd := &cobra.Command{
@@ -36,37 +38,71 @@ Examples:
   samedi stop
   samedi stop --note "Completed chapter 3"
   samedi stop --note "Built API server" --artifact "github.com/user/rust-api"
-  samedi stop --artifact "file.md" --artifact "notes.txt"`,
+  samedi stop --artifact "file.md" --artifact "notes.txt"
+  samedi stop --chunk chunk-005      # the session actually covered chunk-005
+  samedi stop --learned "Pin" --next "Write an executor"
+
+Disable individual prompts with learning.prompt_stop_notes and
+learning.prompt_artifacts. With learning.prompt_reflection on, stop also
+asks what you learned, what blocked you, and what the next step is; the
+answers show in 'plan show' and in reports. --learned, --blocked, and
+--next answer them without the prompt.
+
+Starting a session on a not-started chunk marks it in-progress. Once the
+time logged on a chunk reaches its planned duration, stop asks whether to
+mark it completed (with --auto, or off a terminal, it is marked without
+asking). Turn both off with learning.auto_advance_chunks = false.`,
 		Args: cobra.NoArgs,
-		Run: func(cmd *cobra.Command, _ []string) {
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := getConfig(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+
 			noteFlagSet := cmd.Flags().Changed("note")
 			artifactFlagSet := cmd.Flags().Changed("artifact")
 			if err := executeStop(cmd, stopOptions{
-				notes:           &notes,
-				noteFlagSet:     noteFlagSet,
-				artifacts:       &artifacts,
-				artifactFlagSet: artifactFlagSet,
-				noPrompt:        auto,
+				notes:              &notes,
+				noteFlagSet:        noteFlagSet,
+				artifacts:          &artifacts,
+				artifactFlagSet:    artifactFlagSet,
+				reflection:         &reflection,
+				chunkID:            chunkID,
+				noPrompt:           auto,
+				skipNotePrompt:     !cfg.Learning.PromptStopNotes,
+				skipArtifactPrompt: !cfg.Learning.PromptArtifacts,
+				promptReflection:   cfg.Learning.PromptReflection,
 			}); err != nil {
-				exitWithError("%v", err)
+				return err
 			}
+
+			return nil
 		},
 	}
 
 	// Flags
 	cmd.Flags().StringVar(&notes, "note", "", "session notes")
 	cmd.Flags().StringArrayVar(&artifacts, "artifact", []string{}, "learning artifacts (URLs or file paths)")
+	cmd.Flags().StringVar(&chunkID, "chunk", "", "reassign the session to this chunk")
 	cmd.Flags().BoolVar(&auto, "auto", false, "skip interactive prompts and use defaults")
+	cmd.Flags().StringVar(&reflection.Learned, "learned", "", "reflection: what you learned")
+	cmd.Flags().StringVar(&reflection.Blocked, "blocked", "", "reflection: what blocked you")
+	cmd.Flags().StringVar(&reflection.NextStep, "next", "", "reflection: the next step")
 
 	return cmd
 }
 
 type stopOptions struct {
-	notes           *string
-	noteFlagSet     bool
-	artifacts       *[]string
-	artifactFlagSet bool
-	noPrompt        bool
+	notes              *string
+	noteFlagSet        bool
+	artifacts          *[]string
+	artifactFlagSet    bool
+	reflection         *session.Reflection // answers from flags, filled in by the prompt
+	chunkID            string              // reassigns the session's chunk when set
+	noPrompt           bool
+	skipNotePrompt     bool // learning.prompt_stop_notes = false
+	skipArtifactPrompt bool // learning.prompt_artifacts = false
+	promptReflection   bool // learning.prompt_reflection = true
 }
 
 func executeStop(cmd *cobra.Command, opts stopOptions) error {
@@ -75,6 +111,12 @@ func executeStop(cmd *cobra.Command, opts stopOptions) error {
 		return err
 	}
 
+	// Store local paths as absolute paths so they can be opened later
+	artifacts, err = session.ResolveArtifacts(artifacts)
+	if err != nil {
+		return err
+	}
+
 	// Initialize session service
 	svc, err := getSessionService(cmd)
 	if err != nil {
@@ -83,8 +125,13 @@ func executeStop(cmd *cobra.Command, opts stopOptions) error {
 
 	// Prepare stop request
 	req := session.StopRequest{
-		Notes:     note,
-		Artifacts: artifacts,
+		Notes:      note,
+		Artifacts:  artifacts,
+		ChunkID:    opts.chunkID,
+		Reflection: opts.reflection,
+	}
+	if isInteractive(opts.noPrompt) {
+		req.ConfirmComplete = confirmChunkComplete(bufio.NewReader(os.Stdin), os.Stdout)
 	}
 
 	// Stop session
@@ -93,7 +140,13 @@ func executeStop(cmd *cobra.Command, opts stopOptions) error {
 		return fmt.Errorf("failed to stop session: %w", err)
 	}
 
-	// Display session summary
+	reportStopped(cmd, sess)
+	return nil
+}
+
+// reportStopped prints a stopped session's summary and next steps, and
+// commits and mirrors the data directory if configured to.
+func reportStopped(cmd *cobra.Command, sess *session.Session) {
 	fmt.Printf("✓ Session completed: %s", sess.PlanID)
 	if sess.ChunkID != "" {
 		fmt.Printf(" (%s)", sess.ChunkID)
@@ -114,13 +167,15 @@ func executeStop(cmd *cobra.Command, opts stopOptions) error {
 			fmt.Printf("    - %s\n", artifact)
 		}
 	}
+	writeReflection(os.Stdout, "  ", sess.Reflection)
+
+	autoCommit(cmd, sessionCommitMessage(sess))
+	autoMirror(cmd)
 
 	// Show next steps
 	fmt.Println("\nNext steps:")
 	fmt.Printf("  View history:  samedi plan show %s --sessions\n", sess.PlanID)
 	fmt.Printf("  Start new:     samedi start %s\n", sess.PlanID)
-
-	return nil
 }
 
 func collectStopInputs(opts stopOptions) (string, []string, error) {
@@ -141,7 +196,7 @@ func collectStopInputs(opts stopOptions) (string, []string, error) {
 	reader := bufio.NewReader(os.Stdin)
 	writer := os.Stdout
 
-	if !opts.noteFlagSet && note == "" {
+	if !opts.noteFlagSet && note == "" && !opts.skipNotePrompt {
 		entered, err := promptForStopNote(reader, writer)
 		if err != nil {
 			return "", nil, fmt.Errorf("failed to read notes: %w", err)
@@ -149,7 +204,7 @@ func collectStopInputs(opts stopOptions) (string, []string, error) {
 		note = entered
 	}
 
-	if !opts.artifactFlagSet {
+	if !opts.artifactFlagSet && !opts.skipArtifactPrompt {
 		added, err := promptForArtifacts(reader, writer)
 		if err != nil {
 			return "", nil, fmt.Errorf("failed to capture artifacts: %w", err)
@@ -157,6 +212,12 @@ func collectStopInputs(opts stopOptions) (string, []string, error) {
 		artifacts = append(artifacts, added...)
 	}
 
+	if opts.promptReflection && opts.reflection != nil && opts.reflection.IsEmpty() {
+		if err := promptForReflection(reader, writer, opts.reflection); err != nil {
+			return "", nil, fmt.Errorf("failed to read reflection: %w", err)
+		}
+	}
+
 	if opts.notes != nil {
 		*opts.notes = note
 	}
@@ -199,3 +260,68 @@ func promptForArtifacts(reader *bufio.Reader, writer io.Writer) ([]string, error
 		}
 	}
 }
+
+// confirmChunkComplete returns the question stop asks before marking a
+// chunk completed. A failed read leaves the chunk as it is.
+func confirmChunkComplete(reader *bufio.Reader, writer io.Writer) func(string, int, int) bool {
+	return func(chunkID string, logged, planned int) bool {
+		question := fmt.Sprintf("%s has %s logged of %s planned. Mark it completed?",
+			chunkID, formatDuration(logged), formatDuration(planned))
+		confirmed, err := promptYesNo(reader, writer, question, true)
+		return err == nil && confirmed
+	}
+}
+
+// reflectionQuestions are asked in order at stop, each filling one answer.
+var reflectionQuestions = []struct {
+	prompt string
+	answer func(*session.Reflection) *string
+}{
+	{"What did you learn? ", func(r *session.Reflection) *string { return &r.Learned }},
+	{"What blocked you? ", func(r *session.Reflection) *string { return &r.Blocked }},
+	{"Next step? ", func(r *session.Reflection) *string { return &r.NextStep }},
+}
+
+// promptForReflection asks the reflection questions, storing the answers
+// in reflection. Blank answers are skipped; EOF ends the questions early.
+func promptForReflection(reader *bufio.Reader, writer io.Writer, reflection *session.Reflection) error {
+	fmt.Fprintln(writer, "Reflection (optional, press Enter to skip):")
+	for _, q := range reflectionQuestions {
+		fmt.Fprint(writer, "  "+q.prompt)
+		line, err := reader.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		*q.answer(reflection) = strings.TrimSpace(line)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// writeReflection prints a session's reflection answers, one per line
+// after indent. Nothing is printed without answers.
+func writeReflection(w io.Writer, indent string, reflection *session.Reflection) {
+	if reflection.IsEmpty() {
+		return
+	}
+	for _, line := range []struct{ label, value string }{
+		{"Learned", reflection.Learned},
+		{"Blocked", reflection.Blocked},
+		{"Next step", reflection.NextStep},
+	} {
+		if line.value != "" {
+			fmt.Fprintf(w, "%s%s: %s\n", indent, line.label, line.value)
+		}
+	}
+}
+
+// sessionCommitMessage describes a finished session for sync auto-commits.
+func sessionCommitMessage(sess *session.Session) string {
+	target := sess.PlanID
+	if sess.ChunkID != "" {
+		target += "/" + sess.ChunkID
+	}
+	return fmt.Sprintf("samedi: session logged: %s (%s)", target, sess.ElapsedTime())
+}
@@ -4,8 +4,10 @@
 package cli
 
 import (
+	"bytes"
 	"testing"
 
+	"github.com/pezware/samedi.dev/internal/session"
 	"github.com/pezware/samedi.dev/internal/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -144,6 +146,39 @@ func TestPromptForArtifacts_MultipleArtifacts(t *testing.T) {
 	}
 }
 
+func TestPromptForReflection(t *testing.T) {
+	reader := testutil.MockReader("Pin keeps futures in place\n\nWrite an executor\n")
+	writer := testutil.MockWriter()
+
+	var reflection session.Reflection
+	require.NoError(t, promptForReflection(reader, writer, &reflection))
+	assert.Equal(t, session.Reflection{Learned: "Pin keeps futures in place", NextStep: "Write an executor"}, reflection)
+	assert.Contains(t, writer.String(), "What blocked you?")
+
+	reflection = session.Reflection{}
+	require.NoError(t, promptForReflection(testutil.MockReader("Pin"), testutil.MockWriter(), &reflection))
+	assert.Equal(t, session.Reflection{Learned: "Pin"}, reflection, "EOF ends the questions")
+}
+
+func TestConfirmChunkComplete(t *testing.T) {
+	writer := testutil.MockWriter()
+	confirm := confirmChunkComplete(testutil.MockReader("n\n\n"), writer)
+
+	assert.False(t, confirm("chunk-003", 75, 60))
+	assert.Contains(t, writer.String(), "chunk-003 has 1.2h logged of 1h planned. Mark it completed? [Y/n]")
+	assert.True(t, confirm("chunk-003", 90, 60), "blank answer completes it")
+}
+
+func TestWriteReflection(t *testing.T) {
+	var buf bytes.Buffer
+	writeReflection(&buf, "  ", nil)
+	writeReflection(&buf, "  ", &session.Reflection{})
+	assert.Empty(t, buf.String())
+
+	writeReflection(&buf, "  ", &session.Reflection{Learned: "Pin", Blocked: "lifetimes"})
+	assert.Equal(t, "  Learned: Pin\n  Blocked: lifetimes\n", buf.String())
+}
+
 func TestStopCmd_FlagsDefaultValues(t *testing.T) {
 	cmd := stopCmd()
 
@@ -159,4 +194,10 @@ func TestStopCmd_FlagsDefaultValues(t *testing.T) {
 	auto := cmd.Flags().Lookup("auto")
 	require.NotNil(t, auto)
 	assert.Equal(t, "false", auto.DefValue)
+
+	for _, name := range []string{"learned", "blocked", "next"} {
+		flag := cmd.Flags().Lookup(name)
+		require.NotNil(t, flag, name)
+		assert.Equal(t, "", flag.DefValue)
+	}
 }
@@ -0,0 +1,308 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/pezware/samedi.dev/internal/jobs"
+	"github.com/pezware/samedi.dev/internal/sync"
+	"github.com/spf13/cobra"
+)
+
+// syncCmd creates the `samedi sync` command.
+func syncCmd() *cobra.Command {
+	var (
+		message string
+		noPush  bool
+		noPull  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Sync plans with a git remote",
+		Long: `Version your learning data with git and sync it across machines.
+
+'samedi sync' commits changes in ~/.samedi (plans, cards, templates)
+with a message describing what happened (plan created, chunk completed,
+...), pulls from the remote, rebuilds the SQLite index for pulled plans,
+and pushes.
+
+The SQLite database and config.toml stay local. If a pull produces merge
+conflicts, resolve them in the listed files and run 'samedi sync' again.
+
+Examples:
+  samedi sync init --remote git@github.com:me/samedi-data.git
+  samedi sync                        # Commit, pull, reindex, push
+  samedi sync -m "weekly review"     # Custom commit message
+  samedi sync --no-push              # Commit and pull only
+  samedi sync status                 # Show pending changes
+
+Enable automatic commits after 'init' and 'stop':
+  samedi config set sync.auto_commit true`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runSync(cmd, message, !noPull, !noPush)
+		},
+	}
+
+	cmd.Flags().StringVarP(&message, "message", "m", "", "commit message (default: generated from changes)")
+	cmd.Flags().BoolVar(&noPush, "no-push", false, "do not push to the remote")
+	cmd.Flags().BoolVar(&noPull, "no-pull", false, "do not pull from the remote")
+
+	cmd.AddCommand(mutating(keepsData(syncInitCmd())))
+	cmd.AddCommand(syncStatusCmd())
+
+	return cmd
+}
+
+// syncInitCmd creates the `samedi sync init` subcommand.
+func syncInitCmd() *cobra.Command {
+	var remote string
+
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Initialize a git repository in the data directory",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			repo, err := getSyncRepo()
+			if err != nil {
+				return err
+			}
+
+			if remote == "" {
+				if cfg, err := getConfig(cmd); err == nil {
+					remote = cfg.Sync.GitRemote
+				}
+			}
+
+			if err := repo.Init(context.Background(), remote); err != nil {
+				return fmt.Errorf("failed to initialize sync: %w", err)
+			}
+
+			fmt.Printf("✓ Sync repository ready at %s\n", repo.Dir())
+			if remote != "" {
+				fmt.Printf("✓ Remote: %s\n", remote)
+				fmt.Printf("\nNext: samedi sync\n")
+			} else {
+				fmt.Printf("\nAdd a remote: samedi sync init --remote <url>\n")
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&remote, "remote", "", "git remote URL (default: sync.git_remote from config)")
+
+	return cmd
+}
+
+// syncStatusCmd creates the `samedi sync status` subcommand.
+func syncStatusCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Show sync remote and uncommitted changes",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			repo, err := getSyncRepo()
+			if err != nil {
+				return err
+			}
+			if !repo.IsInitialized() {
+				fmt.Println("Sync is not set up.")
+				fmt.Println("\nInitialize: samedi sync init --remote <url>")
+				return nil
+			}
+
+			ctx := context.Background()
+			remote, err := repo.Remote(ctx)
+			if err != nil {
+				return err
+			}
+			if remote == "" {
+				remote = "(none)"
+			}
+			fmt.Printf("Repository: %s\n", repo.Dir())
+			fmt.Printf("Remote:     %s\n", remote)
+
+			conflicts, err := repo.Conflicts(ctx)
+			if err != nil {
+				return err
+			}
+			if len(conflicts) > 0 {
+				printSyncConflicts(conflicts)
+				return nil
+			}
+
+			changes, err := repo.Status(ctx)
+			if err != nil {
+				return err
+			}
+			if len(changes) == 0 {
+				fmt.Println("\n✓ No uncommitted changes")
+				return nil
+			}
+
+			fmt.Printf("\nUncommitted changes:\n")
+			for _, change := range changes {
+				fmt.Printf("  %-9s %s\n", change.Kind, change.Path)
+			}
+
+			return nil
+		},
+	}
+}
+
+// runSync commits local changes, then pulls, reindexes, and pushes.
+func runSync(cmd *cobra.Command, message string, pull, push bool) error {
+	repo, err := getSyncRepo()
+	if err != nil {
+		return err
+	}
+	if !repo.IsInitialized() {
+		return fmt.Errorf("sync is not set up (run 'samedi sync init --remote <url>')")
+	}
+
+	ctx := context.Background()
+
+	// Finish a merge left over from a previous conflicted pull
+	if repo.IsMerging(ctx) {
+		if err := repo.FinishMerge(ctx); err != nil {
+			return reportSyncConflict(err)
+		}
+		fmt.Println("✓ Merge completed")
+	}
+
+	committed, err := repo.Commit(ctx, message)
+	if err != nil {
+		return err
+	}
+	if committed {
+		fmt.Println("✓ Committed local changes")
+	} else {
+		fmt.Println("✓ No local changes")
+	}
+
+	remote, err := repo.Remote(ctx)
+	if err != nil {
+		return err
+	}
+	if remote == "" {
+		fmt.Println("\nNo remote configured; skipping pull and push.")
+		fmt.Println("Add one: samedi sync init --remote <url>")
+		return nil
+	}
+
+	if pull {
+		result, err := repo.Pull(ctx)
+		if err != nil {
+			return reportSyncConflict(err)
+		}
+		if result.Updated {
+			fmt.Printf("✓ Pulled %d changed file(s)\n", len(result.ChangedFiles))
+			if err := reindexPulledPlans(cmd, result.ChangedFiles); err != nil {
+				return err
+			}
+		} else {
+			fmt.Println("✓ Already up to date")
+		}
+	}
+
+	if push {
+		if err := repo.Push(ctx); err != nil {
+			return err
+		}
+		fmt.Printf("✓ Pushed to %s\n", remote)
+	}
+
+	return nil
+}
+
+// reindexPulledPlans rebuilds SQLite metadata for plan files changed by a pull.
+// Plans that no longer parse (e.g., leftover conflict markers) are reported
+// so the index never silently diverges from markdown.
+func reindexPulledPlans(cmd *cobra.Command, files []string) error {
+	ids := make([]string, 0)
+	for _, file := range files {
+		if id := sync.PlanID(file); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	svc, err := getPlanService(cmd, "")
+	if err != nil {
+		return fmt.Errorf("failed to initialize plan service: %w", err)
+	}
+
+	failed := 0
+	for _, id := range ids {
+		if err := svc.RefreshIndex(context.Background(), id); err != nil {
+			fmt.Fprintf(os.Stderr, "  ✗ %s: %v\n", id, err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d pulled plan(s) could not be indexed; fix the markdown and run 'samedi sync' again", failed)
+	}
+
+	fmt.Printf("✓ Reindexed %d plan(s)\n", len(ids))
+	return nil
+}
+
+// reportSyncConflict prints conflict details and returns an error for the caller.
+func reportSyncConflict(err error) error {
+	var conflictErr *sync.ConflictError
+	if errors.As(err, &conflictErr) {
+		printSyncConflicts(conflictErr.Files)
+		return fmt.Errorf("sync stopped due to merge conflicts")
+	}
+	return err
+}
+
+// printSyncConflicts lists conflicted files with resolution steps.
+func printSyncConflicts(files []string) {
+	fmt.Printf("\n⚠ Merge conflicts:\n")
+	for _, file := range files {
+		fmt.Printf("  %s\n", file)
+	}
+	fmt.Printf("\nResolve the conflict markers in these files, then run 'samedi sync' again.\n")
+}
+
+// autoCommit records a change in the sync repository through the job
+// queue when sync.auto_commit is enabled. Failures are reported as
+// warnings and never fail the command.
+func autoCommit(cmd *cobra.Command, message string) {
+	cfg, err := getConfig(cmd)
+	if err != nil || !cfg.Sync.AutoCommit {
+		return
+	}
+
+	repo, err := getSyncRepo()
+	if err != nil || !repo.IsInitialized() {
+		return
+	}
+
+	runJobNow(cmd, "sync auto-commit", jobs.EnqueueRequest{
+		Type:    jobTypeSyncCommit,
+		Payload: syncCommitPayload{Message: message},
+	})
+}
+
+// getSyncRepo returns the sync repository for the data directory.
+func getSyncRepo() (*sync.Repo, error) {
+	if !sync.Available() {
+		return nil, fmt.Errorf("git is not installed or not on PATH")
+	}
+
+	paths, err := getPaths()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get paths: %w", err)
+	}
+
+	return sync.NewRepo(paths.BaseDir), nil
+}
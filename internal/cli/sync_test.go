@@ -0,0 +1,54 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncCmd_Structure(t *testing.T) {
+	cmd := syncCmd()
+
+	assert.Equal(t, "sync", cmd.Use)
+	assert.NotEmpty(t, cmd.Short)
+	assert.Contains(t, cmd.Long, "sync.auto_commit")
+
+	names := make(map[string]bool)
+	for _, sub := range cmd.Commands() {
+		names[sub.Name()] = true
+	}
+	assert.True(t, names["init"], "Should have init subcommand")
+	assert.True(t, names["status"], "Should have status subcommand")
+}
+
+func TestSyncCmd_Flags(t *testing.T) {
+	cmd := syncCmd()
+
+	message := cmd.Flags().Lookup("message")
+	require.NotNil(t, message)
+	assert.Equal(t, "m", message.Shorthand)
+
+	assert.NotNil(t, cmd.Flags().Lookup("no-push"))
+	assert.NotNil(t, cmd.Flags().Lookup("no-pull"))
+
+	remote := syncInitCmd().Flags().Lookup("remote")
+	require.NotNil(t, remote)
+	assert.Equal(t, "", remote.DefValue)
+}
+
+func TestSessionCommitMessage(t *testing.T) {
+	start := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	end := start.Add(75 * time.Minute)
+
+	sess := &session.Session{PlanID: "rust", ChunkID: "chunk-002", StartTime: start, EndTime: &end, Duration: 75}
+	assert.Equal(t, "samedi: session logged: rust/chunk-002 (1h 15m)", sessionCommitMessage(sess))
+
+	sess.ChunkID = ""
+	assert.Equal(t, "samedi: session logged: rust (1h 15m)", sessionCommitMessage(sess))
+}
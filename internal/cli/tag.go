@@ -0,0 +1,163 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/tui/components"
+	"github.com/spf13/cobra"
+)
+
+// tagCmd creates the `samedi tag` command group.
+func tagCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tag",
+		Short: "Manage plan tags",
+		Long: `List, rename, merge, and delete the tags on your plans. Changes are
+written to each affected plan file.
+
+Tags match ignoring case, so 'Rust' and 'rust' are the same tag when
+filtering; merge them to tidy up the spelling.
+
+Examples:
+  samedi tag list
+  samedi tag rename golang go
+  samedi tag merge Rust rustlang rust   # Merge into the last tag
+  samedi tag delete wip
+  samedi plan list --tag rust --tag async`,
+	}
+
+	cmd.AddCommand(tagListCmd())
+	cmd.AddCommand(mutating(tagRenameCmd()))
+	cmd.AddCommand(mutating(tagMergeCmd()))
+	cmd.AddCommand(mutating(tagDeleteCmd()))
+
+	return cmd
+}
+
+// tagListCmd creates the `samedi tag list` subcommand.
+func tagListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List tags and how many plans use them",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			svc, err := getPlanService(cmd, "")
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			tags, err := svc.Tags(context.Background())
+			if err != nil {
+				return err
+			}
+
+			if jsonOutput, _ := cmd.Flags().GetBool("json"); jsonOutput {
+				return printJSON(tags)
+			}
+
+			if len(tags) == 0 {
+				fmt.Println("No tags yet. Add some with 'samedi plan edit <plan-id>'.")
+				return nil
+			}
+
+			w := components.NewTabWriter(os.Stdout, 2)
+			fmt.Fprintln(w, "TAG\tPLANS")
+			for _, tag := range tags {
+				fmt.Fprintf(w, "%s\t%d\n", tag.Tag, tag.Plans)
+			}
+			return w.Flush()
+		},
+	}
+}
+
+// tagRenameCmd creates the `samedi tag rename` subcommand.
+func tagRenameCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rename <old> <new>",
+		Short: "Rename a tag on every plan",
+		Long: `Rename a tag on every plan that carries it. If the new name is already
+in use, run 'samedi tag merge' instead.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			svc, err := getPlanService(cmd, "")
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			changed, err := svc.RenameTag(context.Background(), args[0], args[1])
+			if err != nil {
+				return err
+			}
+			return reportRetag(cmd, changed, fmt.Sprintf("Renamed %s → %s", args[0], args[1]))
+		},
+	}
+}
+
+// tagMergeCmd creates the `samedi tag merge` subcommand.
+func tagMergeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "merge <tag>... <into>",
+		Short: "Merge tags into one",
+		Long: `Replace every listed tag with the last one. Plans that end up with the
+same tag twice keep it once.
+
+Example:
+  samedi tag merge Rust rustlang rust`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			svc, err := getPlanService(cmd, "")
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			from, into := args[:len(args)-1], args[len(args)-1]
+			changed, err := svc.MergeTags(context.Background(), from, into)
+			if err != nil {
+				return err
+			}
+			return reportRetag(cmd, changed, fmt.Sprintf("Merged %s → %s", strings.Join(from, ", "), into))
+		},
+	}
+}
+
+// tagDeleteCmd creates the `samedi tag delete` subcommand.
+func tagDeleteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <tag>",
+		Short: "Remove a tag from every plan",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			svc, err := getPlanService(cmd, "")
+			if err != nil {
+				return fmt.Errorf("failed to initialize: %w", err)
+			}
+
+			changed, err := svc.DeleteTag(context.Background(), args[0])
+			if err != nil {
+				return err
+			}
+			return reportRetag(cmd, changed, "Deleted "+args[0])
+		},
+	}
+}
+
+// reportRetag prints the plans a tag change touched and runs the
+// post-write hooks.
+func reportRetag(cmd *cobra.Command, changed []string, summary string) error {
+	fmt.Printf("✓ %s (%d plan(s))\n", summary, len(changed))
+	for _, id := range changed {
+		fmt.Printf("  %s\n", id)
+	}
+
+	if len(changed) > 0 {
+		autoCommit(cmd, "samedi: tags updated: "+strings.Join(changed, ", "))
+		autoMirror(cmd)
+	}
+	return nil
+}
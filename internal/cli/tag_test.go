@@ -0,0 +1,39 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagCmd_Structure(t *testing.T) {
+	cmd := tagCmd()
+
+	mutates := make(map[string]bool)
+	for _, sub := range cmd.Commands() {
+		mutates[sub.Name()] = sub.Annotations[mutatesAnnotation] == "true"
+	}
+	assert.Equal(t, map[string]bool{"list": false, "rename": true, "merge": true, "delete": true}, mutates)
+}
+
+func TestTagMergeCmd_NeedsTarget(t *testing.T) {
+	cmd := tagMergeCmd()
+	assert.Error(t, cmd.Args(cmd, []string{"rust"}))
+	assert.NoError(t, cmd.Args(cmd, []string{"Rust", "rust"}))
+}
+
+func TestPlanListCmd_TagFlags(t *testing.T) {
+	cmd := planListCmd()
+
+	tag := cmd.Flags().Lookup("tag")
+	if assert.NotNil(t, tag) {
+		assert.Equal(t, "stringArray", tag.Value.Type())
+	}
+	mode := cmd.Flags().Lookup("tag-mode")
+	if assert.NotNil(t, mode) {
+		assert.Equal(t, "and", mode.DefValue)
+	}
+}
@@ -5,31 +5,72 @@ package cli
 
 import (
 	"fmt"
+	"os"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/plan"
 	"github.com/pezware/samedi.dev/internal/stats"
 	"github.com/pezware/samedi.dev/internal/tui"
 	"github.com/pezware/samedi.dev/internal/tui/app"
+	"github.com/pezware/samedi.dev/internal/tui/keymap"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
 	"github.com/spf13/cobra"
 )
 
 func uiCmd() *cobra.Command {
-	return &cobra.Command{
-		Use:   "ui",
-		Short: "Launch the interactive Samedi dashboard",
+	var noWatch bool
+
+	cmd := &cobra.Command{
+		Use:          "ui",
+		Short:        "Launch the interactive Samedi dashboard",
+		SilenceUsage: true,
 		Long: `Launch the Bubble Tea dashboard for Samedi.
 
 Modules:
   - Plans: browse plans, inspect chunks, create or edit plans, toggle chunk status.
+  - Sessions: time the active session, start one on a chosen chunk, pause,
+    and stop it with notes.
+  - Review: see how many flashcards are due per plan and review them.
   - Stats: review streaks, drill into plan metrics, inspect session history, export summaries.
 
 Navigation:
-  - Tab / Shift+Tab cycle modules, 1–9 jump directly, q or Ctrl+C exits.
+  - Tab / Shift+Tab cycle modules, 1–9 jump directly, q or Ctrl+C exits,
+    ? lists every key binding.
   - Plans shortcuts: Enter view plan, n new plan, space toggle chunk, e edit metadata, d delete.
-  - Stats shortcuts: p plan list, s session history, e export dialog.
+    In the tags field, → completes a known tag.
+  - Sessions shortcuts: s start (pick a plan, then a chunk), space pause/resume,
+    x stop and enter notes.
+  - Review shortcuts: Enter start reviewing the highlighted plan, space show
+    the answer, 1-4 grade (again, hard, good, easy), Esc end the review.
+  - Stats shortcuts: p plan list, s session history, e export dialog, t filter the plan list by tag.
+
+Quick actions work from any module (tui.quick_actions):
+  - F2 start the next chunk of tui.pinned_plan (default: last studied plan)
+  - F3 stop the active session, asking for notes first
+  - F4 show the active session
+Rebind with e.g. 'samedi config set tui.quick_actions f5=start-next,f6=stop-note'.
+
+Remap navigation, module switching, and module keys under [tui.keys] in
+config.toml, e.g. 'samedi config set tui.keys "up=w up,down=s down"'.
+
+Plan files edited in another editor while the dashboard is open are
+reindexed and reloaded automatically. Use --no-watch to disable this.
+
+With --read-only (or storage.read_only) the dashboard is browse-only: plan
+edits, chunk status changes, session controls, flashcard reviews, and the
+session quick actions are disabled, and external edits are not reindexed.
 
 Tip: open the stats module on its own with 'samedi stats --tui'.`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := getConfig(cmd)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+
+			readOnly := readOnlyMode(cmd)
+
 			planService, err := getPlanService(cmd, "")
 			if err != nil {
 				return fmt.Errorf("failed to initialize plan service: %w", err)
@@ -40,24 +81,151 @@ Tip: open the stats module on its own with 'samedi stats --tui'.`,
 				return fmt.Errorf("failed to initialize session service: %w", err)
 			}
 
+			scheduler, cardRepo, err := getCardScheduler()
+			if err != nil {
+				return fmt.Errorf("failed to initialize flashcards: %w", err)
+			}
+
 			statsService := stats.NewService(planService, sessionService)
+			statsService.SetReviewSource(cardRepo)
+			statsService.SetStreakRules(streakRules(cfg))
+			statsService.SetLocation(cfg.User.Location())
+
+			planModule := tui.NewPlanModule(planService)
+			planModule.SetReadOnly(readOnly)
+			planModule.SetPlanSort(planSortSetting(cfg, readOnly))
+
+			sessionsModule := tui.NewSessionsModule(sessionService, planService)
+			sessionsModule.SetReadOnly(readOnly)
+			sessionsModule.SetChunkAlert(chunkAlerter(cmd, cfg))
+
+			reviewModule := tui.NewReviewModule(scheduler)
+			reviewModule.SetReadOnly(readOnly)
+
+			statsModule := tui.NewStatsModule(statsService, sessionService, stats.NewTimeRangeAll())
+			statsModule.SetReadOnly(readOnly)
+			statsModule.SetReportWriter(tuiReportWriter(cfg, statsService, stats.NewTimeRangeAll()))
+			statsModule.SetPlanSort(planSortSetting(cfg, readOnly))
 
 			modules := []app.Module{
-				tui.NewPlanModule(planService),
-				tui.NewStatsModule(statsService, sessionService, stats.NewTimeRangeAll()),
+				planModule,
+				sessionsModule,
+				reviewModule,
+				statsModule,
 			}
 
 			shell, err := app.New(modules)
 			if err != nil {
 				return fmt.Errorf("failed to initialize TUI: %w", err)
 			}
+			keys, err := loadKeymap(cfg)
+			if err != nil {
+				return err
+			}
+			shell.SetKeymap(keys)
+			shell.SetAutoRefresh(time.Duration(cfg.TUI.RefreshSeconds) * time.Second)
+			setCrashReports(shell, cfg)
+			if err := applyTheme(cfg); err != nil {
+				return err
+			}
+
+			bindings := cfg.TUI.QuickActions
+			if readOnly {
+				bindings = tui.ReadOnlyQuickActions(bindings)
+			}
+			quickActions, err := tui.NewQuickActions(bindings, cfg.TUI.PinnedPlan, planService, sessionService)
+			if err != nil {
+				return fmt.Errorf("failed to set up quick actions: %w", err)
+			}
+			shell.SetQuickActions(quickActions)
 
 			program := tea.NewProgram(shell)
-			if _, err := program.Run(); err != nil {
-				return fmt.Errorf("failed to run TUI: %w", err)
+
+			// The watcher reindexes plans into SQLite, so it is off in read-only mode
+			if !noWatch && !readOnly {
+				paths, err := configPaths(cfg)
+				if err != nil {
+					return fmt.Errorf("failed to get paths: %w", err)
+				}
+				watcher := tui.NewPlanWatcher(planService, paths.PlansDir, program.Send)
+				if err := watcher.Start(); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: %v; external plan edits will not be picked up\n", err)
+				} else {
+					defer watcher.Close()
+				}
 			}
 
-			return nil
+			return runShell(program, shell)
 		},
 	}
+
+	cmd.Flags().BoolVar(&noWatch, "no-watch", false, "do not reload plans edited outside the dashboard")
+
+	return cmd
+}
+
+// planSortSetting returns the plan list sort in cfg, and how the plan
+// lists save a new one as tui.plan_sort. Nothing is saved in read-only
+// mode.
+func planSortSetting(cfg *config.Config, readOnly bool) (plan.ListSort, tui.SortSaver) {
+	// Load validated tui.plan_sort already
+	current, _ := plan.ParseListSort(cfg.TUI.PlanSort)
+	if readOnly {
+		return current, nil
+	}
+	return current, func(s plan.ListSort) error {
+		saved, err := config.LoadUnchecked()
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+		saved.TUI.PlanSort = s.String()
+		return config.Save(saved)
+	}
+}
+
+// setCrashReports has shell save crash reports in the crash directory.
+func setCrashReports(shell *app.App, cfg *config.Config) {
+	paths, err := rootPaths(cfg)
+	if err != nil {
+		return
+	}
+	shell.SetCrashReports(paths.CrashDir(), fmt.Sprintf("samedi %s (commit %s, built %s)", Version, Commit, BuildDate))
+}
+
+// runShell runs a dashboard until it exits. A crash the shell recovered
+// from is an error naming the crash report, printed once Bubble Tea has
+// restored the terminal.
+func runShell(program *tea.Program, shell *app.App) error {
+	_, err := program.Run()
+	if crash := shell.Crash(); crash != nil {
+		if crash.Path == "" {
+			fmt.Fprintf(os.Stderr, "%s\n%s", crash.Panic, crash.Stack)
+			return fmt.Errorf("the dashboard crashed (%v) and the crash report could not be saved: %w", crash.Panic, crash.Err)
+		}
+		return fmt.Errorf("the dashboard crashed (%v); crash report saved to %s, please attach it to a bug report", crash.Panic, crash.Path)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to run TUI: %w", err)
+	}
+	return nil
+}
+
+// loadKeymap builds the dashboard's key bindings from [tui.keys].
+func loadKeymap(cfg *config.Config) (*keymap.Keymap, error) {
+	keys, err := keymap.New(cfg.TUI.Keys)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tui.keys: %w", err)
+	}
+	return keys, nil
+}
+
+// applyTheme makes tui.theme the active theme, detecting the terminal
+// background when it is "auto".
+func applyTheme(cfg *config.Config) error {
+	theme, err := styles.Load(cfg.TUI.Theme, cfg.TUI.Themes)
+	if err != nil {
+		return fmt.Errorf("%w: invalid tui.theme: %w", config.ErrInvalid, err)
+	}
+	styles.Use(theme)
+	return nil
 }
@@ -6,6 +6,7 @@ package cli
 import (
 	"testing"
 
+	"github.com/pezware/samedi.dev/internal/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -93,3 +94,11 @@ func TestUICmd_TipSection(t *testing.T) {
 	assert.Contains(t, cmd.Long, "stats --tui", "Should mention stats --tui command")
 	assert.Contains(t, cmd.Long, "Tip", "Should have a tip section")
 }
+
+func TestApplyTheme_InvalidThemeIsConfigError(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.TUI.Theme = "nope"
+	err := applyTheme(cfg)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, config.ErrInvalid)
+}
@@ -6,7 +6,10 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
+
+	"github.com/pezware/samedi.dev/internal/events"
 )
 
 // Config holds all user configuration for samedi.
@@ -17,6 +20,13 @@ type Config struct {
 	Sync     SyncConfig     `mapstructure:"sync"`
 	TUI      TUIConfig      `mapstructure:"tui"`
 	Learning LearningConfig `mapstructure:"learning"`
+	Export   ExportConfig   `mapstructure:"export"`
+	Obsidian ObsidianConfig `mapstructure:"obsidian"`
+	Reports  ReportsConfig  `mapstructure:"reports"`
+	Events   EventsConfig   `mapstructure:"events"`
+	Hooks    HooksConfig    `mapstructure:"hooks"`
+	Status   StatusConfig   `mapstructure:"status"`
+	Log      LogConfig      `mapstructure:"log"`
 }
 
 // UserConfig holds user identity and preferences.
@@ -24,6 +34,7 @@ type UserConfig struct {
 	Email    string `mapstructure:"email"`
 	Username string `mapstructure:"username"`
 	Timezone string `mapstructure:"timezone"`
+	Editor   string `mapstructure:"editor"` // Command for plan and config edits (empty: $EDITOR, then vi)
 }
 
 // LLMConfig holds LLM provider configuration.
@@ -32,14 +43,39 @@ type LLMConfig struct {
 	CLICommand     string `mapstructure:"cli_command"`
 	DefaultModel   string `mapstructure:"default_model"`
 	TimeoutSeconds int    `mapstructure:"timeout_seconds"`
+	MaxRetries     int    `mapstructure:"max_retries"`     // Retries on transient API failures (HTTP providers)
+	BaseURL        string `mapstructure:"base_url"`        // API endpoint override for anthropic, openai, ollama
+	APIKeyEnv      string `mapstructure:"api_key_env"`     // Env var holding the API key (default per provider)
+	MaxTokens      int    `mapstructure:"max_tokens"`      // Response length cap for HTTP providers (0 = provider default)
+	RepairAttempts int    `mapstructure:"repair_attempts"` // Times a generated plan that fails to parse is sent back to be fixed
+}
+
+// DefaultAPIKeyEnv returns the environment variable read for a provider's
+// API key when api_key_env is not set, or "" if the provider needs none.
+func DefaultAPIKeyEnv(provider string) string {
+	switch provider {
+	case "anthropic":
+		return "ANTHROPIC_API_KEY"
+	case "openai":
+		return "OPENAI_API_KEY"
+	default:
+		return ""
+	}
 }
 
 // StorageConfig holds storage paths and backup settings.
 type StorageConfig struct {
-	DataDir        string `mapstructure:"data_dir"`
-	BackupEnabled  bool   `mapstructure:"backup_enabled"`
-	BackupDir      string `mapstructure:"backup_dir"`
-	AutoBackupDays int    `mapstructure:"auto_backup_days"`
+	DataDir           string `mapstructure:"data_dir"`
+	BackupEnabled     bool   `mapstructure:"backup_enabled"`
+	BackupDir         string `mapstructure:"backup_dir"`
+	AutoBackupDays    int    `mapstructure:"auto_backup_days"`    // Back up the database once the newest backup is this old (0 disables)
+	BackupKeep        int    `mapstructure:"backup_keep"`         // Automatic database backups to keep (0 keeps all)
+	AutoVacuumPercent int    `mapstructure:"auto_vacuum_percent"` // Vacuum after bulk changes at this % free space (0 disables)
+	ReadOnly          bool   `mapstructure:"read_only"`           // Refuse session writes, plan edits, and LLM calls (demos, kiosks)
+	ArchivePlanFiles  bool   `mapstructure:"archive_plan_files"`  // Move archived plan files into plans/archive
+	Profile           string `mapstructure:"profile"`             // Active profile under data_dir/profiles (empty: data_dir itself)
+	Encrypt           bool   `mapstructure:"encrypt"`             // Encrypt plan files and session notes at rest
+	PassphraseEnv     string `mapstructure:"passphrase_env"`      // Environment variable holding the encryption passphrase
 }
 
 // SyncConfig holds cloud sync settings (Phase 2).
@@ -47,24 +83,209 @@ type SyncConfig struct {
 	Enabled             bool   `mapstructure:"enabled"`
 	CloudflareEndpoint  string `mapstructure:"cloudflare_endpoint"`
 	SyncIntervalMinutes int    `mapstructure:"sync_interval_minutes"`
+	GitRemote           string `mapstructure:"git_remote"`  // Remote URL for `samedi sync`
+	AutoCommit          bool   `mapstructure:"auto_commit"` // Commit the data directory after plan and session changes
 }
 
 // TUIConfig holds TUI theme and display preferences.
 type TUIConfig struct {
-	Theme          string `mapstructure:"theme"`
-	DateFormat     string `mapstructure:"date_format"`
-	TimeFormat     string `mapstructure:"time_format"`
-	FirstDayOfWeek string `mapstructure:"first_day_of_week"`
+	Theme          string                       `mapstructure:"theme"` // Built-in theme, "auto" to follow the terminal, or a [tui.themes] name
+	DateFormat     string                       `mapstructure:"date_format"`
+	TimeFormat     string                       `mapstructure:"time_format"`
+	FirstDayOfWeek string                       `mapstructure:"first_day_of_week"`
+	PinnedPlan     string                       `mapstructure:"pinned_plan"`     // Plan for the start-next quick action (empty: last studied)
+	RefreshSeconds int                          `mapstructure:"refresh_seconds"` // Reload the active module this often (0: only on 'r')
+	PlanSort       string                       `mapstructure:"plan_sort"`       // Plan list order, e.g. "hours:desc" (empty: newest first)
+	QuickActions   []string                     `mapstructure:"quick_actions"`   // "key=action" bindings in `samedi ui`
+	Keys           map[string][]string          `mapstructure:"keys"`            // [tui.keys] remaps: action = ["key", ...]
+	Themes         map[string]map[string]string `mapstructure:"themes"`          // [tui.themes.<name>] colors: role = "#rrggbb" or ANSI number
 }
 
 // LearningConfig holds learning session preferences.
 type LearningConfig struct {
-	DefaultChunkMinutes int    `mapstructure:"default_chunk_minutes"`
-	ReminderEnabled     bool   `mapstructure:"reminder_enabled"`
-	ReminderMessage     string `mapstructure:"reminder_message"`
-	StreakTracking      bool   `mapstructure:"streak_tracking"`
+	DefaultChunkMinutes int      `mapstructure:"default_chunk_minutes"`
+	ReminderEnabled     bool     `mapstructure:"reminder_enabled"`
+	ReminderMessage     string   `mapstructure:"reminder_message"`
+	ReminderTimes       []string `mapstructure:"reminder_times"`    // Daily check times (HH:MM) for `samedi notify daemon`
+	WeeklyGoalHours     int      `mapstructure:"weekly_goal_hours"` // 0 disables weekly goal reminders
+	StreakTracking      bool     `mapstructure:"streak_tracking"`
+	StreakMinMinutes    int      `mapstructure:"streak_min_minutes"`  // Minutes a day needs to count toward a streak; 0 counts any session
+	StreakRestDays      []string `mapstructure:"streak_rest_days"`    // Weekdays that don't break a streak, e.g. ["sunday"]
+	ChunkSelection      string   `mapstructure:"chunk_selection"`     // "ask" prompts with a suggestion, "next" picks the next open chunk
+	ChunkAlert          string   `mapstructure:"chunk_alert"`         // How a session reaching its chunk's planned time is announced
+	PromptStopNotes     bool     `mapstructure:"prompt_stop_notes"`   // Ask for notes on `samedi stop`
+	PromptArtifacts     bool     `mapstructure:"prompt_artifacts"`    // Ask for artifacts on `samedi stop`
+	PromptReflection    bool     `mapstructure:"prompt_reflection"`   // Ask reflection questions on `samedi stop`
+	AutoAdvanceChunks   bool     `mapstructure:"auto_advance_chunks"` // Mark chunks in-progress/completed from session time
+	TotalHoursSource    string   `mapstructure:"total_hours_source"`  // "chunks" recomputes total_hours on save, "plan" only flags drift
+}
+
+// ExportConfig holds output locations and filename templates for files
+// written by report, export, and backup commands. Templates accept the
+// placeholders {{date}}, {{time}}, {{type}}, {{plan}}, {{range}}, and {{ext}}.
+type ExportConfig struct {
+	Dir            string `mapstructure:"dir"`             // Default directory for reports and exports
+	ReportFilename string `mapstructure:"report_filename"` // `samedi report --save`
+	ExportFilename string `mapstructure:"export_filename"` // Plan and flashcard exports
+	BackupFilename string `mapstructure:"backup_filename"` // `samedi db backup` copies
+}
+
+// ObsidianConfig holds the Obsidian vault that plans and sessions are
+// mirrored into after every change. An empty vault path disables mirroring.
+type ObsidianConfig struct {
+	VaultPath string `mapstructure:"vault_path"` // Root of the Obsidian vault
+	Folder    string `mapstructure:"folder"`     // Folder inside the vault owned by samedi
+}
+
+// ReportsConfig schedules dated markdown reports. Once one is due, the
+// next samedi command writes it; `samedi report --auto` writes it on demand.
+type ReportsConfig struct {
+	Schedule string `mapstructure:"schedule"` // "off", "daily", "weekly", or "monthly"
+	Day      string `mapstructure:"day"`      // Weekday weekly reports fall on
+	Dir      string `mapstructure:"dir"`      // Directory scheduled reports are written to
+	Type     string `mapstructure:"type"`     // "summary" or "full"
+	Keep     int    `mapstructure:"keep"`     // Newest scheduled reports kept; older ones are pruned (0 keeps all)
+}
+
+// EventsConfig lists webhooks notified of learning events. URLs may use
+// ${VAR} to read secrets from the environment instead of this file.
+type EventsConfig struct {
+	Webhooks       []string `mapstructure:"webhooks"`        // URLs each event is posted to as JSON
+	Types          []string `mapstructure:"types"`           // Event types to send, e.g. "session.stopped"; empty sends all
+	TimeoutSeconds int      `mapstructure:"timeout_seconds"` // Per-request timeout
+}
+
+// WebhookURLs returns the webhook URLs with environment variables
+// expanded. URLs that expand to nothing are skipped.
+func (e EventsConfig) WebhookURLs() []string {
+	urls := make([]string, 0, len(e.Webhooks))
+	for _, raw := range e.Webhooks {
+		if u := strings.TrimSpace(os.ExpandEnv(raw)); u != "" {
+			urls = append(urls, u)
+		}
+	}
+	return urls
+}
+
+// HooksConfig names commands run after learning events. Each receives
+// the event as JSON on stdin; an empty command runs nothing.
+type HooksConfig struct {
+	PostSessionStart string `mapstructure:"post_session_start"` // After a session starts
+	PostSession      string `mapstructure:"post_session"`       // After a session stops
+	PostChunk        string `mapstructure:"post_chunk"`         // After a chunk is completed
+	PostPlan         string `mapstructure:"post_plan"`          // After a plan is completed
+	PostMilestone    string `mapstructure:"post_milestone"`     // After a streak milestone
+	ChunkTimeUp      string `mapstructure:"chunk_time_up"`      // When a session reaches its chunk's planned time
+	TimeoutSeconds   int    `mapstructure:"timeout_seconds"`    // Hooks still running after this are killed
+}
+
+// Commands maps each event type to its hook command, leaving out hooks
+// that aren't set.
+func (h HooksConfig) Commands() map[events.Type]string {
+	commands := make(map[events.Type]string)
+	for t, command := range map[events.Type]string{
+		events.SessionStarted:  h.PostSessionStart,
+		events.SessionStopped:  h.PostSession,
+		events.ChunkCompleted:  h.PostChunk,
+		events.PlanCompleted:   h.PostPlan,
+		events.StreakMilestone: h.PostMilestone,
+		events.ChunkTimeUp:     h.ChunkTimeUp,
+	} {
+		if strings.TrimSpace(command) != "" {
+			commands[t] = command
+		}
+	}
+	return commands
+}
+
+// StatusConfig shapes `samedi status --minimal`.
+type StatusConfig struct {
+	// Format is the status line, with placeholders such as {plan},
+	// {chunk}, and {elapsed}.
+	Format string `mapstructure:"format"`
+}
+
+// LogConfig controls the diagnostic log in the logs directory.
+type LogConfig struct {
+	Level string `mapstructure:"level"` // debug, info, warn, or error
+	Keep  int    `mapstructure:"keep"`  // Rotated log files kept besides the current one
+}
+
+// DefaultStatusFormat is the default status line, e.g. "rust chunk-003 1h05m".
+const DefaultStatusFormat = "{plan} {chunk} {elapsed}"
+
+// Report schedules.
+const (
+	ReportScheduleOff     = "off"
+	ReportScheduleDaily   = "daily"
+	ReportScheduleWeekly  = "weekly"
+	ReportScheduleMonthly = "monthly"
+)
+
+// Weekday returns the weekday weekly reports fall on, Sunday if Day is
+// not a weekday name.
+func (r ReportsConfig) Weekday() time.Weekday {
+	day, _ := parseWeekday(r.Day)
+	return day
+}
+
+// RestDays returns the configured streak rest days. Unknown names are
+// skipped; Validate rejects them.
+func (l LearningConfig) RestDays() []time.Weekday {
+	days := make([]time.Weekday, 0, len(l.StreakRestDays))
+	for _, name := range l.StreakRestDays {
+		if day, ok := parseWeekday(name); ok {
+			days = append(days, day)
+		}
+	}
+	return days
+}
+
+// Location returns the user's time zone, falling back to the local zone
+// when it is unset or unknown.
+func (u UserConfig) Location() *time.Location {
+	if u.Timezone == "" {
+		return time.Local
+	}
+	loc, err := time.LoadLocation(u.Timezone)
+	if err != nil {
+		return time.Local
+	}
+	return loc
 }
 
+// parseWeekday reads a weekday name such as "sunday", ignoring case.
+func parseWeekday(name string) (time.Weekday, bool) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(name, day.String()) {
+			return day, true
+		}
+	}
+	return time.Sunday, false
+}
+
+// Chunk selection modes for `samedi start <plan>` without a chunk ID.
+const (
+	ChunkSelectionAsk  = "ask"
+	ChunkSelectionNext = "next"
+)
+
+// Alerts for a session reaching its chunk's planned duration.
+const (
+	ChunkAlertOff    = "off"
+	ChunkAlertBell   = "bell"   // Terminal bell
+	ChunkAlertNotify = "notify" // Desktop notification, or the bell without a notification tool
+)
+
+// QuickActionNames lists the actions tui.quick_actions can bind.
+var QuickActionNames = []string{"start-next", "stop-note", "status"}
+
+// Sources of truth for a plan's total hours when it disagrees with its chunks.
+const (
+	TotalHoursSourceChunks = "chunks"
+	TotalHoursSourcePlan   = "plan"
+)
+
 // DefaultConfig returns the default configuration.
 func DefaultConfig() *Config {
 	homeDir, err := os.UserHomeDir()
@@ -77,35 +298,92 @@ func DefaultConfig() *Config {
 			Email:    "",
 			Username: os.Getenv("USER"),
 			Timezone: time.Local.String(),
+			Editor:   "",
 		},
 		LLM: LLMConfig{
 			Provider:       "auto",
 			CLICommand:     "",
 			DefaultModel:   "", // Empty allows each provider to use its own default
 			TimeoutSeconds: 300,
+			MaxRetries:     2,
+			BaseURL:        "",
+			APIKeyEnv:      "",
+			MaxTokens:      0,
+			RepairAttempts: 2,
 		},
 		Storage: StorageConfig{
-			DataDir:        filepath.Join(homeDir, ".samedi"),
-			BackupEnabled:  true,
-			BackupDir:      filepath.Join(homeDir, "samedi-backups"),
-			AutoBackupDays: 7,
+			DataDir:           filepath.Join(homeDir, ".samedi"),
+			BackupEnabled:     true,
+			BackupDir:         filepath.Join(homeDir, "samedi-backups"),
+			AutoBackupDays:    7,
+			BackupKeep:        5,
+			AutoVacuumPercent: 25,
+			Profile:           "",
+			Encrypt:           false,
+			PassphraseEnv:     "SAMEDI_PASSPHRASE",
 		},
 		Sync: SyncConfig{
 			Enabled:             false,
 			CloudflareEndpoint:  "",
 			SyncIntervalMinutes: 30,
+			GitRemote:           "",
+			AutoCommit:          false,
 		},
 		TUI: TUIConfig{
-			Theme:          "dracula",
+			Theme:          "auto",
 			DateFormat:     "2006-01-02",
 			TimeFormat:     "15:04",
 			FirstDayOfWeek: "monday",
+			PinnedPlan:     "",
+			QuickActions:   []string{"f2=start-next", "f3=stop-note", "f4=status"},
 		},
 		Learning: LearningConfig{
 			DefaultChunkMinutes: 60,
 			ReminderEnabled:     true,
 			ReminderMessage:     "What did you learn today?",
+			ReminderTimes:       []string{"20:00"},
+			WeeklyGoalHours:     0,
 			StreakTracking:      true,
+			StreakMinMinutes:    0,
+			StreakRestDays:      []string{},
+			ChunkSelection:      ChunkSelectionAsk,
+			ChunkAlert:          ChunkAlertBell,
+			PromptStopNotes:     true,
+			PromptArtifacts:     true,
+			AutoAdvanceChunks:   true,
+			TotalHoursSource:    TotalHoursSourceChunks,
+		},
+		Export: ExportConfig{
+			Dir:            filepath.Join(homeDir, "samedi-exports"),
+			ReportFilename: "{{date}}-{{plan}}-{{type}}.md",
+			ExportFilename: "{{date}}-{{plan}}-{{type}}.{{ext}}",
+			BackupFilename: "sessions-{{date}}-{{time}}.{{ext}}",
+		},
+		Obsidian: ObsidianConfig{
+			VaultPath: "",
+			Folder:    "Samedi",
+		},
+		Reports: ReportsConfig{
+			Schedule: ReportScheduleOff,
+			Day:      "sunday",
+			Dir:      filepath.Join(homeDir, "samedi-exports", "reports"),
+			Type:     "full",
+			Keep:     12,
+		},
+		Events: EventsConfig{
+			Webhooks:       []string{},
+			Types:          []string{},
+			TimeoutSeconds: 5,
+		},
+		Hooks: HooksConfig{
+			TimeoutSeconds: 10,
+		},
+		Status: StatusConfig{
+			Format: DefaultStatusFormat,
+		},
+		Log: LogConfig{
+			Level: "info",
+			Keep:  3,
 		},
 	}
 }
@@ -119,3 +397,8 @@ func Path() string {
 	}
 	return filepath.Join(homeDir, ".samedi", "config.toml")
 }
+
+// BackupPath returns where Save keeps the previous config file.
+func BackupPath() string {
+	return Path() + ".bak"
+}
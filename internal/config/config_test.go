@@ -5,7 +5,9 @@ package config
 
 import (
 	"testing"
+	"time"
 
+	"github.com/pezware/samedi.dev/internal/events"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -25,11 +27,15 @@ func TestDefaultConfig(t *testing.T) {
 	assert.True(t, cfg.Storage.BackupEnabled)
 
 	// Check TUI defaults
-	assert.Equal(t, "dracula", cfg.TUI.Theme)
+	assert.Equal(t, "auto", cfg.TUI.Theme)
 
 	// Check learning defaults
 	assert.Equal(t, 60, cfg.Learning.DefaultChunkMinutes)
 	assert.True(t, cfg.Learning.StreakTracking)
+	assert.Equal(t, ChunkSelectionAsk, cfg.Learning.ChunkSelection)
+	assert.True(t, cfg.Learning.PromptStopNotes)
+	assert.True(t, cfg.Learning.PromptArtifacts)
+	assert.True(t, cfg.Learning.AutoAdvanceChunks)
 }
 
 func TestConfig_Validate_ValidConfig(t *testing.T) {
@@ -86,6 +92,44 @@ func TestConfig_Validate_InvalidTheme(t *testing.T) {
 	assert.Contains(t, err.Error(), "invalid TUI theme")
 }
 
+func TestConfig_Validate_CustomTheme(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.TUI.Themes = map[string]map[string]string{"ocean": {"base": "light", "accent": "#5fafff"}}
+	cfg.TUI.Theme = "ocean"
+	assert.NoError(t, cfg.Validate())
+
+	cfg.TUI.Themes["ocean"]["accent"] = "sky blue"
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid accent color")
+}
+
+func TestConfig_Validate_RefreshSeconds(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, 0, cfg.TUI.RefreshSeconds)
+
+	cfg.TUI.RefreshSeconds = 30
+	assert.NoError(t, cfg.Validate())
+
+	cfg.TUI.RefreshSeconds = -1
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "refresh_seconds")
+}
+
+func TestConfig_Validate_PlanSort(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Empty(t, cfg.TUI.PlanSort)
+
+	cfg.TUI.PlanSort = "deadline:desc"
+	assert.NoError(t, cfg.Validate())
+
+	cfg.TUI.PlanSort = "size"
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "plan_sort")
+}
+
 func TestConfig_Validate_InvalidFirstDayOfWeek(t *testing.T) {
 	cfg := DefaultConfig()
 	cfg.TUI.FirstDayOfWeek = "wednesday"
@@ -95,6 +139,262 @@ func TestConfig_Validate_InvalidFirstDayOfWeek(t *testing.T) {
 	assert.Contains(t, err.Error(), "invalid first_day_of_week")
 }
 
+func TestConfig_Validate_InvalidReminderTime(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Learning.ReminderTimes = []string{"20:00", "8pm"}
+
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid reminder time")
+}
+
+func TestConfig_Validate_InvalidWeeklyGoal(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Learning.WeeklyGoalHours = -1
+
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "weekly_goal_hours")
+}
+
+func TestConfig_Validate_StreakRules(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Learning.StreakMinMinutes = 15
+	cfg.Learning.StreakRestDays = []string{"Saturday", "sunday"}
+	assert.NoError(t, cfg.Validate())
+	assert.Equal(t, []time.Weekday{time.Saturday, time.Sunday}, cfg.Learning.RestDays())
+
+	cfg.Learning.StreakRestDays = []string{"someday"}
+	assert.ErrorContains(t, cfg.Validate(), "invalid streak rest day")
+
+	cfg.Learning.StreakRestDays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+	assert.ErrorContains(t, cfg.Validate(), "every day of the week")
+
+	cfg.Learning.StreakRestDays = nil
+	cfg.Learning.StreakMinMinutes = -5
+	assert.ErrorContains(t, cfg.Validate(), "streak_min_minutes")
+}
+
+func TestConfig_Validate_Events(t *testing.T) {
+	t.Setenv("SAMEDI_TEST_HOOK", "https://hooks.example.com/secret")
+	cfg := DefaultConfig()
+	cfg.Events.Webhooks = []string{"${SAMEDI_TEST_HOOK}", "$SAMEDI_TEST_UNSET"}
+	cfg.Events.Types = []string{"session.stopped", "streak.milestone"}
+	assert.NoError(t, cfg.Validate())
+	assert.Equal(t, []string{"https://hooks.example.com/secret"}, cfg.Events.WebhookURLs(), "unset variables are skipped")
+
+	cfg.Events.Webhooks = []string{"ftp://hooks.example.com/secret"}
+	err := cfg.Validate()
+	assert.ErrorContains(t, err, "invalid events webhook")
+	assert.NotContains(t, err.Error(), "secret", "URLs may hold tokens")
+
+	cfg.Events.Webhooks = nil
+	cfg.Events.Types = []string{"session.paused"}
+	assert.ErrorContains(t, cfg.Validate(), "invalid events type")
+
+	cfg.Events.Types = nil
+	cfg.Events.TimeoutSeconds = 0
+	assert.ErrorContains(t, cfg.Validate(), "timeout_seconds")
+}
+
+func TestHooksConfig_Commands(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Empty(t, cfg.Hooks.Commands())
+	assert.Equal(t, 10, cfg.Hooks.TimeoutSeconds)
+
+	cfg.Hooks.PostSession = "~/bin/post-session.sh"
+	cfg.Hooks.PostPlan = "  "
+	cfg.Hooks.ChunkTimeUp = "~/bin/time-up.sh"
+	assert.Equal(t, map[events.Type]string{
+		events.SessionStopped: "~/bin/post-session.sh",
+		events.ChunkTimeUp:    "~/bin/time-up.sh",
+	}, cfg.Hooks.Commands())
+
+	cfg.Hooks.TimeoutSeconds = 0
+	assert.ErrorContains(t, cfg.Validate(), "hooks timeout_seconds")
+}
+
+func TestConfig_Validate_AutoVacuumPercent(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, 25, cfg.Storage.AutoVacuumPercent)
+
+	cfg.Storage.AutoVacuumPercent = 0
+	assert.NoError(t, cfg.Validate(), "zero disables auto-vacuum")
+
+	cfg.Storage.AutoVacuumPercent = 101
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "auto_vacuum_percent")
+}
+
+func TestConfig_Validate_Backups(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, 5, cfg.Storage.BackupKeep)
+
+	cfg.Storage.BackupKeep = -1
+	assert.ErrorContains(t, cfg.Validate(), "backup_keep")
+
+	cfg = DefaultConfig()
+	cfg.Storage.AutoBackupDays = -1
+	assert.ErrorContains(t, cfg.Validate(), "auto_backup_days")
+}
+
+func TestConfig_Validate_Log(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, "info", cfg.Log.Level)
+	require.NoError(t, cfg.Validate())
+
+	cfg.Log.Level = "DEBUG"
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Log.Level = "loud"
+	assert.ErrorContains(t, cfg.Validate(), "log level")
+
+	cfg = DefaultConfig()
+	cfg.Log.Keep = 0
+	assert.ErrorContains(t, cfg.Validate(), "log keep")
+}
+
+func TestConfig_Validate_ChunkSelection(t *testing.T) {
+	cfg := DefaultConfig()
+
+	cfg.Learning.ChunkSelection = ChunkSelectionNext
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Learning.ChunkSelection = "random"
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "chunk_selection")
+}
+
+func TestConfig_Validate_ChunkAlert(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, ChunkAlertBell, cfg.Learning.ChunkAlert)
+
+	cfg.Learning.ChunkAlert = ChunkAlertNotify
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Learning.ChunkAlert = "siren"
+	assert.ErrorContains(t, cfg.Validate(), "invalid chunk_alert")
+}
+
+func TestConfig_Validate_TotalHoursSource(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.Equal(t, TotalHoursSourceChunks, cfg.Learning.TotalHoursSource)
+
+	cfg.Learning.TotalHoursSource = TotalHoursSourcePlan
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Learning.TotalHoursSource = "sessions"
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "total_hours_source")
+}
+
+func TestConfig_Validate_HTTPProviders(t *testing.T) {
+	for _, provider := range []string{"anthropic", "openai", "ollama"} {
+		cfg := DefaultConfig()
+		cfg.LLM.Provider = provider
+		assert.NoError(t, cfg.Validate(), provider)
+
+		cfg.LLM.CLICommand = "claude"
+		assert.Error(t, cfg.Validate(), "%s should reject cli_command", provider)
+	}
+
+	cfg := DefaultConfig()
+	cfg.LLM.MaxRetries = 11
+	assert.Error(t, cfg.Validate())
+
+	cfg = DefaultConfig()
+	cfg.LLM.MaxTokens = -1
+	assert.Error(t, cfg.Validate())
+
+	cfg = DefaultConfig()
+	cfg.LLM.RepairAttempts = -1
+	assert.Error(t, cfg.Validate())
+
+	assert.Equal(t, "ANTHROPIC_API_KEY", DefaultAPIKeyEnv("anthropic"))
+	assert.Equal(t, "OPENAI_API_KEY", DefaultAPIKeyEnv("openai"))
+	assert.Empty(t, DefaultAPIKeyEnv("ollama"))
+}
+
+func TestConfig_Validate_ExportTemplates(t *testing.T) {
+	cfg := DefaultConfig()
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Export.ReportFilename = "{{date}}-{{plna}}.md"
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "report_filename")
+
+	cfg = DefaultConfig()
+	cfg.Export.ExportFilename = "../{{date}}.{{ext}}"
+	assert.Error(t, cfg.Validate())
+
+	cfg = DefaultConfig()
+	cfg.Export.BackupFilename = "../{{date}}.{{ext}}"
+	assert.Error(t, cfg.Validate())
+
+	cfg = DefaultConfig()
+	cfg.Export.Dir = ""
+	assert.Error(t, cfg.Validate())
+}
+
+func TestConfig_Validate_ObsidianFolder(t *testing.T) {
+	for _, folder := range []string{"Samedi", "Learning/Samedi"} {
+		cfg := DefaultConfig()
+		cfg.Obsidian.Folder = folder
+		assert.NoError(t, cfg.Validate(), folder)
+	}
+
+	for _, folder := range []string{"", "..", "../outside", "/abs/path"} {
+		cfg := DefaultConfig()
+		cfg.Obsidian.Folder = folder
+		assert.Error(t, cfg.Validate(), folder)
+	}
+}
+
+func TestConfig_Validate_QuickActions(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.TUI.QuickActions = []string{"f5=start-next", "ctrl+s = stop-note"}
+	assert.NoError(t, cfg.Validate())
+
+	for _, binding := range [][]string{
+		{"f2"},
+		{"=status"},
+		{"q=status"},
+		{"3=status"},
+		{"f2=dance"},
+		{"f2=status", "f2=start-next"},
+	} {
+		cfg := DefaultConfig()
+		cfg.TUI.QuickActions = binding
+		assert.Error(t, cfg.Validate(), binding)
+	}
+}
+
+func TestConfig_Validate_Keys(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.TUI.Keys = map[string][]string{"quit": {"ctrl+q"}, "up": {"w", "up"}}
+	cfg.TUI.QuickActions = []string{"q=status"}
+	assert.NoError(t, cfg.Validate(), "q is free once quit moves")
+
+	cfg.TUI.QuickActions = []string{"ctrl+q=status"}
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "reserved")
+
+	for _, keys := range []map[string][]string{
+		{"dance": {"x"}},
+		{"up": {}},
+		{"help": {"q"}},
+	} {
+		cfg := DefaultConfig()
+		cfg.TUI.Keys = keys
+		assert.Error(t, cfg.Validate(), keys)
+	}
+}
+
 func TestConfig_Validate_ProviderCommandMismatch(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -128,3 +428,39 @@ func TestConfig_Validate_ProviderCommandMismatch(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_Validate_Timezone(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.User.Timezone = "Europe/Paris"
+	require.NoError(t, cfg.Validate())
+
+	cfg.User.Timezone = "Mars/Olympus"
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid timezone")
+}
+
+func TestConfig_Validate_Encryption(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Storage.Encrypt = true
+	require.NoError(t, cfg.Validate())
+
+	cfg.Storage.PassphraseEnv = "hunter2 is my passphrase"
+	assert.ErrorContains(t, cfg.Validate(), "must name an environment variable")
+
+	cfg.Storage.PassphraseEnv = ""
+	assert.ErrorContains(t, cfg.Validate(), "passphrase_env is required")
+}
+
+func TestValidateProfileName(t *testing.T) {
+	for _, name := range []string{"work", "side-project", "Uni_2025"} {
+		assert.NoError(t, ValidateProfileName(name), name)
+	}
+	for _, name := range []string{"", "-work", "../work", "my work", "a/b"} {
+		assert.Error(t, ValidateProfileName(name), name)
+	}
+
+	cfg := DefaultConfig()
+	cfg.Storage.Profile = "../escape"
+	assert.ErrorContains(t, cfg.Validate(), "invalid storage profile")
+}
@@ -8,13 +8,34 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 
 	"github.com/spf13/viper"
 )
 
+// ErrInvalid is returned when the configuration fails validation.
+var ErrInvalid = errors.New("invalid configuration")
+
 // Load reads configuration from file and environment variables.
 // It returns the default config if no config file exists.
 func Load() (*Config, error) {
+	cfg, err := LoadUnchecked()
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate configuration
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalid, err)
+	}
+
+	return cfg, nil
+}
+
+// LoadUnchecked reads configuration like Load but without validating it,
+// so `samedi config set` can repair an invalid value.
+func LoadUnchecked() (*Config, error) {
 	cfg := DefaultConfig()
 
 	// Set up viper
@@ -50,11 +71,6 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
-	// Validate configuration
-	if err := cfg.Validate(); err != nil {
-		return nil, fmt.Errorf("invalid configuration: %w", err)
-	}
-
 	return cfg, nil
 }
 
@@ -62,7 +78,7 @@ func Load() (*Config, error) {
 func Save(cfg *Config) error {
 	// Validate before saving
 	if err := cfg.Validate(); err != nil {
-		return fmt.Errorf("invalid configuration: %w", err)
+		return fmt.Errorf("%w: %w", ErrInvalid, err)
 	}
 
 	// Ensure config directory exists
@@ -72,18 +88,29 @@ func Save(cfg *Config) error {
 		return fmt.Errorf("failed to create config directory: %w", err)
 	}
 
+	// Keep the previous file so a bad change can be undone by hand
+	if err := backupConfig(configPath); err != nil {
+		return err
+	}
+
 	// Set up viper for writing
 	v := viper.New()
 	v.SetConfigFile(configPath)
 	v.SetConfigType("toml")
 
-	// Set values in viper
-	v.Set("user", cfg.User)
-	v.Set("llm", cfg.LLM)
-	v.Set("storage", cfg.Storage)
-	v.Set("sync", cfg.Sync)
-	v.Set("tui", cfg.TUI)
-	v.Set("learning", cfg.Learning)
+	// Set values in viper, keyed by the same names Load reads
+	v.Set("user", sectionMap(cfg.User))
+	v.Set("llm", sectionMap(cfg.LLM))
+	v.Set("storage", sectionMap(cfg.Storage))
+	v.Set("sync", sectionMap(cfg.Sync))
+	v.Set("tui", sectionMap(cfg.TUI))
+	v.Set("learning", sectionMap(cfg.Learning))
+	v.Set("export", sectionMap(cfg.Export))
+	v.Set("obsidian", sectionMap(cfg.Obsidian))
+	v.Set("reports", sectionMap(cfg.Reports))
+	v.Set("events", sectionMap(cfg.Events))
+	v.Set("hooks", sectionMap(cfg.Hooks))
+	v.Set("status", sectionMap(cfg.Status))
 
 	// Write config file
 	if err := v.WriteConfig(); err != nil {
@@ -117,3 +144,52 @@ func InitConfig() error {
 
 	return nil
 }
+
+// backupConfig copies the config file at path to BackupPath, replacing
+// any older backup. A missing config file has nothing to back up.
+func backupConfig(path string) error {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read config for backup: %w", err)
+	}
+	if err := os.WriteFile(path+".bak", data, 0o600); err != nil {
+		return fmt.Errorf("failed to back up config: %w", err)
+	}
+	return nil
+}
+
+// Keys lists every setting as "section.key", in the order of the Config
+// struct, matching the names in config.toml.
+func Keys() []string {
+	var keys []string
+	cfg := reflect.TypeOf(Config{})
+	for i := 0; i < cfg.NumField(); i++ {
+		section := cfg.Field(i)
+		fields := section.Type
+		for j := 0; j < fields.NumField(); j++ {
+			keys = append(keys, section.Tag.Get("mapstructure")+"."+fields.Field(j).Tag.Get("mapstructure"))
+		}
+	}
+	return keys
+}
+
+// sectionMap converts a config section to a map keyed by its mapstructure
+// tags. Writing the struct directly would use Go field names
+// ("ChunkSelection"), which Load doesn't map back to "chunk_selection".
+func sectionMap(section interface{}) map[string]interface{} {
+	value := reflect.ValueOf(section)
+	fields := value.Type()
+
+	out := make(map[string]interface{}, fields.NumField())
+	for i := 0; i < fields.NumField(); i++ {
+		key := fields.Field(i).Tag.Get("mapstructure")
+		if key == "" {
+			key = strings.ToLower(fields.Field(i).Name)
+		}
+		out[key] = value.Field(i).Interface()
+	}
+	return out
+}
@@ -51,6 +51,9 @@ func TestSave_And_Load(t *testing.T) {
 	cfg := DefaultConfig()
 	cfg.LLM.Provider = "codex"
 	cfg.LLM.TimeoutSeconds = 60
+	cfg.Learning.ChunkSelection = ChunkSelectionNext
+	cfg.Export.ReportFilename = "{{type}}-{{date}}.md"
+	cfg.TUI.Keys = map[string][]string{"up": {"w", "up"}}
 
 	// Save config
 	err := Save(cfg)
@@ -64,12 +67,16 @@ func TestSave_And_Load(t *testing.T) {
 	data, err := os.ReadFile(configPath)
 	require.NoError(t, err)
 	assert.Contains(t, string(data), "codex")
-	assert.Contains(t, string(data), "TimeoutSeconds = 60")
+	assert.Contains(t, string(data), "timeout_seconds = 60")
 
-	// Note: We can't easily test Load() in this test because viper caches
-	// the home directory lookup. The Save() function correctly writes the
-	// config file, which is what we're testing here.
-	// Load() is tested separately in TestLoad_NoConfigFile.
+	// Keys use the snake_case names Load reads back
+	loaded, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, "codex", loaded.LLM.Provider)
+	assert.Equal(t, 60, loaded.LLM.TimeoutSeconds)
+	assert.Equal(t, ChunkSelectionNext, loaded.Learning.ChunkSelection)
+	assert.Equal(t, "{{type}}-{{date}}.md", loaded.Export.ReportFilename)
+	assert.Equal(t, []string{"w", "up"}, loaded.TUI.Keys["up"])
 }
 
 func TestSave_InvalidConfig(t *testing.T) {
@@ -114,3 +121,42 @@ func TestPath(t *testing.T) {
 	assert.Contains(t, path, ".samedi")
 	assert.Contains(t, path, "config.toml")
 }
+
+func TestSave_BacksUpPreviousConfig(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	cfg := DefaultConfig()
+	require.NoError(t, Save(cfg))
+	assert.NoFileExists(t, BackupPath(), "nothing to back up on first save")
+
+	cfg.LLM.Provider = "codex"
+	require.NoError(t, Save(cfg))
+
+	backup, err := os.ReadFile(BackupPath())
+	require.NoError(t, err)
+	assert.Contains(t, string(backup), "provider = 'auto'")
+	info, err := os.Stat(BackupPath())
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
+
+func TestLoadUnchecked_InvalidConfig(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	require.NoError(t, os.MkdirAll(filepath.Join(home, ".samedi"), 0o755))
+	require.NoError(t, os.WriteFile(Path(), []byte("[llm]\nprovider = \"bogus\"\n"), 0o600))
+
+	_, err := Load()
+	require.Error(t, err)
+
+	cfg, err := LoadUnchecked()
+	require.NoError(t, err)
+	assert.Equal(t, "bogus", cfg.LLM.Provider)
+}
+
+func TestKeys(t *testing.T) {
+	keys := Keys()
+	assert.Contains(t, keys, "llm.provider")
+	assert.Contains(t, keys, "learning.chunk_selection")
+	assert.Equal(t, "user.email", keys[0])
+}
@@ -3,7 +3,20 @@
 
 package config
 
-import "fmt"
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/events"
+	"github.com/pezware/samedi.dev/internal/export"
+	"github.com/pezware/samedi.dev/internal/log"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/tui/keymap"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
+)
 
 // Validate checks if the configuration is valid.
 func (c *Config) Validate() error {
@@ -18,9 +31,13 @@ func (c *Config) Validate() error {
 		"mock":    true, // Mock provider for testing
 		"amazonq": true, // Amazon Q CLI
 		"custom":  true, // Custom provider
+		// HTTP API providers
+		"anthropic": true, // Anthropic Messages API
+		"openai":    true, // OpenAI-compatible Chat Completions API
+		"ollama":    true, // Local Ollama server
 	}
 	if !validProviders[c.LLM.Provider] {
-		return fmt.Errorf("invalid LLM provider: %s (must be one of: auto, claude, codex, gemini, llm, stdin, mock, amazonq, custom)", c.LLM.Provider)
+		return fmt.Errorf("invalid LLM provider: %s (must be one of: auto, claude, codex, gemini, llm, stdin, mock, amazonq, custom, anthropic, openai, ollama)", c.LLM.Provider)
 	}
 
 	// Validate provider/command consistency
@@ -33,19 +50,56 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("LLM timeout must be between 10 and 600 seconds, got %d", c.LLM.TimeoutSeconds)
 	}
 
+	// Validate retry and token limits
+	if c.LLM.MaxRetries < 0 || c.LLM.MaxRetries > 10 {
+		return fmt.Errorf("LLM max_retries must be between 0 and 10, got %d", c.LLM.MaxRetries)
+	}
+	if c.LLM.MaxTokens < 0 {
+		return fmt.Errorf("LLM max_tokens cannot be negative, got %d", c.LLM.MaxTokens)
+	}
+	if c.LLM.RepairAttempts < 0 || c.LLM.RepairAttempts > 5 {
+		return fmt.Errorf("LLM repair_attempts must be between 0 and 5, got %d", c.LLM.RepairAttempts)
+	}
+
 	// Validate data directory exists or can be created
 	if c.Storage.DataDir == "" {
 		return fmt.Errorf("storage data_dir cannot be empty")
 	}
 
-	// Validate TUI theme
-	validThemes := map[string]bool{
-		"dracula": true,
-		"monokai": true,
-		"gruvbox": true,
+	if c.Storage.AutoBackupDays < 0 {
+		return fmt.Errorf("storage auto_backup_days cannot be negative, got %d", c.Storage.AutoBackupDays)
+	}
+	if c.Storage.BackupKeep < 0 {
+		return fmt.Errorf("storage backup_keep cannot be negative, got %d", c.Storage.BackupKeep)
+	}
+
+	if c.Storage.AutoVacuumPercent < 0 || c.Storage.AutoVacuumPercent > 100 {
+		return fmt.Errorf("storage auto_vacuum_percent must be between 0 and 100, got %d", c.Storage.AutoVacuumPercent)
+	}
+
+	if c.Storage.Profile != "" {
+		if err := ValidateProfileName(c.Storage.Profile); err != nil {
+			return fmt.Errorf("invalid storage profile: %w", err)
+		}
+	}
+
+	if c.Storage.Encrypt && c.Storage.PassphraseEnv == "" {
+		return fmt.Errorf("storage passphrase_env is required when encrypt is on")
 	}
-	if !validThemes[c.TUI.Theme] {
-		return fmt.Errorf("invalid TUI theme: %s (must be one of: dracula, monokai, gruvbox)", c.TUI.Theme)
+	if strings.ContainsAny(c.Storage.PassphraseEnv, " =$") {
+		return fmt.Errorf("storage passphrase_env must name an environment variable, got %q", c.Storage.PassphraseEnv)
+	}
+
+	// Validate timezone, which must be an IANA name such as Europe/Paris
+	if c.User.Timezone != "" {
+		if _, err := time.LoadLocation(c.User.Timezone); err != nil {
+			return fmt.Errorf("invalid timezone: %s (must be an IANA name like America/New_York)", c.User.Timezone)
+		}
+	}
+
+	// Validate TUI theme and any custom themes
+	if err := styles.Check(c.TUI.Theme, c.TUI.Themes); err != nil {
+		return fmt.Errorf("invalid TUI theme: %w", err)
 	}
 
 	// Validate first day of week
@@ -57,6 +111,190 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("invalid first_day_of_week: %s (must be monday or sunday)", c.TUI.FirstDayOfWeek)
 	}
 
+	if c.TUI.RefreshSeconds < 0 {
+		return fmt.Errorf("tui refresh_seconds cannot be negative, got %d", c.TUI.RefreshSeconds)
+	}
+
+	if _, err := plan.ParseListSort(c.TUI.PlanSort); err != nil {
+		return fmt.Errorf("invalid tui plan_sort: %w", err)
+	}
+
+	// Validate reminder times
+	for _, t := range c.Learning.ReminderTimes {
+		if _, err := time.Parse("15:04", t); err != nil {
+			return fmt.Errorf("invalid reminder time: %s (must be HH:MM in 24-hour format)", t)
+		}
+	}
+
+	// Validate weekly goal
+	if c.Learning.WeeklyGoalHours < 0 || c.Learning.WeeklyGoalHours > 168 {
+		return fmt.Errorf("weekly_goal_hours must be between 0 and 168, got %d", c.Learning.WeeklyGoalHours)
+	}
+
+	// Validate streak rules
+	if c.Learning.StreakMinMinutes < 0 || c.Learning.StreakMinMinutes > 24*60 {
+		return fmt.Errorf("streak_min_minutes must be between 0 and 1440, got %d", c.Learning.StreakMinMinutes)
+	}
+	restDays := make(map[time.Weekday]bool)
+	for _, name := range c.Learning.StreakRestDays {
+		day, ok := parseWeekday(name)
+		if !ok {
+			return fmt.Errorf("invalid streak rest day: %s (must be a weekday like sunday)", name)
+		}
+		restDays[day] = true
+	}
+	if len(restDays) == 7 {
+		return fmt.Errorf("streak_rest_days cannot include every day of the week")
+	}
+
+	// Validate chunk selection mode
+	if c.Learning.ChunkSelection != ChunkSelectionAsk && c.Learning.ChunkSelection != ChunkSelectionNext {
+		return fmt.Errorf("invalid chunk_selection: %s (must be ask or next)", c.Learning.ChunkSelection)
+	}
+
+	// Validate the chunk time alert
+	switch c.Learning.ChunkAlert {
+	case ChunkAlertOff, ChunkAlertBell, ChunkAlertNotify:
+	default:
+		return fmt.Errorf("invalid chunk_alert: %s (must be off, bell, or notify)", c.Learning.ChunkAlert)
+	}
+
+	// Validate key bindings before quick actions, which must avoid them
+	keys, err := keymap.New(c.TUI.Keys)
+	if err != nil {
+		return fmt.Errorf("invalid tui.keys: %w", err)
+	}
+	if err := c.validateQuickActions(keys); err != nil {
+		return err
+	}
+
+	// Validate total hours source
+	if c.Learning.TotalHoursSource != TotalHoursSourceChunks && c.Learning.TotalHoursSource != TotalHoursSourcePlan {
+		return fmt.Errorf("invalid total_hours_source: %s (must be chunks or plan)", c.Learning.TotalHoursSource)
+	}
+
+	// Validate export settings
+	if c.Export.Dir == "" {
+		return fmt.Errorf("export dir cannot be empty")
+	}
+	templates := map[string]string{
+		"report_filename": c.Export.ReportFilename,
+		"export_filename": c.Export.ExportFilename,
+		"backup_filename": c.Export.BackupFilename,
+	}
+	for _, key := range []string{"report_filename", "export_filename", "backup_filename"} {
+		if err := export.ValidateTemplate(templates[key]); err != nil {
+			return fmt.Errorf("invalid export %s: %w", key, err)
+		}
+	}
+
+	// Validate Obsidian folder, which must stay inside the vault
+	folder := filepath.Clean(c.Obsidian.Folder)
+	if c.Obsidian.Folder == "" || filepath.IsAbs(folder) || folder == ".." || strings.HasPrefix(folder, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("invalid obsidian folder: %q (must be a relative path inside the vault)", c.Obsidian.Folder)
+	}
+
+	if err := c.validateReports(); err != nil {
+		return err
+	}
+	if err := c.validateEvents(); err != nil {
+		return err
+	}
+	if c.Hooks.TimeoutSeconds < 1 || c.Hooks.TimeoutSeconds > 300 {
+		return fmt.Errorf("hooks timeout_seconds must be between 1 and 300, got %d", c.Hooks.TimeoutSeconds)
+	}
+	if strings.TrimSpace(c.Status.Format) == "" {
+		return fmt.Errorf("status format cannot be empty")
+	}
+	if _, err := log.ParseLevel(c.Log.Level); err != nil {
+		return fmt.Errorf("log level: %w", err)
+	}
+	if c.Log.Keep < 1 {
+		return fmt.Errorf("log keep must be at least 1, got %d", c.Log.Keep)
+	}
+	return nil
+}
+
+// validateReports checks the report schedule settings.
+func (c *Config) validateReports() error {
+	switch c.Reports.Schedule {
+	case ReportScheduleOff, ReportScheduleDaily, ReportScheduleWeekly, ReportScheduleMonthly:
+	default:
+		return fmt.Errorf("invalid reports schedule: %s (must be off, daily, weekly, or monthly)", c.Reports.Schedule)
+	}
+	if _, ok := parseWeekday(c.Reports.Day); !ok {
+		return fmt.Errorf("invalid reports day: %s (must be a weekday such as sunday)", c.Reports.Day)
+	}
+	if c.Reports.Dir == "" {
+		return fmt.Errorf("reports dir cannot be empty")
+	}
+	if c.Reports.Type != "summary" && c.Reports.Type != "full" {
+		return fmt.Errorf("invalid reports type: %s (must be summary or full)", c.Reports.Type)
+	}
+	if c.Reports.Keep < 0 {
+		return fmt.Errorf("reports keep cannot be negative, got %d", c.Reports.Keep)
+	}
+	return nil
+}
+
+// validateEvents checks webhook URLs and event types. URLs whose
+// environment variables are unset are checked once they're set.
+func (c *Config) validateEvents() error {
+	for _, raw := range c.Events.WebhookURLs() {
+		u, err := url.Parse(raw)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid events webhook: must be an http or https URL")
+		}
+	}
+	for _, t := range c.Events.Types {
+		if !events.Known(t) {
+			return fmt.Errorf("invalid events type: %s (known types: %s)", t, strings.Join(eventTypeNames(), ", "))
+		}
+	}
+	if c.Events.TimeoutSeconds < 1 || c.Events.TimeoutSeconds > 60 {
+		return fmt.Errorf("events timeout_seconds must be between 1 and 60, got %d", c.Events.TimeoutSeconds)
+	}
+	return nil
+}
+
+func eventTypeNames() []string {
+	names := make([]string, len(events.Types))
+	for i, t := range events.Types {
+		names[i] = string(t)
+	}
+	return names
+}
+
+// validateQuickActions checks "key=action" bindings. Keys the dashboard
+// shell already uses cannot be rebound.
+func (c *Config) validateQuickActions(keys *keymap.Keymap) error {
+	reserved := make(map[string]bool)
+	for _, key := range keys.ShellKeys() {
+		reserved[key] = true
+	}
+	seen := make(map[string]bool)
+	for _, binding := range c.TUI.QuickActions {
+		key, action, ok := strings.Cut(binding, "=")
+		key, action = strings.TrimSpace(key), strings.TrimSpace(action)
+		if !ok || key == "" {
+			return fmt.Errorf("invalid quick action: %q (must be key=action)", binding)
+		}
+		if reserved[key] {
+			return fmt.Errorf("invalid quick action: %q (key %s is reserved by the dashboard)", binding, key)
+		}
+		if seen[key] {
+			return fmt.Errorf("invalid quick action: %q (key %s is bound twice)", binding, key)
+		}
+		seen[key] = true
+
+		known := false
+		for _, name := range QuickActionNames {
+			known = known || name == action
+		}
+		if !known {
+			return fmt.Errorf("invalid quick action: %q (action must be one of %s)", binding, strings.Join(QuickActionNames, ", "))
+		}
+	}
 	return nil
 }
 
@@ -68,6 +306,14 @@ func (c *Config) validateProviderCommand() error {
 		return nil
 	}
 
+	// HTTP providers don't run a command
+	if c.LLM.Provider == "anthropic" || c.LLM.Provider == "openai" || c.LLM.Provider == "ollama" {
+		if c.LLM.CLICommand != "" {
+			return fmt.Errorf("provider '%s' calls an HTTP API and does not use cli_command; set cli_command to empty string (use base_url to change the endpoint)", c.LLM.Provider)
+		}
+		return nil
+	}
+
 	// Empty command is OK - provider will use its default
 	if c.LLM.CLICommand == "" {
 		return nil
@@ -99,3 +345,18 @@ func (c *Config) validateProviderCommand() error {
 
 	return nil
 }
+
+// ValidateProfileName checks that name can name a profile directory:
+// letters, digits, "-" and "_", starting with a letter or digit.
+func ValidateProfileName(name string) error {
+	if name == "" || len(name) > 64 {
+		return fmt.Errorf("profile name must be 1-64 characters, got %q", name)
+	}
+	for i, r := range name {
+		alnum := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+		if !alnum && (i == 0 || (r != '-' && r != '_')) {
+			return fmt.Errorf("invalid profile name %q (use letters, digits, - and _)", name)
+		}
+	}
+	return nil
+}
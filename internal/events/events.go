@@ -0,0 +1,126 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+// Package events delivers learning events, such as a session stopping or a
+// plan being completed, to sinks like webhooks.
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Type names an event. Types are stable; webhook consumers match on them.
+type Type string
+
+const (
+	// SessionStarted fires when a learning session starts.
+	SessionStarted Type = "session.started"
+	// SessionStopped fires when a learning session stops.
+	SessionStopped Type = "session.stopped"
+	// ChunkCompleted fires when a chunk is marked completed.
+	ChunkCompleted Type = "chunk.completed"
+	// PlanCompleted fires when every chunk of a plan is completed.
+	PlanCompleted Type = "plan.completed"
+	// StreakMilestone fires when the current streak reaches a milestone.
+	StreakMilestone Type = "streak.milestone"
+	// ChunkTimeUp fires when a session brings its chunk's logged time up
+	// to the planned duration.
+	ChunkTimeUp Type = "chunk.time_up"
+)
+
+// Types lists every event type.
+var Types = []Type{SessionStarted, SessionStopped, ChunkCompleted, PlanCompleted, StreakMilestone, ChunkTimeUp}
+
+// Known reports whether name is an event type.
+func Known(name string) bool {
+	for _, t := range Types {
+		if string(t) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Event is something that happened while learning.
+type Event struct {
+	Type      Type           `json:"type"`
+	Time      time.Time      `json:"time"`
+	PlanID    string         `json:"plan_id,omitempty"`
+	ChunkID   string         `json:"chunk_id,omitempty"`
+	SessionID string         `json:"session_id,omitempty"`
+	Message   string         `json:"message"`        // One line for people, e.g. "Completed plan rust-async"
+	Data      map[string]any `json:"data,omitempty"` // Details that depend on the type
+}
+
+// New creates an event of type t happening now, with a default message.
+func New(t Type, planID string) Event {
+	e := Event{Type: t, Time: time.Now(), PlanID: planID}
+	e.Message = e.defaultMessage()
+	return e
+}
+
+func (e Event) defaultMessage() string {
+	switch e.Type {
+	case SessionStarted:
+		return fmt.Sprintf("Started a session on %s", e.PlanID)
+	case SessionStopped:
+		return fmt.Sprintf("Finished a session on %s", e.PlanID)
+	case ChunkCompleted:
+		return fmt.Sprintf("Completed a chunk of %s", e.PlanID)
+	case PlanCompleted:
+		return fmt.Sprintf("Completed plan %s", e.PlanID)
+	case StreakMilestone:
+		return "Reached a learning streak milestone"
+	case ChunkTimeUp:
+		return fmt.Sprintf("Reached the planned time of a chunk of %s", e.PlanID)
+	default:
+		return string(e.Type)
+	}
+}
+
+// Emitter accepts events. Emitting never fails; delivery problems are the
+// emitter's to report.
+type Emitter interface {
+	Emit(ctx context.Context, e Event)
+}
+
+// Sink receives events.
+type Sink interface {
+	Send(ctx context.Context, e Event) error
+}
+
+// Bus emits events to every sink in turn. A nil Bus drops events.
+type Bus struct {
+	sinks   []Sink
+	onError func(error)
+}
+
+// NewBus creates a bus delivering to sinks.
+func NewBus(sinks ...Sink) *Bus {
+	return &Bus{sinks: sinks}
+}
+
+// Add adds a sink.
+func (b *Bus) Add(sink Sink) {
+	b.sinks = append(b.sinks, sink)
+}
+
+// OnError sets a function called with each failed delivery. Without one,
+// failures are dropped.
+func (b *Bus) OnError(handler func(error)) {
+	b.onError = handler
+}
+
+// Emit delivers e to every sink. Sinks that fail don't stop the rest.
+func (b *Bus) Emit(ctx context.Context, e Event) {
+	if b == nil {
+		return
+	}
+	for _, sink := range b.sinks {
+		if err := sink.Send(ctx, e); err != nil && b.onError != nil {
+			b.onError(err)
+		}
+	}
+}
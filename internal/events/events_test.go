@@ -0,0 +1,48 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingSink keeps the events it receives and fails with err.
+type recordingSink struct {
+	events []Event
+	err    error
+}
+
+func (r *recordingSink) Send(_ context.Context, e Event) error {
+	r.events = append(r.events, e)
+	return r.err
+}
+
+func TestBus_Emit(t *testing.T) {
+	failing := &recordingSink{err: errors.New("unreachable")}
+	ok := &recordingSink{}
+	bus := NewBus(failing)
+	bus.Add(ok)
+
+	var failures []error
+	bus.OnError(func(err error) { failures = append(failures, err) })
+
+	bus.Emit(context.Background(), New(PlanCompleted, "rust-async"))
+	assert.Len(t, failing.events, 1)
+	assert.Len(t, ok.events, 1, "a failing sink doesn't stop the rest")
+	assert.Len(t, failures, 1)
+	assert.Equal(t, "Completed plan rust-async", ok.events[0].Message)
+
+	var nilBus *Bus
+	assert.NotPanics(t, func() { nilBus.Emit(context.Background(), New(SessionStarted, "p")) })
+}
+
+func TestKnown(t *testing.T) {
+	assert.True(t, Known("session.stopped"))
+	assert.True(t, Known("streak.milestone"))
+	assert.False(t, Known("session.paused"))
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// hookStderrLimit caps how much of a hook's stderr is kept for error
+// messages.
+const hookStderrLimit = 4 << 10
+
+// HookSink runs an external command for one event type, passing the
+// event as JSON on stdin. Hooks run after samedi has saved its own state
+// and their output is ignored, so a failing or hanging hook can't corrupt
+// anything; it is killed once its timeout passes.
+type HookSink struct {
+	event   Type
+	command string
+	timeout time.Duration
+}
+
+// NewHookSink creates a sink running command, such as
+// "~/bin/post-session.sh --quiet", for events of type t. The command is
+// split on whitespace and run without a shell.
+func NewHookSink(t Type, command string, timeout time.Duration) *HookSink {
+	return &HookSink{event: t, command: command, timeout: timeout}
+}
+
+// Send runs the hook if e is of its type. The hook also sees the event
+// type in $SAMEDI_EVENT. Exiting non-zero or timing out is an error.
+func (h *HookSink) Send(ctx context.Context, e Event) error {
+	fields := strings.Fields(h.command)
+	if e.Type != h.event || len(fields) == 0 {
+		return nil
+	}
+	name := expandHome(fields[0])
+
+	body, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", e.Type, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	stderr := &cappedBuffer{limit: hookStderrLimit}
+	cmd := exec.CommandContext(ctx, name, fields[1:]...)
+	cmd.Stdin = bytes.NewReader(body)
+	cmd.Stdout = io.Discard
+	cmd.Stderr = stderr
+	cmd.Env = append(os.Environ(), "SAMEDI_EVENT="+string(e.Type))
+	// Don't wait on children that outlive a killed hook and hold its pipes
+	cmd.WaitDelay = time.Second
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("%s hook %s timed out after %s", e.Type, fields[0], h.timeout)
+		}
+		if msg := lastLine(stderr.String()); msg != "" {
+			return fmt.Errorf("%s hook %s failed: %w: %s", e.Type, fields[0], err, msg)
+		}
+		return fmt.Errorf("%s hook %s failed: %w", e.Type, fields[0], err)
+	}
+	return nil
+}
+
+// expandHome replaces a leading ~ with the home directory.
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
+
+// lastLine returns the last non-blank line of s.
+func lastLine(s string) string {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	return strings.TrimSpace(lines[len(lines)-1])
+}
+
+// cappedBuffer keeps the first limit bytes written to it and drops the
+// rest, so a chatty hook can't exhaust memory.
+type cappedBuffer struct {
+	bytes.Buffer
+	limit int
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if room := b.limit - b.Len(); room > 0 {
+		b.Buffer.Write(p[:min(room, len(p))])
+	}
+	return len(p), nil
+}
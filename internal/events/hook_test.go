@@ -0,0 +1,70 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeHook writes an executable shell script and returns its path.
+func writeHook(t *testing.T, script string) string {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("hook scripts need a POSIX shell")
+	}
+	path := filepath.Join(t.TempDir(), "hook.sh")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+script+"\n"), 0o755))
+	return path
+}
+
+func TestHookSink_Send(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "event.json")
+	hook := writeHook(t, `cat > "$1"; echo "$SAMEDI_EVENT" >> "$1.type"`)
+	sink := NewHookSink(SessionStopped, hook+" "+out, 5*time.Second)
+
+	e := New(SessionStopped, "rust")
+	e.SessionID = "s1"
+	require.NoError(t, sink.Send(context.Background(), e))
+
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	var got Event
+	require.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, SessionStopped, got.Type)
+	assert.Equal(t, "s1", got.SessionID)
+
+	eventType, err := os.ReadFile(out + ".type")
+	require.NoError(t, err)
+	assert.Equal(t, "session.stopped\n", string(eventType))
+
+	require.NoError(t, os.Remove(out))
+	require.NoError(t, sink.Send(context.Background(), New(PlanCompleted, "rust")))
+	assert.NoFileExists(t, out, "other event types don't run the hook")
+}
+
+func TestHookSink_Failures(t *testing.T) {
+	ctx := context.Background()
+
+	failing := NewHookSink(PlanCompleted, writeHook(t, "echo 'no such plan' >&2; exit 3"), 5*time.Second)
+	err := failing.Send(ctx, New(PlanCompleted, "rust"))
+	assert.ErrorContains(t, err, "plan.completed hook")
+	assert.ErrorContains(t, err, "no such plan")
+
+	start := time.Now()
+	slow := NewHookSink(PlanCompleted, writeHook(t, "sleep 10"), 100*time.Millisecond)
+	assert.ErrorContains(t, slow.Send(ctx, New(PlanCompleted, "rust")), "timed out")
+	assert.Less(t, time.Since(start), 5*time.Second, "slow hooks are killed")
+
+	missing := NewHookSink(PlanCompleted, filepath.Join(t.TempDir(), "missing.sh"), time.Second)
+	assert.Error(t, missing.Send(ctx, New(PlanCompleted, "rust")))
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// WebhookSink posts events as JSON to a URL.
+type WebhookSink struct {
+	url    string
+	types  map[Type]bool // Empty sends every type
+	client *http.Client
+}
+
+// webhookPayload is the JSON body of a webhook. Besides the event, it
+// carries the message as "text" and "content", which Slack and Discord
+// incoming webhooks display as-is.
+type webhookPayload struct {
+	Event
+	Text    string `json:"text"`
+	Content string `json:"content"`
+}
+
+// NewWebhookSink creates a sink posting events of the given types to
+// rawURL, or every event when types is empty. Requests give up after
+// timeout.
+func NewWebhookSink(rawURL string, types []Type, timeout time.Duration) *WebhookSink {
+	sink := &WebhookSink{
+		url:    rawURL,
+		types:  make(map[Type]bool, len(types)),
+		client: &http.Client{Timeout: timeout},
+	}
+	for _, t := range types {
+		sink.types[t] = true
+	}
+	return sink
+}
+
+// Send posts e unless its type is filtered out. Responses outside 2xx are
+// errors.
+func (w *WebhookSink) Send(ctx context.Context, e Event) error {
+	if len(w.types) > 0 && !w.types[e.Type] {
+		return nil
+	}
+
+	body, err := json.Marshal(webhookPayload{Event: e, Text: e.Message, Content: e.Message})
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", e.Type, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", redactURL(err))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "samedi")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post %s event to %s: %w", e.Type, w.host(), redactURL(err))
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook %s rejected %s event: %s", w.host(), e.Type, resp.Status)
+	}
+	return nil
+}
+
+// host returns the webhook's host. Webhook URLs often embed secrets in
+// their path, so errors name only the host.
+func (w *WebhookSink) host() string {
+	u, err := url.Parse(w.url)
+	if err != nil || u.Host == "" {
+		return "webhook"
+	}
+	return u.Host
+}
+
+// redactURL strips the URL from net/http errors, which quote it in full.
+func redactURL(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err
+	}
+	return err
+}
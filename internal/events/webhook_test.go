@@ -0,0 +1,72 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookSink_Send(t *testing.T) {
+	var got map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	e := New(SessionStopped, "rust-async")
+	e.SessionID = "abc"
+	e.Data = map[string]any{"duration_minutes": 45}
+
+	sink := NewWebhookSink(server.URL, nil, time.Second)
+	require.NoError(t, sink.Send(context.Background(), e))
+
+	assert.Equal(t, "session.stopped", got["type"])
+	assert.Equal(t, "rust-async", got["plan_id"])
+	assert.Equal(t, "abc", got["session_id"])
+	assert.Equal(t, e.Message, got["text"], "Slack shows text")
+	assert.Equal(t, e.Message, got["content"], "Discord shows content")
+	assert.Equal(t, map[string]any{"duration_minutes": float64(45)}, got["data"])
+}
+
+func TestWebhookSink_FiltersTypes(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		calls++
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, []Type{PlanCompleted}, time.Second)
+	require.NoError(t, sink.Send(context.Background(), New(SessionStarted, "p")))
+	require.NoError(t, sink.Send(context.Background(), New(PlanCompleted, "p")))
+	assert.Equal(t, 1, calls)
+}
+
+func TestWebhookSink_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL+"/hooks/secret-token", nil, time.Second)
+	err := sink.Send(context.Background(), New(PlanCompleted, "p"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "403")
+	assert.NotContains(t, err.Error(), "secret-token")
+
+	server.Close()
+	err = sink.Send(context.Background(), New(PlanCompleted, "p"))
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "secret-token", "URLs can hold secrets")
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+// Package export names and writes files produced by report, export, and
+// backup commands.
+package export
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// placeholderRegex matches {{name}} placeholders in filename templates.
+var placeholderRegex = regexp.MustCompile(`\{\{\s*([a-z]+)\s*\}\}`)
+
+// Placeholders lists the names available in filename templates.
+var Placeholders = []string{"date", "time", "type", "plan", "range", "ext"}
+
+// Vars fill the placeholders in a filename template.
+type Vars struct {
+	Time  time.Time // {{date}} as 2006-01-02, {{time}} as 150405
+	Type  string    // {{type}}: report or export kind (e.g. "full", "anki")
+	Plan  string    // {{plan}}: plan ID, or "all"
+	Range string    // {{range}}: time range (e.g. "this-week")
+	Ext   string    // {{ext}}: file extension without the dot
+}
+
+// ValidateTemplate checks that a template only uses known placeholders and
+// stays inside its output directory.
+func ValidateTemplate(template string) error {
+	if strings.TrimSpace(template) == "" {
+		return fmt.Errorf("filename template cannot be empty")
+	}
+
+	for _, match := range placeholderRegex.FindAllStringSubmatch(template, -1) {
+		if !isPlaceholder(match[1]) {
+			return fmt.Errorf("unknown placeholder {{%s}} (available: %s)", match[1], strings.Join(Placeholders, ", "))
+		}
+	}
+
+	rest := placeholderRegex.ReplaceAllString(template, "x")
+	if strings.Contains(rest, "{{") || strings.Contains(rest, "}}") {
+		return fmt.Errorf("unterminated placeholder in %q", template)
+	}
+	if filepath.IsAbs(rest) || strings.HasPrefix(filepath.Clean(rest), "..") {
+		return fmt.Errorf("filename template must be relative to the output directory: %q", template)
+	}
+
+	return nil
+}
+
+// Filename renders a template like "{{date}}-{{type}}.md". Values are
+// sanitized so they can't introduce path separators.
+func Filename(template string, vars Vars) (string, error) {
+	if err := ValidateTemplate(template); err != nil {
+		return "", err
+	}
+
+	if vars.Time.IsZero() {
+		vars.Time = time.Now()
+	}
+	values := map[string]string{
+		"date":  vars.Time.Format("2006-01-02"),
+		"time":  vars.Time.Format("150405"),
+		"type":  vars.Type,
+		"plan":  vars.Plan,
+		"range": vars.Range,
+		"ext":   strings.TrimPrefix(vars.Ext, "."),
+	}
+
+	name := placeholderRegex.ReplaceAllStringFunc(template, func(match string) string {
+		key := placeholderRegex.FindStringSubmatch(match)[1]
+		return sanitize(values[key])
+	})
+
+	// Empty values can leave doubled or dangling separators ("2025-01-01-.md")
+	name = strings.ReplaceAll(name, "--", "-")
+	name = strings.ReplaceAll(name, "-.", ".")
+
+	return filepath.Clean(name), nil
+}
+
+// Save renders the template, creates the directory, and writes data to a
+// new file. If the name is taken, "-2", "-3", ... is inserted before the
+// extension; existing files are never overwritten. Returns the path written.
+func Save(dir, template string, vars Vars, data []byte) (string, error) {
+	name, err := Filename(template, vars)
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(ExpandHome(dir), name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	return WriteNew(path, data)
+}
+
+// WriteNew writes data to path, or to the first free "-N" variant of it.
+func WriteNew(path string, data []byte) (string, error) {
+	candidate := path
+	for n := 2; ; n++ {
+		file, err := os.OpenFile(candidate, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600) // #nosec G304 - user-chosen output path
+		if errors.Is(err, os.ErrExist) {
+			candidate = numbered(path, n)
+			continue
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to create %s: %w", candidate, err)
+		}
+
+		if _, err := file.Write(data); err != nil {
+			_ = file.Close()
+			return "", fmt.Errorf("failed to write %s: %w", candidate, err)
+		}
+		if err := file.Close(); err != nil {
+			return "", fmt.Errorf("failed to write %s: %w", candidate, err)
+		}
+		return candidate, nil
+	}
+}
+
+// FreePath returns path, or the first "-N" variant of it that doesn't
+// exist yet, for files that must be created by someone else, such as a
+// database writing its own backup.
+func FreePath(path string) string {
+	candidate := path
+	for n := 2; ; n++ {
+		if _, err := os.Lstat(candidate); errors.Is(err, os.ErrNotExist) {
+			return candidate
+		}
+		candidate = numbered(path, n)
+	}
+}
+
+// numbered returns the "-n" variant of path, e.g. "report-2.md".
+func numbered(path string, n int) string {
+	ext := fullExt(path)
+	return fmt.Sprintf("%s-%d%s", strings.TrimSuffix(path, ext), n, ext)
+}
+
+// ExpandHome replaces a leading "~" with the user's home directory.
+func ExpandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
+
+// fullExt returns the extension including compound ones like ".tar.gz".
+func fullExt(path string) string {
+	base := filepath.Base(path)
+	if strings.HasSuffix(base, ".tar.gz") && base != ".tar.gz" {
+		return ".tar.gz"
+	}
+	return filepath.Ext(base)
+}
+
+func isPlaceholder(name string) bool {
+	for _, p := range Placeholders {
+		if p == name {
+			return true
+		}
+	}
+	return false
+}
+
+// sanitize keeps placeholder values from creating directories or hidden files.
+func sanitize(value string) string {
+	value = strings.NewReplacer("/", "-", "\\", "-", "..", "-").Replace(value)
+	return strings.Trim(value, "-. ")
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilename(t *testing.T) {
+	now := time.Date(2025, 1, 15, 9, 30, 5, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		template string
+		vars     Vars
+		want     string
+	}{
+		{"date and type", "{{date}}-{{type}}.md", Vars{Time: now, Type: "full"}, "2025-01-15-full.md"},
+		{"all placeholders", "{{plan}}/{{range}}-{{date}}T{{time}}.{{ext}}",
+			Vars{Time: now, Plan: "rust", Range: "this-week", Ext: ".csv"}, "rust/this-week-2025-01-15T093005.csv"},
+		{"spaces inside braces", "{{ date }}.md", Vars{Time: now}, "2025-01-15.md"},
+		{"empty value collapses separators", "{{date}}-{{type}}.md", Vars{Time: now}, "2025-01-15.md"},
+		{"values cannot add directories", "{{plan}}.md", Vars{Time: now, Plan: "../etc/passwd"}, "etc-passwd.md"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Filename(tt.template, tt.vars)
+			require.NoError(t, err)
+			assert.Equal(t, filepath.FromSlash(tt.want), got)
+		})
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	assert.NoError(t, ValidateTemplate("{{date}}-{{plan}}-{{type}}.{{ext}}"))
+	assert.NoError(t, ValidateTemplate("reports/{{date}}.md"))
+
+	assert.ErrorContains(t, ValidateTemplate(""), "empty")
+	assert.ErrorContains(t, ValidateTemplate("{{day}}.md"), "unknown placeholder {{day}}")
+	assert.ErrorContains(t, ValidateTemplate("{{date.md"), "unterminated")
+	assert.ErrorContains(t, ValidateTemplate("../{{date}}.md"), "relative")
+	assert.ErrorContains(t, ValidateTemplate("/tmp/{{date}}.md"), "relative")
+}
+
+func TestSave_NeverOverwrites(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "exports")
+	vars := Vars{Time: time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC), Type: "full"}
+
+	first, err := Save(dir, "{{date}}-{{type}}.md", vars, []byte("first"))
+	require.NoError(t, err)
+	second, err := Save(dir, "{{date}}-{{type}}.md", vars, []byte("second"))
+	require.NoError(t, err)
+
+	assert.Equal(t, filepath.Join(dir, "2025-01-15-full.md"), first)
+	assert.Equal(t, filepath.Join(dir, "2025-01-15-full-2.md"), second)
+
+	data, err := os.ReadFile(first)
+	require.NoError(t, err)
+	assert.Equal(t, "first", string(data))
+}
+
+func TestWriteNew_CompoundExtension(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "samedi-2025-01-15.tar.gz")
+
+	_, err := WriteNew(path, []byte("a"))
+	require.NoError(t, err)
+	second, err := WriteNew(path, []byte("b"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "samedi-2025-01-15-2.tar.gz", filepath.Base(second))
+}
+
+func TestFreePath(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sessions-2025-01-15-090000.db")
+	assert.Equal(t, path, FreePath(path))
+
+	require.NoError(t, os.WriteFile(path, nil, 0o600))
+	assert.Equal(t, "sessions-2025-01-15-090000-2.db", filepath.Base(FreePath(path)))
+}
+
+func TestExpandHome(t *testing.T) {
+	home, err := os.UserHomeDir()
+	require.NoError(t, err)
+
+	assert.Equal(t, filepath.Join(home, "exports"), ExpandHome("~/exports"))
+	assert.Equal(t, home, ExpandHome("~"))
+	assert.Equal(t, "/tmp/exports", ExpandHome("/tmp/exports"))
+	assert.Equal(t, "~user/x", ExpandHome("~user/x"))
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package flashcard
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+)
+
+// AnkiDeckPrefix is the parent deck exported cards are filed under, one
+// subdeck per plan (e.g. "Samedi::french-b1").
+const AnkiDeckPrefix = "Samedi"
+
+// WriteAnkiTSV writes cards as an Anki plain-text import file: tab-separated
+// front, back, tags, and deck columns, with header lines telling Anki how
+// to read them (File → Import, Anki 2.1.55 or later). Fields are HTML, so
+// line breaks survive the import.
+//
+// Each card is tagged with its provenance: samedi::plan::<plan-id> and,
+// when known, samedi::chunk::<chunk-id>, alongside its own tags.
+func WriteAnkiTSV(w io.Writer, cards []Card) error {
+	header := "#separator:tab\n#html:true\n#notetype:Basic\n#tags column:3\n#deck column:4\n"
+	if _, err := io.WriteString(w, header); err != nil {
+		return fmt.Errorf("failed to write Anki header: %w", err)
+	}
+
+	for _, card := range cards {
+		row := strings.Join([]string{
+			ankiField(card.Question),
+			ankiField(card.Answer),
+			strings.Join(ankiTags(card), " "),
+			AnkiDeckPrefix + "::" + card.PlanID,
+		}, "\t")
+		if _, err := io.WriteString(w, row+"\n"); err != nil {
+			return fmt.Errorf("failed to write card %s: %w", card.ID, err)
+		}
+	}
+	return nil
+}
+
+// ankiField escapes text for an HTML field on a single TSV line.
+func ankiField(text string) string {
+	escaped := html.EscapeString(strings.ReplaceAll(text, "\t", " "))
+	return strings.ReplaceAll(escaped, "\n", "<br>")
+}
+
+// ankiTags returns a card's tags with provenance. Anki tags are
+// space-separated, so spaces inside a tag become underscores.
+func ankiTags(card Card) []string {
+	tags := []string{"samedi::plan::" + card.PlanID}
+	if card.ChunkID != "" {
+		tags = append(tags, "samedi::chunk::"+card.ChunkID)
+	}
+	for _, tag := range card.Tags {
+		tags = append(tags, strings.Join(strings.Fields(tag), "_"))
+	}
+	return tags
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+// Package flashcard reads flashcards stored as markdown in
+// ~/.samedi/cards/{plan-id}.cards.md, schedules their reviews, and exports
+// them for other tools.
+package flashcard
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+)
+
+var (
+	// cardHeaderRegex matches "## Card 1 {#card-001}"
+	cardHeaderRegex = regexp.MustCompile(`^##\s+Card\b.*\{#([^}]+)\}\s*$`)
+	cardFieldRegex  = regexp.MustCompile(`^\*\*([^*]+)\*\*:\s*(.*)$`)
+	chunkNumRegex   = regexp.MustCompile(`(?i)^chunk\s+(\d+)$`)
+)
+
+// ErrCardNotFound is returned, wrapped with the card ID, when no card has
+// the requested ID.
+var ErrCardNotFound = errors.New("card not found")
+
+// cardsFileSuffix names a plan's cards file: {plan-id}.cards.md.
+const cardsFileSuffix = ".cards.md"
+
+// Card is one flashcard. Scheduling fields written in the markdown (ease,
+// interval, next review) are not parsed; the Scheduler keeps each card's
+// schedule in the database instead.
+type Card struct {
+	ID       string
+	PlanID   string
+	ChunkID  string // Source chunk, empty if unknown
+	Question string
+	Answer   string
+	Tags     []string
+}
+
+// Parse reads the cards markdown for a plan. Cards missing a question or
+// answer are returned as an error naming the card.
+func Parse(content, planID string) ([]Card, error) {
+	var (
+		cards   []Card
+		current *Card
+		field   string // field receiving continuation lines (Q or A)
+	)
+
+	flush := func() error {
+		if current == nil {
+			return nil
+		}
+		current.Question = strings.TrimSpace(current.Question)
+		current.Answer = strings.TrimSpace(current.Answer)
+		if current.Question == "" || current.Answer == "" {
+			return fmt.Errorf("card %s in %s needs both **Q** and **A**", current.ID, planID)
+		}
+		cards = append(cards, *current)
+		current = nil
+		return nil
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \t")
+		trimmed := strings.TrimSpace(line)
+
+		if matches := cardHeaderRegex.FindStringSubmatch(trimmed); matches != nil {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			current = &Card{ID: matches[1], PlanID: planID}
+			field = ""
+			continue
+		}
+		if current == nil {
+			continue
+		}
+
+		if matches := cardFieldRegex.FindStringSubmatch(trimmed); matches != nil {
+			field = ""
+			value := strings.TrimSpace(matches[2])
+			switch strings.ToLower(matches[1]) {
+			case "q":
+				current.Question, field = value, "q"
+			case "a":
+				current.Answer, field = value, "a"
+			case "tags":
+				current.Tags = splitTags(value)
+			case "source":
+				current.ChunkID = sourceChunkID(value)
+			}
+			continue
+		}
+
+		// "---" separates cards; other lines continue a multi-line Q or A
+		if trimmed == "---" {
+			field = ""
+			continue
+		}
+		switch field {
+		case "q":
+			current.Question += "\n" + line
+		case "a":
+			current.Answer += "\n" + line
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading cards: %w", err)
+	}
+
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return cards, nil
+}
+
+// splitTags splits a comma-separated tag list.
+func splitTags(value string) []string {
+	var tags []string
+	for _, tag := range strings.Split(value, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// sourceChunkID maps a card's source to a chunk ID. "Chunk 3" becomes
+// "chunk-003", matching generated plans; chunk IDs are kept as written.
+func sourceChunkID(source string) string {
+	if matches := chunkNumRegex.FindStringSubmatch(source); matches != nil {
+		n, err := strconv.Atoi(matches[1])
+		if err == nil {
+			return fmt.Sprintf("chunk-%03d", n)
+		}
+	}
+	if strings.HasPrefix(source, "chunk-") {
+		return source
+	}
+	return ""
+}
+
+// Load reads the cards for one plan. A plan without a cards file has no cards.
+func Load(paths *storage.Paths, planID string) ([]Card, error) {
+	content, err := os.ReadFile(paths.CardsPath(planID))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cards for %s: %w", planID, err)
+	}
+	return Parse(string(content), planID)
+}
+
+// LoadAll reads the cards of every plan with a cards file, ordered by plan ID.
+func LoadAll(paths *storage.Paths) ([]Card, error) {
+	matches, err := filepath.Glob(filepath.Join(paths.CardsDir, "*"+cardsFileSuffix))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cards: %w", err)
+	}
+	sort.Strings(matches)
+
+	var cards []Card
+	for _, match := range matches {
+		planID := strings.TrimSuffix(filepath.Base(match), cardsFileSuffix)
+		planCards, err := Load(paths, planID)
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, planCards...)
+	}
+	return cards, nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package flashcard
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const frenchCards = `# French B1 Flashcards
+
+## Card 1 {#card-001}
+**Q**: How do you say "Good morning" formally in French?
+**A**: Bonjour
+
+**Tags**: greeting, formal
+**Source**: Chunk 1
+**Created**: 2024-01-15
+**Ease**: 2.5
+
+---
+
+## Card 2 {#card-002}
+**Q**: Conjugate "parler" in present tense
+**A**: je parle
+tu parles
+il parle
+
+**Tags**: verb, present tense
+**Source**: chunk-004
+
+---
+`
+
+func TestParse(t *testing.T) {
+	cards, err := Parse(frenchCards, "french-b1")
+	require.NoError(t, err)
+	require.Len(t, cards, 2)
+
+	assert.Equal(t, Card{
+		ID:       "card-001",
+		PlanID:   "french-b1",
+		ChunkID:  "chunk-001",
+		Question: `How do you say "Good morning" formally in French?`,
+		Answer:   "Bonjour",
+		Tags:     []string{"greeting", "formal"},
+	}, cards[0])
+
+	assert.Equal(t, "je parle\ntu parles\nil parle", cards[1].Answer)
+	assert.Equal(t, "chunk-004", cards[1].ChunkID)
+}
+
+func TestParse_MissingAnswer(t *testing.T) {
+	_, err := Parse("## Card 1 {#card-001}\n**Q**: Question?\n", "french-b1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "card-001")
+}
+
+func TestLoadAll(t *testing.T) {
+	root := t.TempDir()
+	paths := &storage.Paths{CardsDir: filepath.Join(root, "cards")}
+	require.NoError(t, os.MkdirAll(paths.CardsDir, 0o755))
+	require.NoError(t, os.WriteFile(paths.CardsPath("french-b1"), []byte(frenchCards), 0o600))
+
+	cards, err := LoadAll(paths)
+	require.NoError(t, err)
+	assert.Len(t, cards, 2)
+
+	none, err := Load(paths, "rust-async")
+	require.NoError(t, err)
+	assert.Empty(t, none)
+}
+
+func TestWriteAnkiTSV(t *testing.T) {
+	cards, err := Parse(frenchCards, "french-b1")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteAnkiTSV(&buf, cards))
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 7, "5 header lines and one line per card")
+	assert.Equal(t, "#separator:tab", string(lines[0]))
+	assert.Equal(t,
+		"How do you say &#34;Good morning&#34; formally in French?\tBonjour\t"+
+			"samedi::plan::french-b1 samedi::chunk::chunk-001 greeting formal\tSamedi::french-b1",
+		string(lines[5]))
+	assert.Contains(t, string(lines[6]), "je parle<br>tu parles<br>il parle")
+	assert.Contains(t, string(lines[6]), "present_tense")
+}
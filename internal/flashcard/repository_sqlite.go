@@ -0,0 +1,254 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package flashcard
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+)
+
+// Review is one graded card.
+type Review struct {
+	CardID     string // Card key, see Key
+	PlanID     string
+	Rating     Rating
+	ReviewedAt time.Time
+}
+
+// SQLiteRepository stores card schedules in the cards table and graded
+// reviews in card_reviews. It implements storage.CardRepository.
+type SQLiteRepository struct {
+	db *storage.SQLiteDB
+}
+
+var _ storage.CardRepository = (*SQLiteRepository)(nil)
+
+// NewSQLiteRepository creates a new SQLite-backed card repository.
+func NewSQLiteRepository(db *storage.SQLiteDB) *SQLiteRepository {
+	return &SQLiteRepository{db: db}
+}
+
+// Upsert inserts a card's schedule or replaces the stored one.
+func (r *SQLiteRepository) Upsert(ctx context.Context, card *storage.CardRecord) error {
+	tags, err := json.Marshal(card.Tags)
+	if err != nil {
+		return fmt.Errorf("failed to marshal tags: %w", err)
+	}
+
+	_, err = r.db.DB().ExecContext(ctx, `
+		INSERT INTO cards (
+			id, plan_id, chunk_id, question, answer, tags, created_at,
+			ease_factor, interval_days, repetitions, next_review, last_review
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			plan_id = excluded.plan_id, chunk_id = excluded.chunk_id,
+			question = excluded.question, answer = excluded.answer, tags = excluded.tags,
+			ease_factor = excluded.ease_factor, interval_days = excluded.interval_days,
+			repetitions = excluded.repetitions, next_review = excluded.next_review,
+			last_review = excluded.last_review
+	`,
+		card.ID, card.PlanID, nullString(card.ChunkID), card.Question, card.Answer, string(tags), card.CreatedAt,
+		card.EaseFactor, card.IntervalDays, card.Repetitions, card.NextReview, nullTime(card.LastReview),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save card %s: %w", card.ID, err)
+	}
+	return nil
+}
+
+const cardColumns = `id, plan_id, chunk_id, question, answer, tags, created_at,
+	ease_factor, interval_days, repetitions, next_review, last_review`
+
+// Get retrieves a card's schedule by key.
+func (r *SQLiteRepository) Get(ctx context.Context, id string) (*storage.CardRecord, error) {
+	rows, err := r.db.DB().QueryContext(ctx, `SELECT `+cardColumns+` FROM cards WHERE id = ?`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get card: %w", err)
+	}
+	defer rows.Close()
+
+	cards, err := scanCards(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(cards) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrCardNotFound, id)
+	}
+	return cards[0], nil
+}
+
+// List returns card schedules matching filter, soonest due first.
+func (r *SQLiteRepository) List(ctx context.Context, filter *storage.CardFilter) ([]*storage.CardRecord, error) {
+	query := `SELECT ` + cardColumns + ` FROM cards`
+	var where []string
+	var args []interface{}
+	if filter != nil {
+		if filter.PlanID != "" {
+			where = append(where, "plan_id = ?")
+			args = append(args, filter.PlanID)
+		}
+		if filter.DueBefore != nil {
+			where = append(where, "next_review <= ?")
+			args = append(args, *filter.DueBefore)
+		}
+	}
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY next_review, id"
+	if filter != nil && filter.Limit > 0 && len(filter.Tags) == 0 {
+		query += " LIMIT ?"
+		args = append(args, filter.Limit)
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cards: %w", err)
+	}
+	defer rows.Close()
+
+	cards, err := scanCards(rows)
+	if err != nil {
+		return nil, err
+	}
+	if filter == nil || len(filter.Tags) == 0 {
+		return cards, nil
+	}
+
+	// Tags are a JSON array, so they are matched here rather than in SQL
+	tagged := make([]*storage.CardRecord, 0, len(cards))
+	for _, card := range cards {
+		if hasAllTags(card.Tags, filter.Tags) {
+			tagged = append(tagged, card)
+		}
+	}
+	if filter.Limit > 0 && len(tagged) > filter.Limit {
+		tagged = tagged[:filter.Limit]
+	}
+	return tagged, nil
+}
+
+// Delete removes a card's schedule.
+func (r *SQLiteRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.DB().ExecContext(ctx, "DELETE FROM cards WHERE id = ?", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete card: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: %s", ErrCardNotFound, id)
+	}
+	return nil
+}
+
+// RecordReview logs a graded card.
+func (r *SQLiteRepository) RecordReview(ctx context.Context, review Review) error {
+	if !review.Rating.Valid() {
+		return fmt.Errorf("invalid rating %d (must be 1-4)", review.Rating)
+	}
+	_, err := r.db.DB().ExecContext(ctx, `
+		INSERT INTO card_reviews (card_id, plan_id, rating, reviewed_at)
+		VALUES (?, ?, ?, ?)
+	`, review.CardID, review.PlanID, int(review.Rating), review.ReviewedAt.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to record review: %w", err)
+	}
+	return nil
+}
+
+// ListReviews returns the reviews graded at or after since, oldest first.
+func (r *SQLiteRepository) ListReviews(ctx context.Context, since time.Time) ([]Review, error) {
+	rows, err := r.db.DB().QueryContext(ctx, `
+		SELECT card_id, plan_id, rating, reviewed_at
+		FROM card_reviews
+		WHERE reviewed_at >= ?
+		ORDER BY reviewed_at, id
+	`, since.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reviews: %w", err)
+	}
+	defer rows.Close()
+
+	reviews := make([]Review, 0)
+	for rows.Next() {
+		var review Review
+		if err := rows.Scan(&review.CardID, &review.PlanID, &review.Rating, &review.ReviewedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan review: %w", err)
+		}
+		reviews = append(reviews, review)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating reviews: %w", err)
+	}
+	return reviews, nil
+}
+
+// scanCards reads card rows.
+func scanCards(rows *sql.Rows) ([]*storage.CardRecord, error) {
+	cards := make([]*storage.CardRecord, 0)
+	for rows.Next() {
+		var card storage.CardRecord
+		var chunkID, tags sql.NullString
+		var lastReview sql.NullTime
+		err := rows.Scan(
+			&card.ID, &card.PlanID, &chunkID, &card.Question, &card.Answer, &tags, &card.CreatedAt,
+			&card.EaseFactor, &card.IntervalDays, &card.Repetitions, &card.NextReview, &lastReview,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan card: %w", err)
+		}
+		card.ChunkID = chunkID.String
+		if lastReview.Valid {
+			t := lastReview.Time
+			card.LastReview = &t
+		}
+		if tags.Valid && tags.String != "" && tags.String != "null" {
+			if err := json.Unmarshal([]byte(tags.String), &card.Tags); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal tags: %w", err)
+			}
+		}
+		cards = append(cards, &card)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating cards: %w", err)
+	}
+	return cards, nil
+}
+
+// hasAllTags reports whether tags holds every wanted tag, ignoring case.
+func hasAllTags(tags, wanted []string) bool {
+	for _, want := range wanted {
+		found := false
+		for _, tag := range tags {
+			if strings.EqualFold(tag, want) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func nullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
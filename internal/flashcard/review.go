@@ -0,0 +1,109 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package flashcard
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+)
+
+// Key identifies a card across plans. Card IDs such as "card-001" repeat
+// from plan to plan, so schedules and reviews are stored under the key.
+func Key(planID, cardID string) string {
+	return planID + "/" + cardID
+}
+
+// DueCard is a card with its review schedule.
+type DueCard struct {
+	Card
+	Schedule Schedule
+	New      bool // Never reviewed
+}
+
+// Scheduler decides which cards are due and records grades. Cards are
+// read from the markdown files; their schedules are kept in the database,
+// and a card without one is new and due today.
+type Scheduler struct {
+	paths *storage.Paths
+	repo  *SQLiteRepository
+	now   func() time.Time
+}
+
+// NewScheduler creates a scheduler for the cards under paths.
+func NewScheduler(paths *storage.Paths, repo *SQLiteRepository) *Scheduler {
+	return &Scheduler{paths: paths, repo: repo, now: time.Now}
+}
+
+// Due returns every card due today, ordered by plan and then card.
+func (s *Scheduler) Due(ctx context.Context) ([]DueCard, error) {
+	cards, err := LoadAll(s.paths)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := s.repo.List(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	schedules := make(map[string]*storage.CardRecord, len(records))
+	for _, record := range records {
+		schedules[record.ID] = record
+	}
+
+	now := s.now()
+	due := make([]DueCard, 0)
+	for _, card := range cards {
+		dc := DueCard{Card: card, Schedule: NewSchedule(now), New: true}
+		if record, ok := schedules[Key(card.PlanID, card.ID)]; ok {
+			dc.New = false
+			dc.Schedule = Schedule{
+				EaseFactor:   record.EaseFactor,
+				IntervalDays: record.IntervalDays,
+				Repetitions:  record.Repetitions,
+				NextReview:   record.NextReview,
+				LastReview:   record.LastReview,
+			}
+		}
+		if dc.Schedule.Due(now) {
+			due = append(due, dc)
+		}
+	}
+	return due, nil
+}
+
+// Grade reschedules a card for rating and logs the review.
+func (s *Scheduler) Grade(ctx context.Context, card DueCard, rating Rating) (Schedule, error) {
+	if !rating.Valid() {
+		return Schedule{}, fmt.Errorf("invalid rating %d (must be 1-4)", rating)
+	}
+
+	now := s.now()
+	next := card.Schedule.Review(rating, now)
+	key := Key(card.PlanID, card.ID)
+
+	record := &storage.CardRecord{
+		ID:           key,
+		PlanID:       card.PlanID,
+		ChunkID:      card.ChunkID,
+		Question:     card.Question,
+		Answer:       card.Answer,
+		Tags:         card.Tags,
+		CreatedAt:    now,
+		EaseFactor:   next.EaseFactor,
+		IntervalDays: next.IntervalDays,
+		Repetitions:  next.Repetitions,
+		NextReview:   next.NextReview,
+		LastReview:   next.LastReview,
+	}
+	if err := s.repo.Upsert(ctx, record); err != nil {
+		return Schedule{}, err
+	}
+	if err := s.repo.RecordReview(ctx, Review{CardID: key, PlanID: card.PlanID, Rating: rating, ReviewedAt: now}); err != nil {
+		return Schedule{}, err
+	}
+	return next, nil
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package flashcard
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestScheduler(t *testing.T) (*Scheduler, *SQLiteRepository) {
+	t.Helper()
+	root := t.TempDir()
+	paths := &storage.Paths{CardsDir: filepath.Join(root, "cards")}
+	require.NoError(t, os.MkdirAll(paths.CardsDir, 0o755))
+	require.NoError(t, os.WriteFile(paths.CardsPath("french-b1"), []byte(frenchCards), 0o600))
+
+	db, err := storage.NewSQLiteDB(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	require.NoError(t, storage.NewMigrator(db).Migrate())
+
+	repo := NewSQLiteRepository(db)
+	return NewScheduler(paths, repo), repo
+}
+
+func TestScheduler_DueAndGrade(t *testing.T) {
+	scheduler, repo := newTestScheduler(t)
+	ctx := context.Background()
+	now := time.Date(2025, 3, 10, 9, 0, 0, 0, time.Local)
+	scheduler.now = func() time.Time { return now }
+
+	due, err := scheduler.Due(ctx)
+	require.NoError(t, err)
+	require.Len(t, due, 2, "new cards are due today")
+	assert.True(t, due[0].New)
+
+	next, err := scheduler.Grade(ctx, due[0], Good)
+	require.NoError(t, err)
+	assert.Equal(t, 1, next.IntervalDays)
+	_, err = scheduler.Grade(ctx, due[1], Again)
+	require.NoError(t, err)
+	_, err = scheduler.Grade(ctx, due[1], Rating(0))
+	assert.Error(t, err)
+
+	stored, err := repo.Get(ctx, Key("french-b1", "card-001"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, stored.Repetitions)
+	assert.Equal(t, []string{"greeting", "formal"}, stored.Tags)
+
+	due, err = scheduler.Due(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, due, "graded cards wait for their next review")
+
+	scheduler.now = func() time.Time { return now.AddDate(0, 0, 1) }
+	due, err = scheduler.Due(ctx)
+	require.NoError(t, err)
+	assert.Len(t, due, 2)
+	assert.False(t, due[0].New)
+
+	reviews, err := repo.ListReviews(ctx, now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, reviews, 2)
+	assert.Equal(t, Good, reviews[0].Rating)
+	assert.Equal(t, "french-b1/card-001", reviews[0].CardID)
+
+	later, err := repo.ListReviews(ctx, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, later)
+}
+
+func TestSQLiteRepository_ListFilters(t *testing.T) {
+	_, repo := newTestScheduler(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	for _, rec := range []*storage.CardRecord{
+		{ID: "a/card-001", PlanID: "a", Question: "q", Answer: "a", Tags: []string{"Verb"}, CreatedAt: now, EaseFactor: 2.5, IntervalDays: 1, NextReview: now.AddDate(0, 0, -1)},
+		{ID: "b/card-001", PlanID: "b", Question: "q", Answer: "a", CreatedAt: now, EaseFactor: 2.5, IntervalDays: 1, NextReview: now.AddDate(0, 0, 3)},
+	} {
+		require.NoError(t, repo.Upsert(ctx, rec))
+	}
+
+	all, err := repo.List(ctx, nil)
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	due, err := repo.List(ctx, &storage.CardFilter{DueBefore: &now})
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, "a/card-001", due[0].ID)
+
+	tagged, err := repo.List(ctx, &storage.CardFilter{Tags: []string{"verb"}})
+	require.NoError(t, err)
+	assert.Len(t, tagged, 1)
+
+	require.NoError(t, repo.Delete(ctx, "b/card-001"))
+	assert.Error(t, repo.Delete(ctx, "b/card-001"))
+}
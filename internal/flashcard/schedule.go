@@ -0,0 +1,115 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package flashcard
+
+import (
+	"fmt"
+	"time"
+)
+
+// Rating grades how well a card was recalled during review.
+type Rating int
+
+// Ratings, from forgotten to instantly recalled.
+const (
+	Again Rating = 1
+	Hard  Rating = 2
+	Good  Rating = 3
+	Easy  Rating = 4
+)
+
+// Ratings lists every rating in grading order.
+var Ratings = []Rating{Again, Hard, Good, Easy}
+
+// String returns the rating's name.
+func (r Rating) String() string {
+	switch r {
+	case Again:
+		return "Again"
+	case Hard:
+		return "Hard"
+	case Good:
+		return "Good"
+	case Easy:
+		return "Easy"
+	}
+	return fmt.Sprintf("Rating(%d)", int(r))
+}
+
+// Valid reports whether r is one of the four ratings.
+func (r Rating) Valid() bool {
+	return r >= Again && r <= Easy
+}
+
+// Recalled reports whether the rating counts as remembering the card.
+func (r Rating) Recalled() bool {
+	return r >= Good
+}
+
+const (
+	defaultEase = 2.5
+	minEase     = 1.3
+)
+
+// Schedule is a card's SM-2 spaced repetition state.
+type Schedule struct {
+	EaseFactor   float64
+	IntervalDays int
+	Repetitions  int
+	NextReview   time.Time // Day the card is next due, at local midnight
+	LastReview   *time.Time
+}
+
+// NewSchedule returns the schedule of a card never reviewed: due today.
+func NewSchedule(now time.Time) Schedule {
+	return Schedule{
+		EaseFactor:   defaultEase,
+		IntervalDays: 1,
+		NextReview:   startOfDay(now),
+	}
+}
+
+// Due reports whether the card is due on now's day.
+func (s Schedule) Due(now time.Time) bool {
+	return !s.NextReview.After(now)
+}
+
+// Review returns the schedule after grading the card at now. Good and
+// Easy grow the interval (1 day, then 6, then by the ease factor); Again
+// and Hard start the card over. The ease factor moves with every rating
+// and never drops below 1.3.
+func (s Schedule) Review(rating Rating, now time.Time) Schedule {
+	next := s
+	if rating.Recalled() {
+		switch next.Repetitions {
+		case 0:
+			next.IntervalDays = 1
+		case 1:
+			next.IntervalDays = 6
+		default:
+			next.IntervalDays = int(float64(next.IntervalDays) * next.EaseFactor)
+		}
+		next.Repetitions++
+	} else {
+		next.IntervalDays = 1
+		next.Repetitions = 0
+	}
+
+	miss := float64(Good - rating)
+	next.EaseFactor += 0.1 - miss*(0.08+miss*0.02)
+	if next.EaseFactor < minEase {
+		next.EaseFactor = minEase
+	}
+
+	reviewed := now
+	next.LastReview = &reviewed
+	next.NextReview = startOfDay(now).AddDate(0, 0, next.IntervalDays)
+	return next
+}
+
+// startOfDay returns local midnight on t's day.
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
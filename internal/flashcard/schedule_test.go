@@ -0,0 +1,53 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package flashcard
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchedule_Review_Progression(t *testing.T) {
+	now := time.Date(2025, 3, 10, 14, 30, 0, 0, time.Local)
+	s := NewSchedule(now)
+	assert.True(t, s.Due(now))
+
+	s = s.Review(Good, now)
+	assert.Equal(t, 1, s.IntervalDays)
+	assert.Equal(t, time.Date(2025, 3, 11, 0, 0, 0, 0, time.Local), s.NextReview)
+	assert.False(t, s.Due(now))
+	assert.InDelta(t, 2.6, s.EaseFactor, 0.001)
+
+	s = s.Review(Good, now)
+	assert.Equal(t, 6, s.IntervalDays)
+	assert.Equal(t, 2, s.Repetitions)
+
+	s = s.Review(Easy, now)
+	assert.Equal(t, 16, s.IntervalDays, "6 days times an ease of 2.7")
+	assert.InDelta(t, 2.86, s.EaseFactor, 0.001)
+
+	s = s.Review(Hard, now)
+	assert.Equal(t, 1, s.IntervalDays, "a hard card starts over")
+	assert.Equal(t, 0, s.Repetitions)
+	assert.InDelta(t, 2.86, s.EaseFactor, 0.001, "hard leaves the ease unchanged")
+}
+
+func TestSchedule_Review_EaseFloor(t *testing.T) {
+	now := time.Now()
+	s := NewSchedule(now)
+	for i := 0; i < 10; i++ {
+		s = s.Review(Again, now)
+	}
+	assert.Equal(t, minEase, s.EaseFactor)
+	assert.NotNil(t, s.LastReview)
+}
+
+func TestRating(t *testing.T) {
+	assert.Equal(t, "Hard", Hard.String())
+	assert.True(t, Good.Recalled())
+	assert.False(t, Hard.Recalled())
+	assert.False(t, Rating(5).Valid())
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+// Package jobs provides a persistent background job queue.
+//
+// Features that need work done outside the foreground command (background
+// plan generation, scheduled reports, backups, sync) enqueue a Job instead of
+// spawning their own goroutines. A Worker claims due jobs, dispatches them to
+// registered handlers, and reschedules failures with exponential backoff.
+package jobs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrJobNotFound is returned, wrapped with the job ID, when no job has the
+// requested ID.
+var ErrJobNotFound = errors.New("job not found")
+
+// ErrLeaseLost is returned when a worker no longer holds the job it is
+// running: its lease ran out and the job was requeued.
+var ErrLeaseLost = errors.New("job lease lost")
+
+// Status represents the lifecycle state of a job.
+type Status string
+
+const (
+	// StatusPending means the job is waiting for its run time.
+	StatusPending Status = "pending"
+	// StatusRunning means a worker has claimed the job.
+	StatusRunning Status = "running"
+	// StatusSucceeded means the handler completed without error.
+	StatusSucceeded Status = "succeeded"
+	// StatusFailed means the job exhausted its attempts.
+	StatusFailed Status = "failed"
+	// StatusCancelled means the job was cancelled before it ran.
+	StatusCancelled Status = "cancelled"
+)
+
+const (
+	// DefaultMaxAttempts is the number of attempts before a job is marked failed.
+	DefaultMaxAttempts = 5
+
+	// BaseBackoff is the delay before the first retry.
+	BaseBackoff = 30 * time.Second
+
+	// MaxBackoff caps the delay between retries.
+	MaxBackoff = time.Hour
+
+	// LeaseDuration is how long a claimed job stays with its worker
+	// without a renewal. Workers renew it while the handler runs.
+	LeaseDuration = 2 * time.Minute
+)
+
+// Job is a unit of background work.
+type Job struct {
+	ID          string    `json:"id"`
+	Type        string    `json:"type"`
+	Payload     string    `json:"payload,omitempty"` // JSON-encoded handler input
+	Status      Status    `json:"status"`
+	Attempts    int       `json:"attempts"`
+	MaxAttempts int       `json:"max_attempts"`
+	LastError   string    `json:"last_error,omitempty"`
+	RunAt       time.Time `json:"run_at"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+
+	// The worker running the job, and until when; empty unless running
+	Owner      string     `json:"owner,omitempty"`
+	LeaseUntil *time.Time `json:"lease_until,omitempty"`
+}
+
+// Validate checks that the job has all required fields.
+func (j *Job) Validate() error {
+	if j.ID == "" {
+		return fmt.Errorf("job ID is required")
+	}
+	if j.Type == "" {
+		return fmt.Errorf("job type is required")
+	}
+	if !isValidStatus(j.Status) {
+		return fmt.Errorf("invalid job status: %s", j.Status)
+	}
+	if j.MaxAttempts < 1 {
+		return fmt.Errorf("max attempts must be at least 1")
+	}
+	if j.Attempts < 0 {
+		return fmt.Errorf("attempts cannot be negative")
+	}
+	return nil
+}
+
+// DecodePayload unmarshals the job payload into v.
+func (j *Job) DecodePayload(v interface{}) error {
+	if j.Payload == "" {
+		return fmt.Errorf("job %s has no payload", j.ID)
+	}
+	if err := json.Unmarshal([]byte(j.Payload), v); err != nil {
+		return fmt.Errorf("failed to decode payload: %w", err)
+	}
+	return nil
+}
+
+// IsTerminal reports whether the job will not run again without a retry.
+func (j *Job) IsTerminal() bool {
+	return j.Status == StatusSucceeded || j.Status == StatusFailed || j.Status == StatusCancelled
+}
+
+// Backoff returns the delay before the next attempt after the given number
+// of failed attempts. The delay doubles with each attempt, capped at MaxBackoff.
+func Backoff(attempts int) time.Duration {
+	if attempts < 1 {
+		return 0
+	}
+
+	delay := BaseBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= MaxBackoff {
+			return MaxBackoff
+		}
+	}
+	return delay
+}
+
+// isValidStatus checks if a status value is known.
+func isValidStatus(s Status) bool {
+	switch s {
+	case StatusPending, StatusRunning, StatusSucceeded, StatusFailed, StatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
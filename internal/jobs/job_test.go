@@ -0,0 +1,76 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJob_Validate(t *testing.T) {
+	valid := func() *Job {
+		return &Job{ID: "job-1", Type: "report", Status: StatusPending, MaxAttempts: 3}
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(j *Job)
+		wantErr string
+	}{
+		{"valid", func(_ *Job) {}, ""},
+		{"missing ID", func(j *Job) { j.ID = "" }, "job ID is required"},
+		{"missing type", func(j *Job) { j.Type = "" }, "job type is required"},
+		{"unknown status", func(j *Job) { j.Status = "bogus" }, "invalid job status"},
+		{"zero max attempts", func(j *Job) { j.MaxAttempts = 0 }, "max attempts"},
+		{"negative attempts", func(j *Job) { j.Attempts = -1 }, "attempts cannot be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := valid()
+			tt.mutate(job)
+			err := job.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestJob_DecodePayload(t *testing.T) {
+	job := &Job{ID: "job-1", Payload: `{"topic":"rust","hours":20}`}
+
+	var payload struct {
+		Topic string  `json:"topic"`
+		Hours float64 `json:"hours"`
+	}
+	require.NoError(t, job.DecodePayload(&payload))
+	assert.Equal(t, "rust", payload.Topic)
+	assert.InDelta(t, 20.0, payload.Hours, 0.001)
+
+	empty := &Job{ID: "job-2"}
+	assert.Error(t, empty.DecodePayload(&payload))
+}
+
+func TestJob_IsTerminal(t *testing.T) {
+	assert.False(t, (&Job{Status: StatusPending}).IsTerminal())
+	assert.False(t, (&Job{Status: StatusRunning}).IsTerminal())
+	assert.True(t, (&Job{Status: StatusSucceeded}).IsTerminal())
+	assert.True(t, (&Job{Status: StatusFailed}).IsTerminal())
+	assert.True(t, (&Job{Status: StatusCancelled}).IsTerminal())
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, time.Duration(0), Backoff(0))
+	assert.Equal(t, 30*time.Second, Backoff(1))
+	assert.Equal(t, time.Minute, Backoff(2))
+	assert.Equal(t, 2*time.Minute, Backoff(3))
+	assert.Equal(t, MaxBackoff, Backoff(20), "backoff should be capped")
+}
@@ -0,0 +1,411 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+)
+
+// Filter contains criteria for listing jobs.
+type Filter struct {
+	Statuses []Status
+	Type     string
+	Limit    int
+}
+
+// Repository defines the interface for job persistence.
+type Repository interface {
+	// Create inserts a new job.
+	Create(ctx context.Context, job *Job) error
+
+	// Get retrieves a job by ID.
+	Get(ctx context.Context, id string) (*Job, error)
+
+	// Update updates an existing job.
+	Update(ctx context.Context, job *Job) error
+
+	// List retrieves jobs matching the filter, newest first.
+	List(ctx context.Context, filter Filter) ([]*Job, error)
+
+	// ClaimNext atomically marks the oldest due pending job as running
+	// for owner until leaseUntil, incrementing its attempt count. Returns
+	// nil if no job is due.
+	ClaimNext(ctx context.Context, owner string, now, leaseUntil time.Time) (*Job, error)
+
+	// Claim marks the job with id as running for owner, like ClaimNext,
+	// if it is pending and due. Returns nil otherwise.
+	Claim(ctx context.Context, id, owner string, now, leaseUntil time.Time) (*Job, error)
+
+	// RenewLease extends owner's lease on a running job to until. Returns
+	// ErrLeaseLost if owner no longer holds the job.
+	RenewLease(ctx context.Context, id, owner string, until time.Time) error
+
+	// Finish records the outcome of owner's run of a job and releases it.
+	// Returns ErrLeaseLost if owner no longer holds the job.
+	Finish(ctx context.Context, job *Job, owner string) error
+
+	// RequeueRunning moves running jobs whose lease ran out by now back to
+	// pending, since their worker crashed or stopped mid-job. Returns the
+	// number of jobs requeued.
+	RequeueRunning(ctx context.Context, now time.Time) (int, error)
+}
+
+// SQLiteRepository implements job storage using SQLite.
+type SQLiteRepository struct {
+	db *storage.SQLiteDB
+}
+
+// NewSQLiteRepository creates a new SQLite-backed job repository.
+func NewSQLiteRepository(db *storage.SQLiteDB) Repository {
+	return &SQLiteRepository{db: db}
+}
+
+const jobColumns = `id, type, payload, status, attempts, max_attempts,
+	last_error, run_at, created_at, updated_at, owner, lease_until`
+
+// Create inserts a new job into the database.
+func (r *SQLiteRepository) Create(ctx context.Context, job *Job) error {
+	if err := job.Validate(); err != nil {
+		return fmt.Errorf("invalid job: %w", err)
+	}
+
+	query := `
+		INSERT INTO jobs (` + jobColumns + `)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	_, err := r.db.DB().ExecContext(ctx, query,
+		job.ID,
+		job.Type,
+		job.Payload,
+		string(job.Status),
+		job.Attempts,
+		job.MaxAttempts,
+		nullString(job.LastError),
+		job.RunAt.UTC(),
+		job.CreatedAt.UTC(),
+		job.UpdatedAt.UTC(),
+		nullString(job.Owner),
+		nullTime(job.LeaseUntil),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create job: %w", err)
+	}
+
+	return nil
+}
+
+// Get retrieves a job by ID.
+func (r *SQLiteRepository) Get(ctx context.Context, id string) (*Job, error) {
+	query := `SELECT ` + jobColumns + ` FROM jobs WHERE id = ?`
+
+	job, err := scanJob(r.db.DB().QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", ErrJobNotFound, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+
+	return job, nil
+}
+
+// Update updates an existing job.
+func (r *SQLiteRepository) Update(ctx context.Context, job *Job) error {
+	if err := job.Validate(); err != nil {
+		return fmt.Errorf("invalid job: %w", err)
+	}
+
+	query := `
+		UPDATE jobs
+		SET type = ?, payload = ?, status = ?, attempts = ?, max_attempts = ?,
+			last_error = ?, run_at = ?, updated_at = ?, owner = ?, lease_until = ?
+		WHERE id = ?
+	`
+
+	result, err := r.db.DB().ExecContext(ctx, query,
+		job.Type,
+		job.Payload,
+		string(job.Status),
+		job.Attempts,
+		job.MaxAttempts,
+		nullString(job.LastError),
+		job.RunAt.UTC(),
+		job.UpdatedAt.UTC(),
+		nullString(job.Owner),
+		nullTime(job.LeaseUntil),
+		job.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update job: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: %s", ErrJobNotFound, job.ID)
+	}
+
+	return nil
+}
+
+// List retrieves jobs matching the filter, newest first.
+func (r *SQLiteRepository) List(ctx context.Context, filter Filter) ([]*Job, error) {
+	var conditions []string
+	var args []interface{}
+
+	if len(filter.Statuses) > 0 {
+		placeholders := make([]string, len(filter.Statuses))
+		for i, status := range filter.Statuses {
+			placeholders[i] = "?"
+			args = append(args, string(status))
+		}
+		conditions = append(conditions, fmt.Sprintf("status IN (%s)", strings.Join(placeholders, ", ")))
+	}
+
+	if filter.Type != "" {
+		conditions = append(conditions, "type = ?")
+		args = append(args, filter.Type)
+	}
+
+	query := `SELECT ` + jobColumns + ` FROM jobs`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY created_at DESC"
+	if filter.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, filter.Limit)
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+	defer rows.Close()
+
+	jobs := make([]*Job, 0)
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan job: %w", err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating jobs: %w", err)
+	}
+
+	return jobs, nil
+}
+
+// ClaimNext atomically marks the oldest due pending job as running for
+// owner.
+func (r *SQLiteRepository) ClaimNext(ctx context.Context, owner string, now, leaseUntil time.Time) (*Job, error) {
+	return r.claim(ctx, "", owner, now, leaseUntil)
+}
+
+// Claim marks the job with id as running for owner if it is pending and
+// due.
+func (r *SQLiteRepository) Claim(ctx context.Context, id, owner string, now, leaseUntil time.Time) (*Job, error) {
+	return r.claim(ctx, id, owner, now, leaseUntil)
+}
+
+// claim marks the oldest due pending job as running for owner, or only
+// the job with id when id is set.
+func (r *SQLiteRepository) claim(ctx context.Context, id, owner string, now, leaseUntil time.Time) (*Job, error) {
+	tx, err := r.db.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		// Ignore error on rollback - it's expected if commit succeeded
+		//nolint:errcheck // Transaction cleanup
+		tx.Rollback()
+	}()
+
+	query := `SELECT ` + jobColumns + ` FROM jobs WHERE status = ? AND run_at <= ?`
+	args := []interface{}{string(StatusPending), now.UTC()}
+	if id != "" {
+		query += " AND id = ?"
+		args = append(args, id)
+	}
+	query += " ORDER BY run_at ASC, created_at ASC LIMIT 1"
+
+	job, err := scanJob(tx.QueryRowContext(ctx, query, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil // Nothing due is not an error
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find due job: %w", err)
+	}
+
+	job.Status = StatusRunning
+	job.Attempts++
+	job.UpdatedAt = now
+	job.Owner = owner
+	job.LeaseUntil = &leaseUntil
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE jobs SET status = ?, attempts = ?, updated_at = ?, owner = ?, lease_until = ? WHERE id = ?",
+		string(job.Status), job.Attempts, job.UpdatedAt.UTC(), owner, leaseUntil.UTC(), job.ID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim job: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return job, nil
+}
+
+// RenewLease extends owner's lease on a running job.
+func (r *SQLiteRepository) RenewLease(ctx context.Context, id, owner string, until time.Time) error {
+	result, err := r.db.DB().ExecContext(ctx,
+		"UPDATE jobs SET lease_until = ? WHERE id = ? AND status = ? AND owner = ?",
+		until.UTC(), id, string(StatusRunning), owner,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to renew job lease: %w", err)
+	}
+	return leaseHeld(result, id)
+}
+
+// Finish records the outcome of owner's run of a job and releases it.
+func (r *SQLiteRepository) Finish(ctx context.Context, job *Job, owner string) error {
+	if err := job.Validate(); err != nil {
+		return fmt.Errorf("invalid job: %w", err)
+	}
+
+	query := `
+		UPDATE jobs
+		SET status = ?, attempts = ?, last_error = ?, run_at = ?, updated_at = ?,
+			owner = NULL, lease_until = NULL
+		WHERE id = ? AND status = ? AND owner = ?
+	`
+
+	result, err := r.db.DB().ExecContext(ctx, query,
+		string(job.Status),
+		job.Attempts,
+		nullString(job.LastError),
+		job.RunAt.UTC(),
+		job.UpdatedAt.UTC(),
+		job.ID,
+		string(StatusRunning),
+		owner,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update job: %w", err)
+	}
+	return leaseHeld(result, job.ID)
+}
+
+// leaseHeld returns ErrLeaseLost unless an update guarded by the job's
+// owner changed a row.
+func leaseHeld(result sql.Result, id string) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: %s", ErrLeaseLost, id)
+	}
+	return nil
+}
+
+// RequeueRunning moves running jobs whose lease ran out back to pending.
+// Jobs claimed before leases existed have none and count as expired.
+func (r *SQLiteRepository) RequeueRunning(ctx context.Context, now time.Time) (int, error) {
+	result, err := r.db.DB().ExecContext(ctx,
+		`UPDATE jobs SET status = ?, run_at = ?, updated_at = ?, owner = NULL, lease_until = NULL
+		WHERE status = ? AND (lease_until IS NULL OR lease_until < ?)`,
+		string(StatusPending), now.UTC(), now.UTC(), string(StatusRunning), now.UTC(),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to requeue running jobs: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanJob scans a single job from a database row.
+func scanJob(row rowScanner) (*Job, error) {
+	var job Job
+	var payload sql.NullString
+	var status string
+	var lastError sql.NullString
+	var owner sql.NullString
+	var leaseUntil sql.NullTime
+
+	err := row.Scan(
+		&job.ID,
+		&job.Type,
+		&payload,
+		&status,
+		&job.Attempts,
+		&job.MaxAttempts,
+		&lastError,
+		&job.RunAt,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+		&owner,
+		&leaseUntil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	job.Status = Status(status)
+	if payload.Valid {
+		job.Payload = payload.String
+	}
+	if lastError.Valid {
+		job.LastError = lastError.String
+	}
+	job.Owner = owner.String
+	if leaseUntil.Valid {
+		job.LeaseUntil = &leaseUntil.Time
+	}
+
+	return &job, nil
+}
+
+// nullString converts a string to sql.NullString.
+func nullString(s string) sql.NullString {
+	if s == "" {
+		return sql.NullString{Valid: false}
+	}
+	return sql.NullString{String: s, Valid: true}
+}
+
+// nullTime converts an optional time to sql.NullTime, in UTC.
+func nullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: t.UTC(), Valid: true}
+}
@@ -0,0 +1,230 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package jobs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestDB(t *testing.T) *storage.SQLiteDB {
+	t.Helper()
+
+	db, err := storage.NewSQLiteDB(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	require.NoError(t, storage.NewMigrator(db).Migrate())
+
+	return db
+}
+
+func newTestJob(id string, runAt time.Time) *Job {
+	return &Job{
+		ID:          id,
+		Type:        "test",
+		Payload:     `{"n":1}`,
+		Status:      StatusPending,
+		MaxAttempts: DefaultMaxAttempts,
+		RunAt:       runAt,
+		CreatedAt:   runAt,
+		UpdatedAt:   runAt,
+	}
+}
+
+func TestSQLiteRepository_CreateAndGet(t *testing.T) {
+	repo := NewSQLiteRepository(setupTestDB(t))
+	ctx := context.Background()
+	now := time.Now()
+
+	job := newTestJob("job-1", now)
+	job.LastError = "previous failure"
+	require.NoError(t, repo.Create(ctx, job))
+
+	got, err := repo.Get(ctx, "job-1")
+	require.NoError(t, err)
+	assert.Equal(t, "test", got.Type)
+	assert.Equal(t, `{"n":1}`, got.Payload)
+	assert.Equal(t, StatusPending, got.Status)
+	assert.Equal(t, "previous failure", got.LastError)
+	assert.WithinDuration(t, now, got.RunAt, time.Second)
+}
+
+func TestSQLiteRepository_Get_NotFound(t *testing.T) {
+	repo := NewSQLiteRepository(setupTestDB(t))
+
+	_, err := repo.Get(context.Background(), "missing")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrJobNotFound)
+}
+
+func TestSQLiteRepository_Update(t *testing.T) {
+	repo := NewSQLiteRepository(setupTestDB(t))
+	ctx := context.Background()
+
+	job := newTestJob("job-1", time.Now())
+	require.NoError(t, repo.Create(ctx, job))
+
+	job.Status = StatusFailed
+	job.LastError = "boom"
+	require.NoError(t, repo.Update(ctx, job))
+
+	got, err := repo.Get(ctx, "job-1")
+	require.NoError(t, err)
+	assert.Equal(t, StatusFailed, got.Status)
+	assert.Equal(t, "boom", got.LastError)
+
+	missing := newTestJob("missing", time.Now())
+	assert.Error(t, repo.Update(ctx, missing))
+}
+
+func TestSQLiteRepository_List_Filters(t *testing.T) {
+	repo := NewSQLiteRepository(setupTestDB(t))
+	ctx := context.Background()
+	base := time.Now().Add(-time.Hour)
+
+	for i, status := range []Status{StatusPending, StatusFailed, StatusSucceeded} {
+		job := newTestJob(string(status), base.Add(time.Duration(i)*time.Minute))
+		job.Status = status
+		require.NoError(t, repo.Create(ctx, job))
+	}
+
+	all, err := repo.List(ctx, Filter{})
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	assert.Equal(t, "succeeded", all[0].ID, "newest job should be first")
+
+	failed, err := repo.List(ctx, Filter{Statuses: []Status{StatusFailed}})
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	assert.Equal(t, StatusFailed, failed[0].Status)
+
+	limited, err := repo.List(ctx, Filter{Limit: 2})
+	require.NoError(t, err)
+	assert.Len(t, limited, 2)
+
+	byType, err := repo.List(ctx, Filter{Type: "other"})
+	require.NoError(t, err)
+	assert.Empty(t, byType)
+}
+
+func TestSQLiteRepository_ClaimNext(t *testing.T) {
+	repo := NewSQLiteRepository(setupTestDB(t))
+	ctx := context.Background()
+	now := time.Now()
+
+	require.NoError(t, repo.Create(ctx, newTestJob("future", now.Add(time.Hour))))
+	require.NoError(t, repo.Create(ctx, newTestJob("due-later", now.Add(-time.Minute))))
+	require.NoError(t, repo.Create(ctx, newTestJob("due-first", now.Add(-time.Hour))))
+
+	job, err := repo.ClaimNext(ctx, "w1", now, now.Add(LeaseDuration))
+	require.NoError(t, err)
+	require.NotNil(t, job)
+	assert.Equal(t, "due-first", job.ID)
+	assert.Equal(t, StatusRunning, job.Status)
+	assert.Equal(t, 1, job.Attempts)
+
+	stored, err := repo.Get(ctx, "due-first")
+	require.NoError(t, err)
+	assert.Equal(t, StatusRunning, stored.Status)
+
+	job, err = repo.ClaimNext(ctx, "w1", now, now.Add(LeaseDuration))
+	require.NoError(t, err)
+	require.NotNil(t, job)
+	assert.Equal(t, "due-later", job.ID)
+
+	job, err = repo.ClaimNext(ctx, "w1", now, now.Add(LeaseDuration))
+	require.NoError(t, err)
+	assert.Nil(t, job, "future job should not be claimed")
+}
+
+func TestSQLiteRepository_Claim(t *testing.T) {
+	repo := NewSQLiteRepository(setupTestDB(t))
+	ctx := context.Background()
+	now := time.Now()
+
+	require.NoError(t, repo.Create(ctx, newTestJob("due-first", now.Add(-time.Hour))))
+	require.NoError(t, repo.Create(ctx, newTestJob("due-later", now.Add(-time.Minute))))
+	require.NoError(t, repo.Create(ctx, newTestJob("future", now.Add(time.Hour))))
+
+	job, err := repo.Claim(ctx, "due-later", "w1", now, now.Add(LeaseDuration))
+	require.NoError(t, err)
+	require.NotNil(t, job)
+	assert.Equal(t, "due-later", job.ID, "only the named job is claimed")
+	assert.Equal(t, StatusRunning, job.Status)
+	assert.Equal(t, "w1", job.Owner)
+
+	job, err = repo.Claim(ctx, "due-later", "w2", now, now.Add(LeaseDuration))
+	require.NoError(t, err)
+	assert.Nil(t, job, "a running job should not be claimed again")
+
+	job, err = repo.Claim(ctx, "future", "w1", now, now.Add(LeaseDuration))
+	require.NoError(t, err)
+	assert.Nil(t, job, "future job should not be claimed")
+
+	stored, err := repo.Get(ctx, "due-first")
+	require.NoError(t, err)
+	assert.Equal(t, StatusPending, stored.Status)
+}
+
+func TestSQLiteRepository_RequeueRunning(t *testing.T) {
+	repo := NewSQLiteRepository(setupTestDB(t))
+	ctx := context.Background()
+	now := time.Now()
+
+	require.NoError(t, repo.Create(ctx, newTestJob("job-1", now)))
+	_, err := repo.ClaimNext(ctx, "w1", now, now.Add(LeaseDuration))
+	require.NoError(t, err)
+
+	count, err := repo.RequeueRunning(ctx, now)
+	require.NoError(t, err)
+	assert.Zero(t, count, "a live lease keeps the job with its worker")
+
+	later := now.Add(LeaseDuration + time.Second)
+	count, err = repo.RequeueRunning(ctx, later)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	got, err := repo.Get(ctx, "job-1")
+	require.NoError(t, err)
+	assert.Equal(t, StatusPending, got.Status)
+	assert.Empty(t, got.Owner)
+	assert.Nil(t, got.LeaseUntil)
+}
+
+func TestSQLiteRepository_Lease(t *testing.T) {
+	repo := NewSQLiteRepository(setupTestDB(t))
+	ctx := context.Background()
+	now := time.Now()
+
+	require.NoError(t, repo.Create(ctx, newTestJob("job-1", now)))
+	job, err := repo.ClaimNext(ctx, "w1", now, now.Add(LeaseDuration))
+	require.NoError(t, err)
+	assert.Equal(t, "w1", job.Owner)
+
+	stored, err := repo.Get(ctx, "job-1")
+	require.NoError(t, err)
+	assert.Equal(t, "w1", stored.Owner)
+	require.NotNil(t, stored.LeaseUntil)
+	assert.WithinDuration(t, now.Add(LeaseDuration), *stored.LeaseUntil, time.Second)
+
+	renewed := now.Add(2 * LeaseDuration)
+	require.NoError(t, repo.RenewLease(ctx, "job-1", "w1", renewed))
+	assert.ErrorIs(t, repo.RenewLease(ctx, "job-1", "w2", renewed), ErrLeaseLost)
+
+	// Only the owner records the outcome
+	job.Status = StatusSucceeded
+	assert.ErrorIs(t, repo.Finish(ctx, job, "w2"), ErrLeaseLost)
+	require.NoError(t, repo.Finish(ctx, job, "w1"))
+	stored, err = repo.Get(ctx, "job-1")
+	require.NoError(t, err)
+	assert.Equal(t, StatusSucceeded, stored.Status)
+	assert.Empty(t, stored.Owner)
+	assert.ErrorIs(t, repo.Finish(ctx, job, "w1"), ErrLeaseLost, "already finished")
+}
@@ -0,0 +1,230 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Service provides business logic for the job queue.
+type Service struct {
+	repo Repository
+	now  func() time.Time
+}
+
+// NewService creates a new job service.
+func NewService(repo Repository) *Service {
+	return &Service{
+		repo: repo,
+		now:  time.Now,
+	}
+}
+
+// EnqueueRequest contains parameters for enqueuing a job.
+type EnqueueRequest struct {
+	Type        string
+	Payload     interface{} // Marshaled to JSON; may be nil
+	RunAt       time.Time   // Optional; zero means run as soon as possible
+	MaxAttempts int         // Optional; zero means DefaultMaxAttempts
+}
+
+// Enqueue adds a new pending job to the queue.
+func (s *Service) Enqueue(ctx context.Context, req EnqueueRequest) (*Job, error) {
+	if req.Type == "" {
+		return nil, fmt.Errorf("job type cannot be empty")
+	}
+
+	var payload string
+	if req.Payload != nil {
+		data, err := json.Marshal(req.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal payload: %w", err)
+		}
+		payload = string(data)
+	}
+
+	now := s.now()
+	runAt := req.RunAt
+	if runAt.IsZero() {
+		runAt = now
+	}
+
+	maxAttempts := req.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+
+	job := &Job{
+		ID:          uuid.New().String(),
+		Type:        req.Type,
+		Payload:     payload,
+		Status:      StatusPending,
+		MaxAttempts: maxAttempts,
+		RunAt:       runAt,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := s.repo.Create(ctx, job); err != nil {
+		return nil, fmt.Errorf("failed to enqueue job: %w", err)
+	}
+
+	return job, nil
+}
+
+// EnqueueUnique returns the pending or running job of req's type if
+// there is one, and otherwise enqueues req. Work that any run of its type
+// covers, such as a backup, is then queued only once.
+func (s *Service) EnqueueUnique(ctx context.Context, req EnqueueRequest) (*Job, error) {
+	queued, err := s.repo.List(ctx, Filter{
+		Statuses: []Status{StatusPending, StatusRunning},
+		Type:     req.Type,
+		Limit:    1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list queued jobs: %w", err)
+	}
+	if len(queued) > 0 {
+		return queued[0], nil
+	}
+	return s.Enqueue(ctx, req)
+}
+
+// Get retrieves a job by ID.
+func (s *Service) Get(ctx context.Context, id string) (*Job, error) {
+	return s.repo.Get(ctx, id)
+}
+
+// List retrieves jobs matching the filter.
+func (s *Service) List(ctx context.Context, filter Filter) ([]*Job, error) {
+	return s.repo.List(ctx, filter)
+}
+
+// Retry resets a failed or cancelled job so it runs again immediately
+// with a fresh attempt budget. The last error is kept for reference.
+func (s *Service) Retry(ctx context.Context, id string) (*Job, error) {
+	job, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.Status != StatusFailed && job.Status != StatusCancelled {
+		return nil, fmt.Errorf("cannot retry job in %s state (only failed or cancelled jobs can be retried)", job.Status)
+	}
+
+	now := s.now()
+	job.Status = StatusPending
+	job.Attempts = 0
+	job.RunAt = now
+	job.UpdatedAt = now
+
+	if err := s.repo.Update(ctx, job); err != nil {
+		return nil, fmt.Errorf("failed to retry job: %w", err)
+	}
+
+	return job, nil
+}
+
+// Cancel stops a pending job from running. Running and finished jobs
+// cannot be cancelled.
+func (s *Service) Cancel(ctx context.Context, id string) (*Job, error) {
+	job, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.Status != StatusPending {
+		return nil, fmt.Errorf("cannot cancel job in %s state (only pending jobs can be cancelled)", job.Status)
+	}
+
+	job.Status = StatusCancelled
+	job.UpdatedAt = s.now()
+
+	if err := s.repo.Update(ctx, job); err != nil {
+		return nil, fmt.Errorf("failed to cancel job: %w", err)
+	}
+
+	return job, nil
+}
+
+// Claim returns the next due job, marked as running for owner for a
+// LeaseDuration, or nil if none is due.
+func (s *Service) Claim(ctx context.Context, owner string) (*Job, error) {
+	now := s.now()
+	return s.repo.ClaimNext(ctx, owner, now, now.Add(LeaseDuration))
+}
+
+// ClaimJob returns the job with id, marked as running for owner like
+// Claim, or nil if it is not pending and due.
+func (s *Service) ClaimJob(ctx context.Context, id, owner string) (*Job, error) {
+	now := s.now()
+	return s.repo.Claim(ctx, id, owner, now, now.Add(LeaseDuration))
+}
+
+// RenewLease keeps a running job with its owner for another
+// LeaseDuration. It returns ErrLeaseLost once the job was requeued.
+func (s *Service) RenewLease(ctx context.Context, job *Job) error {
+	until := s.now().Add(LeaseDuration)
+	if err := s.repo.RenewLease(ctx, job.ID, job.Owner, until); err != nil {
+		return err
+	}
+	job.LeaseUntil = &until
+	return nil
+}
+
+// Complete marks a running job as succeeded.
+func (s *Service) Complete(ctx context.Context, job *Job) error {
+	job.Status = StatusSucceeded
+	job.LastError = ""
+	job.UpdatedAt = s.now()
+
+	if err := s.finish(ctx, job); err != nil {
+		return fmt.Errorf("failed to complete job: %w", err)
+	}
+
+	return nil
+}
+
+// Fail records a handler error. The job is rescheduled with exponential
+// backoff until it exhausts MaxAttempts, after which it is marked failed.
+func (s *Service) Fail(ctx context.Context, job *Job, cause error) error {
+	now := s.now()
+	job.LastError = cause.Error()
+	job.UpdatedAt = now
+
+	if job.Attempts >= job.MaxAttempts {
+		job.Status = StatusFailed
+	} else {
+		job.Status = StatusPending
+		job.RunAt = now.Add(Backoff(job.Attempts))
+	}
+
+	if err := s.finish(ctx, job); err != nil {
+		return fmt.Errorf("failed to record job failure: %w", err)
+	}
+
+	return nil
+}
+
+// finish saves the outcome of a run and releases the job, unless its
+// owner lost it in the meantime.
+func (s *Service) finish(ctx context.Context, job *Job) error {
+	if err := s.repo.Finish(ctx, job, job.Owner); err != nil {
+		return err
+	}
+	job.Owner, job.LeaseUntil = "", nil
+	return nil
+}
+
+// RecoverOrphaned requeues running jobs whose lease ran out: their worker
+// exited, or stopped renewing, without finishing them. Jobs a live worker
+// is running are left alone.
+func (s *Service) RecoverOrphaned(ctx context.Context) (int, error) {
+	return s.repo.RequeueRunning(ctx, s.now())
+}
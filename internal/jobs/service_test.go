@@ -0,0 +1,140 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestService returns a service whose clock can be advanced by the test.
+func newTestService(t *testing.T) (*Service, *time.Time) {
+	t.Helper()
+
+	svc := NewService(NewSQLiteRepository(setupTestDB(t)))
+	clock := time.Now()
+	svc.now = func() time.Time { return clock }
+
+	return svc, &clock
+}
+
+func TestService_Enqueue(t *testing.T) {
+	svc, clock := newTestService(t)
+	ctx := context.Background()
+
+	job, err := svc.Enqueue(ctx, EnqueueRequest{
+		Type:    "plan.generate",
+		Payload: map[string]string{"topic": "rust"},
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, job.ID)
+	assert.Equal(t, StatusPending, job.Status)
+	assert.Equal(t, DefaultMaxAttempts, job.MaxAttempts)
+	assert.Equal(t, `{"topic":"rust"}`, job.Payload)
+	assert.True(t, job.RunAt.Equal(*clock))
+
+	_, err = svc.Enqueue(ctx, EnqueueRequest{})
+	assert.Error(t, err, "empty type should be rejected")
+}
+
+func TestService_EnqueueUnique(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	first, err := svc.EnqueueUnique(ctx, EnqueueRequest{Type: "db.backup"})
+	require.NoError(t, err)
+	again, err := svc.EnqueueUnique(ctx, EnqueueRequest{Type: "db.backup"})
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, again.ID, "a queued job of the type is reused")
+
+	other, err := svc.EnqueueUnique(ctx, EnqueueRequest{Type: "sync.commit"})
+	require.NoError(t, err)
+	assert.NotEqual(t, first.ID, other.ID)
+
+	job, err := svc.Claim(ctx, "w1")
+	require.NoError(t, err)
+	require.NoError(t, svc.Complete(ctx, job))
+	next, err := svc.EnqueueUnique(ctx, EnqueueRequest{Type: job.Type})
+	require.NoError(t, err)
+	assert.NotEqual(t, job.ID, next.ID, "finished jobs are not reused")
+}
+
+func TestService_Fail_BacksOffThenFails(t *testing.T) {
+	svc, clock := newTestService(t)
+	ctx := context.Background()
+
+	_, err := svc.Enqueue(ctx, EnqueueRequest{Type: "sync", MaxAttempts: 2})
+	require.NoError(t, err)
+
+	job, err := svc.Claim(ctx, "w1")
+	require.NoError(t, err)
+	require.NotNil(t, job)
+
+	require.NoError(t, svc.Fail(ctx, job, errors.New("network down")))
+	assert.Equal(t, StatusPending, job.Status)
+	assert.Equal(t, "network down", job.LastError)
+	assert.True(t, job.RunAt.Equal(clock.Add(BaseBackoff)))
+
+	// Not due until the backoff elapses
+	next, err := svc.Claim(ctx, "w1")
+	require.NoError(t, err)
+	assert.Nil(t, next)
+
+	*clock = clock.Add(BaseBackoff)
+	job, err = svc.Claim(ctx, "w1")
+	require.NoError(t, err)
+	require.NotNil(t, job)
+	assert.Equal(t, 2, job.Attempts)
+
+	require.NoError(t, svc.Fail(ctx, job, errors.New("still down")))
+	assert.Equal(t, StatusFailed, job.Status)
+}
+
+func TestService_Retry(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	queued, err := svc.Enqueue(ctx, EnqueueRequest{Type: "backup", MaxAttempts: 1})
+	require.NoError(t, err)
+
+	_, err = svc.Retry(ctx, queued.ID)
+	assert.Error(t, err, "pending jobs cannot be retried")
+
+	job, err := svc.Claim(ctx, "w1")
+	require.NoError(t, err)
+	require.NoError(t, svc.Fail(ctx, job, errors.New("disk full")))
+
+	retried, err := svc.Retry(ctx, queued.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusPending, retried.Status)
+	assert.Equal(t, 0, retried.Attempts)
+	assert.Equal(t, "disk full", retried.LastError)
+}
+
+func TestService_Cancel(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	queued, err := svc.Enqueue(ctx, EnqueueRequest{Type: "report"})
+	require.NoError(t, err)
+
+	cancelled, err := svc.Cancel(ctx, queued.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusCancelled, cancelled.Status)
+
+	_, err = svc.Cancel(ctx, queued.ID)
+	assert.Error(t, err, "cancelled jobs cannot be cancelled again")
+
+	job, err := svc.Claim(ctx, "w1")
+	require.NoError(t, err)
+	assert.Nil(t, job, "cancelled jobs should not be claimed")
+
+	_, err = svc.Cancel(ctx, "missing")
+	assert.Error(t, err)
+}
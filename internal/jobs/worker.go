@@ -0,0 +1,185 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Handler executes a job. Returning an error schedules a retry.
+type Handler func(ctx context.Context, job *Job) error
+
+// DefaultPollInterval is how often an idle worker checks for due jobs.
+const DefaultPollInterval = 5 * time.Second
+
+// Worker claims due jobs and dispatches them to registered handlers.
+// Jobs run one at a time, matching SQLite's single-writer model. Each
+// worker holds the jobs it claims under its own ID and renews their lease
+// while they run, so workers in other processes leave them alone.
+type Worker struct {
+	id           string
+	service      *Service
+	handlers     map[string]Handler
+	pollInterval time.Duration
+	renewEvery   time.Duration
+}
+
+// NewWorker creates a worker for the given job service.
+func NewWorker(service *Service) *Worker {
+	return &Worker{
+		id:           uuid.New().String(),
+		service:      service,
+		handlers:     make(map[string]Handler),
+		pollInterval: DefaultPollInterval,
+		renewEvery:   LeaseDuration / 4,
+	}
+}
+
+// Register associates a handler with a job type, replacing any existing one.
+func (w *Worker) Register(jobType string, handler Handler) {
+	w.handlers[jobType] = handler
+}
+
+// SetPollInterval changes how often an idle worker checks for due jobs.
+func (w *Worker) SetPollInterval(d time.Duration) {
+	if d > 0 {
+		w.pollInterval = d
+	}
+}
+
+// RunOnce claims and executes at most one due job.
+// Returns false if no job was due.
+func (w *Worker) RunOnce(ctx context.Context) (bool, error) {
+	job, err := w.service.Claim(ctx, w.id)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim job: %w", err)
+	}
+	if job == nil {
+		return false, nil
+	}
+	return true, w.run(ctx, job)
+}
+
+// RunJob claims and executes the job with id if it is due, so a command
+// can run queued work at once instead of waiting for the daemon. Returns
+// false if the job was not due, such as while it backs off after a
+// failure or another worker is running it.
+func (w *Worker) RunJob(ctx context.Context, id string) (bool, error) {
+	job, err := w.service.ClaimJob(ctx, id, w.id)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim job: %w", err)
+	}
+	if job == nil {
+		return false, nil
+	}
+	return true, w.run(ctx, job)
+}
+
+// run dispatches a claimed job to its handler and records the outcome.
+func (w *Worker) run(ctx context.Context, job *Job) error {
+	handler, ok := w.handlers[job.Type]
+	if !ok {
+		// Unknown types fail permanently; retrying cannot help.
+		job.Attempts = job.MaxAttempts
+		return released(w.service.Fail(ctx, job, fmt.Errorf("no handler registered for job type: %s", job.Type)))
+	}
+
+	if runErr := w.runHandler(ctx, handler, job); runErr != nil {
+		return released(w.service.Fail(ctx, job, runErr))
+	}
+
+	return released(w.service.Complete(ctx, job))
+}
+
+// released drops ErrLeaseLost from recording a job's outcome: the job was
+// requeued and runs again elsewhere, which is no reason to stop working.
+func released(err error) error {
+	if errors.Is(err, ErrLeaseLost) {
+		return nil
+	}
+	return err
+}
+
+// Drain runs due jobs until none remain. Returns the number of jobs processed.
+func (w *Worker) Drain(ctx context.Context) (int, error) {
+	processed := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return processed, err
+		}
+
+		ran, err := w.RunOnce(ctx)
+		if err != nil {
+			return processed, err
+		}
+		if !ran {
+			return processed, nil
+		}
+		processed++
+	}
+}
+
+// Run processes jobs until the context is cancelled. Before each round,
+// jobs whose worker stopped renewing their lease are requeued.
+func (w *Worker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := w.service.RecoverOrphaned(ctx); err != nil && ctx.Err() == nil {
+			return fmt.Errorf("failed to recover orphaned jobs: %w", err)
+		}
+		if _, err := w.Drain(ctx); err != nil && ctx.Err() == nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// runHandler executes a handler, renewing the job's lease until it
+// returns and converting panics into errors so one misbehaving job cannot
+// take down the worker. Losing the lease cancels the handler's context.
+func (w *Worker) runHandler(ctx context.Context, handler Handler, job *Job) (err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go w.renewLease(ctx, cancel, job)
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job handler panicked: %v", r)
+		}
+	}()
+	return handler(ctx, job)
+}
+
+// renewLease renews a running job's lease every renewEvery until ctx is
+// done, and calls lost if the job was taken away.
+func (w *Worker) renewLease(ctx context.Context, lost context.CancelFunc, job *Job) {
+	ticker := time.NewTicker(w.renewEvery)
+	defer ticker.Stop()
+
+	// A copy, so renewals don't race with the worker reading the job
+	lease := *job
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := w.service.RenewLease(ctx, &lease); errors.Is(err, ErrLeaseLost) {
+			lost()
+			return
+		}
+	}
+}
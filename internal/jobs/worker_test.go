@@ -0,0 +1,268 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorker_RunOnce_Success(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	queued, err := svc.Enqueue(ctx, EnqueueRequest{Type: "echo", Payload: map[string]int{"n": 7}})
+	require.NoError(t, err)
+
+	var received int
+	worker := NewWorker(svc)
+	worker.Register("echo", func(_ context.Context, job *Job) error {
+		var payload struct{ N int }
+		if err := job.DecodePayload(&payload); err != nil {
+			return err
+		}
+		received = payload.N
+		return nil
+	})
+
+	ran, err := worker.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.True(t, ran)
+	assert.Equal(t, 7, received)
+
+	job, err := svc.Get(ctx, queued.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusSucceeded, job.Status)
+
+	ran, err = worker.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.False(t, ran, "queue should be empty")
+}
+
+func TestWorker_RunOnce_HandlerErrorSchedulesRetry(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	queued, err := svc.Enqueue(ctx, EnqueueRequest{Type: "flaky"})
+	require.NoError(t, err)
+
+	worker := NewWorker(svc)
+	worker.Register("flaky", func(_ context.Context, _ *Job) error {
+		return errors.New("temporary failure")
+	})
+
+	_, err = worker.RunOnce(ctx)
+	require.NoError(t, err)
+
+	job, err := svc.Get(ctx, queued.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusPending, job.Status)
+	assert.Equal(t, 1, job.Attempts)
+	assert.Equal(t, "temporary failure", job.LastError)
+}
+
+func TestWorker_RunOnce_PanicIsRecovered(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	queued, err := svc.Enqueue(ctx, EnqueueRequest{Type: "panics"})
+	require.NoError(t, err)
+
+	worker := NewWorker(svc)
+	worker.Register("panics", func(_ context.Context, _ *Job) error {
+		panic("unexpected")
+	})
+
+	_, err = worker.RunOnce(ctx)
+	require.NoError(t, err)
+
+	job, err := svc.Get(ctx, queued.ID)
+	require.NoError(t, err)
+	assert.Contains(t, job.LastError, "panicked")
+}
+
+func TestWorker_RunOnce_UnknownTypeFailsPermanently(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	queued, err := svc.Enqueue(ctx, EnqueueRequest{Type: "unknown"})
+	require.NoError(t, err)
+
+	_, err = NewWorker(svc).RunOnce(ctx)
+	require.NoError(t, err)
+
+	job, err := svc.Get(ctx, queued.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusFailed, job.Status)
+	assert.Contains(t, job.LastError, "no handler registered")
+}
+
+func TestWorker_Drain(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		_, err := svc.Enqueue(ctx, EnqueueRequest{Type: "count"})
+		require.NoError(t, err)
+	}
+
+	calls := 0
+	worker := NewWorker(svc)
+	worker.Register("count", func(_ context.Context, _ *Job) error {
+		calls++
+		return nil
+	})
+
+	processed, err := worker.Drain(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, processed)
+	assert.Equal(t, 3, calls)
+}
+
+func TestWorker_Run_StopsOnCancel(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	_, err := svc.Enqueue(ctx, EnqueueRequest{Type: "stop"})
+	require.NoError(t, err)
+
+	worker := NewWorker(svc)
+	worker.SetPollInterval(10 * time.Millisecond)
+	worker.Register("stop", func(_ context.Context, _ *Job) error {
+		cancel()
+		return nil
+	})
+
+	done := make(chan error, 1)
+	go func() { done <- worker.Run(ctx) }()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("worker did not stop after context cancellation")
+	}
+}
+
+func TestWorker_SecondWorkerLeavesRunningJobAlone(t *testing.T) {
+	svc, clock := newTestService(t)
+	ctx := context.Background()
+
+	queued, err := svc.Enqueue(ctx, EnqueueRequest{Type: "slow"})
+	require.NoError(t, err)
+
+	calls := 0
+	worker := NewWorker(svc)
+	worker.Register("slow", func(ctx context.Context, _ *Job) error {
+		calls++
+		// Another worker, as 'samedi jobs run' next to the daemon, starts
+		// while this one is mid-job
+		requeued, err := svc.RecoverOrphaned(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, requeued)
+		ran, err := NewWorker(svc).RunOnce(ctx)
+		require.NoError(t, err)
+		assert.False(t, ran)
+		return nil
+	})
+
+	_, err = worker.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+
+	job, err := svc.Get(ctx, queued.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusSucceeded, job.Status)
+	assert.Empty(t, job.Owner)
+
+	// A worker that died mid-job stops renewing; once its lease runs out
+	// the job is requeued
+	queued, err = svc.Enqueue(ctx, EnqueueRequest{Type: "slow"})
+	require.NoError(t, err)
+	_, err = svc.Claim(ctx, "crashed")
+	require.NoError(t, err)
+	*clock = clock.Add(LeaseDuration + time.Second)
+	requeued, err := svc.RecoverOrphaned(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, requeued)
+
+	job, err = svc.Get(ctx, queued.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusPending, job.Status)
+}
+
+func TestWorker_LostLeaseIsNotAnError(t *testing.T) {
+	svc, clock := newTestService(t)
+	ctx := context.Background()
+
+	queued, err := svc.Enqueue(ctx, EnqueueRequest{Type: "stalled"})
+	require.NoError(t, err)
+
+	worker := NewWorker(svc)
+	worker.Register("stalled", func(ctx context.Context, _ *Job) error {
+		// The lease runs out and another worker requeues the job
+		*clock = clock.Add(LeaseDuration + time.Second)
+		_, err := svc.RecoverOrphaned(ctx)
+		return err
+	})
+
+	ran, err := worker.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.True(t, ran)
+
+	job, err := svc.Get(ctx, queued.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusPending, job.Status, "left for the next worker")
+}
+
+func TestWorker_RunJob(t *testing.T) {
+	svc, clock := newTestService(t)
+	ctx := context.Background()
+
+	older, err := svc.Enqueue(ctx, EnqueueRequest{Type: "echo"})
+	require.NoError(t, err)
+	queued, err := svc.Enqueue(ctx, EnqueueRequest{Type: "flaky"})
+	require.NoError(t, err)
+
+	var ran []string
+	worker := NewWorker(svc)
+	worker.Register("echo", func(_ context.Context, job *Job) error {
+		ran = append(ran, job.Type)
+		return nil
+	})
+	worker.Register("flaky", func(_ context.Context, job *Job) error {
+		ran = append(ran, job.Type)
+		return errors.New("disk full")
+	})
+
+	done, err := worker.RunJob(ctx, queued.ID)
+	require.NoError(t, err, "a failing handler backs off rather than failing the run")
+	assert.True(t, done)
+	assert.Equal(t, []string{"flaky"}, ran, "only the named job runs")
+
+	job, err := svc.Get(ctx, queued.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusPending, job.Status)
+	assert.Equal(t, "disk full", job.LastError)
+
+	// Backing off, the job waits for its retry time
+	done, err = worker.RunJob(ctx, queued.ID)
+	require.NoError(t, err)
+	assert.False(t, done)
+
+	*clock = clock.Add(BaseBackoff)
+	done, err = worker.RunJob(ctx, queued.ID)
+	require.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, []string{"flaky", "flaky"}, ran)
+
+	job, err = svc.Get(ctx, older.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusPending, job.Status)
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+// Package journal keeps a free-form markdown note per day, apart from the
+// notes on individual sessions. Notes live in the data directory's
+// journal/ folder, named by date, so git sync carries them along.
+package journal
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+)
+
+// DateLayout is how notes are named and dates are given on the command line.
+const DateLayout = "2006-01-02"
+
+// Entry is one day's note.
+type Entry struct {
+	Date    time.Time `json:"date"` // Midnight starting the day
+	Path    string    `json:"path"`
+	Content string    `json:"content"`
+}
+
+// Excerpt returns the note's prose, headings and blank lines left out,
+// joined onto one line and cut to at most max runes.
+func (e *Entry) Excerpt(max int) string {
+	var parts []string
+	for _, line := range strings.Split(e.Content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts = append(parts, line)
+	}
+	text := strings.Join(parts, " ")
+	if utf8.RuneCountInString(text) <= max {
+		return text
+	}
+	runes := []rune(text)
+	return strings.TrimSpace(string(runes[:max-3])) + "..."
+}
+
+// Store reads and writes journal notes.
+type Store struct {
+	fs    *storage.FilesystemStorage
+	paths *storage.Paths
+}
+
+// NewStore returns a store for the journal under paths.
+func NewStore(fs *storage.FilesystemStorage, paths *storage.Paths) *Store {
+	return &Store{fs: fs, paths: paths}
+}
+
+// Path returns the note for day, whether or not it exists.
+func (s *Store) Path(day time.Time) string {
+	return s.paths.JournalPath(day.Format(DateLayout))
+}
+
+// heading starts a new note.
+func heading(day time.Time) string {
+	return fmt.Sprintf("# %s\n\n", day.Format("Monday, January 2, 2006"))
+}
+
+// Edit lets edit change day's note in place, as an external editor does,
+// starting a new note with the date as its heading. A new note left as
+// it was started is removed again. changed reports whether the note was
+// written.
+func (s *Store) Edit(day time.Time, edit func(path string) error) (changed bool, err error) {
+	path := s.Path(day)
+	before, err := os.ReadFile(path)
+	created := errors.Is(err, fs.ErrNotExist)
+	if err != nil && !created {
+		return false, fmt.Errorf("failed to read journal note: %w", err)
+	}
+	if created {
+		if err := os.MkdirAll(s.paths.JournalDir(), 0o755); err != nil {
+			return false, fmt.Errorf("failed to create journal directory: %w", err)
+		}
+		if err := s.fs.WriteFile(path, []byte(heading(day))); err != nil {
+			return false, err
+		}
+		// Compare what is on disk, which may be encrypted
+		if before, err = os.ReadFile(path); err != nil {
+			return false, fmt.Errorf("failed to read journal note: %w", err)
+		}
+	}
+
+	if err := s.fs.EditFile(path, edit); err != nil {
+		return false, err
+	}
+
+	after, err := os.ReadFile(path)
+	if err != nil {
+		return false, fmt.Errorf("failed to read journal note: %w", err)
+	}
+	if string(after) != string(before) {
+		return true, nil
+	}
+	if created {
+		if err := s.fs.DeleteFile(path); err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// Get returns day's note, or nil when there is none.
+func (s *Store) Get(day time.Time) (*Entry, error) {
+	path := s.Path(day)
+	data, err := s.fs.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read journal note: %w", err)
+	}
+	return &Entry{Date: midnight(day), Path: path, Content: string(data)}, nil
+}
+
+// List returns the notes for the days from start through end, oldest
+// first. Dates are read in start's location.
+func (s *Store) List(start, end time.Time) ([]*Entry, error) {
+	files, err := os.ReadDir(s.paths.JournalDir())
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read journal directory: %w", err)
+	}
+
+	first, last := midnight(start), end.In(start.Location())
+	var entries []*Entry
+	for _, file := range files {
+		name, ok := strings.CutSuffix(file.Name(), ".md")
+		if !ok || file.IsDir() {
+			continue
+		}
+		day, err := time.ParseInLocation(DateLayout, name, start.Location())
+		if err != nil || day.Before(first) || day.After(last) {
+			continue
+		}
+		entry, err := s.Get(day)
+		if err != nil {
+			return nil, err
+		}
+		if entry != nil {
+			entries = append(entries, entry)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Date.Before(entries[j].Date) })
+	return entries, nil
+}
+
+// ParseDay reads a day as "today", "yesterday", or a date such as
+// "2026-10-16", relative to now and in its location.
+func ParseDay(value string, now time.Time) (time.Time, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "today":
+		return midnight(now), nil
+	case "yesterday":
+		return midnight(now).AddDate(0, 0, -1), nil
+	}
+	day, err := time.ParseInLocation(DateLayout, strings.TrimSpace(value), now.Location())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q (expected YYYY-MM-DD, today, or yesterday)", value)
+	}
+	return day, nil
+}
+
+func midnight(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package journal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+	paths, err := storage.NewPaths(t.TempDir())
+	require.NoError(t, err)
+	return NewStore(storage.NewFilesystemStorage(paths), paths)
+}
+
+func day(d int) time.Time {
+	return time.Date(2026, 10, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestStore_Edit(t *testing.T) {
+	store := newTestStore(t)
+
+	changed, err := store.Edit(day(16), func(path string) error {
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "# Friday, October 16, 2026\n\n", string(data), "new notes start with the date")
+		return os.WriteFile(path, append(data, []byte("Pinning finally clicked.\n")...), 0o600)
+	})
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "2026-10-16.md", filepath.Base(store.Path(day(16))))
+
+	entry, err := store.Get(day(16))
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	assert.Contains(t, entry.Content, "Pinning finally clicked.")
+
+	changed, err = store.Edit(day(16), func(string) error { return nil })
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.FileExists(t, store.Path(day(16)), "an existing note is kept")
+}
+
+func TestStore_Edit_UntouchedNewNoteIsRemoved(t *testing.T) {
+	store := newTestStore(t)
+
+	changed, err := store.Edit(day(15), func(string) error { return nil })
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.NoFileExists(t, store.Path(day(15)))
+
+	entry, err := store.Get(day(15))
+	require.NoError(t, err)
+	assert.Nil(t, entry)
+}
+
+func TestStore_Edit_Encrypted(t *testing.T) {
+	paths, err := storage.NewPaths(t.TempDir())
+	require.NoError(t, err)
+	cipher, err := storage.NewCipher("hunter2", paths)
+	require.NoError(t, err)
+	fs := storage.NewFilesystemStorage(paths)
+	fs.SetCipher(cipher)
+	store := NewStore(fs, paths)
+
+	changed, err := store.Edit(day(16), func(path string) error {
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "# Friday, October 16, 2026\n\n", string(data), "the editor sees plaintext")
+		return os.WriteFile(path, append(data, []byte("Private thoughts.\n")...), 0o600)
+	})
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	raw, err := os.ReadFile(store.Path(day(16)))
+	require.NoError(t, err)
+	assert.True(t, storage.IsEncrypted(raw))
+	assert.NotContains(t, string(raw), "Private thoughts.")
+
+	entry, err := store.Get(day(16))
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	assert.Contains(t, entry.Content, "Private thoughts.")
+
+	changed, err = store.Edit(day(16), func(string) error { return nil })
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	changed, err = store.Edit(day(15), func(string) error { return nil })
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.NoFileExists(t, store.Path(day(15)), "an untouched new note is removed")
+}
+
+func TestStore_List(t *testing.T) {
+	store := newTestStore(t)
+	entries, err := store.List(day(1), day(31))
+	require.NoError(t, err)
+	assert.Empty(t, entries, "no journal directory yet")
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(store.Path(day(1))), 0o755))
+	for _, d := range []int{14, 12, 20} {
+		require.NoError(t, os.WriteFile(store.Path(day(d)), []byte("note"), 0o600))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(filepath.Dir(store.Path(day(1))), "ideas.md"), []byte("x"), 0o600))
+
+	entries, err = store.List(day(12), day(19).Add(-time.Nanosecond))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, day(12), entries[0].Date)
+	assert.Equal(t, day(14), entries[1].Date)
+}
+
+func TestEntry_Excerpt(t *testing.T) {
+	entry := &Entry{Content: "# Friday, October 16, 2026\n\nPinning finally\nclicked.\n\n## Later\n\nTokio next."}
+	assert.Equal(t, "Pinning finally clicked. Tokio next.", entry.Excerpt(80))
+	assert.Equal(t, "Pinning...", entry.Excerpt(11))
+}
+
+func TestParseDay(t *testing.T) {
+	now := time.Date(2026, 10, 16, 22, 30, 0, 0, time.UTC)
+
+	for value, want := range map[string]time.Time{
+		"":           day(16),
+		"today":      day(16),
+		"Yesterday":  day(15),
+		"2026-10-01": day(1),
+	} {
+		got, err := ParseDay(value, now)
+		require.NoError(t, err, value)
+		assert.Equal(t, want, got, value)
+	}
+
+	_, err := ParseDay("10/16", now)
+	assert.Error(t, err)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+// Package ledger records LLM calls with their token usage and estimated
+// cost, so users can see what plan and card generation has cost them.
+package ledger
+
+import (
+	"fmt"
+	"time"
+)
+
+// Entry is one LLM call in the cost ledger.
+type Entry struct {
+	ID           int64     `json:"id"`
+	Operation    string    `json:"operation"`
+	Provider     string    `json:"provider"`
+	Model        string    `json:"model,omitempty"`
+	PlanID       string    `json:"plan_id,omitempty"`
+	InputTokens  int       `json:"input_tokens"`
+	OutputTokens int       `json:"output_tokens"`
+	Estimated    bool      `json:"estimated"`
+	CostUSD      float64   `json:"cost_usd"`
+	Priced       bool      `json:"priced"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// Validate checks that the entry can be stored.
+func (e *Entry) Validate() error {
+	if e.Operation == "" {
+		return fmt.Errorf("operation cannot be empty")
+	}
+	if e.Provider == "" {
+		return fmt.Errorf("provider cannot be empty")
+	}
+	if e.InputTokens < 0 || e.OutputTokens < 0 {
+		return fmt.Errorf("token counts cannot be negative")
+	}
+	if e.CostUSD < 0 {
+		return fmt.Errorf("cost cannot be negative, got %f", e.CostUSD)
+	}
+	if e.CreatedAt.IsZero() {
+		return fmt.Errorf("created_at cannot be zero")
+	}
+	return nil
+}
+
+// Summary aggregates ledger entries for one month and operation.
+type Summary struct {
+	Month        string  `json:"month"` // YYYY-MM in local time
+	Operation    string  `json:"operation"`
+	Calls        int     `json:"calls"`
+	InputTokens  int     `json:"input_tokens"`
+	OutputTokens int     `json:"output_tokens"`
+	CostUSD      float64 `json:"cost_usd"`
+
+	// EstimatedCalls counts calls whose tokens were approximated.
+	EstimatedCalls int `json:"estimated_calls"`
+
+	// UnpricedCalls counts calls whose model price is unknown (cost 0).
+	UnpricedCalls int `json:"unpriced_calls"`
+}
+
+// TotalTokens returns input plus output tokens.
+func (s Summary) TotalTokens() int {
+	return s.InputTokens + s.OutputTokens
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+)
+
+// Filter contains criteria for listing ledger entries.
+type Filter struct {
+	Since time.Time // Zero means no lower bound
+	Until time.Time // Zero means no upper bound (exclusive)
+}
+
+// Repository defines the interface for ledger persistence.
+type Repository interface {
+	// Record inserts a new entry and sets its ID.
+	Record(ctx context.Context, entry *Entry) error
+
+	// List retrieves entries matching the filter, oldest first.
+	List(ctx context.Context, filter Filter) ([]*Entry, error)
+}
+
+// SQLiteRepository implements ledger storage using SQLite.
+type SQLiteRepository struct {
+	db *storage.SQLiteDB
+}
+
+// NewSQLiteRepository creates a new SQLite-backed ledger repository.
+func NewSQLiteRepository(db *storage.SQLiteDB) Repository {
+	return &SQLiteRepository{db: db}
+}
+
+// Record inserts a new entry into the ledger.
+func (r *SQLiteRepository) Record(ctx context.Context, entry *Entry) error {
+	if err := entry.Validate(); err != nil {
+		return fmt.Errorf("invalid ledger entry: %w", err)
+	}
+
+	query := `
+		INSERT INTO llm_usage (
+			operation, provider, model, plan_id, input_tokens, output_tokens,
+			estimated, cost_usd, priced, created_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := r.db.DB().ExecContext(ctx, query,
+		entry.Operation,
+		entry.Provider,
+		entry.Model,
+		entry.PlanID,
+		entry.InputTokens,
+		entry.OutputTokens,
+		entry.Estimated,
+		entry.CostUSD,
+		entry.Priced,
+		entry.CreatedAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record LLM usage: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get ledger entry ID: %w", err)
+	}
+	entry.ID = id
+
+	return nil
+}
+
+// List retrieves entries matching the filter, oldest first.
+func (r *SQLiteRepository) List(ctx context.Context, filter Filter) ([]*Entry, error) {
+	var conditions []string
+	var args []interface{}
+
+	if !filter.Since.IsZero() {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, filter.Since.UTC())
+	}
+	if !filter.Until.IsZero() {
+		conditions = append(conditions, "created_at < ?")
+		args = append(args, filter.Until.UTC())
+	}
+
+	query := `
+		SELECT id, operation, provider, COALESCE(model, ''), COALESCE(plan_id, ''),
+			input_tokens, output_tokens, estimated, cost_usd, priced, created_at
+		FROM llm_usage`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY created_at, id"
+
+	rows, err := r.db.DB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list LLM usage: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make([]*Entry, 0)
+	for rows.Next() {
+		var entry Entry
+		if err := rows.Scan(
+			&entry.ID,
+			&entry.Operation,
+			&entry.Provider,
+			&entry.Model,
+			&entry.PlanID,
+			&entry.InputTokens,
+			&entry.OutputTokens,
+			&entry.Estimated,
+			&entry.CostUSD,
+			&entry.Priced,
+			&entry.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan ledger entry: %w", err)
+		}
+		entries = append(entries, &entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ledger entries: %w", err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package ledger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestDB(t *testing.T) *storage.SQLiteDB {
+	t.Helper()
+
+	db, err := storage.NewSQLiteDB(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	require.NoError(t, storage.NewMigrator(db).Migrate())
+
+	return db
+}
+
+func newTestEntry(createdAt time.Time) *Entry {
+	return &Entry{
+		Operation:    "plan.generate",
+		Provider:     "anthropic",
+		Model:        "claude-sonnet-4",
+		PlanID:       "rust-async",
+		InputTokens:  1200,
+		OutputTokens: 3400,
+		CostUSD:      0.0546,
+		Priced:       true,
+		CreatedAt:    createdAt,
+	}
+}
+
+func TestSQLiteRepository_RecordAndList(t *testing.T) {
+	repo := NewSQLiteRepository(setupTestDB(t))
+	ctx := context.Background()
+	now := time.Now()
+
+	entry := newTestEntry(now)
+	require.NoError(t, repo.Record(ctx, entry))
+	assert.NotZero(t, entry.ID)
+
+	entries, err := repo.List(ctx, Filter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	got := entries[0]
+	assert.Equal(t, "plan.generate", got.Operation)
+	assert.Equal(t, "anthropic", got.Provider)
+	assert.Equal(t, "claude-sonnet-4", got.Model)
+	assert.Equal(t, "rust-async", got.PlanID)
+	assert.Equal(t, 1200, got.InputTokens)
+	assert.Equal(t, 3400, got.OutputTokens)
+	assert.False(t, got.Estimated)
+	assert.True(t, got.Priced)
+	assert.InDelta(t, 0.0546, got.CostUSD, 0.00001)
+	assert.WithinDuration(t, now, got.CreatedAt, time.Second)
+}
+
+func TestSQLiteRepository_Record_Invalid(t *testing.T) {
+	repo := NewSQLiteRepository(setupTestDB(t))
+
+	entry := newTestEntry(time.Now())
+	entry.Operation = ""
+
+	assert.Error(t, repo.Record(context.Background(), entry))
+}
+
+func TestSQLiteRepository_List_Filter(t *testing.T) {
+	repo := NewSQLiteRepository(setupTestDB(t))
+	ctx := context.Background()
+	now := time.Now()
+
+	for _, age := range []time.Duration{72 * time.Hour, 48 * time.Hour, time.Hour} {
+		require.NoError(t, repo.Record(ctx, newTestEntry(now.Add(-age))))
+	}
+
+	entries, err := repo.List(ctx, Filter{Since: now.Add(-50 * time.Hour)})
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	entries, err = repo.List(ctx, Filter{Since: now.Add(-50 * time.Hour), Until: now.Add(-2 * time.Hour)})
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/llm"
+)
+
+// Service provides business logic for the LLM cost ledger.
+type Service struct {
+	repo Repository
+	now  func() time.Time
+}
+
+// NewService creates a new ledger service.
+func NewService(repo Repository) *Service {
+	return &Service{
+		repo: repo,
+		now:  time.Now,
+	}
+}
+
+// Record stores a metered LLM call with its estimated cost.
+// It matches the recorder signature of llm.NewMeteredProvider.
+func (s *Service) Record(ctx context.Context, call llm.CallRecord) error {
+	cost, priced := llm.EstimateCost(call.Provider, call.Model, call.Usage)
+
+	operation := call.Operation
+	if operation == "" {
+		operation = "other"
+	}
+
+	entry := &Entry{
+		Operation:    operation,
+		Provider:     call.Provider,
+		Model:        call.Model,
+		PlanID:       call.PlanID,
+		InputTokens:  call.Usage.InputTokens,
+		OutputTokens: call.Usage.OutputTokens,
+		Estimated:    call.Usage.Estimated,
+		CostUSD:      cost,
+		Priced:       priced,
+		CreatedAt:    s.now(),
+	}
+
+	return s.repo.Record(ctx, entry)
+}
+
+// List returns ledger entries matching the filter.
+func (s *Service) List(ctx context.Context, filter Filter) ([]*Entry, error) {
+	entries, err := s.repo.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ledger: %w", err)
+	}
+	return entries, nil
+}
+
+// Monthly aggregates entries by local calendar month and operation,
+// newest month first and operations alphabetically within a month.
+func (s *Service) Monthly(ctx context.Context, filter Filter) ([]Summary, error) {
+	entries, err := s.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return summarize(entries), nil
+}
+
+// summarize groups entries by month and operation.
+func summarize(entries []*Entry) []Summary {
+	type key struct{ month, operation string }
+
+	byKey := make(map[key]*Summary)
+	for _, entry := range entries {
+		k := key{month: entry.CreatedAt.Local().Format("2006-01"), operation: entry.Operation}
+		summary, ok := byKey[k]
+		if !ok {
+			summary = &Summary{Month: k.month, Operation: k.operation}
+			byKey[k] = summary
+		}
+
+		summary.Calls++
+		summary.InputTokens += entry.InputTokens
+		summary.OutputTokens += entry.OutputTokens
+		summary.CostUSD += entry.CostUSD
+		if entry.Estimated {
+			summary.EstimatedCalls++
+		}
+		if !entry.Priced {
+			summary.UnpricedCalls++
+		}
+	}
+
+	summaries := make([]Summary, 0, len(byKey))
+	for _, summary := range byKey {
+		summaries = append(summaries, *summary)
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].Month != summaries[j].Month {
+			return summaries[i].Month > summaries[j].Month
+		}
+		return summaries[i].Operation < summaries[j].Operation
+	})
+
+	return summaries
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package ledger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/llm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Record(t *testing.T) {
+	repo := NewSQLiteRepository(setupTestDB(t))
+	svc := NewService(repo)
+	ctx := context.Background()
+
+	err := svc.Record(ctx, llm.CallRecord{
+		CallInfo: llm.CallInfo{Operation: llm.OperationPlanGenerate, PlanID: "rust"},
+		Provider: "anthropic",
+		Model:    "claude-sonnet-4-20250514",
+		Usage:    llm.Usage{InputTokens: 1_000_000, OutputTokens: 0},
+	})
+	require.NoError(t, err)
+
+	err = svc.Record(ctx, llm.CallRecord{
+		Provider: "llm",
+		Model:    "mystery",
+		Usage:    llm.Usage{InputTokens: 10, OutputTokens: 20, Estimated: true},
+	})
+	require.NoError(t, err)
+
+	entries, err := svc.List(ctx, Filter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, "plan.generate", entries[0].Operation)
+	assert.InDelta(t, 3.0, entries[0].CostUSD, 0.0001)
+	assert.True(t, entries[0].Priced)
+
+	assert.Equal(t, "other", entries[1].Operation)
+	assert.True(t, entries[1].Estimated)
+	assert.False(t, entries[1].Priced)
+	assert.Zero(t, entries[1].CostUSD)
+}
+
+func TestSummarize(t *testing.T) {
+	sep := time.Date(2025, 9, 15, 12, 0, 0, 0, time.Local)
+	oct := time.Date(2025, 10, 3, 12, 0, 0, 0, time.Local)
+
+	entries := []*Entry{
+		{Operation: "plan.generate", InputTokens: 10, OutputTokens: 20, CostUSD: 0.5, Priced: true, CreatedAt: sep},
+		{Operation: "plan.generate", InputTokens: 5, OutputTokens: 5, CostUSD: 0.25, Priced: true, Estimated: true, CreatedAt: oct},
+		{Operation: "cards.generate", InputTokens: 1, OutputTokens: 2, CreatedAt: oct},
+		{Operation: "plan.generate", InputTokens: 5, OutputTokens: 5, CostUSD: 0.25, Priced: true, CreatedAt: oct},
+	}
+
+	summaries := summarize(entries)
+	require.Len(t, summaries, 3)
+
+	// Newest month first, operations sorted within the month
+	assert.Equal(t, "2025-10", summaries[0].Month)
+	assert.Equal(t, "cards.generate", summaries[0].Operation)
+	assert.Equal(t, 1, summaries[0].UnpricedCalls)
+
+	assert.Equal(t, "2025-10", summaries[1].Month)
+	assert.Equal(t, "plan.generate", summaries[1].Operation)
+	assert.Equal(t, 2, summaries[1].Calls)
+	assert.Equal(t, 20, summaries[1].TotalTokens())
+	assert.InDelta(t, 0.5, summaries[1].CostUSD, 0.0001)
+	assert.Equal(t, 1, summaries[1].EstimatedCalls)
+
+	assert.Equal(t, "2025-09", summaries[2].Month)
+	assert.Equal(t, 1, summaries[2].Calls)
+}
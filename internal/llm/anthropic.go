@@ -0,0 +1,107 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package llm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// anthropicVersion is the Messages API version header value.
+const anthropicVersion = "2023-06-01"
+
+// AnthropicProvider calls the Anthropic Messages API directly over HTTP.
+// See: https://docs.anthropic.com/en/api/messages
+//
+// The API key is read from ANTHROPIC_API_KEY (or llm.api_key_env).
+type AnthropicProvider struct {
+	config Config
+	http   *httpClient
+}
+
+// NewAnthropicProvider creates a new Anthropic API provider.
+func NewAnthropicProvider(config *Config) *AnthropicProvider {
+	if config.BaseURL == "" {
+		config.BaseURL = "https://api.anthropic.com"
+	}
+	if config.Model == "" {
+		config.Model = "claude-sonnet-4-20250514"
+	}
+	if config.MaxTokens == 0 {
+		config.MaxTokens = 8192
+	}
+	if config.Timeout == 0 {
+		config.Timeout = DefaultConfig().Timeout
+	}
+
+	return &AnthropicProvider{
+		config: *config,
+		http:   newHTTPClient("anthropic", config),
+	}
+}
+
+type anthropicMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type anthropicRequest struct {
+	Model     string             `json:"model"`
+	MaxTokens int                `json:"max_tokens"`
+	Messages  []anthropicMessage `json:"messages"`
+}
+
+type anthropicResponse struct {
+	Content []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"content"`
+	StopReason string `json:"stop_reason"`
+	Usage      struct {
+		InputTokens  int `json:"input_tokens"`
+		OutputTokens int `json:"output_tokens"`
+	} `json:"usage"`
+}
+
+// Call sends a prompt as a single user message and returns the text reply.
+func (a *AnthropicProvider) Call(ctx context.Context, prompt string) (string, error) {
+	text, _, err := a.CallWithUsage(ctx, prompt)
+	return text, err
+}
+
+// CallWithUsage is Call plus the token usage reported by the API.
+func (a *AnthropicProvider) CallWithUsage(ctx context.Context, prompt string) (string, Usage, error) {
+	if a.config.APIKey == "" {
+		return "", Usage{}, &ProviderError{Provider: "anthropic", Err: fmt.Errorf("API key not set"), Unavailable: true}
+	}
+
+	req := anthropicRequest{
+		Model:     a.config.Model,
+		MaxTokens: a.config.MaxTokens,
+		Messages:  []anthropicMessage{{Role: "user", Content: prompt}},
+	}
+	headers := map[string]string{
+		"x-api-key":         a.config.APIKey,
+		"anthropic-version": anthropicVersion,
+	}
+
+	var resp anthropicResponse
+	if err := a.http.postJSON(ctx, joinURL(a.config.BaseURL, "/v1/messages"), headers, req, &resp); err != nil {
+		return "", Usage{}, err
+	}
+
+	var text strings.Builder
+	for _, block := range resp.Content {
+		if block.Type == "text" {
+			text.WriteString(block.Text)
+		}
+	}
+	if text.Len() == 0 {
+		return "", Usage{}, &ProviderError{Provider: "anthropic", Err: fmt.Errorf("empty response (stop reason: %s)", resp.StopReason)}
+	}
+
+	usage := reportedUsage(prompt, text.String(), resp.Usage.InputTokens, resp.Usage.OutputTokens)
+	return text.String(), usage, nil
+}
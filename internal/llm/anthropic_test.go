@@ -0,0 +1,62 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAnthropicProvider_Defaults(t *testing.T) {
+	provider := NewAnthropicProvider(&Config{})
+
+	assert.Equal(t, "https://api.anthropic.com", provider.config.BaseURL)
+	assert.NotEmpty(t, provider.config.Model)
+	assert.Equal(t, 8192, provider.config.MaxTokens)
+	assert.Equal(t, 120*time.Second, provider.config.Timeout)
+}
+
+func TestAnthropicProvider_Call(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/messages", r.URL.Path)
+		assert.Equal(t, "test-key", r.Header.Get("x-api-key"))
+		assert.Equal(t, anthropicVersion, r.Header.Get("anthropic-version"))
+
+		var req anthropicRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "claude-test", req.Model)
+		assert.Equal(t, 1000, req.MaxTokens)
+		require.Len(t, req.Messages, 1)
+		assert.Equal(t, "user", req.Messages[0].Role)
+		assert.Equal(t, "make a plan", req.Messages[0].Content)
+
+		_, _ = w.Write([]byte(`{"content":[{"type":"text","text":"---\nid: x\n"},{"type":"text","text":"---"}],"stop_reason":"end_turn","usage":{"input_tokens":12,"output_tokens":34}}`))
+	}))
+	defer server.Close()
+
+	provider := NewAnthropicProvider(&Config{BaseURL: server.URL, APIKey: "test-key", Model: "claude-test", MaxTokens: 1000})
+	response, usage, err := provider.CallWithUsage(context.Background(), "make a plan")
+
+	require.NoError(t, err)
+	assert.Equal(t, "---\nid: x\n---", response)
+	assert.Equal(t, Usage{InputTokens: 12, OutputTokens: 34}, usage)
+}
+
+func TestAnthropicProvider_Call_MissingAPIKey(t *testing.T) {
+	provider := NewAnthropicProvider(&Config{BaseURL: "http://127.0.0.1:0"})
+
+	_, err := provider.Call(context.Background(), "prompt")
+
+	var providerErr *ProviderError
+	require.ErrorAs(t, err, &providerErr)
+	assert.Equal(t, "anthropic", providerErr.Provider)
+	assert.False(t, providerErr.Retryable)
+}
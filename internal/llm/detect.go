@@ -39,6 +39,22 @@ type CLIInfo struct {
 //	    fmt.Printf("Using %s CLI\n", info.Name)
 //	}
 func DetectCLI() CLIInfo {
+	for _, cli := range DetectCLIs() {
+		if cli.Found {
+			return cli
+		}
+	}
+
+	// No CLI found, will fall back to mock
+	return CLIInfo{
+		Name:  "mock",
+		Found: false,
+	}
+}
+
+// DetectCLIs reports every supported CLI in priority order, with Found
+// set for those on the PATH. Setup uses it to show what was detected.
+func DetectCLIs() []CLIInfo {
 	// Priority-ordered list of CLIs to check
 	clis := []CLIInfo{
 		{
@@ -63,17 +79,11 @@ func DetectCLI() CLIInfo {
 		},
 	}
 
-	// Check each CLI in order
-	for _, cli := range clis {
-		if _, err := exec.LookPath(cli.Command); err == nil {
-			cli.Found = true
-			return cli
+	for i := range clis {
+		if _, err := exec.LookPath(clis[i].Command); err == nil {
+			clis[i].Found = true
 		}
 	}
 
-	// No CLI found, will fall back to mock
-	return CLIInfo{
-		Name:  "mock",
-		Found: false,
-	}
+	return clis
 }
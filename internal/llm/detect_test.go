@@ -4,9 +4,12 @@
 package llm
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDetectCLI_ReturnsStructure(t *testing.T) {
@@ -47,3 +50,18 @@ func TestDetectCLI_PriorityOrder(t *testing.T) {
 	assert.Equal(t, "claude", expectedOrder[0], "claude should be first priority")
 	assert.Equal(t, "llm", expectedOrder[3], "llm should be last priority before mock")
 }
+
+func TestDetectCLIs_ReportsEachCLI(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "gemini"), []byte("#!/bin/sh\n"), 0o755))
+	t.Setenv("PATH", dir)
+
+	clis := DetectCLIs()
+	require.Len(t, clis, 4)
+	assert.Equal(t, []string{"claude", "codex", "gemini", "llm"},
+		[]string{clis[0].Name, clis[1].Name, clis[2].Name, clis[3].Name})
+	assert.False(t, clis[0].Found)
+	assert.True(t, clis[2].Found)
+
+	assert.Equal(t, "gemini", DetectCLI().Name)
+}
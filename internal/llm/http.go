@@ -0,0 +1,164 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// retryBaseDelay is the first backoff delay; it doubles on each retry.
+// Tests shorten it.
+var retryBaseDelay = time.Second
+
+// maxRetryDelay caps backoff and Retry-After waits.
+const maxRetryDelay = 30 * time.Second
+
+// maxErrorBody limits how much of an error response is included in errors.
+const maxErrorBody = 512
+
+// httpClient sends JSON requests for the HTTP-based providers with a
+// per-attempt timeout and exponential backoff on transient failures
+// (network errors, 408, 429, and 5xx responses).
+type httpClient struct {
+	provider   string
+	client     *http.Client
+	timeout    time.Duration
+	maxRetries int
+}
+
+func newHTTPClient(provider string, config *Config) *httpClient {
+	return &httpClient{
+		provider:   provider,
+		client:     &http.Client{},
+		timeout:    config.Timeout,
+		maxRetries: config.MaxRetries,
+	}
+}
+
+// postJSON sends body to url and decodes the JSON response into out.
+func (c *httpClient) postJSON(ctx context.Context, url string, headers map[string]string, body, out interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return &ProviderError{Provider: c.provider, Err: fmt.Errorf("failed to encode request: %w", err)}
+	}
+
+	var lastErr *ProviderError
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if attempt > 0 {
+			delay := backoffDelay(attempt)
+			if lastErr != nil && lastErr.retryAfter > 0 {
+				delay = lastErr.retryAfter
+			}
+			select {
+			case <-ctx.Done():
+				return &ProviderError{Provider: c.provider, Err: ctx.Err()}
+			case <-time.After(delay):
+			}
+		}
+
+		lastErr = c.attempt(ctx, url, headers, payload, out)
+		if lastErr == nil {
+			return nil
+		}
+		if !lastErr.Retryable || ctx.Err() != nil {
+			return lastErr
+		}
+	}
+
+	if c.maxRetries > 0 {
+		lastErr.Err = fmt.Errorf("%w (gave up after %d attempts)", lastErr.Err, c.maxRetries+1)
+	}
+	return lastErr
+}
+
+// attempt performs a single request.
+func (c *httpClient) attempt(ctx context.Context, url string, headers map[string]string, payload []byte, out interface{}) *ProviderError {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return &ProviderError{Provider: c.provider, Err: fmt.Errorf("failed to create request: %w", err)}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return &ProviderError{Provider: c.provider, Err: fmt.Errorf("timeout after %v", c.timeout), Retryable: true}
+		}
+		return &ProviderError{Provider: c.provider, Err: fmt.Errorf("request failed: %w", err), Retryable: true, Unavailable: true}
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return &ProviderError{Provider: c.provider, Err: fmt.Errorf("failed to read response: %w", err), Retryable: true}
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &ProviderError{
+			Provider:   c.provider,
+			Err:        fmt.Errorf("API error (HTTP %d): %s", resp.StatusCode, truncateBody(data)),
+			Retryable:  isRetryableStatus(resp.StatusCode),
+			retryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+	}
+
+	if err := json.Unmarshal(data, out); err != nil {
+		return &ProviderError{Provider: c.provider, Err: fmt.Errorf("invalid response: %w", err)}
+	}
+	return nil
+}
+
+// backoffDelay returns the wait before retry n (1-based): base, 2×base, 4×base, ...
+func backoffDelay(retry int) time.Duration {
+	delay := retryBaseDelay << (retry - 1)
+	if delay > maxRetryDelay || delay <= 0 {
+		return maxRetryDelay
+	}
+	return delay
+}
+
+func isRetryableStatus(code int) bool {
+	return code == http.StatusRequestTimeout || code == http.StatusTooManyRequests || code >= 500
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds.
+func parseRetryAfter(value string) time.Duration {
+	seconds, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	delay := time.Duration(seconds) * time.Second
+	if delay > maxRetryDelay {
+		return maxRetryDelay
+	}
+	return delay
+}
+
+func truncateBody(data []byte) string {
+	body := strings.TrimSpace(string(data))
+	if len(body) > maxErrorBody {
+		return body[:maxErrorBody] + "..."
+	}
+	return body
+}
+
+// joinURL appends a path to a base URL without doubling slashes.
+func joinURL(base, path string) string {
+	return strings.TrimRight(base, "/") + "/" + strings.TrimLeft(path, "/")
+}
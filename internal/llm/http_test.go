@@ -0,0 +1,129 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package llm
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fastRetries shortens backoff for the duration of a test.
+func fastRetries(t *testing.T) {
+	t.Helper()
+	original := retryBaseDelay
+	retryBaseDelay = time.Millisecond
+	t.Cleanup(func() { retryBaseDelay = original })
+}
+
+func TestHTTPClient_RetriesTransientErrors(t *testing.T) {
+	fastRetries(t)
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			_, _ = w.Write([]byte(`{"ok": true}`))
+		}
+	}))
+	defer server.Close()
+
+	client := newHTTPClient("test", &Config{Timeout: 5 * time.Second, MaxRetries: 2})
+	var out struct{ OK bool }
+	require.NoError(t, client.postJSON(context.Background(), server.URL, nil, map[string]string{}, &out))
+
+	assert.True(t, out.OK)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestHTTPClient_GivesUpAfterMaxRetries(t *testing.T) {
+	fastRetries(t)
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte("overloaded"))
+	}))
+	defer server.Close()
+
+	client := newHTTPClient("test", &Config{Timeout: 5 * time.Second, MaxRetries: 1})
+	err := client.postJSON(context.Background(), server.URL, nil, map[string]string{}, &struct{}{})
+
+	var providerErr *ProviderError
+	require.ErrorAs(t, err, &providerErr)
+	assert.True(t, providerErr.Retryable)
+	assert.Contains(t, err.Error(), "HTTP 503")
+	assert.Contains(t, err.Error(), "overloaded")
+	assert.Contains(t, err.Error(), "gave up after 2 attempts")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestHTTPClient_DoesNotRetryClientErrors(t *testing.T) {
+	fastRetries(t)
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error": "invalid api key"}`))
+	}))
+	defer server.Close()
+
+	client := newHTTPClient("test", &Config{Timeout: 5 * time.Second, MaxRetries: 3})
+	err := client.postJSON(context.Background(), server.URL, nil, map[string]string{}, &struct{}{})
+
+	var providerErr *ProviderError
+	require.ErrorAs(t, err, &providerErr)
+	assert.False(t, providerErr.Retryable)
+	assert.Contains(t, err.Error(), "invalid api key")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestHTTPClient_Timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newHTTPClient("test", &Config{Timeout: 20 * time.Millisecond, MaxRetries: 0})
+	err := client.postJSON(context.Background(), server.URL, nil, map[string]string{}, &struct{}{})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timeout after 20ms")
+}
+
+func TestBackoffDelay(t *testing.T) {
+	assert.Equal(t, time.Second, backoffDelay(1))
+	assert.Equal(t, 2*time.Second, backoffDelay(2))
+	assert.Equal(t, 4*time.Second, backoffDelay(3))
+	assert.Equal(t, maxRetryDelay, backoffDelay(10))
+	assert.Equal(t, maxRetryDelay, backoffDelay(100))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	assert.Equal(t, 3*time.Second, parseRetryAfter("3"))
+	assert.Equal(t, maxRetryDelay, parseRetryAfter("3600"))
+	assert.Equal(t, time.Duration(0), parseRetryAfter(""))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("Wed, 21 Oct 2015 07:28:00 GMT"))
+}
+
+func TestJoinURL(t *testing.T) {
+	assert.Equal(t, "https://api.example.com/v1/messages", joinURL("https://api.example.com/", "/v1/messages"))
+	assert.Equal(t, "http://localhost:11434/api/generate", joinURL("http://localhost:11434", "api/generate"))
+}
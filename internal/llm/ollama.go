@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package llm
+
+import (
+	"context"
+	"fmt"
+)
+
+// OllamaProvider calls a local (or remote) Ollama server.
+// See: https://github.com/ollama/ollama/blob/main/docs/api.md
+//
+// No API key is needed. Pull the model first: ollama pull llama3.1
+type OllamaProvider struct {
+	config Config
+	http   *httpClient
+}
+
+// NewOllamaProvider creates a new Ollama API provider.
+func NewOllamaProvider(config *Config) *OllamaProvider {
+	if config.BaseURL == "" {
+		config.BaseURL = "http://localhost:11434"
+	}
+	if config.Model == "" {
+		config.Model = "llama3.1"
+	}
+	if config.Timeout == 0 {
+		config.Timeout = DefaultConfig().Timeout
+	}
+
+	return &OllamaProvider{
+		config: *config,
+		http:   newHTTPClient("ollama", config),
+	}
+}
+
+type ollamaOptions struct {
+	NumPredict int `json:"num_predict,omitempty"`
+}
+
+type ollamaRequest struct {
+	Model   string         `json:"model"`
+	Prompt  string         `json:"prompt"`
+	Stream  bool           `json:"stream"`
+	Options *ollamaOptions `json:"options,omitempty"`
+}
+
+type ollamaResponse struct {
+	Response string `json:"response"`
+	Done     bool   `json:"done"`
+
+	PromptEvalCount int `json:"prompt_eval_count"`
+	EvalCount       int `json:"eval_count"`
+}
+
+// Call sends a prompt to /api/generate without streaming.
+func (o *OllamaProvider) Call(ctx context.Context, prompt string) (string, error) {
+	text, _, err := o.CallWithUsage(ctx, prompt)
+	return text, err
+}
+
+// CallWithUsage is Call plus the token counts reported by Ollama.
+func (o *OllamaProvider) CallWithUsage(ctx context.Context, prompt string) (string, Usage, error) {
+	req := ollamaRequest{
+		Model:  o.config.Model,
+		Prompt: prompt,
+		Stream: false,
+	}
+	if o.config.MaxTokens > 0 {
+		req.Options = &ollamaOptions{NumPredict: o.config.MaxTokens}
+	}
+
+	var resp ollamaResponse
+	if err := o.http.postJSON(ctx, joinURL(o.config.BaseURL, "/api/generate"), nil, req, &resp); err != nil {
+		return "", Usage{}, err
+	}
+
+	if resp.Response == "" {
+		return "", Usage{}, &ProviderError{Provider: "ollama", Err: fmt.Errorf("empty response")}
+	}
+
+	return resp.Response, reportedUsage(prompt, resp.Response, resp.PromptEvalCount, resp.EvalCount), nil
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOllamaProvider_Defaults(t *testing.T) {
+	provider := NewOllamaProvider(&Config{})
+
+	assert.Equal(t, "http://localhost:11434", provider.config.BaseURL)
+	assert.Equal(t, "llama3.1", provider.config.Model)
+}
+
+func TestOllamaProvider_Call(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/generate", r.URL.Path)
+
+		var req ollamaRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "qwen2.5", req.Model)
+		assert.False(t, req.Stream)
+		require.NotNil(t, req.Options)
+		assert.Equal(t, 2048, req.Options.NumPredict)
+
+		_, _ = w.Write([]byte(`{"response":"plan text","done":true,"prompt_eval_count":7,"eval_count":9}`))
+	}))
+	defer server.Close()
+
+	provider := NewOllamaProvider(&Config{BaseURL: server.URL, Model: "qwen2.5", MaxTokens: 2048})
+	response, usage, err := provider.CallWithUsage(context.Background(), "make a plan")
+
+	require.NoError(t, err)
+	assert.Equal(t, "plan text", response)
+	assert.Equal(t, Usage{InputTokens: 7, OutputTokens: 9}, usage)
+}
+
+func TestOllamaProvider_Call_ServerDown(t *testing.T) {
+	fastRetries(t)
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	provider := NewOllamaProvider(&Config{BaseURL: url, MaxRetries: 1})
+	_, err := provider.Call(context.Background(), "prompt")
+
+	var providerErr *ProviderError
+	require.ErrorAs(t, err, &providerErr)
+	assert.Equal(t, "ollama", providerErr.Provider)
+	assert.True(t, providerErr.Retryable)
+}
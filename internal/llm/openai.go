@@ -0,0 +1,91 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package llm
+
+import (
+	"context"
+	"fmt"
+)
+
+// OpenAIProvider calls an OpenAI-compatible Chat Completions endpoint.
+// Besides api.openai.com this works with any server implementing
+// POST /chat/completions (OpenRouter, LM Studio, vLLM, ...) via BaseURL.
+//
+// The API key is read from OPENAI_API_KEY (or llm.api_key_env). It may be
+// empty for local servers that don't require one.
+type OpenAIProvider struct {
+	config Config
+	http   *httpClient
+}
+
+// NewOpenAIProvider creates a new OpenAI-compatible API provider.
+func NewOpenAIProvider(config *Config) *OpenAIProvider {
+	if config.BaseURL == "" {
+		config.BaseURL = "https://api.openai.com/v1"
+	}
+	if config.Model == "" {
+		config.Model = "gpt-4o"
+	}
+	if config.Timeout == 0 {
+		config.Timeout = DefaultConfig().Timeout
+	}
+
+	return &OpenAIProvider{
+		config: *config,
+		http:   newHTTPClient("openai", config),
+	}
+}
+
+type openAIMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type openAIRequest struct {
+	Model     string          `json:"model"`
+	Messages  []openAIMessage `json:"messages"`
+	MaxTokens int             `json:"max_tokens,omitempty"`
+}
+
+type openAIResponse struct {
+	Choices []struct {
+		Message      openAIMessage `json:"message"`
+		FinishReason string        `json:"finish_reason"`
+	} `json:"choices"`
+	Usage struct {
+		PromptTokens     int `json:"prompt_tokens"`
+		CompletionTokens int `json:"completion_tokens"`
+	} `json:"usage"`
+}
+
+// Call sends a prompt as a single user message and returns the reply.
+func (o *OpenAIProvider) Call(ctx context.Context, prompt string) (string, error) {
+	text, _, err := o.CallWithUsage(ctx, prompt)
+	return text, err
+}
+
+// CallWithUsage is Call plus the token usage reported by the server.
+func (o *OpenAIProvider) CallWithUsage(ctx context.Context, prompt string) (string, Usage, error) {
+	req := openAIRequest{
+		Model:     o.config.Model,
+		Messages:  []openAIMessage{{Role: "user", Content: prompt}},
+		MaxTokens: o.config.MaxTokens,
+	}
+	headers := map[string]string{}
+	if o.config.APIKey != "" {
+		headers["Authorization"] = "Bearer " + o.config.APIKey
+	}
+
+	var resp openAIResponse
+	if err := o.http.postJSON(ctx, joinURL(o.config.BaseURL, "/chat/completions"), headers, req, &resp); err != nil {
+		return "", Usage{}, err
+	}
+
+	if len(resp.Choices) == 0 || resp.Choices[0].Message.Content == "" {
+		return "", Usage{}, &ProviderError{Provider: "openai", Err: fmt.Errorf("empty response")}
+	}
+
+	text := resp.Choices[0].Message.Content
+	return text, reportedUsage(prompt, text, resp.Usage.PromptTokens, resp.Usage.CompletionTokens), nil
+}
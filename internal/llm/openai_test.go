@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOpenAIProvider_Defaults(t *testing.T) {
+	provider := NewOpenAIProvider(&Config{})
+
+	assert.Equal(t, "https://api.openai.com/v1", provider.config.BaseURL)
+	assert.Equal(t, "gpt-4o", provider.config.Model)
+}
+
+func TestOpenAIProvider_Call(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/chat/completions", r.URL.Path)
+		assert.Equal(t, "Bearer sk-test", r.Header.Get("Authorization"))
+
+		var req openAIRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "gpt-test", req.Model)
+		assert.Equal(t, "make a plan", req.Messages[0].Content)
+
+		_, _ = w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"plan text"},"finish_reason":"stop"}]}`))
+	}))
+	defer server.Close()
+
+	provider := NewOpenAIProvider(&Config{BaseURL: server.URL + "/v1", APIKey: "sk-test", Model: "gpt-test"})
+	response, usage, err := provider.CallWithUsage(context.Background(), "make a plan")
+
+	require.NoError(t, err)
+	assert.Equal(t, "plan text", response)
+	// The server omitted usage, so it is estimated from the text.
+	assert.True(t, usage.Estimated)
+	assert.Equal(t, 3, usage.InputTokens)
+}
+
+func TestOpenAIProvider_CallWithUsage_Reported(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"plan text"}}],"usage":{"prompt_tokens":20,"completion_tokens":40}}`))
+	}))
+	defer server.Close()
+
+	provider := NewOpenAIProvider(&Config{BaseURL: server.URL, APIKey: "sk-test"})
+	_, usage, err := provider.CallWithUsage(context.Background(), "make a plan")
+
+	require.NoError(t, err)
+	assert.Equal(t, Usage{InputTokens: 20, OutputTokens: 40}, usage)
+}
+
+func TestOpenAIProvider_Call_NoKeyForLocalServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"choices":[]}`))
+	}))
+	defer server.Close()
+
+	provider := NewOpenAIProvider(&Config{BaseURL: server.URL})
+	_, err := provider.Call(context.Background(), "prompt")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "empty response")
+}
@@ -5,6 +5,8 @@ package llm
 
 import (
 	"context"
+	"errors"
+	"os/exec"
 	"time"
 )
 
@@ -39,6 +41,17 @@ type Config struct {
 
 	// Whether to pass prompt via stdin (vs command line arg)
 	UseStdin bool
+
+	// BaseURL overrides the API endpoint for HTTP providers
+	// (e.g., a proxy, an OpenAI-compatible server, or a remote Ollama host)
+	BaseURL string
+
+	// APIKey for HTTP providers. Read from an environment variable by the
+	// caller; never stored in config.
+	APIKey string
+
+	// MaxTokens caps the response length for HTTP providers (0 uses the provider default)
+	MaxTokens int
 }
 
 // DefaultConfig returns a Config with sensible defaults.
@@ -53,6 +66,11 @@ func DefaultConfig() Config {
 	}
 }
 
+// ErrLLMUnavailable matches provider errors where the LLM could not be
+// reached at all: its CLI is not installed, its API key or command is not
+// set, or the request never got an answer.
+var ErrLLMUnavailable = errors.New("LLM unavailable")
+
 // ProviderError represents an error from an LLM provider.
 type ProviderError struct {
 	// Provider that generated the error
@@ -63,6 +81,12 @@ type ProviderError struct {
 
 	// Whether this error is retryable
 	Retryable bool
+
+	// Whether the provider could not be reached at all (see ErrLLMUnavailable)
+	Unavailable bool
+
+	// retryAfter is the server-requested wait before retrying (HTTP providers)
+	retryAfter time.Duration
 }
 
 func (e *ProviderError) Error() string {
@@ -72,3 +96,9 @@ func (e *ProviderError) Error() string {
 func (e *ProviderError) Unwrap() error {
 	return e.Err
 }
+
+// Is matches ErrLLMUnavailable for unavailable providers, including CLI
+// providers whose command is not installed.
+func (e *ProviderError) Is(target error) bool {
+	return target == ErrLLMUnavailable && (e.Unavailable || errors.Is(e.Err, exec.ErrNotFound))
+}
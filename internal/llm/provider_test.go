@@ -164,6 +164,7 @@ func TestClaudeProvider_Call_CommandNotFound(t *testing.T) {
 	require.ErrorAs(t, err, &providerErr)
 	assert.Equal(t, "claude", providerErr.Provider)
 	assert.False(t, providerErr.Retryable) // Command not found is not retryable
+	assert.ErrorIs(t, err, ErrLLMUnavailable)
 }
 
 func TestClaudeProvider_Call_Timeout(t *testing.T) {
@@ -199,3 +200,8 @@ func TestProviderError_Unwrap(t *testing.T) {
 
 	assert.Equal(t, originalErr, err.Unwrap())
 }
+
+func TestProviderError_Is(t *testing.T) {
+	assert.ErrorIs(t, &ProviderError{Provider: "anthropic", Err: fmt.Errorf("API key not set"), Unavailable: true}, ErrLLMUnavailable)
+	assert.NotErrorIs(t, &ProviderError{Provider: "anthropic", Err: fmt.Errorf("empty response")}, ErrLLMUnavailable)
+}
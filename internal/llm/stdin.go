@@ -46,9 +46,10 @@ func (s *StdinProvider) Call(ctx context.Context, prompt string) (string, error)
 	// Validate command
 	if s.config.Command == "" {
 		return "", &ProviderError{
-			Provider:  "stdin",
-			Err:       fmt.Errorf("command not configured"),
-			Retryable: false,
+			Provider:    "stdin",
+			Err:         fmt.Errorf("command not configured"),
+			Retryable:   false,
+			Unavailable: true,
 		}
 	}
 
@@ -0,0 +1,218 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package llm
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pezware/samedi.dev/internal/log"
+)
+
+// Operations recorded in the LLM cost ledger.
+const (
+	OperationPlanGenerate   = "plan.generate"
+	OperationPlanRegenerate = "plan.regenerate"
+	OperationPlanRepair     = "plan.repair"
+	OperationPlanImport     = "plan.import"
+	OperationCardsGenerate  = "cards.generate"
+	OperationWeekPlan       = "week.plan"
+	OperationQuizGenerate   = "quiz.generate"
+	OperationQuizGrade      = "quiz.grade"
+)
+
+// Usage is the token count of a single LLM call.
+type Usage struct {
+	InputTokens  int
+	OutputTokens int
+
+	// Estimated is true when the provider didn't report usage and the
+	// counts were approximated from the prompt and response text.
+	Estimated bool
+}
+
+// TotalTokens returns input plus output tokens.
+func (u Usage) TotalTokens() int {
+	return u.InputTokens + u.OutputTokens
+}
+
+// UsageProvider is implemented by providers that report token usage
+// (the HTTP API providers). CLI providers only implement Provider.
+type UsageProvider interface {
+	Provider
+
+	// CallWithUsage behaves like Call and also returns the reported usage.
+	CallWithUsage(ctx context.Context, prompt string) (string, Usage, error)
+}
+
+// EstimateTokens approximates the token count of text using the common
+// rule of thumb of four characters per token.
+func EstimateTokens(text string) int {
+	chars := utf8.RuneCountInString(text)
+	if chars == 0 {
+		return 0
+	}
+	return (chars + 3) / 4
+}
+
+// EstimateUsage approximates usage for a call from its prompt and response.
+func EstimateUsage(prompt, response string) Usage {
+	return Usage{
+		InputTokens:  EstimateTokens(prompt),
+		OutputTokens: EstimateTokens(response),
+		Estimated:    true,
+	}
+}
+
+// reportedUsage builds Usage from provider-reported counts, falling back to
+// an estimate when the server omitted them (some OpenAI-compatible servers do).
+func reportedUsage(prompt, response string, input, output int) Usage {
+	if input == 0 && output == 0 {
+		return EstimateUsage(prompt, response)
+	}
+	return Usage{InputTokens: input, OutputTokens: output}
+}
+
+// modelPrice is the list price in USD per million tokens.
+type modelPrice struct {
+	prefix string
+	input  float64
+	output float64
+}
+
+// modelPrices holds list prices for common models, matched by the longest
+// prefix of the lowercased model name. Prices change; treat costs derived
+// from this table as estimates.
+var modelPrices = []modelPrice{
+	{prefix: "claude-opus-4", input: 15, output: 75},
+	{prefix: "claude-3-opus", input: 15, output: 75},
+	{prefix: "opus", input: 15, output: 75},
+	{prefix: "claude-sonnet-4", input: 3, output: 15},
+	{prefix: "claude-3-7-sonnet", input: 3, output: 15},
+	{prefix: "claude-3-5-sonnet", input: 3, output: 15},
+	{prefix: "sonnet", input: 3, output: 15},
+	{prefix: "claude-haiku-4", input: 1, output: 5},
+	{prefix: "claude-3-5-haiku", input: 0.8, output: 4},
+	{prefix: "haiku", input: 0.8, output: 4},
+	{prefix: "gpt-4o-mini", input: 0.15, output: 0.6},
+	{prefix: "gpt-4o", input: 2.5, output: 10},
+	{prefix: "gpt-4.1-nano", input: 0.1, output: 0.4},
+	{prefix: "gpt-4.1-mini", input: 0.4, output: 1.6},
+	{prefix: "gpt-4.1", input: 2, output: 8},
+	{prefix: "o3-mini", input: 1.1, output: 4.4},
+	{prefix: "o4-mini", input: 1.1, output: 4.4},
+	{prefix: "gemini-2.5-pro", input: 1.25, output: 10},
+	{prefix: "gemini-2.5-flash", input: 0.3, output: 2.5},
+}
+
+// EstimateCost returns the estimated cost in USD of a call. ok is false
+// when the price is unknown: local models (ollama), the mock provider, and
+// models missing from the price table all cost 0.
+func EstimateCost(provider, model string, usage Usage) (cost float64, ok bool) {
+	switch strings.ToLower(provider) {
+	case "ollama", "mock":
+		return 0, true
+	}
+
+	name := strings.ToLower(model)
+	var best *modelPrice
+	for i := range modelPrices {
+		price := &modelPrices[i]
+		if strings.HasPrefix(name, price.prefix) && (best == nil || len(price.prefix) > len(best.prefix)) {
+			best = price
+		}
+	}
+	if best == nil {
+		return 0, false
+	}
+
+	return (float64(usage.InputTokens)*best.input + float64(usage.OutputTokens)*best.output) / 1_000_000, true
+}
+
+// CallInfo describes why an LLM call was made, for the cost ledger.
+type CallInfo struct {
+	Operation string
+	PlanID    string
+}
+
+type callInfoKey struct{}
+
+// WithCallInfo attaches call information to a context.
+func WithCallInfo(ctx context.Context, info CallInfo) context.Context {
+	return context.WithValue(ctx, callInfoKey{}, info)
+}
+
+// CallInfoFrom returns the call information attached to ctx, if any.
+func CallInfoFrom(ctx context.Context) CallInfo {
+	info, _ := ctx.Value(callInfoKey{}).(CallInfo)
+	return info
+}
+
+// CallRecord is passed to a MeteredProvider's recorder after each
+// successful call.
+type CallRecord struct {
+	CallInfo
+	Provider string
+	Model    string
+	Usage    Usage
+}
+
+// MeteredProvider wraps a provider and reports token usage for every
+// successful call. Usage is taken from the provider when it implements
+// UsageProvider and estimated otherwise.
+type MeteredProvider struct {
+	inner    Provider
+	provider string
+	model    string
+	record   func(context.Context, CallRecord)
+}
+
+// NewMeteredProvider wraps inner. provider and model label the records;
+// record is called synchronously after each successful call.
+func NewMeteredProvider(inner Provider, provider, model string, record func(context.Context, CallRecord)) *MeteredProvider {
+	return &MeteredProvider{
+		inner:    inner,
+		provider: provider,
+		model:    model,
+		record:   record,
+	}
+}
+
+// Call forwards the prompt to the wrapped provider and records its usage.
+func (m *MeteredProvider) Call(ctx context.Context, prompt string) (string, error) {
+	var (
+		response string
+		usage    Usage
+		err      error
+	)
+	info := CallInfoFrom(ctx)
+	start := time.Now()
+
+	if up, ok := m.inner.(UsageProvider); ok {
+		response, usage, err = up.CallWithUsage(ctx, prompt)
+	} else {
+		response, err = m.inner.Call(ctx, prompt)
+		usage = EstimateUsage(prompt, response)
+	}
+	elapsed := time.Since(start).Round(time.Millisecond)
+	if err != nil {
+		log.Error("llm call failed", "operation", info.Operation, "plan", info.PlanID,
+			"provider", m.provider, "model", m.model, "duration", elapsed, "error", err)
+		return "", err
+	}
+	log.Info("llm call", "operation", info.Operation, "plan", info.PlanID, "provider", m.provider,
+		"model", m.model, "duration", elapsed, "input_tokens", usage.InputTokens,
+		"output_tokens", usage.OutputTokens, "estimated", usage.Estimated)
+
+	m.record(ctx, CallRecord{
+		CallInfo: info,
+		Provider: m.provider,
+		Model:    m.model,
+		Usage:    usage,
+	})
+
+	return response, nil
+}
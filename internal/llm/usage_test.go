@@ -0,0 +1,103 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package llm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateTokens(t *testing.T) {
+	assert.Equal(t, 0, EstimateTokens(""))
+	assert.Equal(t, 1, EstimateTokens("abc"))
+	assert.Equal(t, 2, EstimateTokens("hello"))
+	// Counts runes, not bytes
+	assert.Equal(t, 1, EstimateTokens("日本語"))
+}
+
+func TestEstimateCost(t *testing.T) {
+	usage := Usage{InputTokens: 1_000_000, OutputTokens: 100_000}
+
+	cost, ok := EstimateCost("anthropic", "claude-sonnet-4-20250514", usage)
+	assert.True(t, ok)
+	assert.InDelta(t, 4.5, cost, 0.0001) // $3 in + $1.50 out
+
+	// Longest prefix wins: gpt-4o-mini is not priced as gpt-4o
+	cost, ok = EstimateCost("openai", "gpt-4o-mini", usage)
+	assert.True(t, ok)
+	assert.InDelta(t, 0.21, cost, 0.0001)
+
+	cost, ok = EstimateCost("ollama", "llama3.1", usage)
+	assert.True(t, ok)
+	assert.Zero(t, cost)
+
+	cost, ok = EstimateCost("llm", "some-unknown-model", usage)
+	assert.False(t, ok)
+	assert.Zero(t, cost)
+}
+
+func TestCallInfo_Context(t *testing.T) {
+	assert.Equal(t, CallInfo{}, CallInfoFrom(context.Background()))
+
+	ctx := WithCallInfo(context.Background(), CallInfo{Operation: OperationPlanGenerate, PlanID: "rust"})
+	assert.Equal(t, CallInfo{Operation: OperationPlanGenerate, PlanID: "rust"}, CallInfoFrom(ctx))
+}
+
+func TestMeteredProvider_EstimatesForCLIProviders(t *testing.T) {
+	mock := NewMockProvider()
+	mock.DefaultResponse = "12345678"
+
+	var records []CallRecord
+	metered := NewMeteredProvider(mock, "claude", "sonnet", func(_ context.Context, call CallRecord) {
+		records = append(records, call)
+	})
+
+	ctx := WithCallInfo(context.Background(), CallInfo{Operation: OperationPlanGenerate, PlanID: "rust"})
+	response, err := metered.Call(ctx, "abcd")
+	require.NoError(t, err)
+	assert.Equal(t, "12345678", response)
+
+	require.Len(t, records, 1)
+	assert.Equal(t, OperationPlanGenerate, records[0].Operation)
+	assert.Equal(t, "rust", records[0].PlanID)
+	assert.Equal(t, "claude", records[0].Provider)
+	assert.Equal(t, "sonnet", records[0].Model)
+	assert.Equal(t, Usage{InputTokens: 1, OutputTokens: 2, Estimated: true}, records[0].Usage)
+}
+
+type usageStub struct{}
+
+func (usageStub) Call(context.Context, string) (string, error) { return "ok", nil }
+
+func (usageStub) CallWithUsage(context.Context, string) (string, Usage, error) {
+	return "ok", Usage{InputTokens: 100, OutputTokens: 200}, nil
+}
+
+func TestMeteredProvider_UsesReportedUsage(t *testing.T) {
+	var records []CallRecord
+	metered := NewMeteredProvider(usageStub{}, "anthropic", "claude-sonnet-4", func(_ context.Context, call CallRecord) {
+		records = append(records, call)
+	})
+
+	_, err := metered.Call(context.Background(), "prompt")
+	require.NoError(t, err)
+
+	require.Len(t, records, 1)
+	assert.Equal(t, Usage{InputTokens: 100, OutputTokens: 200}, records[0].Usage)
+}
+
+func TestMeteredProvider_DoesNotRecordFailures(t *testing.T) {
+	mock := NewMockProvider()
+	mock.ShouldError = true
+
+	recorded := false
+	metered := NewMeteredProvider(mock, "claude", "", func(context.Context, CallRecord) { recorded = true })
+
+	_, err := metered.Call(context.Background(), "prompt")
+	assert.Error(t, err)
+	assert.False(t, recorded)
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+// Package log is samedi's diagnostic log. Commands write it to a rotating
+// file under ~/.samedi/logs, which bug reports can attach, and to stderr
+// with --verbose. Until Setup runs, as in tests, everything is discarded.
+package log
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+)
+
+// FileName is the log file in the log directory. Rotated files get a
+// numeric suffix: samedi.log.1 is the newest.
+const FileName = "samedi.log"
+
+// Defaults for Options.
+const (
+	DefaultMaxBytes = 1 << 20
+	DefaultKeep     = 3
+)
+
+// Options configures Setup.
+type Options struct {
+	Dir      string     // Directory for the log file; empty writes no file
+	Level    slog.Level // Minimum level written to the file
+	Verbose  io.Writer  // Also write everything from debug up here, e.g. stderr for --verbose
+	MaxBytes int64      // Rotate the file once it reaches this size (DefaultMaxBytes when 0)
+	Keep     int        // Rotated files kept besides the current one (DefaultKeep when 0)
+}
+
+var current atomic.Pointer[slog.Logger]
+
+func init() {
+	current.Store(slog.New(slog.DiscardHandler))
+}
+
+// Setup starts logging as opts describes and returns a closer for the log
+// file. Records go to the file and the verbose writer, whichever are set.
+func Setup(opts Options) (io.Closer, error) {
+	var (
+		handlers []slog.Handler
+		closer   io.Closer = nopCloser{}
+	)
+
+	if opts.Dir != "" {
+		if err := os.MkdirAll(opts.Dir, 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create log directory: %w", err)
+		}
+		file, err := openRotating(filepath.Join(opts.Dir, FileName), opts.MaxBytes, opts.Keep)
+		if err != nil {
+			return nil, err
+		}
+		handlers = append(handlers, slog.NewTextHandler(file, &slog.HandlerOptions{Level: opts.Level}))
+		closer = file
+	}
+	if opts.Verbose != nil {
+		handlers = append(handlers, slog.NewTextHandler(opts.Verbose, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	}
+
+	switch len(handlers) {
+	case 0:
+		current.Store(slog.New(slog.DiscardHandler))
+	case 1:
+		current.Store(slog.New(handlers[0]))
+	default:
+		current.Store(slog.New(fanout(handlers)))
+	}
+	return closer, nil
+}
+
+// Logger returns the logger Setup configured.
+func Logger() *slog.Logger {
+	return current.Load()
+}
+
+// Enabled reports whether records at level are written anywhere, so
+// callers can skip building expensive attributes.
+func Enabled(level slog.Level) bool {
+	return Logger().Enabled(context.Background(), level)
+}
+
+// Debug logs at debug level.
+func Debug(msg string, args ...any) {
+	Logger().Debug(msg, args...)
+}
+
+// Info logs at info level.
+func Info(msg string, args ...any) {
+	Logger().Info(msg, args...)
+}
+
+// Warn logs at warn level.
+func Warn(msg string, args ...any) {
+	Logger().Warn(msg, args...)
+}
+
+// Error logs at error level.
+func Error(msg string, args ...any) {
+	Logger().Error(msg, args...)
+}
+
+// ParseLevel parses "debug", "info", "warn", or "error".
+func ParseLevel(value string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info":
+		return slog.LevelInfo, nil
+	case "warn":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	}
+	return 0, fmt.Errorf("invalid log level %q (must be debug, info, warn, or error)", value)
+}
+
+// fanout sends each record to every handler that accepts its level.
+type fanout []slog.Handler
+
+func (f fanout) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, h := range f {
+		if h.Enabled(ctx, level) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f fanout) Handle(ctx context.Context, record slog.Record) error {
+	var first error
+	for _, h := range f {
+		if !h.Enabled(ctx, record.Level) {
+			continue
+		}
+		if err := h.Handle(ctx, record.Clone()); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+func (f fanout) WithAttrs(attrs []slog.Attr) slog.Handler {
+	handlers := make(fanout, len(f))
+	for i, h := range f {
+		handlers[i] = h.WithAttrs(attrs)
+	}
+	return handlers
+}
+
+func (f fanout) WithGroup(name string) slog.Handler {
+	handlers := make(fanout, len(f))
+	for i, h := range f {
+		handlers[i] = h.WithGroup(name)
+	}
+	return handlers
+}
+
+type nopCloser struct{}
+
+func (nopCloser) Close() error { return nil }
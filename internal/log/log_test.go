@@ -0,0 +1,72 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package log
+
+import (
+	"bytes"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetup(t *testing.T) {
+	t.Cleanup(func() { current.Store(slog.New(slog.DiscardHandler)) })
+
+	assert.False(t, Enabled(slog.LevelError), "discarded until Setup")
+
+	dir := filepath.Join(t.TempDir(), "logs")
+	var verbose bytes.Buffer
+	closer, err := Setup(Options{Dir: dir, Level: slog.LevelInfo, Verbose: &verbose})
+	require.NoError(t, err)
+
+	Debug("db query", "ms", 3)
+	Info("llm call", "provider", "claude")
+	require.NoError(t, closer.Close())
+
+	data, err := os.ReadFile(filepath.Join(dir, FileName))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `msg="llm call" provider=claude`)
+	assert.NotContains(t, string(data), "db query", "below the file's level")
+	assert.Contains(t, verbose.String(), `msg="db query" ms=3`)
+	assert.Contains(t, verbose.String(), "llm call")
+
+	_, err = Setup(Options{})
+	require.NoError(t, err)
+	assert.False(t, Enabled(slog.LevelError))
+}
+
+func TestParseLevel(t *testing.T) {
+	level, err := ParseLevel(" Debug ")
+	require.NoError(t, err)
+	assert.Equal(t, slog.LevelDebug, level)
+
+	_, err = ParseLevel("trace")
+	assert.ErrorContains(t, err, `invalid log level "trace"`)
+}
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+	file, err := openRotating(path, 10, 2)
+	require.NoError(t, err)
+	defer file.Close()
+
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		_, err := file.Write([]byte(line))
+		require.NoError(t, err)
+	}
+
+	read := func(name string) string {
+		data, err := os.ReadFile(name)
+		require.NoError(t, err)
+		return string(data)
+	}
+	assert.Equal(t, "fourth\n", read(path))
+	assert.Equal(t, "third\n", read(path+".1"))
+	assert.Equal(t, "second\n", read(path+".2"))
+	assert.NoFileExists(t, path+".3", "only keep rotated files are kept")
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+// Package mcp serves samedi to AI assistants over the Model Context
+// Protocol: JSON-RPC 2.0 messages, one per line, on stdin and stdout.
+// Only tools are offered; resources and prompts are not.
+package mcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/storage"
+)
+
+// ProtocolVersion is the newest MCP revision the server speaks.
+const ProtocolVersion = "2025-06-18"
+
+// supportedVersions are the revisions a client may ask for. Tool calls
+// haven't changed across them.
+var supportedVersions = map[string]bool{
+	"2024-11-05": true,
+	"2025-03-26": true,
+	"2025-06-18": true,
+}
+
+// maxMessageBytes caps a single message.
+const maxMessageBytes = 4 << 20
+
+// JSON-RPC error codes.
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+)
+
+// PlanService is the subset of the plan service the tools use.
+type PlanService interface {
+	List(ctx context.Context, filter *storage.PlanFilter) ([]*storage.PlanRecord, error)
+	Get(ctx context.Context, id string) (*plan.Plan, error)
+	Exists(ctx context.Context, id string) bool
+	Create(ctx context.Context, req plan.CreateRequest) (*plan.Plan, error)
+}
+
+// SessionService is the subset of the session service the tools use.
+type SessionService interface {
+	GetActive(ctx context.Context) (*session.Session, error)
+	GetChunkSessions(ctx context.Context, planID, chunkID string) ([]*session.Session, error)
+	Start(ctx context.Context, req session.StartRequest) (*session.Session, error)
+	Stop(ctx context.Context, req session.StopRequest) (*session.Session, error)
+	Record(ctx context.Context, sess *session.Session, policy session.OverlapPolicy) (*session.RecordResult, error)
+}
+
+// Options configures a Server.
+type Options struct {
+	Plans    PlanService
+	Sessions SessionService
+	Version  string // Reported to clients as the server version
+
+	// ReadOnly refuses tools that change data.
+	ReadOnly bool
+
+	// AfterSession and AfterPlan, if set, run after a tool stops or logs
+	// a session and after it creates a plan, e.g. to commit and mirror the
+	// change as the CLI commands do.
+	AfterSession func(sess *session.Session)
+	AfterPlan    func(p *plan.Plan)
+}
+
+// Server answers MCP requests.
+type Server struct {
+	opts  Options
+	tools []tool
+}
+
+// New creates a server.
+func New(opts Options) *Server {
+	s := &Server{opts: opts}
+	s.tools = s.toolset()
+	for i := range s.tools {
+		s.tools[i].Annotations = map[string]any{"readOnlyHint": !s.tools[i].mutates}
+	}
+	return s
+}
+
+// request is a JSON-RPC request or, without an ID, a notification.
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Serve reads requests from r and writes responses to w until r ends or
+// ctx is canceled. Requests are handled one at a time, in order.
+func (s *Server) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64<<10), maxMessageBytes)
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return nil
+		}
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		if resp := s.handle(ctx, line); resp != nil {
+			if err := s.write(w, resp); err != nil {
+				return err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read request: %w", err)
+	}
+	return nil
+}
+
+func (s *Server) write(w io.Writer, resp *response) error {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return fmt.Errorf("failed to encode response: %w", err)
+	}
+	if _, err := w.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write response: %w", err)
+	}
+	return nil
+}
+
+// handle answers one message. Notifications get no response.
+func (s *Server) handle(ctx context.Context, line []byte) *response {
+	var req request
+	if err := json.Unmarshal(line, &req); err != nil {
+		return errorResponse(json.RawMessage("null"), codeParseError, "parse error: "+err.Error())
+	}
+	if req.ID == nil {
+		return nil
+	}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		return errorResponse(req.ID, codeInvalidRequest, "invalid request")
+	}
+
+	var (
+		result any
+		rpcErr *rpcError
+	)
+	switch req.Method {
+	case "initialize":
+		result, rpcErr = s.initialize(req.Params)
+	case "ping":
+		result = struct{}{}
+	case "tools/list":
+		result = map[string]any{"tools": s.tools}
+	case "tools/call":
+		result, rpcErr = s.callTool(ctx, req.Params)
+	default:
+		rpcErr = &rpcError{Code: codeMethodNotFound, Message: "method not found: " + req.Method}
+	}
+	if rpcErr != nil {
+		return errorResponse(req.ID, rpcErr.Code, rpcErr.Message)
+	}
+	return &response{JSONRPC: "2.0", ID: req.ID, Result: result}
+}
+
+func errorResponse(id json.RawMessage, code int, message string) *response {
+	return &response{JSONRPC: "2.0", ID: id, Error: &rpcError{Code: code, Message: message}}
+}
+
+// initialize agrees on a protocol version: the client's if supported,
+// else the newest this server knows.
+func (s *Server) initialize(params json.RawMessage) (any, *rpcError) {
+	var p struct {
+		ProtocolVersion string `json:"protocolVersion"`
+	}
+	if len(params) > 0 {
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, &rpcError{Code: codeInvalidParams, Message: "invalid initialize params: " + err.Error()}
+		}
+	}
+	version := ProtocolVersion
+	if supportedVersions[p.ProtocolVersion] {
+		version = p.ProtocolVersion
+	}
+	return map[string]any{
+		"protocolVersion": version,
+		"capabilities":    map[string]any{"tools": map[string]any{}},
+		"serverInfo":      map[string]any{"name": "samedi", "version": s.opts.Version},
+		"instructions": "samedi tracks learning plans made of chunks, and the study sessions " +
+			"logged against them. Use list_plans and get_plan to find plan and chunk IDs " +
+			"before starting, stopping, or logging sessions.",
+	}, nil
+}
@@ -0,0 +1,245 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePlans struct {
+	plans map[string]*plan.Plan
+}
+
+func (f *fakePlans) List(_ context.Context, _ *storage.PlanFilter) ([]*storage.PlanRecord, error) {
+	var records []*storage.PlanRecord
+	for _, p := range f.plans {
+		records = append(records, &storage.PlanRecord{ID: p.ID, Title: p.Title, Status: string(p.Status)})
+	}
+	return records, nil
+}
+
+func (f *fakePlans) Get(_ context.Context, id string) (*plan.Plan, error) {
+	return f.plans[id], nil
+}
+
+func (f *fakePlans) Exists(_ context.Context, id string) bool {
+	return f.plans[id] != nil
+}
+
+func (f *fakePlans) Create(_ context.Context, req plan.CreateRequest) (*plan.Plan, error) {
+	p := &plan.Plan{ID: "new-plan", Title: req.Topic, TotalHours: req.TotalHours}
+	f.plans[p.ID] = p
+	return p, nil
+}
+
+type fakeSessions struct {
+	active   *session.Session
+	recorded []*session.Session
+}
+
+func (f *fakeSessions) GetActive(_ context.Context) (*session.Session, error) {
+	return f.active, nil
+}
+
+func (f *fakeSessions) GetChunkSessions(_ context.Context, _, chunkID string) ([]*session.Session, error) {
+	var out []*session.Session
+	for _, sess := range f.recorded {
+		if sess.ChunkID == chunkID {
+			out = append(out, sess)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeSessions) Start(_ context.Context, req session.StartRequest) (*session.Session, error) {
+	if f.active != nil {
+		return nil, errors.New("active session already exists")
+	}
+	f.active = &session.Session{ID: "s1", PlanID: req.PlanID, ChunkID: req.ChunkID, StartTime: time.Now()}
+	return f.active, nil
+}
+
+func (f *fakeSessions) Stop(_ context.Context, req session.StopRequest) (*session.Session, error) {
+	if f.active == nil {
+		return nil, errors.New("no active session")
+	}
+	sess := f.active
+	sess.Notes = req.Notes
+	end := time.Now()
+	sess.EndTime = &end
+	f.active = nil
+	f.recorded = append(f.recorded, sess)
+	return sess, nil
+}
+
+func (f *fakeSessions) Record(_ context.Context, sess *session.Session, _ session.OverlapPolicy) (*session.RecordResult, error) {
+	sess.Duration = sess.CalculateDuration()
+	f.recorded = append(f.recorded, sess)
+	return &session.RecordResult{Session: sess, Action: "created"}, nil
+}
+
+func newTestServer(readOnly bool) (*Server, *fakeSessions, *[]string) {
+	sessions := &fakeSessions{}
+	var changes []string
+	server := New(Options{
+		Plans: &fakePlans{plans: map[string]*plan.Plan{
+			"rust": {ID: "rust", Title: "Rust", Chunks: []plan.Chunk{{ID: "chunk-001", Title: "Ownership"}}},
+		}},
+		Sessions:     sessions,
+		Version:      "test",
+		ReadOnly:     readOnly,
+		AfterSession: func(sess *session.Session) { changes = append(changes, "session "+sess.PlanID) },
+		AfterPlan:    func(p *plan.Plan) { changes = append(changes, "plan "+p.ID) },
+	})
+	return server, sessions, &changes
+}
+
+// exchange sends messages, one per line, and decodes each response.
+func exchange(t *testing.T, server *Server, messages ...string) []map[string]any {
+	t.Helper()
+	var out bytes.Buffer
+	require.NoError(t, server.Serve(context.Background(), strings.NewReader(strings.Join(messages, "\n")+"\n"), &out))
+
+	var responses []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var resp map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &resp), line)
+		responses = append(responses, resp)
+	}
+	return responses
+}
+
+// callTool calls one tool and returns its text and whether it failed.
+func callTool(t *testing.T, server *Server, name, args string) (string, bool) {
+	t.Helper()
+	msg := `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"` + name + `","arguments":` + args + `}}`
+	responses := exchange(t, server, msg)
+	require.Len(t, responses, 1)
+	result, ok := responses[0]["result"].(map[string]any)
+	require.True(t, ok, "tools/call returned %v", responses[0])
+	content := result["content"].([]any)[0].(map[string]any)
+	return content["text"].(string), result["isError"].(bool)
+}
+
+func TestServer_Handshake(t *testing.T) {
+	server, _, _ := newTestServer(false)
+
+	responses := exchange(t, server,
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2024-11-05","capabilities":{},"clientInfo":{"name":"test"}}}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"tools/list"}`,
+		`{"jsonrpc":"2.0","id":3,"method":"resources/list"}`,
+		`not json`,
+	)
+	require.Len(t, responses, 4, "notifications get no response")
+
+	initResult := responses[0]["result"].(map[string]any)
+	assert.Equal(t, "2024-11-05", initResult["protocolVersion"])
+	assert.Equal(t, "samedi", initResult["serverInfo"].(map[string]any)["name"])
+
+	tools := responses[1]["result"].(map[string]any)["tools"].([]any)
+	var names []string
+	for _, tool := range tools {
+		names = append(names, tool.(map[string]any)["name"].(string))
+	}
+	assert.Equal(t, []string{"list_plans", "get_plan", "get_chunk", "get_status", "start_session", "stop_session", "log_session", "create_plan"}, names)
+
+	assert.Equal(t, float64(codeMethodNotFound), responses[2]["error"].(map[string]any)["code"])
+	assert.Equal(t, float64(codeParseError), responses[3]["error"].(map[string]any)["code"])
+}
+
+func TestServer_SessionTools(t *testing.T) {
+	server, sessions, changes := newTestServer(false)
+
+	text, failed := callTool(t, server, "start_session", `{"plan_id":"rust","chunk_id":"chunk-001"}`)
+	assert.False(t, failed, text)
+	require.NotNil(t, sessions.active)
+
+	text, failed = callTool(t, server, "start_session", `{"plan_id":"rust"}`)
+	assert.True(t, failed)
+	assert.Contains(t, text, "already exists")
+
+	text, failed = callTool(t, server, "stop_session", `{"notes":"Borrowing rules"}`)
+	assert.False(t, failed, text)
+	assert.Contains(t, text, "Borrowing rules")
+
+	text, failed = callTool(t, server, "log_session", `{"plan_id":"rust","chunk_id":"chunk-001","minutes":30,"ended_at":"2025-01-15T19:30:00Z"}`)
+	assert.False(t, failed, text)
+	require.Len(t, sessions.recorded, 2)
+	logged := sessions.recorded[1]
+	assert.Equal(t, 30, logged.Duration)
+	assert.True(t, logged.EndTime.Equal(time.Date(2025, 1, 15, 19, 30, 0, 0, time.UTC)))
+
+	text, failed = callTool(t, server, "get_chunk", `{"plan_id":"rust","chunk_id":"chunk-001"}`)
+	assert.False(t, failed, text)
+	var detail chunkDetail
+	require.NoError(t, json.Unmarshal([]byte(text), &detail))
+	assert.Equal(t, "Ownership", detail.Chunk.Title)
+	assert.Len(t, detail.Sessions, 2)
+
+	assert.Equal(t, []string{"session rust", "session rust"}, *changes)
+}
+
+func TestServer_ToolErrors(t *testing.T) {
+	server, _, _ := newTestServer(false)
+
+	text, failed := callTool(t, server, "get_plan", `{"plan_id":"missing"}`)
+	assert.True(t, failed)
+	assert.Contains(t, text, "plan not found")
+
+	text, failed = callTool(t, server, "log_session", `{"plan_id":"rust","minutes":0}`)
+	assert.True(t, failed)
+	assert.Contains(t, text, "minutes must be positive")
+
+	text, failed = callTool(t, server, "get_chunk", `{"plan_id":"rust","chunk_id":"chunk-009"}`)
+	assert.True(t, failed)
+	assert.Contains(t, text, "chunk not found")
+
+	_, failed = callTool(t, server, "list_plans", `{"bogus":true}`)
+	assert.True(t, failed, "unknown arguments are rejected")
+
+	responses := exchange(t, server, `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"nope"}}`)
+	assert.Equal(t, float64(codeInvalidParams), responses[0]["error"].(map[string]any)["code"])
+}
+
+func TestServer_ReadOnly(t *testing.T) {
+	server, sessions, changes := newTestServer(true)
+
+	text, failed := callTool(t, server, "create_plan", `{"topic":"Go","total_hours":10}`)
+	assert.True(t, failed)
+	assert.Contains(t, text, "read-only")
+	assert.Empty(t, *changes)
+
+	_, failed = callTool(t, server, "start_session", `{"plan_id":"rust"}`)
+	assert.True(t, failed)
+	assert.Nil(t, sessions.active)
+
+	text, failed = callTool(t, server, "list_plans", `{}`)
+	assert.False(t, failed, "reads still work")
+	assert.Contains(t, text, `"id": "rust"`)
+}
+
+func TestServer_CreatePlan(t *testing.T) {
+	server, _, changes := newTestServer(false)
+
+	text, failed := callTool(t, server, "create_plan", `{"topic":"Go","total_hours":10,"tags":["lang"]}`)
+	assert.False(t, failed, text)
+	assert.Contains(t, text, `"id": "new-plan"`)
+	assert.Equal(t, []string{"plan new-plan"}, *changes)
+}
@@ -0,0 +1,449 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/storage"
+)
+
+// tool is an MCP tool: its listing and the function that runs it.
+type tool struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	InputSchema map[string]any `json:"inputSchema"`
+	Annotations map[string]any `json:"annotations,omitempty"`
+
+	mutates bool
+	run     func(ctx context.Context, args json.RawMessage) (any, error)
+}
+
+// schema builds an object schema from properties, requiring the listed
+// names.
+func schema(properties map[string]any, required ...string) map[string]any {
+	s := map[string]any{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		s["required"] = required
+	}
+	return s
+}
+
+func stringProp(description string) map[string]any {
+	return map[string]any{"type": "string", "description": description}
+}
+
+func numberProp(description string) map[string]any {
+	return map[string]any{"type": "number", "description": description}
+}
+
+// toolset lists the tools in the order clients see them.
+func (s *Server) toolset() []tool {
+	return []tool{
+		{
+			Name:        "list_plans",
+			Description: "List learning plans with their ID, title, status, and total hours.",
+			InputSchema: schema(map[string]any{
+				"status": stringProp("Only plans with this status: not-started, in-progress, completed, or archived"),
+			}),
+			run: s.listPlans,
+		},
+		{
+			Name:        "get_plan",
+			Description: "Get a plan with all of its chunks, including each chunk's ID, status, objectives, and resources.",
+			InputSchema: schema(map[string]any{
+				"plan_id": stringProp("Plan ID, as returned by list_plans"),
+			}, "plan_id"),
+			run: s.getPlan,
+		},
+		{
+			Name:        "get_chunk",
+			Description: "Get one chunk of a plan along with the sessions logged against it.",
+			InputSchema: schema(map[string]any{
+				"plan_id":  stringProp("Plan ID"),
+				"chunk_id": stringProp("Chunk ID, such as chunk-003"),
+			}, "plan_id", "chunk_id"),
+			run: s.getChunk,
+		},
+		{
+			Name:        "get_status",
+			Description: "Get the active learning session, if any, and how many minutes it has run.",
+			InputSchema: schema(map[string]any{}),
+			run:         s.getStatus,
+		},
+		{
+			Name:        "start_session",
+			Description: "Start timing a learning session on a plan, optionally for one chunk. Only one session can be active.",
+			InputSchema: schema(map[string]any{
+				"plan_id":  stringProp("Plan ID"),
+				"chunk_id": stringProp("Chunk ID to attribute the session to"),
+				"notes":    stringProp("Initial notes"),
+			}, "plan_id"),
+			mutates: true,
+			run:     s.startSession,
+		},
+		{
+			Name:        "stop_session",
+			Description: "Stop the active learning session, recording notes and artifacts.",
+			InputSchema: schema(map[string]any{
+				"notes": stringProp("What was covered"),
+				"artifacts": map[string]any{
+					"type":        "array",
+					"items":       map[string]any{"type": "string"},
+					"description": "URLs or file paths produced during the session",
+				},
+			}),
+			mutates: true,
+			run:     s.stopSession,
+		},
+		{
+			Name:        "log_session",
+			Description: "Log a session that already happened, such as study done away from the computer. Overlapping an existing session on the plan is refused.",
+			InputSchema: schema(map[string]any{
+				"plan_id":  stringProp("Plan ID"),
+				"chunk_id": stringProp("Chunk ID"),
+				"minutes":  numberProp("How long the session lasted, in minutes"),
+				"ended_at": stringProp("When the session ended, as RFC 3339; defaults to now"),
+				"notes":    stringProp("What was covered"),
+			}, "plan_id", "minutes"),
+			mutates: true,
+			run:     s.logSession,
+		},
+		{
+			Name:        "create_plan",
+			Description: "Generate a new learning plan with samedi's configured LLM and save it. This can take a minute.",
+			InputSchema: schema(map[string]any{
+				"topic":       stringProp("What to learn"),
+				"total_hours": numberProp("Hours to spend in total"),
+				"level":       stringProp("beginner, intermediate, or advanced"),
+				"goals":       stringProp("Specific goals to shape the plan"),
+				"tags": map[string]any{
+					"type":        "array",
+					"items":       map[string]any{"type": "string"},
+					"description": "Tags to add to the plan",
+				},
+			}, "topic", "total_hours"),
+			mutates: true,
+			run:     s.createPlan,
+		},
+	}
+}
+
+// callTool runs a tools/call request. Failures of the tool itself are
+// returned as results flagged isError, so the assistant sees them; only
+// malformed calls are protocol errors.
+func (s *Server) callTool(ctx context.Context, params json.RawMessage) (any, *rpcError) {
+	var call struct {
+		Name      string          `json:"name"`
+		Arguments json.RawMessage `json:"arguments"`
+	}
+	if err := json.Unmarshal(params, &call); err != nil {
+		return nil, &rpcError{Code: codeInvalidParams, Message: "invalid tools/call params: " + err.Error()}
+	}
+
+	var t *tool
+	for i := range s.tools {
+		if s.tools[i].Name == call.Name {
+			t = &s.tools[i]
+		}
+	}
+	if t == nil {
+		return nil, &rpcError{Code: codeInvalidParams, Message: "unknown tool: " + call.Name}
+	}
+	if t.mutates && s.opts.ReadOnly {
+		return toolError(errors.New("samedi is in read-only mode")), nil
+	}
+
+	out, err := t.run(ctx, call.Arguments)
+	if err != nil {
+		return toolError(err), nil
+	}
+	text, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return toolError(fmt.Errorf("failed to encode result: %w", err)), nil
+	}
+	return map[string]any{
+		"content": []map[string]any{{"type": "text", "text": string(text)}},
+		"isError": false,
+	}, nil
+}
+
+func toolError(err error) map[string]any {
+	return map[string]any{
+		"content": []map[string]any{{"type": "text", "text": err.Error()}},
+		"isError": true,
+	}
+}
+
+// decodeArgs decodes tool arguments into v, rejecting unknown fields.
+func decodeArgs(args json.RawMessage, v any) error {
+	if len(args) == 0 || string(args) == "null" {
+		return nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(args))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("invalid arguments: %w", err)
+	}
+	return nil
+}
+
+// sessionChanged runs AfterSession, if set.
+func (s *Server) sessionChanged(sess *session.Session) {
+	if s.opts.AfterSession != nil {
+		s.opts.AfterSession(sess)
+	}
+}
+
+// findChunk returns the chunk of p with id, or nil.
+func findChunk(p *plan.Plan, id string) *plan.Chunk {
+	for i := range p.Chunks {
+		if p.Chunks[i].ID == id {
+			return &p.Chunks[i]
+		}
+	}
+	return nil
+}
+
+// planSummary is a plan as listed by list_plans.
+type planSummary struct {
+	ID         string   `json:"id"`
+	Title      string   `json:"title"`
+	Status     string   `json:"status"`
+	TotalHours float64  `json:"total_hours"`
+	Tags       []string `json:"tags,omitempty"`
+	Deadline   string   `json:"deadline,omitempty"`
+}
+
+func (s *Server) listPlans(ctx context.Context, args json.RawMessage) (any, error) {
+	var in struct {
+		Status string `json:"status"`
+	}
+	if err := decodeArgs(args, &in); err != nil {
+		return nil, err
+	}
+	filter := &storage.PlanFilter{}
+	if in.Status != "" {
+		filter.Statuses = []string{in.Status}
+	}
+
+	records, err := s.opts.Plans.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plans: %w", err)
+	}
+	plans := make([]planSummary, len(records))
+	for i, record := range records {
+		plans[i] = planSummary{
+			ID:         record.ID,
+			Title:      record.Title,
+			Status:     record.Status,
+			TotalHours: record.TotalHours,
+			Tags:       record.Tags,
+			Deadline:   record.Deadline,
+		}
+	}
+	return plans, nil
+}
+
+// loadPlan loads a plan, reporting unknown IDs plainly.
+func (s *Server) loadPlan(ctx context.Context, id string) (*plan.Plan, error) {
+	if id == "" {
+		return nil, errors.New("plan_id is required")
+	}
+	if !s.opts.Plans.Exists(ctx, id) {
+		return nil, fmt.Errorf("plan not found: %s", id)
+	}
+	p, err := s.opts.Plans.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load plan %s: %w", id, err)
+	}
+	return p, nil
+}
+
+func (s *Server) getPlan(ctx context.Context, args json.RawMessage) (any, error) {
+	var in struct {
+		PlanID string `json:"plan_id"`
+	}
+	if err := decodeArgs(args, &in); err != nil {
+		return nil, err
+	}
+	return s.loadPlan(ctx, in.PlanID)
+}
+
+// chunkDetail is the result of get_chunk.
+type chunkDetail struct {
+	PlanID    string             `json:"plan_id"`
+	PlanTitle string             `json:"plan_title"`
+	Chunk     plan.Chunk         `json:"chunk"`
+	Minutes   int                `json:"minutes_logged"`
+	Sessions  []*session.Session `json:"sessions"`
+}
+
+func (s *Server) getChunk(ctx context.Context, args json.RawMessage) (any, error) {
+	var in struct {
+		PlanID  string `json:"plan_id"`
+		ChunkID string `json:"chunk_id"`
+	}
+	if err := decodeArgs(args, &in); err != nil {
+		return nil, err
+	}
+	p, err := s.loadPlan(ctx, in.PlanID)
+	if err != nil {
+		return nil, err
+	}
+	chunk := findChunk(p, in.ChunkID)
+	if chunk == nil {
+		return nil, fmt.Errorf("chunk not found: %s in plan %s", in.ChunkID, in.PlanID)
+	}
+
+	sessions, err := s.opts.Sessions.GetChunkSessions(ctx, p.ID, chunk.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load sessions: %w", err)
+	}
+	detail := chunkDetail{PlanID: p.ID, PlanTitle: p.Title, Chunk: *chunk, Sessions: sessions}
+	if detail.Sessions == nil {
+		detail.Sessions = []*session.Session{}
+	}
+	for _, sess := range sessions {
+		if !sess.IsActive() {
+			detail.Minutes += sess.Duration
+		}
+	}
+	return detail, nil
+}
+
+// status is the result of get_status.
+type status struct {
+	Active         *session.Session `json:"active"`
+	ElapsedMinutes int              `json:"elapsed_minutes"`
+}
+
+func (s *Server) getStatus(ctx context.Context, _ json.RawMessage) (any, error) {
+	active, err := s.opts.Sessions.GetActive(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active session: %w", err)
+	}
+	result := status{Active: active}
+	if active != nil {
+		result.ElapsedMinutes = active.CalculateDuration()
+	}
+	return result, nil
+}
+
+func (s *Server) startSession(ctx context.Context, args json.RawMessage) (any, error) {
+	var in struct {
+		PlanID  string `json:"plan_id"`
+		ChunkID string `json:"chunk_id"`
+		Notes   string `json:"notes"`
+	}
+	if err := decodeArgs(args, &in); err != nil {
+		return nil, err
+	}
+	sess, err := s.opts.Sessions.Start(ctx, session.StartRequest{PlanID: in.PlanID, ChunkID: in.ChunkID, Notes: in.Notes})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start session: %w", err)
+	}
+	return sess, nil
+}
+
+func (s *Server) stopSession(ctx context.Context, args json.RawMessage) (any, error) {
+	var in struct {
+		Notes     string   `json:"notes"`
+		Artifacts []string `json:"artifacts"`
+	}
+	if err := decodeArgs(args, &in); err != nil {
+		return nil, err
+	}
+	sess, err := s.opts.Sessions.Stop(ctx, session.StopRequest{Notes: in.Notes, Artifacts: in.Artifacts})
+	if err != nil {
+		return nil, fmt.Errorf("failed to stop session: %w", err)
+	}
+	s.sessionChanged(sess)
+	return sess, nil
+}
+
+func (s *Server) logSession(ctx context.Context, args json.RawMessage) (any, error) {
+	var in struct {
+		PlanID  string  `json:"plan_id"`
+		ChunkID string  `json:"chunk_id"`
+		Minutes float64 `json:"minutes"`
+		EndedAt string  `json:"ended_at"`
+		Notes   string  `json:"notes"`
+	}
+	if err := decodeArgs(args, &in); err != nil {
+		return nil, err
+	}
+	if in.Minutes <= 0 {
+		return nil, errors.New("minutes must be positive")
+	}
+	end := time.Now()
+	if in.EndedAt != "" {
+		parsed, err := time.Parse(time.RFC3339, in.EndedAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ended_at %q: use RFC 3339, such as 2025-01-15T19:30:00Z", in.EndedAt)
+		}
+		end = parsed.Local()
+	}
+	if end.After(time.Now()) {
+		return nil, errors.New("ended_at cannot be in the future")
+	}
+
+	p, err := s.loadPlan(ctx, in.PlanID)
+	if err != nil {
+		return nil, err
+	}
+	if in.ChunkID != "" && findChunk(p, in.ChunkID) == nil {
+		return nil, fmt.Errorf("chunk not found: %s in plan %s", in.ChunkID, in.PlanID)
+	}
+
+	sess := &session.Session{
+		PlanID:    p.ID,
+		ChunkID:   in.ChunkID,
+		StartTime: end.Add(-time.Duration(in.Minutes * float64(time.Minute))),
+		EndTime:   &end,
+		Notes:     strings.TrimSpace(in.Notes),
+		Artifacts: []string{},
+	}
+	result, err := s.opts.Sessions.Record(ctx, sess, session.OverlapReject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to log session: %w", err)
+	}
+	s.sessionChanged(result.Session)
+	return result.Session, nil
+}
+
+func (s *Server) createPlan(ctx context.Context, args json.RawMessage) (any, error) {
+	var in struct {
+		Topic      string   `json:"topic"`
+		TotalHours float64  `json:"total_hours"`
+		Level      string   `json:"level"`
+		Goals      string   `json:"goals"`
+		Tags       []string `json:"tags"`
+	}
+	if err := decodeArgs(args, &in); err != nil {
+		return nil, err
+	}
+	p, err := s.opts.Plans.Create(ctx, plan.CreateRequest{
+		Topic:      in.Topic,
+		TotalHours: in.TotalHours,
+		Level:      in.Level,
+		Goals:      in.Goals,
+		Tags:       in.Tags,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create plan: %w", err)
+	}
+	if s.opts.AfterPlan != nil {
+		s.opts.AfterPlan(p)
+	}
+	return p, nil
+}
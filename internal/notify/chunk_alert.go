@@ -0,0 +1,200 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package notify
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/events"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+)
+
+// TimeUp describes a session reaching its chunk's planned duration.
+type TimeUp struct {
+	SessionID  string
+	PlanID     string
+	ChunkID    string
+	ChunkTitle string
+	Planned    int // Minutes
+}
+
+// Event returns the chunk.time_up event webhooks and hooks receive.
+func (t TimeUp) Event() events.Event {
+	e := events.New(events.ChunkTimeUp, t.PlanID)
+	e.SessionID = t.SessionID
+	e.ChunkID = t.ChunkID
+	e.Message = fmt.Sprintf("Reached the %d minutes planned for %q", t.Planned, t.ChunkTitle)
+	e.Data = map[string]any{"title": t.ChunkTitle, "planned_minutes": t.Planned}
+	return e
+}
+
+// ChunkAlert announces a session reaching its chunk's planned duration,
+// the way learning.chunk_alert says: a terminal bell or a desktop
+// notification. Commands run from hooks.chunk_time_up instead.
+type ChunkAlert struct {
+	mode     string
+	bell     io.Writer
+	notifier Notifier // Nil rings the bell instead
+}
+
+// NewChunkAlert creates an alert for mode, one of the config.ChunkAlert
+// values. The bell is written to bell, which should be the terminal but
+// not the stream a full-screen TUI draws on. Without a desktop
+// notification tool, "notify" rings the bell.
+func NewChunkAlert(mode string, bell io.Writer) *ChunkAlert {
+	a := &ChunkAlert{mode: mode, bell: bell}
+	if mode == config.ChunkAlertNotify {
+		notifier := DetectNotifier(io.Discard)
+		if _, fallback := notifier.(*WriterNotifier); !fallback {
+			a.notifier = notifier
+		}
+	}
+	return a
+}
+
+// Send delivers the alert.
+func (a *ChunkAlert) Send(_ context.Context, t TimeUp) error {
+	switch a.mode {
+	case config.ChunkAlertBell:
+		return a.ring()
+	case config.ChunkAlertNotify:
+		if a.notifier == nil {
+			return a.ring()
+		}
+		return a.notifier.Notify("Time's up: "+t.ChunkTitle,
+			fmt.Sprintf("%d min planned for %s. Wrap up or keep going.", t.Planned, t.ChunkID))
+	}
+	return nil
+}
+
+// ring writes the terminal bell.
+func (a *ChunkAlert) ring() error {
+	if _, err := io.WriteString(a.bell, "\a"); err != nil {
+		return fmt.Errorf("failed to ring the bell: %w", err)
+	}
+	return nil
+}
+
+// TimeUpInterval is how often a TimeUpWatcher looks at the active
+// session.
+const TimeUpInterval = 30 * time.Second
+
+// ActiveSessions reads the running session and the time already logged
+// on its chunk. The session service implements it.
+type ActiveSessions interface {
+	GetActive(ctx context.Context) (*session.Session, error)
+	GetChunkStats(ctx context.Context, planID, chunkID string) (*session.ChunkStats, error)
+}
+
+// ChunkReader looks up a chunk's planned duration. The plan service
+// implements it.
+type ChunkReader interface {
+	GetChunk(ctx context.Context, planID, chunkID string) (*plan.Chunk, error)
+}
+
+// TimeUpWatcher follows the active session, wherever it was started, and
+// alerts once when it brings its chunk's logged time up to the planned
+// duration. As in the TUI, a session already past it when first seen
+// never sets it off.
+type TimeUpWatcher struct {
+	sessions ActiveSessions
+	chunks   ChunkReader
+	alert    func(ctx context.Context, t TimeUp) error
+	now      func() time.Time
+	onError  func(error)
+
+	sessionID string // The session last seen
+	done      bool   // Alerted, or past the planned time when first seen
+}
+
+// NewTimeUpWatcher creates a watcher calling alert.
+func NewTimeUpWatcher(sessions ActiveSessions, chunks ChunkReader, alert func(ctx context.Context, t TimeUp) error) *TimeUpWatcher {
+	return &TimeUpWatcher{
+		sessions: sessions,
+		chunks:   chunks,
+		alert:    alert,
+		now:      time.Now,
+		onError:  func(error) {},
+	}
+}
+
+// OnError registers a callback for errors that occur while the watcher is
+// running. Errors do not stop the watcher.
+func (w *TimeUpWatcher) OnError(fn func(error)) {
+	w.onError = fn
+}
+
+// Check looks at the active session once and alerts if it has just
+// reached its chunk's planned duration.
+func (w *TimeUpWatcher) Check(ctx context.Context) error {
+	active, err := w.sessions.GetActive(ctx)
+	if err != nil {
+		return err
+	}
+	if active == nil || active.ChunkID == "" {
+		w.sessionID, w.done = "", false
+		return nil
+	}
+	first := active.ID != w.sessionID
+	if first {
+		w.sessionID, w.done = active.ID, false
+	}
+	if w.done {
+		return nil
+	}
+
+	chunk, err := w.chunks.GetChunk(ctx, active.PlanID, active.ChunkID)
+	if err != nil {
+		return fmt.Errorf("failed to load chunk: %w", err)
+	}
+	if chunk.Duration <= 0 {
+		return nil
+	}
+	stats, err := w.sessions.GetChunkStats(ctx, active.PlanID, active.ChunkID)
+	if err != nil {
+		return fmt.Errorf("failed to read chunk time: %w", err)
+	}
+	logged := time.Duration(stats.TotalDuration)*time.Minute + active.Elapsed(w.now())
+	if logged < time.Duration(chunk.Duration)*time.Minute {
+		return nil
+	}
+
+	w.done = true
+	if first {
+		return nil
+	}
+	return w.alert(ctx, TimeUp{
+		SessionID:  active.ID,
+		PlanID:     active.PlanID,
+		ChunkID:    chunk.ID,
+		ChunkTitle: chunk.Title,
+		Planned:    chunk.Duration,
+	})
+}
+
+// Run checks the active session every TimeUpInterval until the context is
+// cancelled.
+func (w *TimeUpWatcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(TimeUpInterval)
+	defer ticker.Stop()
+
+	if err := w.Check(ctx); err != nil {
+		w.onError(err)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if err := w.Check(ctx); err != nil {
+			w.onError(err)
+		}
+	}
+}
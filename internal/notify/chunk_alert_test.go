@@ -0,0 +1,113 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package notify
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/config"
+	"github.com/pezware/samedi.dev/internal/events"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunkAlert_Bell(t *testing.T) {
+	var bell bytes.Buffer
+	alert := NewChunkAlert(config.ChunkAlertBell, &bell)
+	require.NoError(t, alert.Send(context.Background(), TimeUp{ChunkID: "chunk-001", Planned: 30}))
+	assert.Equal(t, "\a", bell.String())
+
+	bell.Reset()
+	require.NoError(t, NewChunkAlert(config.ChunkAlertOff, &bell).Send(context.Background(), TimeUp{}))
+	assert.Empty(t, bell.String())
+}
+
+func TestChunkAlert_NotifyWithoutToolRingsBell(t *testing.T) {
+	withLookPath(t)
+
+	var bell bytes.Buffer
+	alert := NewChunkAlert(config.ChunkAlertNotify, &bell)
+	require.NoError(t, alert.Send(context.Background(), TimeUp{ChunkID: "chunk-001", Planned: 30}))
+	assert.Equal(t, "\a", bell.String(), "no text that would spoil a full-screen TUI")
+}
+
+func TestTimeUp_Event(t *testing.T) {
+	e := TimeUp{SessionID: "s1", PlanID: "rust", ChunkID: "chunk-002", ChunkTitle: "Ownership", Planned: 45}.Event()
+	assert.Equal(t, events.ChunkTimeUp, e.Type)
+	assert.Equal(t, "s1", e.SessionID)
+	assert.Equal(t, "rust", e.PlanID)
+	assert.Equal(t, "chunk-002", e.ChunkID)
+	assert.Equal(t, `Reached the 45 minutes planned for "Ownership"`, e.Message)
+	assert.Equal(t, map[string]any{"title": "Ownership", "planned_minutes": 45}, e.Data)
+}
+
+// stubActiveSessions serves one active session with earlier time logged
+// on its chunk.
+type stubActiveSessions struct {
+	active *session.Session
+	logged int // Minutes
+}
+
+func (s *stubActiveSessions) GetActive(context.Context) (*session.Session, error) {
+	return s.active, nil
+}
+
+func (s *stubActiveSessions) GetChunkStats(context.Context, string, string) (*session.ChunkStats, error) {
+	return &session.ChunkStats{TotalDuration: s.logged}, nil
+}
+
+type stubChunks map[string]plan.Chunk
+
+func (c stubChunks) GetChunk(_ context.Context, _, chunkID string) (*plan.Chunk, error) {
+	chunk := c[chunkID]
+	return &chunk, nil
+}
+
+func TestTimeUpWatcher_Check(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	now := start.Add(10 * time.Minute)
+
+	sessions := &stubActiveSessions{
+		active: &session.Session{ID: "s1", PlanID: "rust", ChunkID: "chunk-001", StartTime: start},
+		logged: 15,
+	}
+	chunks := stubChunks{"chunk-001": {ID: "chunk-001", Title: "Ownership", Duration: 30}}
+	var alerts []TimeUp
+	watcher := NewTimeUpWatcher(sessions, chunks, func(_ context.Context, t TimeUp) error {
+		alerts = append(alerts, t)
+		return nil
+	})
+	watcher.now = func() time.Time { return now }
+
+	// 15 logged + 10 elapsed of 30 planned
+	require.NoError(t, watcher.Check(ctx))
+	assert.Empty(t, alerts)
+
+	now = start.Add(15 * time.Minute)
+	require.NoError(t, watcher.Check(ctx))
+	require.Len(t, alerts, 1)
+	assert.Equal(t, TimeUp{SessionID: "s1", PlanID: "rust", ChunkID: "chunk-001", ChunkTitle: "Ownership", Planned: 30}, alerts[0])
+
+	now = start.Add(20 * time.Minute)
+	require.NoError(t, watcher.Check(ctx))
+	assert.Len(t, alerts, 1, "once per session")
+
+	// A session already past the planned time when first seen stays quiet
+	sessions.active = &session.Session{ID: "s2", PlanID: "rust", ChunkID: "chunk-001", StartTime: start}
+	require.NoError(t, watcher.Check(ctx))
+	assert.Len(t, alerts, 1)
+
+	// Without a session or a chunk there is nothing to watch
+	sessions.active = nil
+	require.NoError(t, watcher.Check(ctx))
+	sessions.active = &session.Session{ID: "s3", PlanID: "rust", StartTime: start}
+	require.NoError(t, watcher.Check(ctx))
+	assert.Len(t, alerts, 1)
+}
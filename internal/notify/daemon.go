@@ -0,0 +1,115 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package notify
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Schedule is a set of daily check times.
+type Schedule struct {
+	times []time.Duration // offsets from midnight, sorted
+}
+
+// ParseSchedule parses daily check times in 24-hour "HH:MM" format.
+func ParseSchedule(times []string) (*Schedule, error) {
+	if len(times) == 0 {
+		return nil, fmt.Errorf("at least one reminder time is required")
+	}
+
+	offsets := make([]time.Duration, 0, len(times))
+	for _, value := range times {
+		parsed, err := time.Parse("15:04", value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid reminder time %q (expected HH:MM): %w", value, err)
+		}
+		offsets = append(offsets, time.Duration(parsed.Hour())*time.Hour+time.Duration(parsed.Minute())*time.Minute)
+	}
+
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+
+	return &Schedule{times: offsets}, nil
+}
+
+// Next returns the first scheduled time strictly after t.
+func (s *Schedule) Next(t time.Time) time.Time {
+	day := startOfDay(t)
+	for i := 0; i < 2; i++ {
+		for _, offset := range s.times {
+			candidate := time.Date(day.Year(), day.Month(), day.Day(),
+				int(offset/time.Hour), int(offset%time.Hour/time.Minute), 0, 0, day.Location())
+			if candidate.After(t) {
+				return candidate
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	// Unreachable with a non-empty schedule
+	return day
+}
+
+// Daemon checks for due reminders on a schedule and delivers them.
+type Daemon struct {
+	checker  *Checker
+	notifier Notifier
+	schedule *Schedule
+	now      func() time.Time
+	onError  func(error)
+}
+
+// NewDaemon creates a reminder daemon.
+func NewDaemon(checker *Checker, notifier Notifier, schedule *Schedule) *Daemon {
+	return &Daemon{
+		checker:  checker,
+		notifier: notifier,
+		schedule: schedule,
+		now:      time.Now,
+		onError:  func(error) {},
+	}
+}
+
+// OnError registers a callback for errors that occur while the daemon is
+// running. Errors do not stop the daemon.
+func (d *Daemon) OnError(fn func(error)) {
+	d.onError = fn
+}
+
+// RunOnce checks for due reminders and sends them. Returns the reminders sent.
+func (d *Daemon) RunOnce(ctx context.Context) ([]Reminder, error) {
+	reminders, err := d.checker.Check(ctx, d.now())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range reminders {
+		if err := d.notifier.Notify(r.Title, r.Message); err != nil {
+			return nil, fmt.Errorf("failed to send notification: %w", err)
+		}
+	}
+
+	return reminders, nil
+}
+
+// Run sleeps until each scheduled time and sends any due reminders,
+// until the context is cancelled.
+func (d *Daemon) Run(ctx context.Context) error {
+	for {
+		wait := d.schedule.Next(d.now()).Sub(d.now())
+		timer := time.NewTimer(wait)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+
+		if _, err := d.RunOnce(ctx); err != nil {
+			d.onError(err)
+		}
+	}
+}
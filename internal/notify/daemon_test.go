@@ -0,0 +1,76 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package notify
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingNotifier struct {
+	sent []Reminder
+}
+
+func (n *recordingNotifier) Name() string { return "recording" }
+
+func (n *recordingNotifier) Notify(title, message string) error {
+	n.sent = append(n.sent, Reminder{Title: title, Message: message})
+	return nil
+}
+
+func TestParseSchedule(t *testing.T) {
+	schedule, err := ParseSchedule([]string{"20:00", "08:30"})
+	require.NoError(t, err)
+	assert.Equal(t, []time.Duration{8*time.Hour + 30*time.Minute, 20 * time.Hour}, schedule.times)
+
+	_, err = ParseSchedule(nil)
+	assert.Error(t, err)
+
+	_, err = ParseSchedule([]string{"25:00"})
+	assert.ErrorContains(t, err, "invalid reminder time")
+}
+
+func TestSchedule_Next(t *testing.T) {
+	schedule, err := ParseSchedule([]string{"08:30", "20:00"})
+	require.NoError(t, err)
+
+	morning := time.Date(2025, 6, 11, 7, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2025, 6, 11, 8, 30, 0, 0, time.UTC), schedule.Next(morning))
+
+	atCheck := time.Date(2025, 6, 11, 8, 30, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2025, 6, 11, 20, 0, 0, 0, time.UTC), schedule.Next(atCheck), "next must be strictly after")
+
+	night := time.Date(2025, 6, 11, 22, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2025, 6, 12, 8, 30, 0, 0, time.UTC), schedule.Next(night))
+}
+
+func TestDaemon_RunOnce_SendsReminders(t *testing.T) {
+	schedule, err := ParseSchedule([]string{"20:00"})
+	require.NoError(t, err)
+
+	notifier := &recordingNotifier{}
+	checker := NewChecker(stubSessions{}, Settings{Message: "nudge"})
+	daemon := NewDaemon(checker, notifier, schedule)
+	daemon.now = func() time.Time { return checkTime }
+
+	sent, err := daemon.RunOnce(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, sent, 1)
+	assert.Equal(t, sent, notifier.sent)
+}
+
+func TestDaemon_Run_StopsOnCancel(t *testing.T) {
+	schedule, err := ParseSchedule([]string{"20:00"})
+	require.NoError(t, err)
+
+	daemon := NewDaemon(NewChecker(stubSessions{}, Settings{}), &recordingNotifier{}, schedule)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.NoError(t, daemon.Run(ctx))
+}
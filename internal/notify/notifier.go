@@ -0,0 +1,119 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+// Package notify sends desktop reminders about learning streaks and goals.
+package notify
+
+import (
+	"fmt"
+	"io"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// Notifier delivers a notification to the user.
+type Notifier interface {
+	// Name identifies the notification backend (e.g., "notify-send").
+	Name() string
+
+	// Notify shows a notification with the given title and message.
+	Notify(title, message string) error
+}
+
+// lookPath is swapped out in tests.
+var lookPath = exec.LookPath
+
+// CommandNotifier shells out to a platform notification tool.
+type CommandNotifier struct {
+	command string
+	args    func(title, message string) []string
+}
+
+// Name returns the notification command.
+func (n *CommandNotifier) Name() string {
+	return n.command
+}
+
+// Notify runs the notification command. Arguments are passed directly,
+// never through a shell.
+func (n *CommandNotifier) Notify(title, message string) error {
+	// #nosec G204 - command is chosen from a fixed list, arguments are not shell-interpreted
+	cmd := exec.Command(n.command, n.args(title, message)...)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%s failed: %w (%s)", n.command, err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// WriterNotifier prints notifications with a terminal bell. It is the
+// fallback when no desktop notification tool is available.
+type WriterNotifier struct {
+	w io.Writer
+}
+
+// NewWriterNotifier creates a notifier that writes to w.
+func NewWriterNotifier(w io.Writer) *WriterNotifier {
+	return &WriterNotifier{w: w}
+}
+
+// Name returns "terminal".
+func (n *WriterNotifier) Name() string {
+	return "terminal"
+}
+
+// Notify writes the notification to the underlying writer.
+func (n *WriterNotifier) Notify(title, message string) error {
+	if _, err := fmt.Fprintf(n.w, "\a🔔 %s: %s\n", title, message); err != nil {
+		return fmt.Errorf("failed to write notification: %w", err)
+	}
+	return nil
+}
+
+// DetectNotifier returns the best available notifier for this platform.
+// It checks in order: terminal-notifier and osascript on macOS,
+// notify-send elsewhere, and falls back to writing to fallback.
+func DetectNotifier(fallback io.Writer) Notifier {
+	for _, candidate := range platformNotifiers(runtime.GOOS) {
+		if _, err := lookPath(candidate.command); err == nil {
+			return candidate
+		}
+	}
+	return NewWriterNotifier(fallback)
+}
+
+// platformNotifiers lists notification commands to try for an OS, in priority order.
+func platformNotifiers(goos string) []*CommandNotifier {
+	terminalNotifier := &CommandNotifier{
+		command: "terminal-notifier",
+		args: func(title, message string) []string {
+			return []string{"-title", title, "-message", message, "-group", "samedi"}
+		},
+	}
+	osascript := &CommandNotifier{
+		command: "osascript",
+		args: func(title, message string) []string {
+			script := fmt.Sprintf("display notification %s with title %s",
+				appleScriptString(message), appleScriptString(title))
+			return []string{"-e", script}
+		},
+	}
+	notifySend := &CommandNotifier{
+		command: "notify-send",
+		args: func(title, message string) []string {
+			return []string{"--app-name=samedi", title, message}
+		},
+	}
+
+	if goos == "darwin" {
+		return []*CommandNotifier{terminalNotifier, osascript}
+	}
+	return []*CommandNotifier{notifySend}
+}
+
+// appleScriptString quotes s as an AppleScript string literal.
+func appleScriptString(s string) string {
+	escaped := strings.ReplaceAll(s, `\`, `\\`)
+	escaped = strings.ReplaceAll(escaped, `"`, `\"`)
+	return `"` + escaped + `"`
+}
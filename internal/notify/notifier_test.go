@@ -0,0 +1,66 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package notify
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func withLookPath(t *testing.T, available ...string) {
+	t.Helper()
+
+	original := lookPath
+	lookPath = func(file string) (string, error) {
+		for _, name := range available {
+			if name == file {
+				return "/usr/bin/" + file, nil
+			}
+		}
+		return "", errors.New("not found")
+	}
+	t.Cleanup(func() { lookPath = original })
+}
+
+func TestDetectNotifier_FallsBackToWriter(t *testing.T) {
+	withLookPath(t)
+
+	var buf bytes.Buffer
+	notifier := DetectNotifier(&buf)
+	assert.Equal(t, "terminal", notifier.Name())
+
+	require.NoError(t, notifier.Notify("Streak at risk", "Study today"))
+	assert.Contains(t, buf.String(), "Streak at risk: Study today")
+}
+
+func TestDetectNotifier_UsesAvailableCommand(t *testing.T) {
+	withLookPath(t, "notify-send", "terminal-notifier", "osascript")
+
+	notifier := DetectNotifier(&bytes.Buffer{})
+	assert.NotEqual(t, "terminal", notifier.Name())
+}
+
+func TestPlatformNotifiers(t *testing.T) {
+	darwin := platformNotifiers("darwin")
+	require.Len(t, darwin, 2)
+	assert.Equal(t, "terminal-notifier", darwin[0].Name())
+	assert.Equal(t, "osascript", darwin[1].Name())
+
+	linux := platformNotifiers("linux")
+	require.Len(t, linux, 1)
+	assert.Equal(t, "notify-send", linux[0].Name())
+	assert.Equal(t, []string{"--app-name=samedi", "Title", "Body"}, linux[0].args("Title", "Body"))
+}
+
+func TestAppleScriptString_EscapesQuotes(t *testing.T) {
+	assert.Equal(t, `"say \"hi\" \\ bye"`, appleScriptString(`say "hi" \ bye`))
+
+	args := platformNotifiers("darwin")[1].args(`It's "late"`, "msg")
+	require.Len(t, args, 2)
+	assert.Equal(t, `display notification "msg" with title "It's \"late\""`, args[1])
+}
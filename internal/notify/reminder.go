@@ -0,0 +1,171 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package notify
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/stats"
+)
+
+// Reminder is a notification the user should receive.
+type Reminder struct {
+	Title   string
+	Message string
+}
+
+// SessionLister provides the session history reminders are based on.
+type SessionLister interface {
+	ListAll(ctx context.Context) ([]*session.Session, error)
+}
+
+// Settings controls which reminders are produced.
+type Settings struct {
+	// Message is the generic nudge sent when the user hasn't learned today
+	// and has no streak to protect.
+	Message string
+
+	// StreakTracking enables streak-at-risk reminders.
+	StreakTracking bool
+
+	// Streak decides which days count toward a streak, matching `samedi stats`.
+	Streak stats.StreakRules
+
+	// WeeklyGoalHours enables weekly goal reminders when positive.
+	WeeklyGoalHours int
+
+	// WeekStartsSunday selects Sunday instead of Monday as the first day of the week.
+	WeekStartsSunday bool
+}
+
+// Checker decides which reminders are due based on learning activity.
+type Checker struct {
+	sessions SessionLister
+	settings Settings
+}
+
+// NewChecker creates a reminder checker.
+func NewChecker(sessions SessionLister, settings Settings) *Checker {
+	return &Checker{sessions: sessions, settings: settings}
+}
+
+// Check returns reminders due at now. It returns nothing if the user has
+// already learned today and is on pace for their weekly goal.
+func (c *Checker) Check(ctx context.Context, now time.Time) ([]Reminder, error) {
+	sessions, err := c.sessions.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	if c.settings.Streak.Location != nil {
+		now = now.In(c.settings.Streak.Location)
+	}
+	activity := summarize(sessions, now, c.settings.WeekStartsSunday, c.settings.Streak)
+	reminders := make([]Reminder, 0, 2)
+
+	if !activity.learnedToday {
+		switch {
+		case c.settings.StreakTracking && activity.streak > 0 && !c.settings.Streak.IsRestDay(now):
+			reminders = append(reminders, Reminder{
+				Title:   "Streak at risk",
+				Message: fmt.Sprintf("Your %d-day learning streak ends at midnight. %s", activity.streak, c.settings.Message),
+			})
+		case c.settings.Message != "":
+			reminders = append(reminders, Reminder{
+				Title:   "Time to learn",
+				Message: c.settings.Message,
+			})
+		}
+	}
+
+	if goal := float64(c.settings.WeeklyGoalHours); goal > 0 {
+		expected := goal * float64(activity.daysElapsed) / 7
+		if activity.weekHours < expected && activity.weekHours < goal {
+			remaining := goal - activity.weekHours
+			daysLeft := 7 - activity.daysElapsed + 1 // today still counts
+			reminders = append(reminders, Reminder{
+				Title: "Weekly goal behind",
+				Message: fmt.Sprintf("%.1fh of %gh this week. About %.1fh/day over the next %d day(s) gets you there.",
+					activity.weekHours, goal, remaining/float64(daysLeft), daysLeft),
+			})
+		}
+	}
+
+	return reminders, nil
+}
+
+// activitySummary captures what reminders need to know about recent sessions.
+type activitySummary struct {
+	learnedToday bool
+	streak       int // consecutive days ending yesterday
+	weekHours    float64
+	daysElapsed  int // days of the current week including today (1-7)
+}
+
+// summarize computes today's status, the streak leading into today, and
+// hours logged in the current week. Days count toward the streak under
+// rules; rest days without enough study are skipped over.
+func summarize(sessions []*session.Session, now time.Time, weekStartsSunday bool, rules stats.StreakRules) activitySummary {
+	today := startOfDay(now)
+	weekStart := startOfWeek(today, weekStartsSunday)
+
+	dayMinutes := make(map[time.Time]int)
+	var weekMinutes float64
+	first := today
+
+	for _, s := range sessions {
+		start := s.StartTime.In(now.Location())
+		minutes := s.Duration
+		if s.IsActive() {
+			minutes = s.ElapsedMinutes()
+		}
+		day := startOfDay(start)
+		dayMinutes[day] += minutes
+		if day.Before(first) {
+			first = day
+		}
+
+		if !start.Before(weekStart) && !start.After(now) {
+			weekMinutes += float64(minutes)
+		}
+	}
+	counts := func(day time.Time) bool {
+		minutes, ok := dayMinutes[day]
+		return ok && rules.Counts(minutes)
+	}
+
+	streak := 0
+	for day := today.AddDate(0, 0, -1); !day.Before(first); day = day.AddDate(0, 0, -1) {
+		if counts(day) {
+			streak++
+		} else if !rules.IsRestDay(day) {
+			break
+		}
+	}
+
+	return activitySummary{
+		learnedToday: counts(today),
+		streak:       streak,
+		weekHours:    math.Round(weekMinutes/60*10) / 10,
+		daysElapsed:  int(today.Sub(weekStart).Hours()/24+0.5) + 1,
+	}
+}
+
+// startOfDay returns midnight of t's day in t's location.
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// startOfWeek returns midnight of the first day of day's week.
+func startOfWeek(day time.Time, weekStartsSunday bool) time.Time {
+	offset := int(day.Weekday())
+	if !weekStartsSunday {
+		offset = (offset + 6) % 7 // Monday = 0
+	}
+	return day.AddDate(0, 0, -offset)
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package notify
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubSessions []*session.Session
+
+func (s stubSessions) ListAll(_ context.Context) ([]*session.Session, error) {
+	return s, nil
+}
+
+// completedSession builds a finished session starting at start.
+func completedSession(start time.Time, minutes int) *session.Session {
+	end := start.Add(time.Duration(minutes) * time.Minute)
+	return &session.Session{
+		ID:        start.String(),
+		PlanID:    "plan",
+		StartTime: start,
+		EndTime:   &end,
+		Duration:  minutes,
+	}
+}
+
+// Wednesday evening, so Monday-start weeks have 3 elapsed days.
+var checkTime = time.Date(2025, 6, 11, 20, 0, 0, 0, time.UTC)
+
+func TestChecker_StreakAtRisk(t *testing.T) {
+	sessions := stubSessions{
+		completedSession(checkTime.AddDate(0, 0, -1), 60),
+		completedSession(checkTime.AddDate(0, 0, -2), 60),
+		completedSession(checkTime.AddDate(0, 0, -5), 60), // separate, older streak
+	}
+	checker := NewChecker(sessions, Settings{Message: "Keep going!", StreakTracking: true})
+
+	reminders, err := checker.Check(context.Background(), checkTime)
+	require.NoError(t, err)
+	require.Len(t, reminders, 1)
+	assert.Equal(t, "Streak at risk", reminders[0].Title)
+	assert.Contains(t, reminders[0].Message, "2-day learning streak")
+}
+
+func TestChecker_StreakRules(t *testing.T) {
+	// Learned Sunday and Tuesday, rested Monday, and too briefly on Saturday
+	sessions := stubSessions{
+		completedSession(checkTime.AddDate(0, 0, -1), 30),
+		completedSession(checkTime.AddDate(0, 0, -3), 30),
+		completedSession(checkTime.AddDate(0, 0, -4), 5),
+	}
+	rules := stats.StreakRules{MinMinutes: 15, RestDays: []time.Weekday{time.Monday}}
+	checker := NewChecker(sessions, Settings{Message: "nudge", StreakTracking: true, Streak: rules})
+
+	reminders, err := checker.Check(context.Background(), checkTime)
+	require.NoError(t, err)
+	require.Len(t, reminders, 1)
+	assert.Contains(t, reminders[0].Message, "2-day learning streak", "Monday rests; Saturday falls short")
+
+	rules.RestDays = []time.Weekday{time.Monday, time.Wednesday}
+	checker = NewChecker(sessions, Settings{Message: "nudge", StreakTracking: true, Streak: rules})
+	reminders, err = checker.Check(context.Background(), checkTime)
+	require.NoError(t, err)
+	require.Len(t, reminders, 1)
+	assert.Equal(t, "Time to learn", reminders[0].Title, "no streak is at risk on a rest day")
+}
+
+func TestChecker_GenericReminderWithoutStreak(t *testing.T) {
+	checker := NewChecker(stubSessions{}, Settings{Message: "What did you learn today?", StreakTracking: true})
+
+	reminders, err := checker.Check(context.Background(), checkTime)
+	require.NoError(t, err)
+	require.Len(t, reminders, 1)
+	assert.Equal(t, "Time to learn", reminders[0].Title)
+	assert.Equal(t, "What did you learn today?", reminders[0].Message)
+}
+
+func TestChecker_NothingWhenLearnedToday(t *testing.T) {
+	sessions := stubSessions{
+		completedSession(checkTime.Add(-2*time.Hour), 30),
+		completedSession(checkTime.AddDate(0, 0, -1), 30),
+	}
+	checker := NewChecker(sessions, Settings{Message: "nudge", StreakTracking: true})
+
+	reminders, err := checker.Check(context.Background(), checkTime)
+	require.NoError(t, err)
+	assert.Empty(t, reminders)
+}
+
+func TestChecker_WeeklyGoal(t *testing.T) {
+	tests := []struct {
+		name     string
+		minutes  int
+		wantGoal bool
+	}{
+		{"behind pace", 60, true}, // 1h logged, 3/7 of 6h = 2.6h expected
+		{"on pace", 180, false},   // 3h logged
+		{"goal already met", 400, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessions := stubSessions{completedSession(checkTime.Add(-time.Hour), tt.minutes)}
+			checker := NewChecker(sessions, Settings{WeeklyGoalHours: 6})
+
+			reminders, err := checker.Check(context.Background(), checkTime)
+			require.NoError(t, err)
+
+			if !tt.wantGoal {
+				assert.Empty(t, reminders)
+				return
+			}
+			require.Len(t, reminders, 1)
+			assert.Equal(t, "Weekly goal behind", reminders[0].Title)
+			assert.Contains(t, reminders[0].Message, "1.0h of 6h")
+			assert.Contains(t, reminders[0].Message, "next 5 day(s)")
+		})
+	}
+}
+
+func TestSummarize_WeekStart(t *testing.T) {
+	// Sunday session counts toward a Sunday-start week but not a Monday-start week.
+	sunday := time.Date(2025, 6, 8, 10, 0, 0, 0, time.UTC)
+	sessions := []*session.Session{completedSession(sunday, 120)}
+
+	monday := summarize(sessions, checkTime, false, stats.StreakRules{})
+	assert.InDelta(t, 0.0, monday.weekHours, 0.001)
+	assert.Equal(t, 3, monday.daysElapsed)
+
+	sundayStart := summarize(sessions, checkTime, true, stats.StreakRules{})
+	assert.InDelta(t, 2.0, sundayStart.weekHours, 0.001)
+	assert.Equal(t, 4, sundayStart.daysElapsed)
+}
@@ -0,0 +1,225 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package obsidian
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+)
+
+// PlanNote returns the vault-relative path (without extension) of a plan's note.
+func PlanNote(planID string) string {
+	return PlansFolder + "/" + planID
+}
+
+// ChunkNote returns the vault-relative path of a chunk's note.
+func ChunkNote(planID, chunkID string) string {
+	return ChunksFolder + "/" + planID + " " + chunkID
+}
+
+// SessionNote returns the vault-relative path of a session's note, named
+// by date and plan so notes sort chronologically in the file explorer.
+func SessionNote(sess *session.Session) string {
+	id := sess.ID
+	if len(id) > 8 {
+		id = id[:8]
+	}
+	return SessionsFolder + "/" + sess.StartTime.Format("2006-01-02") + " " + sess.PlanID + " " + id
+}
+
+// wikilink formats an Obsidian link to a note with a display alias.
+func wikilink(path, alias string) string {
+	alias = strings.NewReplacer("|", "-", "[", "(", "]", ")").Replace(alias)
+	return "[[" + path + "|" + alias + "]]"
+}
+
+// buildNotes renders every note for the given plans and sessions, keyed by
+// vault-relative path. Active sessions are skipped until they are stopped.
+func buildNotes(plans []*plan.Plan, sessions []*session.Session) map[string]string {
+	plansByID := make(map[string]*plan.Plan, len(plans))
+	for _, p := range plans {
+		plansByID[p.ID] = p
+	}
+
+	completed := make([]*session.Session, 0, len(sessions))
+	for _, sess := range sessions {
+		if !sess.IsActive() {
+			completed = append(completed, sess)
+		}
+	}
+	sort.Slice(completed, func(i, j int) bool {
+		return completed[i].StartTime.Before(completed[j].StartTime)
+	})
+
+	byPlan := make(map[string][]*session.Session)
+	byChunk := make(map[string][]*session.Session)
+	for _, sess := range completed {
+		byPlan[sess.PlanID] = append(byPlan[sess.PlanID], sess)
+		if sess.ChunkID != "" {
+			key := ChunkNote(sess.PlanID, sess.ChunkID)
+			byChunk[key] = append(byChunk[key], sess)
+		}
+	}
+
+	notes := make(map[string]string)
+	for _, p := range plans {
+		notes[PlanNote(p.ID)] = renderPlanNote(p, byPlan[p.ID])
+		for i := range p.Chunks {
+			chunk := &p.Chunks[i]
+			key := ChunkNote(p.ID, chunk.ID)
+			notes[key] = renderChunkNote(p, chunk, byChunk[key])
+		}
+	}
+	for _, sess := range completed {
+		notes[SessionNote(sess)] = renderSessionNote(sess, plansByID[sess.PlanID])
+	}
+	return notes
+}
+
+// renderPlanNote renders a plan with links to its chunks and sessions.
+func renderPlanNote(p *plan.Plan, sessions []*session.Session) string {
+	var b strings.Builder
+
+	b.WriteString("---\n")
+	fmt.Fprintf(&b, "samedi_plan: %s\n", p.ID)
+	fmt.Fprintf(&b, "status: %s\n", p.Status)
+	fmt.Fprintf(&b, "total_hours: %g\n", p.TotalHours)
+	b.WriteString(frontmatterTags(p.Tags))
+	b.WriteString("---\n\n")
+
+	fmt.Fprintf(&b, "# %s\n\n", p.Title)
+	fmt.Fprintf(&b, "Status: %s · %gh · %d%% complete\n", p.Status, p.TotalHours, p.ProgressPercent())
+
+	b.WriteString("\n## Chunks\n\n")
+	for i := range p.Chunks {
+		chunk := &p.Chunks[i]
+		check := " "
+		if chunk.Status == plan.StatusCompleted {
+			check = "x"
+		}
+		fmt.Fprintf(&b, "- [%s] %s (%d min)\n", check, wikilink(ChunkNote(p.ID, chunk.ID), chunk.Title), chunk.Duration)
+	}
+
+	b.WriteString("\n## Sessions\n\n")
+	writeSessionLinks(&b, sessions)
+
+	return b.String()
+}
+
+// renderChunkNote renders a chunk with a link back to its plan.
+func renderChunkNote(p *plan.Plan, chunk *plan.Chunk, sessions []*session.Session) string {
+	var b strings.Builder
+
+	b.WriteString("---\n")
+	fmt.Fprintf(&b, "samedi_plan: %s\n", p.ID)
+	fmt.Fprintf(&b, "samedi_chunk: %s\n", chunk.ID)
+	fmt.Fprintf(&b, "status: %s\n", chunk.Status)
+	b.WriteString("---\n\n")
+
+	fmt.Fprintf(&b, "# %s\n\n", chunk.Title)
+	fmt.Fprintf(&b, "Plan: %s\n", wikilink(PlanNote(p.ID), p.Title))
+	fmt.Fprintf(&b, "Duration: %d min · Status: %s\n", chunk.Duration, chunk.Status)
+
+	if len(chunk.Objectives) > 0 {
+		b.WriteString("\n## Objectives\n\n")
+		for _, objective := range chunk.Objectives {
+			fmt.Fprintf(&b, "- %s\n", objective)
+		}
+	}
+
+	if len(chunk.Resources) > 0 {
+		b.WriteString("\n## Resources\n\n")
+		for _, resource := range chunk.ParsedResources() {
+			fmt.Fprintf(&b, "- %s\n", resource)
+		}
+	}
+
+	if chunk.Deliverable != "" {
+		fmt.Fprintf(&b, "\n## Deliverable\n\n%s\n", chunk.Deliverable)
+	}
+
+	b.WriteString("\n## Sessions\n\n")
+	writeSessionLinks(&b, sessions)
+
+	return b.String()
+}
+
+// renderSessionNote renders a completed session with links to its plan
+// and chunk. p is nil when the session's plan no longer exists.
+func renderSessionNote(sess *session.Session, p *plan.Plan) string {
+	var b strings.Builder
+
+	b.WriteString("---\n")
+	fmt.Fprintf(&b, "samedi_session: %s\n", sess.ID)
+	fmt.Fprintf(&b, "samedi_plan: %s\n", sess.PlanID)
+	fmt.Fprintf(&b, "date: %s\n", sess.StartTime.Format("2006-01-02"))
+	fmt.Fprintf(&b, "duration_minutes: %d\n", sess.Duration)
+	b.WriteString("---\n\n")
+
+	fmt.Fprintf(&b, "# %s · %s\n\n", sess.PlanID, sess.StartTime.Format("Jan 2, 2006 15:04"))
+
+	if p == nil {
+		fmt.Fprintf(&b, "Plan: %s (deleted)\n", sess.PlanID)
+	} else {
+		fmt.Fprintf(&b, "Plan: %s\n", wikilink(PlanNote(p.ID), p.Title))
+		if sess.ChunkID != "" {
+			if chunk := findChunk(p, sess.ChunkID); chunk != nil {
+				fmt.Fprintf(&b, "Chunk: %s\n", wikilink(ChunkNote(p.ID, chunk.ID), chunk.Title))
+			}
+		}
+	}
+	fmt.Fprintf(&b, "Duration: %s\n", sess.ElapsedTime())
+
+	if sess.Notes != "" {
+		fmt.Fprintf(&b, "\n## Notes\n\n%s\n", sess.Notes)
+	}
+
+	if len(sess.Artifacts) > 0 {
+		b.WriteString("\n## Artifacts\n\n")
+		for _, artifact := range sess.Artifacts {
+			fmt.Fprintf(&b, "- %s\n", artifact)
+		}
+	}
+
+	return b.String()
+}
+
+// findChunk returns the plan's chunk with the given ID, or nil.
+func findChunk(p *plan.Plan, chunkID string) *plan.Chunk {
+	for i := range p.Chunks {
+		if p.Chunks[i].ID == chunkID {
+			return &p.Chunks[i]
+		}
+	}
+	return nil
+}
+
+// writeSessionLinks lists links to sessions, oldest first.
+func writeSessionLinks(b *strings.Builder, sessions []*session.Session) {
+	if len(sessions) == 0 {
+		b.WriteString("No sessions yet.\n")
+		return
+	}
+	for _, sess := range sessions {
+		label := sess.StartTime.Format("Jan 2, 2006 15:04")
+		fmt.Fprintf(b, "- %s · %s\n", wikilink(SessionNote(sess), label), sess.ElapsedTime())
+	}
+}
+
+// frontmatterTags formats plan tags as an Obsidian tag list. Obsidian
+// tags cannot contain spaces, so spaces become hyphens.
+func frontmatterTags(tags []string) string {
+	formatted := []string{"samedi"}
+	for _, tag := range tags {
+		tag = strings.ReplaceAll(strings.TrimSpace(tag), " ", "-")
+		if tag != "" {
+			formatted = append(formatted, tag)
+		}
+	}
+	return "tags: [" + strings.Join(formatted, ", ") + "]\n"
+}
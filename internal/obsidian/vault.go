@@ -0,0 +1,145 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+// Package obsidian mirrors plans, chunks, and sessions into an Obsidian
+// vault as markdown notes joined by wikilinks, so the learning graph can
+// be browsed in Obsidian's graph view.
+package obsidian
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+)
+
+// Folders inside the vault directory owned by samedi.
+const (
+	PlansFolder    = "Plans"
+	ChunksFolder   = "Chunks"
+	SessionsFolder = "Sessions"
+)
+
+// managedMarker starts the frontmatter of every note samedi writes. Only
+// notes carrying it are ever removed, so hand-written notes are safe.
+const managedMarker = "---\nsamedi_"
+
+// Vault writes notes under a directory inside an Obsidian vault.
+type Vault struct {
+	dir string
+}
+
+// NewVault creates a vault writer rooted at dir (the vault path joined
+// with the configured folder).
+func NewVault(dir string) *Vault {
+	return &Vault{dir: dir}
+}
+
+// Dir returns the directory notes are written to.
+func (v *Vault) Dir() string {
+	return v.dir
+}
+
+// Result summarizes a mirror run.
+type Result struct {
+	Plans    int // Plans mirrored
+	Sessions int // Completed sessions mirrored
+	Written  int // Notes created or changed
+	Removed  int // Stale notes deleted
+}
+
+// Mirror writes one note per plan, chunk, and completed session, then
+// removes samedi notes whose plan or session no longer exists. Notes whose
+// content is unchanged are left untouched so Obsidian does not reindex them.
+func (v *Vault) Mirror(plans []*plan.Plan, sessions []*session.Session) (*Result, error) {
+	notes := buildNotes(plans, sessions)
+	result := &Result{Plans: len(plans)}
+	for _, sess := range sessions {
+		if !sess.IsActive() {
+			result.Sessions++
+		}
+	}
+
+	for _, folder := range []string{PlansFolder, ChunksFolder, SessionsFolder} {
+		if err := os.MkdirAll(filepath.Join(v.dir, folder), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create vault folder: %w", err)
+		}
+	}
+
+	paths := make([]string, 0, len(notes))
+	for path := range notes {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		written, err := v.writeNote(path, notes[path])
+		if err != nil {
+			return nil, err
+		}
+		if written {
+			result.Written++
+		}
+	}
+
+	removed, err := v.removeStale(notes)
+	if err != nil {
+		return nil, err
+	}
+	result.Removed = removed
+
+	return result, nil
+}
+
+// writeNote writes content to the note at the vault-relative path unless
+// the file already holds it.
+func (v *Vault) writeNote(path, content string) (bool, error) {
+	fullPath := filepath.Join(v.dir, filepath.FromSlash(path)+".md")
+	existing, err := os.ReadFile(fullPath)
+	if err == nil && bytes.Equal(existing, []byte(content)) {
+		return false, nil
+	}
+
+	if err := os.WriteFile(fullPath, []byte(content), 0o644); err != nil {
+		return false, fmt.Errorf("failed to write note %s: %w", path, err)
+	}
+	return true, nil
+}
+
+// removeStale deletes samedi notes in the managed folders that were not
+// part of this mirror run.
+func (v *Vault) removeStale(notes map[string]string) (int, error) {
+	removed := 0
+	for _, folder := range []string{PlansFolder, ChunksFolder, SessionsFolder} {
+		entries, err := os.ReadDir(filepath.Join(v.dir, folder))
+		if err != nil {
+			return removed, fmt.Errorf("failed to read vault folder: %w", err)
+		}
+
+		for _, entry := range entries {
+			name := entry.Name()
+			if entry.IsDir() || !strings.HasSuffix(name, ".md") {
+				continue
+			}
+			if _, ok := notes[folder+"/"+strings.TrimSuffix(name, ".md")]; ok {
+				continue
+			}
+
+			fullPath := filepath.Join(v.dir, folder, name)
+			content, err := os.ReadFile(fullPath)
+			if err != nil || !strings.HasPrefix(string(content), managedMarker) {
+				continue
+			}
+			if err := os.Remove(fullPath); err != nil {
+				return removed, fmt.Errorf("failed to remove stale note %s: %w", name, err)
+			}
+			removed++
+		}
+	}
+	return removed, nil
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package obsidian
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func vaultTestData() ([]*plan.Plan, []*session.Session) {
+	start := time.Date(2025, 10, 14, 9, 0, 0, 0, time.UTC)
+	end := start.Add(75 * time.Minute)
+
+	plans := []*plan.Plan{
+		{
+			ID:         "rust-async",
+			Title:      "Rust Async",
+			TotalHours: 2,
+			Status:     plan.StatusInProgress,
+			Tags:       []string{"rust", "systems programming"},
+			Chunks: []plan.Chunk{
+				{ID: "chunk-001", Title: "Futures", Duration: 60, Status: plan.StatusCompleted},
+				{
+					ID:         "chunk-002",
+					Title:      "Tokio basics",
+					Duration:   60,
+					Status:     plan.StatusInProgress,
+					Objectives: []string{"Spawn tasks"},
+					Resources:  []string{"[x] Tokio tutorial"},
+				},
+			},
+		},
+	}
+	sessions := []*session.Session{
+		{
+			ID:        "3f2a9c1e-aaaa-bbbb-cccc-000000000001",
+			PlanID:    "rust-async",
+			ChunkID:   "chunk-002",
+			StartTime: start,
+			EndTime:   &end,
+			Duration:  75,
+			Notes:     "Learned about join handles",
+			Artifacts: []string{"https://github.com/user/repo"},
+		},
+		{
+			ID:        "7b8c0d2e-aaaa-bbbb-cccc-000000000002",
+			PlanID:    "rust-async",
+			StartTime: start.Add(24 * time.Hour),
+		},
+	}
+	return plans, sessions
+}
+
+func readNote(t *testing.T, dir, path string) string {
+	t.Helper()
+	content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(path)+".md"))
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestVault_Mirror(t *testing.T) {
+	dir := t.TempDir()
+	plans, sessions := vaultTestData()
+
+	result, err := NewVault(dir).Mirror(plans, sessions)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Plans)
+	assert.Equal(t, 1, result.Sessions, "active sessions are skipped")
+	assert.Equal(t, 4, result.Written)
+
+	planNote := readNote(t, dir, "Plans/rust-async")
+	assert.Contains(t, planNote, "tags: [samedi, rust, systems-programming]")
+	assert.Contains(t, planNote, "- [x] [[Chunks/rust-async chunk-001|Futures]] (60 min)")
+	assert.Contains(t, planNote, "[[Sessions/2025-10-14 rust-async 3f2a9c1e|Oct 14, 2025 09:00]] · 1h 15m")
+
+	chunkNote := readNote(t, dir, "Chunks/rust-async chunk-002")
+	assert.Contains(t, chunkNote, "Plan: [[Plans/rust-async|Rust Async]]")
+	assert.Contains(t, chunkNote, "- [x] Tokio tutorial")
+	assert.Contains(t, chunkNote, "Sessions/2025-10-14 rust-async 3f2a9c1e")
+
+	sessionNote := readNote(t, dir, "Sessions/2025-10-14 rust-async 3f2a9c1e")
+	assert.Contains(t, sessionNote, "Chunk: [[Chunks/rust-async chunk-002|Tokio basics]]")
+	assert.Contains(t, sessionNote, "Learned about join handles")
+	assert.Contains(t, sessionNote, "- https://github.com/user/repo")
+}
+
+func TestVault_Mirror_SkipsUnchangedNotes(t *testing.T) {
+	dir := t.TempDir()
+	plans, sessions := vaultTestData()
+	vault := NewVault(dir)
+
+	_, err := vault.Mirror(plans, sessions)
+	require.NoError(t, err)
+
+	result, err := vault.Mirror(plans, sessions)
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Written)
+	assert.Equal(t, 0, result.Removed)
+}
+
+func TestVault_Mirror_RemovesStaleNotes(t *testing.T) {
+	dir := t.TempDir()
+	plans, sessions := vaultTestData()
+	vault := NewVault(dir)
+
+	_, err := vault.Mirror(plans, sessions)
+	require.NoError(t, err)
+
+	handWritten := filepath.Join(dir, PlansFolder, "My thoughts.md")
+	require.NoError(t, os.WriteFile(handWritten, []byte("# Thoughts"), 0o600))
+
+	result, err := vault.Mirror(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 4, result.Removed)
+	assert.FileExists(t, handWritten, "notes without samedi frontmatter are kept")
+	assert.NoFileExists(t, filepath.Join(dir, PlansFolder, "rust-async.md"))
+}
+
+func TestWikilink_EscapesAlias(t *testing.T) {
+	assert.Equal(t, "[[Plans/x|A - B (1)]]", wikilink("Plans/x", "A | B [1]"))
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"context"
+	"fmt"
+)
+
+// Archive marks a plan as archived. With moveFile set, its markdown file
+// is also moved into the plans/archive directory so the active plans
+// directory stays uncluttered.
+func (s *Service) Archive(ctx context.Context, id string, moveFile bool) (*Plan, error) {
+	plan, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if moveFile {
+		if err := s.filesystemRepo.Move(ctx, id, true); err != nil {
+			return nil, err
+		}
+	}
+
+	plan.Status = StatusArchived
+	if err := s.Update(ctx, plan); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// Unarchive restores an archived plan: its file moves back into the plans
+// directory if it was archived there, and its status is inferred again
+// from its chunks.
+func (s *Service) Unarchive(ctx context.Context, id string) (*Plan, error) {
+	plan, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	archivedFile := s.filesystemRepo.IsArchived(id)
+	if plan.Status != StatusArchived && !archivedFile {
+		return nil, fmt.Errorf("plan %s is not archived", id)
+	}
+
+	if archivedFile {
+		if err := s.filesystemRepo.Move(ctx, id, false); err != nil {
+			return nil, err
+		}
+	}
+
+	if plan.Status == StatusArchived {
+		plan.Status = s.inferPlanStatus(plan)
+	}
+	if err := s.Update(ctx, plan); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// FilePath returns the markdown file backing a plan, in the plans
+// directory or its archive.
+func (s *Service) FilePath(id string) string {
+	return s.filesystemRepo.Path(id)
+}
+
+// EditFile lets edit change a plan's markdown file in place, as an
+// external editor does. An encrypted plan is handed to edit as a
+// temporary plaintext copy and encrypted again afterwards.
+func (s *Service) EditFile(id string, edit func(path string) error) error {
+	return s.fs.EditFile(s.FilePath(id), edit)
+}
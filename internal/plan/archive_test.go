@@ -0,0 +1,112 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_ArchiveMovesFileAndUnarchiveRestores(t *testing.T) {
+	service, _, paths, cleanup := setupTestService(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	_, err := service.Scaffold(ctx, CreateRequest{Topic: "Rust Async", TotalHours: 2})
+	require.NoError(t, err)
+
+	p, err := service.Archive(ctx, "rust-async", true)
+	require.NoError(t, err)
+	assert.Equal(t, StatusArchived, p.Status)
+	assert.NoFileExists(t, paths.PlanPath("rust-async"))
+	assert.FileExists(t, paths.ArchivedPlanPath("rust-async"))
+	assert.Equal(t, paths.ArchivedPlanPath("rust-async"), service.FilePath("rust-async"))
+
+	// Load and the index both follow the file into the archive
+	loaded, err := service.Get(ctx, "rust-async")
+	require.NoError(t, err)
+	assert.Equal(t, StatusArchived, loaded.Status)
+
+	records, err := service.List(ctx, &storage.PlanFilter{IDs: []string{"rust-async"}})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, paths.ArchivedPlanPath("rust-async"), records[0].FilePath)
+
+	p, err = service.Unarchive(ctx, "rust-async")
+	require.NoError(t, err)
+	assert.Equal(t, StatusNotStarted, p.Status)
+	assert.FileExists(t, paths.PlanPath("rust-async"))
+	assert.NoFileExists(t, paths.ArchivedPlanPath("rust-async"))
+
+	_, err = service.Unarchive(ctx, "rust-async")
+	assert.ErrorContains(t, err, "not archived")
+}
+
+func TestService_ArchiveWithoutMoveKeepsFile(t *testing.T) {
+	service, _, paths, cleanup := setupTestService(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	_, err := service.Scaffold(ctx, CreateRequest{Topic: "French", TotalHours: 2})
+	require.NoError(t, err)
+
+	_, err = service.Archive(ctx, "french", false)
+	require.NoError(t, err)
+	assert.FileExists(t, paths.PlanPath("french"))
+
+	_, err = service.Unarchive(ctx, "french")
+	require.NoError(t, err)
+	assert.FileExists(t, paths.PlanPath("french"))
+}
+
+// archiveTestPlan returns a minimal valid plan with the given ID.
+func archiveTestPlan(id string) *Plan {
+	now := time.Now()
+	return &Plan{
+		ID:         id,
+		Title:      id,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		TotalHours: 1.0,
+		Status:     StatusNotStarted,
+		Chunks:     []Chunk{{ID: "chunk-001", Title: "Chunk 1", Duration: 60, Status: StatusNotStarted}},
+	}
+}
+
+func TestFilesystemRepository_ListIncludesArchive(t *testing.T) {
+	repo, paths, cleanup := setupTestFilesystem(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	for _, id := range []string{"active", "archived"} {
+		require.NoError(t, repo.Save(ctx, archiveTestPlan(id)))
+	}
+	require.NoError(t, repo.Move(ctx, "archived", true))
+	assert.True(t, repo.IsArchived("archived"))
+
+	ids, err := repo.List(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"active", "archived"}, ids)
+
+	// Saving an archived plan keeps it in the archive
+	require.NoError(t, repo.Save(ctx, archiveTestPlan("archived")))
+	assert.NoFileExists(t, paths.PlanPath("archived"))
+
+	// Moving onto an existing file is refused; the active copy wins
+	content, err := os.ReadFile(paths.ArchivedPlanPath("archived"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(paths.PlanPath("archived"), content, 0o600))
+	assert.False(t, repo.IsArchived("archived"))
+	assert.ErrorContains(t, repo.Move(ctx, "archived", true), "already exists")
+
+	ids, err = repo.List(ctx)
+	require.NoError(t, err)
+	assert.Len(t, ids, 2, "a plan in both directories is listed once")
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// IndexedChunk is a chunk as kept in the chunks table: enough to find,
+// filter, and count chunks across plans. Objectives, resources, and
+// deliverables stay in the markdown.
+type IndexedChunk struct {
+	PlanID    string `json:"plan_id"`
+	PlanTitle string `json:"plan_title"`
+	ID        string `json:"id"`
+	Position  int    `json:"position"` // 0-based order within the plan
+	Title     string `json:"title"`
+	Duration  int    `json:"duration"` // Minutes
+	Status    Status `json:"status"`
+}
+
+// ChunkFilter narrows ListChunks. Empty fields match everything.
+type ChunkFilter struct {
+	PlanIDs  []string
+	Statuses []Status
+
+	// IncludeArchived keeps chunks of archived plans, which are left out
+	// by default.
+	IncludeArchived bool
+}
+
+// SaveChunks replaces the indexed chunks of a plan with chunks.
+func (r *SQLiteRepository) SaveChunks(ctx context.Context, planID string, chunks []Chunk) error {
+	tx, err := r.db.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		// Ignore error on rollback - it's expected if commit succeeded
+		//nolint:errcheck // Transaction cleanup
+		tx.Rollback()
+	}()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM chunks WHERE plan_id = ?", planID); err != nil {
+		return fmt.Errorf("failed to clear chunks: %w", err)
+	}
+	for i, chunk := range chunks {
+		// Hand-edited files may repeat an ID; the later chunk wins
+		_, err := tx.ExecContext(ctx, `
+			INSERT OR REPLACE INTO chunks (plan_id, id, position, title, duration_minutes, status)
+			VALUES (?, ?, ?, ?, ?, ?)
+		`, planID, chunk.ID, i, chunk.Title, chunk.Duration, string(chunk.Status))
+		if err != nil {
+			return fmt.Errorf("failed to index chunk %s: %w", chunk.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// ListChunks returns indexed chunks matching filter, by plan and then in
+// plan order.
+func (r *SQLiteRepository) ListChunks(ctx context.Context, filter *ChunkFilter) ([]*IndexedChunk, error) {
+	if filter == nil {
+		filter = &ChunkFilter{}
+	}
+
+	var (
+		conditions []string
+		args       []any
+	)
+	if len(filter.PlanIDs) > 0 {
+		conditions = append(conditions, "chunks.plan_id IN ("+r.buildPlaceholders(len(filter.PlanIDs))+")")
+		for _, id := range filter.PlanIDs {
+			args = append(args, id)
+		}
+	}
+	if len(filter.Statuses) > 0 {
+		conditions = append(conditions, "chunks.status IN ("+r.buildPlaceholders(len(filter.Statuses))+")")
+		for _, status := range filter.Statuses {
+			args = append(args, string(status))
+		}
+	}
+	if !filter.IncludeArchived {
+		conditions = append(conditions, "plans.status != ?")
+		args = append(args, string(StatusArchived))
+	}
+
+	query := `
+		SELECT chunks.plan_id, plans.title, chunks.id, chunks.position,
+			chunks.title, chunks.duration_minutes, chunks.status
+		FROM chunks
+		JOIN plans ON plans.id = chunks.plan_id`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY chunks.plan_id, chunks.position"
+
+	rows, err := r.db.DB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chunks: %w", err)
+	}
+	defer rows.Close()
+
+	chunks := make([]*IndexedChunk, 0)
+	for rows.Next() {
+		c := &IndexedChunk{}
+		var status string
+		if err := rows.Scan(&c.PlanID, &c.PlanTitle, &c.ID, &c.Position, &c.Title, &c.Duration, &status); err != nil {
+			return nil, fmt.Errorf("failed to scan chunk: %w", err)
+		}
+		c.Status = Status(status)
+		chunks = append(chunks, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating chunk rows: %w", err)
+	}
+	return chunks, nil
+}
+
+// chunksIndexed reports whether indexed holds exactly chunks, in order.
+func chunksIndexed(indexed []*IndexedChunk, chunks []Chunk) bool {
+	if len(indexed) != len(chunks) {
+		return false
+	}
+	for i, chunk := range chunks {
+		c := indexed[i]
+		if c.ID != chunk.ID || c.Title != chunk.Title || c.Duration != chunk.Duration || c.Status != chunk.Status {
+			return false
+		}
+	}
+	return true
+}
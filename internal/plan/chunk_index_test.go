@@ -0,0 +1,97 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLiteRepository_ListChunks(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewSQLiteRepository(db)
+	ctx := context.Background()
+
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	save := func(p *Plan) {
+		p.CreatedAt, p.UpdatedAt, p.TotalHours = now, now, 2
+		require.NoError(t, repo.Upsert(ctx, ToRecord(p, "/path/to/"+p.ID+".md")))
+		require.NoError(t, repo.SaveChunks(ctx, p.ID, p.Chunks))
+	}
+	save(&Plan{ID: "rust", Title: "Rust", Status: StatusInProgress, Chunks: []Chunk{
+		{ID: "chunk-001", Title: "Basics", Duration: 60, Status: StatusCompleted},
+		{ID: "chunk-002", Title: "Ownership", Duration: 90, Status: StatusInProgress},
+	}})
+	save(&Plan{ID: "go", Title: "Go", Status: StatusInProgress, Chunks: []Chunk{
+		{ID: "chunk-001", Title: "Tour", Duration: 60, Status: StatusInProgress},
+	}})
+	save(&Plan{ID: "old", Title: "Old", Status: StatusArchived, Chunks: []Chunk{
+		{ID: "chunk-001", Title: "Gone", Duration: 30, Status: StatusInProgress},
+	}})
+
+	chunks, err := repo.ListChunks(ctx, &ChunkFilter{Statuses: []Status{StatusInProgress}})
+	require.NoError(t, err)
+	require.Len(t, chunks, 2, "archived plans are left out")
+	assert.Equal(t, "go", chunks[0].PlanID)
+	assert.Equal(t, "Go", chunks[0].PlanTitle)
+	assert.Equal(t, "Ownership", chunks[1].Title)
+	assert.Equal(t, 1, chunks[1].Position)
+	assert.Equal(t, 90, chunks[1].Duration)
+
+	chunks, err = repo.ListChunks(ctx, &ChunkFilter{Statuses: []Status{StatusInProgress}, IncludeArchived: true})
+	require.NoError(t, err)
+	assert.Len(t, chunks, 3)
+
+	// Saving again replaces the plan's chunks
+	require.NoError(t, repo.SaveChunks(ctx, "rust", []Chunk{{ID: "chunk-009", Title: "Async", Duration: 30, Status: StatusNotStarted}}))
+	chunks, err = repo.ListChunks(ctx, &ChunkFilter{PlanIDs: []string{"rust"}})
+	require.NoError(t, err)
+	require.Len(t, chunks, 1)
+	assert.Equal(t, "chunk-009", chunks[0].ID)
+
+	require.NoError(t, repo.Delete(ctx, "rust"))
+	chunks, err = repo.ListChunks(ctx, nil)
+	require.NoError(t, err)
+	assert.Len(t, chunks, 1, "a deleted plan takes its chunks along")
+}
+
+func TestService_ChunksFollowPlanSaves(t *testing.T) {
+	service, _, paths, cleanup := setupTestService(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	require.NoError(t, os.WriteFile(paths.PlanPath("test-plan"), []byte(validPlanMarkdown), 0o600))
+	require.NoError(t, service.RefreshIndex(ctx, "test-plan"))
+
+	chunks, err := service.ListChunks(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, chunks, 1)
+	assert.Equal(t, StatusNotStarted, chunks[0].Status)
+
+	require.NoError(t, service.UpdateChunkStatus(ctx, "test-plan", "chunk-001", StatusInProgress))
+	chunks, err = service.ListChunks(ctx, &ChunkFilter{Statuses: []Status{StatusInProgress}})
+	require.NoError(t, err)
+	require.Len(t, chunks, 1)
+
+	// A hand edit to a chunk alone is picked up by reindex
+	edited := strings.Replace(validPlanMarkdown, "First Chunk", "Renamed Chunk", 1)
+	edited = strings.Replace(edited, "**Status**: not-started", "**Status**: in-progress", 1)
+	require.NoError(t, os.WriteFile(paths.PlanPath("test-plan"), []byte(edited), 0o600))
+	result, err := service.Reindex(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"test-plan"}, result.Updated)
+
+	chunks, err = service.ListChunks(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, chunks, 1)
+	assert.Equal(t, "Renamed Chunk", chunks[0].Title)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Markdown returns a plan file's content as stored on disk, including any
+// text the parser doesn't keep, such as notes and code examples.
+func (s *Service) Markdown(ctx context.Context, id string) (string, error) {
+	if !s.filesystemRepo.Exists(ctx, id) {
+		return "", fmt.Errorf("%w: %s", ErrPlanNotFound, id)
+	}
+	data, err := s.fs.ReadFile(s.filesystemRepo.Path(id))
+	if err != nil {
+		return "", fmt.Errorf("failed to read plan file: %w", err)
+	}
+	return string(data), nil
+}
+
+// ChunkMarkdown cuts the body of chunkID's section out of a plan file's
+// content: every line after its header up to the next chunk header or the
+// "---" between chunks, less the Duration and Status fields, which callers
+// show on their own. Lines inside code fences never end the section. ok is
+// false when no chunk has that ID.
+func ChunkMarkdown(content, chunkID string) (body string, ok bool) {
+	var lines []string
+	found, inside, fenced := false, false, false
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if !fenced {
+			if matches := chunkHeaderRegex.FindStringSubmatch(trimmed); matches != nil || looseChunkHeaderRegex.MatchString(trimmed) {
+				if inside {
+					break
+				}
+				inside = matches != nil && matches[2] == chunkID
+				found = found || inside
+				continue
+			}
+			if !inside {
+				continue
+			}
+			if trimmed == frontmatterDelimiter {
+				break
+			}
+			if durationRegex.MatchString(trimmed) || statusRegex.MatchString(trimmed) {
+				continue
+			}
+		}
+		if isFence(trimmed) {
+			fenced = !fenced
+		}
+		lines = append(lines, line)
+	}
+	if !found {
+		return "", false
+	}
+	body = strings.TrimRight(strings.TrimLeft(strings.Join(lines, "\n"), "\r\n"), " \t\r\n")
+	if body == "" {
+		return "", true
+	}
+	return body + "\n", true
+}
+
+// isFence reports whether a trimmed line opens or closes a code fence.
+func isFence(trimmed string) bool {
+	return strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")
+}
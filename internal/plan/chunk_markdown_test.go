@@ -0,0 +1,74 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const chunkNotesMarkdown = `---
+id: rust
+title: Rust
+---
+
+# Rust
+
+## Chunk 1: Ownership {#chunk-001}
+**Duration**: 1 hour
+**Status**: completed
+
+---
+
+## Chunk 2: Borrowing {#chunk-002}
+**Duration**: 1 hour
+**Status**: in-progress
+
+Notes on borrowing:
+
+` + "```rust" + `
+// ---
+// ## Chunk 9: not a header {#chunk-009}
+let r = &s;
+` + "```" + `
+
+## Chunk 3: Lifetimes {#chunk-003}
+**Duration**: 1 hour
+**Status**: not-started
+`
+
+func TestChunkMarkdown(t *testing.T) {
+	body, ok := ChunkMarkdown(chunkNotesMarkdown, "chunk-002")
+	require.True(t, ok)
+	assert.True(t, strings.HasPrefix(body, "Notes on borrowing:\n"), "the header, duration and status are left out")
+	assert.True(t, strings.HasSuffix(body, "let r = &s;\n```\n"), "separators and headers inside fences don't end the section")
+	assert.NotContains(t, body, "Lifetimes")
+
+	body, ok = ChunkMarkdown(chunkNotesMarkdown, "chunk-001")
+	require.True(t, ok)
+	assert.Empty(t, body)
+
+	_, ok = ChunkMarkdown(chunkNotesMarkdown, "chunk-009")
+	assert.False(t, ok)
+}
+
+func TestService_Markdown(t *testing.T) {
+	service, _, paths, cleanup := setupTestService(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	require.NoError(t, os.WriteFile(paths.PlanPath("rust"), []byte(chunkNotesMarkdown), 0o600))
+
+	content, err := service.Markdown(ctx, "rust")
+	require.NoError(t, err)
+	assert.Equal(t, chunkNotesMarkdown, content)
+
+	_, err = service.Markdown(ctx, "missing")
+	assert.Error(t, err)
+}
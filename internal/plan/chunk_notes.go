@@ -0,0 +1,48 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AddNote appends a session note to the chunk's notes, dated by at. A
+// note spanning several lines is joined onto one, as the list item it is
+// kept as in the plan file. Blank notes are ignored.
+func (c *Chunk) AddNote(note string, at time.Time) {
+	note = strings.Join(strings.Fields(note), " ")
+	if note == "" {
+		return
+	}
+	c.Notes = append(c.Notes, fmt.Sprintf("%s: %s", at.Format(DateFormat), note))
+}
+
+// AddChunkNote records a session's note under its chunk in the plan file,
+// so the chunk collects what was learned in every session spent on it.
+func (s *Service) AddChunkNote(ctx context.Context, planID, chunkID, note string, at time.Time) error {
+	plan, err := s.Get(ctx, planID)
+	if err != nil {
+		return fmt.Errorf("failed to load plan: %w", err)
+	}
+
+	var chunk *Chunk
+	for i := range plan.Chunks {
+		if plan.Chunks[i].ID == chunkID {
+			chunk = &plan.Chunks[i]
+			break
+		}
+	}
+	if chunk == nil {
+		return fmt.Errorf("%w: %s in plan %s", ErrChunkNotFound, chunkID, planID)
+	}
+	chunk.AddNote(note, at)
+
+	if err := s.Update(ctx, plan); err != nil {
+		return fmt.Errorf("failed to update plan: %w", err)
+	}
+	return nil
+}
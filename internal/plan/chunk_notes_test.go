@@ -0,0 +1,85 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunk_AddNote(t *testing.T) {
+	at := time.Date(2026, 10, 16, 21, 0, 0, 0, time.UTC)
+	chunk := &Chunk{ID: "chunk-001"}
+
+	chunk.AddNote("Pin keeps\n  futures in place", at)
+	chunk.AddNote("   ", at)
+	assert.Equal(t, []string{"2026-10-16: Pin keeps futures in place"}, chunk.Notes)
+}
+
+func TestParse_ChunkNotes(t *testing.T) {
+	content := `---
+title: Notes
+created: 2026-10-01T00:00:00Z
+updated: 2026-10-01T00:00:00Z
+total_hours: 1
+status: in-progress
+---
+
+# Notes
+
+## Chunk 1: Futures {#chunk-001}
+**Duration**: 1 hour
+**Status**: in-progress
+**Objectives**:
+- Understand polling
+
+**Notes**:
+- 2026-10-14: Poll returns Pending until woken
+- 2026-10-16: Pin keeps futures in place
+`
+
+	p, warnings, err := ParseWithWarnings(content)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+	require.Len(t, p.Chunks, 1)
+	assert.Equal(t, []string{"Understand polling"}, p.Chunks[0].Objectives)
+	assert.Equal(t, []string{
+		"2026-10-14: Poll returns Pending until woken",
+		"2026-10-16: Pin keeps futures in place",
+	}, p.Chunks[0].Notes)
+
+	formatted, err := Format(p)
+	require.NoError(t, err)
+	assert.Contains(t, formatted, "**Notes**:\n- 2026-10-14: Poll returns Pending until woken\n")
+	reparsed, err := Parse(formatted)
+	require.NoError(t, err)
+	assert.Equal(t, p.Chunks[0].Notes, reparsed.Chunks[0].Notes)
+}
+
+func TestService_AddChunkNote(t *testing.T) {
+	service, mockLLM, _, cleanup := setupTestService(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	mockLLM.CallFunc = func(_ context.Context, _ string) (string, error) {
+		return validPlanMarkdown, nil
+	}
+	_, err := service.Create(ctx, CreateRequest{Topic: "Test Plan", TotalHours: 10.0})
+	require.NoError(t, err)
+
+	at := time.Date(2026, 10, 16, 21, 0, 0, 0, time.UTC)
+	require.NoError(t, service.AddChunkNote(ctx, "test-plan", "chunk-001", "Pin keeps futures in place", at))
+	require.NoError(t, service.AddChunkNote(ctx, "test-plan", "chunk-001", "Wakers next", at.AddDate(0, 0, 1)))
+
+	reloaded, err := service.Get(ctx, "test-plan")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2026-10-16: Pin keeps futures in place", "2026-10-17: Wakers next"}, reloaded.Chunks[0].Notes)
+
+	err = service.AddChunkNote(ctx, "test-plan", "chunk-404", "lost", at)
+	assert.ErrorIs(t, err, ErrChunkNotFound)
+}
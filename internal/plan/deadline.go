@@ -0,0 +1,85 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DateFormat is how deadlines are written in frontmatter and on the
+// command line.
+const DateFormat = "2006-01-02"
+
+// Date is a calendar day in local time, written as "2006-01-02" so it
+// reads naturally in frontmatter.
+type Date struct {
+	time.Time
+}
+
+// ParseDate parses a "2006-01-02" date as midnight local time.
+func ParseDate(value string) (Date, error) {
+	t, err := time.ParseInLocation(DateFormat, strings.TrimSpace(value), time.Local)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid date %q (expected YYYY-MM-DD)", value)
+	}
+	return Date{Time: t}, nil
+}
+
+// String formats the date as "2006-01-02".
+func (d Date) String() string {
+	return d.Format(DateFormat)
+}
+
+// DaysFrom returns the number of calendar days from now's date until d;
+// negative once d has passed.
+func (d Date) DaysFrom(now time.Time) int {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	day := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)
+	return int(day.Sub(today).Hours() / 24)
+}
+
+// MarshalYAML writes the date without a time.
+func (d Date) MarshalYAML() (interface{}, error) {
+	return d.String(), nil
+}
+
+// UnmarshalYAML reads a "2006-01-02" date. Full timestamps, as YAML
+// tools sometimes write, are accepted and truncated to their day.
+func (d *Date) UnmarshalYAML(node *yaml.Node) error {
+	parsed, err := ParseDate(node.Value)
+	if err == nil {
+		*d = parsed
+		return nil
+	}
+	t, tsErr := time.Parse(time.RFC3339, node.Value)
+	if tsErr != nil {
+		return err
+	}
+	*d = Date{Time: time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)}
+	return nil
+}
+
+// MarshalJSON writes the date as a "2006-01-02" string.
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON reads a "2006-01-02" string.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	parsed, err := ParseDate(value)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestParseDate(t *testing.T) {
+	d, err := ParseDate(" 2025-06-30 ")
+	require.NoError(t, err)
+	assert.Equal(t, "2025-06-30", d.String())
+
+	_, err = ParseDate("30/06/2025")
+	assert.ErrorContains(t, err, "expected YYYY-MM-DD")
+}
+
+func TestDate_DaysFrom(t *testing.T) {
+	d, err := ParseDate("2025-06-30")
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, d.DaysFrom(time.Date(2025, 6, 28, 23, 0, 0, 0, time.Local)))
+	assert.Equal(t, 0, d.DaysFrom(time.Date(2025, 6, 30, 8, 0, 0, 0, time.Local)))
+	assert.Equal(t, -1, d.DaysFrom(time.Date(2025, 7, 1, 0, 0, 0, 0, time.Local)))
+}
+
+func TestDate_YAMLAndJSON(t *testing.T) {
+	type doc struct {
+		Deadline *Date `yaml:"deadline,omitempty" json:"deadline,omitempty"`
+	}
+
+	var in doc
+	require.NoError(t, yaml.Unmarshal([]byte("deadline: 2025-06-30\n"), &in))
+	require.NotNil(t, in.Deadline)
+	assert.Equal(t, "2025-06-30", in.Deadline.String())
+
+	out, err := yaml.Marshal(in)
+	require.NoError(t, err)
+	assert.Equal(t, "deadline: \"2025-06-30\"\n", string(out))
+
+	data, err := json.Marshal(in)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"deadline":"2025-06-30"}`, string(data))
+
+	var back doc
+	require.NoError(t, json.Unmarshal(data, &back))
+	assert.Equal(t, in.Deadline.String(), back.Deadline.String())
+
+	// Timestamps written by other tools keep their day
+	var stamped doc
+	require.NoError(t, yaml.Unmarshal([]byte("deadline: 2025-06-30T00:00:00Z\n"), &stamped))
+	assert.Equal(t, "2025-06-30", stamped.Deadline.String())
+
+	empty, err := yaml.Marshal(doc{})
+	require.NoError(t, err)
+	assert.Equal(t, "{}\n", string(empty))
+}
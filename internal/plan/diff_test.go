@@ -0,0 +1,51 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	a := "title\n\n## Chunk 1\nStatus: not-started\none\ntwo\nthree\nfour\nfive\nsix\nseven\nlast\n"
+	b := "title\n\n## Chunk 1\nStatus: completed\none\ntwo\nthree\nfour\nfive\nsix\nseven\nlast\nadded\n"
+
+	diff := UnifiedDiff("p@v1", "p@v2", a, b)
+
+	assert.Equal(t, `--- p@v1
++++ p@v2
+@@ -1,7 +1,7 @@
+ title
+ 
+ ## Chunk 1
+-Status: not-started
++Status: completed
+ one
+ two
+ three
+@@ -10,3 +10,4 @@
+ six
+ seven
+ last
++added
+`, diff)
+}
+
+func TestUnifiedDiff_Identical(t *testing.T) {
+	assert.Empty(t, UnifiedDiff("a", "b", "same\n", "same\n"))
+}
+
+func TestUnifiedDiff_FromEmpty(t *testing.T) {
+	diff := UnifiedDiff("a", "b", "", "one\ntwo\n")
+	assert.True(t, strings.HasPrefix(diff, "--- a\n+++ b\n@@ -0,0 +1,2 @@\n+one\n+two\n"), diff)
+}
+
+func TestDiffStats(t *testing.T) {
+	added, removed := DiffStats("a\nb\nc\n", "a\nB\nc\nd\n")
+	assert.Equal(t, 2, added)
+	assert.Equal(t, 1, removed)
+}
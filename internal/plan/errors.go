@@ -0,0 +1,18 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import "errors"
+
+// ErrPlanNotFound is returned, wrapped with the plan ID, when no plan has
+// the requested ID.
+var ErrPlanNotFound = errors.New("plan not found")
+
+// ErrChunkNotFound is returned, wrapped with the chunk and plan IDs, when
+// a plan has no chunk with the requested ID.
+var ErrChunkNotFound = errors.New("chunk not found")
+
+// ErrInvalidStatus is returned, wrapped with the status, when a status is
+// not one a plan or chunk can have.
+var ErrInvalidStatus = errors.New("invalid status")
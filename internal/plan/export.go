@@ -0,0 +1,198 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ExportOptions controls what an exported plan includes.
+type ExportOptions struct {
+	Markdown string         // The plan file's content, for chunk text the parser doesn't keep
+	Logged   map[string]int // Minutes of finished sessions per chunk ID
+	Notes    bool           // Include the notes gathered from sessions, which are personal
+	Now      time.Time      // When the export was made
+}
+
+// Export renders p as a standalone markdown document to share: no
+// frontmatter, each chunk annotated with its status and the time logged
+// on it, and the plan's progress up top.
+func Export(p *Plan, opts ExportOptions) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", p.Title)
+
+	logged := 0
+	for _, minutes := range opts.Logged {
+		logged += minutes
+	}
+	fmt.Fprintf(&b, "- **Progress**: %d of %d chunks completed (%d%%) · %s of %gh logged\n",
+		p.CompletedChunks(), len(p.Chunks), p.ProgressPercent(), exportMinutes(logged), p.TotalHours)
+	fmt.Fprintf(&b, "- **Status**: %s", exportStatus(p.Status))
+	if p.Deadline != nil {
+		fmt.Fprintf(&b, " · **Deadline**: %s", p.Deadline)
+	}
+	b.WriteString("\n")
+	if len(p.Tags) > 0 {
+		fmt.Fprintf(&b, "- **Tags**: %s\n", strings.Join(p.Tags, ", "))
+	}
+
+	if intro := introMarkdown(opts.Markdown); intro != "" {
+		fmt.Fprintf(&b, "\n%s\n", intro)
+	}
+
+	for i := range p.Chunks {
+		chunk := &p.Chunks[i]
+		fmt.Fprintf(&b, "\n## %d. %s\n\n", i+1, chunk.Title)
+
+		fmt.Fprintf(&b, "*%s · %s planned", exportStatus(chunk.Status), exportMinutes(chunk.Duration))
+		if minutes := opts.Logged[chunk.ID]; minutes > 0 {
+			fmt.Fprintf(&b, " · %s logged", exportMinutes(minutes))
+		}
+		b.WriteString("*\n\n")
+
+		body, ok := ChunkMarkdown(opts.Markdown, chunk.ID)
+		if !ok {
+			body = chunkFields(chunk)
+		}
+		if !opts.Notes {
+			body = withoutNotes(body)
+		}
+		if body = strings.TrimSpace(body); body != "" {
+			b.WriteString(body)
+			b.WriteString("\n")
+		}
+	}
+
+	fmt.Fprintf(&b, "\n---\n\n*Exported from samedi on %s.*\n", opts.Now.Format("January 2, 2006"))
+	return b.String()
+}
+
+// Export renders plan id for sharing, with the time logged on each chunk
+// when sessions are available. See Export.
+func (s *Service) Export(ctx context.Context, id string, notes bool) (string, error) {
+	p, err := s.Get(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	content, err := s.Markdown(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	sessions, err := s.PlanSessions(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	logged := make(map[string]int)
+	for _, sess := range sessions {
+		if !sess.IsActive() && sess.ChunkID != "" {
+			logged[sess.ChunkID] += sess.Duration
+		}
+	}
+
+	return Export(p, ExportOptions{Markdown: content, Logged: logged, Notes: notes, Now: time.Now()}), nil
+}
+
+// introMarkdown returns the text of a plan file between its title heading
+// and the first chunk, such as the goal and timeline.
+func introMarkdown(content string) string {
+	_, body, err := splitFrontmatter(content)
+	if err != nil {
+		return ""
+	}
+	var lines []string
+	for _, line := range strings.Split(body, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if chunkHeaderRegex.MatchString(trimmed) || looseChunkHeaderRegex.MatchString(trimmed) {
+			break
+		}
+		if strings.HasPrefix(trimmed, "# ") || trimmed == frontmatterDelimiter {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// chunkFields renders a chunk's parsed fields, for plans whose file has no
+// section for it.
+func chunkFields(c *Chunk) string {
+	var b strings.Builder
+	for _, list := range []struct {
+		label string
+		items []string
+	}{
+		{"Objectives", c.Objectives},
+		{"Resources", c.Resources},
+	} {
+		if len(list.items) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "**%s**:\n\n", list.label)
+		for _, item := range list.items {
+			fmt.Fprintf(&b, "- %s\n", item)
+		}
+		b.WriteString("\n")
+	}
+	if c.Deliverable != "" {
+		fmt.Fprintf(&b, "**Deliverable**: %s\n\n", c.Deliverable)
+	}
+	if len(c.Notes) > 0 {
+		b.WriteString("**Notes**:\n\n")
+		for _, note := range c.Notes {
+			fmt.Fprintf(&b, "- %s\n", note)
+		}
+	}
+	return b.String()
+}
+
+// withoutNotes drops the Notes list from a chunk body.
+func withoutNotes(body string) string {
+	var lines []string
+	inNotes := false
+	for _, line := range strings.Split(body, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case notesRegex.MatchString(trimmed):
+			inNotes = true
+			continue
+		case inNotes && (trimmed == "" || isListItem(trimmed)):
+			continue
+		}
+		inNotes = false
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// exportStatus names a status in prose.
+func exportStatus(status Status) string {
+	switch status {
+	case StatusNotStarted:
+		return "Not started"
+	case StatusInProgress:
+		return "In progress"
+	case StatusCompleted:
+		return "Completed"
+	case StatusSkipped:
+		return "Skipped"
+	case StatusArchived:
+		return "Archived"
+	}
+	return string(status)
+}
+
+// exportMinutes formats minutes as "45min", "2h", or "1h 30min".
+func exportMinutes(minutes int) string {
+	switch {
+	case minutes < 60:
+		return fmt.Sprintf("%dmin", minutes)
+	case minutes%60 == 0:
+		return fmt.Sprintf("%dh", minutes/60)
+	}
+	return fmt.Sprintf("%dh %dmin", minutes/60, minutes%60)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const exportPlanMarkdown = `---
+id: rust-async
+title: Rust Async
+created: 2026-10-01T00:00:00Z
+updated: 2026-10-01T00:00:00Z
+total_hours: 2.5
+status: in-progress
+tags: [rust]
+---
+
+# Rust Async
+
+**Goal**: Write a small executor
+
+## Chunk 1: Futures {#chunk-001}
+
+**Duration**: 1 hour
+**Status**: completed
+**Objectives**:
+- Poll a future by hand
+
+` + "```rust\nfut.poll(cx)\n```" + `
+
+**Deliverable**: A hand-rolled future
+
+**Notes**:
+- 2026-10-02: Waker finally clicked
+
+---
+
+## Chunk 2: Pinning {#chunk-002}
+
+**Duration**: 90 minutes
+**Status**: not-started
+`
+
+func TestExport(t *testing.T) {
+	p, err := Parse(exportPlanMarkdown)
+	require.NoError(t, err)
+
+	doc := Export(p, ExportOptions{
+		Markdown: exportPlanMarkdown,
+		Logged:   map[string]int{"chunk-001": 75},
+		Notes:    true,
+		Now:      time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC),
+	})
+
+	assert.NotContains(t, doc, "created:", "no frontmatter")
+	assert.NotContains(t, doc, "**Status**: completed", "status shows as an annotation")
+	assert.Contains(t, doc, "# Rust Async\n")
+	assert.Contains(t, doc, "- **Progress**: 1 of 2 chunks completed (50%) · 1h 15min of 2.5h logged")
+	assert.Contains(t, doc, "**Goal**: Write a small executor")
+	assert.Contains(t, doc, "## 1. Futures\n\n*Completed · 1h planned · 1h 15min logged*")
+	assert.Contains(t, doc, "fut.poll(cx)", "text the parser skips is kept")
+	assert.Contains(t, doc, "Waker finally clicked")
+	assert.Contains(t, doc, "## 2. Pinning\n\n*Not started · 1h 30min planned*\n")
+	assert.Contains(t, doc, "*Exported from samedi on October 16, 2026.*")
+
+	doc = Export(p, ExportOptions{Markdown: exportPlanMarkdown, Now: time.Now()})
+	assert.NotContains(t, doc, "Waker finally clicked", "notes are personal and left out by default")
+	assert.NotContains(t, doc, "**Notes**")
+	assert.Contains(t, doc, "**Deliverable**: A hand-rolled future")
+}
+
+func TestExport_ChunkMissingFromFile(t *testing.T) {
+	p := &Plan{
+		Title:      "Go",
+		TotalHours: 1,
+		Status:     StatusNotStarted,
+		Chunks: []Chunk{{
+			ID: "chunk-001", Title: "Syntax", Duration: 60, Status: StatusNotStarted,
+			Objectives: []string{"Write hello world"}, Deliverable: "main.go",
+		}},
+	}
+
+	doc := Export(p, ExportOptions{Now: time.Now()})
+	assert.Contains(t, doc, "**Objectives**:\n\n- Write hello world")
+	assert.Contains(t, doc, "**Deliverable**: main.go")
+}
+
+func TestService_Export(t *testing.T) {
+	service, _, _, cleanup := setupTestService(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	_, _, err := service.Import(ctx, exportPlanMarkdown, "")
+	require.NoError(t, err)
+
+	doc, err := service.Export(ctx, "rust-async", false)
+	require.NoError(t, err)
+	assert.Contains(t, doc, "## 1. Futures")
+
+	_, err = service.Export(ctx, "missing", false)
+	assert.Error(t, err)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"fmt"
+	"math"
+)
+
+// Sources of truth for a plan's total hours when it disagrees with the sum
+// of its chunk durations (config learning.total_hours_source).
+const (
+	// HoursSourceChunks recomputes total_hours from chunks whenever the plan is saved.
+	HoursSourceChunks = "chunks"
+	// HoursSourcePlan keeps total_hours as a fixed budget and only flags mismatches.
+	HoursSourcePlan = "plan"
+)
+
+// ChunkHours returns the sum of chunk durations in hours.
+func (p *Plan) ChunkHours() float64 {
+	return float64(p.TotalMinutes()) / 60.0
+}
+
+// HoursMismatch reports whether TotalHours disagrees with the chunk
+// durations by a minute or more. Plans without chunks never mismatch.
+func (p *Plan) HoursMismatch() bool {
+	if len(p.Chunks) == 0 {
+		return false
+	}
+	return int(math.Round(p.TotalHours*60)) != p.TotalMinutes()
+}
+
+// RecalcTotalHours sets TotalHours from the chunk durations, rounded to
+// hundredths of an hour. It reports whether the value changed.
+func (p *Plan) RecalcTotalHours() bool {
+	if !p.HoursMismatch() || p.TotalMinutes() <= 0 {
+		return false
+	}
+	p.TotalHours = math.Round(p.ChunkHours()*100) / 100
+	return true
+}
+
+// hoursMismatchWarning describes a total_hours mismatch for Check results.
+func hoursMismatchWarning(p *Plan) Warning {
+	chunkHours := math.Round(p.ChunkHours()*100) / 100
+	return Warning{Message: fmt.Sprintf("total_hours is %g but chunks add up to %gh", p.TotalHours, chunkHours)}
+}
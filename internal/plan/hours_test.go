@@ -0,0 +1,36 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlan_HoursMismatch(t *testing.T) {
+	p := &Plan{
+		TotalHours: 2,
+		Chunks: []Chunk{
+			{ID: "chunk-001", Duration: 60},
+			{ID: "chunk-002", Duration: 65},
+		},
+	}
+
+	assert.InDelta(t, 2.083, p.ChunkHours(), 0.001)
+	assert.True(t, p.HoursMismatch())
+
+	assert.True(t, p.RecalcTotalHours())
+	assert.Equal(t, 2.08, p.TotalHours)
+	assert.False(t, p.HoursMismatch(), "rounded hours still match to the minute")
+	assert.False(t, p.RecalcTotalHours())
+}
+
+func TestPlan_HoursMismatch_NoChunks(t *testing.T) {
+	p := &Plan{TotalHours: 10}
+
+	assert.False(t, p.HoursMismatch())
+	assert.False(t, p.RecalcTotalHours())
+	assert.Equal(t, 10.0, p.TotalHours)
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/llm"
+)
+
+// Import adds a plan written outside samedi. content must be in samedi's
+// plan format; what the format leaves out is filled in: the ID (id when
+// given, else the frontmatter's, else one from the title, numbered when
+// taken), the dates, the status, and total_hours from the chunks. The
+// plan is saved in canonical form, so text the parser skipped is dropped;
+// the warnings say where.
+func (s *Service) Import(ctx context.Context, content, id string) (*Plan, []Warning, error) {
+	plan, warnings, err := ParseWithWarnings(strings.ReplaceAll(content, "\r\n", "\n"))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(plan.Chunks) == 0 {
+		return nil, warnings, fmt.Errorf("no chunks found (expected \"## Chunk N: Title {#chunk-NNN}\" sections)")
+	}
+
+	plan.Title = strings.TrimSpace(plan.Title)
+	if plan.Title == "" {
+		return nil, warnings, fmt.Errorf("plan title cannot be empty (set title: in the frontmatter)")
+	}
+	if id == "" {
+		id = plan.ID
+	}
+	if id == "" {
+		id = plan.Title
+	}
+	plan.ID = s.uniquePlanID(ctx, slugify(id))
+
+	now := time.Now()
+	if plan.CreatedAt.IsZero() {
+		plan.CreatedAt = now
+	}
+	if plan.UpdatedAt.Before(plan.CreatedAt) {
+		plan.UpdatedAt = plan.CreatedAt
+	}
+	if plan.TotalHours <= 0 {
+		plan.TotalHours = float64(plan.TotalMinutes()) / 60
+	}
+	if plan.Status == "" {
+		plan.Status = s.inferPlanStatus(plan)
+	}
+	plan.Tags = dedupeTags(plan.Tags)
+
+	if err := plan.Validate(); err != nil {
+		return nil, warnings, fmt.Errorf("invalid plan: %w", err)
+	}
+	if err := s.checkSubPlans(ctx, plan); err != nil {
+		return nil, warnings, err
+	}
+
+	if err := s.store(ctx, plan); err != nil {
+		return nil, warnings, err
+	}
+	return plan, warnings, nil
+}
+
+// convertPrompt asks the LLM to turn a syllabus or outline into a plan.
+// It gets the plan ID, title, hours, timestamp, and the outline.
+const convertPrompt = `Convert the course syllabus or outline below into a learning plan.
+
+Keep the outline's order, topics, readings, and assignments; don't invent a
+different curriculum. Split or merge its units into chunks of 30-90 minutes.
+%s
+
+Output only markdown in exactly this format, with no commentary or code fences:
+
+---
+id: %s
+title: %s
+created: %s
+updated: %s
+total_hours: [sum of the chunk durations in hours]
+status: not-started
+tags: []
+---
+
+# %s
+
+## Chunk 1: [Title] {#chunk-001}
+
+**Duration**: [X hours or X minutes]
+**Status**: not-started
+**Objectives**:
+
+- [What the learner will be able to do]
+
+**Resources**:
+
+- [Readings, videos, or links from the outline]
+
+**Deliverable**: [The assignment or exercise that shows the chunk is done]
+
+---
+
+[One "## Chunk N: Title {#chunk-NNN}" section per chunk, numbered 001, 002, ...]
+
+---BEGIN OUTLINE---
+%s
+---END OUTLINE---
+`
+
+// ConvertRequest contains parameters for importing a syllabus or outline
+// that isn't in samedi's plan format.
+type ConvertRequest struct {
+	Outline    string
+	Title      string
+	TotalHours float64 // Optional; the LLM sizes the plan from the outline when zero
+	Debug      bool    // If true, log full prompt and response
+}
+
+// Convert imports a syllabus, course outline, or other free-form text by
+// having the LLM rewrite it in samedi's plan format. Replies that don't
+// parse are sent back for repair, as with Create.
+func (s *Service) Convert(ctx context.Context, req ConvertRequest) (*Plan, error) {
+	if strings.TrimSpace(req.Outline) == "" {
+		return nil, fmt.Errorf("outline is empty")
+	}
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		return nil, fmt.Errorf("title cannot be empty")
+	}
+	if req.TotalHours < 0 || req.TotalHours > 1000 {
+		return nil, fmt.Errorf("total hours must be between 0 and 1000, got %.1f", req.TotalHours)
+	}
+
+	planID := s.uniquePlanID(ctx, slugify(title))
+
+	sizing := "Size each chunk by the outline's workload."
+	if req.TotalHours > 0 {
+		sizing = fmt.Sprintf("The chunks should add up to about %g hours.", req.TotalHours)
+	}
+	now := time.Now().Format(time.RFC3339)
+	prompt := fmt.Sprintf(convertPrompt, sizing, planID, title, now, now, title, strings.TrimSpace(req.Outline))
+
+	if req.Debug {
+		fmt.Fprintf(os.Stderr, "\n→ DEBUG: Prompt sent to LLM (%d chars):\n", len(prompt))
+		fmt.Fprintf(os.Stderr, "---BEGIN PROMPT---\n%s\n---END PROMPT---\n\n", prompt)
+	}
+
+	plan, err := s.generate(ctx, llm.OperationPlanImport, CreateRequest{Topic: title, Debug: req.Debug}, planID, prompt)
+	if err != nil {
+		return nil, err
+	}
+
+	provenance := s.generator
+	plan.Provenance = &provenance
+
+	if err := s.store(ctx, plan); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const handwrittenPlan = `---
+title: Rust Async
+tags: [rust, rust]
+---
+
+# Rust Async
+
+## Chunk 1: Futures {#chunk-001}
+
+**Duration**: 1 hour
+**Objectives**:
+- Poll a future by hand
+
+Skim the RFC first.
+
+## Chunk 2: Pinning {#chunk-002}
+
+**Duration**: 30 minutes
+**Status**: completed
+`
+
+func TestService_Import(t *testing.T) {
+	service, mockLLM, paths, cleanup := setupTestService(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	plan, warnings, err := service.Import(ctx, handwrittenPlan, "")
+	require.NoError(t, err)
+	assert.Equal(t, "rust-async", plan.ID, "ID from the title")
+	assert.Equal(t, 1.5, plan.TotalHours, "hours from the chunks")
+	assert.Equal(t, StatusInProgress, plan.Status)
+	assert.Equal(t, []string{"rust"}, plan.Tags)
+	assert.False(t, plan.CreatedAt.IsZero())
+	require.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0].String(), "text in chunk-001 ignored")
+	assert.Empty(t, mockLLM.Calls)
+
+	assert.FileExists(t, paths.PlanPath("rust-async"))
+	record, err := service.GetMetadata(ctx, "rust-async")
+	require.NoError(t, err)
+	assert.Equal(t, "Rust Async", record.Title)
+
+	again, _, err := service.Import(ctx, handwrittenPlan, "")
+	require.NoError(t, err)
+	assert.Equal(t, "rust-async-2", again.ID, "a taken ID is numbered")
+
+	named, _, err := service.Import(ctx, validPlanMarkdown, "Team Plan")
+	require.NoError(t, err)
+	assert.Equal(t, "team-plan", named.ID)
+	assert.Equal(t, 10.0, named.TotalHours, "total_hours kept when given")
+}
+
+func TestService_Import_Invalid(t *testing.T) {
+	service, _, _, cleanup := setupTestService(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	_, _, err := service.Import(ctx, "# Syllabus\n\n- Week 1: Futures\n", "")
+	assert.ErrorContains(t, err, "frontmatter")
+
+	_, _, err = service.Import(ctx, "---\ntitle: Empty\n---\n\n# Empty\n", "")
+	assert.ErrorContains(t, err, "no chunks found")
+
+	_, _, err = service.Import(ctx, "---\nid: x\n---\n\n## Chunk 1: A {#chunk-001}\n\n**Duration**: 1 hour\n", "")
+	assert.ErrorContains(t, err, "title cannot be empty")
+}
+
+func TestService_Convert(t *testing.T) {
+	service, mockLLM, _, cleanup := setupTestService(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	mockLLM.CallFunc = func(_ context.Context, _ string) (string, error) {
+		return validPlanMarkdown, nil
+	}
+
+	plan, err := service.Convert(ctx, ConvertRequest{
+		Outline:    "Week 1: Testing basics\nWeek 2: Mocks",
+		Title:      "Testing Course",
+		TotalHours: 12,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "testing-course", plan.ID)
+	require.NotNil(t, plan.Provenance)
+
+	require.Len(t, mockLLM.Calls, 1)
+	assert.Contains(t, mockLLM.Calls[0], "Week 2: Mocks")
+	assert.Contains(t, mockLLM.Calls[0], "id: testing-course")
+	assert.Contains(t, mockLLM.Calls[0], "about 12 hours")
+
+	_, err = service.Convert(ctx, ConvertRequest{Outline: "  ", Title: "Empty"})
+	assert.ErrorContains(t, err, "outline is empty")
+}
@@ -28,40 +28,79 @@ var (
 	deliverableRegex = regexp.MustCompile(`^\*\*Deliverable\*\*:\s*(.+)$`)
 	objectivesRegex  = regexp.MustCompile(`^\*\*Objectives\*\*:\s*$`)
 	resourcesRegex   = regexp.MustCompile(`^\*\*Resources\*\*:\s*$`)
+	notesRegex       = regexp.MustCompile(`^\*\*Notes\*\*:\s*$`)
+
+	// looseChunkHeaderRegex matches headings meant as chunks that chunkHeaderRegex rejects
+	looseChunkHeaderRegex = regexp.MustCompile(`(?i)^#{2,}\s*Chunk\b`)
+	fieldRegex            = regexp.MustCompile(`^\*\*([^*]+)\*\*:`)
 )
 
+// Warning describes plan content the parser skipped. Warnings don't stop a
+// plan from loading, but the skipped content is lost the next time the
+// plan is saved, so callers surface them to the user.
+type Warning struct {
+	Line    int    `json:"line"` // 1-based line number in the file, 0 for whole-plan warnings
+	Message string `json:"message"`
+}
+
+// String formats the warning as "line N: message", or just the message
+// for warnings not tied to a line.
+func (w Warning) String() string {
+	if w.Line == 0 {
+		return w.Message
+	}
+	return fmt.Sprintf("line %d: %s", w.Line, w.Message)
+}
+
 // ParseFile reads a plan markdown file and returns a Plan struct.
 func ParseFile(path string) (*Plan, error) {
-	content, err := os.ReadFile(path)
+	plan, _, err := ParseFileWithWarnings(path)
+	return plan, err
+}
+
+// ParseFileWithWarnings reads a plan markdown file and also reports
+// content that was skipped while parsing.
+func ParseFileWithWarnings(path string) (*Plan, []Warning, error) {
+	content, err := os.ReadFile(path) // #nosec G304 - plan paths come from the data directory
 	if err != nil {
-		return nil, fmt.Errorf("failed to read file: %w", err)
+		return nil, nil, fmt.Errorf("failed to read file: %w", err)
 	}
 
-	return Parse(string(content))
+	return ParseWithWarnings(string(content))
 }
 
 // Parse parses markdown content with YAML frontmatter into a Plan struct.
 func Parse(content string) (*Plan, error) {
+	plan, _, err := ParseWithWarnings(content)
+	return plan, err
+}
+
+// ParseWithWarnings parses a plan like Parse and also returns warnings for
+// malformed chunk headers, unparseable values, and lines that were ignored.
+func ParseWithWarnings(content string) (*Plan, []Warning, error) {
 	// Split frontmatter and body
 	frontmatter, body, err := splitFrontmatter(content)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Parse frontmatter into Plan
 	var plan Plan
 	if err := yaml.Unmarshal([]byte(frontmatter), &plan); err != nil {
-		return nil, fmt.Errorf("failed to parse frontmatter: %w", err)
+		return nil, nil, fmt.Errorf("failed to parse frontmatter: %w", err)
 	}
 
+	// Body starts after both delimiters and the frontmatter lines
+	firstLine := strings.Count(content, "\n") - strings.Count(body, "\n") + 1
+
 	// Parse chunks from body
-	chunks, err := parseChunks(body)
+	chunks, warnings, err := parseChunks(body, firstLine)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse chunks: %w", err)
+		return nil, nil, fmt.Errorf("failed to parse chunks: %w", err)
 	}
 	plan.Chunks = chunks
 
-	return &plan, nil
+	return &plan, warnings, nil
 }
 
 // splitFrontmatter separates YAML frontmatter from markdown body.
@@ -93,106 +132,202 @@ func splitFrontmatter(content string) (string, string, error) {
 	return frontmatter, body, nil
 }
 
-// parseChunks extracts chunk information from markdown body.
-func parseChunks(body string) ([]Chunk, error) {
-	var chunks []Chunk
-	var currentChunk *Chunk
-	var inObjectives, inResources bool
+// listSection is the chunk list a list item belongs to.
+type listSection int
+
+const (
+	noList listSection = iota
+	objectivesList
+	resourcesList
+	notesList
+)
+
+// chunkParser accumulates chunks and warnings while scanning the body.
+type chunkParser struct {
+	chunks   []Chunk
+	warnings []Warning
+	seen     map[string]bool
+
+	current  *Chunk
+	skipping bool // inside a section whose header could not be parsed
+	list     listSection
+}
+
+// parseChunks extracts chunk information from markdown body. firstLine is
+// the file line number of the body's first line, used in warnings.
+func parseChunks(body string, firstLine int) ([]Chunk, []Warning, error) {
+	p := &chunkParser{seen: make(map[string]bool)}
 
+	lineNum := firstLine - 1
 	scanner := bufio.NewScanner(strings.NewReader(body))
 	for scanner.Scan() {
-		line := scanner.Text()
-		trimmed := strings.TrimSpace(line)
+		lineNum++
+		p.parseLine(strings.TrimSpace(scanner.Text()), lineNum)
+	}
 
-		// Check for chunk header
-		if matches := chunkHeaderRegex.FindStringSubmatch(trimmed); matches != nil {
-			if currentChunk != nil {
-				chunks = append(chunks, *currentChunk)
-			}
-			currentChunk = &Chunk{
-				ID:     matches[2],
-				Title:  matches[1],
-				Status: StatusNotStarted,
-			}
-			inObjectives, inResources = false, false
-			continue
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("error reading chunks: %w", err)
+	}
+
+	p.flush()
+	return p.chunks, p.warnings, nil
+}
+
+func (p *chunkParser) warn(line int, format string, args ...interface{}) {
+	p.warnings = append(p.warnings, Warning{Line: line, Message: fmt.Sprintf(format, args...)})
+}
+
+// flush appends the chunk being parsed, if any.
+func (p *chunkParser) flush() {
+	if p.current != nil {
+		p.chunks = append(p.chunks, *p.current)
+		p.current = nil
+	}
+}
+
+func (p *chunkParser) parseLine(trimmed string, line int) {
+	// Check for chunk header
+	if matches := chunkHeaderRegex.FindStringSubmatch(trimmed); matches != nil {
+		p.flush()
+		p.skipping = false
+		p.list = noList
+
+		id := matches[2]
+		if p.seen[id] {
+			p.warn(line, "duplicate chunk ID %q", id)
 		}
+		p.seen[id] = true
 
-		if currentChunk == nil {
-			continue
+		p.current = &Chunk{
+			ID:     id,
+			Title:  matches[1],
+			Status: StatusNotStarted,
 		}
+		return
+	}
+
+	// A heading that looks like a chunk but doesn't parse starts a skipped section
+	if looseChunkHeaderRegex.MatchString(trimmed) {
+		p.flush()
+		p.skipping = true
+		p.warn(line, "malformed chunk header %q (expected \"## Chunk N: Title {#id}\"); section skipped", trimmed)
+		return
+	}
+
+	if p.current == nil || p.skipping {
+		return
+	}
 
-		// Parse chunk metadata and update section flags
-		parsed, newInObjectives, newInResources := parseChunkLine(trimmed, currentChunk, inObjectives, inResources)
-		if parsed {
-			inObjectives, inResources = newInObjectives, newInResources
+	// Parse chunk metadata and update section flags
+	parsed, list := parseChunkLine(trimmed, p.current, p.list)
+	if parsed {
+		p.list = list
+	}
+
+	p.checkLine(trimmed, line, parsed)
+}
+
+// checkLine reports values parseChunkLine could not use and lines it dropped.
+func (p *chunkParser) checkLine(trimmed string, line int, parsed bool) {
+	chunk := p.current
+
+	if matches := durationRegex.FindStringSubmatch(trimmed); matches != nil {
+		if _, err := parseDuration(matches[1]); err != nil {
+			p.warn(line, "invalid duration %q in %s: %v", strings.TrimSpace(matches[1]), chunk.ID, err)
 		}
+		return
 	}
 
-	if currentChunk != nil {
-		chunks = append(chunks, *currentChunk)
+	if statusRegex.MatchString(trimmed) {
+		if !chunk.Status.IsValid() {
+			p.warn(line, "invalid status %q in %s", chunk.Status, chunk.ID)
+		}
+		return
 	}
 
-	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("error reading chunks: %w", err)
+	if deliverableRegex.MatchString(trimmed) || objectivesRegex.MatchString(trimmed) ||
+		resourcesRegex.MatchString(trimmed) || notesRegex.MatchString(trimmed) {
+		return
 	}
 
-	return chunks, nil
+	if matches := fieldRegex.FindStringSubmatch(trimmed); matches != nil {
+		switch matches[1] {
+		case "Objectives", "Resources", "Notes":
+			p.warn(line, "%s must be followed by a list; inline text in %s ignored", matches[1], chunk.ID)
+		default:
+			p.warn(line, "unknown field %q in %s ignored", matches[1], chunk.ID)
+		}
+		return
+	}
+
+	if isListItem(trimmed) {
+		if extractListItem(trimmed) != "" && p.list == noList {
+			p.warn(line, "list item outside Objectives/Resources/Notes in %s ignored", chunk.ID)
+		}
+		return
+	}
+
+	if parsed && trimmed != frontmatterDelimiter && !strings.HasPrefix(trimmed, "#") {
+		p.warn(line, "text in %s ignored", chunk.ID)
+	}
 }
 
 // parseChunkLine processes a single line of chunk content.
-// Returns whether the line was processed and updated section flags.
-func parseChunkLine(trimmed string, chunk *Chunk, inObjectives, inResources bool) (bool, bool, bool) {
+// Returns whether the line was processed and the list that follows it.
+func parseChunkLine(trimmed string, chunk *Chunk, list listSection) (bool, listSection) {
 	// Parse duration
 	if matches := durationRegex.FindStringSubmatch(trimmed); matches != nil {
 		duration, err := parseDuration(matches[1])
 		if err == nil {
 			chunk.Duration = duration
 		}
-		return true, false, false
+		return true, noList
 	}
 
 	// Parse status
 	if matches := statusRegex.FindStringSubmatch(trimmed); matches != nil {
 		chunk.Status = Status(strings.TrimSpace(matches[1]))
-		return true, false, false
+		return true, noList
 	}
 
 	// Parse deliverable
 	if matches := deliverableRegex.FindStringSubmatch(trimmed); matches != nil {
 		chunk.Deliverable = strings.TrimSpace(matches[1])
-		return true, false, false
-	}
-
-	// Check for objectives section
-	if objectivesRegex.MatchString(trimmed) {
-		return true, true, false
+		return true, noList
 	}
 
-	// Check for resources section
-	if resourcesRegex.MatchString(trimmed) {
-		return true, false, true
+	// Check for the list sections
+	switch {
+	case objectivesRegex.MatchString(trimmed):
+		return true, objectivesList
+	case resourcesRegex.MatchString(trimmed):
+		return true, resourcesList
+	case notesRegex.MatchString(trimmed):
+		return true, notesList
 	}
 
 	// Parse list items
 	if isListItem(trimmed) {
 		item := extractListItem(trimmed)
 		if item != "" {
-			if inObjectives {
+			switch list {
+			case objectivesList:
 				chunk.Objectives = append(chunk.Objectives, item)
-			} else if inResources {
-				chunk.Resources = append(chunk.Resources, item)
+			case resourcesList:
+				chunk.Resources = append(chunk.Resources, ParseResource(item).String())
+			case notesList:
+				chunk.Notes = append(chunk.Notes, item)
 			}
 		}
-		return true, inObjectives, inResources
+		return true, list
 	}
 
 	// End of list sections on non-list, non-empty line
-	if trimmed != "" && !isListItem(trimmed) {
-		return true, false, false
+	if trimmed != "" {
+		return true, noList
 	}
 
-	return false, inObjectives, inResources
+	return false, list
 }
 
 // isListItem checks if a line is a markdown list item.
@@ -300,6 +435,15 @@ func Format(plan *Plan) (string, error) {
 		if chunk.Deliverable != "" {
 			buf.WriteString(fmt.Sprintf("**Deliverable**: %s\n\n", chunk.Deliverable))
 		}
+
+		// Notes gathered from sessions
+		if len(chunk.Notes) > 0 {
+			buf.WriteString("**Notes**:\n")
+			for _, note := range chunk.Notes {
+				buf.WriteString(fmt.Sprintf("- %s\n", note))
+			}
+			buf.WriteString("\n")
+		}
 	}
 
 	return buf.String(), nil
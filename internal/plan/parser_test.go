@@ -90,6 +90,70 @@ func TestParse_MalformedChunk(t *testing.T) {
 	}
 }
 
+func TestParseFileWithWarnings_MalformedChunk(t *testing.T) {
+	plan, warnings, err := ParseFileWithWarnings("testdata/malformed_chunk.md")
+	require.NoError(t, err)
+
+	assert.Empty(t, plan.Chunks)
+	require.Len(t, warnings, 1)
+	assert.Equal(t, 12, warnings[0].Line)
+	assert.Contains(t, warnings[0].Message, "malformed chunk header")
+	assert.Contains(t, warnings[0].Message, "section skipped")
+}
+
+func TestParseFileWithWarnings_ValidPlanHasNoWarnings(t *testing.T) {
+	_, warnings, err := ParseFileWithWarnings("testdata/valid_plan.md")
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+}
+
+func TestParseWithWarnings_ReportsSkippedContent(t *testing.T) {
+	content := `---
+id: test
+title: Test
+---
+
+# Test
+
+## Chunk 1: First {#chunk-001}
+**Duration**: forever
+**Status**: someday
+**Difficulty**: hard
+- stray item
+Some prose notes.
+
+## Chunk 2 Missing Colon {#chunk-002}
+**Duration**: 1 hour
+**Status**: completed
+
+## Chunk 3: Dup {#chunk-001}
+**Duration**: 1 hour
+**Objectives**: inline
+`
+
+	plan, warnings, err := ParseWithWarnings(content)
+	require.NoError(t, err)
+
+	// Chunk 2 is skipped and must not overwrite chunk 1's fields
+	require.Len(t, plan.Chunks, 2)
+	assert.Equal(t, Status("someday"), plan.Chunks[0].Status)
+
+	messages := make([]string, 0, len(warnings))
+	for _, w := range warnings {
+		messages = append(messages, w.String())
+	}
+	assert.Equal(t, []string{
+		`line 9: invalid duration "forever" in chunk-001: invalid format: expected '<number> <unit>'`,
+		`line 10: invalid status "someday" in chunk-001`,
+		`line 11: unknown field "Difficulty" in chunk-001 ignored`,
+		`line 12: list item outside Objectives/Resources/Notes in chunk-001 ignored`,
+		`line 13: text in chunk-001 ignored`,
+		`line 15: malformed chunk header "## Chunk 2 Missing Colon {#chunk-002}" (expected "## Chunk N: Title {#id}"); section skipped`,
+		`line 19: duplicate chunk ID "chunk-001"`,
+		`line 21: Objectives must be followed by a list; inline text in chunk-001 ignored`,
+	}, messages)
+}
+
 func TestSplitFrontmatter_Valid(t *testing.T) {
 	content := `---
 id: test
@@ -270,6 +334,62 @@ func TestFormat_RoundTrip(t *testing.T) {
 	assert.Equal(t, original.Chunks[0].Status, parsed.Chunks[0].Status)
 }
 
+func TestFormat_RoundTrip_Provenance(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	original := &Plan{
+		ID:         "test-provenance",
+		Title:      "Provenance Test",
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		TotalHours: 1.0,
+		Status:     StatusNotStarted,
+		Provenance: &Provenance{Provider: "ollama", Model: "llama3.1", TemplateVersion: "sha256:0123456789ab"},
+	}
+
+	markdown, err := Format(original)
+	require.NoError(t, err)
+	assert.Contains(t, markdown, "generated_by:")
+	assert.Contains(t, markdown, "template_version: sha256:0123456789ab")
+
+	parsed, err := Parse(markdown)
+	require.NoError(t, err)
+	assert.Equal(t, original.Provenance, parsed.Provenance)
+}
+
+func TestParse_ResourceCheckboxes(t *testing.T) {
+	content := `---
+id: checkboxes
+title: Checkboxes
+created: 2024-01-15T10:00:00Z
+updated: 2024-01-15T10:00:00Z
+total_hours: 1
+status: in-progress
+---
+
+## Chunk 1: Reading {#chunk-001}
+**Duration**: 1 hour
+**Status**: in-progress
+**Resources**:
+- [x] Book chapter 1
+- [X] Book chapter 2
+- [ ] Video lecture
+- Reference docs
+`
+
+	parsed, err := Parse(content)
+	require.NoError(t, err)
+	require.Len(t, parsed.Chunks, 1)
+	assert.Equal(t, []string{"[x] Book chapter 1", "[x] Book chapter 2", "[ ] Video lecture", "Reference docs"},
+		parsed.Chunks[0].Resources)
+
+	markdown, err := Format(parsed)
+	require.NoError(t, err)
+	assert.Contains(t, markdown, "- [x] Book chapter 1\n")
+	assert.Contains(t, markdown, "- [ ] Video lecture\n")
+	assert.Contains(t, markdown, "- Reference docs\n")
+}
+
 func TestFormat_RoundTrip_SubHourChunks(t *testing.T) {
 	// Test round-trip with sub-hour durations (regression test for hourss bug)
 	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
@@ -343,8 +463,9 @@ func TestParseChunks_VariousFormats(t *testing.T) {
 **Status**: completed
 `
 
-	chunks, err := parseChunks(body)
+	chunks, warnings, err := parseChunks(body, 1)
 	require.NoError(t, err)
+	assert.Empty(t, warnings)
 	require.Len(t, chunks, 2)
 
 	assert.Equal(t, "chunk-001", chunks[0].ID)
@@ -19,6 +19,42 @@ type Plan struct {
 	Status     Status    `json:"status" yaml:"status"`
 	Tags       []string  `json:"tags,omitempty" yaml:"tags,omitempty"`
 	Chunks     []Chunk   `json:"chunks" yaml:"-"`
+
+	// Children lists the IDs of sub-plans this plan is made of, such as
+	// "go-deep-dive" under "backend-engineer". Their progress rolls up into
+	// this plan's stats.
+	Children []string `json:"children,omitempty" yaml:"children,omitempty"`
+
+	// Deadline is the optional date the learner wants to finish by. Stats
+	// show the days left and the weekly pace needed to make it.
+	Deadline *Date `json:"deadline,omitempty" yaml:"deadline,omitempty"`
+
+	// Provenance records what generated the plan. Nil for plans written
+	// by hand or scaffolded without an LLM.
+	Provenance *Provenance `json:"provenance,omitempty" yaml:"generated_by,omitempty"`
+}
+
+// Provenance identifies the provider, model and prompt template that
+// generated a piece of content, for debugging bad generations and
+// reproducing good ones.
+type Provenance struct {
+	Provider        string `json:"provider" yaml:"provider"`
+	Model           string `json:"model,omitempty" yaml:"model,omitempty"`
+	TemplateVersion string `json:"template_version,omitempty" yaml:"template_version,omitempty"`
+	Style           string `json:"style,omitempty" yaml:"style,omitempty"`               // Style asked for at generation
+	Instructions    string `json:"instructions,omitempty" yaml:"instructions,omitempty"` // Extra prompt instructions
+}
+
+// String formats provenance as "provider/model (template version)".
+func (p Provenance) String() string {
+	s := p.Provider
+	if p.Model != "" {
+		s += "/" + p.Model
+	}
+	if p.TemplateVersion != "" {
+		s += fmt.Sprintf(" (template %s)", p.TemplateVersion)
+	}
+	return s
 }
 
 // Chunk represents a single learning session within a plan.
@@ -31,6 +67,10 @@ type Chunk struct {
 	Objectives  []string `json:"objectives,omitempty" yaml:"objectives,omitempty"`
 	Resources   []string `json:"resources,omitempty" yaml:"resources,omitempty"`
 	Deliverable string   `json:"deliverable,omitempty" yaml:"deliverable,omitempty"`
+
+	// Notes accumulates the notes of sessions spent on the chunk, each
+	// "YYYY-MM-DD: text", oldest first.
+	Notes []string `json:"notes,omitempty" yaml:"notes,omitempty"`
 }
 
 // Status represents the current state of a plan or chunk.
@@ -87,6 +127,22 @@ func (p *Plan) Validate() error {
 		return fmt.Errorf("updated_at cannot be before created_at")
 	}
 
+	// Validate sub-plan references; cycles across plans are checked by the
+	// service, which can see the other plans
+	childIDs := make(map[string]bool)
+	for _, child := range p.Children {
+		if child == "" {
+			return fmt.Errorf("sub-plan ID cannot be empty")
+		}
+		if child == p.ID {
+			return fmt.Errorf("plan cannot be its own sub-plan")
+		}
+		if childIDs[child] {
+			return fmt.Errorf("duplicate sub-plan: %s", child)
+		}
+		childIDs[child] = true
+	}
+
 	// Validate chunks
 	chunkIDs := make(map[string]bool)
 	for i, chunk := range p.Chunks {
@@ -128,14 +184,18 @@ func (p *Plan) Progress() float64 {
 		return 0.0
 	}
 
+	return float64(p.CompletedChunks()) / float64(len(p.Chunks))
+}
+
+// CompletedChunks returns how many chunks are completed.
+func (p *Plan) CompletedChunks() int {
 	completed := 0
 	for _, chunk := range p.Chunks {
 		if chunk.Status == StatusCompleted {
 			completed++
 		}
 	}
-
-	return float64(completed) / float64(len(p.Chunks))
+	return completed
 }
 
 // ProgressPercent returns the completion percentage as an integer (0-100).
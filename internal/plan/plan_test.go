@@ -465,3 +465,9 @@ func TestPlan_NextChunk(t *testing.T) {
 func strPtr(s string) *string {
 	return &s
 }
+
+func TestProvenance_String(t *testing.T) {
+	assert.Equal(t, "mock", Provenance{Provider: "mock"}.String())
+	assert.Equal(t, "claude/sonnet (template sha256:0123456789ab)",
+		Provenance{Provider: "claude", Model: "sonnet", TemplateVersion: "sha256:0123456789ab"}.String())
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ReadingItem is one resource of a plan's reading list. A resource listed
+// by several chunks appears once.
+type ReadingItem struct {
+	Key      string   `json:"key"` // The URL, or the normalized text of resources without one
+	Text     string   `json:"text"`
+	URL      string   `json:"url,omitempty"`
+	ChunkIDs []string `json:"chunk_ids"` // Chunks listing the resource, in plan order
+	Done     bool     `json:"done"`
+}
+
+// resourceKey identifies a resource across chunks: by its URL when it has
+// one, so differently worded links to one page merge, and otherwise by its
+// text, ignoring case and spacing.
+func resourceKey(r Resource) string {
+	if url := r.URL(); url != "" {
+		return strings.TrimSuffix(url, "/")
+	}
+	return strings.ToLower(strings.Join(strings.Fields(r.Text), " "))
+}
+
+// ReadingList gathers the resources of p's chunks in order of first
+// appearance. A resource is done when any chunk listing it has it checked
+// off.
+func ReadingList(p *Plan) []ReadingItem {
+	items := make([]ReadingItem, 0)
+	positions := make(map[string]int)
+	for _, chunk := range p.Chunks {
+		for _, res := range chunk.ParsedResources() {
+			key := resourceKey(res)
+			if key == "" {
+				continue
+			}
+			i, ok := positions[key]
+			if !ok {
+				i = len(items)
+				positions[key] = i
+				items = append(items, ReadingItem{Key: key, Text: res.Text, URL: res.URL()})
+			}
+			item := &items[i]
+			if !slices.Contains(item.ChunkIDs, chunk.ID) {
+				item.ChunkIDs = append(item.ChunkIDs, chunk.ID)
+			}
+			item.Done = item.Done || res.Done
+		}
+	}
+	return items
+}
+
+// ReadingProgress counts the done items of the plan's reading list.
+func (p *Plan) ReadingProgress() (done, total int) {
+	items := ReadingList(p)
+	for _, item := range items {
+		if item.Done {
+			done++
+		}
+	}
+	return done, len(items)
+}
+
+// SaveResources replaces the indexed reading list of a plan with items.
+func (r *SQLiteRepository) SaveResources(ctx context.Context, planID string, items []ReadingItem) error {
+	tx, err := r.db.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		// Ignore error on rollback - it's expected if commit succeeded
+		//nolint:errcheck // Transaction cleanup
+		tx.Rollback()
+	}()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM resources WHERE plan_id = ?", planID); err != nil {
+		return fmt.Errorf("failed to clear resources: %w", err)
+	}
+	for i, item := range items {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO resources (plan_id, key, position, text, url, chunk_ids, done)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+		`, planID, item.Key, i, item.Text, item.URL, strings.Join(item.ChunkIDs, ","), item.Done)
+		if err != nil {
+			return fmt.Errorf("failed to index resource %q: %w", item.Text, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// ListResources returns the indexed reading list of a plan, in order.
+func (r *SQLiteRepository) ListResources(ctx context.Context, planID string) ([]ReadingItem, error) {
+	rows, err := r.db.DB().QueryContext(ctx, `
+		SELECT key, text, url, chunk_ids, done
+		FROM resources
+		WHERE plan_id = ?
+		ORDER BY position
+	`, planID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resources: %w", err)
+	}
+	defer rows.Close()
+
+	items := make([]ReadingItem, 0)
+	for rows.Next() {
+		var (
+			item     ReadingItem
+			chunkIDs string
+		)
+		if err := rows.Scan(&item.Key, &item.Text, &item.URL, &chunkIDs, &item.Done); err != nil {
+			return nil, fmt.Errorf("failed to scan resource: %w", err)
+		}
+		item.ChunkIDs = strings.Split(chunkIDs, ",")
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating resource rows: %w", err)
+	}
+	return items, nil
+}
+
+// resourcesIndexed reports whether indexed holds exactly items, in order.
+func resourcesIndexed(indexed, items []ReadingItem) bool {
+	return slices.EqualFunc(indexed, items, func(a, b ReadingItem) bool {
+		return a.Key == b.Key && a.Text == b.Text && a.URL == b.URL && a.Done == b.Done &&
+			slices.Equal(a.ChunkIDs, b.ChunkIDs)
+	})
+}
+
+// ReadingList returns the reading list of a plan from the index. A plan
+// indexed before reading lists existed is indexed on the way.
+func (s *Service) ReadingList(ctx context.Context, planID string) ([]ReadingItem, error) {
+	items, err := s.sqliteRepo.ListResources(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) > 0 {
+		return items, nil
+	}
+
+	plan, err := s.Get(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+	items = ReadingList(plan)
+	if len(items) > 0 {
+		if err := s.sqliteRepo.SaveResources(ctx, planID, items); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// SetResourceDone marks the reading list item at number (1-based) done or
+// not done. Every chunk listing the resource gets its checkbox set, so the
+// state is kept in the plan file and indexed from there.
+func (s *Service) SetResourceDone(ctx context.Context, planID string, number int, done bool) (*ReadingItem, error) {
+	plan, err := s.Get(ctx, planID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load plan: %w", err)
+	}
+
+	items := ReadingList(plan)
+	if number < 1 || number > len(items) {
+		return nil, fmt.Errorf("resource number must be between 1 and %d, got %d", len(items), number)
+	}
+	item := items[number-1]
+
+	for i := range plan.Chunks {
+		chunk := &plan.Chunks[i]
+		for j, raw := range chunk.Resources {
+			res := ParseResource(raw)
+			if resourceKey(res) != item.Key || (!done && !res.Checkbox) {
+				continue
+			}
+			res.Checkbox, res.Done = true, done
+			chunk.Resources[j] = res.String()
+		}
+	}
+
+	if err := s.Update(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to update plan: %w", err)
+	}
+	item.Done = done
+	return &item, nil
+}
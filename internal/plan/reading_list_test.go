@@ -0,0 +1,147 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadingList(t *testing.T) {
+	p := &Plan{Chunks: []Chunk{
+		{ID: "chunk-001", Resources: []string{
+			"[The Rust Book](https://doc.rust-lang.org/book/)",
+			"[ ] Async  Book",
+		}},
+		{ID: "chunk-002", Resources: []string{
+			"[x] Rust Book ch. 16 - https://doc.rust-lang.org/book",
+			"async book",
+			"Tokio tutorial",
+		}},
+	}}
+
+	items := ReadingList(p)
+	require.Len(t, items, 3)
+
+	assert.Equal(t, ReadingItem{
+		Key:      "https://doc.rust-lang.org/book",
+		Text:     "[The Rust Book](https://doc.rust-lang.org/book/)",
+		URL:      "https://doc.rust-lang.org/book/",
+		ChunkIDs: []string{"chunk-001", "chunk-002"},
+		Done:     true,
+	}, items[0], "links to one page merge, and one checked listing is enough")
+	assert.Equal(t, []string{"chunk-001", "chunk-002"}, items[1].ChunkIDs, "text matches ignore case and spacing")
+	assert.False(t, items[1].Done)
+	assert.Equal(t, "Tokio tutorial", items[2].Text)
+
+	done, total := p.ReadingProgress()
+	assert.Equal(t, 1, done)
+	assert.Equal(t, 3, total)
+}
+
+// readingPlanMarkdown lists one resource in two chunks.
+var readingPlanMarkdown = strings.Replace(validPlanMarkdown, "**Deliverable**: Complete exercises\n", `**Deliverable**: Complete exercises
+
+## Chunk 2: Second Chunk {#chunk-002}
+
+**Duration**: 1 hour
+**Status**: not-started
+
+**Resources**:
+- [ ] Book chapter 1
+- https://go.dev/tour
+
+**Deliverable**: More exercises
+`, 1)
+
+func TestService_ReadingList(t *testing.T) {
+	service, _, paths, cleanup := setupTestService(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	// Written outside the service, so nothing is indexed yet
+	require.NoError(t, os.WriteFile(paths.PlanPath("test-plan"), []byte(readingPlanMarkdown), 0o600))
+
+	items, err := service.ReadingList(ctx, "test-plan")
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, []string{"chunk-001", "chunk-002"}, items[0].ChunkIDs)
+
+	indexed, err := service.sqliteRepo.ListResources(ctx, "test-plan")
+	require.NoError(t, err)
+	assert.Equal(t, items, indexed, "the list is indexed on first read")
+
+	_, err = service.ReadingList(ctx, "missing")
+	assert.Error(t, err)
+}
+
+func TestService_SetResourceDone(t *testing.T) {
+	service, _, paths, cleanup := setupTestService(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	require.NoError(t, os.WriteFile(paths.PlanPath("test-plan"), []byte(readingPlanMarkdown), 0o600))
+	require.NoError(t, service.RefreshIndex(ctx, "test-plan"))
+
+	item, err := service.SetResourceDone(ctx, "test-plan", 1, true)
+	require.NoError(t, err)
+	assert.True(t, item.Done)
+
+	// Every listing is checked off in the plan file
+	reloaded, err := service.Get(ctx, "test-plan")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"[x] Book chapter 1"}, reloaded.Chunks[0].Resources)
+	assert.Equal(t, "[x] Book chapter 1", reloaded.Chunks[1].Resources[0])
+
+	items, err := service.ReadingList(ctx, "test-plan")
+	require.NoError(t, err)
+	assert.True(t, items[0].Done)
+	assert.False(t, items[1].Done)
+
+	_, err = service.SetResourceDone(ctx, "test-plan", 1, false)
+	require.NoError(t, err)
+	items, err = service.ReadingList(ctx, "test-plan")
+	require.NoError(t, err)
+	assert.False(t, items[0].Done)
+
+	// Unchecking a resource without a checkbox leaves it as written
+	_, err = service.SetResourceDone(ctx, "test-plan", 2, false)
+	require.NoError(t, err)
+	reloaded, err = service.Get(ctx, "test-plan")
+	require.NoError(t, err)
+	assert.Equal(t, "https://go.dev/tour", reloaded.Chunks[1].Resources[1])
+
+	_, err = service.SetResourceDone(ctx, "test-plan", 3, true)
+	assert.ErrorContains(t, err, "between 1 and 2")
+}
+
+func TestService_Reindex_Resources(t *testing.T) {
+	service, _, paths, cleanup := setupTestService(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	require.NoError(t, os.WriteFile(paths.PlanPath("test-plan"), []byte(readingPlanMarkdown), 0o600))
+	require.NoError(t, service.RefreshIndex(ctx, "test-plan"))
+
+	// Checking a box by hand changes only the reading list
+	edited := strings.Replace(readingPlanMarkdown, "- [ ] Book chapter 1", "- [x] Book chapter 1", 1)
+	require.NoError(t, os.WriteFile(paths.PlanPath("test-plan"), []byte(edited), 0o600))
+	result, err := service.Reindex(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"test-plan"}, result.Updated)
+
+	items, err := service.sqliteRepo.ListResources(ctx, "test-plan")
+	require.NoError(t, err)
+	assert.True(t, items[0].Done)
+
+	require.NoError(t, service.Delete(ctx, "test-plan"))
+	items, err = service.sqliteRepo.ListResources(ctx, "test-plan")
+	require.NoError(t, err)
+	assert.Empty(t, items)
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/llm"
+)
+
+// DefaultRepairAttempts is how many times Create sends a plan that fails
+// to parse back to the LLM for fixing before giving up.
+const DefaultRepairAttempts = 2
+
+// repairPrompt asks the LLM to fix the format of a plan it wrote. It gets
+// the original request, the reply, and what was wrong with the reply.
+const repairPrompt = `Your previous response to the request below could not be read as a learning plan.
+
+Problems found:
+%s
+
+Rewrite your response so it follows the format the request describes exactly:
+YAML frontmatter between "---" lines, then one "## Chunk N: Title {#chunk-NNN}"
+section per chunk with its **Duration**, **Status**, **Objectives**,
+**Resources**, and **Deliverable** fields. Keep the content; fix only the
+format. Output only the corrected markdown, with no commentary or code fences.
+
+---BEGIN REQUEST---
+%s
+---END REQUEST---
+
+---BEGIN PREVIOUS RESPONSE---
+%s
+---END PREVIOUS RESPONSE---
+`
+
+// SetRepairAttempts sets how many times Create asks the LLM to fix a plan
+// that fails to parse. Zero surfaces the first failure.
+func (s *Service) SetRepairAttempts(attempts int) {
+	s.repairAttempts = attempts
+}
+
+// generate calls the LLM with prompt, recorded in the cost ledger as
+// operation, and parses its reply into a valid plan. A reply that fails to parse or validate is sent back with the
+// problems found, up to the service's repair attempts. When every reply
+// fails, the last one is saved for inspection and named in the error.
+func (s *Service) generate(ctx context.Context, operation string, req CreateRequest, planID, prompt string) (*Plan, error) {
+	callCtx := llm.WithCallInfo(ctx, llm.CallInfo{Operation: operation, PlanID: planID})
+	output, err := s.llmProvider.Call(callCtx, prompt)
+	if err != nil {
+		return nil, fmt.Errorf("LLM call failed: %w", err)
+	}
+
+	repairCtx := llm.WithCallInfo(ctx, llm.CallInfo{Operation: llm.OperationPlanRepair, PlanID: planID})
+	for attempt := 0; ; attempt++ {
+		plan, problems := parseGenerated(output, planID, req.Debug)
+		if plan != nil {
+			return plan, nil
+		}
+		if attempt == s.repairAttempts {
+			return nil, s.generationFailed(planID, output, attempt, problems)
+		}
+
+		if req.Debug {
+			fmt.Fprintf(os.Stderr, "→ DEBUG: Asking the LLM to repair its output (attempt %d of %d)\n\n", attempt+1, s.repairAttempts)
+		}
+		output, err = s.llmProvider.Call(repairCtx, fmt.Sprintf(repairPrompt, problems, prompt, output))
+		if err != nil {
+			return nil, fmt.Errorf("LLM repair call failed: %w", err)
+		}
+	}
+}
+
+// parseGenerated cleans and parses one LLM reply. It returns the plan when
+// the reply is a valid plan, and otherwise the problems found, one per
+// line, for the repair prompt.
+func parseGenerated(output, planID string, debug bool) (*Plan, string) {
+	// Debug: Show raw LLM response
+	if debug {
+		fmt.Fprintf(os.Stderr, "→ DEBUG: Raw LLM response (%d chars):\n", len(output))
+		fmt.Fprintf(os.Stderr, "---BEGIN RESPONSE---\n%s\n---END RESPONSE---\n\n", output)
+	}
+
+	// Clean LLM output (strip markdown code fences, etc.)
+	cleaned := cleanLLMOutput(output)
+
+	// Debug: Show cleaned output if different
+	if debug && cleaned != output {
+		fmt.Fprintf(os.Stderr, "→ DEBUG: Cleaned output (%d chars, removed %d chars):\n",
+			len(cleaned), len(output)-len(cleaned))
+		fmt.Fprintf(os.Stderr, "---BEGIN CLEANED---\n%s\n---END CLEANED---\n\n", cleaned)
+	}
+
+	plan, warnings, err := ParseWithWarnings(cleaned)
+	if err == nil {
+		// Ensure plan ID matches
+		plan.ID = planID
+		if err = plan.Validate(); err == nil {
+			return plan, ""
+		}
+		err = fmt.Errorf("generated plan is invalid: %w", err)
+	}
+
+	problems := []string{"- " + err.Error()}
+	for _, w := range warnings {
+		problems = append(problems, "- "+w.String())
+	}
+	return nil, strings.Join(problems, "\n")
+}
+
+// generationFailed saves the last LLM reply next to the plans and returns
+// the error Create reports. If the reply can't be saved, a preview of it
+// goes in the error instead.
+func (s *Service) generationFailed(planID, output string, repairs int, problems string) error {
+	msg := "failed to parse LLM output"
+	if repairs > 0 {
+		msg = fmt.Sprintf("failed to parse LLM output after %d repair attempts", repairs)
+	}
+
+	path := s.paths.FailedOutputPath(planID)
+	err := os.MkdirAll(filepath.Dir(path), 0o700)
+	if err == nil {
+		err = s.fs.WriteFile(path, []byte(output))
+	}
+	if err != nil {
+		preview := output
+		if len(preview) > 200 {
+			preview = preview[:200] + "..."
+		}
+		return fmt.Errorf("%s:\n%s\nOutput preview: %s", msg, problems, preview)
+	}
+	return fmt.Errorf("%s:\n%s\nRaw output saved to %s", msg, problems, path)
+}
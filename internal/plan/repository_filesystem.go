@@ -39,8 +39,8 @@ func (r *FilesystemRepository) Save(_ context.Context, plan *Plan) error {
 		return fmt.Errorf("failed to format plan: %w", err)
 	}
 
-	// Get file path
-	filePath := r.paths.PlanPath(plan.ID)
+	// Keep archived files in the archive directory
+	filePath := r.Path(plan.ID)
 
 	// Write to filesystem
 	if err := r.fs.WriteFile(filePath, []byte(content)); err != nil {
@@ -51,36 +51,42 @@ func (r *FilesystemRepository) Save(_ context.Context, plan *Plan) error {
 }
 
 // Load reads and parses a plan from a markdown file.
-func (r *FilesystemRepository) Load(_ context.Context, id string) (*Plan, error) {
-	filePath := r.paths.PlanPath(id)
+func (r *FilesystemRepository) Load(ctx context.Context, id string) (*Plan, error) {
+	plan, _, err := r.LoadWithWarnings(ctx, id)
+	return plan, err
+}
+
+// LoadWithWarnings reads a plan and reports content the parser skipped.
+func (r *FilesystemRepository) LoadWithWarnings(_ context.Context, id string) (*Plan, []Warning, error) {
+	filePath := r.Path(id)
 
 	// Check if file exists
 	if !r.fs.FileExists(filePath) {
-		return nil, fmt.Errorf("plan not found: %s", id)
+		return nil, nil, fmt.Errorf("%w: %s", ErrPlanNotFound, id)
 	}
 
 	// Read file
 	content, err := r.fs.ReadFile(filePath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read plan file: %w", err)
+		return nil, nil, fmt.Errorf("failed to read plan file: %w", err)
 	}
 
 	// Parse markdown
-	plan, err := Parse(string(content))
+	plan, warnings, err := ParseWithWarnings(string(content))
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse plan: %w", err)
+		return nil, nil, fmt.Errorf("failed to parse plan: %w", err)
 	}
 
-	return plan, nil
+	return plan, warnings, nil
 }
 
 // Delete removes a plan's markdown file.
 func (r *FilesystemRepository) Delete(_ context.Context, id string) error {
-	filePath := r.paths.PlanPath(id)
+	filePath := r.Path(id)
 
 	// Check if file exists
 	if !r.fs.FileExists(filePath) {
-		return fmt.Errorf("plan not found: %s", id)
+		return fmt.Errorf("%w: %s", ErrPlanNotFound, id)
 	}
 
 	// Delete file
@@ -93,19 +99,26 @@ func (r *FilesystemRepository) Delete(_ context.Context, id string) error {
 
 // Exists checks if a plan file exists.
 func (r *FilesystemRepository) Exists(_ context.Context, id string) bool {
-	filePath := r.paths.PlanPath(id)
+	filePath := r.Path(id)
 	return r.fs.FileExists(filePath)
 }
 
-// List returns all plan IDs by scanning the plans directory.
+// List returns all plan IDs by scanning the plans directory and its
+// archive directory.
 func (r *FilesystemRepository) List(_ context.Context) ([]string, error) {
 	// Read directory entries
 	entries, err := os.ReadDir(r.paths.PlansDir)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read plans directory: %w", err)
 	}
+	archived, err := os.ReadDir(r.paths.ArchiveDir())
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read plan archive directory: %w", err)
+	}
+	entries = append(entries, archived...)
 
 	planIDs := make([]string, 0, len(entries))
+	seen := make(map[string]bool)
 	for _, entry := range entries {
 		// Skip directories
 		if entry.IsDir() {
@@ -118,17 +131,60 @@ func (r *FilesystemRepository) List(_ context.Context) ([]string, error) {
 			continue
 		}
 
-		// Extract plan ID from filename (remove .md extension)
+		// Extract plan ID from filename (remove .md extension); a plan
+		// in both directories is listed once
 		planID := strings.TrimSuffix(name, ".md")
+		if seen[planID] {
+			continue
+		}
+		seen[planID] = true
 		planIDs = append(planIDs, planID)
 	}
 
 	return planIDs, nil
 }
 
-// Path returns the full file path for a plan ID.
+// Path returns the full file path for a plan ID: its file in the archive
+// directory if it was moved there, otherwise its file in the plans
+// directory. A file in the plans directory wins when both exist.
 func (r *FilesystemRepository) Path(id string) string {
-	return r.paths.PlanPath(id)
+	active := r.paths.PlanPath(id)
+	if !r.fs.FileExists(active) {
+		if archived := r.paths.ArchivedPlanPath(id); r.fs.FileExists(archived) {
+			return archived
+		}
+	}
+	return active
+}
+
+// IsArchived reports whether a plan's file lives in the archive directory.
+func (r *FilesystemRepository) IsArchived(id string) bool {
+	return r.Path(id) == r.paths.ArchivedPlanPath(id)
+}
+
+// Move moves a plan file into the archive directory (archive true) or
+// back into the plans directory. Moving a file already in place is a no-op.
+func (r *FilesystemRepository) Move(_ context.Context, id string, archive bool) error {
+	from := r.Path(id)
+	if !r.fs.FileExists(from) {
+		return fmt.Errorf("%w: %s", ErrPlanNotFound, id)
+	}
+
+	to := r.paths.PlanPath(id)
+	if archive {
+		to = r.paths.ArchivedPlanPath(id)
+	}
+	if from == to {
+		return nil
+	}
+	if r.fs.FileExists(to) {
+		return fmt.Errorf("cannot move plan %s: %s already exists", id, to)
+	}
+
+	if err := r.fs.Rename(from, to); err != nil {
+		return fmt.Errorf("failed to move plan file: %w", err)
+	}
+	return nil
 }
 
 // LoadAll loads all plans from the filesystem.
@@ -219,7 +219,7 @@ func TestFilesystemRepository_Load_NonexistentPlan(t *testing.T) {
 
 	_, err := repo.Load(ctx, "nonexistent")
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "plan not found")
+	assert.ErrorIs(t, err, ErrPlanNotFound)
 }
 
 func TestFilesystemRepository_Load_CorruptedFile(t *testing.T) {
@@ -287,7 +287,7 @@ func TestFilesystemRepository_Delete_NonexistentPlan(t *testing.T) {
 
 	err := repo.Delete(ctx, "nonexistent")
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "plan not found")
+	assert.ErrorIs(t, err, ErrPlanNotFound)
 }
 
 func TestFilesystemRepository_Exists(t *testing.T) {
@@ -8,13 +8,15 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/pezware/samedi.dev/internal/storage"
 )
 
 // SQLiteRepository implements plan storage using SQLite.
 type SQLiteRepository struct {
-	db *storage.SQLiteDB
+	db     *storage.SQLiteDB
+	cipher *storage.Cipher // Encrypts version snapshots at rest when set
 }
 
 // NewSQLiteRepository creates a new SQLite-backed plan repository.
@@ -22,9 +24,15 @@ func NewSQLiteRepository(db *storage.SQLiteDB) *SQLiteRepository {
 	return &SQLiteRepository{db: db}
 }
 
+// SetCipher encrypts the plan markdown kept in version snapshots, like the
+// plan files themselves. A nil cipher stores snapshots in plaintext.
+func (r *SQLiteRepository) SetCipher(c *storage.Cipher) {
+	r.cipher = c
+}
+
 // ToRecord converts a Plan domain model to a storage PlanRecord.
 func ToRecord(plan *Plan, filePath string) *storage.PlanRecord {
-	return &storage.PlanRecord{
+	record := &storage.PlanRecord{
 		ID:         plan.ID,
 		Title:      plan.Title,
 		CreatedAt:  plan.CreatedAt,
@@ -33,13 +41,34 @@ func ToRecord(plan *Plan, filePath string) *storage.PlanRecord {
 		Status:     string(plan.Status),
 		Tags:       plan.Tags,
 		FilePath:   filePath,
+		Children:   plan.Children,
+	}
+	if plan.Deadline != nil {
+		record.Deadline = plan.Deadline.String()
+	}
+
+	if next := plan.NextChunk(); next != nil {
+		record.NextChunkID = next.ID
+		record.NextChunkTitle = next.Title
+	}
+
+	record.ChunksTotal = len(plan.Chunks)
+	record.ChunksCompleted = plan.CompletedChunks()
+	record.ChunksIndexed = true
+
+	if plan.Provenance != nil {
+		record.GeneratedProvider = plan.Provenance.Provider
+		record.GeneratedModel = plan.Provenance.Model
+		record.TemplateVersion = plan.Provenance.TemplateVersion
 	}
+
+	return record
 }
 
 // RecordToPlan converts a storage PlanRecord to a Plan domain model.
 // Note: This only converts metadata; chunks must be loaded from filesystem.
 func RecordToPlan(record *storage.PlanRecord) *Plan {
-	return &Plan{
+	p := &Plan{
 		ID:         record.ID,
 		Title:      record.Title,
 		CreatedAt:  record.CreatedAt,
@@ -47,27 +76,80 @@ func RecordToPlan(record *storage.PlanRecord) *Plan {
 		TotalHours: record.TotalHours,
 		Status:     Status(record.Status),
 		Tags:       record.Tags,
+		Children:   record.Children,
 		Chunks:     []Chunk{}, // Chunks must be loaded separately
 	}
+	if deadline, err := ParseDate(record.Deadline); err == nil {
+		p.Deadline = &deadline
+	}
+
+	if record.GeneratedProvider != "" {
+		p.Provenance = &Provenance{
+			Provider:        record.GeneratedProvider,
+			Model:           record.GeneratedModel,
+			TemplateVersion: record.TemplateVersion,
+		}
+	}
+
+	return p
 }
 
+// selectPlans reads plan metadata joined with each plan's most recent session.
+// GROUP BY keeps one row per plan if two sessions share a start time.
+const selectPlans = `
+	SELECT plans.id, plans.title, plans.created_at, plans.updated_at, plans.total_hours,
+		plans.status, plans.tags, plans.file_path, plans.next_chunk_id, plans.next_chunk_title,
+		plans.generated_provider, plans.generated_model, plans.template_version,
+		plans.children, plans.deadline, plans.chunks_total, plans.chunks_completed,
+		last.start_time
+	FROM plans
+	LEFT JOIN sessions last ON last.plan_id = plans.id
+		AND last.start_time = (SELECT MAX(start_time) FROM sessions WHERE plan_id = plans.id)`
+
 // Upsert creates or updates a plan's metadata in SQLite.
 func (r *SQLiteRepository) Upsert(ctx context.Context, record *storage.PlanRecord) error {
 	tagsJSON, err := json.Marshal(record.Tags)
 	if err != nil {
 		return fmt.Errorf("failed to marshal tags: %w", err)
 	}
+	var childrenJSON sql.NullString
+	if len(record.Children) > 0 {
+		data, err := json.Marshal(record.Children)
+		if err != nil {
+			return fmt.Errorf("failed to marshal children: %w", err)
+		}
+		childrenJSON = nullString(string(data))
+	}
+
+	var chunksTotal, chunksCompleted sql.NullInt64
+	if record.ChunksIndexed {
+		chunksTotal = sql.NullInt64{Int64: int64(record.ChunksTotal), Valid: true}
+		chunksCompleted = sql.NullInt64{Int64: int64(record.ChunksCompleted), Valid: true}
+	}
 
 	query := `
-		INSERT INTO plans (id, title, created_at, updated_at, total_hours, status, tags, file_path)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		INSERT INTO plans (
+			id, title, created_at, updated_at, total_hours, status, tags, file_path,
+			next_chunk_id, next_chunk_title,
+			generated_provider, generated_model, template_version, children, deadline,
+			chunks_total, chunks_completed
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 		ON CONFLICT(id) DO UPDATE SET
 			title = excluded.title,
 			updated_at = excluded.updated_at,
 			total_hours = excluded.total_hours,
 			status = excluded.status,
 			tags = excluded.tags,
-			file_path = excluded.file_path
+			file_path = excluded.file_path,
+			next_chunk_id = excluded.next_chunk_id,
+			next_chunk_title = excluded.next_chunk_title,
+			generated_provider = excluded.generated_provider,
+			generated_model = excluded.generated_model,
+			template_version = excluded.template_version,
+			children = excluded.children,
+			deadline = excluded.deadline,
+			chunks_total = excluded.chunks_total,
+			chunks_completed = excluded.chunks_completed
 	`
 
 	_, err = r.db.DB().ExecContext(ctx, query,
@@ -79,6 +161,15 @@ func (r *SQLiteRepository) Upsert(ctx context.Context, record *storage.PlanRecor
 		record.Status,
 		string(tagsJSON),
 		record.FilePath,
+		nullString(record.NextChunkID),
+		nullString(record.NextChunkTitle),
+		nullString(record.GeneratedProvider),
+		nullString(record.GeneratedModel),
+		nullString(record.TemplateVersion),
+		childrenJSON,
+		nullString(record.Deadline),
+		chunksTotal,
+		chunksCompleted,
 	)
 
 	if err != nil {
@@ -90,52 +181,35 @@ func (r *SQLiteRepository) Upsert(ctx context.Context, record *storage.PlanRecor
 
 // Get retrieves a plan's metadata by ID.
 func (r *SQLiteRepository) Get(ctx context.Context, id string) (*storage.PlanRecord, error) {
-	query := `
-		SELECT id, title, created_at, updated_at, total_hours, status, tags, file_path
-		FROM plans
-		WHERE id = ?
-	`
-
-	var record storage.PlanRecord
-	var tagsJSON string
-
-	err := r.db.DB().QueryRowContext(ctx, query, id).Scan(
-		&record.ID,
-		&record.Title,
-		&record.CreatedAt,
-		&record.UpdatedAt,
-		&record.TotalHours,
-		&record.Status,
-		&tagsJSON,
-		&record.FilePath,
-	)
+	query := selectPlans + " WHERE plans.id = ? GROUP BY plans.id"
 
-	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("plan not found: %s", id)
-	}
+	rows, err := r.db.DB().QueryContext(ctx, query, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get plan: %w", err)
 	}
+	defer rows.Close()
 
-	// Unmarshal tags
-	if tagsJSON != "" {
-		if err := json.Unmarshal([]byte(tagsJSON), &record.Tags); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal tags: %w", err)
-		}
+	records, err := r.scanRows(rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plan: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrPlanNotFound, id)
 	}
 
-	return &record, nil
+	return records[0], nil
 }
 
 // List retrieves plans with optional filtering.
 func (r *SQLiteRepository) List(ctx context.Context, filter *storage.PlanFilter) ([]*storage.PlanRecord, error) {
-	query := "SELECT id, title, created_at, updated_at, total_hours, status, tags, file_path FROM plans"
+	query := selectPlans
 	conditions, args := r.buildWhereClause(filter)
 
 	if conditions != "" {
 		query += " WHERE " + conditions
 	}
 
+	query += " GROUP BY plans.id"
 	query += r.buildOrderClause(filter)
 
 	rows, err := r.db.DB().QueryContext(ctx, query, args...)
@@ -158,7 +232,7 @@ func (r *SQLiteRepository) buildWhereClause(filter *storage.PlanFilter) (string,
 
 	if len(filter.IDs) > 0 {
 		placeholders := r.buildPlaceholders(len(filter.IDs))
-		conditions = append(conditions, fmt.Sprintf("id IN (%s)", placeholders))
+		conditions = append(conditions, fmt.Sprintf("plans.id IN (%s)", placeholders))
 		for _, id := range filter.IDs {
 			args = append(args, id)
 		}
@@ -166,15 +240,28 @@ func (r *SQLiteRepository) buildWhereClause(filter *storage.PlanFilter) (string,
 
 	if len(filter.Statuses) > 0 {
 		placeholders := r.buildPlaceholders(len(filter.Statuses))
-		conditions = append(conditions, fmt.Sprintf("status IN (%s)", placeholders))
+		conditions = append(conditions, fmt.Sprintf("plans.status IN (%s)", placeholders))
 		for _, status := range filter.Statuses {
 			args = append(args, status)
 		}
 	}
 
-	if filter.Tag != "" {
-		conditions = append(conditions, "tags LIKE ?")
-		args = append(args, "%"+filter.Tag+"%")
+	if len(filter.Tags) > 0 {
+		tagConditions := make([]string, len(filter.Tags))
+		for i, tag := range filter.Tags {
+			tagConditions[i] = "EXISTS (SELECT 1 FROM json_each(plans.tags) WHERE lower(json_each.value) = lower(?))"
+			args = append(args, tag)
+		}
+		joiner := " AND "
+		if filter.AnyTag {
+			joiner = " OR "
+		}
+		conditions = append(conditions, "("+strings.Join(tagConditions, joiner)+")")
+	}
+
+	if filter.DueBefore != "" {
+		conditions = append(conditions, "plans.deadline IS NOT NULL AND plans.deadline <= ?")
+		args = append(args, filter.DueBefore)
 	}
 
 	whereClause := ""
@@ -204,16 +291,18 @@ func (r *SQLiteRepository) buildPlaceholders(count int) string {
 // buildOrderClause constructs the ORDER BY clause.
 func (r *SQLiteRepository) buildOrderClause(filter *storage.PlanFilter) string {
 	if filter == nil || filter.SortBy == "" {
-		return " ORDER BY created_at DESC"
+		return " ORDER BY plans.created_at DESC"
 	}
 
 	// Map user-friendly names to SQL columns (prevent SQL injection)
 	sortField := map[string]string{
-		"created": "created_at DESC",
-		"updated": "updated_at DESC",
-		"title":   "title ASC",
-		"status":  "status ASC",
-		"hours":   "total_hours DESC",
+		"created": "plans.created_at DESC",
+		"updated": "plans.updated_at DESC",
+		"title":   "plans.title ASC",
+		"status":  "plans.status ASC",
+		"hours":   "plans.total_hours DESC",
+		// Soonest first; plans without a deadline last
+		"deadline": "plans.deadline IS NULL, plans.deadline ASC",
 	}
 
 	if sqlOrder, ok := sortField[filter.SortBy]; ok {
@@ -221,7 +310,7 @@ func (r *SQLiteRepository) buildOrderClause(filter *storage.PlanFilter) string {
 	}
 
 	// Default if invalid sort field
-	return " ORDER BY created_at DESC"
+	return " ORDER BY plans.created_at DESC"
 }
 
 // scanRows scans all rows into PlanRecords.
@@ -247,6 +336,10 @@ func (r *SQLiteRepository) scanRows(rows *sql.Rows) ([]*storage.PlanRecord, erro
 func (r *SQLiteRepository) scanRow(rows *sql.Rows) (*storage.PlanRecord, error) {
 	var record storage.PlanRecord
 	var tagsJSON string
+	var nextChunkID, nextChunkTitle sql.NullString
+	var generatedProvider, generatedModel, templateVersion, childrenJSON, deadline sql.NullString
+	var chunksTotal, chunksCompleted sql.NullInt64
+	var lastSession sql.NullTime
 
 	err := rows.Scan(
 		&record.ID,
@@ -257,23 +350,62 @@ func (r *SQLiteRepository) scanRow(rows *sql.Rows) (*storage.PlanRecord, error)
 		&record.Status,
 		&tagsJSON,
 		&record.FilePath,
+		&nextChunkID,
+		&nextChunkTitle,
+		&generatedProvider,
+		&generatedModel,
+		&templateVersion,
+		&childrenJSON,
+		&deadline,
+		&chunksTotal,
+		&chunksCompleted,
+		&lastSession,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to scan plan row: %w", err)
 	}
 
+	record.NextChunkID = nextChunkID.String
+	record.NextChunkTitle = nextChunkTitle.String
+	record.GeneratedProvider = generatedProvider.String
+	record.GeneratedModel = generatedModel.String
+	record.TemplateVersion = templateVersion.String
+	record.Deadline = deadline.String
+	if chunksTotal.Valid && chunksCompleted.Valid {
+		record.ChunksTotal = int(chunksTotal.Int64)
+		record.ChunksCompleted = int(chunksCompleted.Int64)
+		record.ChunksIndexed = true
+	}
+	if lastSession.Valid {
+		t := lastSession.Time
+		record.LastSession = &t
+	}
+
 	// Unmarshal tags
 	if tagsJSON != "" {
 		if err := json.Unmarshal([]byte(tagsJSON), &record.Tags); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal tags: %w", err)
 		}
 	}
+	if childrenJSON.Valid {
+		if err := json.Unmarshal([]byte(childrenJSON.String), &record.Children); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal children: %w", err)
+		}
+	}
 
 	return &record, nil
 }
 
-// Delete removes a plan's metadata from SQLite.
+// Delete removes a plan's metadata, indexed chunks, and reading list from
+// SQLite.
 func (r *SQLiteRepository) Delete(ctx context.Context, id string) error {
+	if _, err := r.db.DB().ExecContext(ctx, "DELETE FROM chunks WHERE plan_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete chunks: %w", err)
+	}
+	if _, err := r.db.DB().ExecContext(ctx, "DELETE FROM resources WHERE plan_id = ?", id); err != nil {
+		return fmt.Errorf("failed to delete resources: %w", err)
+	}
+
 	query := "DELETE FROM plans WHERE id = ?"
 
 	result, err := r.db.DB().ExecContext(ctx, query, id)
@@ -287,8 +419,13 @@ func (r *SQLiteRepository) Delete(ctx context.Context, id string) error {
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("plan not found: %s", id)
+		return fmt.Errorf("%w: %s", ErrPlanNotFound, id)
 	}
 
 	return nil
 }
+
+// nullString converts empty strings to NULL.
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
@@ -106,6 +106,41 @@ func TestSQLiteRepository_Upsert_Update(t *testing.T) {
 	assert.Equal(t, 15.0, retrieved.TotalHours)
 }
 
+func TestSQLiteRepository_Upsert_Provenance(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewSQLiteRepository(db)
+	ctx := context.Background()
+
+	now := time.Now()
+	plan := &Plan{
+		ID:         "test-plan",
+		Title:      "Test Plan",
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		TotalHours: 10.0,
+		Status:     StatusNotStarted,
+		Provenance: &Provenance{Provider: "claude", Model: "sonnet", TemplateVersion: "sha256:0123456789ab"},
+	}
+
+	require.NoError(t, repo.Upsert(ctx, ToRecord(plan, "/path/to/test-plan.md")))
+
+	retrieved, err := repo.Get(ctx, "test-plan")
+	require.NoError(t, err)
+	assert.Equal(t, "claude", retrieved.GeneratedProvider)
+	assert.Equal(t, "sonnet", retrieved.GeneratedModel)
+	assert.Equal(t, "sha256:0123456789ab", retrieved.TemplateVersion)
+	assert.Equal(t, plan.Provenance, RecordToPlan(retrieved).Provenance)
+
+	plan.Provenance = nil
+	require.NoError(t, repo.Upsert(ctx, ToRecord(plan, "/path/to/test-plan.md")))
+	retrieved, err = repo.Get(ctx, "test-plan")
+	require.NoError(t, err)
+	assert.Empty(t, retrieved.GeneratedProvider)
+	assert.Nil(t, RecordToPlan(retrieved).Provenance)
+}
+
 func TestSQLiteRepository_Get_NotFound(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -115,7 +150,7 @@ func TestSQLiteRepository_Get_NotFound(t *testing.T) {
 
 	_, err := repo.Get(ctx, "nonexistent")
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "plan not found")
+	assert.ErrorIs(t, err, ErrPlanNotFound)
 }
 
 func TestSQLiteRepository_List_All(t *testing.T) {
@@ -231,7 +266,7 @@ func TestSQLiteRepository_List_FilterByTag(t *testing.T) {
 
 	// Filter by tag
 	filter := &storage.PlanFilter{
-		Tag: "golang",
+		Tags: []string{"golang"},
 	}
 
 	records, err := repo.List(ctx, filter)
@@ -241,6 +276,22 @@ func TestSQLiteRepository_List_FilterByTag(t *testing.T) {
 	ids := []string{records[0].ID, records[1].ID}
 	assert.Contains(t, ids, "plan-1")
 	assert.Contains(t, ids, "plan-3")
+
+	// Every tag must match by default
+	records, err = repo.List(ctx, &storage.PlanFilter{Tags: []string{"GoLang", "testing"}})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "plan-3", records[0].ID)
+
+	// Any tag with AnyTag
+	records, err = repo.List(ctx, &storage.PlanFilter{Tags: []string{"backend", "frontend"}, AnyTag: true})
+	require.NoError(t, err)
+	assert.Len(t, records, 2)
+
+	// Whole tags only: "go" is not "golang"
+	records, err = repo.List(ctx, &storage.PlanFilter{Tags: []string{"go"}})
+	require.NoError(t, err)
+	assert.Empty(t, records)
 }
 
 func TestSQLiteRepository_List_FilterByIDs(t *testing.T) {
@@ -306,7 +357,7 @@ func TestSQLiteRepository_Delete_Success(t *testing.T) {
 	// Verify deleted
 	_, err = repo.Get(ctx, "test-plan")
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "plan not found")
+	assert.ErrorIs(t, err, ErrPlanNotFound)
 }
 
 func TestSQLiteRepository_Delete_NotFound(t *testing.T) {
@@ -318,5 +369,127 @@ func TestSQLiteRepository_Delete_NotFound(t *testing.T) {
 
 	err := repo.Delete(ctx, "nonexistent")
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "plan not found")
+	assert.ErrorIs(t, err, ErrPlanNotFound)
+}
+
+func TestSQLiteRepository_List_LastSessionAndNextChunk(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewSQLiteRepository(db)
+	ctx := context.Background()
+
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	studied := &Plan{
+		ID: "studied", Title: "Studied", CreatedAt: now, UpdatedAt: now, TotalHours: 2, Status: StatusInProgress,
+		Chunks: []Chunk{
+			{ID: "chunk-001", Title: "Basics", Status: StatusCompleted},
+			{ID: "chunk-002", Title: "Ownership", Status: StatusNotStarted},
+		},
+	}
+	untouched := &Plan{ID: "untouched", Title: "Untouched", CreatedAt: now, UpdatedAt: now, TotalHours: 1, Status: StatusCompleted}
+	require.NoError(t, repo.Upsert(ctx, ToRecord(studied, "/path/to/studied.md")))
+	require.NoError(t, repo.Upsert(ctx, ToRecord(untouched, "/path/to/untouched.md")))
+
+	// Two sessions share the latest start time; the plan must still appear once
+	latest := now.Add(-2 * time.Hour)
+	for i, start := range []time.Time{now.Add(-72 * time.Hour), latest, latest} {
+		_, err := db.DB().ExecContext(ctx,
+			"INSERT INTO sessions (id, plan_id, start_time, duration_minutes) VALUES (?, ?, ?, ?)",
+			string(rune('a'+i)), "studied", start, 30)
+		require.NoError(t, err)
+	}
+
+	records, err := repo.List(ctx, &storage.PlanFilter{SortBy: "title"})
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	assert.Equal(t, "studied", records[0].ID)
+	require.NotNil(t, records[0].LastSession)
+	assert.True(t, latest.Equal(*records[0].LastSession))
+	assert.Equal(t, "chunk-002", records[0].NextChunkID)
+	assert.Equal(t, "Ownership", records[0].NextChunkTitle)
+
+	assert.Equal(t, "untouched", records[1].ID)
+	assert.Nil(t, records[1].LastSession)
+	assert.Empty(t, records[1].NextChunkID)
+
+	record, err := repo.Get(ctx, "studied")
+	require.NoError(t, err)
+	require.NotNil(t, record.LastSession)
+	assert.Equal(t, "Ownership", record.NextChunkTitle)
+}
+
+func TestSQLiteRepository_List_Deadlines(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewSQLiteRepository(db)
+	ctx := context.Background()
+
+	now := time.Now()
+	deadline := func(value string) *Date {
+		d, err := ParseDate(value)
+		require.NoError(t, err)
+		return &d
+	}
+	plans := []*Plan{
+		{ID: "later", Title: "Later", CreatedAt: now, UpdatedAt: now, Status: StatusNotStarted, Deadline: deadline("2025-06-30")},
+		{ID: "sooner", Title: "Sooner", CreatedAt: now, UpdatedAt: now, Status: StatusNotStarted, Deadline: deadline("2025-06-01")},
+		{ID: "far", Title: "Far", CreatedAt: now, UpdatedAt: now, Status: StatusNotStarted, Deadline: deadline("2025-12-31")},
+		{ID: "none", Title: "None", CreatedAt: now, UpdatedAt: now, Status: StatusNotStarted},
+	}
+	for _, p := range plans {
+		require.NoError(t, repo.Upsert(ctx, ToRecord(p, "/path/to/"+p.ID+".md")))
+	}
+
+	record, err := repo.Get(ctx, "later")
+	require.NoError(t, err)
+	assert.Equal(t, "2025-06-30", record.Deadline)
+	assert.Equal(t, "2025-06-30", RecordToPlan(record).Deadline.String())
+
+	records, err := repo.List(ctx, &storage.PlanFilter{SortBy: "deadline"})
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+	assert.Equal(t, []string{"sooner", "later", "far", "none"},
+		[]string{records[0].ID, records[1].ID, records[2].ID, records[3].ID}, "plans without a deadline sort last")
+
+	records, err = repo.List(ctx, &storage.PlanFilter{DueBefore: "2025-06-30", SortBy: "deadline"})
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, "sooner", records[0].ID)
+	assert.Equal(t, "later", records[1].ID)
+}
+
+func TestSQLiteRepository_ChunkCounts(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewSQLiteRepository(db)
+	ctx := context.Background()
+
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	p := &Plan{
+		ID: "rust", Title: "Rust", CreatedAt: now, UpdatedAt: now, TotalHours: 3, Status: StatusInProgress,
+		Chunks: []Chunk{
+			{ID: "chunk-001", Title: "Basics", Status: StatusCompleted},
+			{ID: "chunk-002", Title: "Ownership", Status: StatusCompleted},
+			{ID: "chunk-003", Title: "Traits", Status: StatusNotStarted},
+		},
+	}
+	require.NoError(t, repo.Upsert(ctx, ToRecord(p, "/path/to/rust.md")))
+
+	record, err := repo.Get(ctx, "rust")
+	require.NoError(t, err)
+	assert.True(t, record.ChunksIndexed)
+	assert.Equal(t, 3, record.ChunksTotal)
+	assert.Equal(t, 2, record.ChunksCompleted)
+
+	// Rows saved before the counts existed say so
+	_, err = db.DB().ExecContext(ctx, "UPDATE plans SET chunks_total = NULL, chunks_completed = NULL")
+	require.NoError(t, err)
+	records, err := repo.List(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.False(t, records[0].ChunksIndexed)
 }
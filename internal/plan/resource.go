@@ -0,0 +1,110 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Resource is one entry of a chunk's resource list. Entries written as
+// markdown task items ("[ ] Book chapter 1", "[x] Book chapter 1") carry
+// a checkbox; plain entries do not.
+type Resource struct {
+	Text     string
+	Checkbox bool
+	Done     bool
+}
+
+// ParseResource splits a resource list item into its checkbox state and text.
+func ParseResource(item string) Resource {
+	item = strings.TrimSpace(item)
+	if len(item) < 3 || item[0] != '[' || item[2] != ']' {
+		return Resource{Text: item}
+	}
+
+	switch item[1] {
+	case ' ':
+		return Resource{Text: strings.TrimSpace(item[3:]), Checkbox: true}
+	case 'x', 'X':
+		return Resource{Text: strings.TrimSpace(item[3:]), Checkbox: true, Done: true}
+	default:
+		return Resource{Text: item}
+	}
+}
+
+// String formats the resource as a list item body, with its checkbox if any.
+func (r Resource) String() string {
+	switch {
+	case !r.Checkbox:
+		return r.Text
+	case r.Done:
+		return "[x] " + r.Text
+	default:
+		return "[ ] " + r.Text
+	}
+}
+
+// resourceURLRegex finds a link in resource text, bare or inside a
+// markdown link.
+var resourceURLRegex = regexp.MustCompile(`https?://[^\s<>()\[\]]+`)
+
+// URL returns the first http(s) link in the resource, or "".
+func (r Resource) URL() string {
+	return strings.TrimRight(resourceURLRegex.FindString(r.Text), ".,;:!?")
+}
+
+// ParsedResources returns the chunk's resources with checkbox state.
+func (c *Chunk) ParsedResources() []Resource {
+	resources := make([]Resource, len(c.Resources))
+	for i, item := range c.Resources {
+		resources[i] = ParseResource(item)
+	}
+	return resources
+}
+
+// ResourceProgress counts checked-off resources among those with a checkbox.
+func (c *Chunk) ResourceProgress() (done, total int) {
+	for _, resource := range c.ParsedResources() {
+		if !resource.Checkbox {
+			continue
+		}
+		total++
+		if resource.Done {
+			done++
+		}
+	}
+	return done, total
+}
+
+// Progress returns the chunk's completion between 0.0 and 1.0. Completed
+// chunks count fully; otherwise checked-off resources count toward it.
+func (c *Chunk) Progress() float64 {
+	if c.Status == StatusCompleted {
+		return 1.0
+	}
+	done, total := c.ResourceProgress()
+	if total == 0 {
+		return 0.0
+	}
+	return float64(done) / float64(total)
+}
+
+// ToggleResource flips the checkbox of the resource at index. A resource
+// without a checkbox gains one, checked.
+func (c *Chunk) ToggleResource(index int) error {
+	if index < 0 || index >= len(c.Resources) {
+		return fmt.Errorf("resource %d out of range (chunk %s has %d)", index+1, c.ID, len(c.Resources))
+	}
+
+	resource := ParseResource(c.Resources[index])
+	if resource.Checkbox {
+		resource.Done = !resource.Done
+	} else {
+		resource.Checkbox, resource.Done = true, true
+	}
+	c.Resources[index] = resource.String()
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseResource(t *testing.T) {
+	tests := []struct {
+		item string
+		want Resource
+	}{
+		{"Book chapter 1", Resource{Text: "Book chapter 1"}},
+		{"[ ] Book chapter 1", Resource{Text: "Book chapter 1", Checkbox: true}},
+		{"[x] Book chapter 1", Resource{Text: "Book chapter 1", Checkbox: true, Done: true}},
+		{"[X] Book chapter 1", Resource{Text: "Book chapter 1", Checkbox: true, Done: true}},
+		{"[Rust Book](https://doc.rust-lang.org/book/)", Resource{Text: "[Rust Book](https://doc.rust-lang.org/book/)"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.item, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseResource(tt.item))
+		})
+	}
+}
+
+func TestResource_String(t *testing.T) {
+	assert.Equal(t, "Book", Resource{Text: "Book"}.String())
+	assert.Equal(t, "[ ] Book", Resource{Text: "Book", Checkbox: true}.String())
+	assert.Equal(t, "[x] Book", Resource{Text: "Book", Checkbox: true, Done: true}.String())
+}
+
+func TestChunk_ResourceProgress(t *testing.T) {
+	chunk := Chunk{
+		ID:        "chunk-001",
+		Status:    StatusInProgress,
+		Resources: []string{"[x] Book", "[ ] Video", "[ ] Exercises", "Reference docs"},
+	}
+
+	done, total := chunk.ResourceProgress()
+	assert.Equal(t, 1, done)
+	assert.Equal(t, 3, total)
+	assert.InDelta(t, 1.0/3.0, chunk.Progress(), 0.001)
+
+	chunk.Status = StatusCompleted
+	assert.Equal(t, 1.0, chunk.Progress())
+
+	plain := Chunk{ID: "chunk-002", Status: StatusInProgress, Resources: []string{"Book"}}
+	assert.Equal(t, 0.0, plain.Progress())
+}
+
+func TestChunk_ToggleResource(t *testing.T) {
+	chunk := Chunk{ID: "chunk-001", Resources: []string{"[ ] Book", "Video"}}
+
+	require.NoError(t, chunk.ToggleResource(0))
+	assert.Equal(t, "[x] Book", chunk.Resources[0])
+	require.NoError(t, chunk.ToggleResource(0))
+	assert.Equal(t, "[ ] Book", chunk.Resources[0])
+
+	require.NoError(t, chunk.ToggleResource(1))
+	assert.Equal(t, "[x] Video", chunk.Resources[1])
+
+	assert.ErrorContains(t, chunk.ToggleResource(2), "out of range")
+}
+
+func TestResource_URL(t *testing.T) {
+	tests := map[string]string{
+		"[Rust Book](https://doc.rust-lang.org/book/)":  "https://doc.rust-lang.org/book/",
+		"Go tour: https://go.dev/tour.":                 "https://go.dev/tour",
+		"<http://example.com/a?b=c>":                    "http://example.com/a?b=c",
+		"The Book ch. 16":                               "",
+		"[x] Notes at https://example.com/notes, later": "https://example.com/notes",
+	}
+	for text, want := range tests {
+		assert.Equal(t, want, ParseResource(text).URL(), text)
+	}
+}
@@ -6,13 +6,16 @@ package plan
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"math"
 	"os"
-	"regexp"
 	"strings"
 	"text/template"
 	"time"
 
+	"github.com/pezware/samedi.dev/internal/events"
 	"github.com/pezware/samedi.dev/internal/llm"
 	"github.com/pezware/samedi.dev/internal/session"
 	"github.com/pezware/samedi.dev/internal/storage"
@@ -28,6 +31,10 @@ type Service struct {
 	fs             *storage.FilesystemStorage
 	paths          *storage.Paths
 	sessionService *session.Service // Optional - for session integration
+	generator      Provenance       // Provider and model recorded on generated plans
+	hoursSource    string           // HoursSourceChunks or HoursSourcePlan
+	events         events.Emitter   // Optional - notified when chunks and plans are completed
+	repairAttempts int              // Times Create asks the LLM to fix output that fails to parse
 }
 
 // NewService creates a new plan service with all required dependencies.
@@ -44,6 +51,8 @@ func NewService(
 		llmProvider:    llmProvider,
 		fs:             fs,
 		paths:          paths,
+		hoursSource:    HoursSourceChunks,
+		repairAttempts: DefaultRepairAttempts,
 	}
 }
 
@@ -53,13 +62,34 @@ func (s *Service) SetSessionService(sessionService *session.Service) {
 	s.sessionService = sessionService
 }
 
+// SetGenerator records which provider and model the LLM provider uses, so
+// generated plans carry their provenance.
+func (s *Service) SetGenerator(provider, model string) {
+	s.generator = Provenance{Provider: provider, Model: model}
+}
+
+// SetHoursSource chooses whether total_hours follows chunk durations on
+// save (HoursSourceChunks) or stays as written (HoursSourcePlan).
+func (s *Service) SetHoursSource(source string) {
+	s.hoursSource = source
+}
+
+// SetEmitter sets where chunk and plan completed events are sent.
+func (s *Service) SetEmitter(emitter events.Emitter) {
+	s.events = emitter
+}
+
 // CreateRequest contains parameters for creating a new plan.
 type CreateRequest struct {
-	Topic      string
-	TotalHours float64
-	Level      string // beginner, intermediate, advanced
-	Goals      string // Optional specific goals
-	Debug      bool   // If true, log full prompt and response
+	Topic        string
+	TotalHours   float64
+	Level        string   // beginner, intermediate, advanced
+	Goals        string   // Optional specific goals
+	Tags         []string // Optional tags, added to any the LLM suggests
+	Deadline     *Date    // Optional date to finish by
+	Style        string   // Optional teaching style, e.g. "project-based"
+	Instructions string   // Optional free-form instructions added to the prompt
+	Debug        bool     // If true, log full prompt and response
 }
 
 // Create generates a new learning plan using LLM and saves it to both stores.
@@ -70,16 +100,11 @@ func (s *Service) Create(ctx context.Context, req CreateRequest) (*Plan, error)
 		return nil, fmt.Errorf("invalid request: %w", err)
 	}
 
-	// Generate plan ID (slug) from topic
-	planID := slugify(req.Topic)
-
-	// Check if plan already exists
-	if s.filesystemRepo.Exists(ctx, planID) {
-		return nil, fmt.Errorf("plan already exists: %s", planID)
-	}
+	// Generate plan ID (slug) from topic, numbered if the topic was used
+	planID := s.uniquePlanID(ctx, slugify(req.Topic))
 
 	// Load and render template
-	prompt, err := s.renderTemplate(req, planID)
+	prompt, templateVersion, err := s.renderTemplate(req, planID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to render template: %w", err)
 	}
@@ -90,62 +115,119 @@ func (s *Service) Create(ctx context.Context, req CreateRequest) (*Plan, error)
 		fmt.Fprintf(os.Stderr, "---BEGIN PROMPT---\n%s\n---END PROMPT---\n\n", prompt)
 	}
 
-	// Call LLM to generate plan
-	llmOutput, err := s.llmProvider.Call(ctx, prompt)
+	// Call LLM to generate plan (tagged for the cost ledger), asking it to
+	// fix replies that don't parse
+	plan, err := s.generate(ctx, llm.OperationPlanGenerate, req, planID, prompt)
 	if err != nil {
-		return nil, fmt.Errorf("LLM call failed: %w", err)
+		return nil, err
 	}
 
-	// Debug: Show raw LLM response
-	if req.Debug {
-		fmt.Fprintf(os.Stderr, "→ DEBUG: Raw LLM response (%d chars):\n", len(llmOutput))
-		fmt.Fprintf(os.Stderr, "---BEGIN RESPONSE---\n%s\n---END RESPONSE---\n\n", llmOutput)
+	plan.Tags = dedupeTags(append(plan.Tags, req.Tags...))
+	plan.Deadline = req.Deadline
+
+	// Record what generated the plan, including any prompt customization
+	provenance := s.generator
+	provenance.TemplateVersion = templateVersion
+	provenance.Style = req.Style
+	provenance.Instructions = strings.TrimSpace(req.Instructions)
+	plan.Provenance = &provenance
+
+	if err := s.store(ctx, plan); err != nil {
+		return nil, err
 	}
 
-	// Clean LLM output (strip markdown code fences, etc.)
-	cleanedOutput := cleanLLMOutput(llmOutput)
+	return plan, nil
+}
 
-	// Debug: Show cleaned output if different
-	if req.Debug && cleanedOutput != llmOutput {
-		fmt.Fprintf(os.Stderr, "→ DEBUG: Cleaned output (%d chars, removed %d chars):\n",
-			len(cleanedOutput), len(llmOutput)-len(cleanedOutput))
-		fmt.Fprintf(os.Stderr, "---BEGIN CLEANED---\n%s\n---END CLEANED---\n\n", cleanedOutput)
+// scaffoldChunkMinutes is the length of each placeholder chunk in a
+// scaffolded plan.
+const scaffoldChunkMinutes = 60
+
+// Scaffold creates a plan skeleton without calling the LLM: hour-long
+// placeholder chunks covering req.TotalHours, for the learner to fill in.
+// It is the fallback when no real LLM provider is available.
+func (s *Service) Scaffold(ctx context.Context, req CreateRequest) (*Plan, error) {
+	if err := s.validateCreateRequest(req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
 	}
 
-	// Parse LLM output into Plan struct
-	plan, err := Parse(cleanedOutput)
-	if err != nil {
-		// Show preview of output to help debug parsing issues
-		preview := cleanedOutput
-		if len(preview) > 200 {
-			preview = preview[:200] + "..."
-		}
-		return nil, fmt.Errorf("failed to parse LLM output: %w\nOutput preview: %s", err, preview)
+	planID := s.uniquePlanID(ctx, slugify(req.Topic))
+
+	now := time.Now()
+	plan := &Plan{
+		ID:         planID,
+		Title:      strings.TrimSpace(req.Topic),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		TotalHours: req.TotalHours,
+		Status:     StatusNotStarted,
+		Tags:       dedupeTags(req.Tags),
+		Deadline:   req.Deadline,
 	}
 
-	// Ensure plan ID matches
-	plan.ID = planID
+	remaining := int(math.Round(req.TotalHours * 60))
+	for i := 1; remaining > 0; i++ {
+		duration := scaffoldChunkMinutes
+		if remaining < duration {
+			duration = remaining
+		}
+		remaining -= duration
+
+		plan.Chunks = append(plan.Chunks, Chunk{
+			ID:          fmt.Sprintf("chunk-%03d", i),
+			Title:       fmt.Sprintf("Session %d", i),
+			Duration:    duration,
+			Status:      StatusNotStarted,
+			Objectives:  []string{"TODO: describe what this session covers"},
+			Deliverable: "TODO",
+		})
+	}
 
-	// Validate generated plan
 	if err := plan.Validate(); err != nil {
-		return nil, fmt.Errorf("generated plan is invalid: %w", err)
+		return nil, fmt.Errorf("scaffolded plan is invalid: %w", err)
 	}
 
+	if err := s.store(ctx, plan); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// store saves a new plan file and indexes it, removing the file again if
+// indexing fails.
+func (s *Service) store(ctx context.Context, plan *Plan) error {
 	// Save to filesystem
 	if err := s.filesystemRepo.Save(ctx, plan); err != nil {
-		return nil, fmt.Errorf("failed to save plan file: %w", err)
+		return fmt.Errorf("failed to save plan file: %w", err)
 	}
 
 	// Index in SQLite
-	record := ToRecord(plan, s.filesystemRepo.Path(planID))
-	if err := s.sqliteRepo.Upsert(ctx, record); err != nil {
+	if err := s.index(ctx, plan); err != nil {
 		// Rollback: delete the file we just created
 		// We ignore the delete error since the primary error is more important
-		_ = s.filesystemRepo.Delete(ctx, planID) //nolint:errcheck
-		return nil, fmt.Errorf("failed to index plan: %w", err)
+		_ = s.filesystemRepo.Delete(ctx, plan.ID) //nolint:errcheck
+		return fmt.Errorf("failed to index plan: %w", err)
 	}
 
-	return plan, nil
+	return s.recordVersion(ctx, plan.ID)
+}
+
+// index saves plan's metadata and chunks in SQLite.
+func (s *Service) index(ctx context.Context, plan *Plan) error {
+	if err := s.sqliteRepo.Upsert(ctx, ToRecord(plan, s.filesystemRepo.Path(plan.ID))); err != nil {
+		return err
+	}
+	if err := s.sqliteRepo.SaveChunks(ctx, plan.ID, plan.Chunks); err != nil {
+		return err
+	}
+	return s.sqliteRepo.SaveResources(ctx, plan.ID, ReadingList(plan))
+}
+
+// ListChunks returns chunks across plans from the SQLite index, without
+// reading any markdown.
+func (s *Service) ListChunks(ctx context.Context, filter *ChunkFilter) ([]*IndexedChunk, error) {
+	return s.sqliteRepo.ListChunks(ctx, filter)
 }
 
 // Get retrieves a plan by ID from filesystem.
@@ -159,6 +241,12 @@ func (s *Service) Get(ctx context.Context, id string) (*Plan, error) {
 	return plan, nil
 }
 
+// GetWithWarnings retrieves a plan and reports markdown the parser skipped,
+// such as malformed chunk headers or unknown fields.
+func (s *Service) GetWithWarnings(ctx context.Context, id string) (*Plan, []Warning, error) {
+	return s.filesystemRepo.LoadWithWarnings(ctx, id)
+}
+
 // Update saves changes to an existing plan in both stores.
 // This implements FR-002 (Manual Plan Editing) from the specifications.
 func (s *Service) Update(ctx context.Context, plan *Plan) error {
@@ -169,7 +257,14 @@ func (s *Service) Update(ctx context.Context, plan *Plan) error {
 
 	// Check if plan exists
 	if !s.filesystemRepo.Exists(ctx, plan.ID) {
-		return fmt.Errorf("plan not found: %s", plan.ID)
+		return fmt.Errorf("%w: %s", ErrPlanNotFound, plan.ID)
+	}
+	if err := s.checkSubPlans(ctx, plan); err != nil {
+		return fmt.Errorf("invalid plan: %w", err)
+	}
+
+	if s.hoursSource == HoursSourceChunks {
+		plan.RecalcTotalHours()
 	}
 
 	// Update timestamp
@@ -181,27 +276,55 @@ func (s *Service) Update(ctx context.Context, plan *Plan) error {
 	}
 
 	// Update SQLite metadata
-	record := ToRecord(plan, s.filesystemRepo.Path(plan.ID))
-	if err := s.sqliteRepo.Upsert(ctx, record); err != nil {
+	if err := s.index(ctx, plan); err != nil {
 		// Note: We don't rollback the file write here since the file was already updated
 		// This is an acceptable tradeoff - the SQLite index can be rebuilt
 		return fmt.Errorf("failed to update plan index: %w", err)
 	}
 
-	return nil
+	// Snapshot the saved file for plan history
+	return s.recordVersion(ctx, plan.ID)
+}
+
+// RecalcHours sets a plan's total hours from its chunk durations and saves
+// it, regardless of the configured hours source. It returns the plan and
+// its total hours before the change.
+func (s *Service) RecalcHours(ctx context.Context, id string) (*Plan, float64, error) {
+	plan, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	previous := plan.TotalHours
+	if !plan.RecalcTotalHours() {
+		return plan, previous, nil
+	}
+
+	if err := s.Update(ctx, plan); err != nil {
+		return nil, 0, err
+	}
+	return plan, previous, nil
 }
 
 // Delete removes a plan from both filesystem and SQLite.
 func (s *Service) Delete(ctx context.Context, id string) error {
 	// Check if plan exists
 	if !s.filesystemRepo.Exists(ctx, id) {
-		return fmt.Errorf("plan not found: %s", id)
+		return fmt.Errorf("%w: %s", ErrPlanNotFound, id)
+	}
+
+	// Drop references from parent plans while the plan still exists
+	if err := s.detachFromParents(ctx, id); err != nil {
+		return err
 	}
 
 	// Delete from SQLite first (less critical if it fails)
 	if err := s.sqliteRepo.Delete(ctx, id); err != nil {
 		return fmt.Errorf("failed to delete from index: %w", err)
 	}
+	if err := s.sqliteRepo.DeleteVersions(ctx, id); err != nil {
+		return err
+	}
 
 	// Delete from filesystem
 	if err := s.filesystemRepo.Delete(ctx, id); err != nil {
@@ -213,6 +336,246 @@ func (s *Service) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// RefreshIndex re-syncs a plan's SQLite metadata with its markdown file.
+// If the file exists it is re-read and upserted; if it is gone the index
+// entry is removed. Used after external changes such as a sync pull.
+func (s *Service) RefreshIndex(ctx context.Context, id string) error {
+	if !s.filesystemRepo.Exists(ctx, id) {
+		records, err := s.sqliteRepo.List(ctx, &storage.PlanFilter{IDs: []string{id}})
+		if err != nil {
+			return fmt.Errorf("failed to check plan index: %w", err)
+		}
+		if len(records) == 0 {
+			return nil // Nothing indexed, nothing to remove
+		}
+		if err := s.sqliteRepo.Delete(ctx, id); err != nil {
+			return fmt.Errorf("failed to remove plan from index: %w", err)
+		}
+		return nil
+	}
+
+	plan, err := s.filesystemRepo.Load(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to load plan %s: %w", id, err)
+	}
+
+	if err := s.index(ctx, plan); err != nil {
+		return fmt.Errorf("failed to update plan index: %w", err)
+	}
+
+	// External edits become versions too
+	return s.recordVersion(ctx, id)
+}
+
+// ReindexResult summarizes how Reindex reconciled the SQLite index.
+type ReindexResult struct {
+	Added     []string       `json:"added"`     // Plans indexed for the first time
+	Updated   []string       `json:"updated"`   // Plans whose metadata drifted from markdown
+	Removed   []string       `json:"removed"`   // Index entries with no markdown file
+	Unchanged []string       `json:"unchanged"` // Plans already in sync
+	Failed    []ReindexError `json:"failed"`    // Plans that could not be parsed
+}
+
+// ReindexError records a plan file that could not be indexed.
+type ReindexError struct {
+	ID  string `json:"id"`
+	Err string `json:"error"`
+}
+
+// Changed returns the number of index entries that were modified.
+func (r *ReindexResult) Changed() int {
+	return len(r.Added) + len(r.Updated) + len(r.Removed)
+}
+
+// Reindex rebuilds the SQLite plans table from the markdown files in the
+// plans directory: missing plans are inserted, drifted metadata is updated,
+// and entries without a file are removed. Files that fail to parse are
+// reported and their existing index entries are left untouched.
+func (s *Service) Reindex(ctx context.Context) (*ReindexResult, error) {
+	ids, err := s.filesystemRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plan files: %w", err)
+	}
+
+	records, err := s.sqliteRepo.List(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list indexed plans: %w", err)
+	}
+
+	indexed := make(map[string]*storage.PlanRecord, len(records))
+	for _, record := range records {
+		indexed[record.ID] = record
+	}
+
+	result := &ReindexResult{
+		Added:     []string{},
+		Updated:   []string{},
+		Removed:   []string{},
+		Unchanged: []string{},
+		Failed:    []ReindexError{},
+	}
+	onDisk := make(map[string]bool, len(ids))
+
+	for _, id := range ids {
+		onDisk[id] = true
+
+		plan, err := s.filesystemRepo.Load(ctx, id)
+		if err != nil {
+			result.Failed = append(result.Failed, ReindexError{ID: id, Err: err.Error()})
+			continue
+		}
+		if plan.ID != id {
+			result.Failed = append(result.Failed, ReindexError{
+				ID:  id,
+				Err: fmt.Sprintf("frontmatter id %q does not match filename", plan.ID),
+			})
+			continue
+		}
+
+		record := ToRecord(plan, s.filesystemRepo.Path(id))
+		existing, ok := indexed[id]
+		if ok && recordsEqual(existing, record) {
+			chunks, err := s.sqliteRepo.ListChunks(ctx, &ChunkFilter{PlanIDs: []string{id}, IncludeArchived: true})
+			if err != nil {
+				return nil, err
+			}
+			resources, err := s.sqliteRepo.ListResources(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if chunksIndexed(chunks, plan.Chunks) && resourcesIndexed(resources, ReadingList(plan)) {
+				result.Unchanged = append(result.Unchanged, id)
+				continue
+			}
+		}
+
+		if err := s.index(ctx, plan); err != nil {
+			return nil, fmt.Errorf("failed to index plan %s: %w", id, err)
+		}
+		if ok {
+			result.Updated = append(result.Updated, id)
+		} else {
+			result.Added = append(result.Added, id)
+		}
+	}
+
+	for _, record := range records {
+		if onDisk[record.ID] {
+			continue
+		}
+		if err := s.sqliteRepo.Delete(ctx, record.ID); err != nil {
+			return nil, fmt.Errorf("failed to remove orphaned plan %s: %w", record.ID, err)
+		}
+		result.Removed = append(result.Removed, record.ID)
+	}
+
+	return result, nil
+}
+
+// recordsEqual reports whether two plan records hold the same metadata.
+func recordsEqual(a, b *storage.PlanRecord) bool {
+	if a.ID != b.ID || a.Title != b.Title || a.Status != b.Status ||
+		a.TotalHours != b.TotalHours || a.FilePath != b.FilePath ||
+		a.NextChunkID != b.NextChunkID || a.NextChunkTitle != b.NextChunkTitle ||
+		a.Deadline != b.Deadline || a.ChunksIndexed != b.ChunksIndexed ||
+		a.ChunksTotal != b.ChunksTotal || a.ChunksCompleted != b.ChunksCompleted ||
+		a.GeneratedProvider != b.GeneratedProvider || a.GeneratedModel != b.GeneratedModel ||
+		a.TemplateVersion != b.TemplateVersion ||
+		!a.CreatedAt.Equal(b.CreatedAt) || !a.UpdatedAt.Equal(b.UpdatedAt) {
+		return false
+	}
+
+	if len(a.Tags) != len(b.Tags) {
+		return false
+	}
+	for i := range a.Tags {
+		if a.Tags[i] != b.Tags[i] {
+			return false
+		}
+	}
+
+	return equalTags(a.Children, b.Children)
+}
+
+// CheckResult reports problems found in one plan file.
+type CheckResult struct {
+	ID       string    `json:"id"`
+	Warnings []Warning `json:"warnings"`        // Content the parser skipped
+	Err      string    `json:"error,omitempty"` // Set when the plan can't be loaded or is invalid
+}
+
+// OK reports whether the plan parsed cleanly.
+func (r CheckResult) OK() bool {
+	return r.Err == "" && len(r.Warnings) == 0
+}
+
+// Check parses plan files and reports errors and warnings without changing
+// anything. With no IDs, every file in the plans directory is checked.
+func (s *Service) Check(ctx context.Context, ids ...string) ([]CheckResult, error) {
+	if len(ids) == 0 {
+		all, err := s.filesystemRepo.List(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list plan files: %w", err)
+		}
+		ids = all
+	}
+
+	// Sub-plan references are checked against the index, updated with the
+	// files as they are read
+	records, err := s.sqliteRepo.List(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plans: %w", err)
+	}
+	children := ChildrenByPlan(records)
+
+	results := make([]CheckResult, 0, len(ids))
+	for _, id := range ids {
+		result := CheckResult{ID: id, Warnings: []Warning{}}
+
+		plan, warnings, err := s.filesystemRepo.LoadWithWarnings(ctx, id)
+		switch {
+		case err != nil:
+			result.Err = err.Error()
+		case plan.ID != id:
+			result.Err = fmt.Sprintf("frontmatter id %q does not match filename", plan.ID)
+		default:
+			if err := plan.Validate(); err != nil {
+				result.Err = err.Error()
+			}
+		}
+		if warnings != nil {
+			result.Warnings = warnings
+		}
+		if plan != nil && plan.HoursMismatch() {
+			result.Warnings = append(result.Warnings, hoursMismatchWarning(plan))
+		}
+		if plan != nil {
+			children[id] = plan.Children
+		}
+
+		results = append(results, result)
+	}
+
+	for i, result := range results {
+		if result.Err != "" {
+			continue
+		}
+		for _, child := range children[result.ID] {
+			if !s.filesystemRepo.Exists(ctx, child) {
+				results[i].Err = fmt.Sprintf("sub-plan not found: %s", child)
+				break
+			}
+		}
+		if results[i].Err == "" {
+			if path := findCycle(children, result.ID); path != nil {
+				results[i].Err = cycleError(path).Error()
+			}
+		}
+	}
+
+	return results, nil
+}
+
 // List retrieves plan metadata from SQLite with optional filtering.
 func (s *Service) List(ctx context.Context, filter *storage.PlanFilter) ([]*storage.PlanRecord, error) {
 	records, err := s.sqliteRepo.List(ctx, filter)
@@ -263,6 +626,7 @@ func (s *Service) GetRecentSessions(ctx context.Context, planID string, limit in
 			"end_time":   sess.EndTime,
 			"duration":   sess.Duration,
 			"notes":      sess.Notes,
+			"reflection": sess.Reflection,
 			"is_active":  sess.IsActive(),
 		}
 	}
@@ -270,6 +634,26 @@ func (s *Service) GetRecentSessions(ctx context.Context, planID string, limit in
 	return result, nil
 }
 
+// PlanSessions returns every session logged against a plan, for comparing
+// actual time with the plan. It returns nothing when no session service is
+// configured.
+func (s *Service) PlanSessions(ctx context.Context, planID string) ([]session.Session, error) {
+	if s.sessionService == nil {
+		return nil, nil
+	}
+
+	sessions, err := s.sessionService.List(ctx, planID, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sessions: %w", err)
+	}
+
+	result := make([]session.Session, len(sessions))
+	for i := range sessions {
+		result[i] = *sessions[i]
+	}
+	return result, nil
+}
+
 // GetCardCount returns the total number of flashcards for a plan.
 // Stage 4 implementation: Will query CardRepository once flashcards are implemented.
 // Currently returns 0 as placeholder.
@@ -292,6 +676,10 @@ func (s *Service) validateCreateRequest(req CreateRequest) error {
 		return fmt.Errorf("total hours too large (max 1000), got %.1f", req.TotalHours)
 	}
 
+	if req.Deadline != nil && req.Deadline.DaysFrom(time.Now()) < 0 {
+		return fmt.Errorf("deadline %s is in the past", req.Deadline)
+	}
+
 	// Level is optional, but if provided, should be valid
 	if req.Level != "" {
 		validLevels := map[string]bool{
@@ -307,19 +695,42 @@ func (s *Service) validateCreateRequest(req CreateRequest) error {
 	return nil
 }
 
-// renderTemplate loads and renders the plan generation template with request parameters.
-func (s *Service) renderTemplate(req CreateRequest, slug string) (string, error) {
+// instructionsTemplate renders a plan's style and extra instructions, if
+// any, as a prompt section. Plan generation templates place it with
+// {{template "instructions" .}}.
+const instructionsTemplate = `{{if or .Style .Instructions}}
+## Additional Instructions
+
+The learner asked for the following. Follow it where it doesn't conflict
+with the required output format.
+{{if .Style}}
+- **Style**: {{.Style}}
+{{end}}{{if .Instructions}}
+{{.Instructions}}
+{{end}}{{end}}`
+
+// renderTemplate loads and renders the plan generation template with request
+// parameters. It also returns the template version (see TemplateVersion).
+func (s *Service) renderTemplate(req CreateRequest, slug string) (string, string, error) {
 	// Load template file
 	templatePath := s.paths.TemplatePath("plan-generation")
 	content, err := s.fs.ReadFile(templatePath)
 	if err != nil {
-		return "", fmt.Errorf("failed to read template: %w", err)
+		return "", "", fmt.Errorf("failed to read template: %w", err)
 	}
 
-	// Parse template
-	tmpl, err := template.New("plan-generation").Parse(string(content))
+	// Parse template. Templates written before per-plan instructions
+	// existed get them at the end.
+	text := string(content)
+	if !strings.Contains(text, `{{template "instructions"`) {
+		text += "\n" + `{{template "instructions" .}}`
+	}
+	tmpl, err := template.New("plan-generation").Parse(text)
+	if err == nil {
+		_, err = tmpl.New("instructions").Parse(instructionsTemplate)
+	}
 	if err != nil {
-		return "", fmt.Errorf("failed to parse template: %w", err)
+		return "", "", fmt.Errorf("failed to parse template: %w", err)
 	}
 
 	// Set default level if not provided
@@ -336,44 +747,31 @@ func (s *Service) renderTemplate(req CreateRequest, slug string) (string, error)
 
 	// Prepare template data
 	data := map[string]interface{}{
-		"Topic":      req.Topic,
-		"TotalHours": req.TotalHours,
-		"Level":      level,
-		"Goals":      goals,
-		"Slug":       slug,
-		"Now":        time.Now().Format(time.RFC3339),
+		"Topic":        req.Topic,
+		"TotalHours":   req.TotalHours,
+		"Level":        level,
+		"Goals":        goals,
+		"Slug":         slug,
+		"Now":          time.Now().Format(time.RFC3339),
+		"Style":        req.Style,
+		"Instructions": strings.TrimSpace(req.Instructions),
 	}
 
 	// Render template
 	var buf bytes.Buffer
 	if err := tmpl.Execute(&buf, data); err != nil {
-		return "", fmt.Errorf("failed to execute template: %w", err)
+		return "", "", fmt.Errorf("failed to execute template: %w", err)
 	}
 
-	return buf.String(), nil
+	return buf.String(), TemplateVersion(content), nil
 }
 
-// slugify converts a topic string into a filesystem-safe slug.
-// Examples:
-//   - "Rust Async Programming" -> "rust-async-programming"
-//   - "French B1 Mastery" -> "french-b1-mastery"
-//   - "Music Theory (Basics)" -> "music-theory-basics"
-func slugify(s string) string {
-	// Convert to lowercase
-	s = strings.ToLower(s)
-
-	// Replace spaces and special characters with hyphens
-	reg := regexp.MustCompile(`[^a-z0-9]+`)
-	s = reg.ReplaceAllString(s, "-")
-
-	// Trim hyphens from start and end
-	s = strings.Trim(s, "-")
-
-	// Replace multiple consecutive hyphens with single hyphen
-	reg = regexp.MustCompile(`-+`)
-	s = reg.ReplaceAllString(s, "-")
-
-	return s
+// TemplateVersion identifies a prompt template by content: "sha256:"
+// followed by the first 12 hex digits of its hash. Editing the template
+// changes the version.
+func TemplateVersion(content []byte) string {
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:])[:12]
 }
 
 // cleanLLMOutput strips markdown code fences, preamble text, and extra whitespace from LLM output.
@@ -439,7 +837,7 @@ func (s *Service) GetChunk(ctx context.Context, planID, chunkID string) (*Chunk,
 		}
 	}
 
-	return nil, fmt.Errorf("chunk not found: %s in plan %s", chunkID, planID)
+	return nil, fmt.Errorf("%w: %s in plan %s", ErrChunkNotFound, chunkID, planID)
 }
 
 // UpdateChunkStatus updates a chunk's status in the plan and recalculates plan status.
@@ -452,18 +850,20 @@ func (s *Service) UpdateChunkStatus(ctx context.Context, planID, chunkID string,
 	}
 
 	// Find and update the chunk
-	chunkFound := false
+	var chunk *Chunk
 	for i := range plan.Chunks {
 		if plan.Chunks[i].ID == chunkID {
-			plan.Chunks[i].Status = newStatus
-			chunkFound = true
+			chunk = &plan.Chunks[i]
 			break
 		}
 	}
 
-	if !chunkFound {
-		return fmt.Errorf("chunk not found: %s in plan %s", chunkID, planID)
+	if chunk == nil {
+		return fmt.Errorf("%w: %s in plan %s", ErrChunkNotFound, chunkID, planID)
 	}
+	chunkWasCompleted := chunk.Status == StatusCompleted
+	planWasCompleted := plan.Status == StatusCompleted
+	chunk.Status = newStatus
 
 	// Recalculate plan status based on chunks
 	plan.Status = s.inferPlanStatus(plan)
@@ -474,6 +874,85 @@ func (s *Service) UpdateChunkStatus(ctx context.Context, planID, chunkID string,
 		return fmt.Errorf("failed to update plan: %w", err)
 	}
 
+	if newStatus == StatusCompleted && !chunkWasCompleted {
+		s.emitCompleted(ctx, plan, chunk)
+	}
+	if plan.Status == StatusCompleted && !planWasCompleted {
+		s.emitCompleted(ctx, plan, nil)
+	}
+
+	return nil
+}
+
+// UpdatePlanStatus sets a plan's status and saves it, sending a plan
+// completed event when it becomes completed. The next chunk status change
+// infers the plan status from its chunks again.
+func (s *Service) UpdatePlanStatus(ctx context.Context, planID string, newStatus Status) error {
+	plan, err := s.Get(ctx, planID)
+	if err != nil {
+		return fmt.Errorf("failed to load plan: %w", err)
+	}
+
+	wasCompleted := plan.Status == StatusCompleted
+	plan.Status = newStatus
+	if err := s.Update(ctx, plan); err != nil {
+		return fmt.Errorf("failed to update plan: %w", err)
+	}
+
+	if newStatus == StatusCompleted && !wasCompleted {
+		s.emitCompleted(ctx, plan, nil)
+	}
+	return nil
+}
+
+// emitCompleted sends a chunk completed event for chunk, or a plan
+// completed event when chunk is nil.
+func (s *Service) emitCompleted(ctx context.Context, plan *Plan, chunk *Chunk) {
+	if s.events == nil {
+		return
+	}
+	if chunk == nil {
+		e := events.New(events.PlanCompleted, plan.ID)
+		e.Message = fmt.Sprintf("Completed plan %q", plan.Title)
+		e.Data = map[string]any{"title": plan.Title, "total_hours": plan.TotalHours}
+		s.events.Emit(ctx, e)
+		return
+	}
+	e := events.New(events.ChunkCompleted, plan.ID)
+	e.ChunkID = chunk.ID
+	e.Message = fmt.Sprintf("Completed %q in %q", chunk.Title, plan.Title)
+	e.Data = map[string]any{"title": chunk.Title, "plan_title": plan.Title}
+	s.events.Emit(ctx, e)
+}
+
+// ToggleResource checks or unchecks a chunk resource (by zero-based index)
+// and saves the plan.
+func (s *Service) ToggleResource(ctx context.Context, planID, chunkID string, index int) error {
+	plan, err := s.Get(ctx, planID)
+	if err != nil {
+		return fmt.Errorf("failed to load plan: %w", err)
+	}
+
+	var chunk *Chunk
+	for i := range plan.Chunks {
+		if plan.Chunks[i].ID == chunkID {
+			chunk = &plan.Chunks[i]
+			break
+		}
+	}
+	if chunk == nil {
+		return fmt.Errorf("%w: %s in plan %s", ErrChunkNotFound, chunkID, planID)
+	}
+
+	if err := chunk.ToggleResource(index); err != nil {
+		return err
+	}
+	plan.UpdatedAt = time.Now()
+
+	if err := s.Update(ctx, plan); err != nil {
+		return fmt.Errorf("failed to update plan: %w", err)
+	}
+
 	return nil
 }
 
@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/pezware/samedi.dev/internal/events"
 	"github.com/pezware/samedi.dev/internal/storage"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -143,6 +145,10 @@ func TestService_Create_ValidRequest(t *testing.T) {
 	// Verify file exists
 	assert.FileExists(t, paths.PlanPath("test-plan"))
 
+	// Verify provenance was recorded (no generator set on the service)
+	require.NotNil(t, plan.Provenance)
+	assert.Equal(t, TemplateVersion([]byte(mockTemplate)), plan.Provenance.TemplateVersion)
+
 	// Verify LLM was called
 	assert.Len(t, mockLLM.Calls, 1)
 	assert.Contains(t, mockLLM.Calls[0], "Test Plan")
@@ -236,6 +242,48 @@ func TestService_Create_InvalidLevel(t *testing.T) {
 	assert.Contains(t, err.Error(), "invalid level")
 }
 
+func TestService_Create_PastDeadline(t *testing.T) {
+	service, _, _, cleanup := setupTestService(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	yesterday := Date{Time: time.Now().AddDate(0, 0, -1)}
+	req := CreateRequest{
+		Topic:      "Test",
+		TotalHours: 10,
+		Deadline:   &yesterday,
+	}
+
+	_, err := service.Create(ctx, req)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is in the past")
+}
+
+func TestService_Create_Deadline(t *testing.T) {
+	service, mockLLM, _, cleanup := setupTestService(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	mockLLM.CallFunc = func(_ context.Context, _ string) (string, error) {
+		return validPlanMarkdown, nil
+	}
+
+	deadline := Date{Time: time.Now().AddDate(0, 1, 0)}
+	plan, err := service.Create(ctx, CreateRequest{Topic: "Test Plan", TotalHours: 10, Deadline: &deadline})
+	require.NoError(t, err)
+	require.NotNil(t, plan.Deadline)
+
+	loaded, err := service.Get(ctx, "test-plan")
+	require.NoError(t, err)
+	require.NotNil(t, loaded.Deadline)
+	assert.Equal(t, deadline.String(), loaded.Deadline.String())
+
+	record, err := service.GetMetadata(ctx, "test-plan")
+	require.NoError(t, err)
+	assert.Equal(t, deadline.String(), record.Deadline)
+}
+
 func TestService_Create_LLMFailure(t *testing.T) {
 	service, mockLLM, _, cleanup := setupTestService(t)
 	defer cleanup()
@@ -258,7 +306,7 @@ func TestService_Create_LLMFailure(t *testing.T) {
 }
 
 func TestService_Create_InvalidLLMOutput(t *testing.T) {
-	service, mockLLM, _, cleanup := setupTestService(t)
+	service, mockLLM, paths, cleanup := setupTestService(t)
 	defer cleanup()
 
 	ctx := context.Background()
@@ -275,10 +323,58 @@ func TestService_Create_InvalidLLMOutput(t *testing.T) {
 
 	_, err := service.Create(ctx, req)
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "failed to parse LLM output")
+	assert.Contains(t, err.Error(), "failed to parse LLM output after 2 repair attempts")
+	assert.Len(t, mockLLM.Calls, 3, "the first call and two repairs")
+
+	// The last reply is kept for inspection
+	assert.Contains(t, err.Error(), paths.FailedOutputPath("test"))
+	saved, err := os.ReadFile(paths.FailedOutputPath("test"))
+	require.NoError(t, err)
+	assert.Equal(t, "This is not valid plan markdown", string(saved))
 }
 
-func TestService_Create_DuplicatePlan(t *testing.T) {
+func TestService_Create_RepairsLLMOutput(t *testing.T) {
+	service, mockLLM, _, cleanup := setupTestService(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	// The first reply is missing its frontmatter; the repair fixes it
+	mockLLM.CallFunc = func(_ context.Context, _ string) (string, error) {
+		if len(mockLLM.Calls) == 1 {
+			return "## Chunk 1: Basics {#chunk-001}", nil
+		}
+		return validPlanMarkdown, nil
+	}
+
+	plan, err := service.Create(ctx, CreateRequest{Topic: "Test Plan", TotalHours: 10.0})
+	require.NoError(t, err)
+	assert.Equal(t, "test-plan", plan.ID)
+
+	require.Len(t, mockLLM.Calls, 2)
+	repair := mockLLM.Calls[1]
+	assert.Contains(t, repair, "Problems found:")
+	assert.Contains(t, repair, "frontmatter")
+	assert.Contains(t, repair, "## Chunk 1: Basics {#chunk-001}", "the repair includes the broken reply")
+	assert.Contains(t, repair, mockLLM.Calls[0], "and the original request")
+}
+
+func TestService_Create_NoRepairAttempts(t *testing.T) {
+	service, mockLLM, _, cleanup := setupTestService(t)
+	defer cleanup()
+
+	service.SetRepairAttempts(0)
+	mockLLM.CallFunc = func(_ context.Context, _ string) (string, error) {
+		return "This is not valid plan markdown", nil
+	}
+
+	_, err := service.Create(context.Background(), CreateRequest{Topic: "Test", TotalHours: 10.0})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse LLM output:")
+	assert.Len(t, mockLLM.Calls, 1)
+}
+
+func TestService_Create_DuplicateTopic(t *testing.T) {
 	service, mockLLM, _, cleanup := setupTestService(t)
 	defer cleanup()
 
@@ -298,10 +394,139 @@ func TestService_Create_DuplicatePlan(t *testing.T) {
 	_, err := service.Create(ctx, req)
 	require.NoError(t, err)
 
-	// Try to create duplicate
-	_, err = service.Create(ctx, req)
-	require.Error(t, err)
-	assert.Contains(t, err.Error(), "plan already exists")
+	// A second plan on the same topic gets the next free number
+	second, err := service.Create(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, "test-plan-2", second.ID)
+
+	third, err := service.Create(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, "test-plan-3", third.ID)
+}
+
+func TestService_Create_RecordsGenerator(t *testing.T) {
+	service, mockLLM, _, cleanup := setupTestService(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	service.SetGenerator("claude", "sonnet")
+	mockLLM.CallFunc = func(_ context.Context, _ string) (string, error) {
+		return validPlanMarkdown, nil
+	}
+
+	_, err := service.Create(ctx, CreateRequest{Topic: "Test Plan", TotalHours: 10.0})
+	require.NoError(t, err)
+
+	reloaded, err := service.Get(ctx, "test-plan")
+	require.NoError(t, err)
+	require.NotNil(t, reloaded.Provenance)
+	assert.Equal(t, "claude", reloaded.Provenance.Provider)
+	assert.Equal(t, "sonnet", reloaded.Provenance.Model)
+
+	record, err := service.GetMetadata(ctx, "test-plan")
+	require.NoError(t, err)
+	assert.Equal(t, "claude", record.GeneratedProvider)
+	assert.Equal(t, TemplateVersion([]byte(mockTemplate)), record.TemplateVersion)
+}
+
+func TestService_Create_CustomPrompt(t *testing.T) {
+	service, mockLLM, _, cleanup := setupTestService(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	mockLLM.CallFunc = func(_ context.Context, _ string) (string, error) {
+		return validPlanMarkdown, nil
+	}
+
+	_, err := service.Create(ctx, CreateRequest{
+		Topic:        "Test Plan",
+		TotalHours:   10.0,
+		Style:        "project-based",
+		Instructions: "Build one small CLI per chunk.\n",
+	})
+	require.NoError(t, err)
+
+	// The mock template predates instructions, so they are appended
+	prompt := mockLLM.Calls[0]
+	assert.Contains(t, prompt, "## Additional Instructions")
+	assert.Contains(t, prompt, "- **Style**: project-based")
+	assert.Contains(t, prompt, "Build one small CLI per chunk.")
+
+	reloaded, err := service.Get(ctx, "test-plan")
+	require.NoError(t, err)
+	require.NotNil(t, reloaded.Provenance)
+	assert.Equal(t, "project-based", reloaded.Provenance.Style)
+	assert.Equal(t, "Build one small CLI per chunk.", reloaded.Provenance.Instructions)
+}
+
+func TestService_RenderTemplate_Instructions(t *testing.T) {
+	service, _, paths, cleanup := setupTestService(t)
+	defer cleanup()
+
+	// A template that places the instructions itself gets them once
+	placed := "Topic: {{.Topic}}\n{{template \"instructions\" .}}\nEnd\n"
+	require.NoError(t, os.WriteFile(paths.TemplatePath("plan-generation"), []byte(placed), 0o600))
+
+	prompt, _, err := service.renderTemplate(CreateRequest{Topic: "Go", Style: "exam prep"}, "go")
+	require.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(prompt, "## Additional Instructions"))
+	assert.True(t, strings.HasSuffix(prompt, "- **Style**: exam prep\n\nEnd\n"), prompt)
+
+	// Without customization the section is left out
+	prompt, _, err = service.renderTemplate(CreateRequest{Topic: "Go"}, "go")
+	require.NoError(t, err)
+	assert.Equal(t, "Topic: Go\n\nEnd\n", prompt)
+}
+
+func TestService_ToggleResource(t *testing.T) {
+	service, mockLLM, _, cleanup := setupTestService(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	mockLLM.CallFunc = func(_ context.Context, _ string) (string, error) {
+		return validPlanMarkdown, nil
+	}
+	_, err := service.Create(ctx, CreateRequest{Topic: "Test Plan", TotalHours: 10.0})
+	require.NoError(t, err)
+
+	require.NoError(t, service.ToggleResource(ctx, "test-plan", "chunk-001", 0))
+
+	reloaded, err := service.Get(ctx, "test-plan")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"[x] Book chapter 1"}, reloaded.Chunks[0].Resources)
+
+	assert.ErrorIs(t, service.ToggleResource(ctx, "test-plan", "chunk-999", 0), ErrChunkNotFound)
+	assert.ErrorContains(t, service.ToggleResource(ctx, "test-plan", "chunk-001", 5), "out of range")
+}
+
+func TestService_Scaffold(t *testing.T) {
+	service, mockLLM, paths, cleanup := setupTestService(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	plan, err := service.Scaffold(ctx, CreateRequest{Topic: "Rust Async", TotalHours: 2.5})
+	require.NoError(t, err)
+
+	assert.Equal(t, "rust-async", plan.ID)
+	assert.Equal(t, "Rust Async", plan.Title)
+	require.Len(t, plan.Chunks, 3)
+	assert.Equal(t, "chunk-001", plan.Chunks[0].ID)
+	assert.Equal(t, 60, plan.Chunks[0].Duration)
+	assert.Equal(t, 30, plan.Chunks[2].Duration)
+	assert.Equal(t, 150, plan.TotalMinutes())
+	assert.Empty(t, mockLLM.Calls)
+	assert.Nil(t, plan.Provenance)
+
+	assert.FileExists(t, paths.PlanPath("rust-async"))
+	reloaded, err := service.Get(ctx, "rust-async")
+	require.NoError(t, err)
+	assert.Len(t, reloaded.Chunks, 3)
+
+	again, err := service.Scaffold(ctx, CreateRequest{Topic: "Rust Async", TotalHours: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "rust-async-2", again.ID)
+	assert.FileExists(t, paths.PlanPath("rust-async-2"))
 }
 
 func TestService_Get_ExistingPlan(t *testing.T) {
@@ -342,7 +567,7 @@ func TestService_Get_NonexistentPlan(t *testing.T) {
 
 	_, err := service.Get(ctx, "nonexistent")
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "plan not found")
+	assert.ErrorIs(t, err, ErrPlanNotFound)
 }
 
 func TestService_Update_ExistingPlan(t *testing.T) {
@@ -388,6 +613,54 @@ func TestService_Update_ExistingPlan(t *testing.T) {
 	assert.Equal(t, "in-progress", record.Status)
 }
 
+func TestService_Update_RecalculatesHours(t *testing.T) {
+	service, mockLLM, _, cleanup := setupTestService(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	mockLLM.CallFunc = func(_ context.Context, _ string) (string, error) {
+		return validPlanMarkdown, nil
+	}
+
+	plan, err := service.Create(ctx, CreateRequest{Topic: "Test Plan", TotalHours: 10.0})
+	require.NoError(t, err)
+	assert.Equal(t, 10.0, plan.TotalHours, "generated plans keep the requested hours")
+
+	require.NoError(t, service.Update(ctx, plan))
+	assert.Equal(t, 1.0, plan.TotalHours)
+
+	record, err := service.GetMetadata(ctx, "test-plan")
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, record.TotalHours)
+}
+
+func TestService_Update_PlanHoursSource(t *testing.T) {
+	service, mockLLM, _, cleanup := setupTestService(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	mockLLM.CallFunc = func(_ context.Context, _ string) (string, error) {
+		return validPlanMarkdown, nil
+	}
+	service.SetHoursSource(HoursSourcePlan)
+
+	plan, err := service.Create(ctx, CreateRequest{Topic: "Test Plan", TotalHours: 10.0})
+	require.NoError(t, err)
+
+	require.NoError(t, service.Update(ctx, plan))
+	assert.Equal(t, 10.0, plan.TotalHours)
+
+	// RecalcHours applies regardless of the source
+	recalculated, previous, err := service.RecalcHours(ctx, "test-plan")
+	require.NoError(t, err)
+	assert.Equal(t, 10.0, previous)
+	assert.Equal(t, 1.0, recalculated.TotalHours)
+
+	reloaded, err := service.Get(ctx, "test-plan")
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, reloaded.TotalHours)
+}
+
 func TestService_Update_InvalidPlan(t *testing.T) {
 	service, mockLLM, _, cleanup := setupTestService(t)
 	defer cleanup()
@@ -435,7 +708,7 @@ func TestService_Update_NonexistentPlan(t *testing.T) {
 
 	err := service.Update(ctx, plan)
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "plan not found")
+	assert.ErrorIs(t, err, ErrPlanNotFound)
 }
 
 func TestService_Delete_ExistingPlan(t *testing.T) {
@@ -482,7 +755,7 @@ func TestService_Delete_NonexistentPlan(t *testing.T) {
 
 	err := service.Delete(ctx, "nonexistent")
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "plan not found")
+	assert.ErrorIs(t, err, ErrPlanNotFound)
 }
 
 func TestService_List_EmptyResults(t *testing.T) {
@@ -634,7 +907,32 @@ func TestSlugify(t *testing.T) {
 		{
 			name:     "unicode characters",
 			input:    "Café français",
-			expected: "caf-fran-ais",
+			expected: "cafe-francais",
+		},
+		{
+			name:     "letters without a decomposition",
+			input:    "Straße & Œuvre",
+			expected: "strasse-oeuvre",
+		},
+		{
+			name:     "cyrillic",
+			input:    "Русский язык",
+			expected: "russkii-yazyk",
+		},
+		{
+			name:     "accented greek",
+			input:    "Ελληνική γλώσσα",
+			expected: "elliniki-glossa",
+		},
+		{
+			name:     "scripts without transliteration are kept",
+			input:    "日本語 文法",
+			expected: "日本語-文法",
+		},
+		{
+			name:     "no letters",
+			input:    "!!!",
+			expected: "plan",
 		},
 	}
 
@@ -645,3 +943,220 @@ func TestSlugify(t *testing.T) {
 		})
 	}
 }
+
+func TestService_RefreshIndex(t *testing.T) {
+	service, _, paths, cleanup := setupTestService(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	// A plan file written outside the service (e.g., pulled by sync) is not indexed yet
+	require.NoError(t, os.WriteFile(paths.PlanPath("test-plan"), []byte(validPlanMarkdown), 0o600))
+	_, err := service.GetMetadata(ctx, "test-plan")
+	require.Error(t, err)
+
+	require.NoError(t, service.RefreshIndex(ctx, "test-plan"))
+	record, err := service.GetMetadata(ctx, "test-plan")
+	require.NoError(t, err)
+	assert.Equal(t, "Test Plan", record.Title)
+
+	// Removing the file drops the index entry
+	require.NoError(t, os.Remove(paths.PlanPath("test-plan")))
+	require.NoError(t, service.RefreshIndex(ctx, "test-plan"))
+	_, err = service.GetMetadata(ctx, "test-plan")
+	require.Error(t, err)
+
+	// Refreshing an unknown plan is a no-op
+	assert.NoError(t, service.RefreshIndex(ctx, "never-existed"))
+}
+
+func TestService_Reindex(t *testing.T) {
+	service, _, paths, cleanup := setupTestService(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	writePlan := func(id, content string) {
+		require.NoError(t, os.WriteFile(paths.PlanPath(id), []byte(content), 0o600))
+	}
+	planFor := func(id string) string {
+		return strings.Replace(validPlanMarkdown, "id: test-plan", "id: "+id, 1)
+	}
+
+	// Indexed and in sync
+	writePlan("unchanged", planFor("unchanged"))
+	require.NoError(t, service.RefreshIndex(ctx, "unchanged"))
+
+	// Indexed, then edited by hand
+	writePlan("drifted", planFor("drifted"))
+	require.NoError(t, service.RefreshIndex(ctx, "drifted"))
+	writePlan("drifted", strings.Replace(planFor("drifted"), "title: Test Plan", "title: Renamed", 1))
+
+	// Indexed, then the file was deleted
+	writePlan("orphan", planFor("orphan"))
+	require.NoError(t, service.RefreshIndex(ctx, "orphan"))
+	require.NoError(t, os.Remove(paths.PlanPath("orphan")))
+
+	// On disk only
+	writePlan("new", planFor("new"))
+
+	// Unparseable and mismatched files are reported
+	writePlan("broken", "not a plan")
+	writePlan("misnamed", planFor("other-id"))
+
+	result, err := service.Reindex(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"new"}, result.Added)
+	assert.Equal(t, []string{"drifted"}, result.Updated)
+	assert.Equal(t, []string{"orphan"}, result.Removed)
+	assert.Equal(t, []string{"unchanged"}, result.Unchanged)
+	require.Len(t, result.Failed, 2)
+	assert.Equal(t, "broken", result.Failed[0].ID)
+	assert.Equal(t, "misnamed", result.Failed[1].ID)
+	assert.Contains(t, result.Failed[1].Err, "does not match filename")
+	assert.Equal(t, 3, result.Changed())
+
+	record, err := service.GetMetadata(ctx, "drifted")
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", record.Title)
+
+	_, err = service.GetMetadata(ctx, "orphan")
+	assert.Error(t, err)
+
+	// A second pass finds nothing to do
+	result, err = service.Reindex(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Changed())
+	assert.Len(t, result.Unchanged, 3)
+}
+
+func TestRecordsEqual(t *testing.T) {
+	now := time.Now()
+	base := storage.PlanRecord{
+		ID: "rust", Title: "Rust", Status: "in-progress", CreatedAt: now, UpdatedAt: now,
+		GeneratedProvider: "claude", GeneratedModel: "sonnet", TemplateVersion: "sha256:0123456789ab",
+	}
+	same := base
+	assert.True(t, recordsEqual(&base, &same))
+
+	// Provenance edited into the file is picked up by reindex
+	for _, change := range []func(r *storage.PlanRecord){
+		func(r *storage.PlanRecord) { r.GeneratedProvider = "ollama" },
+		func(r *storage.PlanRecord) { r.GeneratedModel = "opus" },
+		func(r *storage.PlanRecord) { r.TemplateVersion = "sha256:fedcba987654" },
+	} {
+		changed := base
+		change(&changed)
+		assert.False(t, recordsEqual(&base, &changed))
+	}
+}
+
+func TestService_Check(t *testing.T) {
+	service, _, paths, cleanup := setupTestService(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	writePlan := func(id, content string) {
+		require.NoError(t, os.WriteFile(paths.PlanPath(id), []byte(content), 0o600))
+	}
+	planFor := func(id string) string {
+		content := strings.Replace(validPlanMarkdown, "id: test-plan", "id: "+id, 1)
+		return strings.Replace(content, "total_hours: 10", "total_hours: 1", 1)
+	}
+
+	writePlan("clean", planFor("clean"))
+	writePlan("sloppy", planFor("sloppy")+"\n## Chunk 2 Oops\n**Duration**: 1 hour\n")
+	writePlan("broken", "not a plan")
+	writePlan("drifted", strings.Replace(planFor("drifted"), "total_hours: 1", "total_hours: 10", 1))
+
+	results, err := service.Check(ctx)
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	byID := make(map[string]CheckResult, len(results))
+	for _, result := range results {
+		byID[result.ID] = result
+	}
+
+	assert.True(t, byID["clean"].OK())
+
+	assert.Empty(t, byID["sloppy"].Err)
+	require.Len(t, byID["sloppy"].Warnings, 1)
+	assert.Contains(t, byID["sloppy"].Warnings[0].Message, "malformed chunk header")
+
+	assert.NotEmpty(t, byID["broken"].Err)
+
+	require.Len(t, byID["drifted"].Warnings, 1)
+	assert.Equal(t, "total_hours is 10 but chunks add up to 1h", byID["drifted"].Warnings[0].String())
+
+	// Specific IDs only
+	results, err = service.Check(ctx, "clean")
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "clean", results[0].ID)
+}
+
+// recordingEmitter collects emitted events.
+type recordingEmitter struct {
+	events []events.Event
+}
+
+func (r *recordingEmitter) Emit(_ context.Context, e events.Event) {
+	r.events = append(r.events, e)
+}
+
+func TestService_UpdateChunkStatus_EmitsCompletions(t *testing.T) {
+	service, mockLLM, _, cleanup := setupTestService(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	mockLLM.CallFunc = func(_ context.Context, _ string) (string, error) {
+		return validPlanMarkdown, nil
+	}
+	_, err := service.Create(ctx, CreateRequest{Topic: "Test Plan", TotalHours: 10.0})
+	require.NoError(t, err)
+
+	emitter := &recordingEmitter{}
+	service.SetEmitter(emitter)
+
+	require.NoError(t, service.UpdateChunkStatus(ctx, "test-plan", "chunk-001", StatusInProgress))
+	assert.Empty(t, emitter.events, "starting a chunk emits nothing")
+
+	require.NoError(t, service.UpdateChunkStatus(ctx, "test-plan", "chunk-001", StatusCompleted))
+	require.Len(t, emitter.events, 2)
+	assert.Equal(t, events.ChunkCompleted, emitter.events[0].Type)
+	assert.Equal(t, "chunk-001", emitter.events[0].ChunkID)
+	assert.Equal(t, events.PlanCompleted, emitter.events[1].Type, "the last chunk completes the plan")
+	assert.Equal(t, "test-plan", emitter.events[1].PlanID)
+
+	require.NoError(t, service.UpdateChunkStatus(ctx, "test-plan", "chunk-001", StatusCompleted))
+	assert.Len(t, emitter.events, 2, "completing again emits nothing")
+}
+
+func TestService_UpdatePlanStatus(t *testing.T) {
+	service, mockLLM, _, cleanup := setupTestService(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	mockLLM.CallFunc = func(_ context.Context, _ string) (string, error) {
+		return validPlanMarkdown, nil
+	}
+	_, err := service.Create(ctx, CreateRequest{Topic: "Test Plan", TotalHours: 10.0})
+	require.NoError(t, err)
+
+	emitter := &recordingEmitter{}
+	service.SetEmitter(emitter)
+
+	require.NoError(t, service.UpdatePlanStatus(ctx, "test-plan", StatusInProgress))
+	record, err := service.GetMetadata(ctx, "test-plan")
+	require.NoError(t, err)
+	assert.Equal(t, string(StatusInProgress), record.Status)
+	assert.Empty(t, emitter.events)
+
+	require.NoError(t, service.UpdatePlanStatus(ctx, "test-plan", StatusCompleted))
+	require.Len(t, emitter.events, 1)
+	assert.Equal(t, events.PlanCompleted, emitter.events[0].Type)
+
+	assert.Error(t, service.UpdatePlanStatus(ctx, "test-plan", Status("paused")))
+	assert.Error(t, service.UpdatePlanStatus(ctx, "missing", StatusCompleted))
+}
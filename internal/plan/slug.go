@@ -0,0 +1,114 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// defaultSlug is the plan ID for topics with no letters or digits at all.
+const defaultSlug = "plan"
+
+// transliterations spell letters in ASCII that don't decompose into an
+// ASCII letter plus accents: a few Latin letters, Greek, and Cyrillic.
+var transliterations = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'đ': "d", 'ð': "d", 'þ': "th", 'ł': "l", 'ı': "i",
+
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th",
+	'ι': "i", 'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p",
+	'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t", 'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ж': "zh", 'з': "z",
+	'и': "i", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r",
+	'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh",
+	'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+	'і': "i", 'є': "ye", 'ґ': "g",
+}
+
+// slugify converts a topic string into a filesystem-safe slug. Accents
+// are dropped and Greek and Cyrillic are transliterated; letters of other
+// scripts, such as CJK, are kept as they are.
+// Examples:
+//   - "Rust Async Programming" -> "rust-async-programming"
+//   - "Music Theory (Basics)" -> "music-theory-basics"
+//   - "Café français" -> "cafe-francais"
+//   - "Русский язык" -> "russkii-yazyk"
+func slugify(s string) string {
+	var slug strings.Builder
+	separate := false
+	for _, r := range norm.NFC.String(strings.ToLower(s)) {
+		part, ok := slugPart(r)
+		if !ok {
+			separate = true
+			continue
+		}
+		if part == "" {
+			continue
+		}
+		if separate && slug.Len() > 0 {
+			slug.WriteByte('-')
+		}
+		separate = false
+		slug.WriteString(part)
+	}
+
+	if slug.Len() == 0 {
+		return defaultSlug
+	}
+	return slug.String()
+}
+
+// slugPart spells r for a slug. It returns false for runes that separate
+// words, such as spaces and punctuation.
+func slugPart(r rune) (string, bool) {
+	if spelled, ok := transliterations[r]; ok {
+		return spelled, true
+	}
+
+	// Accented letters decompose into a base letter and combining marks
+	var base []rune
+	for _, d := range norm.NFKD.String(string(r)) {
+		if !unicode.Is(unicode.Mn, d) {
+			base = append(base, d)
+		}
+	}
+	if len(base) == 1 {
+		if spelled, ok := transliterations[base[0]]; ok {
+			return spelled, true
+		}
+	}
+	if len(base) > 0 && asciiAlnum(base) {
+		return strings.ToLower(string(base)), true
+	}
+
+	if unicode.IsLetter(r) || unicode.IsNumber(r) {
+		return string(r), true
+	}
+	return "", false
+}
+
+func asciiAlnum(runes []rune) bool {
+	for _, r := range runes {
+		if r >= utf8.RuneSelf || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return true
+}
+
+// uniquePlanID returns slug, or slug with the first free numeric suffix
+// ("-2", "-3", ...) when a plan with that ID already exists.
+func (s *Service) uniquePlanID(ctx context.Context, slug string) string {
+	id := slug
+	for n := 2; s.filesystemRepo.Exists(ctx, id); n++ {
+		id = fmt.Sprintf("%s-%d", slug, n)
+	}
+	return id
+}
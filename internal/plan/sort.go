@@ -0,0 +1,240 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+)
+
+// SortField is a column plan lists can be sorted by.
+type SortField string
+
+// Sort fields. Each has a natural direction, used unless one is given:
+// the first four put the most of something first, the rest read as text
+// or a calendar would.
+const (
+	SortProgress SortField = "progress" // Share of chunks completed
+	SortHours    SortField = "hours"    // The hours column of the list
+	SortActivity SortField = "activity" // Last studied
+	SortCreated  SortField = "created"
+	SortUpdated  SortField = "updated"
+	SortDeadline SortField = "deadline" // Soonest first
+	SortTitle    SortField = "title"
+	SortStatus   SortField = "status"
+)
+
+// TableSortFields are the columns the TUI's sort key cycles through, in
+// order.
+var TableSortFields = []SortField{SortProgress, SortHours, SortActivity, SortDeadline}
+
+// sortFields lists every field in the order errors name them.
+var sortFields = []SortField{
+	SortProgress, SortHours, SortActivity, SortDeadline,
+	SortCreated, SortUpdated, SortTitle, SortStatus,
+}
+
+// descending reports whether f sorts from high to low unless told
+// otherwise.
+func (f SortField) descending() bool {
+	switch f {
+	case SortProgress, SortHours, SortActivity, SortCreated, SortUpdated:
+		return true
+	}
+	return false
+}
+
+// ListSort orders a plan list. The zero value leaves the list as stored,
+// newest first.
+type ListSort struct {
+	Field SortField
+	Desc  bool
+}
+
+// ParseListSort parses "field", "field:asc", or "field:desc". A bare
+// field sorts in its natural direction. An empty value is the zero
+// ListSort.
+func ParseListSort(value string) (ListSort, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return ListSort{}, nil
+	}
+
+	name, direction, hasDirection := strings.Cut(value, ":")
+	field := SortField(strings.ToLower(strings.TrimSpace(name)))
+	known := false
+	for _, f := range sortFields {
+		if f == field {
+			known = true
+			break
+		}
+	}
+	if !known {
+		names := make([]string, len(sortFields))
+		for i, f := range sortFields {
+			names[i] = string(f)
+		}
+		return ListSort{}, fmt.Errorf("unknown sort field %q (must be one of %s)", name, strings.Join(names, ", "))
+	}
+
+	s := ListSort{Field: field, Desc: field.descending()}
+	if hasDirection {
+		switch strings.ToLower(strings.TrimSpace(direction)) {
+		case "asc":
+			s.Desc = false
+		case "desc":
+			s.Desc = true
+		default:
+			return ListSort{}, fmt.Errorf("unknown sort direction %q (must be asc or desc)", direction)
+		}
+	}
+	return s, nil
+}
+
+// String returns the sort as ParseListSort reads it, with the direction
+// spelled out, or "" for the zero ListSort.
+func (s ListSort) String() string {
+	if s.Field == "" {
+		return ""
+	}
+	if s.Desc {
+		return string(s.Field) + ":desc"
+	}
+	return string(s.Field) + ":asc"
+}
+
+// Label describes the sort for a list's title, e.g. "hours ↓".
+func (s ListSort) Label() string {
+	if s.Field == "" {
+		return ""
+	}
+	if s.Desc {
+		return string(s.Field) + " ↓"
+	}
+	return string(s.Field) + " ↑"
+}
+
+// Next returns the sort by the column after s in TableSortFields, in its
+// natural direction, and the zero ListSort after the last one.
+func (s ListSort) Next() ListSort {
+	for i, field := range TableSortFields {
+		if field != s.Field {
+			continue
+		}
+		if i+1 == len(TableSortFields) {
+			return ListSort{}
+		}
+		next := TableSortFields[i+1]
+		return ListSort{Field: next, Desc: next.descending()}
+	}
+	first := TableSortFields[0]
+	return ListSort{Field: first, Desc: first.descending()}
+}
+
+// Reversed returns s in the other direction. The zero ListSort has none.
+func (s ListSort) Reversed() ListSort {
+	if s.Field == "" {
+		return s
+	}
+	return ListSort{Field: s.Field, Desc: !s.Desc}
+}
+
+// SortKeys are the values of a plan a ListSort compares.
+type SortKeys struct {
+	Title       string
+	Status      string
+	Created     time.Time
+	Updated     time.Time
+	Hours       float64
+	Progress    float64    // Share of chunks completed, 0-1; negative if unknown
+	LastStudied *time.Time // Nil if never studied
+	Deadline    string     // YYYY-MM-DD; empty without one
+}
+
+// RecordSortKeys returns the sort keys of an indexed plan. Progress is
+// unknown for plans saved before chunk counts were indexed, and for plans
+// without chunks.
+func RecordSortKeys(record *storage.PlanRecord) SortKeys {
+	keys := SortKeys{
+		Title:       record.Title,
+		Status:      record.Status,
+		Created:     record.CreatedAt,
+		Updated:     record.UpdatedAt,
+		Hours:       record.TotalHours,
+		Progress:    -1,
+		LastStudied: record.LastSession,
+		Deadline:    record.Deadline,
+	}
+	if record.ChunksIndexed && record.ChunksTotal > 0 {
+		keys.Progress = float64(record.ChunksCompleted) / float64(record.ChunksTotal)
+	}
+	return keys
+}
+
+// Less reports whether a comes before b. Plans missing the value, such as
+// those never studied when sorting by activity, come last either way.
+func (s ListSort) Less(a, b SortKeys) bool {
+	var aMissing, bMissing bool
+	var cmp int
+	switch s.Field {
+	case SortProgress:
+		aMissing, bMissing = a.Progress < 0, b.Progress < 0
+		cmp = compareFloats(a.Progress, b.Progress)
+	case SortHours:
+		cmp = compareFloats(a.Hours, b.Hours)
+	case SortActivity:
+		aMissing, bMissing = a.LastStudied == nil, b.LastStudied == nil
+		if !aMissing && !bMissing {
+			cmp = a.LastStudied.Compare(*b.LastStudied)
+		}
+	case SortDeadline:
+		aMissing, bMissing = a.Deadline == "", b.Deadline == ""
+		cmp = strings.Compare(a.Deadline, b.Deadline)
+	case SortCreated:
+		cmp = a.Created.Compare(b.Created)
+	case SortUpdated:
+		cmp = a.Updated.Compare(b.Updated)
+	case SortTitle:
+		cmp = strings.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
+	case SortStatus:
+		cmp = strings.Compare(a.Status, b.Status)
+	default:
+		return false
+	}
+
+	if aMissing || bMissing {
+		return !aMissing
+	}
+	if s.Desc {
+		return cmp > 0
+	}
+	return cmp < 0
+}
+
+// compareFloats returns -1, 0, or 1 as a is less than, equal to, or
+// greater than b.
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// SortRecords orders records by s using RecordSortKeys. Plans comparing
+// equal keep their order; the zero ListSort changes nothing.
+func SortRecords(records []*storage.PlanRecord, s ListSort) {
+	if s.Field == "" {
+		return
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return s.Less(RecordSortKeys(records[i]), RecordSortKeys(records[j]))
+	})
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseListSort(t *testing.T) {
+	s, err := ParseListSort("hours")
+	require.NoError(t, err)
+	assert.Equal(t, ListSort{Field: SortHours, Desc: true}, s, "most hours first by default")
+
+	s, err = ParseListSort("Deadline")
+	require.NoError(t, err)
+	assert.Equal(t, ListSort{Field: SortDeadline}, s, "soonest first by default")
+
+	s, err = ParseListSort("progress:asc")
+	require.NoError(t, err)
+	assert.Equal(t, "progress:asc", s.String())
+
+	s, err = ParseListSort("")
+	require.NoError(t, err)
+	assert.Empty(t, s.String())
+
+	_, err = ParseListSort("size")
+	assert.ErrorContains(t, err, "unknown sort field")
+	_, err = ParseListSort("hours:up")
+	assert.ErrorContains(t, err, "unknown sort direction")
+}
+
+func TestListSort_NextAndReversed(t *testing.T) {
+	var s ListSort
+	var seen []string
+	for i := 0; i < len(TableSortFields)+1; i++ {
+		s = s.Next()
+		seen = append(seen, s.String())
+	}
+	assert.Equal(t, []string{"progress:desc", "hours:desc", "activity:desc", "deadline:asc", ""}, seen)
+
+	assert.Equal(t, "hours:asc", ListSort{Field: SortHours, Desc: true}.Reversed().String())
+	assert.Equal(t, ListSort{}, ListSort{}.Reversed())
+	assert.Equal(t, "deadline ↑", ListSort{Field: SortDeadline}.Label())
+}
+
+func TestSortRecords(t *testing.T) {
+	studied := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	earlier := studied.Add(-48 * time.Hour)
+	records := []*storage.PlanRecord{
+		{ID: "new", TotalHours: 1},
+		{ID: "half", TotalHours: 5, ChunksIndexed: true, ChunksTotal: 4, ChunksCompleted: 2, LastSession: &earlier, Deadline: "2025-09-01"},
+		{ID: "done", TotalHours: 3, ChunksIndexed: true, ChunksTotal: 2, ChunksCompleted: 2, LastSession: &studied, Deadline: "2025-07-01"},
+	}
+	ids := func() []string {
+		out := make([]string, len(records))
+		for i, record := range records {
+			out[i] = record.ID
+		}
+		return out
+	}
+
+	SortRecords(records, ListSort{Field: SortHours, Desc: true})
+	assert.Equal(t, []string{"half", "done", "new"}, ids())
+
+	SortRecords(records, ListSort{Field: SortProgress, Desc: true})
+	assert.Equal(t, []string{"done", "half", "new"}, ids())
+
+	// Plans missing the value stay last in either direction
+	SortRecords(records, ListSort{Field: SortProgress})
+	assert.Equal(t, []string{"half", "done", "new"}, ids())
+	SortRecords(records, ListSort{Field: SortActivity, Desc: true})
+	assert.Equal(t, []string{"done", "half", "new"}, ids())
+	SortRecords(records, ListSort{Field: SortDeadline, Desc: true})
+	assert.Equal(t, []string{"half", "done", "new"}, ids())
+
+	SortRecords(records, ListSort{})
+	assert.Equal(t, []string{"half", "done", "new"}, ids(), "the zero sort changes nothing")
+}
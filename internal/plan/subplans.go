@@ -0,0 +1,244 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+)
+
+// ErrSubPlanCycle is returned when sub-plan references would make a plan
+// its own ancestor.
+var ErrSubPlanCycle = errors.New("circular sub-plan reference")
+
+// ChildrenByPlan maps each indexed plan ID to its sub-plan IDs.
+func ChildrenByPlan(records []*storage.PlanRecord) map[string][]string {
+	children := make(map[string][]string, len(records))
+	for _, record := range records {
+		children[record.ID] = record.Children
+	}
+	return children
+}
+
+// Descendants returns every plan under id, depth first in frontmatter
+// order, each once. id itself is not included, even through a cycle.
+func Descendants(children map[string][]string, id string) []string {
+	seen := map[string]bool{id: true}
+	var result []string
+	var walk func(string)
+	walk = func(parent string) {
+		for _, child := range children[parent] {
+			if seen[child] {
+				continue
+			}
+			seen[child] = true
+			result = append(result, child)
+			walk(child)
+		}
+	}
+	walk(id)
+	return result
+}
+
+// Parents returns the IDs of plans that list id as a sub-plan.
+func Parents(records []*storage.PlanRecord, id string) []string {
+	var parents []string
+	for _, record := range records {
+		for _, child := range record.Children {
+			if child == id {
+				parents = append(parents, record.ID)
+				break
+			}
+		}
+	}
+	return parents
+}
+
+// findCycle returns a path of sub-plan references that leads from start
+// back to start, or nil if there is none.
+func findCycle(children map[string][]string, start string) []string {
+	// visited covers plans on the current path too, so cycles that do not
+	// pass through start are not walked forever
+	visited := make(map[string]bool)
+	var path []string
+	var walk func(string) bool
+	walk = func(id string) bool {
+		visited[id] = true
+		path = append(path, id)
+		for _, child := range children[id] {
+			if child == start {
+				path = append(path, child)
+				return true
+			}
+			if !visited[child] && walk(child) {
+				return true
+			}
+		}
+		path = path[:len(path)-1]
+		return false
+	}
+	if walk(start) {
+		return path
+	}
+	return nil
+}
+
+// cycleError describes a cycle found by findCycle.
+func cycleError(path []string) error {
+	return fmt.Errorf("%w: %s", ErrSubPlanCycle, strings.Join(path, " → "))
+}
+
+// checkSubPlans verifies that p's sub-plans exist and that none of them
+// leads back to p.
+func (s *Service) checkSubPlans(ctx context.Context, p *Plan) error {
+	if len(p.Children) == 0 {
+		return nil
+	}
+	for _, child := range p.Children {
+		if !s.filesystemRepo.Exists(ctx, child) {
+			return fmt.Errorf("sub-plan not found: %s", child)
+		}
+	}
+
+	records, err := s.sqliteRepo.List(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list plans: %w", err)
+	}
+	children := ChildrenByPlan(records)
+	children[p.ID] = p.Children
+	if path := findCycle(children, p.ID); path != nil {
+		return cycleError(path)
+	}
+	return nil
+}
+
+// AddSubPlan makes childID a sub-plan of parentID. Adding an existing
+// sub-plan is a no-op.
+func (s *Service) AddSubPlan(ctx context.Context, parentID, childID string) (*Plan, error) {
+	parent, err := s.Get(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+	for _, child := range parent.Children {
+		if child == childID {
+			return parent, nil
+		}
+	}
+
+	parent.Children = append(parent.Children, childID)
+	if err := s.Update(ctx, parent); err != nil {
+		return nil, err
+	}
+	return parent, nil
+}
+
+// RemoveSubPlan detaches childID from parentID. The child plan itself is
+// left alone.
+func (s *Service) RemoveSubPlan(ctx context.Context, parentID, childID string) (*Plan, error) {
+	parent, err := s.Get(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	kept := make([]string, 0, len(parent.Children))
+	for _, child := range parent.Children {
+		if child != childID {
+			kept = append(kept, child)
+		}
+	}
+	if len(kept) == len(parent.Children) {
+		return nil, fmt.Errorf("%s is not a sub-plan of %s", childID, parentID)
+	}
+
+	parent.Children = kept
+	if err := s.Update(ctx, parent); err != nil {
+		return nil, err
+	}
+	return parent, nil
+}
+
+// detachFromParents removes id from every plan that lists it as a
+// sub-plan, so deleting a plan leaves no dangling references.
+func (s *Service) detachFromParents(ctx context.Context, id string) error {
+	records, err := s.sqliteRepo.List(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list plans: %w", err)
+	}
+	for _, parentID := range Parents(records, id) {
+		if _, err := s.RemoveSubPlan(ctx, parentID, id); err != nil {
+			return fmt.Errorf("failed to detach %s from %s: %w", id, parentID, err)
+		}
+	}
+	return nil
+}
+
+// TreeRow is one line of a plan tree: a plan, its depth, and the
+// box-drawing prefix that connects it to its parent.
+type TreeRow struct {
+	Record *storage.PlanRecord
+	Depth  int
+	Prefix string // "", "├─ ", "│  └─ ", ...
+}
+
+// Tree orders records as a tree: each top-level plan in the given order,
+// followed by its sub-plans in frontmatter order. A plan under several
+// parents appears under each; sub-plans missing from records are skipped,
+// and plans caught in a cycle are listed at the top level.
+func Tree(records []*storage.PlanRecord) []TreeRow {
+	byID := make(map[string]*storage.PlanRecord, len(records))
+	isChild := make(map[string]bool)
+	for _, record := range records {
+		byID[record.ID] = record
+		for _, child := range record.Children {
+			isChild[child] = true
+		}
+	}
+
+	rows := make([]TreeRow, 0, len(records))
+	shown := make(map[string]bool, len(records))
+	var walk func(record *storage.PlanRecord, depth int, indent, branch string, ancestors map[string]bool)
+	walk = func(record *storage.PlanRecord, depth int, indent, branch string, ancestors map[string]bool) {
+		rows = append(rows, TreeRow{Record: record, Depth: depth, Prefix: indent + branch})
+		shown[record.ID] = true
+		ancestors[record.ID] = true
+
+		var children []*storage.PlanRecord
+		for _, id := range record.Children {
+			if child, ok := byID[id]; ok && !ancestors[id] {
+				children = append(children, child)
+			}
+		}
+		if depth > 0 {
+			if branch == "└─ " {
+				indent += "   "
+			} else {
+				indent += "│  "
+			}
+		}
+		for i, child := range children {
+			childBranch := "├─ "
+			if i == len(children)-1 {
+				childBranch = "└─ "
+			}
+			walk(child, depth+1, indent, childBranch, ancestors)
+		}
+		delete(ancestors, record.ID)
+	}
+
+	for _, record := range records {
+		if !isChild[record.ID] {
+			walk(record, 0, "", "", make(map[string]bool))
+		}
+	}
+	for _, record := range records {
+		if !shown[record.ID] {
+			walk(record, 0, "", "", make(map[string]bool))
+		}
+	}
+	return rows
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescendants(t *testing.T) {
+	children := map[string][]string{
+		"backend":    {"go", "sql"},
+		"go":         {"go-concurrency"},
+		"sql":        {"go"}, // go reached twice
+		"loop":       {"loop-child"},
+		"loop-child": {"loop"},
+	}
+
+	assert.Equal(t, []string{"go", "go-concurrency", "sql"}, Descendants(children, "backend"))
+	assert.Equal(t, []string{"loop-child"}, Descendants(children, "loop"), "a cycle does not include the start")
+	assert.Empty(t, Descendants(children, "go-concurrency"))
+}
+
+func TestFindCycle(t *testing.T) {
+	children := map[string][]string{
+		"a": {"b"},
+		"b": {"c"},
+		"c": {"a"},
+		"d": {"b"},
+	}
+	assert.Equal(t, []string{"a", "b", "c", "a"}, findCycle(children, "a"))
+	assert.Nil(t, findCycle(children, "d"), "d leads into a cycle but not back to itself")
+	assert.Nil(t, findCycle(map[string][]string{"a": {"b"}}, "a"))
+}
+
+func TestTree(t *testing.T) {
+	records := []*storage.PlanRecord{
+		{ID: "sql"},
+		{ID: "backend", Children: []string{"go", "sql", "deleted"}},
+		{ID: "go", Children: []string{"go-concurrency"}},
+		{ID: "go-concurrency"},
+		{ID: "french"},
+	}
+
+	var lines []string
+	for _, row := range Tree(records) {
+		lines = append(lines, row.Prefix+row.Record.ID)
+	}
+	assert.Equal(t, []string{
+		"backend",
+		"├─ go",
+		"│  └─ go-concurrency",
+		"└─ sql",
+		"french",
+	}, lines)
+}
+
+func TestTree_CycleStillListsEveryPlan(t *testing.T) {
+	records := []*storage.PlanRecord{
+		{ID: "a", Children: []string{"b"}},
+		{ID: "b", Children: []string{"a"}},
+	}
+
+	rows := Tree(records)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "a", rows[0].Record.ID)
+	assert.Equal(t, "└─ ", rows[1].Prefix)
+}
+
+func TestService_SubPlans(t *testing.T) {
+	service, _, _, cleanup := setupTestService(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	for _, topic := range []string{"Backend", "Go", "SQL"} {
+		_, err := service.Scaffold(ctx, CreateRequest{Topic: topic, TotalHours: 2})
+		require.NoError(t, err)
+	}
+
+	parent, err := service.AddSubPlan(ctx, "backend", "go")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"go"}, parent.Children)
+	_, err = service.AddSubPlan(ctx, "go", "sql")
+	require.NoError(t, err)
+
+	record, err := service.GetMetadata(ctx, "backend")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"go"}, record.Children, "indexed")
+
+	_, err = service.AddSubPlan(ctx, "sql", "backend")
+	assert.ErrorIs(t, err, ErrSubPlanCycle)
+	assert.ErrorContains(t, err, "sql → backend → go → sql")
+
+	_, err = service.AddSubPlan(ctx, "backend", "missing")
+	assert.ErrorContains(t, err, "sub-plan not found: missing")
+
+	_, err = service.AddSubPlan(ctx, "go", "go")
+	assert.ErrorContains(t, err, "its own sub-plan")
+
+	// Deleting a sub-plan detaches it from its parent
+	require.NoError(t, service.Delete(ctx, "sql"))
+	goPlan, err := service.Get(ctx, "go")
+	require.NoError(t, err)
+	assert.Empty(t, goPlan.Children)
+
+	_, err = service.RemoveSubPlan(ctx, "backend", "sql")
+	assert.ErrorContains(t, err, "not a sub-plan")
+	parent, err = service.RemoveSubPlan(ctx, "backend", "go")
+	require.NoError(t, err)
+	assert.Empty(t, parent.Children)
+}
+
+func TestService_CheckReportsSubPlanCycle(t *testing.T) {
+	service, _, paths, cleanup := setupTestService(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	for _, topic := range []string{"A", "B"} {
+		_, err := service.Scaffold(ctx, CreateRequest{Topic: topic, TotalHours: 2})
+		require.NoError(t, err)
+	}
+	_, err := service.AddSubPlan(ctx, "a", "b")
+	require.NoError(t, err)
+
+	// An edit made outside samedi closes the loop
+	b, err := service.Get(ctx, "b")
+	require.NoError(t, err)
+	b.Children = []string{"a"}
+	content, err := Format(b)
+	require.NoError(t, err)
+	require.NoError(t, service.fs.WriteFile(paths.PlanPath("b"), []byte(content)))
+
+	results, err := service.Check(ctx)
+	require.NoError(t, err)
+	for _, result := range results {
+		assert.Contains(t, result.Err, "circular sub-plan reference", result.ID)
+	}
+}
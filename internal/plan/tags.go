@@ -0,0 +1,170 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+)
+
+// TagCount is a tag and the number of plans carrying it.
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Plans int    `json:"plans"`
+}
+
+// ValidateTag checks a tag name. Tags are edited as comma-separated lists,
+// so they cannot contain commas.
+func ValidateTag(tag string) error {
+	if strings.TrimSpace(tag) == "" {
+		return fmt.Errorf("tag cannot be empty")
+	}
+	if strings.Contains(tag, ",") {
+		return fmt.Errorf("tag %q cannot contain a comma", tag)
+	}
+	return nil
+}
+
+// CountTags counts the plans carrying each tag, most used first and then
+// alphabetically, ignoring case. Tags differing only in case are listed separately so
+// they can be merged.
+func CountTags(records []*storage.PlanRecord) []TagCount {
+	counts := make(map[string]int)
+	for _, record := range records {
+		for _, tag := range record.Tags {
+			counts[tag]++
+		}
+	}
+
+	tags := make([]TagCount, 0, len(counts))
+	for tag, n := range counts {
+		tags = append(tags, TagCount{Tag: tag, Plans: n})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if tags[i].Plans != tags[j].Plans {
+			return tags[i].Plans > tags[j].Plans
+		}
+		if a, b := strings.ToLower(tags[i].Tag), strings.ToLower(tags[j].Tag); a != b {
+			return a < b
+		}
+		return tags[i].Tag < tags[j].Tag
+	})
+	return tags
+}
+
+// Tags returns every tag in use, including on archived plans.
+func (s *Service) Tags(ctx context.Context) ([]TagCount, error) {
+	records, err := s.List(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return CountTags(records), nil
+}
+
+// RenameTag renames a tag on every plan carrying it and returns the IDs of
+// the changed plans. Renaming onto another tag already in use is refused;
+// use MergeTags for that. Changing only the case of a tag is allowed.
+func (s *Service) RenameTag(ctx context.Context, from, to string) ([]string, error) {
+	if !strings.EqualFold(from, to) {
+		existing, err := s.List(ctx, &storage.PlanFilter{Tags: []string{to}})
+		if err != nil {
+			return nil, err
+		}
+		if len(existing) > 0 {
+			return nil, fmt.Errorf("tag %q is already used by %d plan(s); merge the tags instead", to, len(existing))
+		}
+	}
+	return s.MergeTags(ctx, []string{from}, to)
+}
+
+// MergeTags replaces the from tags with the to tag on every plan carrying
+// any of them, and returns the IDs of the changed plans. Tags match
+// ignoring case.
+func (s *Service) MergeTags(ctx context.Context, from []string, to string) ([]string, error) {
+	to = strings.TrimSpace(to)
+	if err := ValidateTag(to); err != nil {
+		return nil, err
+	}
+	return s.retag(ctx, from, to)
+}
+
+// DeleteTag removes a tag from every plan and returns the IDs of the
+// changed plans.
+func (s *Service) DeleteTag(ctx context.Context, tag string) ([]string, error) {
+	return s.retag(ctx, []string{tag}, "")
+}
+
+// retag replaces the from tags with to (or drops them when to is empty)
+// and saves each affected plan. It fails if no plan carries any from tag.
+func (s *Service) retag(ctx context.Context, from []string, to string) ([]string, error) {
+	records, err := s.List(ctx, &storage.PlanFilter{Tags: from, AnyTag: true})
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("tag not found: %s", strings.Join(from, ", "))
+	}
+
+	var changed []string
+	for _, record := range records {
+		p, err := s.Get(ctx, record.ID)
+		if err != nil {
+			return changed, err
+		}
+
+		tags := replaceTags(p.Tags, from, to)
+		if equalTags(tags, p.Tags) {
+			continue
+		}
+		p.Tags = tags
+		if err := s.Update(ctx, p); err != nil {
+			return changed, fmt.Errorf("failed to update %s: %w", p.ID, err)
+		}
+		changed = append(changed, p.ID)
+	}
+	return changed, nil
+}
+
+// replaceTags swaps tags matching from (ignoring case) for to, keeping the
+// order and dropping duplicates.
+func replaceTags(tags, from []string, to string) []string {
+	result := make([]string, 0, len(tags))
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		for _, f := range from {
+			if strings.EqualFold(tag, f) {
+				tag = to
+				break
+			}
+		}
+		if tag == "" || seen[strings.ToLower(tag)] {
+			continue
+		}
+		seen[strings.ToLower(tag)] = true
+		result = append(result, tag)
+	}
+	return result
+}
+
+// dedupeTags drops empty and repeated tags, ignoring case.
+func dedupeTags(tags []string) []string {
+	return replaceTags(tags, nil, "")
+}
+
+func equalTags(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scaffoldTagged creates scaffolded plans carrying the given tags.
+func scaffoldTagged(t *testing.T, service *Service, tagsByTopic map[string][]string) {
+	t.Helper()
+	for topic, tags := range tagsByTopic {
+		_, err := service.Scaffold(context.Background(), CreateRequest{Topic: topic, TotalHours: 1, Tags: tags})
+		require.NoError(t, err)
+	}
+}
+
+func TestCountTags(t *testing.T) {
+	tags := CountTags([]*storage.PlanRecord{
+		{ID: "a", Tags: []string{"rust", "async"}},
+		{ID: "b", Tags: []string{"rust", "Rust"}},
+		{ID: "c"},
+	})
+
+	assert.Equal(t, []TagCount{
+		{Tag: "rust", Plans: 2},
+		{Tag: "async", Plans: 1},
+		{Tag: "Rust", Plans: 1},
+	}, tags)
+}
+
+func TestService_RenameTag(t *testing.T) {
+	service, _, _, cleanup := setupTestService(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	scaffoldTagged(t, service, map[string][]string{
+		"Rust Async": {"golang-ish", "async"},
+		"Go Basics":  {"Golang-ish"},
+		"French":     {"language"},
+	})
+
+	changed, err := service.RenameTag(ctx, "golang-ish", "go")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"rust-async", "go-basics"}, changed)
+
+	p, err := service.Get(ctx, "rust-async")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"go", "async"}, p.Tags)
+
+	_, err = service.RenameTag(ctx, "go", "language")
+	assert.ErrorContains(t, err, "merge")
+
+	_, err = service.RenameTag(ctx, "cooking", "food")
+	assert.ErrorContains(t, err, "tag not found")
+
+	// Fixing the case of a tag is a rename, not a merge
+	_, err = service.RenameTag(ctx, "language", "Language")
+	require.NoError(t, err)
+}
+
+func TestService_MergeAndDeleteTags(t *testing.T) {
+	service, _, _, cleanup := setupTestService(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	scaffoldTagged(t, service, map[string][]string{
+		"Rust Async": {"Rust", "rustlang", "async"},
+		"Rust CLI":   {"rust"},
+	})
+
+	changed, err := service.MergeTags(ctx, []string{"Rust", "rustlang"}, "rust")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rust-async"}, changed, "rust-cli already had only the target tag")
+
+	p, err := service.Get(ctx, "rust-async")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rust", "async"}, p.Tags)
+
+	_, err = service.MergeTags(ctx, []string{"async"}, "a,b")
+	assert.ErrorContains(t, err, "comma")
+
+	changed, err = service.DeleteTag(ctx, "RUST")
+	require.NoError(t, err)
+	assert.Len(t, changed, 2)
+
+	tags, err := service.Tags(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []TagCount{{Tag: "async", Plans: 1}}, tags)
+}
@@ -0,0 +1,266 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+)
+
+// Version is a snapshot of a plan's markdown taken when it was saved.
+type Version struct {
+	PlanID    string    `json:"plan_id"`
+	Number    int       `json:"version"`
+	Content   string    `json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Label returns the version's display name, like "v3".
+func (v *Version) Label() string {
+	return fmt.Sprintf("v%d", v.Number)
+}
+
+// ParseVersion parses a version reference like "v3" or "3".
+func ParseVersion(ref string) (int, error) {
+	n, err := strconv.Atoi(strings.TrimPrefix(strings.ToLower(strings.TrimSpace(ref)), "v"))
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("invalid version %q (use v1, v2, ...)", ref)
+	}
+	return n, nil
+}
+
+// SaveVersion records content as the plan's next version and returns its
+// number. Content identical to the latest version is not recorded again;
+// that version's number is returned instead.
+func (r *SQLiteRepository) SaveVersion(ctx context.Context, planID, content string, at time.Time) (int, error) {
+	tx, err := r.db.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		// Ignore error on rollback - it's expected if commit succeeded
+		//nolint:errcheck // Transaction cleanup
+		tx.Rollback()
+	}()
+
+	var latest int
+	var latestContent string
+	err = tx.QueryRowContext(ctx, `
+		SELECT version, content FROM plan_versions
+		WHERE plan_id = ?
+		ORDER BY version DESC
+		LIMIT 1
+	`, planID).Scan(&latest, &latestContent)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("failed to get latest version: %w", err)
+	}
+	if latest > 0 {
+		// A snapshot that can't be decrypted just never matches
+		if previous, err := r.readContent(latestContent); err == nil && previous == content {
+			return latest, nil
+		}
+	}
+
+	stored, err := r.storedContent(content)
+	if err != nil {
+		return 0, err
+	}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO plan_versions (plan_id, version, content, created_at)
+		VALUES (?, ?, ?, ?)
+	`, planID, latest+1, stored, at.UTC())
+	if err != nil {
+		return 0, fmt.Errorf("failed to save version: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return latest + 1, nil
+}
+
+// ListVersions returns a plan's versions, newest first.
+func (r *SQLiteRepository) ListVersions(ctx context.Context, planID string) ([]*Version, error) {
+	rows, err := r.db.DB().QueryContext(ctx, `
+		SELECT plan_id, version, content, created_at
+		FROM plan_versions
+		WHERE plan_id = ?
+		ORDER BY version DESC
+	`, planID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list versions: %w", err)
+	}
+	defer rows.Close()
+
+	versions := make([]*Version, 0)
+	for rows.Next() {
+		v := &Version{}
+		if err := rows.Scan(&v.PlanID, &v.Number, &v.Content, &v.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan version: %w", err)
+		}
+		if v.Content, err = r.readContent(v.Content); err != nil {
+			return nil, err
+		}
+		versions = append(versions, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating versions: %w", err)
+	}
+	return versions, nil
+}
+
+// RewriteVersions rewrites every stored snapshot in the repository's
+// current form: encrypted with its cipher, or plaintext without one. It
+// returns how many snapshots it rewrote.
+func (r *SQLiteRepository) RewriteVersions(ctx context.Context, reader *storage.Cipher) (int, error) {
+	rows, err := r.db.DB().QueryContext(ctx, `SELECT plan_id, version, content FROM plan_versions`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list versions: %w", err)
+	}
+
+	type snapshot struct {
+		planID  string
+		version int
+		content string
+	}
+	var snapshots []snapshot
+	for rows.Next() {
+		var snap snapshot
+		if err := rows.Scan(&snap.planID, &snap.version, &snap.content); err != nil {
+			_ = rows.Close()
+			return 0, fmt.Errorf("failed to scan version: %w", err)
+		}
+		snapshots = append(snapshots, snap)
+	}
+	if err := rows.Err(); err != nil {
+		_ = rows.Close()
+		return 0, fmt.Errorf("error iterating versions: %w", err)
+	}
+	_ = rows.Close()
+
+	rewritten := 0
+	for _, snap := range snapshots {
+		if snap.content == "" || storage.IsEncryptedString(snap.content) == (r.cipher != nil) {
+			continue
+		}
+		plain, err := reader.DecryptString(snap.content)
+		if err != nil {
+			return rewritten, fmt.Errorf("failed to decrypt version: %w", err)
+		}
+		stored, err := r.storedContent(plain)
+		if err != nil {
+			return rewritten, err
+		}
+		if _, err := r.db.DB().ExecContext(ctx,
+			"UPDATE plan_versions SET content = ? WHERE plan_id = ? AND version = ?",
+			stored, snap.planID, snap.version); err != nil {
+			return rewritten, fmt.Errorf("failed to rewrite version: %w", err)
+		}
+		rewritten++
+	}
+	return rewritten, nil
+}
+
+// storedContent returns a snapshot as it is written to the database.
+func (r *SQLiteRepository) storedContent(content string) (string, error) {
+	if r.cipher == nil {
+		return content, nil
+	}
+	sealed, err := r.cipher.EncryptString(content)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt version: %w", err)
+	}
+	return sealed, nil
+}
+
+// readContent decrypts a snapshot read from the database.
+func (r *SQLiteRepository) readContent(content string) (string, error) {
+	if !storage.IsEncryptedString(content) {
+		return content, nil
+	}
+	if r.cipher == nil {
+		return "", fmt.Errorf("failed to read version: %w", storage.ErrNoPassphrase)
+	}
+	plain, err := r.cipher.DecryptString(content)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt version: %w", err)
+	}
+	return plain, nil
+}
+
+// DeleteVersions removes a plan's version history.
+func (r *SQLiteRepository) DeleteVersions(ctx context.Context, planID string) error {
+	if _, err := r.db.DB().ExecContext(ctx, "DELETE FROM plan_versions WHERE plan_id = ?", planID); err != nil {
+		return fmt.Errorf("failed to delete versions: %w", err)
+	}
+	return nil
+}
+
+// recordVersion snapshots the plan file as its next version.
+func (s *Service) recordVersion(ctx context.Context, id string) error {
+	data, err := s.fs.ReadFile(s.filesystemRepo.Path(id))
+	if err != nil {
+		return fmt.Errorf("failed to read plan file: %w", err)
+	}
+	if _, err := s.sqliteRepo.SaveVersion(ctx, id, string(data), time.Now()); err != nil {
+		return fmt.Errorf("failed to record plan version: %w", err)
+	}
+	return nil
+}
+
+// History returns a plan's saved versions, newest first.
+func (s *Service) History(ctx context.Context, id string) ([]*Version, error) {
+	versions, err := s.sqliteRepo.ListVersions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 && !s.filesystemRepo.Exists(ctx, id) {
+		return nil, fmt.Errorf("%w: %s", ErrPlanNotFound, id)
+	}
+	return versions, nil
+}
+
+// DiffVersions returns a unified diff between two versions of a plan.
+// A zero from means the version before to; a zero to means the latest.
+func (s *Service) DiffVersions(ctx context.Context, id string, from, to int) (string, error) {
+	versions, err := s.History(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if len(versions) == 0 {
+		return "", fmt.Errorf("no versions recorded for %s yet", id)
+	}
+
+	if to == 0 {
+		to = versions[0].Number
+	}
+	if from == 0 {
+		if to <= 1 {
+			return "", fmt.Errorf("v%d is the first version of %s; nothing to compare", to, id)
+		}
+		from = to - 1
+	}
+
+	byNumber := make(map[int]*Version, len(versions))
+	for _, v := range versions {
+		byNumber[v.Number] = v
+	}
+	a, ok := byNumber[from]
+	if !ok {
+		return "", fmt.Errorf("version v%d of %s not found (latest is v%d)", from, id, versions[0].Number)
+	}
+	b, ok := byNumber[to]
+	if !ok {
+		return "", fmt.Errorf("version v%d of %s not found (latest is v%d)", to, id, versions[0].Number)
+	}
+
+	return UnifiedDiff(id+"@"+a.Label(), id+"@"+b.Label(), a.Content, b.Content), nil
+}
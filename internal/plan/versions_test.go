@@ -0,0 +1,106 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package plan
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersion(t *testing.T) {
+	n, err := ParseVersion("v3")
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	n, err = ParseVersion("12")
+	require.NoError(t, err)
+	assert.Equal(t, 12, n)
+
+	for _, bad := range []string{"", "v0", "latest", "-1"} {
+		_, err := ParseVersion(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestService_HistoryRecordsUpdates(t *testing.T) {
+	service, _, _, cleanup := setupTestService(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	p, err := service.Scaffold(ctx, CreateRequest{Topic: "Rust Async", TotalHours: 2})
+	require.NoError(t, err)
+
+	versions, err := service.History(ctx, p.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 1, "creating a plan records v1")
+
+	p.Title = "Async Rust"
+	require.NoError(t, service.Update(ctx, p))
+
+	// Refreshing an unchanged file does not add a version
+	require.NoError(t, service.RefreshIndex(ctx, p.ID))
+
+	versions, err = service.History(ctx, p.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, 2, versions[0].Number, "newest first")
+	assert.Contains(t, versions[0].Content, "Async Rust")
+
+	diff, err := service.DiffVersions(ctx, p.ID, 0, 0)
+	require.NoError(t, err)
+	assert.Contains(t, diff, "--- rust-async@v1\n+++ rust-async@v2\n")
+	assert.Contains(t, diff, "+title: Async Rust")
+
+	_, err = service.DiffVersions(ctx, p.ID, 1, 7)
+	assert.ErrorContains(t, err, "v7 of rust-async not found")
+	_, err = service.DiffVersions(ctx, p.ID, 0, 1)
+	assert.ErrorContains(t, err, "first version")
+
+	require.NoError(t, service.Delete(ctx, p.ID))
+	_, err = service.History(ctx, p.ID)
+	assert.ErrorIs(t, err, ErrPlanNotFound)
+}
+
+func TestService_HistoryEncrypted(t *testing.T) {
+	service, _, paths, cleanup := setupTestService(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	cipher, err := storage.NewCipher("hunter2", paths)
+	require.NoError(t, err)
+	service.fs.SetCipher(cipher)
+	service.sqliteRepo.SetCipher(cipher)
+
+	p, err := service.Scaffold(ctx, CreateRequest{Topic: "Rust Async", TotalHours: 2})
+	require.NoError(t, err)
+	raw, err := os.ReadFile(service.FilePath(p.ID))
+	require.NoError(t, err)
+	assert.True(t, storage.IsEncrypted(raw))
+
+	// Refreshing an unchanged file still does not add a version
+	require.NoError(t, service.RefreshIndex(ctx, p.ID))
+	versions, err := service.History(ctx, p.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	assert.Contains(t, versions[0].Content, "Rust Async")
+
+	var stored string
+	require.NoError(t, service.sqliteRepo.db.DB().QueryRowContext(ctx,
+		"SELECT content FROM plan_versions WHERE plan_id = ?", p.ID).Scan(&stored))
+	assert.True(t, storage.IsEncryptedString(stored))
+
+	// Turning encryption off rewrites the snapshots in plaintext
+	plain := NewSQLiteRepository(service.sqliteRepo.db)
+	n, err := plain.RewriteVersions(ctx, cipher)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	versions, err = plain.ListVersions(ctx, p.ID)
+	require.NoError(t, err)
+	assert.Contains(t, versions[0].Content, "Rust Async")
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package quiz
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+)
+
+var (
+	// questionRegex matches "Q1: ..." and answerRegex "A1: ..." lines.
+	questionRegex = regexp.MustCompile(`^\**Q(\d+)\**\s*[:.)]\s*\**\s*(.*)$`)
+	answerRegex   = regexp.MustCompile(`^\**A(\d+)\**\s*[:.)]\s*\**\s*(.*)$`)
+
+	// gradeRegex matches "1. SCORE: 7 | FEEDBACK: ..." lines.
+	gradeRegex = regexp.MustCompile(`(?i)^\s*(\d+)[.):]\s*SCORE:\s*(\d+)(?:\s*/\s*10)?\s*(?:\|\s*FEEDBACK:\s*(.*))?$`)
+)
+
+// buildQuestionsPrompt asks an LLM for comprehension questions about a chunk.
+func buildQuestionsPrompt(p *plan.Plan, chunk *plan.Chunk, count int) string {
+	var b strings.Builder
+	b.WriteString("You are writing a short self-test for a learner.\n\n")
+	fmt.Fprintf(&b, "Plan: %s\n", p.Title)
+	fmt.Fprintf(&b, "Chunk: %s (%d min)\n", chunk.Title, chunk.Duration)
+
+	if len(chunk.Objectives) > 0 {
+		b.WriteString("\nObjectives:\n")
+		for _, objective := range chunk.Objectives {
+			fmt.Fprintf(&b, "- %s\n", objective)
+		}
+	}
+	if len(chunk.Resources) > 0 {
+		b.WriteString("\nResources:\n")
+		for _, resource := range chunk.ParsedResources() {
+			fmt.Fprintf(&b, "- %s\n", resource.Text)
+		}
+	}
+	if chunk.Deliverable != "" {
+		fmt.Fprintf(&b, "\nDeliverable: %s\n", chunk.Deliverable)
+	}
+
+	fmt.Fprintf(&b, "\nWrite %d comprehension questions that check the objectives above. ", count)
+	b.WriteString("Each question should be answerable in one to three sentences. ")
+	b.WriteString("Give a concise model answer for each.\n")
+	b.WriteString("Reply in exactly this format and nothing else:\n")
+	b.WriteString("Q1: <question>\nA1: <model answer>\nQ2: <question>\nA2: <model answer>\n")
+
+	return b.String()
+}
+
+// parseQuestions extracts questions and model answers from LLM output.
+// Lines that don't start a question or answer continue the previous one.
+func parseQuestions(output string) ([]Question, error) {
+	questions := make([]Question, 0)
+	var current *string
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if match := questionRegex.FindStringSubmatch(line); match != nil {
+			questions = append(questions, Question{Text: match[2]})
+			current = &questions[len(questions)-1].Text
+			continue
+		}
+		if match := answerRegex.FindStringSubmatch(line); match != nil && len(questions) > 0 {
+			questions[len(questions)-1].Reference = match[2]
+			current = &questions[len(questions)-1].Reference
+			continue
+		}
+		if current != nil && !strings.HasPrefix(line, "```") {
+			*current = strings.TrimSpace(*current + " " + line)
+		}
+	}
+
+	valid := questions[:0]
+	for _, q := range questions {
+		if q.Text != "" {
+			valid = append(valid, q)
+		}
+	}
+	if len(valid) == 0 {
+		return nil, fmt.Errorf("no questions found in LLM output")
+	}
+	return valid, nil
+}
+
+// buildGradingPrompt asks an LLM to score each answer against its model answer.
+func buildGradingPrompt(q *Quiz, answers []string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "You are grading a learner's answers to a self-test on \"%s\".\n", q.ChunkTitle)
+	fmt.Fprintf(&b, "Score each answer from 0 to %d for correctness and completeness against the model answer. ", MaxPoints)
+	b.WriteString("Accept answers in the learner's own words.\n\n")
+
+	for i, question := range q.Questions {
+		fmt.Fprintf(&b, "Question %d: %s\n", i+1, question.Text)
+		if question.Reference != "" {
+			fmt.Fprintf(&b, "Model answer: %s\n", question.Reference)
+		}
+		fmt.Fprintf(&b, "Learner answer: %s\n\n", answers[i])
+	}
+
+	b.WriteString("Reply with one line per question in exactly this format and nothing else:\n")
+	b.WriteString("1. SCORE: <0-10> | FEEDBACK: <one sentence>\n")
+
+	return b.String()
+}
+
+// parseGrades extracts a score and feedback for each of n questions.
+// Scores above MaxPoints are clamped; a missing question is an error.
+func parseGrades(output string, n int) ([]Result, error) {
+	results := make([]Result, n)
+	found := make([]bool, n)
+
+	for _, line := range strings.Split(output, "\n") {
+		match := gradeRegex.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil {
+			continue
+		}
+		index, err := strconv.Atoi(match[1])
+		if err != nil || index < 1 || index > n || found[index-1] {
+			continue
+		}
+		points, err := strconv.Atoi(match[2])
+		if err != nil {
+			continue
+		}
+		if points > MaxPoints {
+			points = MaxPoints
+		}
+
+		results[index-1] = Result{Points: points, Feedback: strings.TrimSpace(match[3])}
+		found[index-1] = true
+	}
+
+	for i, ok := range found {
+		if !ok {
+			return nil, fmt.Errorf("grader returned no score for question %d", i+1)
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package quiz
+
+import (
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildQuestionsPrompt(t *testing.T) {
+	p := &plan.Plan{Title: "Rust Async"}
+	chunk := &plan.Chunk{
+		Title:       "Pinning",
+		Duration:    60,
+		Objectives:  []string{"Explain why futures need pinning"},
+		Resources:   []string{"The Async Book, ch. 4"},
+		Deliverable: "A self-referential struct",
+	}
+
+	prompt := buildQuestionsPrompt(p, chunk, 3)
+
+	assert.Contains(t, prompt, "Rust Async")
+	assert.Contains(t, prompt, "Explain why futures need pinning")
+	assert.Contains(t, prompt, "The Async Book, ch. 4")
+	assert.Contains(t, prompt, "Write 3 comprehension questions")
+	assert.Contains(t, prompt, "Q1: <question>")
+}
+
+func TestParseQuestions(t *testing.T) {
+	output := "Here is your quiz:\n\n" +
+		"Q1: Why can't a future move after polling?\n" +
+		"A1: It may hold references into itself.\n" +
+		"**Q2:** What does Pin guarantee?\n" +
+		"A2: The value won't move\n" +
+		"until dropped.\n"
+
+	questions, err := parseQuestions(output)
+	require.NoError(t, err)
+	require.Len(t, questions, 2)
+
+	assert.Equal(t, "Why can't a future move after polling?", questions[0].Text)
+	assert.Equal(t, "It may hold references into itself.", questions[0].Reference)
+	assert.Equal(t, "What does Pin guarantee?", questions[1].Text)
+	assert.Equal(t, "The value won't move until dropped.", questions[1].Reference)
+}
+
+func TestParseQuestions_None(t *testing.T) {
+	_, err := parseQuestions("I can't help with that.")
+	assert.Error(t, err)
+}
+
+func TestBuildGradingPrompt(t *testing.T) {
+	q := &Quiz{
+		ChunkTitle: "Pinning",
+		Questions:  []Question{{Text: "What does Pin guarantee?", Reference: "The value won't move."}},
+	}
+
+	prompt := buildGradingPrompt(q, []string{"It stays put"})
+
+	assert.Contains(t, prompt, "Question 1: What does Pin guarantee?")
+	assert.Contains(t, prompt, "Model answer: The value won't move.")
+	assert.Contains(t, prompt, "Learner answer: It stays put")
+	assert.Contains(t, prompt, "SCORE: <0-10>")
+}
+
+func TestParseGrades(t *testing.T) {
+	output := "1. SCORE: 8 | FEEDBACK: Good, but mention self-references.\n" +
+		"2) score: 12/10 | feedback: Perfect.\n" +
+		"3: SCORE: 0\n"
+
+	results, err := parseGrades(output, 3)
+	require.NoError(t, err)
+
+	assert.Equal(t, 8, results[0].Points)
+	assert.Equal(t, "Good, but mention self-references.", results[0].Feedback)
+	assert.Equal(t, MaxPoints, results[1].Points, "scores are clamped")
+	assert.Equal(t, 0, results[2].Points)
+	assert.Empty(t, results[2].Feedback)
+}
+
+func TestParseGrades_Missing(t *testing.T) {
+	_, err := parseGrades("1. SCORE: 8 | FEEDBACK: ok", 2)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "question 2")
+}
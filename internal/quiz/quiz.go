@@ -0,0 +1,80 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+// Package quiz generates comprehension questions for a chunk, grades
+// free-text answers with the LLM, and keeps a history of scores.
+package quiz
+
+import (
+	"fmt"
+	"time"
+)
+
+// MaxPoints is the best score for a single answer.
+const MaxPoints = 10
+
+// Question is one generated comprehension question.
+type Question struct {
+	Text      string `json:"text"`
+	Reference string `json:"reference,omitempty"` // Model answer the grader compares against
+}
+
+// Quiz is a set of questions about one chunk.
+type Quiz struct {
+	PlanID     string     `json:"plan_id"`
+	ChunkID    string     `json:"chunk_id"`
+	ChunkTitle string     `json:"chunk_title"`
+	Questions  []Question `json:"questions"`
+}
+
+// Result is the graded answer to one question.
+type Result struct {
+	Question string `json:"question"`
+	Answer   string `json:"answer"`
+	Points   int    `json:"points"` // 0 to MaxPoints
+	Feedback string `json:"feedback,omitempty"`
+}
+
+// Attempt is one graded run through a quiz.
+type Attempt struct {
+	ID      int64     `json:"id"`
+	PlanID  string    `json:"plan_id"`
+	ChunkID string    `json:"chunk_id"`
+	Score   int       `json:"score"` // Percent of available points
+	Results []Result  `json:"results"`
+	TakenAt time.Time `json:"taken_at"`
+}
+
+// score returns the percentage of available points earned.
+func score(results []Result) int {
+	if len(results) == 0 {
+		return 0
+	}
+	points := 0
+	for _, r := range results {
+		points += r.Points
+	}
+	return points * 100 / (len(results) * MaxPoints)
+}
+
+// Validate checks that the attempt can be stored.
+func (a *Attempt) Validate() error {
+	if a.PlanID == "" || a.ChunkID == "" {
+		return fmt.Errorf("attempt needs a plan ID and chunk ID")
+	}
+	if len(a.Results) == 0 {
+		return fmt.Errorf("attempt has no answers")
+	}
+	for i, r := range a.Results {
+		if r.Points < 0 || r.Points > MaxPoints {
+			return fmt.Errorf("answer %d: points must be between 0 and %d, got %d", i+1, MaxPoints, r.Points)
+		}
+	}
+	if a.Score < 0 || a.Score > 100 {
+		return fmt.Errorf("score must be between 0 and 100, got %d", a.Score)
+	}
+	if a.TakenAt.IsZero() {
+		return fmt.Errorf("taken_at cannot be zero")
+	}
+	return nil
+}
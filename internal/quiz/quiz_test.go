@@ -0,0 +1,47 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package quiz
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScore(t *testing.T) {
+	assert.Equal(t, 0, score(nil))
+	assert.Equal(t, 100, score([]Result{{Points: 10}, {Points: 10}}))
+	assert.Equal(t, 65, score([]Result{{Points: 10}, {Points: 3}}))
+}
+
+func TestAttempt_Validate(t *testing.T) {
+	valid := func() *Attempt {
+		return &Attempt{
+			PlanID:  "rust",
+			ChunkID: "chunk-001",
+			Score:   70,
+			Results: []Result{{Question: "What is ownership?", Answer: "...", Points: 7}},
+			TakenAt: time.Now(),
+		}
+	}
+
+	assert.NoError(t, valid().Validate())
+
+	missingChunk := valid()
+	missingChunk.ChunkID = ""
+	assert.Error(t, missingChunk.Validate())
+
+	noResults := valid()
+	noResults.Results = nil
+	assert.Error(t, noResults.Validate())
+
+	tooManyPoints := valid()
+	tooManyPoints.Results[0].Points = 11
+	assert.Error(t, tooManyPoints.Validate())
+
+	noTime := valid()
+	noTime.TakenAt = time.Time{}
+	assert.Error(t, noTime.Validate())
+}
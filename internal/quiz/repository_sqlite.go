@@ -0,0 +1,142 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package quiz
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+)
+
+// Repository defines the interface for quiz attempt persistence.
+type Repository interface {
+	// Save stores an attempt and its answers, setting the attempt's ID.
+	Save(ctx context.Context, attempt *Attempt) error
+
+	// List returns a plan's attempts with their answers, newest first.
+	List(ctx context.Context, planID string) ([]*Attempt, error)
+}
+
+// SQLiteRepository implements attempt storage using SQLite.
+type SQLiteRepository struct {
+	db *storage.SQLiteDB
+}
+
+// NewSQLiteRepository creates a new SQLite-backed quiz repository.
+func NewSQLiteRepository(db *storage.SQLiteDB) Repository {
+	return &SQLiteRepository{db: db}
+}
+
+// Save stores an attempt and its answers in one transaction.
+func (r *SQLiteRepository) Save(ctx context.Context, attempt *Attempt) error {
+	if err := attempt.Validate(); err != nil {
+		return fmt.Errorf("invalid attempt: %w", err)
+	}
+
+	tx, err := r.db.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		// Ignore error on rollback - it's expected if commit succeeded
+		//nolint:errcheck // Transaction cleanup
+		tx.Rollback()
+	}()
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO quiz_attempts (plan_id, chunk_id, score, taken_at)
+		VALUES (?, ?, ?, ?)
+	`, attempt.PlanID, attempt.ChunkID, attempt.Score, attempt.TakenAt.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to save attempt: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get attempt ID: %w", err)
+	}
+
+	for i, r := range attempt.Results {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO quiz_answers (attempt_id, position, question, answer, points, feedback)
+			VALUES (?, ?, ?, ?, ?, ?)
+		`, id, i, r.Question, r.Answer, r.Points, r.Feedback)
+		if err != nil {
+			return fmt.Errorf("failed to save answer %d: %w", i+1, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	attempt.ID = id
+	return nil
+}
+
+// List returns a plan's attempts with their answers, newest first.
+func (r *SQLiteRepository) List(ctx context.Context, planID string) ([]*Attempt, error) {
+	rows, err := r.db.DB().QueryContext(ctx, `
+		SELECT id, plan_id, chunk_id, score, taken_at
+		FROM quiz_attempts
+		WHERE plan_id = ?
+		ORDER BY taken_at DESC, id DESC
+	`, planID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attempts: %w", err)
+	}
+	defer rows.Close()
+
+	attempts := make([]*Attempt, 0)
+	byID := make(map[int64]*Attempt)
+	for rows.Next() {
+		attempt := &Attempt{Results: make([]Result, 0)}
+		if err := rows.Scan(&attempt.ID, &attempt.PlanID, &attempt.ChunkID, &attempt.Score, &attempt.TakenAt); err != nil {
+			return nil, fmt.Errorf("failed to scan attempt: %w", err)
+		}
+		attempts = append(attempts, attempt)
+		byID[attempt.ID] = attempt
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating attempts: %w", err)
+	}
+
+	if err := r.loadAnswers(ctx, planID, byID); err != nil {
+		return nil, err
+	}
+	return attempts, nil
+}
+
+// loadAnswers attaches stored answers to their attempts.
+func (r *SQLiteRepository) loadAnswers(ctx context.Context, planID string, byID map[int64]*Attempt) error {
+	rows, err := r.db.DB().QueryContext(ctx, `
+		SELECT a.attempt_id, a.question, a.answer, a.points, a.feedback
+		FROM quiz_answers a
+		JOIN quiz_attempts q ON q.id = a.attempt_id
+		WHERE q.plan_id = ?
+		ORDER BY a.attempt_id, a.position
+	`, planID)
+	if err != nil {
+		return fmt.Errorf("failed to list answers: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		var result Result
+		var answer, feedback sql.NullString
+		if err := rows.Scan(&id, &result.Question, &answer, &result.Points, &feedback); err != nil {
+			return fmt.Errorf("failed to scan answer: %w", err)
+		}
+		result.Answer = answer.String
+		result.Feedback = feedback.String
+		if attempt, ok := byID[id]; ok {
+			attempt.Results = append(attempt.Results, result)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating answers: %w", err)
+	}
+	return nil
+}
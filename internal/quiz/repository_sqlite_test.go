@@ -0,0 +1,78 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package quiz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestDB(t *testing.T) *storage.SQLiteDB {
+	t.Helper()
+
+	db, err := storage.NewSQLiteDB(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	require.NoError(t, storage.NewMigrator(db).Migrate())
+
+	return db
+}
+
+func TestSQLiteRepository_SaveAndList(t *testing.T) {
+	repo := NewSQLiteRepository(setupTestDB(t))
+	ctx := context.Background()
+	takenAt := time.Date(2025, 10, 14, 18, 0, 0, 0, time.UTC)
+
+	first := &Attempt{
+		PlanID:  "rust",
+		ChunkID: "chunk-001",
+		Score:   50,
+		Results: []Result{
+			{Question: "Q one", Answer: "A one", Points: 10, Feedback: "Right."},
+			{Question: "Q two", Answer: "", Points: 0, Feedback: "No answer given."},
+		},
+		TakenAt: takenAt,
+	}
+	second := &Attempt{
+		PlanID:  "rust",
+		ChunkID: "chunk-002",
+		Score:   80,
+		Results: []Result{{Question: "Q three", Answer: "A three", Points: 8}},
+		TakenAt: takenAt.Add(time.Hour),
+	}
+	other := &Attempt{
+		PlanID:  "french",
+		ChunkID: "chunk-001",
+		Score:   100,
+		Results: []Result{{Question: "Q", Answer: "A", Points: 10}},
+		TakenAt: takenAt,
+	}
+
+	for _, attempt := range []*Attempt{first, second, other} {
+		require.NoError(t, repo.Save(ctx, attempt))
+		assert.NotZero(t, attempt.ID)
+	}
+
+	attempts, err := repo.List(ctx, "rust")
+	require.NoError(t, err)
+	require.Len(t, attempts, 2)
+
+	assert.Equal(t, "chunk-002", attempts[0].ChunkID, "newest first")
+	assert.Equal(t, 80, attempts[0].Score)
+	assert.Equal(t, first.Results, attempts[1].Results, "answers load in order")
+	assert.True(t, attempts[1].TakenAt.Equal(takenAt))
+}
+
+func TestSQLiteRepository_SaveRejectsInvalid(t *testing.T) {
+	repo := NewSQLiteRepository(setupTestDB(t))
+
+	err := repo.Save(context.Background(), &Attempt{PlanID: "rust", ChunkID: "chunk-001", TakenAt: time.Now()})
+	assert.Error(t, err)
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package quiz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/llm"
+	"github.com/pezware/samedi.dev/internal/plan"
+)
+
+// DefaultQuestionCount is the number of questions asked when unspecified.
+const DefaultQuestionCount = 5
+
+// Service generates, grades, and records quizzes.
+type Service struct {
+	repo     Repository
+	provider llm.Provider // Optional - required to generate and grade
+	now      func() time.Time
+}
+
+// NewService creates a new quiz service. provider may be nil when only
+// reading attempt history.
+func NewService(repo Repository, provider llm.Provider) *Service {
+	return &Service{
+		repo:     repo,
+		provider: provider,
+		now:      time.Now,
+	}
+}
+
+// Generate asks the LLM for count questions about a chunk of p.
+func (s *Service) Generate(ctx context.Context, p *plan.Plan, chunkID string, count int) (*Quiz, error) {
+	if s.provider == nil {
+		return nil, fmt.Errorf("no LLM provider configured")
+	}
+	if count <= 0 {
+		return nil, fmt.Errorf("question count must be positive, got %d", count)
+	}
+
+	var chunk *plan.Chunk
+	for i := range p.Chunks {
+		if p.Chunks[i].ID == chunkID {
+			chunk = &p.Chunks[i]
+			break
+		}
+	}
+	if chunk == nil {
+		return nil, fmt.Errorf("%w: %s", plan.ErrChunkNotFound, chunkID)
+	}
+
+	callCtx := llm.WithCallInfo(ctx, llm.CallInfo{Operation: llm.OperationQuizGenerate, PlanID: p.ID})
+	output, err := s.provider.Call(callCtx, buildQuestionsPrompt(p, chunk, count))
+	if err != nil {
+		return nil, fmt.Errorf("LLM call failed: %w", err)
+	}
+
+	questions, err := parseQuestions(output)
+	if err != nil {
+		return nil, err
+	}
+	if len(questions) > count {
+		questions = questions[:count]
+	}
+
+	return &Quiz{
+		PlanID:     p.ID,
+		ChunkID:    chunk.ID,
+		ChunkTitle: chunk.Title,
+		Questions:  questions,
+	}, nil
+}
+
+// Grade scores answers with the LLM. Blank answers score zero without
+// being sent. The attempt is not saved; see Record.
+func (s *Service) Grade(ctx context.Context, q *Quiz, answers []string) (*Attempt, error) {
+	if len(answers) != len(q.Questions) {
+		return nil, fmt.Errorf("expected %d answers, got %d", len(q.Questions), len(answers))
+	}
+
+	trimmed := make([]string, len(answers))
+	results := make([]Result, len(answers))
+	answered := false
+	for i, answer := range answers {
+		trimmed[i] = strings.TrimSpace(answer)
+		results[i] = Result{Question: q.Questions[i].Text, Answer: trimmed[i], Feedback: "No answer given."}
+		if trimmed[i] != "" {
+			answered = true
+		}
+	}
+
+	if answered {
+		if s.provider == nil {
+			return nil, fmt.Errorf("no LLM provider configured")
+		}
+
+		callCtx := llm.WithCallInfo(ctx, llm.CallInfo{Operation: llm.OperationQuizGrade, PlanID: q.PlanID})
+		output, err := s.provider.Call(callCtx, buildGradingPrompt(q, trimmed))
+		if err != nil {
+			return nil, fmt.Errorf("LLM call failed: %w", err)
+		}
+
+		grades, err := parseGrades(output, len(answers))
+		if err != nil {
+			return nil, err
+		}
+		for i, grade := range grades {
+			if trimmed[i] == "" {
+				continue
+			}
+			results[i].Points = grade.Points
+			results[i].Feedback = grade.Feedback
+		}
+	}
+
+	return &Attempt{
+		PlanID:  q.PlanID,
+		ChunkID: q.ChunkID,
+		Score:   score(results),
+		Results: results,
+		TakenAt: s.now(),
+	}, nil
+}
+
+// Record saves a graded attempt.
+func (s *Service) Record(ctx context.Context, attempt *Attempt) error {
+	if err := s.repo.Save(ctx, attempt); err != nil {
+		return fmt.Errorf("failed to save quiz attempt: %w", err)
+	}
+	return nil
+}
+
+// List returns a plan's attempts, newest first.
+func (s *Service) List(ctx context.Context, planID string) ([]*Attempt, error) {
+	attempts, err := s.repo.List(ctx, planID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quiz attempts: %w", err)
+	}
+	return attempts, nil
+}
+
+// Latest returns the most recent attempt for each quizzed chunk of a plan.
+func (s *Service) Latest(ctx context.Context, planID string) (map[string]*Attempt, error) {
+	attempts, err := s.List(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := make(map[string]*Attempt)
+	for _, attempt := range attempts {
+		if _, ok := latest[attempt.ChunkID]; !ok {
+			latest[attempt.ChunkID] = attempt
+		}
+	}
+	return latest, nil
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package quiz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/llm"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPlan() *plan.Plan {
+	return &plan.Plan{
+		ID:    "rust",
+		Title: "Rust Async",
+		Chunks: []plan.Chunk{
+			{ID: "chunk-001", Title: "Futures", Duration: 60, Status: plan.StatusCompleted, Objectives: []string{"Poll a future"}},
+			{ID: "chunk-002", Title: "Pinning", Duration: 60, Status: plan.StatusNotStarted},
+		},
+	}
+}
+
+func TestService_Generate(t *testing.T) {
+	provider := llm.NewMockProvider()
+	provider.DefaultResponse = "Q1: What does poll return?\nA1: Poll::Ready or Poll::Pending.\n" +
+		"Q2: Who calls poll?\nA2: The executor.\nQ3: Extra?\nA3: Dropped."
+	svc := NewService(NewSQLiteRepository(setupTestDB(t)), provider)
+
+	q, err := svc.Generate(context.Background(), testPlan(), "chunk-001", 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, "rust", q.PlanID)
+	assert.Equal(t, "Futures", q.ChunkTitle)
+	assert.Len(t, q.Questions, 2, "extra questions are dropped")
+	assert.Contains(t, provider.LastPrompt, "Poll a future")
+}
+
+func TestService_Generate_Errors(t *testing.T) {
+	svc := NewService(NewSQLiteRepository(setupTestDB(t)), llm.NewMockProvider())
+	ctx := context.Background()
+
+	_, err := svc.Generate(ctx, testPlan(), "chunk-999", 3)
+	assert.ErrorIs(t, err, plan.ErrChunkNotFound)
+
+	_, err = svc.Generate(ctx, testPlan(), "chunk-001", 0)
+	assert.Error(t, err)
+
+	_, err = NewService(nil, nil).Generate(ctx, testPlan(), "chunk-001", 3)
+	assert.ErrorContains(t, err, "no LLM provider")
+}
+
+func TestService_Grade(t *testing.T) {
+	provider := llm.NewMockProvider()
+	provider.DefaultResponse = "1. SCORE: 9 | FEEDBACK: Spot on.\n2. SCORE: 7 | FEEDBACK: Ignored.\n"
+	svc := NewService(NewSQLiteRepository(setupTestDB(t)), provider)
+	svc.now = func() time.Time { return time.Date(2025, 10, 14, 9, 0, 0, 0, time.UTC) }
+
+	q := &Quiz{
+		PlanID:  "rust",
+		ChunkID: "chunk-001",
+		Questions: []Question{
+			{Text: "What does poll return?"},
+			{Text: "Who calls poll?"},
+		},
+	}
+
+	attempt, err := svc.Grade(context.Background(), q, []string{"  Ready or Pending ", ""})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Ready or Pending", attempt.Results[0].Answer)
+	assert.Equal(t, 9, attempt.Results[0].Points)
+	assert.Equal(t, 0, attempt.Results[1].Points, "blank answers score zero")
+	assert.Equal(t, "No answer given.", attempt.Results[1].Feedback)
+	assert.Equal(t, 45, attempt.Score)
+	assert.Equal(t, svc.now(), attempt.TakenAt)
+}
+
+func TestService_Grade_AllBlankSkipsLLM(t *testing.T) {
+	provider := llm.NewMockProvider()
+	svc := NewService(NewSQLiteRepository(setupTestDB(t)), provider)
+	q := &Quiz{PlanID: "rust", ChunkID: "chunk-001", Questions: []Question{{Text: "Q"}}}
+
+	attempt, err := svc.Grade(context.Background(), q, []string{""})
+	require.NoError(t, err)
+	assert.Zero(t, attempt.Score)
+	assert.Zero(t, provider.CallCount)
+}
+
+func TestService_Grade_WrongAnswerCount(t *testing.T) {
+	svc := NewService(nil, llm.NewMockProvider())
+	_, err := svc.Grade(context.Background(), &Quiz{Questions: []Question{{Text: "Q"}}}, nil)
+	assert.Error(t, err)
+}
+
+func TestService_RecordAndLatest(t *testing.T) {
+	svc := NewService(NewSQLiteRepository(setupTestDB(t)), nil)
+	ctx := context.Background()
+	base := time.Date(2025, 10, 14, 9, 0, 0, 0, time.UTC)
+
+	for i, s := range []int{40, 90} {
+		require.NoError(t, svc.Record(ctx, &Attempt{
+			PlanID:  "rust",
+			ChunkID: "chunk-001",
+			Score:   s,
+			Results: []Result{{Question: "Q", Points: s / 10}},
+			TakenAt: base.Add(time.Duration(i) * time.Hour),
+		}))
+	}
+
+	latest, err := svc.Latest(ctx, "rust")
+	require.NoError(t, err)
+	require.Contains(t, latest, "chunk-001")
+	assert.Equal(t, 90, latest["chunk-001"].Score)
+}
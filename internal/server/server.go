@@ -0,0 +1,379 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+// Package server exposes samedi over a local REST/JSON API, so tools such
+// as launcher extensions and status bar widgets can read plans, sessions,
+// and stats, and start or stop sessions.
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/pezware/samedi.dev/internal/storage"
+)
+
+// DefaultSessionLimit is how many sessions GET /api/v1/sessions returns
+// without a limit parameter.
+const DefaultSessionLimit = 50
+
+// maxBodyBytes caps request bodies; start and stop requests are tiny.
+const maxBodyBytes = 64 << 10
+
+// PlanService is the subset of the plan service the API reads.
+type PlanService interface {
+	List(ctx context.Context, filter *storage.PlanFilter) ([]*storage.PlanRecord, error)
+	Get(ctx context.Context, id string) (*plan.Plan, error)
+	Exists(ctx context.Context, id string) bool
+}
+
+// SessionService is the subset of the session service the API uses.
+type SessionService interface {
+	GetActive(ctx context.Context) (*session.Session, error)
+	List(ctx context.Context, planID string, limit int) ([]*session.Session, error)
+	ListAll(ctx context.Context) ([]*session.Session, error)
+	Start(ctx context.Context, req session.StartRequest) (*session.Session, error)
+	Stop(ctx context.Context, req session.StopRequest) (*session.Session, error)
+}
+
+// StatsService is the subset of the stats service the API reads.
+type StatsService interface {
+	GetTotalStats(ctx context.Context, timeRange stats.TimeRange) (*stats.TotalStats, error)
+	GetPlanStats(ctx context.Context, planID string, timeRange stats.TimeRange) (*stats.PlanStats, error)
+}
+
+// Options configures a Server.
+type Options struct {
+	Plans    PlanService
+	Sessions SessionService
+	Stats    StatsService
+
+	// Token must be sent as "Authorization: Bearer <token>" on every
+	// request. It cannot be empty.
+	Token string
+
+	// ReadOnly refuses the start and stop endpoints.
+	ReadOnly bool
+
+	// Location is the time zone ranges such as today start in; nil uses
+	// the local zone.
+	Location *time.Location
+
+	// AfterStop, if set, runs after a session is stopped, e.g. to commit
+	// and mirror the change as `samedi stop` does.
+	AfterStop func(sess *session.Session)
+}
+
+// Server serves the API.
+type Server struct {
+	opts Options
+	mux  *http.ServeMux
+}
+
+// New creates a server.
+func New(opts Options) (*Server, error) {
+	if opts.Token == "" {
+		return nil, errors.New("API token cannot be empty")
+	}
+	s := &Server{opts: opts, mux: http.NewServeMux()}
+	s.routes()
+	return s, nil
+}
+
+func (s *Server) routes() {
+	s.mux.HandleFunc("GET /api/v1/status", s.handleStatus)
+	s.mux.HandleFunc("GET /api/v1/plans", s.handlePlans)
+	s.mux.HandleFunc("GET /api/v1/plans/{id}", s.handlePlan)
+	s.mux.HandleFunc("GET /api/v1/sessions", s.handleSessions)
+	s.mux.HandleFunc("POST /api/v1/sessions/start", s.handleStart)
+	s.mux.HandleFunc("POST /api/v1/sessions/stop", s.handleStop)
+	s.mux.HandleFunc("GET /api/v1/stats", s.handleStats)
+	s.mux.HandleFunc("GET /api/v1/stats/{id}", s.handlePlanStats)
+}
+
+// ServeHTTP checks the token and dispatches to the API.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(r) {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="samedi"`)
+		writeError(w, http.StatusUnauthorized, "missing or invalid token")
+		return
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+// authorized reports whether r carries the token, comparing in constant
+// time.
+func (s *Server) authorized(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return ok && subtle.ConstantTimeCompare([]byte(token), []byte(s.opts.Token)) == 1
+}
+
+// statusResponse is the body of GET /api/v1/status.
+type statusResponse struct {
+	Active         *session.Session `json:"active"`
+	ElapsedMinutes int              `json:"elapsed_minutes"`
+	Paused         bool             `json:"paused"`
+}
+
+func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request) {
+	active, err := s.opts.Sessions.GetActive(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	resp := statusResponse{Active: active}
+	if active != nil {
+		resp.ElapsedMinutes = active.CalculateDuration()
+		resp.Paused = active.IsPaused()
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// planSummary is a plan as listed by GET /api/v1/plans.
+type planSummary struct {
+	ID         string    `json:"id"`
+	Title      string    `json:"title"`
+	Status     string    `json:"status"`
+	TotalHours float64   `json:"total_hours"`
+	Tags       []string  `json:"tags,omitempty"`
+	Deadline   string    `json:"deadline,omitempty"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+func (s *Server) handlePlans(w http.ResponseWriter, r *http.Request) {
+	filter := &storage.PlanFilter{}
+	if status := r.URL.Query().Get("status"); status != "" {
+		filter.Statuses = strings.Split(status, ",")
+	}
+	if tag := r.URL.Query().Get("tag"); tag != "" {
+		filter.Tags = strings.Split(tag, ",")
+	}
+
+	records, err := s.opts.Plans.List(r.Context(), filter)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	plans := make([]planSummary, len(records))
+	for i, record := range records {
+		plans[i] = planSummary{
+			ID:         record.ID,
+			Title:      record.Title,
+			Status:     record.Status,
+			TotalHours: record.TotalHours,
+			Tags:       record.Tags,
+			Deadline:   record.Deadline,
+			UpdatedAt:  record.UpdatedAt,
+		}
+	}
+	writeJSON(w, http.StatusOK, plans)
+}
+
+func (s *Server) handlePlan(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !s.opts.Plans.Exists(r.Context(), id) {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("plan not found: %s", id))
+		return
+	}
+	p, err := s.opts.Plans.Get(r.Context(), id)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, p)
+}
+
+func (s *Server) handleSessions(w http.ResponseWriter, r *http.Request) {
+	limit := DefaultSessionLimit
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 1 {
+			writeError(w, http.StatusBadRequest, "limit must be a positive number")
+			return
+		}
+		limit = n
+	}
+
+	var (
+		sessions []*session.Session
+		err      error
+	)
+	if planID := r.URL.Query().Get("plan"); planID != "" {
+		sessions, err = s.opts.Sessions.List(r.Context(), planID, limit)
+	} else {
+		sessions, err = s.opts.Sessions.ListAll(r.Context())
+		if len(sessions) > limit {
+			sessions = sessions[:limit]
+		}
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if sessions == nil {
+		sessions = []*session.Session{}
+	}
+	writeJSON(w, http.StatusOK, sessions)
+}
+
+// startRequest is the body of POST /api/v1/sessions/start.
+type startRequest struct {
+	PlanID  string `json:"plan_id"`
+	ChunkID string `json:"chunk_id"`
+	Notes   string `json:"notes"`
+}
+
+func (s *Server) handleStart(w http.ResponseWriter, r *http.Request) {
+	if s.opts.ReadOnly {
+		writeError(w, http.StatusForbidden, "samedi is in read-only mode")
+		return
+	}
+	var req startRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	sess, err := s.opts.Sessions.Start(r.Context(), session.StartRequest{
+		PlanID:  req.PlanID,
+		ChunkID: req.ChunkID,
+		Notes:   req.Notes,
+	})
+	if err != nil {
+		writeError(w, sessionErrorStatus(err), err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, sess)
+}
+
+// stopRequest is the body of POST /api/v1/sessions/stop.
+type stopRequest struct {
+	Notes     string   `json:"notes"`
+	Artifacts []string `json:"artifacts"`
+	ChunkID   string   `json:"chunk_id"`
+}
+
+func (s *Server) handleStop(w http.ResponseWriter, r *http.Request) {
+	if s.opts.ReadOnly {
+		writeError(w, http.StatusForbidden, "samedi is in read-only mode")
+		return
+	}
+	var req stopRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	sess, err := s.opts.Sessions.Stop(r.Context(), session.StopRequest{
+		Notes:     req.Notes,
+		Artifacts: req.Artifacts,
+		ChunkID:   req.ChunkID,
+	})
+	if err != nil {
+		writeError(w, sessionErrorStatus(err), err.Error())
+		return
+	}
+	if s.opts.AfterStop != nil {
+		s.opts.AfterStop(sess)
+	}
+	writeJSON(w, http.StatusOK, sess)
+}
+
+// sessionErrorStatus is the status for a session that could not be
+// started or stopped: 404 for a plan or chunk that does not exist, and 409
+// otherwise, as when a session is already active or none is.
+func sessionErrorStatus(err error) int {
+	if errors.Is(err, plan.ErrPlanNotFound) || errors.Is(err, plan.ErrChunkNotFound) {
+		return http.StatusNotFound
+	}
+	return http.StatusConflict
+}
+
+func (s *Server) handleStats(w http.ResponseWriter, r *http.Request) {
+	tr, ok := s.timeRange(w, r)
+	if !ok {
+		return
+	}
+	total, err := s.opts.Stats.GetTotalStats(r.Context(), tr)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, total)
+}
+
+func (s *Server) handlePlanStats(w http.ResponseWriter, r *http.Request) {
+	tr, ok := s.timeRange(w, r)
+	if !ok {
+		return
+	}
+	id := r.PathValue("id")
+	if !s.opts.Plans.Exists(r.Context(), id) {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("plan not found: %s", id))
+		return
+	}
+	planStats, err := s.opts.Stats.GetPlanStats(r.Context(), id, tr)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, planStats)
+}
+
+// timeRange reads the range query parameter, as accepted by
+// `samedi stats --range`. It writes a 400 response and returns false for
+// an unknown range.
+func (s *Server) timeRange(w http.ResponseWriter, r *http.Request) (stats.TimeRange, bool) {
+	name := r.URL.Query().Get("range")
+	if name == "" {
+		name = "all"
+	}
+	now := time.Now()
+	if s.opts.Location != nil {
+		now = now.In(s.opts.Location)
+	}
+	tr, err := stats.ParseTimeRange(name, now)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return stats.TimeRange{}, false
+	}
+	return tr, true
+}
+
+// readJSON decodes the request body into v. An empty body leaves v as
+// is. It writes a 400 response and returns false for malformed JSON.
+func readJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil && !errors.Is(err, io.EOF) {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return false
+	}
+	return true
+}
+
+// errorResponse is the body of every error.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, errorResponse{Error: message})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(v)
+}
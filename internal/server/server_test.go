@@ -0,0 +1,255 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testToken = "secret-token"
+
+type fakePlans struct {
+	plans map[string]*plan.Plan
+}
+
+func (f *fakePlans) List(_ context.Context, _ *storage.PlanFilter) ([]*storage.PlanRecord, error) {
+	var records []*storage.PlanRecord
+	for _, p := range f.plans {
+		records = append(records, &storage.PlanRecord{ID: p.ID, Title: p.Title, Status: string(p.Status)})
+	}
+	return records, nil
+}
+
+func (f *fakePlans) Get(_ context.Context, id string) (*plan.Plan, error) {
+	return f.plans[id], nil
+}
+
+func (f *fakePlans) Exists(_ context.Context, id string) bool {
+	return f.plans[id] != nil
+}
+
+type fakeSessions struct {
+	active  *session.Session
+	stopped []*session.Session
+}
+
+func (f *fakeSessions) GetActive(_ context.Context) (*session.Session, error) {
+	return f.active, nil
+}
+
+func (f *fakeSessions) List(_ context.Context, planID string, limit int) ([]*session.Session, error) {
+	var out []*session.Session
+	for _, sess := range f.stopped {
+		if sess.PlanID == planID && len(out) < limit {
+			out = append(out, sess)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeSessions) ListAll(_ context.Context) ([]*session.Session, error) {
+	return f.stopped, nil
+}
+
+func (f *fakeSessions) Start(_ context.Context, req session.StartRequest) (*session.Session, error) {
+	if f.active != nil {
+		return nil, session.ErrActiveSessionExists
+	}
+	if req.PlanID == "missing" {
+		return nil, fmt.Errorf("cannot start a session: %w: %s", plan.ErrPlanNotFound, req.PlanID)
+	}
+	f.active = &session.Session{ID: "new", PlanID: req.PlanID, ChunkID: req.ChunkID, Notes: req.Notes, StartTime: time.Now()}
+	return f.active, nil
+}
+
+func (f *fakeSessions) Stop(_ context.Context, req session.StopRequest) (*session.Session, error) {
+	if f.active == nil {
+		return nil, fmt.Errorf("%w to stop", session.ErrNoActiveSession)
+	}
+	sess := f.active
+	end := time.Now()
+	sess.EndTime = &end
+	sess.Notes = req.Notes
+	f.active = nil
+	f.stopped = append([]*session.Session{sess}, f.stopped...)
+	return sess, nil
+}
+
+type fakeStats struct{}
+
+func (fakeStats) GetTotalStats(_ context.Context, _ stats.TimeRange) (*stats.TotalStats, error) {
+	return &stats.TotalStats{TotalHours: 12.5}, nil
+}
+
+func (fakeStats) GetPlanStats(_ context.Context, planID string, _ stats.TimeRange) (*stats.PlanStats, error) {
+	return &stats.PlanStats{PlanID: planID, TotalHours: 3}, nil
+}
+
+func newTestServer(t *testing.T, readOnly bool) (*Server, *fakeSessions) {
+	t.Helper()
+	sessions := &fakeSessions{}
+	srv, err := New(Options{
+		Plans: &fakePlans{plans: map[string]*plan.Plan{
+			"rust": {ID: "rust", Title: "Rust", Status: plan.StatusInProgress},
+		}},
+		Sessions: sessions,
+		Stats:    fakeStats{},
+		Token:    testToken,
+		ReadOnly: readOnly,
+	})
+	require.NoError(t, err)
+	return srv, sessions
+}
+
+// call sends a request with the test token and decodes the JSON response.
+func call(t *testing.T, srv *Server, method, path, body string, out any) int {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+testToken)
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	if out != nil {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), out))
+	}
+	return rec.Code
+}
+
+func TestServer_RequiresToken(t *testing.T) {
+	srv, _ := newTestServer(t, false)
+
+	for _, header := range []string{"", "Bearer wrong", testToken} {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/status", nil)
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		rec := httptest.NewRecorder()
+		srv.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code, "header %q", header)
+	}
+
+	_, err := New(Options{})
+	assert.Error(t, err, "an empty token is refused")
+}
+
+func TestServer_StartStop(t *testing.T) {
+	srv, sessions := newTestServer(t, false)
+
+	var status statusResponse
+	assert.Equal(t, http.StatusOK, call(t, srv, http.MethodGet, "/api/v1/status", "", &status))
+	assert.Nil(t, status.Active)
+
+	var failure errorResponse
+	code := call(t, srv, http.MethodPost, "/api/v1/sessions/start", `{"plan_id": "missing"}`, &failure)
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Contains(t, failure.Error, "plan not found")
+
+	var started session.Session
+	code = call(t, srv, http.MethodPost, "/api/v1/sessions/start", `{"plan_id": "rust", "chunk_id": "chunk-001"}`, &started)
+	assert.Equal(t, http.StatusCreated, code)
+	assert.Equal(t, "chunk-001", started.ChunkID)
+
+	code = call(t, srv, http.MethodPost, "/api/v1/sessions/start", `{"plan_id": "rust"}`, &failure)
+	assert.Equal(t, http.StatusConflict, code)
+	assert.Contains(t, failure.Error, "already exists")
+
+	assert.Equal(t, http.StatusOK, call(t, srv, http.MethodGet, "/api/v1/status", "", &status))
+	require.NotNil(t, status.Active)
+	assert.Equal(t, "rust", status.Active.PlanID)
+
+	var stopped session.Session
+	code = call(t, srv, http.MethodPost, "/api/v1/sessions/stop", `{"notes": "Read the book"}`, &stopped)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "Read the book", stopped.Notes)
+	assert.Nil(t, sessions.active)
+
+	code = call(t, srv, http.MethodPost, "/api/v1/sessions/stop", `{"bogus": 1}`, &failure)
+	assert.Equal(t, http.StatusBadRequest, code, "unknown fields are rejected")
+}
+
+func TestServer_ReadOnly(t *testing.T) {
+	srv, sessions := newTestServer(t, true)
+
+	var failure errorResponse
+	assert.Equal(t, http.StatusForbidden, call(t, srv, http.MethodPost, "/api/v1/sessions/start", `{"plan_id": "rust"}`, &failure))
+	assert.Nil(t, sessions.active)
+
+	var plans []planSummary
+	assert.Equal(t, http.StatusOK, call(t, srv, http.MethodGet, "/api/v1/plans", "", &plans), "reads still work")
+	require.Len(t, plans, 1)
+	assert.Equal(t, "rust", plans[0].ID)
+}
+
+func TestServer_Reads(t *testing.T) {
+	srv, sessions := newTestServer(t, false)
+	sessions.stopped = []*session.Session{
+		{ID: "s2", PlanID: "rust"},
+		{ID: "s1", PlanID: "go"},
+	}
+
+	var p plan.Plan
+	assert.Equal(t, http.StatusOK, call(t, srv, http.MethodGet, "/api/v1/plans/rust", "", &p))
+	assert.Equal(t, "Rust", p.Title)
+
+	var failure errorResponse
+	assert.Equal(t, http.StatusNotFound, call(t, srv, http.MethodGet, "/api/v1/plans/missing", "", &failure))
+	assert.Equal(t, http.StatusNotFound, call(t, srv, http.MethodGet, "/api/v1/stats/missing", "", &failure))
+
+	var listed []session.Session
+	assert.Equal(t, http.StatusOK, call(t, srv, http.MethodGet, "/api/v1/sessions?limit=1", "", &listed))
+	require.Len(t, listed, 1)
+	assert.Equal(t, "s2", listed[0].ID)
+
+	assert.Equal(t, http.StatusOK, call(t, srv, http.MethodGet, "/api/v1/sessions?plan=go", "", &listed))
+	require.Len(t, listed, 1)
+	assert.Equal(t, "s1", listed[0].ID)
+	assert.Equal(t, http.StatusBadRequest, call(t, srv, http.MethodGet, "/api/v1/sessions?limit=0", "", &failure))
+
+	var total stats.TotalStats
+	assert.Equal(t, http.StatusOK, call(t, srv, http.MethodGet, "/api/v1/stats?range=this-week", "", &total))
+	assert.Equal(t, 12.5, total.TotalHours)
+	assert.Equal(t, http.StatusBadRequest, call(t, srv, http.MethodGet, "/api/v1/stats?range=forever", "", &failure))
+
+	var planStats stats.PlanStats
+	assert.Equal(t, http.StatusOK, call(t, srv, http.MethodGet, "/api/v1/stats/rust", "", &planStats))
+	assert.Equal(t, "rust", planStats.PlanID)
+}
+
+func TestLoadToken(t *testing.T) {
+	t.Setenv(TokenEnv, "")
+	path := filepath.Join(t.TempDir(), "samedi", "api-token")
+
+	token, err := LoadToken(path)
+	require.NoError(t, err)
+	assert.Len(t, token, 64)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	again, err := LoadToken(path)
+	require.NoError(t, err)
+	assert.Equal(t, token, again, "the stored token is reused")
+
+	t.Setenv(TokenEnv, "from-env")
+	fromEnv, err := LoadToken(path)
+	require.NoError(t, err)
+	assert.Equal(t, "from-env", fromEnv)
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package session
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Totals sums a set of sessions.
+type Totals struct {
+	Sessions int        // Sessions, active or not
+	Minutes  int        // Recorded minutes; the active session adds none
+	First    *time.Time // Start of the earliest session; nil when there are none
+	Last     *time.Time // Start of the latest session; nil when there are none
+}
+
+// DayTotal sums the sessions that started on one day.
+type DayTotal struct {
+	Day      time.Time // Midnight starting the day
+	Sessions int
+	Minutes  int      // Recorded minutes; the active session adds none
+	Elapsed  int      // Minutes including the active session's time so far
+	PlanIDs  []string // Plans worked on, sorted
+}
+
+// Aggregator is implemented by repositories that can total sessions
+// without loading them. Aggregates ignore the filter's NoteContains and
+// Limit.
+type Aggregator interface {
+	// Totals sums the sessions that pass filter.
+	Totals(ctx context.Context, filter *Filter) (*Totals, error)
+
+	// DailyTotals sums the sessions that pass filter per day, oldest day
+	// first. With a nil loc each session falls on the day it was recorded
+	// in, in its own zone; otherwise days are bucketed in loc. ok is false
+	// when the repository can't bucket in loc.
+	DailyTotals(ctx context.Context, filter *Filter, loc *time.Location) (totals []DayTotal, ok bool, err error)
+}
+
+// SumSessions totals the sessions in repo that pass filter, in the
+// database when repo is an Aggregator.
+func SumSessions(ctx context.Context, repo Repository, filter *Filter) (*Totals, error) {
+	if agg, ok := repo.(Aggregator); ok && !hasNoteFilter(filter) {
+		return agg.Totals(ctx, filter)
+	}
+	sessions, err := repo.List(ctx, unlimited(filter))
+	if err != nil {
+		return nil, err
+	}
+	totals := &Totals{Sessions: len(sessions)}
+	for _, sess := range sessions {
+		totals.Minutes += sess.Duration
+		start := sess.StartTime
+		if totals.First == nil || start.Before(*totals.First) {
+			totals.First = &start
+		}
+		if totals.Last == nil || start.After(*totals.Last) {
+			totals.Last = &start
+		}
+	}
+	return totals, nil
+}
+
+// SumSessionsByDay totals the sessions in repo that pass filter per day,
+// as Aggregator.DailyTotals does, in the database when repo can.
+func SumSessionsByDay(ctx context.Context, repo Repository, filter *Filter, loc *time.Location) ([]DayTotal, error) {
+	if agg, ok := repo.(Aggregator); ok && !hasNoteFilter(filter) {
+		totals, ok, err := agg.DailyTotals(ctx, filter, loc)
+		if err != nil || ok {
+			return totals, err
+		}
+	}
+	sessions, err := repo.List(ctx, unlimited(filter))
+	if err != nil {
+		return nil, err
+	}
+	return sumByDay(sessions, loc), nil
+}
+
+// sumByDay buckets sessions into day totals in Go.
+func sumByDay(sessions []*Session, loc *time.Location) []DayTotal {
+	byDay := make(map[time.Time]*DayTotal)
+	plans := make(map[time.Time]map[string]bool)
+	for _, sess := range sessions {
+		start, dayLoc := sess.StartTime, time.Local
+		if loc != nil {
+			start, dayLoc = start.In(loc), loc
+		}
+		day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, dayLoc)
+		total := byDay[day]
+		if total == nil {
+			total = &DayTotal{Day: day}
+			byDay[day] = total
+			plans[day] = make(map[string]bool)
+		}
+		total.Sessions++
+		total.Minutes += sess.Duration
+		total.Elapsed += sess.ElapsedMinutes()
+		if !plans[day][sess.PlanID] {
+			plans[day][sess.PlanID] = true
+			total.PlanIDs = append(total.PlanIDs, sess.PlanID)
+		}
+	}
+
+	totals := make([]DayTotal, 0, len(byDay))
+	for _, total := range byDay {
+		sort.Strings(total.PlanIDs)
+		totals = append(totals, *total)
+	}
+	sort.Slice(totals, func(i, j int) bool { return totals[i].Day.Before(totals[j].Day) })
+	return totals
+}
+
+func hasNoteFilter(filter *Filter) bool {
+	return filter != nil && filter.NoteContains != ""
+}
+
+// unlimited copies filter without its limit, which aggregates ignore.
+func unlimited(filter *Filter) *Filter {
+	if filter == nil {
+		return nil
+	}
+	f := *filter
+	f.Limit = 0
+	return &f
+}
+
+// Totals sums the sessions that pass filter with one query.
+func (r *SQLiteRepository) Totals(ctx context.Context, filter *Filter) (*Totals, error) {
+	conditions, args := buildSessionWhere(filter)
+	where := ""
+	if len(conditions) > 0 {
+		where = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	totals := &Totals{}
+	query := "SELECT COUNT(*), COALESCE(SUM(duration_minutes), 0) FROM sessions" + where
+	if err := r.db.DB().QueryRowContext(ctx, query, args...).Scan(&totals.Sessions, &totals.Minutes); err != nil {
+		return nil, fmt.Errorf("failed to total sessions: %w", err)
+	}
+	if totals.Sessions == 0 {
+		return totals, nil
+	}
+
+	var first, last time.Time
+	query = "SELECT start_time FROM sessions" + where + " ORDER BY julianday(start_time) LIMIT 1"
+	if err := r.db.DB().QueryRowContext(ctx, query, args...).Scan(&first); err != nil {
+		return nil, fmt.Errorf("failed to find earliest session: %w", err)
+	}
+	query = "SELECT start_time FROM sessions" + where + " ORDER BY julianday(start_time) DESC LIMIT 1"
+	if err := r.db.DB().QueryRowContext(ctx, query, args...).Scan(&last); err != nil {
+		return nil, fmt.Errorf("failed to find latest session: %w", err)
+	}
+	totals.First, totals.Last = &first, &last
+	return totals, nil
+}
+
+// DailyTotals sums sessions per day with one grouped query. SQLite only
+// knows UTC offsets and the process's local zone, so loc must be nil or
+// time.Local.
+func (r *SQLiteRepository) DailyTotals(ctx context.Context, filter *Filter, loc *time.Location) ([]DayTotal, bool, error) {
+	// Start times are stored as "2006-01-02 15:04:05-07:00", so the first
+	// ten characters are the day in the zone the session was recorded in
+	day := "substr(start_time, 1, 10)"
+	switch loc {
+	case nil:
+	case time.Local:
+		day = "date(start_time, 'localtime')"
+	default:
+		return nil, false, nil
+	}
+
+	conditions, args := buildSessionWhere(filter)
+	where := ""
+	if len(conditions) > 0 {
+		where = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	// The active session counts the time since it started, less pauses,
+	// frozen while paused, as Session.Elapsed does
+	query := `
+		SELECT ` + day + ` AS day, COUNT(*), COALESCE(SUM(duration_minutes), 0),
+			COALESCE(SUM(CASE WHEN end_time IS NULL THEN
+				MAX(0, CAST(((julianday(COALESCE(paused_at, ?)) - julianday(start_time)) * 86400 - paused_seconds) / 60 AS INTEGER))
+				ELSE duration_minutes END), 0),
+			GROUP_CONCAT(DISTINCT plan_id)
+		FROM sessions` + where + `
+		GROUP BY day
+		ORDER BY day
+	`
+	args = append([]interface{}{time.Now()}, args...)
+
+	rows, err := r.db.DB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to total sessions by day: %w", err)
+	}
+	defer rows.Close()
+
+	dayLoc := loc
+	if dayLoc == nil {
+		dayLoc = time.Local
+	}
+	totals := make([]DayTotal, 0)
+	for rows.Next() {
+		var (
+			date  string
+			plans sql.NullString
+			total DayTotal
+		)
+		if err := rows.Scan(&date, &total.Sessions, &total.Minutes, &total.Elapsed, &plans); err != nil {
+			return nil, false, fmt.Errorf("failed to scan day total: %w", err)
+		}
+		if total.Day, err = time.ParseInLocation("2006-01-02", date, dayLoc); err != nil {
+			return nil, false, fmt.Errorf("failed to parse session day %q: %w", date, err)
+		}
+		if plans.Valid {
+			total.PlanIDs = strings.Split(plans.String, ",")
+			sort.Strings(total.PlanIDs)
+		}
+		totals = append(totals, total)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, fmt.Errorf("error iterating day totals: %w", err)
+	}
+	return totals, true, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// aggregateFixture stores sessions recorded in two zones plus an active
+// one, and returns the repository and the sessions.
+func aggregateFixture(t *testing.T) (Repository, []*Session) {
+	t.Helper()
+	db, cleanup := setupTestDB(t)
+	t.Cleanup(cleanup)
+	createTestPlan(t, db, "rust")
+	createTestPlan(t, db, "go")
+
+	tokyo := time.FixedZone("JST", 9*3600)
+	completed := func(id, planID string, start time.Time, minutes int) *Session {
+		end := start.Add(time.Duration(minutes) * time.Minute)
+		return &Session{ID: id, PlanID: planID, StartTime: start, EndTime: &end, Duration: minutes, CreatedAt: start}
+	}
+	now := time.Now()
+	sessions := []*Session{
+		completed("s1", "rust", time.Date(2025, 1, 10, 9, 0, 0, 0, time.UTC), 30),
+		completed("s2", "go", time.Date(2025, 1, 10, 20, 0, 0, 0, time.UTC), 45),
+		// 01:00 on the 11th in Tokyo is still the 10th in UTC
+		completed("s3", "rust", time.Date(2025, 1, 11, 1, 0, 0, 0, tokyo), 60),
+		{ID: "s4", PlanID: "go", StartTime: now.Add(-90 * time.Minute), PausedSeconds: 1800, CreatedAt: now},
+	}
+
+	repo := NewSQLiteRepository(db)
+	for _, sess := range sessions {
+		require.NoError(t, repo.Create(context.Background(), sess))
+	}
+	return repo, sessions
+}
+
+// listOnly hides a repository's aggregates, forcing the Go fallback.
+type listOnly struct{ Repository }
+
+func TestSumSessions(t *testing.T) {
+	repo, sessions := aggregateFixture(t)
+	ctx := context.Background()
+
+	for name, r := range map[string]Repository{"sql": repo, "go": listOnly{repo}} {
+		totals, err := SumSessions(ctx, r, nil)
+		require.NoError(t, err, name)
+		assert.Equal(t, 4, totals.Sessions, name)
+		assert.Equal(t, 135, totals.Minutes, name)
+		require.NotNil(t, totals.Last, name)
+		assert.True(t, totals.First.Equal(sessions[0].StartTime), name)
+		assert.True(t, totals.Last.Equal(sessions[3].StartTime), name)
+
+		ranged, err := SumSessions(ctx, r, &Filter{
+			Since: time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC),
+			Until: time.Date(2025, 1, 11, 0, 0, 0, 0, time.UTC),
+		})
+		require.NoError(t, err, name)
+		assert.Equal(t, 2, ranged.Sessions, name)
+		assert.Equal(t, 105, ranged.Minutes, name)
+		assert.True(t, ranged.First.Equal(sessions[2].StartTime), "earliest by instant, not by text: %s", name)
+		assert.True(t, ranged.Last.Equal(sessions[1].StartTime), name)
+
+		none, err := SumSessions(ctx, r, &Filter{PlanID: "missing"})
+		require.NoError(t, err, name)
+		assert.Equal(t, Totals{}, *none, name)
+	}
+}
+
+func TestSumSessionsByDay(t *testing.T) {
+	repo, _ := aggregateFixture(t)
+	ctx := context.Background()
+	past := &Filter{Until: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)}
+
+	for name, r := range map[string]Repository{"sql": repo, "go": listOnly{repo}} {
+		days, err := SumSessionsByDay(ctx, r, past, nil)
+		require.NoError(t, err, name)
+		require.Len(t, days, 2, name)
+		assert.Equal(t, "2025-01-10", days[0].Day.Format("2006-01-02"), name)
+		assert.Equal(t, DayTotal{Day: days[0].Day, Sessions: 2, Minutes: 75, Elapsed: 75, PlanIDs: []string{"go", "rust"}}, days[0], name)
+		assert.Equal(t, "2025-01-11", days[1].Day.Format("2006-01-02"), "days as recorded: %s", name)
+
+		utc, err := SumSessionsByDay(ctx, r, past, time.UTC)
+		require.NoError(t, err, name)
+		require.Len(t, utc, 1, name)
+		assert.Equal(t, 135, utc[0].Minutes, name)
+
+		all, err := SumSessionsByDay(ctx, r, &Filter{PlanID: "go"}, time.Local)
+		require.NoError(t, err, name)
+		active := all[len(all)-1]
+		assert.Equal(t, 0, active.Minutes, name)
+		assert.InDelta(t, 60, active.Elapsed, 1, "the active session counts time so far, less pauses: %s", name)
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package session
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// ArtifactKind distinguishes web links from local files.
+type ArtifactKind string
+
+const (
+	ArtifactURL  ArtifactKind = "url"
+	ArtifactFile ArtifactKind = "file"
+)
+
+// Artifact is a parsed session artifact.
+type Artifact struct {
+	Raw    string       `json:"artifact"` // Value as stored on the session
+	Kind   ArtifactKind `json:"kind"`
+	Target string       `json:"target"` // URL with scheme, or absolute file path
+	Exists bool         `json:"exists"` // Always true for URLs
+}
+
+// ParseArtifact classifies an artifact and resolves what opening it should
+// launch. Values with a scheme, or host-like values such as
+// "github.com/user/repo", are URLs; everything else is a local path.
+func ParseArtifact(raw string) Artifact {
+	raw = strings.TrimSpace(raw)
+	artifact := Artifact{Raw: raw, Kind: ArtifactFile}
+
+	if u, err := url.Parse(raw); err == nil && u.Scheme != "" && u.Host != "" {
+		artifact.Kind = ArtifactURL
+		artifact.Target = raw
+		artifact.Exists = true
+		return artifact
+	}
+
+	path := expandHome(raw)
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	_, statErr := os.Stat(path)
+	artifact.Target = path
+	artifact.Exists = statErr == nil
+
+	if !artifact.Exists && looksLikeHost(raw) {
+		artifact.Kind = ArtifactURL
+		artifact.Target = "https://" + raw
+		artifact.Exists = true
+	}
+	return artifact
+}
+
+// ResolveArtifacts prepares artifacts for storage. URLs are kept as given,
+// local paths are made absolute so they can be opened from any directory,
+// and a local path that does not exist is an error.
+func ResolveArtifacts(raw []string) ([]string, error) {
+	resolved := make([]string, 0, len(raw))
+	for _, value := range raw {
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+		artifact := ParseArtifact(value)
+		if artifact.Kind == ArtifactURL {
+			resolved = append(resolved, artifact.Raw)
+			continue
+		}
+		if !artifact.Exists {
+			return nil, fmt.Errorf("artifact file not found: %s", artifact.Raw)
+		}
+		resolved = append(resolved, artifact.Target)
+	}
+	return resolved, nil
+}
+
+// startCommand is swapped out in tests.
+var startCommand = func(name string, args ...string) error {
+	// #nosec G204 - command is chosen from a fixed list, arguments are not shell-interpreted
+	return exec.Command(name, args...).Start()
+}
+
+// OpenArtifact opens a URL in the default browser, or a local file in its
+// default application.
+func OpenArtifact(artifact Artifact) error {
+	if artifact.Kind == ArtifactFile && !artifact.Exists {
+		return fmt.Errorf("artifact file not found: %s", artifact.Target)
+	}
+	name, args := openCommand(runtime.GOOS, artifact.Target)
+	if err := startCommand(name, args...); err != nil {
+		return fmt.Errorf("failed to open %s: %w", artifact.Target, err)
+	}
+	return nil
+}
+
+// openCommand returns the platform command that opens target.
+func openCommand(goos, target string) (string, []string) {
+	switch goos {
+	case "darwin":
+		return "open", []string{target}
+	case "windows":
+		return "rundll32", []string{"url.dll,FileProtocolHandler", target}
+	default:
+		return "xdg-open", []string{target}
+	}
+}
+
+// looksLikeHost reports whether a scheme-less value starts with a domain,
+// as in "github.com/user/repo".
+func looksLikeHost(raw string) bool {
+	if strings.HasPrefix(raw, ".") || strings.HasPrefix(raw, "~") || filepath.IsAbs(raw) {
+		return false
+	}
+	host, _, found := strings.Cut(raw, "/")
+	if !found || strings.ContainsAny(host, ` \`) {
+		return false
+	}
+	dot := strings.LastIndex(host, ".")
+	return dot > 0 && dot < len(host)-2
+}
+
+// expandHome replaces a leading "~" with the user's home directory.
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
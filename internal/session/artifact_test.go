@@ -0,0 +1,105 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package session
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseArtifact_URLWithScheme(t *testing.T) {
+	artifact := ParseArtifact("https://github.com/user/repo")
+
+	assert.Equal(t, ArtifactURL, artifact.Kind)
+	assert.Equal(t, "https://github.com/user/repo", artifact.Target)
+	assert.True(t, artifact.Exists)
+}
+
+func TestParseArtifact_HostWithoutScheme(t *testing.T) {
+	artifact := ParseArtifact("github.com/user/rust-api")
+
+	assert.Equal(t, ArtifactURL, artifact.Kind)
+	assert.Equal(t, "https://github.com/user/rust-api", artifact.Target)
+}
+
+func TestParseArtifact_ExistingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notes.md")
+	require.NoError(t, os.WriteFile(path, []byte("# Notes"), 0o600))
+
+	artifact := ParseArtifact(path)
+
+	assert.Equal(t, ArtifactFile, artifact.Kind)
+	assert.Equal(t, path, artifact.Target)
+	assert.True(t, artifact.Exists)
+}
+
+func TestParseArtifact_MissingFile(t *testing.T) {
+	artifact := ParseArtifact("no-such-file.md")
+
+	assert.Equal(t, ArtifactFile, artifact.Kind)
+	assert.True(t, filepath.IsAbs(artifact.Target))
+	assert.False(t, artifact.Exists)
+}
+
+func TestParseArtifact_HomeDirectory(t *testing.T) {
+	home, err := os.UserHomeDir()
+	require.NoError(t, err)
+
+	artifact := ParseArtifact("~/samedi-missing-artifact.md")
+
+	assert.Equal(t, ArtifactFile, artifact.Kind)
+	assert.Equal(t, filepath.Join(home, "samedi-missing-artifact.md"), artifact.Target)
+}
+
+func TestResolveArtifacts(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "main.go")
+	require.NoError(t, os.WriteFile(path, []byte("package main"), 0o600))
+	t.Chdir(dir)
+
+	resolved, err := ResolveArtifacts([]string{"https://example.com", "main.go", " "})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://example.com", path}, resolved)
+}
+
+func TestResolveArtifacts_MissingFile(t *testing.T) {
+	_, err := ResolveArtifacts([]string{filepath.Join(t.TempDir(), "gone.md")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "artifact file not found")
+}
+
+func TestOpenArtifact(t *testing.T) {
+	var gotName string
+	var gotArgs []string
+	original := startCommand
+	startCommand = func(name string, args ...string) error {
+		gotName, gotArgs = name, args
+		return nil
+	}
+	t.Cleanup(func() { startCommand = original })
+
+	require.NoError(t, OpenArtifact(ParseArtifact("https://example.com")))
+	assert.NotEmpty(t, gotName)
+	assert.Contains(t, gotArgs, "https://example.com")
+
+	err := OpenArtifact(ParseArtifact(filepath.Join(t.TempDir(), "gone.md")))
+	assert.Error(t, err)
+}
+
+func TestOpenCommand(t *testing.T) {
+	name, args := openCommand("darwin", "https://example.com")
+	assert.Equal(t, "open", name)
+	assert.Equal(t, []string{"https://example.com"}, args)
+
+	name, _ = openCommand("linux", "https://example.com")
+	assert.Equal(t, "xdg-open", name)
+
+	name, args = openCommand("windows", "https://example.com")
+	assert.Equal(t, "rundll32", name)
+	assert.Equal(t, "https://example.com", args[len(args)-1])
+}
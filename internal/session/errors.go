@@ -0,0 +1,18 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package session
+
+import "errors"
+
+// ErrSessionNotFound is returned, wrapped with the ID, when no session
+// has the requested ID.
+var ErrSessionNotFound = errors.New("session not found")
+
+// ErrActiveSessionExists is returned when starting a session while
+// another one is active.
+var ErrActiveSessionExists = errors.New("active session already exists")
+
+// ErrNoActiveSession is returned when stopping, pausing, or resuming with
+// no session active.
+var ErrNoActiveSession = errors.New("no active session")
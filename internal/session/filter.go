@@ -0,0 +1,63 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package session
+
+import (
+	"strings"
+	"time"
+)
+
+// Filter selects sessions to list. Zero fields match every session.
+type Filter struct {
+	PlanID  string
+	ChunkID string
+
+	// Since and Until bound the start time: Since is inclusive, Until
+	// exclusive.
+	Since time.Time
+	Until time.Time
+
+	// NoteContains keeps sessions whose notes contain this text, ignoring
+	// case. Notes may be encrypted at rest, so this is matched after they
+	// are read rather than in SQL.
+	NoteContains string
+
+	// MinMinutes keeps completed sessions at least this long.
+	MinMinutes int
+
+	// Limit caps how many sessions are returned; 0 returns all of them.
+	Limit int
+}
+
+// Matches reports whether s passes every condition of the filter except
+// Limit. A nil filter matches everything.
+func (f *Filter) Matches(s *Session) bool {
+	if f == nil {
+		return true
+	}
+	if f.PlanID != "" && s.PlanID != f.PlanID {
+		return false
+	}
+	if f.ChunkID != "" && s.ChunkID != f.ChunkID {
+		return false
+	}
+	if !f.Since.IsZero() && s.StartTime.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && !s.StartTime.Before(f.Until) {
+		return false
+	}
+	if f.MinMinutes > 0 && (s.EndTime == nil || s.Duration < f.MinMinutes) {
+		return false
+	}
+	if f.NoteContains != "" && !containsFold(s.Notes, f.NoteContains) {
+		return false
+	}
+	return true
+}
+
+// containsFold reports whether substr is within s, ignoring case.
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
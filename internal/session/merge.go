@@ -0,0 +1,228 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package session
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Merge actions, as reported per incoming session.
+const (
+	MergeAdded     = "added"     // New here and overlapping nothing
+	MergeDuplicate = "duplicate" // Same ID and content as a session here
+	MergeUpdated   = "updated"   // Same ID; the two copies were combined
+	MergeOverlap   = "overlap"   // Overlaps sessions here; see Resolution
+	MergeSkipped   = "skipped"   // Still active on the other machine
+)
+
+// MergeItem is what a merge did, or would do, with one incoming session.
+type MergeItem struct {
+	Session     *Session   `json:"session"`
+	Action      string     `json:"action"`
+	Resolution  string     `json:"resolution,omitempty"`  // For overlaps: "created", "merged", or "skipped"
+	Overlapping []*Session `json:"overlapping,omitempty"` // Sessions here it overlaps
+}
+
+// MergeReport lists what a merge did with each incoming session, in the
+// order they were given.
+type MergeReport struct {
+	Items  []MergeItem `json:"items"`
+	DryRun bool        `json:"dry_run"`
+}
+
+// Count returns how many items had action.
+func (r *MergeReport) Count(action string) int {
+	n := 0
+	for _, item := range r.Items {
+		if item.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// Conflicts returns the items a plain import refuses: sessions already
+// here under the same ID with other content, and overlaps.
+func (r *MergeReport) Conflicts() []MergeItem {
+	var conflicts []MergeItem
+	for _, item := range r.Items {
+		if item.Action == MergeUpdated || item.Action == MergeOverlap {
+			conflicts = append(conflicts, item)
+		}
+	}
+	return conflicts
+}
+
+// MergeOptions controls Merge.
+type MergeOptions struct {
+	// Overlap decides what happens to a session that overlaps sessions
+	// here: OverlapSkip keeps only the sessions here (the default when
+	// empty), OverlapMerge folds it into them, and OverlapForce adds it.
+	Overlap OverlapPolicy
+
+	// DryRun reports what would happen without writing anything.
+	DryRun bool
+}
+
+// Merge reconciles sessions recorded on another machine with the ones
+// here. Sessions are matched by ID first: an identical copy is dropped as
+// a duplicate, and a copy that differs, such as one whose notes were
+// edited on one side, is combined with the one here. Sessions new here
+// are checked for overlaps with sessions on the same plan, and overlaps
+// are flagged and resolved by opts.Overlap. Sessions still active on the
+// other machine are skipped.
+func (s *Service) Merge(ctx context.Context, incoming []*Session, opts MergeOptions) (*MergeReport, error) {
+	if opts.Overlap == OverlapReject {
+		opts.Overlap = OverlapSkip
+	}
+
+	sorted := append([]*Session(nil), incoming...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].StartTime.Before(sorted[j].StartTime) })
+
+	all, err := s.repo.List(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	byID := make(map[string]*Session, len(all))
+	for _, sess := range all {
+		byID[sess.ID] = sess
+	}
+
+	report := &MergeReport{DryRun: opts.DryRun}
+	for _, sess := range sorted {
+		item, err := s.mergeOne(ctx, sess, byID[sess.ID], opts)
+		if err != nil {
+			return nil, err
+		}
+		report.Items = append(report.Items, *item)
+	}
+	return report, nil
+}
+
+// mergeOne merges a single incoming session; local is the session here
+// with the same ID, if any.
+func (s *Service) mergeOne(ctx context.Context, sess, local *Session, opts MergeOptions) (*MergeItem, error) {
+	if sess.IsActive() {
+		return &MergeItem{Session: sess, Action: MergeSkipped}, nil
+	}
+	if sess.CreatedAt.IsZero() {
+		sess.CreatedAt = sess.StartTime
+	}
+	sess.Duration = sess.CalculateDuration()
+	if err := sess.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid session %s: %w", shortID(sess.ID), err)
+	}
+
+	if local != nil {
+		return s.mergeSameID(ctx, local, sess, opts.DryRun)
+	}
+
+	existing, err := s.repo.GetByPlan(ctx, sess.PlanID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for overlapping sessions: %w", err)
+	}
+	var overlapping []*Session
+	for _, other := range existing {
+		if sess.Overlaps(other) {
+			overlapping = append(overlapping, other)
+		}
+	}
+	sort.Slice(overlapping, func(i, j int) bool {
+		return overlapping[i].StartTime.Before(overlapping[j].StartTime)
+	})
+
+	if len(overlapping) == 0 {
+		if !opts.DryRun {
+			if err := s.repo.Create(ctx, sess); err != nil {
+				return nil, fmt.Errorf("failed to create session: %w", err)
+			}
+		}
+		return &MergeItem{Session: sess, Action: MergeAdded}, nil
+	}
+
+	item := &MergeItem{Session: sess, Action: MergeOverlap, Overlapping: overlapping}
+	for _, other := range overlapping {
+		if opts.Overlap == OverlapMerge && other.IsActive() {
+			// Never fold into a session that is still running here
+			item.Resolution = "skipped"
+			return item, nil
+		}
+	}
+	switch opts.Overlap {
+	case OverlapForce:
+		item.Resolution = "created"
+		if !opts.DryRun {
+			if err := s.repo.Create(ctx, sess); err != nil {
+				return nil, fmt.Errorf("failed to create session: %w", err)
+			}
+		}
+	case OverlapMerge:
+		item.Resolution = "merged"
+		if !opts.DryRun {
+			kept := overlapping[0]
+			kept.Merge(append(overlapping[1:], sess)...)
+			if err := s.replace(ctx, kept, overlapping[1:]); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		item.Resolution = "skipped"
+	}
+	return item, nil
+}
+
+// mergeSameID reconciles two copies of one session.
+// A copy that adds nothing to the one here is a duplicate.
+func (s *Service) mergeSameID(ctx context.Context, local, incoming *Session, dryRun bool) (*MergeItem, error) {
+	if local.IsActive() {
+		// Still running here; stopping it will settle its window
+		return &MergeItem{Session: local, Action: MergeSkipped}, nil
+	}
+
+	merged := *local
+	merged.Artifacts = append([]string(nil), local.Artifacts...)
+	merged.Merge(incoming)
+	if merged.Reflection.IsEmpty() {
+		merged.Reflection = incoming.Reflection
+	}
+	if sameSession(&merged, local) && merged.Reflection == local.Reflection {
+		return &MergeItem{Session: local, Action: MergeDuplicate}, nil
+	}
+
+	if !dryRun {
+		if err := merged.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid merged session: %w", err)
+		}
+		if err := s.repo.Update(ctx, &merged); err != nil {
+			return nil, fmt.Errorf("failed to update session: %w", err)
+		}
+	}
+	return &MergeItem{Session: &merged, Action: MergeUpdated}, nil
+}
+
+// sameSession reports whether two copies of a session agree on what a
+// merge combines, reflection aside.
+func sameSession(a, b *Session) bool {
+	if a.PlanID != b.PlanID || a.ChunkID != b.ChunkID || a.Notes != b.Notes ||
+		!a.StartTime.Equal(b.StartTime) || !sameEnd(a.EndTime, b.EndTime) ||
+		len(a.Artifacts) != len(b.Artifacts) {
+		return false
+	}
+	for i := range a.Artifacts {
+		if a.Artifacts[i] != b.Artifacts[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func sameEnd(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Merge(t *testing.T) {
+	base := time.Date(2025, 10, 13, 9, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	setup := func() (*Service, *MockRepository) {
+		repo := NewMockRepository()
+		require.NoError(t, repo.Create(ctx, completedSession("same", "rust", base, 60)))
+		edited := completedSession("edited", "rust", base.Add(2*time.Hour), 30)
+		edited.Notes = "pinning"
+		require.NoError(t, repo.Create(ctx, edited))
+		require.NoError(t, repo.Create(ctx, completedSession("laptop", "go", base, 60)))
+		return NewService(repo, nil), repo
+	}
+	incoming := func() []*Session {
+		edited := completedSession("edited", "rust", base.Add(2*time.Hour), 30)
+		edited.Notes = "unpin"
+		active := completedSession("running", "rust", base.Add(5*time.Hour), 0)
+		active.EndTime = nil
+		return []*Session{
+			completedSession("new", "rust", base.Add(3*time.Hour), 45),
+			completedSession("same", "rust", base, 60),
+			edited,
+			completedSession("desktop", "go", base.Add(30*time.Minute), 60),
+			active,
+		}
+	}
+	actions := func(report *MergeReport) map[string]string {
+		got := make(map[string]string)
+		for _, item := range report.Items {
+			got[item.Session.ID] = item.Action + "/" + item.Resolution
+		}
+		return got
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		svc, repo := setup()
+		report, err := svc.Merge(ctx, incoming(), MergeOptions{DryRun: true})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"new":     "added/",
+			"same":    "duplicate/",
+			"edited":  "updated/",
+			"desktop": "overlap/skipped",
+			"running": "skipped/",
+		}, actions(report))
+		assert.Len(t, report.Conflicts(), 2)
+		assert.Equal(t, 1, report.Count(MergeAdded))
+		assert.Len(t, repo.sessions, 3, "nothing written")
+	})
+
+	t.Run("skip overlaps", func(t *testing.T) {
+		svc, repo := setup()
+		_, err := svc.Merge(ctx, incoming(), MergeOptions{})
+		require.NoError(t, err)
+		assert.Len(t, repo.sessions, 4)
+		assert.Contains(t, repo.sessions, "new")
+		assert.NotContains(t, repo.sessions, "desktop")
+		assert.Equal(t, "pinning\nunpin", repo.sessions["edited"].Notes)
+	})
+
+	t.Run("merge overlaps", func(t *testing.T) {
+		svc, repo := setup()
+		_, err := svc.Merge(ctx, incoming(), MergeOptions{Overlap: OverlapMerge})
+		require.NoError(t, err)
+		assert.NotContains(t, repo.sessions, "desktop")
+		assert.Equal(t, 90, repo.sessions["laptop"].Duration, "the window grows to cover both")
+	})
+
+	t.Run("force overlaps", func(t *testing.T) {
+		svc, repo := setup()
+		_, err := svc.Merge(ctx, incoming(), MergeOptions{Overlap: OverlapForce})
+		require.NoError(t, err)
+		assert.Contains(t, repo.sessions, "desktop")
+	})
+}
@@ -0,0 +1,269 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrOverlap is returned when a recorded session overlaps an existing
+// session on the same plan and no overlap policy was chosen.
+var ErrOverlap = errors.New("session overlaps an existing session")
+
+// OverlapPolicy decides what Record does with a session that overlaps an
+// existing one on the same plan.
+type OverlapPolicy string
+
+const (
+	// OverlapReject refuses the session with ErrOverlap (the default).
+	OverlapReject OverlapPolicy = ""
+	// OverlapMerge folds the session into the sessions it overlaps.
+	OverlapMerge OverlapPolicy = "merge"
+	// OverlapSkip drops the session and keeps the existing ones.
+	OverlapSkip OverlapPolicy = "skip"
+	// OverlapForce records the session anyway.
+	OverlapForce OverlapPolicy = "force"
+)
+
+// ParseOverlapPolicy parses "merge", "skip", or "force"; an empty string
+// is OverlapReject.
+func ParseOverlapPolicy(value string) (OverlapPolicy, error) {
+	switch policy := OverlapPolicy(strings.ToLower(strings.TrimSpace(value))); policy {
+	case OverlapReject, OverlapMerge, OverlapSkip, OverlapForce:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("invalid overlap policy %q (use merge, skip, or force)", value)
+	}
+}
+
+// Overlaps reports whether two sessions on the same plan share any time.
+// Sessions that only touch end to start do not overlap; an active session
+// runs until now.
+func (s *Session) Overlaps(other *Session) bool {
+	if s.PlanID != other.PlanID || s.ID == other.ID {
+		return false
+	}
+	return s.StartTime.Before(other.end()) && other.StartTime.Before(s.end())
+}
+
+// end returns the session's end time, or now for an active session.
+func (s *Session) end() time.Time {
+	if s.EndTime == nil {
+		return time.Now()
+	}
+	return *s.EndTime
+}
+
+// Merge folds others into s: the window grows to cover all of them, notes
+// and artifacts are combined without repeats, and a missing chunk is taken
+// from the first session that has one. All sessions must be completed.
+func (s *Session) Merge(others ...*Session) {
+	for _, other := range others {
+		if other.StartTime.Before(s.StartTime) {
+			s.StartTime = other.StartTime
+		}
+		if other.EndTime != nil && other.EndTime.After(*s.EndTime) {
+			end := *other.EndTime
+			s.EndTime = &end
+		}
+		if s.ChunkID == "" {
+			s.ChunkID = other.ChunkID
+		}
+		if other.Notes != "" && !strings.Contains(s.Notes, other.Notes) {
+			s.AddNotes(other.Notes)
+		}
+		for _, artifact := range other.Artifacts {
+			if !containsString(s.Artifacts, artifact) {
+				s.AddArtifact(artifact)
+			}
+		}
+		s.CardsCreated = max(s.CardsCreated, other.CardsCreated)
+	}
+	s.Duration = s.CalculateDuration()
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// RecordResult describes what Record did with a session.
+type RecordResult struct {
+	Session     *Session   `json:"session"`               // The stored session (the merged one after a merge)
+	Action      string     `json:"action"`                // "created", "merged", or "skipped"
+	Overlapping []*Session `json:"overlapping,omitempty"` // Existing sessions the new one overlapped
+}
+
+// Record stores a completed session, such as one imported or logged after
+// the fact, checking it against the plan's existing sessions. Overlaps are
+// handled by policy; the default refuses them with ErrOverlap.
+func (s *Service) Record(ctx context.Context, session *Session, policy OverlapPolicy) (*RecordResult, error) {
+	if session.EndTime == nil {
+		return nil, fmt.Errorf("only completed sessions can be recorded")
+	}
+	if session.ID == "" {
+		session.ID = uuid.New().String()
+	}
+	if session.CreatedAt.IsZero() {
+		session.CreatedAt = time.Now()
+	}
+	session.Duration = session.CalculateDuration()
+	if err := session.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid session: %w", err)
+	}
+
+	existing, err := s.repo.GetByPlan(ctx, session.PlanID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for overlapping sessions: %w", err)
+	}
+	var overlapping []*Session
+	for _, other := range existing {
+		if session.Overlaps(other) {
+			overlapping = append(overlapping, other)
+		}
+	}
+	sort.Slice(overlapping, func(i, j int) bool {
+		return overlapping[i].StartTime.Before(overlapping[j].StartTime)
+	})
+
+	if len(overlapping) == 0 || policy == OverlapForce {
+		if err := s.repo.Create(ctx, session); err != nil {
+			return nil, fmt.Errorf("failed to create session: %w", err)
+		}
+		return &RecordResult{Session: session, Action: "created", Overlapping: overlapping}, nil
+	}
+
+	switch policy {
+	case OverlapSkip:
+		return &RecordResult{Session: overlapping[0], Action: "skipped", Overlapping: overlapping}, nil
+	case OverlapMerge:
+		for _, other := range overlapping {
+			if other.IsActive() {
+				return nil, fmt.Errorf("cannot merge into the active session %s; stop it first", shortID(other.ID))
+			}
+		}
+		kept := overlapping[0]
+		kept.Merge(append(overlapping[1:], session)...)
+		if err := s.replace(ctx, kept, overlapping[1:]); err != nil {
+			return nil, err
+		}
+		return &RecordResult{Session: kept, Action: "merged", Overlapping: overlapping}, nil
+	default:
+		other := overlapping[0]
+		return nil, fmt.Errorf("%w: %s on %s overlaps %s (%s–%s); merge, skip, or force it",
+			ErrOverlap, session.PlanID, session.StartTime.Format("2006-01-02 15:04"),
+			shortID(other.ID), other.StartTime.Format("15:04"), other.end().Format("15:04"))
+	}
+}
+
+// replace saves a merged session and deletes the sessions folded into it.
+func (s *Service) replace(ctx context.Context, kept *Session, removed []*Session) error {
+	if err := kept.Validate(); err != nil {
+		return fmt.Errorf("invalid merged session: %w", err)
+	}
+	if err := s.repo.Update(ctx, kept); err != nil {
+		return fmt.Errorf("failed to update session: %w", err)
+	}
+	for _, other := range removed {
+		if err := s.repo.Delete(ctx, other.ID); err != nil {
+			return fmt.Errorf("failed to delete merged session %s: %w", shortID(other.ID), err)
+		}
+	}
+	return nil
+}
+
+// DuplicateGroup is a run of overlapping sessions on one plan. Dedupe
+// merges Removed into Kept, the earliest of them.
+type DuplicateGroup struct {
+	Kept    *Session   `json:"kept"`
+	Removed []*Session `json:"removed"`
+}
+
+// FindDuplicates groups completed sessions that overlap others on the same
+// plan. Overlap is transitive: A–B and B–C put A, B, and C in one group.
+// Active sessions are left alone.
+func (s *Service) FindDuplicates(ctx context.Context) ([]DuplicateGroup, error) {
+	sessions, err := s.repo.List(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	byPlan := make(map[string][]*Session)
+	var planIDs []string
+	for _, sess := range sessions {
+		if sess.IsActive() {
+			continue
+		}
+		if _, ok := byPlan[sess.PlanID]; !ok {
+			planIDs = append(planIDs, sess.PlanID)
+		}
+		byPlan[sess.PlanID] = append(byPlan[sess.PlanID], sess)
+	}
+	sort.Strings(planIDs)
+
+	var groups []DuplicateGroup
+	for _, planID := range planIDs {
+		planSessions := byPlan[planID]
+		sort.SliceStable(planSessions, func(i, j int) bool {
+			return planSessions[i].StartTime.Before(planSessions[j].StartTime)
+		})
+
+		var group []*Session
+		var groupEnd time.Time
+		flush := func() {
+			if len(group) > 1 {
+				groups = append(groups, DuplicateGroup{Kept: group[0], Removed: group[1:]})
+			}
+		}
+		for _, sess := range planSessions {
+			if len(group) > 0 && sess.StartTime.Before(groupEnd) {
+				group = append(group, sess)
+				if sess.EndTime.After(groupEnd) {
+					groupEnd = *sess.EndTime
+				}
+				continue
+			}
+			flush()
+			group = []*Session{sess}
+			groupEnd = *sess.EndTime
+		}
+		flush()
+	}
+	return groups, nil
+}
+
+// Dedupe merges each group of overlapping sessions into its earliest
+// session and deletes the rest. It returns the groups it merged.
+func (s *Service) Dedupe(ctx context.Context) ([]DuplicateGroup, error) {
+	groups, err := s.FindDuplicates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		group.Kept.Merge(group.Removed...)
+		if err := s.replace(ctx, group.Kept, group.Removed); err != nil {
+			return nil, err
+		}
+	}
+	return groups, nil
+}
+
+// shortID returns the first 8 characters of a session ID for messages.
+func shortID(id string) string {
+	if len(id) > 8 {
+		return id[:8]
+	}
+	return id
+}
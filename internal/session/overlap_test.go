@@ -0,0 +1,149 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// completedSession returns a completed session on planID from start to
+// start+minutes.
+func completedSession(id, planID string, start time.Time, minutes int) *Session {
+	end := start.Add(time.Duration(minutes) * time.Minute)
+	return &Session{
+		ID:        id,
+		PlanID:    planID,
+		StartTime: start,
+		EndTime:   &end,
+		Duration:  minutes,
+		CreatedAt: start,
+	}
+}
+
+func TestParseOverlapPolicy(t *testing.T) {
+	for _, value := range []string{"", "merge", "Skip", "force"} {
+		_, err := ParseOverlapPolicy(value)
+		assert.NoError(t, err, value)
+	}
+	_, err := ParseOverlapPolicy("replace")
+	assert.ErrorContains(t, err, "invalid overlap policy")
+}
+
+func TestSession_Overlaps(t *testing.T) {
+	base := time.Date(2025, 10, 13, 9, 0, 0, 0, time.UTC)
+	a := completedSession("a", "rust", base, 60)
+
+	assert.True(t, a.Overlaps(completedSession("b", "rust", base.Add(30*time.Minute), 60)))
+	assert.False(t, a.Overlaps(completedSession("c", "rust", base.Add(60*time.Minute), 30)), "touching is not overlapping")
+	assert.False(t, a.Overlaps(completedSession("d", "french", base, 60)), "other plans never overlap")
+	assert.False(t, a.Overlaps(a), "a session does not overlap itself")
+}
+
+func TestService_Record(t *testing.T) {
+	base := time.Date(2025, 10, 13, 9, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	setup := func() (*Service, *MockRepository) {
+		repo := NewMockRepository()
+		existing := completedSession("existing-1", "rust", base, 60)
+		existing.Notes = "read chapter 3"
+		require.NoError(t, repo.Create(ctx, existing))
+		return NewService(repo, nil), repo
+	}
+	imported := func() *Session {
+		s := completedSession("", "rust", base.Add(30*time.Minute), 60)
+		s.Notes = "exercises"
+		return s
+	}
+
+	t.Run("reject by default", func(t *testing.T) {
+		svc, repo := setup()
+		_, err := svc.Record(ctx, imported(), OverlapReject)
+		assert.ErrorIs(t, err, ErrOverlap)
+		assert.Len(t, repo.sessions, 1)
+	})
+
+	t.Run("skip", func(t *testing.T) {
+		svc, repo := setup()
+		result, err := svc.Record(ctx, imported(), OverlapSkip)
+		require.NoError(t, err)
+		assert.Equal(t, "skipped", result.Action)
+		assert.Equal(t, "existing-1", result.Session.ID)
+		assert.Len(t, repo.sessions, 1)
+	})
+
+	t.Run("force", func(t *testing.T) {
+		svc, repo := setup()
+		result, err := svc.Record(ctx, imported(), OverlapForce)
+		require.NoError(t, err)
+		assert.Equal(t, "created", result.Action)
+		assert.Len(t, result.Overlapping, 1)
+		assert.Len(t, repo.sessions, 2)
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		svc, repo := setup()
+		result, err := svc.Record(ctx, imported(), OverlapMerge)
+		require.NoError(t, err)
+		assert.Equal(t, "merged", result.Action)
+		require.Len(t, repo.sessions, 1)
+
+		merged := repo.sessions["existing-1"]
+		assert.Equal(t, base, merged.StartTime)
+		assert.Equal(t, 90, merged.Duration)
+		assert.Equal(t, "read chapter 3\nexercises", merged.Notes)
+	})
+
+	t.Run("no overlap", func(t *testing.T) {
+		svc, repo := setup()
+		later := completedSession("", "rust", base.Add(2*time.Hour), 30)
+		result, err := svc.Record(ctx, later, OverlapReject)
+		require.NoError(t, err)
+		assert.Equal(t, "created", result.Action)
+		assert.NotEmpty(t, later.ID)
+		assert.Len(t, repo.sessions, 2)
+	})
+}
+
+func TestService_Dedupe(t *testing.T) {
+	base := time.Date(2025, 10, 13, 9, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	repo := NewMockRepository()
+
+	for _, s := range []*Session{
+		completedSession("a", "rust", base, 60),
+		completedSession("a-copy", "rust", base, 60),
+		completedSession("b", "rust", base.Add(50*time.Minute), 30), // chains onto a
+		completedSession("c", "rust", base.Add(3*time.Hour), 30),
+		completedSession("d", "french", base, 60),
+	} {
+		require.NoError(t, repo.Create(ctx, s))
+	}
+	svc := NewService(repo, nil)
+
+	groups, err := svc.FindDuplicates(ctx)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	assert.Len(t, groups[0].Removed, 2)
+	assert.Len(t, repo.sessions, 5, "finding changes nothing")
+
+	groups, err = svc.Dedupe(ctx)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	assert.Len(t, repo.sessions, 3)
+
+	kept := repo.sessions[groups[0].Kept.ID]
+	require.NotNil(t, kept)
+	assert.Equal(t, base, kept.StartTime)
+	assert.Equal(t, 80, kept.Duration)
+
+	groups, err = svc.FindDuplicates(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, groups)
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/pezware/samedi.dev/internal/storage"
@@ -29,8 +30,9 @@ type Repository interface {
 	// Update updates an existing session.
 	Update(ctx context.Context, session *Session) error
 
-	// List retrieves sessions for a plan, ordered by start time descending.
-	List(ctx context.Context, planID string, limit int) ([]*Session, error)
+	// List retrieves the sessions that pass filter, ordered by start time
+	// descending. A nil filter returns every session.
+	List(ctx context.Context, filter *Filter) ([]*Session, error)
 
 	// GetByPlan retrieves all sessions for a specific plan.
 	GetByPlan(ctx context.Context, planID string) ([]*Session, error)
@@ -41,7 +43,8 @@ type Repository interface {
 
 // SQLiteRepository implements session storage using SQLite.
 type SQLiteRepository struct {
-	db *storage.SQLiteDB
+	db     *storage.SQLiteDB
+	cipher *storage.Cipher // Encrypts notes at rest when set
 }
 
 // NewSQLiteRepository creates a new SQLite-backed session repository.
@@ -49,6 +52,74 @@ func NewSQLiteRepository(db *storage.SQLiteDB) Repository {
 	return &SQLiteRepository{db: db}
 }
 
+// NewEncryptedSQLiteRepository creates a session repository that encrypts
+// notes with c as they are written and decrypts them as they are read.
+// Notes written before encryption was turned on are still read as is.
+// A nil cipher behaves like NewSQLiteRepository.
+func NewEncryptedSQLiteRepository(db *storage.SQLiteDB, c *storage.Cipher) Repository {
+	return &SQLiteRepository{db: db, cipher: c}
+}
+
+// storedNotes returns notes as they are written to the database.
+func (r *SQLiteRepository) storedNotes(notes string) (string, error) {
+	if r.cipher == nil {
+		return notes, nil
+	}
+	sealed, err := r.cipher.EncryptString(notes)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt notes: %w", err)
+	}
+	return sealed, nil
+}
+
+// readNotes decrypts notes read from the database.
+func (r *SQLiteRepository) readNotes(notes string) (string, error) {
+	if !storage.IsEncryptedString(notes) {
+		return notes, nil
+	}
+	if r.cipher == nil {
+		return "", fmt.Errorf("failed to read notes: %w", storage.ErrNoPassphrase)
+	}
+	plain, err := r.cipher.DecryptString(notes)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt notes: %w", err)
+	}
+	return plain, nil
+}
+
+// storedReflection returns a reflection as it is written to the
+// database: JSON, sealed like notes, or NULL when nothing was answered.
+func (r *SQLiteRepository) storedReflection(reflection *Reflection) (sql.NullString, error) {
+	if reflection.IsEmpty() {
+		return sql.NullString{}, nil
+	}
+	data, err := json.Marshal(reflection)
+	if err != nil {
+		return sql.NullString{}, fmt.Errorf("failed to marshal reflection: %w", err)
+	}
+	stored, err := r.storedNotes(string(data))
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: stored, Valid: true}, nil
+}
+
+// readReflection decodes a reflection read from the database.
+func (r *SQLiteRepository) readReflection(stored sql.NullString) (*Reflection, error) {
+	if !stored.Valid || stored.String == "" {
+		return nil, nil
+	}
+	data, err := r.readNotes(stored.String)
+	if err != nil {
+		return nil, err
+	}
+	var reflection Reflection
+	if err := json.Unmarshal([]byte(data), &reflection); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal reflection: %w", err)
+	}
+	return &reflection, nil
+}
+
 // Create inserts a new session into the database.
 func (r *SQLiteRepository) Create(ctx context.Context, session *Session) error {
 	artifactsJSON, err := json.Marshal(session.Artifacts)
@@ -56,11 +127,21 @@ func (r *SQLiteRepository) Create(ctx context.Context, session *Session) error {
 		return fmt.Errorf("failed to marshal artifacts: %w", err)
 	}
 
+	notes, err := r.storedNotes(session.Notes)
+	if err != nil {
+		return err
+	}
+	reflection, err := r.storedReflection(session.Reflection)
+	if err != nil {
+		return err
+	}
+
 	query := `
 		INSERT INTO sessions (
 			id, plan_id, chunk_id, start_time, end_time, duration_minutes,
-			notes, artifacts, cards_created, created_at
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			notes, artifacts, cards_created, created_at, paused_at, paused_seconds,
+			reflection
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
 	_, err = r.db.DB().ExecContext(ctx, query,
@@ -70,10 +151,13 @@ func (r *SQLiteRepository) Create(ctx context.Context, session *Session) error {
 		session.StartTime,
 		nullTime(session.EndTime),
 		session.Duration,
-		session.Notes,
+		notes,
 		string(artifactsJSON),
 		session.CardsCreated,
 		session.CreatedAt,
+		nullTime(session.PausedAt),
+		session.PausedSeconds,
+		reflection,
 	)
 
 	if err != nil {
@@ -87,14 +171,15 @@ func (r *SQLiteRepository) Create(ctx context.Context, session *Session) error {
 func (r *SQLiteRepository) Get(ctx context.Context, id string) (*Session, error) {
 	query := `
 		SELECT id, plan_id, chunk_id, start_time, end_time, duration_minutes,
-			notes, artifacts, cards_created, created_at
+			notes, artifacts, cards_created, created_at, paused_at, paused_seconds,
+			reflection
 		FROM sessions
 		WHERE id = ?
 	`
 
 	session, err := r.scanSession(r.db.DB().QueryRowContext(ctx, query, id))
 	if errors.Is(err, sql.ErrNoRows) {
-		return nil, fmt.Errorf("session not found: %s", id)
+		return nil, fmt.Errorf("%w: %s", ErrSessionNotFound, id)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get session: %w", err)
@@ -107,7 +192,8 @@ func (r *SQLiteRepository) Get(ctx context.Context, id string) (*Session, error)
 func (r *SQLiteRepository) GetActive(ctx context.Context) (*Session, error) {
 	query := `
 		SELECT id, plan_id, chunk_id, start_time, end_time, duration_minutes,
-			notes, artifacts, cards_created, created_at
+			notes, artifacts, cards_created, created_at, paused_at, paused_seconds,
+			reflection
 		FROM sessions
 		WHERE end_time IS NULL
 		ORDER BY start_time DESC
@@ -132,10 +218,20 @@ func (r *SQLiteRepository) Update(ctx context.Context, session *Session) error {
 		return fmt.Errorf("failed to marshal artifacts: %w", err)
 	}
 
+	notes, err := r.storedNotes(session.Notes)
+	if err != nil {
+		return err
+	}
+	reflection, err := r.storedReflection(session.Reflection)
+	if err != nil {
+		return err
+	}
+
 	query := `
 		UPDATE sessions
 		SET plan_id = ?, chunk_id = ?, start_time = ?, end_time = ?,
-			duration_minutes = ?, notes = ?, artifacts = ?, cards_created = ?
+			duration_minutes = ?, notes = ?, artifacts = ?, cards_created = ?,
+			paused_at = ?, paused_seconds = ?, reflection = ?
 		WHERE id = ?
 	`
 
@@ -145,9 +241,12 @@ func (r *SQLiteRepository) Update(ctx context.Context, session *Session) error {
 		session.StartTime,
 		nullTime(session.EndTime),
 		session.Duration,
-		session.Notes,
+		notes,
 		string(artifactsJSON),
 		session.CardsCreated,
+		nullTime(session.PausedAt),
+		session.PausedSeconds,
+		reflection,
 		session.ID,
 	)
 
@@ -161,77 +260,99 @@ func (r *SQLiteRepository) Update(ctx context.Context, session *Session) error {
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("session not found: %s", session.ID)
+		return fmt.Errorf("%w: %s", ErrSessionNotFound, session.ID)
 	}
 
 	return nil
 }
 
-// List retrieves sessions for a plan, ordered by start time descending.
-// If planID is empty, returns recent sessions across all plans.
-func (r *SQLiteRepository) List(ctx context.Context, planID string, limit int) ([]*Session, error) {
-	var query string
-	var rows *sql.Rows
-	var err error
-
-	// Handle empty planID as "all plans"
-	if planID == "" {
-		if limit > 0 {
-			query = `
-				SELECT id, plan_id, chunk_id, start_time, end_time, duration_minutes,
-					notes, artifacts, cards_created, created_at
-				FROM sessions
-				ORDER BY start_time DESC
-				LIMIT ?
-			`
-			rows, err = r.db.DB().QueryContext(ctx, query, limit)
-		} else {
-			query = `
-				SELECT id, plan_id, chunk_id, start_time, end_time, duration_minutes,
-					notes, artifacts, cards_created, created_at
-				FROM sessions
-				ORDER BY start_time DESC
-			`
-			rows, err = r.db.DB().QueryContext(ctx, query)
-		}
-	} else {
-		// Filter by specific plan
-		if limit > 0 {
-			query = `
-				SELECT id, plan_id, chunk_id, start_time, end_time, duration_minutes,
-					notes, artifacts, cards_created, created_at
-				FROM sessions
-				WHERE plan_id = ?
-				ORDER BY start_time DESC
-				LIMIT ?
-			`
-			rows, err = r.db.DB().QueryContext(ctx, query, planID, limit)
-		} else {
-			// No limit - return all sessions for the plan
-			query = `
-				SELECT id, plan_id, chunk_id, start_time, end_time, duration_minutes,
-					notes, artifacts, cards_created, created_at
-				FROM sessions
-				WHERE plan_id = ?
-				ORDER BY start_time DESC
-			`
-			rows, err = r.db.DB().QueryContext(ctx, query, planID)
-		}
+// List retrieves the sessions that pass filter, ordered by start time
+// descending. A nil filter returns every session.
+func (r *SQLiteRepository) List(ctx context.Context, filter *Filter) ([]*Session, error) {
+	query := `
+		SELECT id, plan_id, chunk_id, start_time, end_time, duration_minutes,
+			notes, artifacts, cards_created, created_at, paused_at, paused_seconds,
+			reflection
+		FROM sessions
+	`
+	conditions, args := buildSessionWhere(filter)
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
 	}
+	query += " ORDER BY start_time DESC"
 
+	// Notes are filtered after decryption, so the limit has to wait too
+	if filter != nil && filter.Limit > 0 && filter.NoteContains == "" {
+		query += " LIMIT ?"
+		args = append(args, filter.Limit)
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list sessions: %w", err)
 	}
 	defer rows.Close()
 
-	return r.scanSessions(rows)
+	sessions, err := r.scanSessions(rows)
+	if err != nil {
+		return nil, err
+	}
+	if filter == nil || filter.NoteContains == "" {
+		return sessions, nil
+	}
+
+	matched := make([]*Session, 0, len(sessions))
+	for _, sess := range sessions {
+		if !containsFold(sess.Notes, filter.NoteContains) {
+			continue
+		}
+		matched = append(matched, sess)
+		if filter.Limit > 0 && len(matched) >= filter.Limit {
+			break
+		}
+	}
+	return matched, nil
+}
+
+// buildSessionWhere turns every condition of filter but the note text into
+// SQL. Start times are stored with their UTC offset, so they are compared
+// through julianday rather than as text.
+func buildSessionWhere(filter *Filter) ([]string, []interface{}) {
+	if filter == nil {
+		return nil, nil
+	}
+
+	var conditions []string
+	var args []interface{}
+	if filter.PlanID != "" {
+		conditions = append(conditions, "plan_id = ?")
+		args = append(args, filter.PlanID)
+	}
+	if filter.ChunkID != "" {
+		conditions = append(conditions, "chunk_id = ?")
+		args = append(args, filter.ChunkID)
+	}
+	if !filter.Since.IsZero() {
+		conditions = append(conditions, "julianday(start_time) >= julianday(?)")
+		args = append(args, filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		conditions = append(conditions, "julianday(start_time) < julianday(?)")
+		args = append(args, filter.Until)
+	}
+	if filter.MinMinutes > 0 {
+		conditions = append(conditions, "end_time IS NOT NULL AND duration_minutes >= ?")
+		args = append(args, filter.MinMinutes)
+	}
+	return conditions, args
 }
 
 // GetByPlan retrieves all sessions for a specific plan.
 func (r *SQLiteRepository) GetByPlan(ctx context.Context, planID string) ([]*Session, error) {
 	query := `
 		SELECT id, plan_id, chunk_id, start_time, end_time, duration_minutes,
-			notes, artifacts, cards_created, created_at
+			notes, artifacts, cards_created, created_at, paused_at, paused_seconds,
+			reflection
 		FROM sessions
 		WHERE plan_id = ?
 		ORDER BY start_time DESC
@@ -261,7 +382,7 @@ func (r *SQLiteRepository) Delete(ctx context.Context, id string) error {
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("session not found: %s", id)
+		return fmt.Errorf("%w: %s", ErrSessionNotFound, id)
 	}
 
 	return nil
@@ -271,8 +392,9 @@ func (r *SQLiteRepository) Delete(ctx context.Context, id string) error {
 func (r *SQLiteRepository) scanSession(row *sql.Row) (*Session, error) {
 	var session Session
 	var chunkID sql.NullString
-	var endTime sql.NullTime
+	var endTime, pausedAt sql.NullTime
 	var artifactsJSON string
+	var reflection sql.NullString
 
 	err := row.Scan(
 		&session.ID,
@@ -285,6 +407,9 @@ func (r *SQLiteRepository) scanSession(row *sql.Row) (*Session, error) {
 		&artifactsJSON,
 		&session.CardsCreated,
 		&session.CreatedAt,
+		&pausedAt,
+		&session.PausedSeconds,
+		&reflection,
 	)
 
 	if err != nil {
@@ -301,6 +426,11 @@ func (r *SQLiteRepository) scanSession(row *sql.Row) (*Session, error) {
 		session.EndTime = &t
 	}
 
+	if pausedAt.Valid {
+		t := pausedAt.Time
+		session.PausedAt = &t
+	}
+
 	// Unmarshal artifacts
 	if artifactsJSON != "" && artifactsJSON != "null" {
 		if err := json.Unmarshal([]byte(artifactsJSON), &session.Artifacts); err != nil {
@@ -308,6 +438,13 @@ func (r *SQLiteRepository) scanSession(row *sql.Row) (*Session, error) {
 		}
 	}
 
+	if session.Notes, err = r.readNotes(session.Notes); err != nil {
+		return nil, err
+	}
+	if session.Reflection, err = r.readReflection(reflection); err != nil {
+		return nil, err
+	}
+
 	return &session, nil
 }
 
@@ -318,8 +455,9 @@ func (r *SQLiteRepository) scanSessions(rows *sql.Rows) ([]*Session, error) {
 	for rows.Next() {
 		var session Session
 		var chunkID sql.NullString
-		var endTime sql.NullTime
+		var endTime, pausedAt sql.NullTime
 		var artifactsJSON string
+		var reflection sql.NullString
 
 		err := rows.Scan(
 			&session.ID,
@@ -332,6 +470,9 @@ func (r *SQLiteRepository) scanSessions(rows *sql.Rows) ([]*Session, error) {
 			&artifactsJSON,
 			&session.CardsCreated,
 			&session.CreatedAt,
+			&pausedAt,
+			&session.PausedSeconds,
+			&reflection,
 		)
 
 		if err != nil {
@@ -348,6 +489,11 @@ func (r *SQLiteRepository) scanSessions(rows *sql.Rows) ([]*Session, error) {
 			session.EndTime = &t
 		}
 
+		if pausedAt.Valid {
+			t := pausedAt.Time
+			session.PausedAt = &t
+		}
+
 		// Unmarshal artifacts
 		if artifactsJSON != "" && artifactsJSON != "null" {
 			if err := json.Unmarshal([]byte(artifactsJSON), &session.Artifacts); err != nil {
@@ -355,6 +501,13 @@ func (r *SQLiteRepository) scanSessions(rows *sql.Rows) ([]*Session, error) {
 			}
 		}
 
+		if session.Notes, err = r.readNotes(session.Notes); err != nil {
+			return nil, err
+		}
+		if session.Reflection, err = r.readReflection(reflection); err != nil {
+			return nil, err
+		}
+
 		sessions = append(sessions, &session)
 	}
 
@@ -365,6 +518,32 @@ func (r *SQLiteRepository) scanSessions(rows *sql.Rows) ([]*Session, error) {
 	return sessions, nil
 }
 
+// CountNotes counts the sessions whose notes are encrypted and those whose
+// notes are in plaintext, without needing the passphrase.
+func CountNotes(ctx context.Context, db *storage.SQLiteDB) (encrypted, plaintext int, err error) {
+	rows, err := db.DB().QueryContext(ctx, `SELECT notes FROM sessions WHERE notes IS NOT NULL AND notes != ''`)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to count notes: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var notes string
+		if err := rows.Scan(&notes); err != nil {
+			return 0, 0, fmt.Errorf("failed to scan notes: %w", err)
+		}
+		if storage.IsEncryptedString(notes) {
+			encrypted++
+		} else {
+			plaintext++
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, 0, fmt.Errorf("error iterating notes: %w", err)
+	}
+	return encrypted, plaintext, nil
+}
+
 // nullString converts a string to sql.NullString.
 func nullString(s string) sql.NullString {
 	if s == "" {
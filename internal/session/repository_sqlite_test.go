@@ -152,7 +152,7 @@ func TestSQLiteRepository_Get_NotFound(t *testing.T) {
 
 	_, err := repo.Get(ctx, "non-existent")
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "session not found")
+	assert.ErrorIs(t, err, ErrSessionNotFound)
 }
 
 func TestSQLiteRepository_GetActive_NoActiveSession(t *testing.T) {
@@ -264,6 +264,36 @@ func TestSQLiteRepository_Update(t *testing.T) {
 	assert.Len(t, retrieved.Artifacts, 1)
 }
 
+func TestSQLiteRepository_Update_Pause(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	createTestPlan(t, db, "test-plan")
+
+	repo := NewSQLiteRepository(db)
+	ctx := context.Background()
+
+	now := time.Now()
+	session := &Session{
+		ID:        uuid.New().String(),
+		PlanID:    "test-plan",
+		StartTime: now,
+		CreatedAt: now,
+	}
+	require.NoError(t, repo.Create(ctx, session))
+
+	session.PausedSeconds = 90
+	require.NoError(t, session.Pause(now.Add(10*time.Minute)))
+	require.NoError(t, repo.Update(ctx, session))
+
+	active, err := repo.GetActive(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, active)
+	assert.True(t, active.IsPaused())
+	assert.WithinDuration(t, now.Add(10*time.Minute), *active.PausedAt, time.Second)
+	assert.Equal(t, 90, active.PausedSeconds)
+}
+
 func TestSQLiteRepository_Update_NotFound(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -280,7 +310,7 @@ func TestSQLiteRepository_Update_NotFound(t *testing.T) {
 
 	err := repo.Update(ctx, session)
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "session not found")
+	assert.ErrorIs(t, err, ErrSessionNotFound)
 }
 
 func TestSQLiteRepository_List(t *testing.T) {
@@ -306,7 +336,7 @@ func TestSQLiteRepository_List(t *testing.T) {
 	}
 
 	// List with limit
-	sessions, err := repo.List(ctx, "test-plan", 3)
+	sessions, err := repo.List(ctx, &Filter{PlanID: "test-plan", Limit: 3})
 	require.NoError(t, err)
 	assert.Len(t, sessions, 3)
 
@@ -324,7 +354,7 @@ func TestSQLiteRepository_List_EmptyResult(t *testing.T) {
 	repo := NewSQLiteRepository(db)
 	ctx := context.Background()
 
-	sessions, err := repo.List(ctx, "test-plan", 10)
+	sessions, err := repo.List(ctx, &Filter{PlanID: "test-plan", Limit: 10})
 	require.NoError(t, err)
 	assert.Len(t, sessions, 0)
 }
@@ -357,7 +387,7 @@ func TestSQLiteRepository_List_AllPlans(t *testing.T) {
 	}
 
 	// List with empty planID should return sessions across all plans
-	sessions, err := repo.List(ctx, "", 10)
+	sessions, err := repo.List(ctx, &Filter{Limit: 10})
 	require.NoError(t, err)
 	assert.Len(t, sessions, 6, "should return sessions from all plans")
 
@@ -449,7 +479,7 @@ func TestSQLiteRepository_Delete(t *testing.T) {
 	// Verify deleted
 	_, err = repo.Get(ctx, session.ID)
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "session not found")
+	assert.ErrorIs(t, err, ErrSessionNotFound)
 }
 
 func TestSQLiteRepository_Delete_NotFound(t *testing.T) {
@@ -461,7 +491,7 @@ func TestSQLiteRepository_Delete_NotFound(t *testing.T) {
 
 	err := repo.Delete(ctx, "non-existent")
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "session not found")
+	assert.ErrorIs(t, err, ErrSessionNotFound)
 }
 
 func TestSQLiteRepository_Artifacts_EmptyArray(t *testing.T) {
@@ -523,3 +553,84 @@ func TestSQLiteRepository_Artifacts_MultipleValues(t *testing.T) {
 	assert.Equal(t, "/path/to/file.md", retrieved.Artifacts[1])
 	assert.Equal(t, "https://example.com/resource", retrieved.Artifacts[2])
 }
+
+func TestSQLiteRepository_EncryptedNotes(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	createTestPlan(t, db, "test-plan")
+	ctx := context.Background()
+
+	// Notes written before encryption was turned on
+	plainRepo := NewSQLiteRepository(db)
+	now := time.Now()
+	older := &Session{ID: uuid.New().String(), PlanID: "test-plan", StartTime: now.Add(-time.Hour), Notes: "old notes", CreatedAt: now}
+	require.NoError(t, plainRepo.Create(ctx, older))
+
+	cipher, err := storage.NewCipher("hunter2", &storage.Paths{BaseDir: t.TempDir()})
+	require.NoError(t, err)
+	repo := NewEncryptedSQLiteRepository(db, cipher)
+
+	session := &Session{ID: uuid.New().String(), PlanID: "test-plan", StartTime: now, Notes: "stuck on lifetimes", CreatedAt: now}
+	require.NoError(t, repo.Create(ctx, session))
+
+	var stored string
+	require.NoError(t, db.DB().QueryRowContext(ctx, "SELECT notes FROM sessions WHERE id = ?", session.ID).Scan(&stored))
+	assert.True(t, storage.IsEncryptedString(stored))
+	assert.NotContains(t, stored, "lifetimes")
+
+	encrypted, plaintext, err := CountNotes(ctx, db)
+	require.NoError(t, err)
+	assert.Equal(t, 1, encrypted)
+	assert.Equal(t, 1, plaintext)
+
+	sessions, err := repo.List(ctx, &Filter{PlanID: "test-plan"})
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, "stuck on lifetimes", sessions[0].Notes)
+	assert.Equal(t, "old notes", sessions[1].Notes)
+
+	grep, err := repo.List(ctx, &Filter{NoteContains: "Lifetimes"})
+	require.NoError(t, err)
+	require.Len(t, grep, 1, "encrypted notes are searched after decryption")
+	assert.Equal(t, session.ID, grep[0].ID)
+
+	_, err = plainRepo.Get(ctx, session.ID)
+	assert.ErrorIs(t, err, storage.ErrNoPassphrase)
+}
+
+func TestSQLiteRepository_Reflection(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	createTestPlan(t, db, "test-plan")
+	ctx := context.Background()
+	repo := NewSQLiteRepository(db)
+	now := time.Now()
+
+	session := &Session{ID: uuid.New().String(), PlanID: "test-plan", StartTime: now, CreatedAt: now}
+	require.NoError(t, repo.Create(ctx, session))
+	got, err := repo.Get(ctx, session.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got.Reflection)
+
+	session.Reflection = &Reflection{Learned: "Pin keeps futures in place", NextStep: "write an executor"}
+	require.NoError(t, repo.Update(ctx, session))
+	got, err = repo.Get(ctx, session.ID)
+	require.NoError(t, err)
+	assert.Equal(t, session.Reflection, got.Reflection)
+
+	cipher, err := storage.NewCipher("hunter2", &storage.Paths{BaseDir: t.TempDir()})
+	require.NoError(t, err)
+	encrypted := NewEncryptedSQLiteRepository(db, cipher)
+	session.Reflection.Blocked = "lifetimes"
+	require.NoError(t, encrypted.Update(ctx, session))
+
+	var stored string
+	require.NoError(t, db.DB().QueryRowContext(ctx, "SELECT reflection FROM sessions WHERE id = ?", session.ID).Scan(&stored))
+	assert.True(t, storage.IsEncryptedString(stored))
+	sessions, err := encrypted.List(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "lifetimes", sessions[0].Reflection.Blocked)
+}
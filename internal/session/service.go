@@ -6,9 +6,11 @@ package session
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/pezware/samedi.dev/internal/events"
 )
 
 // PlanChunk represents a chunk for the session service's needs.
@@ -31,21 +33,85 @@ type PlanService interface {
 	UpdateChunkStatus(ctx context.Context, planID, chunkID, newStatus string) error
 }
 
+// ChunkNoter is implemented by plan services that keep the notes of
+// sessions under their chunk in the plan.
+type ChunkNoter interface {
+	AddChunkNote(ctx context.Context, planID, chunkID, note string, at time.Time) error
+}
+
 // Service provides business logic for session management.
 // It orchestrates between the session repository and plan service.
 type Service struct {
 	repo        Repository
-	planService PlanService // Optional - can be nil
+	planService PlanService    // Optional - can be nil
+	autoAdvance bool           // Update chunk status from session activity
+	events      events.Emitter // Optional - notified when sessions start and stop
+	snapshot    *SnapshotFile  // Optional - cache of the active session for status lines
 }
 
 // NewService creates a new session service.
+// Chunk status auto-advance is enabled by default.
 func NewService(repo Repository, planService PlanService) *Service {
 	return &Service{
 		repo:        repo,
 		planService: planService,
+		autoAdvance: true,
+	}
+}
+
+// SetAutoAdvanceChunks controls whether starting a session marks its chunk
+// in-progress and stopping one marks it completed once enough time is logged.
+func (s *Service) SetAutoAdvanceChunks(enabled bool) {
+	s.autoAdvance = enabled
+}
+
+// SetEmitter sets where session started and stopped events are sent.
+func (s *Service) SetEmitter(emitter events.Emitter) {
+	s.events = emitter
+}
+
+// SetSnapshotFile sets a file kept in step with the active session as
+// sessions start, stop, pause, and resume.
+func (s *Service) SetSnapshotFile(file *SnapshotFile) {
+	s.snapshot = file
+}
+
+// dropSnapshot removes the snapshot before the active session changes in
+// the database. Until saveSnapshot writes it again, readers ask the
+// database, so a crash in between can't leave a stale snapshot behind.
+func (s *Service) dropSnapshot() {
+	if s.snapshot != nil {
+		_ = s.snapshot.Remove()
 	}
 }
 
+// saveSnapshot records active (nil when idle) in the snapshot file, if
+// set. The session is already saved by then, so a failed write only
+// removes the stale snapshot, leaving readers to ask the database.
+func (s *Service) saveSnapshot(active *Session) {
+	if s.snapshot == nil {
+		return
+	}
+	if err := s.snapshot.Write(active); err != nil {
+		_ = s.snapshot.Remove()
+	}
+}
+
+// emit sends an event about sess, if an emitter is set.
+func (s *Service) emit(ctx context.Context, t events.Type, sess *Session) {
+	if s.events == nil {
+		return
+	}
+	e := events.New(t, sess.PlanID)
+	e.SessionID = sess.ID
+	e.ChunkID = sess.ChunkID
+	if t == events.SessionStopped {
+		e.Message = fmt.Sprintf("Finished a %d-minute session on %s", sess.Duration, sess.PlanID)
+		e.Data = map[string]any{"duration_minutes": sess.Duration}
+	}
+	s.events.Emit(ctx, e)
+}
+
 // StartRequest contains parameters for starting a new session.
 type StartRequest struct {
 	PlanID  string
@@ -73,14 +139,13 @@ func (s *Service) Start(ctx context.Context, req StartRequest) (*Session, error)
 	}
 
 	if active != nil {
-		return nil, fmt.Errorf("active session already exists: %s (plan: %s). Stop it with 'samedi stop'", active.ID, active.PlanID)
+		return nil, fmt.Errorf("%w: %s (plan: %s)", ErrActiveSessionExists, active.ID, active.PlanID)
 	}
 
 	// Verify plan exists (if plan service is available)
 	if s.planService != nil {
-		_, err := s.planService.Get(ctx, req.PlanID)
-		if err != nil {
-			return nil, fmt.Errorf("plan not found: %s", req.PlanID)
+		if _, err := s.planService.Get(ctx, req.PlanID); err != nil {
+			return nil, fmt.Errorf("cannot start a session: %w", err)
 		}
 	}
 
@@ -104,12 +169,13 @@ func (s *Service) Start(ctx context.Context, req StartRequest) (*Session, error)
 	}
 
 	// Save to repository
+	s.dropSnapshot()
 	if err := s.repo.Create(ctx, session); err != nil {
 		return nil, fmt.Errorf("failed to create session: %w", err)
 	}
 
 	// Smart inference: Mark chunk as in-progress if it's not-started
-	if s.planService != nil && req.ChunkID != "" {
+	if s.autoAdvance && s.planService != nil && req.ChunkID != "" {
 		chunk, err := s.planService.GetChunk(ctx, req.PlanID, req.ChunkID)
 		if err == nil && chunk.Status == "not-started" {
 			// Best-effort update: silently ignore errors as this is not critical to session creation
@@ -118,13 +184,22 @@ func (s *Service) Start(ctx context.Context, req StartRequest) (*Session, error)
 		}
 	}
 
+	s.saveSnapshot(session)
+	s.emit(ctx, events.SessionStarted, session)
 	return session, nil
 }
 
 // StopRequest contains parameters for stopping an active session.
 type StopRequest struct {
-	Notes     string
-	Artifacts []string
+	Notes      string
+	Artifacts  []string
+	ChunkID    string      // Optional: reassigns the session to this chunk
+	Reflection *Reflection // Optional: answers to the stop reflection questions
+
+	// ConfirmComplete, when set, is asked before the chunk is marked
+	// completed once its logged time reaches the planned duration.
+	// Without it the chunk is completed unasked.
+	ConfirmComplete func(chunkID string, loggedMinutes, plannedMinutes int) bool
 }
 
 // Stop completes the currently active session.
@@ -132,6 +207,7 @@ type StopRequest struct {
 //
 // Returns an error if:
 // - No active session exists
+// - The reassigned chunk does not exist (if planService is configured)
 // - The session update fails
 func (s *Service) Stop(ctx context.Context, req StopRequest) (*Session, error) {
 	// Find active session
@@ -141,7 +217,17 @@ func (s *Service) Stop(ctx context.Context, req StopRequest) (*Session, error) {
 	}
 
 	if session == nil {
-		return nil, fmt.Errorf("no active session to stop. Start one with 'samedi start <plan-id>'")
+		return nil, fmt.Errorf("%w to stop", ErrNoActiveSession)
+	}
+
+	// Reassign the chunk if the session turned out to cover a different one
+	if req.ChunkID != "" && req.ChunkID != session.ChunkID {
+		if s.planService != nil {
+			if _, err := s.planService.GetChunk(ctx, session.PlanID, req.ChunkID); err != nil {
+				return nil, fmt.Errorf("cannot reassign the session: %w", err)
+			}
+		}
+		session.ChunkID = req.ChunkID
 	}
 
 	// Complete the session
@@ -160,23 +246,36 @@ func (s *Service) Stop(ctx context.Context, req StopRequest) (*Session, error) {
 		session.AddArtifact(artifact)
 	}
 
+	if !req.Reflection.IsEmpty() {
+		session.Reflection = req.Reflection
+	}
+
 	// Validate the updated session
 	if err := session.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid session after update: %w", err)
 	}
 
 	// Update in repository
+	s.dropSnapshot()
 	if err := s.repo.Update(ctx, session); err != nil {
 		return nil, fmt.Errorf("failed to update session: %w", err)
 	}
 
+	// Gather the notes under the chunk; best-effort like the status update
+	if noter, ok := s.planService.(ChunkNoter); ok && req.Notes != "" && session.ChunkID != "" {
+		//nolint:errcheck // the session is stopped; the plan can miss the note
+		noter.AddChunkNote(ctx, session.PlanID, session.ChunkID, req.Notes, now)
+	}
+
 	// Smart inference: Auto-complete chunk if total time >= chunk duration
 	// Best-effort update: silently ignore errors as session was successfully stopped
-	if s.planService != nil && session.ChunkID != "" {
+	if s.autoAdvance && s.planService != nil && session.ChunkID != "" {
 		//nolint:errcheck // intentionally ignoring error for best-effort status update
-		s.checkAndCompleteChunk(ctx, session.PlanID, session.ChunkID)
+		s.checkAndCompleteChunk(ctx, session.PlanID, session.ChunkID, req.ConfirmComplete)
 	}
 
+	s.saveSnapshot(nil)
+	s.emit(ctx, events.SessionStopped, session)
 	return session, nil
 }
 
@@ -191,6 +290,43 @@ func (s *Service) GetActive(ctx context.Context) (*Session, error) {
 	return session, nil
 }
 
+// Pause stops the clock on the active session without ending it. Time
+// spent paused is left out of the session's duration.
+func (s *Service) Pause(ctx context.Context) (*Session, error) {
+	return s.updateActive(ctx, func(session *Session) error {
+		return session.Pause(time.Now())
+	})
+}
+
+// Resume restarts the clock on the paused active session.
+func (s *Service) Resume(ctx context.Context) (*Session, error) {
+	return s.updateActive(ctx, func(session *Session) error {
+		return session.Resume(time.Now())
+	})
+}
+
+// updateActive applies change to the active session and saves it.
+func (s *Service) updateActive(ctx context.Context, change func(*Session) error) (*Session, error) {
+	session, err := s.repo.GetActive(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active session: %w", err)
+	}
+	if session == nil {
+		return nil, ErrNoActiveSession
+	}
+
+	if err := change(session); err != nil {
+		return nil, err
+	}
+	s.dropSnapshot()
+	if err := s.repo.Update(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to update session: %w", err)
+	}
+
+	s.saveSnapshot(session)
+	return session, nil
+}
+
 // Status represents the current state of sessions.
 type Status struct {
 	Active  *Session   // Currently active session, or nil
@@ -218,7 +354,7 @@ func (s *Service) GetStatus(ctx context.Context) (*Status, error) {
 		planID = "" // Empty planID means "all plans"
 	}
 
-	recent, err = s.repo.List(ctx, planID, 5)
+	recent, err = s.repo.List(ctx, &Filter{PlanID: planID, Limit: 5})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get recent sessions: %w", err)
 	}
@@ -238,7 +374,7 @@ func (s *Service) List(ctx context.Context, planID string, limit int) ([]*Sessio
 		return nil, fmt.Errorf("plan ID cannot be empty")
 	}
 
-	sessions, err := s.repo.List(ctx, planID, limit)
+	sessions, err := s.repo.List(ctx, &Filter{PlanID: planID, Limit: limit})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list sessions: %w", err)
 	}
@@ -248,13 +384,88 @@ func (s *Service) List(ctx context.Context, planID string, limit int) ([]*Sessio
 
 // ListAll retrieves all sessions across all plans ordered by start time descending.
 func (s *Service) ListAll(ctx context.Context) ([]*Session, error) {
-	sessions, err := s.repo.List(ctx, "", 0)
+	sessions, err := s.repo.List(ctx, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list sessions: %w", err)
 	}
 	return sessions, nil
 }
 
+// Query retrieves the sessions that pass filter, across all plans unless
+// the filter names one, ordered by start time descending.
+func (s *Service) Query(ctx context.Context, filter *Filter) ([]*Session, error) {
+	sessions, err := s.repo.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+// Totals sums the sessions that pass filter without loading them.
+func (s *Service) Totals(ctx context.Context, filter *Filter) (*Totals, error) {
+	totals, err := SumSessions(ctx, s.repo, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to total sessions: %w", err)
+	}
+	return totals, nil
+}
+
+// DailyTotals sums the sessions that pass filter per day, bucketed in loc
+// or, when loc is nil, in each session's own zone.
+func (s *Service) DailyTotals(ctx context.Context, filter *Filter, loc *time.Location) ([]DayTotal, error) {
+	totals, err := SumSessionsByDay(ctx, s.repo, filter, loc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to total sessions by day: %w", err)
+	}
+	return totals, nil
+}
+
+// Find retrieves the session whose ID is or starts with idPrefix, so short
+// IDs as shown in listings can be used. The prefix must be unambiguous.
+func (s *Service) Find(ctx context.Context, idPrefix string) (*Session, error) {
+	if idPrefix == "" {
+		return nil, fmt.Errorf("session ID cannot be empty")
+	}
+
+	sessions, err := s.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var match *Session
+	for _, sess := range sessions {
+		if sess.ID == idPrefix {
+			return sess, nil
+		}
+		if !strings.HasPrefix(sess.ID, idPrefix) {
+			continue
+		}
+		if match != nil {
+			return nil, fmt.Errorf("session ID %q is ambiguous", idPrefix)
+		}
+		match = sess
+	}
+	if match == nil {
+		return nil, fmt.Errorf("%w: %s", ErrSessionNotFound, idPrefix)
+	}
+	return match, nil
+}
+
+// UpdateNotes replaces a session's notes and returns the updated session.
+func (s *Service) UpdateNotes(ctx context.Context, id, notes string) (*Session, error) {
+	session, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	session.Notes = strings.TrimSpace(notes)
+	if err := s.repo.Update(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to update session: %w", err)
+	}
+
+	return session, nil
+}
+
 // GetByPlan retrieves all sessions for a specific plan.
 func (s *Service) GetByPlan(ctx context.Context, planID string) ([]*Session, error) {
 	if planID == "" {
@@ -350,8 +561,9 @@ func (s *Service) GetChunkStats(ctx context.Context, planID, chunkID string) (*C
 }
 
 // checkAndCompleteChunk checks if a chunk should be auto-completed based on session time.
-// If total session time for the chunk >= chunk duration, marks it as completed.
-func (s *Service) checkAndCompleteChunk(ctx context.Context, planID, chunkID string) error {
+// If total session time for the chunk >= chunk duration, marks it as completed,
+// provided confirm (when not nil) agrees.
+func (s *Service) checkAndCompleteChunk(ctx context.Context, planID, chunkID string, confirm func(string, int, int) bool) error {
 	// Get the chunk to find its expected duration
 	chunk, err := s.planService.GetChunk(ctx, planID, chunkID)
 	if err != nil {
@@ -379,6 +591,9 @@ func (s *Service) checkAndCompleteChunk(ctx context.Context, planID, chunkID str
 
 	// If total time >= chunk duration, mark as completed
 	if totalMinutes >= chunk.Duration {
+		if confirm != nil && !confirm(chunkID, totalMinutes, chunk.Duration) {
+			return nil
+		}
 		if err := s.planService.UpdateChunkStatus(ctx, planID, chunkID, "completed"); err != nil {
 			return fmt.Errorf("failed to mark chunk as completed: %w", err)
 		}
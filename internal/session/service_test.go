@@ -5,11 +5,15 @@ package session
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/pezware/samedi.dev/internal/events"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -74,16 +78,15 @@ func (m *MockRepository) Update(_ context.Context, session *Session) error {
 	return nil
 }
 
-func (m *MockRepository) List(_ context.Context, planID string, limit int) ([]*Session, error) {
+func (m *MockRepository) List(_ context.Context, filter *Filter) ([]*Session, error) {
 	if m.listError != nil {
 		return nil, m.listError
 	}
 	sessions := make([]*Session, 0)
 	for _, session := range m.sessions {
-		// Empty planID means "all plans"
-		if planID == "" || session.PlanID == planID {
+		if filter.Matches(session) {
 			sessions = append(sessions, session)
-			if limit > 0 && len(sessions) >= limit {
+			if filter != nil && filter.Limit > 0 && len(sessions) >= filter.Limit {
 				break
 			}
 		}
@@ -248,8 +251,8 @@ func TestService_Start_ActiveSessionExists(t *testing.T) {
 
 	_, err := service.Start(ctx, req)
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "active session already exists")
-	assert.Contains(t, err.Error(), "samedi stop")
+	assert.ErrorIs(t, err, ErrActiveSessionExists)
+	assert.Contains(t, err.Error(), "existing-plan")
 }
 
 func TestService_Start_PlanNotFound(t *testing.T) {
@@ -347,6 +350,76 @@ func TestService_Stop_NoNotes(t *testing.T) {
 	assert.Empty(t, stoppedSession.Notes)
 }
 
+func TestService_Stop_Reflection(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewService(repo, nil)
+	ctx := context.Background()
+
+	_, err := service.Start(ctx, StartRequest{PlanID: "test-plan"})
+	require.NoError(t, err)
+	stopped, err := service.Stop(ctx, StopRequest{Reflection: &Reflection{Learned: "Pin", NextStep: "executor"}})
+	require.NoError(t, err)
+	assert.Equal(t, &Reflection{Learned: "Pin", NextStep: "executor"}, stopped.Reflection)
+
+	_, err = service.Start(ctx, StartRequest{PlanID: "test-plan"})
+	require.NoError(t, err)
+	stopped, err = service.Stop(ctx, StopRequest{Reflection: &Reflection{}})
+	require.NoError(t, err)
+	assert.Nil(t, stopped.Reflection, "unanswered questions store nothing")
+}
+
+func TestService_Stop_ReassignsChunk(t *testing.T) {
+	repo := NewMockRepository()
+	planService := NewMockPlanService()
+	planService.AddPlan("test-plan")
+	planService.AddChunk("test-plan", "chunk-003", 60, "in-progress")
+	planService.AddChunk("test-plan", "chunk-005", 60, "not-started")
+	service := NewService(repo, planService)
+	ctx := context.Background()
+
+	_, err := service.Start(ctx, StartRequest{PlanID: "test-plan", ChunkID: "chunk-003"})
+	require.NoError(t, err)
+
+	_, err = service.Stop(ctx, StopRequest{ChunkID: "chunk-404"})
+	assert.ErrorContains(t, err, "chunk not found: chunk-404")
+
+	stopped, err := service.Stop(ctx, StopRequest{ChunkID: "chunk-005"})
+	require.NoError(t, err)
+	assert.Equal(t, "chunk-005", stopped.ChunkID)
+	assert.Equal(t, "chunk-005", repo.sessions[stopped.ID].ChunkID)
+}
+
+// notingPlanService also keeps session notes under chunks.
+type notingPlanService struct {
+	*MockPlanService
+	notes []string
+}
+
+func (m *notingPlanService) AddChunkNote(_ context.Context, planID, chunkID, note string, _ time.Time) error {
+	m.notes = append(m.notes, planID+"/"+chunkID+": "+note)
+	return nil
+}
+
+func TestService_Stop_AddsChunkNote(t *testing.T) {
+	planService := &notingPlanService{MockPlanService: NewMockPlanService()}
+	planService.AddPlan("test-plan")
+	planService.AddChunk("test-plan", "chunk-003", 60, "in-progress")
+	service := NewService(NewMockRepository(), planService)
+	ctx := context.Background()
+
+	_, err := service.Start(ctx, StartRequest{PlanID: "test-plan", ChunkID: "chunk-003"})
+	require.NoError(t, err)
+	_, err = service.Stop(ctx, StopRequest{Notes: "Pin keeps futures in place"})
+	require.NoError(t, err)
+
+	_, err = service.Start(ctx, StartRequest{PlanID: "test-plan"})
+	require.NoError(t, err)
+	_, err = service.Stop(ctx, StopRequest{Notes: "No chunk, no chunk note"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"test-plan/chunk-003: Pin keeps futures in place"}, planService.notes)
+}
+
 func TestService_Stop_NoActiveSession(t *testing.T) {
 	repo := NewMockRepository()
 	service := NewService(repo, nil)
@@ -356,8 +429,8 @@ func TestService_Stop_NoActiveSession(t *testing.T) {
 
 	_, err := service.Stop(ctx, stopReq)
 	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrNoActiveSession)
 	assert.Contains(t, err.Error(), "no active session to stop")
-	assert.Contains(t, err.Error(), "samedi start")
 }
 
 func TestService_Stop_RepositoryError(t *testing.T) {
@@ -378,6 +451,33 @@ func TestService_Stop_RepositoryError(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to update session")
 }
 
+func TestService_PauseResume(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewService(repo, nil)
+	ctx := context.Background()
+
+	_, err := service.Pause(ctx)
+	assert.ErrorIs(t, err, ErrNoActiveSession)
+
+	started, err := service.Start(ctx, StartRequest{PlanID: "test-plan"})
+	require.NoError(t, err)
+
+	paused, err := service.Pause(ctx)
+	require.NoError(t, err)
+	assert.True(t, paused.IsPaused())
+	assert.True(t, repo.sessions[started.ID].IsPaused())
+
+	_, err = service.Pause(ctx)
+	assert.ErrorContains(t, err, "already paused")
+
+	resumed, err := service.Resume(ctx)
+	require.NoError(t, err)
+	assert.False(t, resumed.IsPaused())
+
+	_, err = service.Resume(ctx)
+	assert.ErrorContains(t, err, "not paused")
+}
+
 func TestService_GetActive_WithActiveSession(t *testing.T) {
 	repo := NewMockRepository()
 	service := NewService(repo, nil)
@@ -651,3 +751,187 @@ func TestService_GetTotalDuration_NoPlan(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 0, totalDuration)
 }
+
+func TestService_AutoAdvanceChunks(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("enabled by default", func(t *testing.T) {
+		planService := NewMockPlanService()
+		planService.AddPlan("test-plan")
+		planService.AddChunk("test-plan", "chunk-001", 0, "not-started")
+		service := NewService(NewMockRepository(), planService)
+
+		_, err := service.Start(ctx, StartRequest{PlanID: "test-plan", ChunkID: "chunk-001"})
+		require.NoError(t, err)
+		assert.Equal(t, "in-progress", planService.chunks["test-plan:chunk-001"].Status)
+
+		time.Sleep(10 * time.Millisecond)
+		_, err = service.Stop(ctx, StopRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, "completed", planService.chunks["test-plan:chunk-001"].Status)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		planService := NewMockPlanService()
+		planService.AddPlan("test-plan")
+		planService.AddChunk("test-plan", "chunk-001", 0, "not-started")
+		service := NewService(NewMockRepository(), planService)
+		service.SetAutoAdvanceChunks(false)
+
+		_, err := service.Start(ctx, StartRequest{PlanID: "test-plan", ChunkID: "chunk-001"})
+		require.NoError(t, err)
+		assert.Equal(t, "not-started", planService.chunks["test-plan:chunk-001"].Status)
+
+		time.Sleep(10 * time.Millisecond)
+		_, err = service.Stop(ctx, StopRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, "not-started", planService.chunks["test-plan:chunk-001"].Status)
+	})
+
+	t.Run("asks before completing", func(t *testing.T) {
+		planService := NewMockPlanService()
+		planService.AddPlan("test-plan")
+		planService.AddChunk("test-plan", "chunk-001", 0, "not-started")
+		service := NewService(NewMockRepository(), planService)
+
+		var asked []string
+		confirm := func(chunkID string, logged, planned int) bool {
+			asked = append(asked, chunkID)
+			assert.GreaterOrEqual(t, logged, planned)
+			return false
+		}
+
+		_, err := service.Start(ctx, StartRequest{PlanID: "test-plan", ChunkID: "chunk-001"})
+		require.NoError(t, err)
+		_, err = service.Stop(ctx, StopRequest{ConfirmComplete: confirm})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"chunk-001"}, asked)
+		assert.Equal(t, "in-progress", planService.chunks["test-plan:chunk-001"].Status, "declined")
+
+		_, err = service.Start(ctx, StartRequest{PlanID: "test-plan", ChunkID: "chunk-001"})
+		require.NoError(t, err)
+		_, err = service.Stop(ctx, StopRequest{ConfirmComplete: func(string, int, int) bool { return true }})
+		require.NoError(t, err)
+		assert.Equal(t, "completed", planService.chunks["test-plan:chunk-001"].Status)
+	})
+}
+
+func TestService_Find(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewService(repo, nil)
+	ctx := context.Background()
+
+	start := time.Now()
+	end := start.Add(time.Hour)
+	for _, id := range []string{"3f2a9c1e-0001", "3f2a9c1e-0002", "7b8c0d2e-0001"} {
+		repo.Create(ctx, &Session{ID: id, PlanID: "test-plan", StartTime: start, EndTime: &end, Duration: 60, CreatedAt: start})
+	}
+
+	sess, err := service.Find(ctx, "7b8c")
+	require.NoError(t, err)
+	assert.Equal(t, "7b8c0d2e-0001", sess.ID)
+
+	sess, err = service.Find(ctx, "3f2a9c1e-0002")
+	require.NoError(t, err)
+	assert.Equal(t, "3f2a9c1e-0002", sess.ID)
+
+	_, err = service.Find(ctx, "3f2a")
+	assert.ErrorContains(t, err, "ambiguous")
+
+	_, err = service.Find(ctx, "ffff")
+	assert.ErrorContains(t, err, "session not found")
+}
+
+func TestService_UpdateNotes(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewService(repo, nil)
+	ctx := context.Background()
+
+	start := time.Now()
+	end := start.Add(time.Hour)
+	repo.Create(ctx, &Session{ID: "s1", PlanID: "test-plan", StartTime: start, EndTime: &end, Duration: 60, Notes: "Old", CreatedAt: start})
+
+	updated, err := service.UpdateNotes(ctx, "s1", "  Rewrote the parser  ")
+	require.NoError(t, err)
+	assert.Equal(t, "Rewrote the parser", updated.Notes)
+
+	stored, err := repo.Get(ctx, "s1")
+	require.NoError(t, err)
+	assert.Equal(t, "Rewrote the parser", stored.Notes)
+
+	_, err = service.UpdateNotes(ctx, "missing", "notes")
+	assert.ErrorContains(t, err, "session not found")
+}
+
+// recordingEmitter collects emitted events.
+type recordingEmitter struct {
+	events []events.Event
+}
+
+func (r *recordingEmitter) Emit(_ context.Context, e events.Event) {
+	r.events = append(r.events, e)
+}
+
+func TestService_EmitsEvents(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewService(repo, nil)
+	emitter := &recordingEmitter{}
+	service.SetEmitter(emitter)
+	ctx := context.Background()
+
+	started, err := service.Start(ctx, StartRequest{PlanID: "test-plan", ChunkID: "chunk-001"})
+	require.NoError(t, err)
+	_, err = service.Stop(ctx, StopRequest{})
+	require.NoError(t, err)
+
+	require.Len(t, emitter.events, 2)
+	assert.Equal(t, events.SessionStarted, emitter.events[0].Type)
+	assert.Equal(t, started.ID, emitter.events[0].SessionID)
+	assert.Equal(t, "chunk-001", emitter.events[0].ChunkID)
+
+	stopped := emitter.events[1]
+	assert.Equal(t, events.SessionStopped, stopped.Type)
+	assert.Equal(t, "test-plan", stopped.PlanID)
+	assert.Contains(t, stopped.Data, "duration_minutes")
+}
+
+func TestService_KeepsSnapshot(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewService(repo, nil)
+	file := NewSnapshotFile(filepath.Join(t.TempDir(), "status.json"))
+	service.SetSnapshotFile(file)
+	ctx := context.Background()
+
+	started, err := service.Start(ctx, StartRequest{PlanID: "test-plan"})
+	require.NoError(t, err)
+	snap, err := file.Read()
+	require.NoError(t, err)
+	assert.Equal(t, started.ID, snap.SessionID)
+
+	_, err = service.Pause(ctx)
+	require.NoError(t, err)
+	snap, err = file.Read()
+	require.NoError(t, err)
+	assert.NotNil(t, snap.PausedAt)
+
+	_, err = service.Stop(ctx, StopRequest{})
+	require.NoError(t, err)
+	snap, err = file.Read()
+	require.NoError(t, err)
+	assert.False(t, snap.Active())
+}
+
+func TestService_DropsSnapshotWhenSaveFails(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewService(repo, nil)
+	file := NewSnapshotFile(filepath.Join(t.TempDir(), "status.json"))
+	require.NoError(t, file.Write(nil))
+	service.SetSnapshotFile(file)
+
+	repo.createError = errors.New("disk full")
+	_, err := service.Start(context.Background(), StartRequest{PlanID: "test-plan"})
+	require.Error(t, err)
+
+	_, err = file.Read()
+	assert.ErrorIs(t, err, os.ErrNotExist, "readers fall back to the database")
+}
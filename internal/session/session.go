@@ -21,6 +21,27 @@ type Session struct {
 	Artifacts    []string   `json:"artifacts,omitempty"`     // URLs or file paths
 	CardsCreated int        `json:"cards_created,omitempty"` // Number of flashcards generated
 	CreatedAt    time.Time  `json:"created_at"`              // Record creation timestamp
+
+	// PausedAt is when the active session was paused (nil while running).
+	// PausedSeconds totals the finished pauses; neither counts toward Duration.
+	PausedAt      *time.Time `json:"paused_at,omitempty"`
+	PausedSeconds int        `json:"paused_seconds,omitempty"`
+
+	// Reflection holds the answers to the questions asked at stop, if any.
+	Reflection *Reflection `json:"reflection,omitempty"`
+}
+
+// Reflection is a learner's look back at a session: what they learned,
+// what got in the way, and what to do next. Any answer may be blank.
+type Reflection struct {
+	Learned  string `json:"learned,omitempty"`
+	Blocked  string `json:"blocked,omitempty"`
+	NextStep string `json:"next_step,omitempty"`
+}
+
+// IsEmpty reports whether no question was answered.
+func (r *Reflection) IsEmpty() bool {
+	return r == nil || (r.Learned == "" && r.Blocked == "" && r.NextStep == "")
 }
 
 // Validate checks if the session has all required fields and valid values.
@@ -57,6 +78,13 @@ func (s *Session) Validate() error {
 		return fmt.Errorf("active session should have zero duration, got %d", s.Duration)
 	}
 
+	if s.PausedSeconds < 0 {
+		return fmt.Errorf("paused seconds cannot be negative, got %d", s.PausedSeconds)
+	}
+	if s.PausedAt != nil && s.EndTime != nil {
+		return fmt.Errorf("completed session cannot be paused")
+	}
+
 	if s.CardsCreated < 0 {
 		return fmt.Errorf("cards created cannot be negative, got %d", s.CardsCreated)
 	}
@@ -69,24 +97,48 @@ func (s *Session) IsActive() bool {
 	return s.EndTime == nil
 }
 
-// CalculateDuration calculates the duration between start and end time in minutes.
-// Returns 0 if the session is still active.
+// IsPaused returns true if the session is active but paused.
+func (s *Session) IsPaused() bool {
+	return s.EndTime == nil && s.PausedAt != nil
+}
+
+// CalculateDuration calculates the duration between start and end time in minutes,
+// leaving out time spent paused. Returns 0 if the session is still active.
 func (s *Session) CalculateDuration() int {
 	if s.EndTime == nil {
 		return 0
 	}
 
-	duration := s.EndTime.Sub(s.StartTime)
+	duration := s.EndTime.Sub(s.StartTime) - time.Duration(s.PausedSeconds)*time.Second
+	if duration < 0 {
+		return 0
+	}
 	return int(duration.Minutes())
 }
 
+// Elapsed returns the time spent learning in an active session as of now:
+// the time since start, less any pauses, frozen while paused. For completed
+// sessions it returns the final duration.
+func (s *Session) Elapsed(now time.Time) time.Duration {
+	if s.EndTime != nil {
+		return time.Duration(s.Duration) * time.Minute
+	}
+	if s.PausedAt != nil {
+		now = *s.PausedAt
+	}
+	elapsed := now.Sub(s.StartTime) - time.Duration(s.PausedSeconds)*time.Second
+	if elapsed < 0 {
+		return 0
+	}
+	return elapsed
+}
+
 // ElapsedMinutes returns the current elapsed time for active sessions,
 // or the final duration for completed sessions.
 func (s *Session) ElapsedMinutes() int {
 	if s.EndTime == nil {
 		// Active session - calculate from start to now
-		duration := time.Since(s.StartTime)
-		return int(duration.Minutes())
+		return int(s.Elapsed(time.Now()).Minutes())
 	}
 	// Completed session - return stored duration
 	return s.Duration
@@ -116,11 +168,49 @@ func (s *Session) Complete(endTime time.Time) error {
 		return fmt.Errorf("end time cannot be before start time")
 	}
 
+	// Stopping while paused ends the pause where the session ends
+	if s.PausedAt != nil {
+		if err := s.Resume(endTime); err != nil {
+			return err
+		}
+	}
+
 	s.EndTime = &endTime
 	s.Duration = s.CalculateDuration()
 	return nil
 }
 
+// Pause stops the clock on an active session at the given time.
+func (s *Session) Pause(at time.Time) error {
+	if !s.IsActive() {
+		return fmt.Errorf("session is already complete")
+	}
+	if s.PausedAt != nil {
+		return fmt.Errorf("session is already paused")
+	}
+	if at.Before(s.StartTime) {
+		return fmt.Errorf("pause time cannot be before start time")
+	}
+
+	s.PausedAt = &at
+	return nil
+}
+
+// Resume restarts the clock on a paused session, adding the pause to
+// PausedSeconds.
+func (s *Session) Resume(at time.Time) error {
+	if s.PausedAt == nil {
+		return fmt.Errorf("session is not paused")
+	}
+	if at.Before(*s.PausedAt) {
+		at = *s.PausedAt
+	}
+
+	s.PausedSeconds += int(at.Sub(*s.PausedAt).Seconds())
+	s.PausedAt = nil
+	return nil
+}
+
 // AddNotes appends notes to the session.
 func (s *Session) AddNotes(notes string) {
 	if s.Notes == "" {
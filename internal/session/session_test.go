@@ -388,6 +388,36 @@ func TestSession_Complete_EndTimeBeforeStart(t *testing.T) {
 	assert.Contains(t, err.Error(), "end time cannot be before start time")
 }
 
+func TestSession_PauseResume(t *testing.T) {
+	start := time.Now().Add(-2 * time.Hour)
+	session := &Session{
+		ID:        "test-session-id",
+		PlanID:    "test-plan",
+		StartTime: start,
+		CreatedAt: start,
+	}
+
+	require.NoError(t, session.Pause(start.Add(30*time.Minute)))
+	assert.True(t, session.IsPaused())
+	assert.Error(t, session.Pause(start.Add(40*time.Minute)), "already paused")
+
+	// The clock stays frozen while paused
+	assert.Equal(t, 30*time.Minute, session.Elapsed(start.Add(time.Hour)))
+
+	require.NoError(t, session.Resume(start.Add(50*time.Minute)))
+	assert.False(t, session.IsPaused())
+	assert.Equal(t, 20*60, session.PausedSeconds)
+	assert.Equal(t, 40*time.Minute, session.Elapsed(start.Add(time.Hour)))
+	assert.Error(t, session.Resume(start.Add(time.Hour)), "not paused")
+
+	// Stopping while paused ends the pause at the stop time
+	require.NoError(t, session.Pause(start.Add(70*time.Minute)))
+	require.NoError(t, session.Complete(start.Add(80*time.Minute)))
+	assert.Nil(t, session.PausedAt)
+	assert.Equal(t, 50, session.Duration)
+	assert.NoError(t, session.Validate())
+}
+
 func TestSession_AddNotes_EmptyNotes(t *testing.T) {
 	session := &Session{}
 	session.AddNotes("First note")
@@ -0,0 +1,122 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package session
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Snapshot is the active session as cached on disk: just enough to draw
+// a status line. Notes and artifacts are left out so an encrypted data
+// directory doesn't leak them in plaintext.
+type Snapshot struct {
+	SessionID     string     `json:"session_id,omitempty"` // Empty when no session is active
+	PlanID        string     `json:"plan_id,omitempty"`
+	ChunkID       string     `json:"chunk_id,omitempty"`
+	StartTime     time.Time  `json:"start_time,omitzero"`
+	PausedAt      *time.Time `json:"paused_at,omitempty"`
+	PausedSeconds int        `json:"paused_seconds,omitempty"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// Active reports whether a session was active when the snapshot was taken.
+func (s *Snapshot) Active() bool {
+	return s.SessionID != ""
+}
+
+// Session rebuilds the cached session, without notes or artifacts, or
+// returns nil if none was active.
+func (s *Snapshot) Session() *Session {
+	if !s.Active() {
+		return nil
+	}
+	return &Session{
+		ID:            s.SessionID,
+		PlanID:        s.PlanID,
+		ChunkID:       s.ChunkID,
+		StartTime:     s.StartTime,
+		PausedAt:      s.PausedAt,
+		PausedSeconds: s.PausedSeconds,
+	}
+}
+
+// SnapshotFile caches the active session in a small JSON file, so status
+// lines can be drawn without opening the database.
+type SnapshotFile struct {
+	path string
+}
+
+// NewSnapshotFile creates a snapshot file at path.
+func NewSnapshotFile(path string) *SnapshotFile {
+	return &SnapshotFile{path: path}
+}
+
+// Path returns where the snapshot is stored.
+func (f *SnapshotFile) Path() string {
+	return f.path
+}
+
+// Read loads the snapshot. A missing file is reported as os.ErrNotExist.
+func (f *SnapshotFile) Read() (*Snapshot, error) {
+	data, err := os.ReadFile(f.path) // #nosec G304 - path is under the samedi data directory
+	if err != nil {
+		return nil, err
+	}
+	var snap Snapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return nil, fmt.Errorf("failed to parse status snapshot: %w", err)
+	}
+	return &snap, nil
+}
+
+// Write replaces the snapshot with active, or with an idle snapshot when
+// active is nil. The file is swapped in whole so readers never see half
+// of it.
+func (f *SnapshotFile) Write(active *Session) error {
+	snap := Snapshot{UpdatedAt: time.Now()}
+	if active != nil && active.IsActive() {
+		snap.SessionID = active.ID
+		snap.PlanID = active.PlanID
+		snap.ChunkID = active.ChunkID
+		snap.StartTime = active.StartTime
+		snap.PausedAt = active.PausedAt
+		snap.PausedSeconds = active.PausedSeconds
+	}
+	data, err := json.Marshal(snap)
+	if err != nil {
+		return fmt.Errorf("failed to encode status snapshot: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(f.path), 0o700); err != nil {
+		return fmt.Errorf("failed to create state directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(f.path), ".status-*.json")
+	if err != nil {
+		return fmt.Errorf("failed to write status snapshot: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write status snapshot: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write status snapshot: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), f.path); err != nil {
+		return fmt.Errorf("failed to write status snapshot: %w", err)
+	}
+	return nil
+}
+
+// Remove deletes the snapshot, so readers fall back to the database.
+func (f *SnapshotFile) Remove() error {
+	if err := os.Remove(f.path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove status snapshot: %w", err)
+	}
+	return nil
+}
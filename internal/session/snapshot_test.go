@@ -0,0 +1,52 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package session
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotFile_WriteRead(t *testing.T) {
+	file := NewSnapshotFile(filepath.Join(t.TempDir(), "state", "status.json"))
+
+	_, err := file.Read()
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	start := time.Now().Add(-30 * time.Minute).Truncate(time.Second)
+	require.NoError(t, file.Write(&Session{
+		ID:        "s1",
+		PlanID:    "rust",
+		ChunkID:   "chunk-001",
+		StartTime: start,
+		Notes:     "private notes",
+		Artifacts: []string{"https://example.com"},
+	}))
+
+	data, err := os.ReadFile(file.Path())
+	require.NoError(t, err)
+	assert.False(t, strings.Contains(string(data), "private"), "notes stay out of the snapshot")
+
+	snap, err := file.Read()
+	require.NoError(t, err)
+	require.True(t, snap.Active())
+	sess := snap.Session()
+	assert.Equal(t, "chunk-001", sess.ChunkID)
+	assert.True(t, sess.StartTime.Equal(start))
+
+	require.NoError(t, file.Write(nil))
+	snap, err = file.Read()
+	require.NoError(t, err)
+	assert.False(t, snap.Active())
+	assert.Nil(t, snap.Session())
+
+	require.NoError(t, file.Remove())
+	require.NoError(t, file.Remove(), "removing twice is fine")
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package stats
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+// badgeTitleLimit is the longest plan title a badge shows before
+// truncating it.
+const badgeTitleLimit = 40
+
+// Badge colors: the label background, the unfilled value background, and
+// the fill by progress.
+const (
+	badgeLabelColor = "#555"
+	badgeTrackColor = "#9f9f9f"
+	badgeLowColor   = "#e05d44" // Under 25%
+	badgeMidColor   = "#dfb317" // Under 75%
+	badgeHighColor  = "#4c1"    // 75% and up
+)
+
+// RenderBadge renders a plan's progress as a flat SVG badge: the plan
+// title on the left, and the percent complete and hours spent on the
+// right over a bar filled to the plan's progress. Plans with sub-plans
+// show the totals of the whole tree. The SVG is self-contained and loads
+// nothing from the network.
+func RenderBadge(ps *PlanStats) string {
+	progress, hours := ps.Progress, ps.TotalHours
+	if ps.Rollup != nil {
+		progress, hours = ps.Rollup.Progress, ps.Rollup.TotalHours
+	}
+	progress = math.Min(math.Max(progress, 0), 1)
+
+	label := ps.PlanTitle
+	if utf8.RuneCountInString(label) > badgeTitleLimit {
+		label = string([]rune(label)[:badgeTitleLimit-1]) + "…"
+	}
+	value := fmt.Sprintf("%.0f%% · %.1fh", progress*100, hours)
+
+	labelWidth := badgeTextWidth(label) + 20
+	valueWidth := badgeTextWidth(value) + 20
+	width := labelWidth + valueWidth
+	fill := int(math.Round(progress * float64(valueWidth)))
+
+	title := html.EscapeString(fmt.Sprintf("%s: %s", ps.PlanTitle, value))
+	label = html.EscapeString(label)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="20" role="img" aria-label="%s">`+"\n", width, title)
+	fmt.Fprintf(&b, "  <title>%s</title>\n", title)
+	b.WriteString(`  <linearGradient id="s" x2="0" y2="100%"><stop offset="0" stop-color="#bbb" stop-opacity=".1"/><stop offset="1" stop-opacity=".1"/></linearGradient>` + "\n")
+	fmt.Fprintf(&b, `  <clipPath id="r"><rect width="%d" height="20" rx="3" fill="#fff"/></clipPath>`+"\n", width)
+	b.WriteString(`  <g clip-path="url(#r)">` + "\n")
+	fmt.Fprintf(&b, `    <rect width="%d" height="20" fill="%s"/>`+"\n", labelWidth, badgeLabelColor)
+	fmt.Fprintf(&b, `    <rect x="%d" width="%d" height="20" fill="%s"/>`+"\n", labelWidth, valueWidth, badgeTrackColor)
+	if fill > 0 {
+		fmt.Fprintf(&b, `    <rect x="%d" width="%d" height="20" fill="%s"/>`+"\n", labelWidth, fill, badgeColor(progress))
+	}
+	fmt.Fprintf(&b, `    <rect width="%d" height="20" fill="url(#s)"/>`+"\n", width)
+	b.WriteString("  </g>\n")
+	b.WriteString(`  <g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11">` + "\n")
+	writeBadgeText(&b, labelWidth/2, label)
+	writeBadgeText(&b, labelWidth+valueWidth/2, html.EscapeString(value))
+	b.WriteString("  </g>\n")
+	b.WriteString("</svg>\n")
+	return b.String()
+}
+
+// writeBadgeText writes text centered at x with a drop shadow.
+func writeBadgeText(b *strings.Builder, x int, text string) {
+	fmt.Fprintf(b, `    <text x="%d" y="15" fill="#010101" fill-opacity=".3">%s</text>`+"\n", x, text)
+	fmt.Fprintf(b, `    <text x="%d" y="14">%s</text>`+"\n", x, text)
+}
+
+// badgeColor picks the fill color for progress.
+func badgeColor(progress float64) string {
+	switch {
+	case progress < 0.25:
+		return badgeLowColor
+	case progress < 0.75:
+		return badgeMidColor
+	default:
+		return badgeHighColor
+	}
+}
+
+// badgeTextWidth estimates the width in pixels of text in 11px Verdana.
+// Badges are rendered without measuring fonts, so narrow and wide glyphs
+// get rough widths of their own.
+func badgeTextWidth(text string) int {
+	width := 0.0
+	for _, r := range text {
+		switch {
+		case strings.ContainsRune("iIjl.,:;'|!()[] ", r):
+			width += 4
+		case strings.ContainsRune("mwMW%", r):
+			width += 11
+		case r >= 'A' && r <= 'Z':
+			width += 8
+		case r < 0x80:
+			width += 7
+		default:
+			width += 9 // Accented letters, CJK, and symbols run wide
+		}
+	}
+	return int(math.Ceil(width))
+}
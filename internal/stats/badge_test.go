@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package stats
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderBadge(t *testing.T) {
+	badge := RenderBadge(&PlanStats{PlanID: "rust", PlanTitle: "Rust <Async> & Tokio", Progress: 0.42, TotalHours: 12.5})
+
+	assert.True(t, strings.HasPrefix(badge, `<svg xmlns="http://www.w3.org/2000/svg"`))
+	assert.Contains(t, badge, "Rust &lt;Async&gt; &amp; Tokio", "titles are escaped")
+	assert.Contains(t, badge, "42% · 12.5h")
+	assert.Contains(t, badge, badgeMidColor)
+	assert.NotContains(t, badge, "http://www.w3.org/1999/xlink", "nothing is linked")
+	assert.Equal(t, 1, strings.Count(badge, "http"), "only the SVG namespace is a URL")
+
+	// The output is well-formed XML
+	decoder := xml.NewDecoder(strings.NewReader(badge))
+	for {
+		_, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+	}
+}
+
+func TestRenderBadge_Rollup(t *testing.T) {
+	badge := RenderBadge(&PlanStats{
+		PlanTitle: "Languages",
+		Rollup:    &RollupStats{Progress: 0.8, TotalHours: 40},
+	})
+	assert.Contains(t, badge, "80% · 40.0h")
+	assert.Contains(t, badge, badgeHighColor)
+}
+
+func TestRenderBadge_Empty(t *testing.T) {
+	badge := RenderBadge(&PlanStats{PlanTitle: strings.Repeat("Long title ", 10)})
+	assert.Contains(t, badge, "0% · 0.0h")
+	assert.Contains(t, badge, "…")
+	assert.NotContains(t, badge, badgeLowColor, "no fill without progress")
+}
+
+func TestBadgeTextWidth(t *testing.T) {
+	assert.Less(t, badgeTextWidth("iii"), badgeTextWidth("mmm"))
+	assert.Zero(t, badgeTextWidth(""))
+}
@@ -4,6 +4,7 @@
 package stats
 
 import (
+	"sort"
 	"time"
 
 	"github.com/pezware/samedi.dev/internal/plan"
@@ -64,6 +65,11 @@ func CalculatePlanStats(planID string, sessions []session.Session, p *plan.Plan)
 		PlannedHours: p.TotalHours,
 		TotalChunks:  len(p.Chunks),
 		Status:       string(p.Status),
+		Tags:         p.Tags,
+		SubPlans:     p.Children,
+	}
+	if p.Provenance != nil {
+		stats.GeneratedBy = p.Provenance.String()
 	}
 
 	// Filter sessions for this plan
@@ -100,7 +106,14 @@ func CalculatePlanStats(planID string, sessions []session.Session, p *plan.Plan)
 }
 
 // CalculateDailyStats groups sessions by day and returns daily statistics.
+// Each session falls on the day it started in its own time zone.
 func CalculateDailyStats(sessions []session.Session, timeRange TimeRange) []DailyStats {
+	return CalculateDailyStatsIn(sessions, timeRange, nil)
+}
+
+// CalculateDailyStatsIn groups sessions by the day they started in loc,
+// or in their own zone when loc is nil.
+func CalculateDailyStatsIn(sessions []session.Session, timeRange TimeRange, loc *time.Location) []DailyStats {
 	if len(sessions) == 0 {
 		return []DailyStats{}
 	}
@@ -116,18 +129,17 @@ func CalculateDailyStats(sessions []session.Session, timeRange TimeRange) []Dail
 			continue
 		}
 
+		start := sess.StartTime
+		if loc != nil {
+			start = start.In(loc)
+		}
+
 		// Get day key (date at midnight)
-		dayKey := getDayKey(sess.StartTime)
+		dayKey := getDayKey(start)
 
 		// Initialize daily stats if not exists
 		if dailyMap[dayKey] == nil {
-			dayStart := time.Date(
-				sess.StartTime.Year(),
-				sess.StartTime.Month(),
-				sess.StartTime.Day(),
-				0, 0, 0, 0,
-				sess.StartTime.Location(),
-			)
+			dayStart := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
 			dailyMap[dayKey] = &DailyStats{
 				Date:  dayStart,
 				Plans: []string{},
@@ -156,19 +168,168 @@ func CalculateDailyStats(sessions []session.Session, timeRange TimeRange) []Dail
 	return result
 }
 
+// FillDays returns one entry per calendar day, adding empty days to
+// daily (as returned by CalculateDailyStats) so charts show idle days. The
+// days run from the range start, or the first active day if later, through
+// the range end. With no active days it returns an empty slice.
+func FillDays(daily []DailyStats, timeRange TimeRange) []DailyStats {
+	if len(daily) == 0 {
+		return []DailyStats{}
+	}
+
+	byDay := make(map[string]DailyStats, len(daily))
+	for _, ds := range daily {
+		byDay[getDayKey(ds.Date)] = ds
+	}
+
+	first := daily[0].Date
+	loc := first.Location()
+	start := timeRange.Start.In(loc)
+	if day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc); day.After(first) {
+		first = day
+	}
+	end := timeRange.End.In(loc)
+
+	filled := make([]DailyStats, 0, len(daily))
+	for day := first; !day.After(end); day = day.AddDate(0, 0, 1) {
+		if ds, ok := byDay[getDayKey(day)]; ok {
+			filled = append(filled, ds)
+			continue
+		}
+		filled = append(filled, DailyStats{Date: day, Plans: []string{}})
+	}
+	return filled
+}
+
+// CalculateWeeklyStats rolls daily stats up into weeks, oldest first.
+// Weeks start on Monday, or on Sunday when startsSunday is set. Idle weeks
+// between active ones are included so the weeks can be charted as is.
+func CalculateWeeklyStats(daily []DailyStats, startsSunday bool) []PeriodStats {
+	return rollUpDays(daily, func(day time.Time) time.Time {
+		offset := (int(day.Weekday()) + 6) % 7
+		if startsSunday {
+			offset = int(day.Weekday())
+		}
+		return day.AddDate(0, 0, -offset)
+	}, 0, 7)
+}
+
+// CalculateMonthlyStats rolls daily stats up into calendar months, oldest
+// first, including idle months between active ones.
+func CalculateMonthlyStats(daily []DailyStats) []PeriodStats {
+	return rollUpDays(daily, func(day time.Time) time.Time {
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location())
+	}, 1, 0)
+}
+
+// rollUpDays sums daily stats into periods. periodStart maps a day to the
+// first day of its period, and each period lasts months plus days.
+func rollUpDays(daily []DailyStats, periodStart func(time.Time) time.Time, months, days int) []PeriodStats {
+	if len(daily) == 0 {
+		return []PeriodStats{}
+	}
+
+	byStart := make(map[string]*PeriodStats)
+	plans := make(map[string]map[string]bool)
+	first, last := periodStart(daily[0].Date), periodStart(daily[0].Date)
+	for _, ds := range daily {
+		start := periodStart(ds.Date)
+		key := getDayKey(start)
+		period := byStart[key]
+		if period == nil {
+			period = &PeriodStats{Start: start, End: start.AddDate(0, months, days), Plans: []string{}}
+			byStart[key] = period
+			plans[key] = make(map[string]bool)
+		}
+		period.Duration += ds.Duration
+		period.SessionCount += ds.SessionCount
+		if ds.SessionCount > 0 {
+			period.ActiveDays++
+		}
+		for _, id := range ds.Plans {
+			if !plans[key][id] {
+				plans[key][id] = true
+				period.Plans = append(period.Plans, id)
+			}
+		}
+		if start.Before(first) {
+			first = start
+		}
+		if start.After(last) {
+			last = start
+		}
+	}
+
+	periods := make([]PeriodStats, 0, len(byStart))
+	for start := first; !start.After(last); start = start.AddDate(0, months, days) {
+		period, ok := byStart[getDayKey(start)]
+		if !ok {
+			periods = append(periods, PeriodStats{Start: start, End: start.AddDate(0, months, days), Plans: []string{}})
+			continue
+		}
+		sort.Strings(period.Plans)
+		periods = append(periods, *period)
+	}
+	return periods
+}
+
 // AggregateByPlan creates a map of plan IDs to their statistics.
 func AggregateByPlan(sessions []session.Session, plans []plan.Plan) map[string]PlanStats {
 	result := make(map[string]PlanStats)
 
 	// Calculate stats for each plan
+	children := make(map[string][]string, len(plans))
 	for i := range plans {
 		stats := CalculatePlanStats(plans[i].ID, sessions, &plans[i])
 		result[plans[i].ID] = stats
+		children[plans[i].ID] = plans[i].Children
+	}
+
+	// Roll sub-plans up into their parents
+	for id, stats := range result {
+		stats.Rollup = CalculateRollup(id, result, children)
+		result[id] = stats
 	}
 
 	return result
 }
 
+// CalculateRollup totals the plan planID with all of its sub-plans, found
+// through children. Progress is weighted by chunk, like a single plan's.
+// It returns nil when the plan has no sub-plans in stats.
+func CalculateRollup(planID string, stats map[string]PlanStats, children map[string][]string) *RollupStats {
+	descendants := plan.Descendants(children, planID)
+	if len(descendants) == 0 {
+		return nil
+	}
+
+	rollup := &RollupStats{}
+	totalMinutes := 0.0
+	for _, id := range append([]string{planID}, descendants...) {
+		ps, ok := stats[id]
+		if !ok {
+			continue
+		}
+		if id != planID {
+			rollup.SubPlans++
+		}
+		totalMinutes += ps.TotalHours * 60
+		rollup.PlannedHours += ps.PlannedHours
+		rollup.SessionCount += ps.SessionCount
+		rollup.CompletedChunks += ps.CompletedChunks
+		rollup.TotalChunks += ps.TotalChunks
+	}
+	if rollup.SubPlans == 0 {
+		return nil
+	}
+
+	rollup.TotalHours = totalMinutes / 60
+	if rollup.TotalChunks > 0 {
+		rollup.Progress = float64(rollup.CompletedChunks) / float64(rollup.TotalChunks)
+	}
+	return rollup
+}
+
 // Helper functions
 
 // filterSessionsByPlan returns sessions that belong to the specified plan.
@@ -10,6 +10,7 @@ import (
 	"github.com/pezware/samedi.dev/internal/plan"
 	"github.com/pezware/samedi.dev/internal/session"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCalculateTotalStats(t *testing.T) {
@@ -552,3 +553,135 @@ func TestAggregateByPlan(t *testing.T) {
 func ptrTime(t time.Time) *time.Time {
 	return &t
 }
+
+func TestAggregateByPlan_RollsUpSubPlans(t *testing.T) {
+	start := time.Date(2025, 10, 13, 9, 0, 0, 0, time.UTC)
+	chunks := func(done, total int) []plan.Chunk {
+		result := make([]plan.Chunk, total)
+		for i := range result {
+			result[i] = plan.Chunk{Status: plan.StatusNotStarted}
+			if i < done {
+				result[i].Status = plan.StatusCompleted
+			}
+		}
+		return result
+	}
+
+	plans := []plan.Plan{
+		{ID: "backend", Title: "Backend", TotalHours: 1, Chunks: chunks(0, 2), Children: []string{"go", "sql"}},
+		{ID: "go", Title: "Go", TotalHours: 10, Chunks: chunks(3, 4), Children: []string{"go-concurrency"}},
+		{ID: "go-concurrency", Title: "Concurrency", TotalHours: 4, Chunks: chunks(1, 2)},
+		{ID: "sql", Title: "SQL", TotalHours: 5, Chunks: chunks(0, 2)},
+	}
+	sessions := []session.Session{
+		{PlanID: "go", StartTime: start, Duration: 90},
+		{PlanID: "go-concurrency", StartTime: start, Duration: 30},
+		{PlanID: "sql", StartTime: start, Duration: 60},
+	}
+
+	result := AggregateByPlan(sessions, plans)
+
+	rollup := result["backend"].Rollup
+	if assert.NotNil(t, rollup) {
+		assert.Equal(t, 3, rollup.SubPlans)
+		assert.InDelta(t, 3.0, rollup.TotalHours, 0.001)
+		assert.InDelta(t, 20.0, rollup.PlannedHours, 0.001)
+		assert.Equal(t, 3, rollup.SessionCount)
+		assert.Equal(t, 4, rollup.CompletedChunks)
+		assert.Equal(t, 10, rollup.TotalChunks)
+		assert.InDelta(t, 0.4, rollup.Progress, 0.001)
+	}
+
+	goRollup := result["go"].Rollup
+	if assert.NotNil(t, goRollup) {
+		assert.Equal(t, 1, goRollup.SubPlans)
+		assert.InDelta(t, 2.0, goRollup.TotalHours, 0.001)
+	}
+
+	assert.Nil(t, result["sql"].Rollup, "plans without sub-plans have no rollup")
+	assert.Equal(t, []string{"go", "sql"}, result["backend"].SubPlans)
+}
+
+func TestFillDays(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 10, d, 0, 0, 0, 0, time.UTC) }
+	daily := []DailyStats{
+		{Date: day(2), Duration: 60, SessionCount: 1, Plans: []string{"p1"}},
+		{Date: day(4), Duration: 30, SessionCount: 1, Plans: []string{"p2"}},
+	}
+
+	filled := FillDays(daily, TimeRange{Start: time.Unix(0, 0).UTC(), End: day(5).Add(12 * time.Hour)})
+	require.Len(t, filled, 4, "starts at the first active day, ends on the range's last day")
+	assert.Equal(t, day(2), filled[0].Date)
+	assert.Equal(t, 60, filled[0].Duration)
+	assert.Equal(t, day(3), filled[1].Date)
+	assert.Zero(t, filled[1].Duration)
+	assert.Equal(t, 30, filled[2].Duration)
+	assert.Equal(t, day(5), filled[3].Date)
+
+	filled = FillDays(daily, TimeRange{Start: day(1), End: day(4)})
+	require.Len(t, filled, 3)
+	assert.Equal(t, day(2), filled[0].Date)
+
+	assert.Empty(t, FillDays(nil, TimeRange{Start: day(1), End: day(4)}))
+}
+
+func TestCalculateWeeklyAndMonthlyStats(t *testing.T) {
+	day := func(m time.Month, d int) time.Time { return time.Date(2025, m, d, 0, 0, 0, 0, time.UTC) }
+	daily := []DailyStats{
+		{Date: day(9, 29), Duration: 60, SessionCount: 1, Plans: []string{"rust"}}, // Monday
+		{Date: day(10, 5), Duration: 30, SessionCount: 2, Plans: []string{"go", "rust"}},
+		{Date: day(10, 6), Duration: 45, SessionCount: 1, Plans: []string{"go"}},
+		{Date: day(10, 20), Duration: 90, SessionCount: 1, Plans: []string{"sql"}},
+		{Date: day(11, 2), Duration: 15, SessionCount: 1, Plans: []string{"go"}}, // Sunday
+	}
+
+	weekly := CalculateWeeklyStats(daily, false)
+	require.Len(t, weekly, 5)
+	assert.Equal(t, PeriodStats{Start: day(9, 29), End: day(10, 6), Duration: 90, SessionCount: 3, ActiveDays: 2, Plans: []string{"go", "rust"}}, weekly[0])
+	assert.Equal(t, day(10, 13), weekly[2].Start)
+	assert.Zero(t, weekly[2].SessionCount, "idle weeks between active ones are kept")
+	assert.Equal(t, day(10, 27), weekly[4].Start)
+
+	sundays := CalculateWeeklyStats(daily, true)
+	require.Len(t, sundays, 6)
+	assert.Equal(t, day(9, 28), sundays[0].Start)
+	assert.Equal(t, 75, sundays[1].Duration, "Sunday starts the week")
+	assert.Equal(t, day(11, 2), sundays[5].Start)
+
+	monthly := CalculateMonthlyStats(daily)
+	require.Len(t, monthly, 3)
+	assert.Equal(t, day(10, 1), monthly[1].Start)
+	assert.Equal(t, day(11, 1), monthly[1].End)
+	assert.Equal(t, 165, monthly[1].Duration)
+	assert.Equal(t, 3, monthly[1].ActiveDays)
+	assert.Equal(t, []string{"go", "rust", "sql"}, monthly[1].Plans)
+
+	assert.Empty(t, CalculateWeeklyStats(nil, false))
+	assert.Empty(t, CalculateMonthlyStats(nil))
+}
+
+func TestCalculateDailyStatsIn(t *testing.T) {
+	ny := newYork(t)
+	sessions := []session.Session{
+		createSession("s1", "p1", time.Date(2024, 3, 10, 4, 30, 0, 0, time.UTC), 30), // 23:30 EST on the 9th
+		createSession("s2", "p1", time.Date(2024, 3, 10, 7, 30, 0, 0, time.UTC), 30), // 03:30 EDT on the 10th
+		createSession("s3", "p2", time.Date(2024, 3, 11, 3, 30, 0, 0, time.UTC), 30), // 23:30 EDT on the 10th
+	}
+	all := TimeRange{Start: time.Unix(0, 0), End: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	daily := CalculateDailyStatsIn(sessions, all, ny)
+	require.Len(t, daily, 2)
+	assert.Equal(t, time.Date(2024, 3, 9, 0, 0, 0, 0, ny), daily[0].Date)
+	assert.Equal(t, 1, daily[0].SessionCount)
+	assert.Equal(t, time.Date(2024, 3, 10, 0, 0, 0, 0, ny), daily[1].Date)
+	assert.Equal(t, 2, daily[1].SessionCount)
+	assert.ElementsMatch(t, []string{"p1", "p2"}, daily[1].Plans)
+
+	utc := CalculateDailyStats(sessions, all)
+	require.Len(t, utc, 2, "without a zone each session keeps its own")
+	assert.Equal(t, 2, utc[0].SessionCount)
+
+	filled := FillDays(daily, TimeRange{Start: daily[0].Date, End: time.Date(2024, 3, 12, 12, 0, 0, 0, ny)})
+	require.Len(t, filled, 4, "filling steps over the short day")
+	assert.Equal(t, time.Date(2024, 3, 11, 0, 0, 0, 0, ny), filled[2].Date)
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package stats
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+)
+
+// OverrunThreshold is how far over its planned duration a chunk must run,
+// as a fraction of the plan, before it is flagged as an overrun.
+const OverrunThreshold = 0.5
+
+// ChunkStats compares the time logged against a chunk with its plan.
+type ChunkStats struct {
+	ChunkID         string  `json:"chunk_id"`
+	Title           string  `json:"title"`
+	Status          string  `json:"status"`
+	PlannedMinutes  int     `json:"planned_minutes"`  // Duration from the plan
+	ActualMinutes   int     `json:"actual_minutes"`   // Time logged in sessions
+	SessionCount    int     `json:"session_count"`    // Sessions logged against the chunk
+	VarianceMinutes int     `json:"variance_minutes"` // Actual minus planned
+	VariancePercent float64 `json:"variance_percent"` // Variance as a fraction of planned; 0 when nothing was planned
+	Overrun         bool    `json:"overrun"`          // Ran OverrunThreshold or more over plan
+}
+
+// ChunkBreakdown is the per-chunk time of a plan, in plan order.
+type ChunkBreakdown struct {
+	PlanID            string       `json:"plan_id"`
+	PlanTitle         string       `json:"plan_title"`
+	Chunks            []ChunkStats `json:"chunks"`
+	UnassignedMinutes int          `json:"unassigned_minutes"` // Time logged without a chunk, or against a chunk no longer in the plan
+}
+
+// Overruns returns the chunks flagged as overruns.
+func (b *ChunkBreakdown) Overruns() []ChunkStats {
+	var overruns []ChunkStats
+	for _, chunk := range b.Chunks {
+		if chunk.Overrun {
+			overruns = append(overruns, chunk)
+		}
+	}
+	return overruns
+}
+
+// CalculateChunkStats totals the sessions logged against each of p's
+// chunks and compares them with the planned durations. Active sessions
+// have no duration yet and are not counted.
+func CalculateChunkStats(p *plan.Plan, sessions []session.Session) ChunkBreakdown {
+	breakdown := ChunkBreakdown{
+		PlanID:    p.ID,
+		PlanTitle: p.Title,
+		Chunks:    make([]ChunkStats, 0, len(p.Chunks)),
+	}
+
+	index := make(map[string]int, len(p.Chunks))
+	for i, chunk := range p.Chunks {
+		index[chunk.ID] = i
+		breakdown.Chunks = append(breakdown.Chunks, ChunkStats{
+			ChunkID:        chunk.ID,
+			Title:          chunk.Title,
+			Status:         string(chunk.Status),
+			PlannedMinutes: chunk.Duration,
+		})
+	}
+
+	for i := range sessions {
+		sess := &sessions[i]
+		if sess.PlanID != p.ID || sess.IsActive() {
+			continue
+		}
+		n, ok := index[sess.ChunkID]
+		if !ok {
+			breakdown.UnassignedMinutes += sess.Duration
+			continue
+		}
+		breakdown.Chunks[n].ActualMinutes += sess.Duration
+		breakdown.Chunks[n].SessionCount++
+	}
+
+	for i := range breakdown.Chunks {
+		chunk := &breakdown.Chunks[i]
+		if chunk.SessionCount == 0 {
+			continue
+		}
+		chunk.VarianceMinutes = chunk.ActualMinutes - chunk.PlannedMinutes
+		if chunk.PlannedMinutes > 0 {
+			chunk.VariancePercent = float64(chunk.VarianceMinutes) / float64(chunk.PlannedMinutes)
+			chunk.Overrun = chunk.VariancePercent >= OverrunThreshold
+		}
+	}
+
+	return breakdown
+}
+
+// GetChunkStats computes the per-chunk time of a plan within the time
+// range.
+func (s *Service) GetChunkStats(ctx context.Context, planID string, timeRange TimeRange) (*ChunkBreakdown, error) {
+	p, err := s.planService.Get(ctx, planID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load plan: %w", err)
+	}
+
+	sessions, err := s.GetPlanSessions(ctx, planID, timeRange)
+	if err != nil {
+		return nil, err
+	}
+
+	breakdown := CalculateChunkStats(p, sessions)
+	return &breakdown, nil
+}
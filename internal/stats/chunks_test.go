@@ -0,0 +1,94 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package stats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newChunkTestPlan() *plan.Plan {
+	return newTestPlan("rust", "Rust", plan.StatusInProgress, []plan.Chunk{
+		{ID: "chunk-001", Title: "Ownership", Duration: 60, Status: plan.StatusCompleted},
+		{ID: "chunk-002", Title: "Lifetimes", Duration: 60, Status: plan.StatusInProgress},
+		{ID: "chunk-003", Title: "Traits", Duration: 90, Status: plan.StatusNotStarted},
+	})
+}
+
+func chunkSession(id, chunkID string, start time.Time, minutes int) *session.Session {
+	s := newTestSession(id, "rust", start, minutes)
+	s.ChunkID = chunkID
+	return s
+}
+
+func TestCalculateChunkStats(t *testing.T) {
+	start := time.Now().AddDate(0, 0, -2)
+	active := chunkSession("s5", "chunk-002", time.Now(), 0)
+	active.EndTime = nil
+
+	sessions := []session.Session{
+		*chunkSession("s1", "chunk-001", start, 50),
+		*chunkSession("s2", "chunk-002", start, 60),
+		*chunkSession("s3", "chunk-002", start.Add(time.Hour), 35),
+		*chunkSession("s4", "", start, 20),
+		*chunkSession("s6", "chunk-gone", start, 15),
+		*active,
+		*newTestSession("s7", "other", start, 120),
+	}
+
+	breakdown := CalculateChunkStats(newChunkTestPlan(), sessions)
+	require.Len(t, breakdown.Chunks, 3)
+	assert.Equal(t, "rust", breakdown.PlanID)
+	assert.Equal(t, 35, breakdown.UnassignedMinutes)
+
+	ownership := breakdown.Chunks[0]
+	assert.Equal(t, 50, ownership.ActualMinutes)
+	assert.Equal(t, -10, ownership.VarianceMinutes)
+	assert.False(t, ownership.Overrun)
+
+	lifetimes := breakdown.Chunks[1]
+	assert.Equal(t, 95, lifetimes.ActualMinutes)
+	assert.Equal(t, 2, lifetimes.SessionCount, "active sessions are not counted")
+	assert.Equal(t, 35, lifetimes.VarianceMinutes)
+	assert.InDelta(t, 0.583, lifetimes.VariancePercent, 0.001)
+	assert.True(t, lifetimes.Overrun)
+
+	traits := breakdown.Chunks[2]
+	assert.Zero(t, traits.ActualMinutes)
+	assert.Zero(t, traits.VarianceMinutes, "unstarted chunks have no variance")
+	assert.False(t, traits.Overrun)
+
+	overruns := breakdown.Overruns()
+	require.Len(t, overruns, 1)
+	assert.Equal(t, "chunk-002", overruns[0].ChunkID)
+}
+
+func TestService_GetChunkStats(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	planService := new(MockPlanService)
+	sessionService := new(MockSessionService)
+	planService.On("Get", ctx, "rust").Return(newChunkTestPlan(), nil)
+	sessionService.On("List", ctx, "rust", 0).Return([]*session.Session{
+		chunkSession("s1", "chunk-001", now.AddDate(0, 0, -40), 90),
+		chunkSession("s2", "chunk-001", now.Add(-time.Hour), 30),
+	}, nil)
+
+	svc := NewService(planService, sessionService)
+	breakdown, err := svc.GetChunkStats(ctx, "rust", NewTimeRangeSince(now.AddDate(0, 0, -7)))
+	require.NoError(t, err)
+	assert.Equal(t, 30, breakdown.Chunks[0].ActualMinutes, "sessions outside the range are skipped")
+
+	breakdown, err = svc.GetChunkStats(ctx, "rust", NewTimeRangeAll())
+	require.NoError(t, err)
+	assert.Equal(t, 120, breakdown.Chunks[0].ActualMinutes)
+	assert.True(t, breakdown.Chunks[0].Overrun)
+}
@@ -15,6 +15,7 @@ import (
 // Exporter handles exporting statistics to various formats.
 type Exporter struct {
 	template *template.Template
+	location *time.Location // Zone dates are written in; nil keeps each date's own
 }
 
 // NewExporter creates a new statistics exporter with default templates.
@@ -22,6 +23,19 @@ func NewExporter() *Exporter {
 	return &Exporter{}
 }
 
+// SetLocation sets the time zone dates are written in.
+func (e *Exporter) SetLocation(loc *time.Location) {
+	e.location = loc
+}
+
+// now returns the current time in the exporter's zone.
+func (e *Exporter) now() time.Time {
+	if e.location == nil {
+		return time.Now()
+	}
+	return time.Now().In(e.location)
+}
+
 // ExportTotalStats exports total statistics to markdown format.
 func (e *Exporter) ExportTotalStats(stats *TotalStats) (string, error) {
 	if err := stats.Validate(); err != nil {
@@ -80,6 +94,9 @@ func (e *Exporter) ExportPlanStats(stats *PlanStats) (string, error) {
 	buf.WriteString(fmt.Sprintf("# Plan: %s\n\n", stats.PlanTitle))
 	buf.WriteString(fmt.Sprintf("**Plan ID:** %s\n", stats.PlanID))
 	buf.WriteString(fmt.Sprintf("**Status:** %s\n", stats.Status))
+	if stats.GeneratedBy != "" {
+		buf.WriteString(fmt.Sprintf("**Generated By:** %s\n", stats.GeneratedBy))
+	}
 	buf.WriteString("\n")
 
 	buf.WriteString("## Progress\n\n")
@@ -139,12 +156,63 @@ func (e *Exporter) ExportDailyStats(dailyStats []DailyStats) (string, error) {
 	return buf.String(), nil
 }
 
+// ExportWeeklyStats exports weekly statistics, as returned by
+// CalculateWeeklyStats, to markdown format.
+func (e *Exporter) ExportWeeklyStats(weeklyStats []PeriodStats) (string, error) {
+	return e.exportPeriods("Weekly Statistics", weeklyStats, func(ps PeriodStats) string {
+		return "Week of " + ps.Start.Format("2006-01-02")
+	}), nil
+}
+
+// ExportMonthlyStats exports monthly statistics, as returned by
+// CalculateMonthlyStats, to markdown format.
+func (e *Exporter) ExportMonthlyStats(monthlyStats []PeriodStats) (string, error) {
+	return e.exportPeriods("Monthly Statistics", monthlyStats, func(ps PeriodStats) string {
+		return ps.Start.Format("January 2006")
+	}), nil
+}
+
+// exportPeriods renders weeks or months like ExportDailyStats renders
+// days, headed by each period's label.
+func (e *Exporter) exportPeriods(title string, periods []PeriodStats, label func(PeriodStats) string) string {
+	var buf bytes.Buffer
+
+	buf.WriteString(fmt.Sprintf("# %s\n\n", title))
+
+	totalDuration := 0
+	totalSessions := 0
+	for _, ps := range periods {
+		totalDuration += ps.Duration
+		totalSessions += ps.SessionCount
+	}
+	if totalSessions == 0 {
+		buf.WriteString("No statistics available.\n")
+		return buf.String()
+	}
+
+	buf.WriteString(fmt.Sprintf("**Total:** %.1f hours across %d sessions\n\n", float64(totalDuration)/60.0, totalSessions))
+
+	buf.WriteString("## Breakdown\n\n")
+
+	for _, ps := range periods {
+		buf.WriteString(fmt.Sprintf("### %s\n\n", label(ps)))
+		buf.WriteString(fmt.Sprintf("- **Duration:** %.1f hours\n", ps.Hours()))
+		buf.WriteString(fmt.Sprintf("- **Sessions:** %d sessions on %d days\n", ps.SessionCount, ps.ActiveDays))
+		if len(ps.Plans) > 0 {
+			buf.WriteString(fmt.Sprintf("- **Plans:** %s\n", strings.Join(ps.Plans, ", ")))
+		}
+		buf.WriteString("\n")
+	}
+
+	return buf.String()
+}
+
 // ExportFullReport generates a comprehensive markdown report with all statistics.
 func (e *Exporter) ExportFullReport(totalStats *TotalStats, planStats []PlanStats, dailyStats []DailyStats) (string, error) {
 	var buf bytes.Buffer
 
 	buf.WriteString("# Learning Statistics Report\n\n")
-	buf.WriteString(fmt.Sprintf("*Generated: %s*\n\n", time.Now().Format("2006-01-02 15:04:05")))
+	buf.WriteString(fmt.Sprintf("*Generated: %s*\n\n", e.now().Format("2006-01-02 15:04:05")))
 
 	// Check if we have any data
 	if totalStats.TotalSessions == 0 && len(planStats) == 0 && len(dailyStats) == 0 {
@@ -218,9 +286,31 @@ func (e *Exporter) FormatDate(date *time.Time) string {
 	if date == nil {
 		return "N/A"
 	}
+	if e.location != nil {
+		return date.In(e.location).Format("2006-01-02")
+	}
 	return date.Format("2006-01-02")
 }
 
+// FormatRelativeTime formats t relative to now, e.g. "3d ago".
+func FormatRelativeTime(t, now time.Time) string {
+	ago := now.Sub(t)
+	switch {
+	case ago < time.Minute:
+		return "just now"
+	case ago < time.Hour:
+		return fmt.Sprintf("%dm ago", int(ago.Minutes()))
+	case ago < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(ago.Hours()))
+	case ago < 30*24*time.Hour:
+		return fmt.Sprintf("%dd ago", int(ago.Hours()/24))
+	case ago < 365*24*time.Hour:
+		return fmt.Sprintf("%dmo ago", int(ago.Hours()/(24*30)))
+	default:
+		return fmt.Sprintf("%dy ago", int(ago.Hours()/(24*365)))
+	}
+}
+
 // FormatProgress formats a progress float (0.0-1.0) to a percentage string.
 func (e *Exporter) FormatProgress(progress float64) string {
 	return fmt.Sprintf("%d%%", int(progress*100))
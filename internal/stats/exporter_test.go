@@ -86,6 +86,7 @@ func TestExporter_ExportPlanStats_Basic(t *testing.T) {
 		Progress:        0.375,
 		Status:          "in-progress",
 		LastSession:     &lastSession,
+		GeneratedBy:     "claude/sonnet (template sha256:0123456789ab)",
 	}
 
 	exporter := NewExporter()
@@ -93,6 +94,7 @@ func TestExporter_ExportPlanStats_Basic(t *testing.T) {
 
 	require.NoError(t, err)
 	assert.Contains(t, result, "# Plan: Rust Async Programming")
+	assert.Contains(t, result, "**Generated By:** claude/sonnet (template sha256:0123456789ab)")
 	assert.Contains(t, result, "rust-async")
 	assert.Contains(t, result, "12.5 hours")
 	assert.Contains(t, result, "40.0 hours")
@@ -122,6 +124,7 @@ func TestExporter_ExportPlanStats_NoSessions(t *testing.T) {
 	require.NoError(t, err)
 	assert.Contains(t, result, "No sessions recorded")
 	assert.Contains(t, result, "not-started")
+	assert.NotContains(t, result, "Generated By")
 }
 
 func TestExporter_ExportPlanStats_Completed(t *testing.T) {
@@ -212,6 +215,32 @@ func TestExporter_ExportDailyStats_MultipleDays(t *testing.T) {
 	assert.Contains(t, result, "**Total:** 4.5 hours")
 }
 
+func TestExporter_ExportPeriodStats(t *testing.T) {
+	daily := []DailyStats{
+		{Date: time.Date(2025, 10, 7, 0, 0, 0, 0, time.UTC), Duration: 60, SessionCount: 1, Plans: []string{"rust-async"}},
+		{Date: time.Date(2025, 10, 8, 0, 0, 0, 0, time.UTC), Duration: 90, SessionCount: 2, Plans: []string{"french-b1"}},
+		{Date: time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC), Duration: 30, SessionCount: 1, Plans: []string{"rust-async"}},
+	}
+	exporter := NewExporter()
+
+	weekly, err := exporter.ExportWeeklyStats(CalculateWeeklyStats(daily, false))
+	require.NoError(t, err)
+	assert.Contains(t, weekly, "# Weekly Statistics")
+	assert.Contains(t, weekly, "### Week of 2025-10-06")
+	assert.Contains(t, weekly, "- **Sessions:** 3 sessions on 2 days")
+	assert.Contains(t, weekly, "**Total:** 3.0 hours across 4 sessions")
+
+	monthly, err := exporter.ExportMonthlyStats(CalculateMonthlyStats(daily))
+	require.NoError(t, err)
+	assert.Contains(t, monthly, "### October 2025")
+	assert.Contains(t, monthly, "- **Plans:** french-b1, rust-async")
+	assert.Contains(t, monthly, "### November 2025")
+
+	empty, err := exporter.ExportMonthlyStats(nil)
+	require.NoError(t, err)
+	assert.Contains(t, empty, "No statistics available.")
+}
+
 func TestExporter_ExportFullReport_Complete(t *testing.T) {
 	lastSession := time.Date(2025, 10, 9, 14, 30, 0, 0, time.UTC)
 	totalStats := &TotalStats{
@@ -340,6 +369,17 @@ func TestExporter_FormatDuration_Minutes(t *testing.T) {
 	}
 }
 
+func TestExporter_SetLocation(t *testing.T) {
+	// 02:00 UTC is still the previous evening in New York
+	date := time.Date(2024, 11, 4, 2, 0, 0, 0, time.UTC)
+
+	exporter := NewExporter()
+	assert.Equal(t, "2024-11-04", exporter.FormatDate(&date))
+
+	exporter.SetLocation(newYork(t))
+	assert.Equal(t, "2024-11-03", exporter.FormatDate(&date))
+}
+
 func TestExporter_FormatDate_Various(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -522,3 +562,25 @@ func TestExporter_GenerateProgressBar_ASCII(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatRelativeTime(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		ago  time.Duration
+		want string
+	}{
+		{30 * time.Second, "just now"},
+		{15 * time.Minute, "15m ago"},
+		{5 * time.Hour, "5h ago"},
+		{3 * 24 * time.Hour, "3d ago"},
+		{60 * 24 * time.Hour, "2mo ago"},
+		{400 * 24 * time.Hour, "1y ago"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, FormatRelativeTime(now.Add(-tt.ago), now))
+		})
+	}
+}
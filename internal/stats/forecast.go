@@ -0,0 +1,164 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package stats
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+)
+
+// PaceWindow is how far back sessions count toward a plan's recent pace.
+const PaceWindow = 28 * 24 * time.Hour
+
+// Forecast projects when a plan will be finished at its recent pace.
+type Forecast struct {
+	RemainingHours float64    `json:"remaining_hours"`           // Planned hours of chunks not yet done
+	WeeklyPace     float64    `json:"weekly_pace"`               // Hours per week over PaceWindow
+	CompletionDate *time.Time `json:"completion_date,omitempty"` // Nil without recent sessions
+	Deadline       *plan.Date `json:"deadline,omitempty"`        // The plan's deadline, if it has one
+	DaysLeft       int        `json:"days_left"`                 // Days until the deadline; negative once overdue
+	RequiredPace   float64    `json:"required_pace,omitempty"`   // Hours per week needed to finish by the deadline
+	PastDeadline   bool       `json:"past_deadline"`             // Completion is forecast after the deadline, or it has passed
+}
+
+// CalculateForecast projects p's completion date from the time logged on
+// it over the PaceWindow before now, and checks it against p's deadline.
+// Plans that are finished or have nothing left to do get no forecast.
+func CalculateForecast(p *plan.Plan, sessions []session.Session, now time.Time) *Forecast {
+	if p.Status == plan.StatusCompleted || p.Status == plan.StatusArchived {
+		return nil
+	}
+
+	totalMinutes := 0
+	recentMinutes := 0
+	var first time.Time
+	windowStart := now.Add(-PaceWindow)
+	for i := range sessions {
+		sess := &sessions[i]
+		if sess.PlanID != p.ID || sess.IsActive() {
+			continue
+		}
+		totalMinutes += sess.Duration
+		if first.IsZero() || sess.StartTime.Before(first) {
+			first = sess.StartTime
+		}
+		if !sess.StartTime.Before(windowStart) && !sess.StartTime.After(now) {
+			recentMinutes += sess.Duration
+		}
+	}
+
+	return forecastFrom(p, totalMinutes, recentMinutes, first, now)
+}
+
+// forecastFrom projects p's completion from the minutes logged on it in
+// total and over the PaceWindow before now, and its first session.
+func forecastFrom(p *plan.Plan, totalMinutes, recentMinutes int, first, now time.Time) *Forecast {
+	if p.Status == plan.StatusCompleted || p.Status == plan.StatusArchived {
+		return nil
+	}
+
+	remaining := p.RemainingHours()
+	if len(p.Chunks) == 0 {
+		remaining = p.TotalHours - float64(totalMinutes)/60
+	}
+	if remaining <= 0 {
+		return nil
+	}
+
+	forecast := &Forecast{RemainingHours: remaining}
+	if recentMinutes > 0 {
+		forecast.project(recentMinutes, first, now)
+	}
+	if p.Deadline != nil {
+		forecast.CheckDeadline(*p.Deadline, now)
+	}
+	return forecast
+}
+
+// project sets the weekly pace from recentMinutes logged within the
+// PaceWindow and the completion date it leads to.
+func (f *Forecast) project(recentMinutes int, first, now time.Time) {
+	// A plan begun within the window is paced over the time since its
+	// first session, so a good first week isn't diluted across four
+	weeks := PaceWindow.Hours() / (24 * 7)
+	if since := now.Sub(first).Hours() / (24 * 7); since < weeks {
+		weeks = max(since, 1)
+	}
+	f.WeeklyPace = float64(recentMinutes) / 60 / weeks
+
+	days := f.RemainingHours / f.WeeklyPace * 7
+	completion := now.Add(time.Duration(days * 24 * float64(time.Hour)))
+	completion = time.Date(completion.Year(), completion.Month(), completion.Day(), 0, 0, 0, 0, completion.Location())
+	f.CompletionDate = &completion
+}
+
+// CheckDeadline records the days left until deadline, the weekly pace
+// needed to make it, and whether the plan is forecast to miss it. Without
+// a pace the forecast can't slip, but a passed deadline is always missed.
+func (f *Forecast) CheckDeadline(deadline plan.Date, now time.Time) {
+	f.Deadline = &deadline
+	f.DaysLeft = deadline.DaysFrom(now)
+	f.RequiredPace = 0
+	if f.DaysLeft >= 0 {
+		// The deadline day itself still counts for study
+		f.RequiredPace = f.RemainingHours / (float64(f.DaysLeft+1) / 7)
+	}
+
+	f.PastDeadline = f.DaysLeft < 0
+	if f.CompletionDate != nil && f.CompletionDate.After(deadline.Time) {
+		f.PastDeadline = true
+	}
+}
+
+// Summary describes the projected completion, such as
+// "Mar 29, 2025 at 2.5 h/week (10.0 h left)".
+func (f *Forecast) Summary() string {
+	if f.CompletionDate == nil {
+		return fmt.Sprintf("no sessions in the last %d days (%.1f h left)",
+			int(PaceWindow.Hours()/24), f.RemainingHours)
+	}
+	return fmt.Sprintf("%s at %.1f h/week (%.1f h left)",
+		f.CompletionDate.Format("Jan 2, 2006"), f.WeeklyPace, f.RemainingHours)
+}
+
+// DeadlineSummary describes the schedule pressure of a deadline, such as
+// "Jun 30, 2025 (12 days left, needs 5.8 h/week)", or "" without one.
+func (f *Forecast) DeadlineSummary() string {
+	if f.Deadline == nil {
+		return ""
+	}
+	date := f.Deadline.Format("Jan 2, 2006")
+	switch {
+	case f.DaysLeft < 0:
+		return fmt.Sprintf("%s (%s overdue)", date, pluralDays(-f.DaysLeft))
+	case f.DaysLeft == 0:
+		return fmt.Sprintf("%s (due today, needs %.1f h/week)", date, f.RequiredPace)
+	default:
+		return fmt.Sprintf("%s (%s left, needs %.1f h/week)", date, pluralDays(f.DaysLeft), f.RequiredPace)
+	}
+}
+
+// pluralDays formats a day count as "1 day" or "12 days".
+func pluralDays(n int) string {
+	if n == 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%d days", n)
+}
+
+// Warning returns a warning when the deadline has passed or completion is
+// forecast after it, or "" otherwise.
+func (f *Forecast) Warning() string {
+	if !f.PastDeadline {
+		return ""
+	}
+	date := f.Deadline.Format("Jan 2, 2006")
+	if f.DaysLeft < 0 {
+		return fmt.Sprintf("⚠ The deadline of %s has passed with %.1f h left", date, f.RemainingHours)
+	}
+	return fmt.Sprintf("⚠ Forecast is past the deadline of %s", date)
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newForecastTestPlan() *plan.Plan {
+	// 10 hours left: two 5-hour chunks not yet done
+	return newTestPlan("rust", "Rust", plan.StatusInProgress, []plan.Chunk{
+		{ID: "chunk-001", Duration: 300, Status: plan.StatusCompleted},
+		{ID: "chunk-002", Duration: 300, Status: plan.StatusInProgress},
+		{ID: "chunk-003", Duration: 300, Status: plan.StatusNotStarted},
+		{ID: "chunk-004", Duration: 300, Status: plan.StatusSkipped},
+	})
+}
+
+func TestCalculateForecast(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	sessions := []session.Session{
+		// 10 hours over the last four weeks: 2.5 hours a week
+		*newTestSession("s1", "rust", now.AddDate(0, 0, -20), 300),
+		*newTestSession("s2", "rust", now.AddDate(0, 0, -3), 300),
+		*newTestSession("s3", "rust", now.AddDate(0, 0, -60), 600), // Outside the window
+		*newTestSession("s4", "other", now.AddDate(0, 0, -1), 600),
+	}
+
+	forecast := CalculateForecast(newForecastTestPlan(), sessions, now)
+	require.NotNil(t, forecast)
+	assert.InDelta(t, 10.0, forecast.RemainingHours, 0.001)
+	assert.InDelta(t, 2.5, forecast.WeeklyPace, 0.001)
+	require.NotNil(t, forecast.CompletionDate)
+	assert.Equal(t, time.Date(2025, 3, 29, 0, 0, 0, 0, time.UTC), *forecast.CompletionDate)
+
+	forecast.CheckDeadline(plan.Date{Time: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)}, now)
+	assert.False(t, forecast.PastDeadline)
+	forecast.CheckDeadline(plan.Date{Time: time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)}, now)
+	assert.True(t, forecast.PastDeadline)
+	assert.Equal(t, "Mar 29, 2025 at 2.5 h/week (10.0 h left)", forecast.Summary())
+	assert.Equal(t, "⚠ Forecast is past the deadline of Mar 15, 2025", forecast.Warning())
+}
+
+func TestCalculateForecast_NewPlanPacedSinceFirstSession(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	sessions := []session.Session{
+		*newTestSession("s1", "rust", now.AddDate(0, 0, -14), 300),
+	}
+
+	forecast := CalculateForecast(newForecastTestPlan(), sessions, now)
+	require.NotNil(t, forecast)
+	assert.InDelta(t, 2.5, forecast.WeeklyPace, 0.001, "5 hours over two weeks, not four")
+}
+
+func TestCalculateForecast_NoPace(t *testing.T) {
+	now := time.Now()
+	forecast := CalculateForecast(newForecastTestPlan(), nil, now)
+	require.NotNil(t, forecast)
+	assert.Nil(t, forecast.CompletionDate)
+	assert.Equal(t, "no sessions in the last 28 days (10.0 h left)", forecast.Summary())
+
+	forecast.CheckDeadline(plan.Date{Time: now}, now)
+	assert.False(t, forecast.PastDeadline, "no pace means no slip")
+	assert.Empty(t, forecast.Warning())
+}
+
+func TestCalculateForecast_Finished(t *testing.T) {
+	done := newTestPlan("rust", "Rust", plan.StatusCompleted, nil)
+	assert.Nil(t, CalculateForecast(done, nil, time.Now()))
+
+	allDone := newTestPlan("rust", "Rust", plan.StatusInProgress, []plan.Chunk{
+		{ID: "chunk-001", Duration: 60, Status: plan.StatusCompleted},
+	})
+	assert.Nil(t, CalculateForecast(allDone, nil, time.Now()))
+}
+
+func TestCalculateForecast_NoChunksUsesPlannedHours(t *testing.T) {
+	now := time.Now()
+	p := newTestPlan("rust", "Rust", plan.StatusInProgress, nil) // 40 hours planned
+	sessions := []session.Session{*newTestSession("s1", "rust", now.AddDate(0, 0, -7), 600)}
+
+	forecast := CalculateForecast(p, sessions, now)
+	require.NotNil(t, forecast)
+	assert.InDelta(t, 30.0, forecast.RemainingHours, 0.001)
+}
+
+func TestForecast_CheckDeadline(t *testing.T) {
+	now := time.Date(2025, 3, 1, 18, 0, 0, 0, time.UTC)
+
+	forecast := &Forecast{RemainingHours: 10}
+	forecast.CheckDeadline(plan.Date{Time: time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)}, now)
+	assert.Equal(t, 13, forecast.DaysLeft)
+	assert.InDelta(t, 5.0, forecast.RequiredPace, 0.001, "10 hours over 14 study days")
+	assert.False(t, forecast.PastDeadline)
+	assert.Equal(t, "Mar 14, 2025 (13 days left, needs 5.0 h/week)", forecast.DeadlineSummary())
+
+	forecast.CheckDeadline(plan.Date{Time: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)}, now)
+	assert.Equal(t, "Mar 1, 2025 (due today, needs 70.0 h/week)", forecast.DeadlineSummary())
+
+	forecast.CheckDeadline(plan.Date{Time: time.Date(2025, 2, 26, 0, 0, 0, 0, time.UTC)}, now)
+	assert.Equal(t, -3, forecast.DaysLeft)
+	assert.Zero(t, forecast.RequiredPace)
+	assert.True(t, forecast.PastDeadline, "a passed deadline is missed even without a pace")
+	assert.Equal(t, "Feb 26, 2025 (3 days overdue)", forecast.DeadlineSummary())
+	assert.Equal(t, "⚠ The deadline of Feb 26, 2025 has passed with 10.0 h left", forecast.Warning())
+}
+
+func TestCalculateForecast_UsesPlanDeadline(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	p := newForecastTestPlan()
+	p.Deadline = &plan.Date{Time: time.Date(2025, 3, 8, 0, 0, 0, 0, time.UTC)}
+	sessions := []session.Session{*newTestSession("s1", "rust", now.AddDate(0, 0, -40), 60)}
+
+	forecast := CalculateForecast(p, sessions, now)
+	require.NotNil(t, forecast)
+	require.NotNil(t, forecast.Deadline)
+	assert.Equal(t, 7, forecast.DaysLeft)
+	assert.Nil(t, forecast.CompletionDate)
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package stats
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/session"
+)
+
+// InsightMinSessions is how many sessions insights need before they name
+// a best hour, weekday, or time of day. With fewer, one long session
+// would decide them.
+const InsightMinSessions = 5
+
+// InsightSlot totals the sessions started in one hour, on one weekday, or
+// in one part of the day.
+type InsightSlot struct {
+	Label    string `json:"label"` // Such as "09:00", "Monday", or "morning"
+	Minutes  int    `json:"minutes"`
+	Sessions int    `json:"sessions"`
+}
+
+// AverageMinutes returns the mean session length in the slot.
+func (s InsightSlot) AverageMinutes() int {
+	if s.Sessions == 0 {
+		return 0
+	}
+	return s.Minutes / s.Sessions
+}
+
+// Summary describes the slot, such as "1.8h over 2 sessions, 52 min on
+// average".
+func (s InsightSlot) Summary() string {
+	sessions := "sessions"
+	if s.Sessions == 1 {
+		sessions = "session"
+	}
+	return fmt.Sprintf("%.1fh over %d %s, %d min on average",
+		float64(s.Minutes)/60, s.Sessions, sessions, s.AverageMinutes())
+}
+
+// Insights shows when the user learns: the time logged by the hour,
+// weekday, and part of the day sessions start in, and the busiest of
+// each. A session counts wholly toward the hour it started in.
+type Insights struct {
+	Sessions int           `json:"sessions"`
+	Minutes  int           `json:"minutes"`
+	Hours    []InsightSlot `json:"hours"`    // 24, midnight first
+	Weekdays []InsightSlot `json:"weekdays"` // 7, Monday first
+	Periods  []InsightSlot `json:"periods"`  // Morning, afternoon, evening, and night
+
+	// The slots with the most time, or nil below InsightMinSessions
+	BestHour    *InsightSlot `json:"best_hour,omitempty"`
+	BestWeekday *InsightSlot `json:"best_weekday,omitempty"`
+	BestPeriod  *InsightSlot `json:"best_period,omitempty"`
+}
+
+// dayPeriods names the parts of the day, each running from its hour to
+// the next one's.
+var dayPeriods = []struct {
+	label string
+	from  int
+}{
+	{"morning", 5},
+	{"afternoon", 12},
+	{"evening", 17},
+	{"night", 22},
+}
+
+// dayPeriod returns the index in dayPeriods of the part of the day hour
+// falls in. The night runs past midnight until morning.
+func dayPeriod(hour int) int {
+	for i := len(dayPeriods) - 1; i >= 0; i-- {
+		if hour >= dayPeriods[i].from {
+			return i
+		}
+	}
+	return len(dayPeriods) - 1
+}
+
+// CalculateInsights totals the finished sessions in timeRange by the hour,
+// weekday, and part of the day they started in, on the clock of loc (or
+// each session's own zone when loc is nil).
+func CalculateInsights(sessions []session.Session, timeRange TimeRange, loc *time.Location) *Insights {
+	insights := &Insights{
+		Hours:    make([]InsightSlot, 24),
+		Weekdays: make([]InsightSlot, 7),
+		Periods:  make([]InsightSlot, len(dayPeriods)),
+	}
+	for hour := range insights.Hours {
+		insights.Hours[hour].Label = fmt.Sprintf("%02d:00", hour)
+	}
+	for i := range insights.Weekdays {
+		insights.Weekdays[i].Label = time.Weekday((i + 1) % 7).String()
+	}
+	for i, period := range dayPeriods {
+		insights.Periods[i].Label = period.label
+	}
+
+	for i := range sessions {
+		sess := &sessions[i]
+		if sess.IsActive() || !timeRange.Contains(sess.StartTime) {
+			continue
+		}
+		start := sess.StartTime
+		if loc != nil {
+			start = start.In(loc)
+		}
+
+		insights.Sessions++
+		insights.Minutes += sess.Duration
+		for _, slot := range []*InsightSlot{
+			&insights.Hours[start.Hour()],
+			&insights.Weekdays[(int(start.Weekday())+6)%7],
+			&insights.Periods[dayPeriod(start.Hour())],
+		} {
+			slot.Minutes += sess.Duration
+			slot.Sessions++
+		}
+	}
+
+	if insights.Sessions >= InsightMinSessions {
+		insights.BestHour = busiestSlot(insights.Hours)
+		insights.BestWeekday = busiestSlot(insights.Weekdays)
+		insights.BestPeriod = busiestSlot(insights.Periods)
+	}
+	return insights
+}
+
+// busiestSlot returns the slot with the most minutes, then the most
+// sessions, then the first; nil if no slot has any.
+func busiestSlot(slots []InsightSlot) *InsightSlot {
+	var best *InsightSlot
+	for i := range slots {
+		slot := &slots[i]
+		if slot.Sessions == 0 {
+			continue
+		}
+		if best == nil || slot.Minutes > best.Minutes ||
+			(slot.Minutes == best.Minutes && slot.Sessions > best.Sessions) {
+			best = slot
+		}
+	}
+	if best == nil {
+		return nil
+	}
+	found := *best
+	return &found
+}
+
+// ActiveHours returns the hours from the earliest to the latest one a
+// session started in, idle hours between them included, for charting.
+func (i *Insights) ActiveHours() []InsightSlot {
+	first, last := -1, -1
+	for hour, slot := range i.Hours {
+		if slot.Sessions == 0 {
+			continue
+		}
+		if first < 0 {
+			first = hour
+		}
+		last = hour
+	}
+	if first < 0 {
+		return nil
+	}
+	return i.Hours[first : last+1]
+}
+
+// GetInsights computes when the user learns best from the sessions in
+// timeRange, in the service's zone. Only the sessions in range are loaded
+// when the session service can query.
+func (s *Service) GetInsights(ctx context.Context, timeRange TimeRange) (*Insights, error) {
+	if err := timeRange.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid time range: %w", err)
+	}
+
+	var sessions []*session.Session
+	var err error
+	if querier, ok := s.sessionService.(SessionQuerier); ok {
+		sessions, err = querier.Query(ctx, rangeFilter(timeRange))
+	} else {
+		sessions, err = s.sessionService.ListAll(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	return CalculateInsights(sessionValues(sessions), timeRange, s.location), nil
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package stats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateInsights(t *testing.T) {
+	// 2025-03-10 is a Monday
+	at := func(day, hour int) time.Time { return time.Date(2025, 3, day, hour, 30, 0, 0, time.UTC) }
+	sessions := []session.Session{
+		*newTestSession("s1", "rust", at(10, 9), 60),
+		*newTestSession("s2", "rust", at(11, 9), 45),
+		*newTestSession("s3", "rust", at(12, 20), 30),
+		*newTestSession("s4", "go", at(13, 20), 30),
+		*newTestSession("s5", "go", at(16, 23), 90),    // Sunday night
+		*newTestSession("s6", "go", at(17, 2), 20),     // Monday, still the night
+		*newTestSession("s7", "go", at(1, 9), 600),     // Out of range
+		{ID: "s8", PlanID: "go", StartTime: at(17, 9)}, // Still running
+	}
+	tr := TimeRange{Start: at(10, 0), End: at(20, 0)}
+
+	insights := CalculateInsights(sessions, tr, nil)
+	assert.Equal(t, 6, insights.Sessions)
+	assert.Equal(t, 275, insights.Minutes)
+	assert.Equal(t, InsightSlot{Label: "09:00", Minutes: 105, Sessions: 2}, insights.Hours[9])
+	assert.Equal(t, 52, insights.Hours[9].AverageMinutes())
+	assert.Equal(t, "1.8h over 2 sessions, 52 min on average", insights.Hours[9].Summary())
+	active := insights.ActiveHours()
+	require.Len(t, active, 22, "02:00 through 23:00")
+	assert.Equal(t, "02:00", active[0].Label)
+	assert.Equal(t, "Monday", insights.Weekdays[0].Label)
+	assert.Equal(t, 80, insights.Weekdays[0].Minutes)
+	assert.Equal(t, "Sunday", insights.Weekdays[6].Label)
+
+	require.NotNil(t, insights.BestHour)
+	assert.Equal(t, "09:00", insights.BestHour.Label)
+	require.NotNil(t, insights.BestWeekday)
+	assert.Equal(t, "Sunday", insights.BestWeekday.Label)
+	require.NotNil(t, insights.BestPeriod)
+	assert.Equal(t, InsightSlot{Label: "night", Minutes: 110, Sessions: 2}, *insights.BestPeriod)
+
+	// Nine in the morning UTC is the small hours in Los Angeles
+	la, err := time.LoadLocation("America/Los_Angeles")
+	require.NoError(t, err)
+	shifted := CalculateInsights(sessions, tr, la)
+	assert.Equal(t, 105, shifted.Hours[2].Minutes)
+}
+
+func TestCalculateInsights_TooFewSessions(t *testing.T) {
+	start := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	sessions := []session.Session{*newTestSession("s1", "rust", start, 600)}
+
+	insights := CalculateInsights(sessions, NewTimeRangeAll(), nil)
+	assert.Equal(t, 1, insights.Sessions)
+	assert.Equal(t, 600, insights.Hours[9].Minutes)
+	assert.Nil(t, insights.BestHour, "one session is no pattern")
+	assert.Nil(t, insights.BestWeekday)
+	assert.Nil(t, insights.BestPeriod)
+	assert.Equal(t, "10.0h over 1 session, 600 min on average", insights.Hours[9].Summary())
+
+	assert.Nil(t, CalculateInsights(nil, NewTimeRangeAll(), nil).ActiveHours())
+}
+
+func TestService_GetInsights(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	mockSessionService := new(MockSessionService)
+	mockSessionService.On("ListAll", ctx).Return([]*session.Session{
+		newTestSession("s1", "rust", start, 60),
+		newTestSession("s2", "rust", start.Add(time.Hour), 30),
+	}, nil)
+
+	service := NewService(new(MockPlanService), mockSessionService)
+	service.SetLocation(time.FixedZone("UTC+2", 2*60*60))
+	insights, err := service.GetInsights(ctx, NewTimeRangeAll())
+	require.NoError(t, err)
+	assert.Equal(t, 2, insights.Sessions)
+	assert.Equal(t, 60, insights.Hours[11].Minutes, "hours are read in the service's zone")
+	assert.Equal(t, 30, insights.Hours[12].Minutes)
+
+	_, err = service.GetInsights(ctx, TimeRange{Start: start, End: start.Add(-time.Hour)})
+	assert.Error(t, err)
+}
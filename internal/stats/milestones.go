@@ -0,0 +1,75 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package stats
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/events"
+	"github.com/pezware/samedi.dev/internal/session"
+)
+
+// StreakMilestones are the streak lengths, in days, worth celebrating.
+// Every full year after the last one is a milestone too.
+var StreakMilestones = []int{3, 7, 14, 30, 50, 100, 200, 365}
+
+// IsStreakMilestone reports whether a streak of days is a milestone.
+func IsStreakMilestone(days int) bool {
+	for _, m := range StreakMilestones {
+		if days == m {
+			return true
+		}
+	}
+	return days > 365 && days%365 == 0
+}
+
+// MilestoneWatcher is an events sink that emits a streak milestone event
+// when a stopped session carries the current streak to a milestone.
+type MilestoneWatcher struct {
+	service *Service
+	emitter events.Emitter
+}
+
+// NewMilestoneWatcher creates a watcher that counts streaks with service's
+// rules and emits milestones to emitter.
+func NewMilestoneWatcher(service *Service, emitter events.Emitter) *MilestoneWatcher {
+	return &MilestoneWatcher{service: service, emitter: emitter}
+}
+
+// Send checks session stopped events for a new milestone. Other events
+// are ignored.
+func (w *MilestoneWatcher) Send(ctx context.Context, e events.Event) error {
+	if e.Type != events.SessionStopped {
+		return nil
+	}
+
+	sessions, err := w.service.sessionService.ListAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list sessions: %w", err)
+	}
+	all := make([]session.Session, 0, len(sessions))
+	before := make([]session.Session, 0, len(sessions))
+	for _, sess := range sessions {
+		all = append(all, *sess)
+		if sess.ID != e.SessionID {
+			before = append(before, *sess)
+		}
+	}
+
+	now := time.Now()
+	previous, _ := w.service.streakRules.Streaks(before, now)
+	current, _ := w.service.streakRules.Streaks(all, now)
+	if current <= previous || !IsStreakMilestone(current) {
+		return nil
+	}
+
+	milestone := events.New(events.StreakMilestone, e.PlanID)
+	milestone.SessionID = e.SessionID
+	milestone.Message = fmt.Sprintf("Reached a %d-day learning streak", current)
+	milestone.Data = map[string]any{"streak_days": current}
+	w.emitter.Emit(ctx, milestone)
+	return nil
+}
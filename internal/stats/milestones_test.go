@@ -0,0 +1,66 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package stats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/events"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingEmitter collects emitted events.
+type recordingEmitter struct {
+	events []events.Event
+}
+
+func (r *recordingEmitter) Emit(_ context.Context, e events.Event) {
+	r.events = append(r.events, e)
+}
+
+func TestIsStreakMilestone(t *testing.T) {
+	for _, days := range []int{3, 7, 30, 365, 730} {
+		assert.True(t, IsStreakMilestone(days), "%d days", days)
+	}
+	for _, days := range []int{0, 1, 4, 366, 400} {
+		assert.False(t, IsStreakMilestone(days), "%d days", days)
+	}
+}
+
+func TestMilestoneWatcher_Send(t *testing.T) {
+	today := dayStart(time.Now())
+	sessions := []*session.Session{
+		newTestSession("s1", "rust", today.AddDate(0, 0, -2).Add(time.Hour), 30),
+		newTestSession("s2", "rust", today.AddDate(0, 0, -1).Add(time.Hour), 30),
+		newTestSession("s3", "rust", today, 30),
+		newTestSession("s4", "rust", today.Add(time.Minute), 30),
+	}
+	sessionService := new(MockSessionService)
+	sessionService.On("ListAll", mock.Anything).Return(sessions, nil)
+
+	emitter := &recordingEmitter{}
+	watcher := NewMilestoneWatcher(NewService(new(MockPlanService), sessionService), emitter)
+	ctx := context.Background()
+
+	stopped := events.New(events.SessionStopped, "rust")
+	stopped.SessionID = "s3"
+	// s4 already counts today, so s3 alone doesn't change the streak
+	require.NoError(t, watcher.Send(ctx, stopped))
+	assert.Empty(t, emitter.events)
+
+	sessionService.ExpectedCalls = nil
+	sessionService.On("ListAll", mock.Anything).Return(sessions[:3], nil)
+	require.NoError(t, watcher.Send(ctx, stopped))
+	require.Len(t, emitter.events, 1)
+	assert.Equal(t, events.StreakMilestone, emitter.events[0].Type)
+	assert.Equal(t, 3, emitter.events[0].Data["streak_days"])
+
+	require.NoError(t, watcher.Send(ctx, events.New(events.SessionStarted, "rust")))
+	assert.Len(t, emitter.events, 1, "only stopped sessions are checked")
+}
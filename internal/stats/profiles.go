@@ -0,0 +1,104 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package stats
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/session"
+)
+
+// ProfileStats is one profile's totals in a multi-profile view.
+type ProfileStats struct {
+	Profile string      `json:"profile"`
+	Stats   *TotalStats `json:"stats,omitempty"`
+	Error   string      `json:"error,omitempty"` // Why the profile couldn't be read
+
+	activeDays []time.Time // All-time active days, for merging streaks
+}
+
+// MultiProfileStats combines totals from several profiles. Each profile's
+// data stays in its own store; only the computed totals are merged.
+type MultiProfileStats struct {
+	Totals   TotalStats     `json:"totals"`
+	Profiles []ProfileStats `json:"profiles"`
+}
+
+// GetProfileStats computes the totals of the profile this service reads,
+// with all-time streaks as in the single-profile view.
+func (s *Service) GetProfileStats(ctx context.Context, profile string, timeRange TimeRange) (ProfileStats, error) {
+	totals, err := s.GetTotalStats(ctx, timeRange)
+	if err != nil {
+		return ProfileStats{}, err
+	}
+
+	sessions, err := s.sessionService.ListAll(ctx)
+	if err != nil {
+		return ProfileStats{}, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	sessionValues := make([]session.Session, len(sessions))
+	for i := range sessions {
+		sessionValues[i] = *sessions[i]
+	}
+
+	activeDays := s.streakRules.ActiveDays(sessionValues)
+	totals.CurrentStreak, totals.LongestStreak = streakFromDays(activeDays, time.Now(), s.streakRules)
+
+	return ProfileStats{Profile: profile, Stats: totals, activeDays: activeDays}, nil
+}
+
+// MergeProfileStats sums the totals of every readable profile. Streaks are
+// recomputed over the union of active days, since a day of study counts
+// once no matter which profile logged it. Rest days come from rules; each
+// profile's own minimum was applied when its active days were found.
+func MergeProfileStats(profiles []ProfileStats, rules StreakRules) *MultiProfileStats {
+	return mergeProfileStatsAsOf(profiles, rules, time.Now())
+}
+
+// mergeProfileStatsAsOf merges profile totals with streaks as of now.
+func mergeProfileStatsAsOf(profiles []ProfileStats, rules StreakRules, now time.Time) *MultiProfileStats {
+	merged := &MultiProfileStats{Profiles: profiles}
+	totals := &merged.Totals
+
+	dayMap := make(map[string]time.Time)
+	for _, p := range profiles {
+		if p.Stats == nil {
+			continue
+		}
+
+		totals.TotalHours += p.Stats.TotalHours
+		totals.TotalSessions += p.Stats.TotalSessions
+		totals.ActivePlans += p.Stats.ActivePlans
+		totals.CompletedPlans += p.Stats.CompletedPlans
+
+		if last := p.Stats.LastSessionDate; last != nil {
+			if totals.LastSessionDate == nil || last.After(*totals.LastSessionDate) {
+				totals.LastSessionDate = last
+			}
+		}
+
+		for _, day := range p.activeDays {
+			dayMap[getDayKey(day)] = day
+		}
+	}
+
+	if totals.TotalSessions > 0 {
+		totals.AverageSession = totals.TotalHours * 60 / float64(totals.TotalSessions)
+	}
+
+	days := make([]time.Time, 0, len(dayMap))
+	for _, day := range dayMap {
+		days = append(days, day)
+	}
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].Before(days[j])
+	})
+	totals.CurrentStreak, totals.LongestStreak = streakFromDays(days, now, rules)
+
+	return merged
+}
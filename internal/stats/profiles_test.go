@@ -0,0 +1,97 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package stats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_GetProfileStats(t *testing.T) {
+	ctx := context.Background()
+	today := time.Now()
+	yesterday := today.AddDate(0, 0, -1)
+
+	planService := new(MockPlanService)
+	sessionService := new(MockSessionService)
+
+	planService.On("List", ctx, (*storage.PlanFilter)(nil)).Return([]*storage.PlanRecord{
+		newTestPlanRecord("rust", "Rust", plan.StatusInProgress),
+	}, nil)
+	planService.On("Get", ctx, "rust").Return(newTestPlan("rust", "Rust", plan.StatusInProgress, nil), nil)
+	sessionService.On("ListAll", mock.Anything).Return([]*session.Session{
+		newTestSession("s1", "rust", yesterday, 60),
+		newTestSession("s2", "rust", today, 30),
+	}, nil)
+
+	svc := NewService(planService, sessionService)
+	result, err := svc.GetProfileStats(ctx, "work", NewTimeRangeAll())
+	require.NoError(t, err)
+
+	assert.Equal(t, "work", result.Profile)
+	require.NotNil(t, result.Stats)
+	assert.Equal(t, 2, result.Stats.TotalSessions)
+	assert.InDelta(t, 1.5, result.Stats.TotalHours, 0.001)
+	assert.Equal(t, 2, result.Stats.CurrentStreak)
+	assert.Len(t, result.activeDays, 2)
+}
+
+func TestMergeProfileStats(t *testing.T) {
+	now := time.Date(2025, 10, 15, 12, 0, 0, 0, time.Local)
+	day := func(offset int) time.Time {
+		return time.Date(2025, 10, 15+offset, 0, 0, 0, 0, time.Local)
+	}
+	workLast := day(-1).Add(9 * time.Hour)
+	personalLast := day(0).Add(20 * time.Hour)
+
+	profiles := []ProfileStats{
+		{
+			Profile: "default",
+			Stats: &TotalStats{
+				TotalHours: 3, TotalSessions: 4, ActivePlans: 1, CompletedPlans: 1,
+				LastSessionDate: &personalLast,
+			},
+			activeDays: []time.Time{day(-3), day(0)},
+		},
+		{
+			Profile: "work",
+			Stats: &TotalStats{
+				TotalHours: 2, TotalSessions: 1, ActivePlans: 2,
+				LastSessionDate: &workLast,
+			},
+			activeDays: []time.Time{day(-2), day(-1), day(0)},
+		},
+		{Profile: "broken", Error: "database is locked"},
+	}
+
+	merged := mergeProfileStatsAsOf(profiles, StreakRules{}, now)
+
+	assert.Len(t, merged.Profiles, 3, "unreadable profiles stay in the breakdown")
+	assert.InDelta(t, 5.0, merged.Totals.TotalHours, 0.001)
+	assert.Equal(t, 5, merged.Totals.TotalSessions)
+	assert.Equal(t, 3, merged.Totals.ActivePlans)
+	assert.Equal(t, 1, merged.Totals.CompletedPlans)
+	assert.InDelta(t, 60.0, merged.Totals.AverageSession, 0.001)
+	assert.Equal(t, personalLast, *merged.Totals.LastSessionDate)
+
+	// Days from both profiles join into one four-day streak
+	assert.Equal(t, 4, merged.Totals.CurrentStreak)
+	assert.Equal(t, 4, merged.Totals.LongestStreak)
+}
+
+func TestMergeProfileStats_NoReadableProfiles(t *testing.T) {
+	merged := MergeProfileStats([]ProfileStats{{Profile: "work", Error: "no database"}}, StreakRules{})
+
+	assert.Zero(t, merged.Totals.TotalSessions)
+	assert.Zero(t, merged.Totals.AverageSession)
+	assert.Nil(t, merged.Totals.LastSessionDate)
+}
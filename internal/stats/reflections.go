@@ -0,0 +1,81 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package stats
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pezware/samedi.dev/internal/session"
+)
+
+// GetReflections returns the sessions within the time range that carry a
+// reflection, oldest first: planID's sessions, or every plan's when
+// planID is empty.
+func (s *Service) GetReflections(ctx context.Context, planID string, timeRange TimeRange) ([]session.Session, error) {
+	if err := timeRange.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid time range: %w", err)
+	}
+
+	var sessions []session.Session
+	if planID != "" {
+		inRange, err := s.planSessionsInRange(ctx, planID, timeRange)
+		if err != nil {
+			return nil, err
+		}
+		sessions = inRange
+	} else {
+		all, err := s.sessionService.ListAll(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list sessions: %w", err)
+		}
+		for _, sess := range all {
+			if timeRange.Contains(sess.StartTime) {
+				sessions = append(sessions, *sess)
+			}
+		}
+	}
+
+	reflected := make([]session.Session, 0, len(sessions))
+	for _, sess := range sessions {
+		if !sess.Reflection.IsEmpty() {
+			reflected = append(reflected, sess)
+		}
+	}
+	sort.SliceStable(reflected, func(i, j int) bool {
+		return reflected[i].StartTime.Before(reflected[j].StartTime)
+	})
+	return reflected, nil
+}
+
+// ExportReflections renders sessions' reflections as a "## Reflections"
+// section, one entry per session, or "" when there are none.
+func (e *Exporter) ExportReflections(sessions []session.Session) string {
+	if len(sessions) == 0 {
+		return ""
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("## Reflections\n\n")
+	for _, sess := range sessions {
+		target := sess.PlanID
+		if sess.ChunkID != "" {
+			target += "/" + sess.ChunkID
+		}
+		buf.WriteString(fmt.Sprintf("### %s (%s)\n\n", e.FormatDate(&sess.StartTime), target))
+		if sess.Reflection.Learned != "" {
+			buf.WriteString(fmt.Sprintf("- **Learned:** %s\n", sess.Reflection.Learned))
+		}
+		if sess.Reflection.Blocked != "" {
+			buf.WriteString(fmt.Sprintf("- **Blocked:** %s\n", sess.Reflection.Blocked))
+		}
+		if sess.Reflection.NextStep != "" {
+			buf.WriteString(fmt.Sprintf("- **Next step:** %s\n", sess.Reflection.NextStep))
+		}
+		buf.WriteString("\n")
+	}
+	return buf.String()
+}
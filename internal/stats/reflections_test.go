@@ -0,0 +1,60 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package stats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_GetReflections(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	reflected := func(s *session.Session, learned string) *session.Session {
+		s.Reflection = &session.Reflection{Learned: learned}
+		return s
+	}
+	sessions := []*session.Session{
+		reflected(newTestSession("s3", "p2", now.Add(-time.Hour), 30), "borrowing"),
+		newTestSession("s2", "p1", now.Add(-2*time.Hour), 45),
+		reflected(newTestSession("s1", "p1", now.Add(-3*time.Hour), 60), "pinning"),
+		reflected(newTestSession("s0", "p1", now.AddDate(0, 0, -40), 60), "too old"),
+	}
+	mockSessionService := new(MockSessionService)
+	mockSessionService.On("ListAll", ctx).Return(sessions, nil)
+	mockSessionService.On("List", ctx, "p1", 0).Return([]*session.Session{sessions[1], sessions[2], sessions[3]}, nil)
+	service := NewService(new(MockPlanService), mockSessionService)
+	week := NewTimeRangeSince(now.AddDate(0, 0, -7))
+
+	all, err := service.GetReflections(ctx, "", week)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, "s1", all[0].ID, "oldest first")
+	assert.Equal(t, "s3", all[1].ID)
+
+	p1, err := service.GetReflections(ctx, "p1", week)
+	require.NoError(t, err)
+	require.Len(t, p1, 1)
+	assert.Equal(t, "pinning", p1[0].Reflection.Learned)
+}
+
+func TestExporter_ExportReflections(t *testing.T) {
+	exporter := NewExporter()
+	assert.Empty(t, exporter.ExportReflections(nil))
+
+	start := time.Date(2026, 10, 14, 9, 0, 0, 0, time.UTC)
+	section := exporter.ExportReflections([]session.Session{{
+		PlanID:     "rust-async",
+		ChunkID:    "chunk-003",
+		StartTime:  start,
+		Reflection: &session.Reflection{Learned: "Pin", NextStep: "Write an executor"},
+	}})
+	assert.Equal(t, "## Reflections\n\n### 2026-10-14 (rust-async/chunk-003)\n\n- **Learned:** Pin\n- **Next step:** Write an executor\n\n", section)
+}
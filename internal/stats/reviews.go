@@ -0,0 +1,76 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package stats
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/flashcard"
+)
+
+// ReviewSource lists graded flashcard reviews.
+type ReviewSource interface {
+	ListReviews(ctx context.Context, since time.Time) ([]flashcard.Review, error)
+}
+
+// ReviewStats summarizes flashcard reviews.
+type ReviewStats struct {
+	Reviews   int     `json:"reviews"`
+	Again     int     `json:"again"`
+	Hard      int     `json:"hard"`
+	Good      int     `json:"good"`
+	Easy      int     `json:"easy"`
+	Retention float64 `json:"retention"` // Percent of reviews rated Good or Easy
+}
+
+// CalculateReviewStats counts reviews by rating and computes retention.
+func CalculateReviewStats(reviews []flashcard.Review) ReviewStats {
+	var rs ReviewStats
+	for _, review := range reviews {
+		switch review.Rating {
+		case flashcard.Again:
+			rs.Again++
+		case flashcard.Hard:
+			rs.Hard++
+		case flashcard.Good:
+			rs.Good++
+		case flashcard.Easy:
+			rs.Easy++
+		default:
+			continue
+		}
+		rs.Reviews++
+	}
+	if rs.Reviews > 0 {
+		rs.Retention = float64(rs.Good+rs.Easy) / float64(rs.Reviews) * 100
+	}
+	return rs
+}
+
+// SetReviewSource gives the service flashcard reviews to report on.
+// Without one, review stats are empty.
+func (s *Service) SetReviewSource(source ReviewSource) {
+	s.reviewSource = source
+}
+
+// GetReviewStats summarizes the flashcard reviews graded since the start
+// of timeRange. Ranges end now, so reviews graded after the range was
+// created still count.
+func (s *Service) GetReviewStats(ctx context.Context, timeRange TimeRange) (*ReviewStats, error) {
+	if err := timeRange.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid time range: %w", err)
+	}
+	if s.reviewSource == nil {
+		return &ReviewStats{}, nil
+	}
+
+	reviews, err := s.reviewSource.ListReviews(ctx, timeRange.Start)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reviews: %w", err)
+	}
+	rs := CalculateReviewStats(reviews)
+	return &rs, nil
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package stats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/flashcard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeReviewSource returns the reviews at or after since.
+type fakeReviewSource struct {
+	reviews []flashcard.Review
+}
+
+func (f *fakeReviewSource) ListReviews(_ context.Context, since time.Time) ([]flashcard.Review, error) {
+	var out []flashcard.Review
+	for _, review := range f.reviews {
+		if !review.ReviewedAt.Before(since) {
+			out = append(out, review)
+		}
+	}
+	return out, nil
+}
+
+func TestCalculateReviewStats(t *testing.T) {
+	rs := CalculateReviewStats([]flashcard.Review{
+		{Rating: flashcard.Again},
+		{Rating: flashcard.Good},
+		{Rating: flashcard.Good},
+		{Rating: flashcard.Easy},
+		{Rating: flashcard.Rating(9)},
+	})
+	assert.Equal(t, ReviewStats{Reviews: 4, Again: 1, Good: 2, Easy: 1, Retention: 75}, rs)
+
+	assert.Equal(t, ReviewStats{}, CalculateReviewStats(nil))
+}
+
+func TestService_GetReviewStats(t *testing.T) {
+	svc := NewService(&MockPlanService{}, &MockSessionService{})
+	ctx := context.Background()
+
+	rs, err := svc.GetReviewStats(ctx, NewTimeRangeAll())
+	require.NoError(t, err)
+	assert.Zero(t, rs.Reviews, "no source means no reviews")
+
+	now := time.Now()
+	svc.SetReviewSource(&fakeReviewSource{reviews: []flashcard.Review{
+		{Rating: flashcard.Hard, ReviewedAt: now.AddDate(0, 0, -30)},
+		{Rating: flashcard.Good, ReviewedAt: now.Add(-time.Hour)},
+		{Rating: flashcard.Again, ReviewedAt: now.Add(-time.Minute)},
+	}})
+
+	rs, err = svc.GetReviewStats(ctx, NewTimeRangeAll())
+	require.NoError(t, err)
+	assert.Equal(t, 3, rs.Reviews)
+
+	rs, err = svc.GetReviewStats(ctx, NewTimeRangeSince(now.AddDate(0, 0, -7)))
+	require.NoError(t, err)
+	assert.Equal(t, 2, rs.Reviews)
+	assert.InDelta(t, 50.0, rs.Retention, 0.001)
+}
@@ -6,6 +6,8 @@ package stats
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/pezware/samedi.dev/internal/plan"
 	"github.com/pezware/samedi.dev/internal/session"
@@ -24,12 +26,31 @@ type SessionService interface {
 	ListAll(ctx context.Context) ([]*session.Session, error)
 }
 
+// SessionAggregator is implemented by session services that can total
+// sessions in the database. With one, total and daily stats and streaks
+// are computed without loading every session.
+type SessionAggregator interface {
+	Totals(ctx context.Context, filter *session.Filter) (*session.Totals, error)
+	DailyTotals(ctx context.Context, filter *session.Filter, loc *time.Location) ([]session.DayTotal, error)
+}
+
+// SessionQuerier is implemented by session services that can filter and
+// total sessions in the database, so plan stats load only the sessions
+// they show.
+type SessionQuerier interface {
+	SessionAggregator
+	Query(ctx context.Context, filter *session.Filter) ([]*session.Session, error)
+}
+
 // Service provides statistics calculation using plan and session data.
 // It acts as a facade over the calculator functions, handling data loading
 // and conversion.
 type Service struct {
 	planService    PlanService
 	sessionService SessionService
+	reviewSource   ReviewSource // Optional - flashcard reviews
+	streakRules    StreakRules  // Zero value counts any session on any day
+	location       *time.Location
 }
 
 // NewService creates a new stats service with required dependencies.
@@ -40,8 +61,39 @@ func NewService(planService PlanService, sessionService SessionService) *Service
 	}
 }
 
+// SetStreakRules sets the rules streaks are counted by.
+func (s *Service) SetStreakRules(rules StreakRules) {
+	s.streakRules = rules
+}
+
+// SetLocation sets the time zone days are bucketed in and dates are
+// reported in. Without one, each session's day is the day it was recorded
+// on, in its own zone.
+func (s *Service) SetLocation(loc *time.Location) {
+	s.location = loc
+}
+
+// Location returns the zone set by SetLocation, or the local zone.
+func (s *Service) Location() *time.Location {
+	if s.location == nil {
+		return time.Local
+	}
+	return s.location
+}
+
+// inLocation returns t in the service's zone, or t as is without one.
+func (s *Service) inLocation(t *time.Time) *time.Time {
+	if t == nil || s.location == nil {
+		return t
+	}
+	local := t.In(s.location)
+	return &local
+}
+
 // GetTotalStats computes aggregate statistics across all learning activity.
-// It loads all plans and sessions, filters by time range, then uses the calculator functions.
+// When the session service is a SessionAggregator the sums and streaks are
+// computed in the database; otherwise it loads all plans and sessions,
+// filters by time range, then uses the calculator functions.
 func (s *Service) GetTotalStats(ctx context.Context, timeRange TimeRange) (*TotalStats, error) {
 	// Validate time range
 	if err := timeRange.Validate(); err != nil {
@@ -54,6 +106,10 @@ func (s *Service) GetTotalStats(ctx context.Context, timeRange TimeRange) (*Tota
 		return nil, fmt.Errorf("failed to list plans: %w", err)
 	}
 
+	if agg, ok := s.sessionService.(SessionAggregator); ok {
+		return s.aggregateTotalStats(ctx, agg, planRecords, timeRange)
+	}
+
 	// Load full plans with chunks (needed for progress calculations)
 	plans := make([]plan.Plan, 0, len(planRecords))
 	for _, record := range planRecords {
@@ -80,12 +136,59 @@ func (s *Service) GetTotalStats(ctx context.Context, timeRange TimeRange) (*Tota
 
 	// Calculate stats
 	stats := CalculateTotalStats(sessionValues, plans)
+	stats.CurrentStreak, stats.LongestStreak = s.streakRules.Streaks(sessionValues, time.Now())
+	stats.LastSessionDate = s.inLocation(stats.LastSessionDate)
+
+	return &stats, nil
+}
+
+// aggregateTotalStats computes total stats from database aggregates. Plan
+// counts come from the plan records, so no plan file is read either.
+func (s *Service) aggregateTotalStats(ctx context.Context, agg SessionAggregator, records []*storage.PlanRecord, timeRange TimeRange) (*TotalStats, error) {
+	var stats TotalStats
+	for _, record := range records {
+		switch plan.Status(record.Status) {
+		case plan.StatusNotStarted, plan.StatusInProgress:
+			stats.ActivePlans++
+		case plan.StatusCompleted:
+			stats.CompletedPlans++
+		}
+	}
+
+	filter := rangeFilter(timeRange)
+	totals, err := agg.Totals(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to total sessions: %w", err)
+	}
+	if totals.Sessions == 0 {
+		return &stats, nil
+	}
+	stats.TotalHours = float64(totals.Minutes) / 60.0
+	stats.TotalSessions = totals.Sessions
+	stats.AverageSession = float64(totals.Minutes) / float64(totals.Sessions)
+	stats.LastSessionDate = s.inLocation(totals.Last)
+
+	days, err := agg.DailyTotals(ctx, filter, s.streakRules.Location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to total sessions by day: %w", err)
+	}
+	stats.CurrentStreak, stats.LongestStreak = s.streakRules.StreaksFromTotals(days, time.Now())
 
 	return &stats, nil
 }
 
-// GetPlanStats computes statistics for a specific learning plan.
-// It loads the plan and its sessions, filters by time range, then calculates plan-specific metrics.
+// rangeFilter selects the sessions TimeRange.Contains would. The filter's
+// Until is exclusive and the database compares to the millisecond, so it
+// sits just past the range end.
+func rangeFilter(timeRange TimeRange) *session.Filter {
+	return &session.Filter{Since: timeRange.Start, Until: timeRange.End.Add(time.Millisecond)}
+}
+
+// GetPlanStats computes statistics for a specific learning plan. Hours
+// and sessions cover timeRange; AllTimeHours and AllTimeSessions, the
+// forecast, and velocity don't depend on it. When the session service is
+// a SessionQuerier only the sessions in range and in the pace window are
+// loaded.
 func (s *Service) GetPlanStats(ctx context.Context, planID string, timeRange TimeRange) (*PlanStats, error) {
 	// Validate time range
 	if err := timeRange.Validate(); err != nil {
@@ -98,34 +201,204 @@ func (s *Service) GetPlanStats(ctx context.Context, planID string, timeRange Tim
 		return nil, fmt.Errorf("failed to load plan: %w", err)
 	}
 
+	var stats PlanStats
+	if querier, ok := s.sessionService.(SessionQuerier); ok {
+		stats, err = queryPlanStats(ctx, querier, p, timeRange, time.Now())
+	} else {
+		stats, err = s.loadPlanStats(ctx, p, timeRange, time.Now())
+	}
+	if err != nil {
+		return nil, err
+	}
+	stats.Range = &timeRange
+	stats.LastSession = s.inLocation(stats.LastSession)
+
+	if len(p.Children) > 0 {
+		rollup, err := s.planRollup(ctx, planID, stats, timeRange)
+		if err != nil {
+			return nil, err
+		}
+		stats.Rollup = rollup
+	}
+
+	return &stats, nil
+}
+
+// loadPlanStats computes plan stats from every session of the plan.
+func (s *Service) loadPlanStats(ctx context.Context, p *plan.Plan, timeRange TimeRange, now time.Time) (PlanStats, error) {
 	// Load plan sessions (limit 0 = all sessions)
-	sessions, err := s.sessionService.List(ctx, planID, 0)
+	sessions, err := s.sessionService.List(ctx, p.ID, 0)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list sessions: %w", err)
+		return PlanStats{}, fmt.Errorf("failed to list sessions: %w", err)
 	}
 
 	// Convert pointers to values and filter by time range
+	allSessions := make([]session.Session, len(sessions))
 	sessionValues := make([]session.Session, 0, len(sessions))
 	for i := range sessions {
+		allSessions[i] = *sessions[i]
 		if timeRange.Contains(sessions[i].StartTime) {
 			sessionValues = append(sessionValues, *sessions[i])
 		}
 	}
 
 	// Calculate stats
-	stats := CalculatePlanStats(planID, sessionValues, p)
+	stats := CalculatePlanStats(p.ID, sessionValues, p)
+	allTime := CalculatePlanStats(p.ID, allSessions, p)
+	stats.AllTimeHours = allTime.TotalHours
+	stats.AllTimeSessions = allTime.SessionCount
 
-	return &stats, nil
+	// The forecast uses recent pace whatever range is being shown
+	stats.Forecast = CalculateForecast(p, allSessions, now)
+	velocity := CalculateVelocity(p, allSessions, now)
+	stats.Velocity = &velocity
+
+	return stats, nil
 }
 
-// GetDailyStats groups sessions by day and returns daily statistics.
-// It loads all sessions and filters them by the given time range.
+// queryPlanStats computes plan stats from the sessions in range, the
+// sessions in the pace window, and all-time totals, each asked of the
+// database separately.
+func queryPlanStats(ctx context.Context, querier SessionQuerier, p *plan.Plan, timeRange TimeRange, now time.Time) (PlanStats, error) {
+	filter := rangeFilter(timeRange)
+	filter.PlanID = p.ID
+	inRange, err := querier.Query(ctx, filter)
+	if err != nil {
+		return PlanStats{}, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	stats := CalculatePlanStats(p.ID, sessionValues(inRange), p)
+
+	allTime, err := querier.Totals(ctx, &session.Filter{PlanID: p.ID})
+	if err != nil {
+		return PlanStats{}, fmt.Errorf("failed to total sessions: %w", err)
+	}
+	stats.AllTimeHours = float64(allTime.Minutes) / 60.0
+	stats.AllTimeSessions = allTime.Sessions
+
+	// The pace window covers velocity's two weeks as well
+	recent, err := querier.Query(ctx, &session.Filter{PlanID: p.ID, Since: now.Add(-PaceWindow)})
+	if err != nil {
+		return PlanStats{}, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	recentValues := sessionValues(recent)
+
+	recentMinutes := 0
+	for i := range recentValues {
+		if sess := &recentValues[i]; !sess.IsActive() && !sess.StartTime.After(now) {
+			recentMinutes += sess.Duration
+		}
+	}
+	var first time.Time
+	if allTime.First != nil {
+		first = *allTime.First
+	}
+	stats.Forecast = forecastFrom(p, allTime.Minutes, recentMinutes, first, now)
+	velocity := CalculateVelocity(p, recentValues, now)
+	stats.Velocity = &velocity
+
+	return stats, nil
+}
+
+// sessionValues copies sessions into a slice of values, as the calculator
+// functions take them.
+func sessionValues(sessions []*session.Session) []session.Session {
+	values := make([]session.Session, len(sessions))
+	for i := range sessions {
+		values[i] = *sessions[i]
+	}
+	return values
+}
+
+// planRollup loads every plan beneath planID and totals them with the
+// plan's own stats.
+func (s *Service) planRollup(ctx context.Context, planID string, own PlanStats, timeRange TimeRange) (*RollupStats, error) {
+	records, err := s.planService.List(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plans: %w", err)
+	}
+	children := plan.ChildrenByPlan(records)
+	children[planID] = own.SubPlans
+
+	all := map[string]PlanStats{planID: own}
+	for _, id := range plan.Descendants(children, planID) {
+		child, err := s.planService.Get(ctx, id)
+		if err != nil {
+			continue // Dangling reference; plan validate reports it
+		}
+		values, err := s.planSessionsInRange(ctx, id, timeRange)
+		if err != nil {
+			return nil, err
+		}
+		all[id] = CalculatePlanStats(id, values, child)
+	}
+
+	return CalculateRollup(planID, all, children), nil
+}
+
+// planSessionsInRange returns a plan's sessions within the time range,
+// filtered in the database when the session service can query.
+func (s *Service) planSessionsInRange(ctx context.Context, planID string, timeRange TimeRange) ([]session.Session, error) {
+	if querier, ok := s.sessionService.(SessionQuerier); ok {
+		filter := rangeFilter(timeRange)
+		filter.PlanID = planID
+		sessions, err := querier.Query(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list sessions: %w", err)
+		}
+		return sessionValues(sessions), nil
+	}
+
+	sessions, err := s.sessionService.List(ctx, planID, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	values := make([]session.Session, 0, len(sessions))
+	for i := range sessions {
+		if timeRange.Contains(sessions[i].StartTime) {
+			values = append(values, *sessions[i])
+		}
+	}
+	return values, nil
+}
+
+// GetPlanSessions returns a plan's sessions within the time range, oldest
+// first. Used to drill from a plan's totals down to individual days.
+func (s *Service) GetPlanSessions(ctx context.Context, planID string, timeRange TimeRange) ([]session.Session, error) {
+	if err := timeRange.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid time range: %w", err)
+	}
+
+	result, err := s.planSessionsInRange(ctx, planID, timeRange)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].StartTime.Before(result[j].StartTime)
+	})
+
+	return result, nil
+}
+
+// GetDailyStats groups sessions by day and returns daily statistics for
+// the given time range, grouped in the database when it can be.
 func (s *Service) GetDailyStats(ctx context.Context, timeRange TimeRange) ([]DailyStats, error) {
 	// Validate time range
 	if err := timeRange.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid time range: %w", err)
 	}
 
+	if agg, ok := s.sessionService.(SessionAggregator); ok {
+		days, err := agg.DailyTotals(ctx, rangeFilter(timeRange), s.location)
+		if err != nil {
+			return nil, fmt.Errorf("failed to total sessions by day: %w", err)
+		}
+		daily := make([]DailyStats, len(days))
+		for i, day := range days {
+			daily[i] = DailyStats{Date: day.Day, Duration: day.Minutes, SessionCount: day.Sessions, Plans: day.PlanIDs}
+		}
+		return daily, nil
+	}
+
 	// Load all sessions
 	sessions, err := s.sessionService.ListAll(ctx)
 	if err != nil {
@@ -139,14 +412,23 @@ func (s *Service) GetDailyStats(ctx context.Context, timeRange TimeRange) ([]Dai
 	}
 
 	// Calculate daily stats
-	stats := CalculateDailyStats(sessionValues, timeRange)
+	stats := CalculateDailyStatsIn(sessionValues, timeRange, s.location)
 
 	return stats, nil
 }
 
-// GetStreakInfo returns current and longest learning streaks.
-// A streak is consecutive days with at least one learning session.
+// GetStreakInfo returns current and longest learning streaks under the
+// service's streak rules.
 func (s *Service) GetStreakInfo(ctx context.Context) (currentStreak, longestStreak int, err error) {
+	if agg, ok := s.sessionService.(SessionAggregator); ok {
+		days, err := agg.DailyTotals(ctx, nil, s.streakRules.Location)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to total sessions by day: %w", err)
+		}
+		current, longest := s.streakRules.StreaksFromTotals(days, time.Now())
+		return current, longest, nil
+	}
+
 	// Load all sessions
 	sessions, err := s.sessionService.ListAll(ctx)
 	if err != nil {
@@ -160,7 +442,7 @@ func (s *Service) GetStreakInfo(ctx context.Context) (currentStreak, longestStre
 	}
 
 	// Calculate streaks
-	current, longest := CalculateStreak(sessionValues)
+	current, longest := s.streakRules.Streaks(sessionValues, time.Now())
 
 	return current, longest, nil
 }
@@ -239,6 +521,10 @@ func (s *Service) GetAllPlanStats(ctx context.Context, timeRange TimeRange) (map
 
 	// Aggregate by plan
 	stats := AggregateByPlan(sessionValues, plans)
+	for id, ps := range stats {
+		ps.LastSession = s.inLocation(ps.LastSession)
+		stats[id] = ps
+	}
 
 	return stats, nil
 }
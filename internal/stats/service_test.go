@@ -11,6 +11,7 @@ import (
 	"github.com/pezware/samedi.dev/internal/plan"
 	"github.com/pezware/samedi.dev/internal/session"
 	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/pezware/samedi.dev/pkg/testsupport"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -329,6 +330,56 @@ func TestService_GetPlanStats(t *testing.T) {
 	}
 }
 
+func TestService_GetPlanStats_ForecastIgnoresRange(t *testing.T) {
+	ctx := context.Background()
+	lastWeek := time.Now().AddDate(0, 0, -7)
+
+	mockPlanService := new(MockPlanService)
+	mockSessionService := new(MockSessionService)
+	mockPlanService.On("Get", ctx, "p1").Return(newTestPlan("p1", "Rust Async", plan.StatusInProgress, []plan.Chunk{
+		{ID: "c1", Status: plan.StatusNotStarted, Duration: 120},
+	}), nil)
+	mockSessionService.On("List", ctx, "p1", 0).Return([]*session.Session{
+		newTestSession("s1", "p1", lastWeek, 60),
+	}, nil)
+
+	service := NewService(mockPlanService, mockSessionService)
+	stats, err := service.GetPlanStats(ctx, "p1", NewTimeRangeToday())
+	require.NoError(t, err)
+
+	assert.Zero(t, stats.SessionCount)
+	require.NotNil(t, stats.Forecast)
+	assert.InDelta(t, 2.0, stats.Forecast.RemainingHours, 0.001)
+	assert.InDelta(t, 1.0, stats.Forecast.WeeklyPace, 0.01)
+	assert.NotNil(t, stats.Forecast.CompletionDate)
+
+	require.NotNil(t, stats.Velocity, "velocity also looks past the range")
+	assert.InDelta(t, 1.0, stats.Velocity.LastWeekHours, 0.001)
+	assert.Equal(t, TrendDown, stats.Velocity.Trend)
+}
+
+func TestService_GetPlanSessions(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	older := now.AddDate(0, 0, -40)
+
+	mockPlanService := new(MockPlanService)
+	mockSessionService := new(MockSessionService)
+	mockSessionService.On("List", ctx, "p1", 0).Return([]*session.Session{
+		newTestSession("s3", "p1", now.Add(-time.Hour), 30),
+		newTestSession("s2", "p1", now.Add(-2*time.Hour), 45),
+		newTestSession("s1", "p1", older, 60),
+	}, nil)
+
+	service := NewService(mockPlanService, mockSessionService)
+
+	sessions, err := service.GetPlanSessions(ctx, "p1", NewTimeRangeSince(now.AddDate(0, 0, -7)))
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, "s2", sessions[0].ID, "oldest first")
+	assert.Equal(t, "s3", sessions[1].ID)
+}
+
 func TestService_GetDailyStats(t *testing.T) {
 	ctx := context.Background()
 
@@ -475,3 +526,118 @@ func TestService_GetStreakInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestService_AggregatedStatsMatchLoadedSessions(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	sessions := []*session.Session{
+		newTestSession("s1", "p1", now.Add(-time.Hour), 30),
+		newTestSession("s2", "p2", now.AddDate(0, 0, -1), 45),
+		newTestSession("s3", "p1", now.AddDate(0, 0, -2), 60),
+		newTestSession("s4", "p1", now.AddDate(0, 0, -20), 20),
+	}
+	records := []*storage.PlanRecord{
+		newTestPlanRecord("p1", "Rust", plan.StatusInProgress),
+		newTestPlanRecord("p2", "Go", plan.StatusCompleted),
+	}
+
+	plans := new(MockPlanService)
+	plans.On("List", ctx, (*storage.PlanFilter)(nil)).Return(records, nil)
+	plans.On("Get", ctx, "p1").Return(newTestPlan("p1", "Rust", plan.StatusInProgress, nil), nil)
+	plans.On("Get", ctx, "p2").Return(newTestPlan("p2", "Go", plan.StatusCompleted, nil), nil)
+
+	listed := new(MockSessionService)
+	listed.On("ListAll", ctx).Return(sessions, nil)
+	listed.On("List", ctx, "p1", 0).Return([]*session.Session{sessions[0], sessions[2], sessions[3]}, nil)
+
+	repo := testsupport.NewSessionRepository()
+	for _, sess := range sessions {
+		require.NoError(t, repo.Create(ctx, sess))
+	}
+
+	rules := StreakRules{Location: time.Local}
+	loaded := NewService(plans, listed)
+	loaded.SetStreakRules(rules)
+	aggregated := NewService(plans, session.NewService(repo, nil))
+	aggregated.SetStreakRules(rules)
+
+	for _, timeRange := range []TimeRange{NewTimeRangeAll(), NewTimeRangeSince(now.AddDate(0, 0, -7))} {
+		want, err := loaded.GetTotalStats(ctx, timeRange)
+		require.NoError(t, err)
+		got, err := aggregated.GetTotalStats(ctx, timeRange)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+
+		wantDaily, err := loaded.GetDailyStats(ctx, timeRange)
+		require.NoError(t, err)
+		gotDaily, err := aggregated.GetDailyStats(ctx, timeRange)
+		require.NoError(t, err)
+		assert.Equal(t, len(wantDaily), len(gotDaily))
+		for i := range wantDaily {
+			assert.Equal(t, wantDaily[i].Date.Format("2006-01-02"), gotDaily[i].Date.Format("2006-01-02"))
+			assert.Equal(t, wantDaily[i].Duration, gotDaily[i].Duration)
+			assert.Equal(t, wantDaily[i].SessionCount, gotDaily[i].SessionCount)
+			assert.ElementsMatch(t, wantDaily[i].Plans, gotDaily[i].Plans)
+		}
+	}
+
+	weekly := NewTimeRangeSince(now.AddDate(0, 0, -7))
+	want, err := loaded.GetPlanStats(ctx, "p1", weekly)
+	require.NoError(t, err)
+	got, err := aggregated.GetPlanStats(ctx, "p1", weekly)
+	require.NoError(t, err)
+	assert.Equal(t, 2, got.SessionCount, "only the week's sessions")
+	assert.Equal(t, 3, got.AllTimeSessions)
+	assert.InDelta(t, 110.0/60, got.AllTimeHours, 0.001)
+	assert.Equal(t, []any{want.TotalHours, want.SessionCount, want.AllTimeHours, want.AllTimeSessions, *want.Velocity, want.Range},
+		[]any{got.TotalHours, got.SessionCount, got.AllTimeHours, got.AllTimeSessions, *got.Velocity, got.Range})
+	require.NotNil(t, got.Forecast)
+	assert.Equal(t, want.Forecast.CompletionDate, got.Forecast.CompletionDate)
+	assert.InDelta(t, want.Forecast.WeeklyPace, got.Forecast.WeeklyPace, 0.001)
+
+	wantCurrent, wantLongest, err := loaded.GetStreakInfo(ctx)
+	require.NoError(t, err)
+	gotCurrent, gotLongest, err := aggregated.GetStreakInfo(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []int{wantCurrent, wantLongest}, []int{gotCurrent, gotLongest})
+}
+
+func TestService_SetLocation(t *testing.T) {
+	ctx := context.Background()
+	ny := newYork(t)
+	sessions := []*session.Session{
+		newTestSession("s1", "p1", time.Date(2024, 3, 10, 4, 30, 0, 0, time.UTC), 30), // 23:30 on the 9th in New York
+		newTestSession("s2", "p1", time.Date(2024, 3, 10, 7, 30, 0, 0, time.UTC), 30),
+	}
+	records := []*storage.PlanRecord{newTestPlanRecord("p1", "Rust", plan.StatusInProgress)}
+
+	plans := new(MockPlanService)
+	plans.On("List", ctx, (*storage.PlanFilter)(nil)).Return(records, nil)
+	plans.On("Get", ctx, "p1").Return(newTestPlan("p1", "Rust", plan.StatusInProgress, nil), nil)
+	listed := new(MockSessionService)
+	listed.On("ListAll", ctx).Return(sessions, nil)
+
+	repo := testsupport.NewSessionRepository()
+	for _, sess := range sessions {
+		require.NoError(t, repo.Create(ctx, sess))
+	}
+
+	for name, svc := range map[string]*Service{
+		"loaded":     NewService(plans, listed),
+		"aggregated": NewService(plans, session.NewService(repo, nil)),
+	} {
+		assert.Equal(t, time.Local, svc.Location(), name)
+		svc.SetLocation(ny)
+
+		daily, err := svc.GetDailyStats(ctx, NewTimeRangeAll())
+		require.NoError(t, err, name)
+		require.Len(t, daily, 2, name)
+		assert.Equal(t, "2024-03-09", daily[0].Date.Format("2006-01-02"), name)
+		assert.Equal(t, ny, daily[0].Date.Location(), name)
+
+		total, err := svc.GetTotalStats(ctx, NewTimeRangeAll())
+		require.NoError(t, err, name)
+		require.NotNil(t, total.LastSessionDate, name)
+		assert.Equal(t, "2024-03-10T03:30:00-04:00", total.LastSessionDate.Format(time.RFC3339), "reported in the zone: %s", name)
+	}
+}
@@ -10,6 +10,94 @@ import (
 	"github.com/pezware/samedi.dev/internal/session"
 )
 
+// StreakRules decide which days keep a streak going. The zero value counts
+// any session on a calendar day, bucketed in the session's own time zone.
+type StreakRules struct {
+	MinMinutes int            // Minutes a day needs to count; 0 counts any session
+	RestDays   []time.Weekday // Weekdays that neither extend nor break a streak
+	Location   *time.Location // Zone days are bucketed in; nil uses each session's own
+}
+
+// IsRestDay reports whether day's weekday is a rest day.
+func (r StreakRules) IsRestDay(day time.Time) bool {
+	for _, rest := range r.RestDays {
+		if day.Weekday() == rest {
+			return true
+		}
+	}
+	return false
+}
+
+// Counts reports whether minutes learned in a day are enough to count
+// toward a streak.
+func (r StreakRules) Counts(minutes int) bool {
+	return minutes >= r.MinMinutes
+}
+
+// ActiveDays returns the sorted days whose sessions add up to at least
+// MinMinutes. Active sessions count the time elapsed so far.
+func (r StreakRules) ActiveDays(sessions []session.Session) []time.Time {
+	if len(sessions) == 0 {
+		return []time.Time{}
+	}
+
+	// Use map to track unique days and the minutes logged on each
+	dayMap := make(map[string]time.Time)
+	minutes := make(map[string]int)
+
+	for i := range sessions {
+		start := sessions[i].StartTime
+		if r.Location != nil {
+			start = start.In(r.Location)
+		}
+		// Normalize to midnight on the start day
+		dayStart := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+
+		key := dayStart.Format("2006-01-02")
+		dayMap[key] = dayStart
+		if sessions[i].IsActive() {
+			minutes[key] += sessions[i].ElapsedMinutes()
+		} else {
+			minutes[key] += sessions[i].Duration
+		}
+	}
+
+	// Convert map to slice, dropping days below the minimum
+	days := make([]time.Time, 0, len(dayMap))
+	for key, day := range dayMap {
+		if r.Counts(minutes[key]) {
+			days = append(days, day)
+		}
+	}
+
+	// Sort chronologically
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].Before(days[j])
+	})
+
+	return days
+}
+
+// Streaks calculates the current and longest streaks as of now.
+func (r StreakRules) Streaks(sessions []session.Session, now time.Time) (int, int) {
+	if len(sessions) == 0 {
+		return 0, 0
+	}
+	return streakFromDays(r.ActiveDays(sessions), now, r)
+}
+
+// StreaksFromTotals calculates the current and longest streaks as of now
+// from day totals bucketed in the rules' Location.
+func (r StreakRules) StreaksFromTotals(days []session.DayTotal, now time.Time) (int, int) {
+	active := make([]time.Time, 0, len(days))
+	for _, day := range days {
+		if r.Counts(day.Elapsed) {
+			active = append(active, day.Day)
+		}
+	}
+	return streakFromDays(active, now, r)
+}
+
 // CalculateStreak calculates the current and longest learning streaks.
 // A streak is consecutive days with at least one learning session.
 // Returns (currentStreak, longestStreak).
@@ -20,18 +108,17 @@ func CalculateStreak(sessions []session.Session) (int, int) {
 // calculateStreakAsOf calculates streaks as of a specific point in time.
 // This is useful for testing and historical analysis.
 func calculateStreakAsOf(sessions []session.Session, now time.Time) (int, int) {
-	if len(sessions) == 0 {
-		return 0, 0
-	}
+	return StreakRules{}.Streaks(sessions, now)
+}
 
-	// Get all active days sorted chronologically
-	activeDays := GetActiveDays(sessions)
+// streakFromDays calculates streaks from sorted, unique active days.
+func streakFromDays(activeDays []time.Time, now time.Time, rules StreakRules) (int, int) {
 	if len(activeDays) == 0 {
 		return 0, 0
 	}
 
 	// Find all streaks
-	streaks := findStreaks(activeDays)
+	streaks := findStreaks(activeDays, rules)
 	if len(streaks) == 0 {
 		return 0, 0
 	}
@@ -44,14 +131,16 @@ func calculateStreakAsOf(sessions []session.Session, now time.Time) (int, int) {
 		}
 	}
 
-	// Check if current streak is active (last session was today or yesterday)
+	// The current streak is still going if nothing but rest days lies
+	// between the last active day and today
+	if rules.Location != nil {
+		now = now.In(rules.Location)
+	}
 	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
-	yesterday := today.AddDate(0, 0, -1)
-
 	lastDay := activeDays[len(activeDays)-1]
 
 	currentStreak := 0
-	if sameDay(lastDay, today) || sameDay(lastDay, yesterday) {
+	if sameDay(lastDay, today) || restBetween(lastDay, today, rules) {
 		// Current streak is active - it's the last streak in the list
 		currentStreak = streaks[len(streaks)-1]
 	}
@@ -62,39 +151,7 @@ func calculateStreakAsOf(sessions []session.Session, now time.Time) (int, int) {
 // GetActiveDays returns a sorted list of unique days with learning activity.
 // Days are normalized to midnight in the session's timezone.
 func GetActiveDays(sessions []session.Session) []time.Time {
-	if len(sessions) == 0 {
-		return []time.Time{}
-	}
-
-	// Use map to track unique days
-	dayMap := make(map[string]time.Time)
-
-	for i := range sessions {
-		// Normalize to midnight on the start day
-		dayStart := time.Date(
-			sessions[i].StartTime.Year(),
-			sessions[i].StartTime.Month(),
-			sessions[i].StartTime.Day(),
-			0, 0, 0, 0,
-			sessions[i].StartTime.Location(),
-		)
-
-		key := dayStart.Format("2006-01-02")
-		dayMap[key] = dayStart
-	}
-
-	// Convert map to slice
-	days := make([]time.Time, 0, len(dayMap))
-	for _, day := range dayMap {
-		days = append(days, day)
-	}
-
-	// Sort chronologically
-	sort.Slice(days, func(i, j int) bool {
-		return days[i].Before(days[j])
-	})
-
-	return days
+	return StreakRules{}.ActiveDays(sessions)
 }
 
 // DetectStreakBreaks identifies days where streaks were broken (gaps in activity).
@@ -111,7 +168,7 @@ func DetectStreakBreaks(sessions []session.Session) []time.Time {
 		nextDay := activeDays[i+1]
 
 		// Calculate days between
-		daysBetween := int(nextDay.Sub(currentDay).Hours() / 24)
+		daysBetween := calendarDaysBetween(currentDay, nextDay)
 
 		// If more than 1 day gap, we have break(s)
 		if daysBetween > 1 {
@@ -128,8 +185,9 @@ func DetectStreakBreaks(sessions []session.Session) []time.Time {
 
 // Helper functions
 
-// findStreaks identifies all consecutive day streaks and returns their lengths.
-func findStreaks(activeDays []time.Time) []int {
+// findStreaks identifies all consecutive day streaks and returns their
+// lengths. Gaps made up only of rest days don't end a streak.
+func findStreaks(activeDays []time.Time, rules StreakRules) []int {
 	if len(activeDays) == 0 {
 		return []int{}
 	}
@@ -141,11 +199,8 @@ func findStreaks(activeDays []time.Time) []int {
 		prevDay := activeDays[i-1]
 		currentDay := activeDays[i]
 
-		// Check if days are consecutive
-		daysBetween := int(currentDay.Sub(prevDay).Hours() / 24)
-
-		if daysBetween == 1 {
-			// Consecutive day - continue streak
+		if restBetween(prevDay, currentDay, rules) {
+			// Consecutive, or only rest days between - continue streak
 			currentStreak++
 		} else {
 			// Gap found - save current streak and start new one
@@ -160,6 +215,28 @@ func findStreaks(activeDays []time.Time) []int {
 	return streaks
 }
 
+// restBetween reports whether every day strictly between from and to is a
+// rest day. Consecutive days have nothing between them.
+func restBetween(from, to time.Time, rules StreakRules) bool {
+	if !from.Before(to) {
+		return false
+	}
+	for day := from.AddDate(0, 0, 1); day.Before(to) && !sameDay(day, to); day = day.AddDate(0, 0, 1) {
+		if !rules.IsRestDay(day) {
+			return false
+		}
+	}
+	return true
+}
+
+// calendarDaysBetween counts the calendar days from one day to another.
+// Days that gain or lose an hour to daylight saving still count as one.
+func calendarDaysBetween(from, to time.Time) int {
+	fromDate := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(toDate.Sub(fromDate).Hours() / 24)
+}
+
 // sameDay checks if two times represent the same calendar day.
 func sameDay(t1, t2 time.Time) bool {
 	return t1.Year() == t2.Year() &&
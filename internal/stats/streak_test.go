@@ -280,3 +280,75 @@ func createSession(id, planID string, startTime time.Time, durationMinutes int)
 func dayStart(t time.Time) time.Time {
 	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
 }
+
+func TestStreakRules(t *testing.T) {
+	// Friday evening, after a week with Sunday off
+	now := time.Date(2024, 10, 11, 20, 0, 0, 0, time.UTC)
+	day := func(offset, minutes int) session.Session {
+		return createSession("s", "p1", now.AddDate(0, 0, offset), minutes)
+	}
+	sessions := []session.Session{
+		day(-12, 30), day(-11, 30), day(-10, 30), // Sun–Tue the week before
+		day(-7, 30), day(-6, 30), // Fri and Sat
+		day(-4, 30), day(-3, 30), day(-2, 10), day(-1, 30), // Mon–Thu, Wed too short
+	}
+
+	current, longest := StreakRules{}.Streaks(sessions, now)
+	assert.Equal(t, 4, current, "any session counts by default")
+	assert.Equal(t, 4, longest)
+
+	current, longest = StreakRules{MinMinutes: 15}.Streaks(sessions, now)
+	assert.Equal(t, 1, current, "Wednesday falls short")
+	assert.Equal(t, 3, longest)
+
+	weekends := StreakRules{RestDays: []time.Weekday{time.Saturday, time.Sunday}}
+	current, longest = weekends.Streaks(sessions, now)
+	assert.Equal(t, 6, current, "Sunday rests between Saturday and Monday")
+	assert.Equal(t, 6, longest)
+
+	// Checked on Sunday, the streak survives a Friday and Saturday off
+	fridays := StreakRules{RestDays: []time.Weekday{time.Friday, time.Saturday}}
+	current, _ = fridays.Streaks(sessions[:len(sessions)-1], now.AddDate(0, 0, 2))
+	assert.Equal(t, 0, current, "Thursday is not a rest day")
+	current, _ = fridays.Streaks(sessions, now.AddDate(0, 0, 2))
+	assert.Equal(t, 4, current, "Friday and Saturday rest before Sunday")
+}
+
+func TestStreakRules_Location(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	// 20:00 UTC on the 1st is 05:00 on the 2nd in Tokyo
+	sessions := []session.Session{
+		createSession("s1", "p1", time.Date(2024, 10, 1, 10, 0, 0, 0, time.UTC), 30),
+		createSession("s2", "p1", time.Date(2024, 10, 1, 20, 0, 0, 0, time.UTC), 30),
+	}
+
+	assert.Len(t, StreakRules{}.ActiveDays(sessions), 1)
+	days := StreakRules{Location: tokyo}.ActiveDays(sessions)
+	assert.Len(t, days, 2, "days are bucketed in the configured zone")
+
+	now := time.Date(2024, 10, 2, 12, 0, 0, 0, time.UTC)
+	current, _ := StreakRules{Location: tokyo}.Streaks(sessions, now)
+	assert.Equal(t, 2, current)
+}
+
+func TestStreaks_AcrossDaylightSaving(t *testing.T) {
+	ny := newYork(t)
+	at := func(day, hour int) time.Time { return time.Date(2024, 3, day, hour, 0, 0, 0, ny) }
+	// The 10th is only 23 hours long
+	sessions := []session.Session{
+		createSession("s1", "p1", at(9, 23), 30),
+		createSession("s2", "p1", at(10, 23), 30),
+		createSession("s3", "p1", at(11, 0), 30),
+	}
+
+	rules := StreakRules{Location: ny}
+	current, longest := rules.Streaks(sessions, at(11, 20))
+	assert.Equal(t, 3, current)
+	assert.Equal(t, 3, longest)
+
+	gap := []session.Session{
+		createSession("s1", "p1", at(9, 12), 30),
+		createSession("s2", "p1", at(12, 12), 30),
+	}
+	assert.Equal(t, []time.Time{at(10, 0), at(11, 0)}, DetectStreakBreaks(gap), "a 23-hour day is still a day")
+}
@@ -4,6 +4,7 @@
 package stats
 
 import (
+	"errors"
 	"fmt"
 	"time"
 )
@@ -62,14 +63,36 @@ func (ts *TotalStats) Validate() error {
 type PlanStats struct {
 	PlanID          string     `json:"plan_id"`
 	PlanTitle       string     `json:"plan_title"`
-	TotalHours      float64    `json:"total_hours"`            // Time spent on this plan
-	PlannedHours    float64    `json:"planned_hours"`          // Total planned hours
-	SessionCount    int        `json:"session_count"`          // Number of sessions
-	CompletedChunks int        `json:"completed_chunks"`       // Chunks completed
-	TotalChunks     int        `json:"total_chunks"`           // Total chunks in plan
-	Progress        float64    `json:"progress"`               // Completion percentage (0.0-1.0)
-	Status          string     `json:"status"`                 // Plan status
-	LastSession     *time.Time `json:"last_session,omitempty"` // Most recent session
+	TotalHours      float64    `json:"total_hours"`                 // Time spent on this plan
+	PlannedHours    float64    `json:"planned_hours"`               // Total planned hours
+	SessionCount    int        `json:"session_count"`               // Number of sessions
+	AllTimeHours    float64    `json:"all_time_hours,omitempty"`    // Time spent whatever the range
+	AllTimeSessions int        `json:"all_time_sessions,omitempty"` // Sessions whatever the range
+	CompletedChunks int        `json:"completed_chunks"`            // Chunks completed
+	TotalChunks     int        `json:"total_chunks"`                // Total chunks in plan
+	Progress        float64    `json:"progress"`                    // Completion percentage (0.0-1.0)
+	Status          string     `json:"status"`                      // Plan status
+	LastSession     *time.Time `json:"last_session,omitempty"`      // Most recent session
+	GeneratedBy     string     `json:"generated_by,omitempty"`      // Plan provenance, if LLM-generated
+	Tags            []string   `json:"tags,omitempty"`              // Plan tags
+	Range           *TimeRange `json:"range,omitempty"`             // Window TotalHours and SessionCount cover
+
+	SubPlans []string     `json:"sub_plans,omitempty"` // Direct sub-plan IDs
+	Rollup   *RollupStats `json:"rollup,omitempty"`    // Totals including all sub-plans; nil without any
+
+	Forecast *Forecast `json:"forecast,omitempty"` // Projected completion; nil once finished
+	Velocity *Velocity `json:"velocity,omitempty"` // This week against last
+}
+
+// RollupStats totals a plan together with every plan beneath it.
+type RollupStats struct {
+	SubPlans        int     `json:"sub_plans"`        // Plans beneath this one, at any depth
+	TotalHours      float64 `json:"total_hours"`      // Time spent across the tree
+	PlannedHours    float64 `json:"planned_hours"`    // Planned hours across the tree
+	SessionCount    int     `json:"session_count"`    // Sessions across the tree
+	CompletedChunks int     `json:"completed_chunks"` // Chunks completed across the tree
+	TotalChunks     int     `json:"total_chunks"`     // Chunks across the tree
+	Progress        float64 `json:"progress"`         // Completed / total chunks (0.0-1.0)
 }
 
 // Validate checks if plan stats have valid values.
@@ -147,6 +170,21 @@ func (ds *DailyStats) Hours() float64 {
 	return float64(ds.Duration) / 60.0
 }
 
+// PeriodStats sums the daily stats of one week or month.
+type PeriodStats struct {
+	Start        time.Time `json:"start"`         // First day of the period (midnight)
+	End          time.Time `json:"end"`           // First day of the next period
+	Duration     int       `json:"duration"`      // Total minutes for the period
+	SessionCount int       `json:"session_count"` // Number of sessions
+	ActiveDays   int       `json:"active_days"`   // Days with at least one session
+	Plans        []string  `json:"plans"`         // Plan IDs worked on, sorted
+}
+
+// Hours returns the duration in hours.
+func (ps *PeriodStats) Hours() float64 {
+	return float64(ps.Duration) / 60.0
+}
+
 // TimeRange represents a time range for filtering statistics.
 type TimeRange struct {
 	Start time.Time `json:"start"`
@@ -173,16 +211,44 @@ func (tr *TimeRange) Contains(t time.Time) bool {
 	return !t.Before(tr.Start) && !t.After(tr.End)
 }
 
+// ErrInvalidTimeRange is returned for a time range name ParseTimeRange
+// does not know.
+var ErrInvalidTimeRange = errors.New("invalid time range")
+
+// ParseTimeRange returns the named range as of now: "all", "today",
+// "this-week", or "this-month". Days start at midnight in now's location,
+// so pass now in the user's time zone.
+func ParseTimeRange(name string, now time.Time) (TimeRange, error) {
+	switch name {
+	case "all":
+		return TimeRange{Start: time.Unix(0, 0).In(now.Location()), End: now}, nil
+	case "today":
+		return timeRangeToday(now), nil
+	case "this-week":
+		return timeRangeThisWeek(now), nil
+	case "this-month":
+		return timeRangeThisMonth(now), nil
+	default:
+		return TimeRange{}, fmt.Errorf("%w: %s (supported: all, today, this-week, this-month)", ErrInvalidTimeRange, name)
+	}
+}
+
 // NewTimeRangeToday creates a time range for today (midnight to now).
 func NewTimeRangeToday() TimeRange {
-	now := time.Now()
+	return timeRangeToday(time.Now())
+}
+
+func timeRangeToday(now time.Time) TimeRange {
 	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
 	return TimeRange{Start: start, End: now}
 }
 
 // NewTimeRangeThisWeek creates a time range for the current week (Monday to now).
 func NewTimeRangeThisWeek() TimeRange {
-	now := time.Now()
+	return timeRangeThisWeek(time.Now())
+}
+
+func timeRangeThisWeek(now time.Time) TimeRange {
 	// Find Monday of current week
 	weekday := int(now.Weekday())
 	if weekday == 0 { // Sunday
@@ -196,7 +262,10 @@ func NewTimeRangeThisWeek() TimeRange {
 
 // NewTimeRangeThisMonth creates a time range for the current month (1st to now).
 func NewTimeRangeThisMonth() TimeRange {
-	now := time.Now()
+	return timeRangeThisMonth(time.Now())
+}
+
+func timeRangeThisMonth(now time.Time) TimeRange {
 	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
 	return TimeRange{Start: start, End: now}
 }
@@ -606,3 +606,41 @@ func TestTimeRange_Helpers(t *testing.T) {
 		assert.WithinDuration(t, time.Now(), tr.End, time.Second)
 	})
 }
+
+// newYork loads a zone with daylight saving time; clocks sprang forward
+// on 2024-03-10 and fell back on 2024-11-03.
+func newYork(t *testing.T) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	return loc
+}
+
+func TestParseTimeRange(t *testing.T) {
+	ny := newYork(t)
+	// Tuesday after the spring-forward Sunday
+	now := time.Date(2024, 3, 12, 10, 0, 0, 0, ny)
+
+	today, err := ParseTimeRange("today", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 12, 0, 0, 0, 0, ny), today.Start)
+	assert.Equal(t, now, today.End)
+
+	week, err := ParseTimeRange("this-week", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 11, 0, 0, 0, 0, ny), week.Start)
+
+	month, err := ParseTimeRange("this-month", now)
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03-01T00:00:00-05:00", month.Start.Format(time.RFC3339), "the month began before the change")
+
+	all, err := ParseTimeRange("all", now)
+	require.NoError(t, err)
+	assert.True(t, all.Contains(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	_, err = ParseTimeRange("yesterday", now)
+	assert.ErrorContains(t, err, "invalid time range: yesterday")
+	assert.ErrorIs(t, err, ErrInvalidTimeRange)
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package stats
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+)
+
+// TrendThreshold is how far this week's hours must move from last week's,
+// as a fraction of last week, before a plan counts as accelerating or
+// slowing down.
+const TrendThreshold = 0.1
+
+// Trend is the direction of a plan's week-over-week velocity.
+type Trend string
+
+const (
+	// TrendUp means more time was spent this week than last.
+	TrendUp Trend = "accelerating"
+	// TrendDown means less time was spent this week than last.
+	TrendDown Trend = "slowing"
+	// TrendFlat means the time spent held within TrendThreshold.
+	TrendFlat Trend = "steady"
+)
+
+// Arrow returns the trend as ↑, ↓, or →.
+func (t Trend) Arrow() string {
+	switch t {
+	case TrendUp:
+		return "↑"
+	case TrendDown:
+		return "↓"
+	default:
+		return "→"
+	}
+}
+
+// Velocity compares the last seven days on a plan with the seven before.
+type Velocity struct {
+	ThisWeekHours  float64 `json:"this_week_hours"`  // Hours logged in the last 7 days
+	LastWeekHours  float64 `json:"last_week_hours"`  // Hours logged in the 7 days before that
+	ThisWeekChunks int     `json:"this_week_chunks"` // Chunks completed in the last 7 days
+	LastWeekChunks int     `json:"last_week_chunks"` // Chunks completed in the 7 days before that
+	Trend          Trend   `json:"trend"`
+}
+
+// PlanVelocity is the velocity of one plan.
+type PlanVelocity struct {
+	PlanID    string `json:"plan_id"`
+	PlanTitle string `json:"plan_title"`
+	Status    string `json:"status"`
+	Velocity
+}
+
+// CalculateVelocity compares the time logged on p over the seven days
+// before now with the seven days before that. Chunks carry no completion
+// time, so a completed chunk counts in the week of its last session.
+func CalculateVelocity(p *plan.Plan, sessions []session.Session, now time.Time) Velocity {
+	thisWeek := now.AddDate(0, 0, -7)
+	lastWeek := now.AddDate(0, 0, -14)
+
+	var v Velocity
+	lastSeen := make(map[string]time.Time)
+	for i := range sessions {
+		sess := &sessions[i]
+		if sess.PlanID != p.ID || sess.IsActive() || sess.StartTime.After(now) {
+			continue
+		}
+		hours := float64(sess.Duration) / 60
+		switch {
+		case !sess.StartTime.Before(thisWeek):
+			v.ThisWeekHours += hours
+		case !sess.StartTime.Before(lastWeek):
+			v.LastWeekHours += hours
+		}
+		if sess.ChunkID != "" && sess.StartTime.After(lastSeen[sess.ChunkID]) {
+			lastSeen[sess.ChunkID] = sess.StartTime
+		}
+	}
+
+	for _, chunk := range p.Chunks {
+		seen, ok := lastSeen[chunk.ID]
+		if chunk.Status != plan.StatusCompleted || !ok {
+			continue
+		}
+		switch {
+		case !seen.Before(thisWeek):
+			v.ThisWeekChunks++
+		case !seen.Before(lastWeek):
+			v.LastWeekChunks++
+		}
+	}
+
+	v.Trend = trendOf(v.ThisWeekHours, v.LastWeekHours)
+	return v
+}
+
+// trendOf compares this week's hours with last week's.
+func trendOf(thisWeek, lastWeek float64) Trend {
+	switch {
+	case thisWeek > lastWeek*(1+TrendThreshold):
+		return TrendUp
+	case thisWeek < lastWeek*(1-TrendThreshold):
+		return TrendDown
+	default:
+		return TrendFlat
+	}
+}
+
+// weekSummary describes one week, such as "3.5h, 2 chunks".
+func weekSummary(hours float64, chunks int) string {
+	if chunks == 1 {
+		return fmt.Sprintf("%.1fh, 1 chunk", hours)
+	}
+	return fmt.Sprintf("%.1fh, %d chunks", hours, chunks)
+}
+
+// ThisWeek describes the last seven days, such as "3.5h, 2 chunks".
+func (v Velocity) ThisWeek() string {
+	return weekSummary(v.ThisWeekHours, v.ThisWeekChunks)
+}
+
+// LastWeek describes the seven days before that.
+func (v Velocity) LastWeek() string {
+	return weekSummary(v.LastWeekHours, v.LastWeekChunks)
+}
+
+// GetTrends computes the velocity of every active plan, fastest this week
+// first. Plans are active until completed or archived.
+func (s *Service) GetTrends(ctx context.Context, now time.Time) ([]PlanVelocity, error) {
+	records, err := s.planService.List(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plans: %w", err)
+	}
+
+	sessions, err := s.sessionService.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	sessionValues := make([]session.Session, len(sessions))
+	for i := range sessions {
+		sessionValues[i] = *sessions[i]
+	}
+
+	trends := make([]PlanVelocity, 0, len(records))
+	for _, record := range records {
+		if record.Status == string(plan.StatusCompleted) || record.Status == string(plan.StatusArchived) {
+			continue
+		}
+		p, err := s.planService.Get(ctx, record.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load plan %s: %w", record.ID, err)
+		}
+		trends = append(trends, PlanVelocity{
+			PlanID:    p.ID,
+			PlanTitle: p.Title,
+			Status:    string(p.Status),
+			Velocity:  CalculateVelocity(p, sessionValues, now),
+		})
+	}
+
+	sort.SliceStable(trends, func(i, j int) bool {
+		if trends[i].ThisWeekHours != trends[j].ThisWeekHours {
+			return trends[i].ThisWeekHours > trends[j].ThisWeekHours
+		}
+		return trends[i].PlanID < trends[j].PlanID
+	})
+	return trends, nil
+}
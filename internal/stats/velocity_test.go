@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package stats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateVelocity(t *testing.T) {
+	now := time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)
+	p := newTestPlan("rust", "Rust", plan.StatusInProgress, []plan.Chunk{
+		{ID: "chunk-001", Status: plan.StatusCompleted},
+		{ID: "chunk-002", Status: plan.StatusCompleted},
+		{ID: "chunk-003", Status: plan.StatusInProgress},
+	})
+	sessions := []session.Session{
+		*chunkSession("s1", "chunk-001", now.AddDate(0, 0, -10), 60),
+		*chunkSession("s2", "chunk-002", now.AddDate(0, 0, -9), 30),
+		*chunkSession("s3", "chunk-002", now.AddDate(0, 0, -2), 90), // Finished this week
+		*chunkSession("s4", "chunk-003", now.AddDate(0, 0, -1), 90),
+		*chunkSession("s5", "", now.AddDate(0, 0, -30), 600), // Too old to count
+		*newTestSession("s6", "go", now.AddDate(0, 0, -1), 600),
+	}
+
+	v := CalculateVelocity(p, sessions, now)
+	assert.InDelta(t, 3.0, v.ThisWeekHours, 0.001)
+	assert.InDelta(t, 1.5, v.LastWeekHours, 0.001)
+	assert.Equal(t, 1, v.ThisWeekChunks, "a chunk counts in the week of its last session")
+	assert.Equal(t, 1, v.LastWeekChunks)
+	assert.Equal(t, TrendUp, v.Trend)
+	assert.Equal(t, "3.0h, 1 chunk", v.ThisWeek())
+}
+
+func TestTrendOf(t *testing.T) {
+	assert.Equal(t, TrendUp, trendOf(2, 1))
+	assert.Equal(t, TrendDown, trendOf(1, 2))
+	assert.Equal(t, TrendFlat, trendOf(2.1, 2), "small changes are steady")
+	assert.Equal(t, TrendFlat, trendOf(0, 0))
+	assert.Equal(t, TrendUp, trendOf(0.5, 0))
+
+	assert.Equal(t, "↑", TrendUp.Arrow())
+	assert.Equal(t, "↓", TrendDown.Arrow())
+	assert.Equal(t, "→", TrendFlat.Arrow())
+}
+
+func TestService_GetTrends(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	mockPlanService := new(MockPlanService)
+	mockSessionService := new(MockSessionService)
+	mockPlanService.On("List", ctx, (*storage.PlanFilter)(nil)).Return([]*storage.PlanRecord{
+		newTestPlanRecord("slow", "Slow", plan.StatusInProgress),
+		newTestPlanRecord("fast", "Fast", plan.StatusInProgress),
+		newTestPlanRecord("done", "Done", plan.StatusCompleted),
+	}, nil)
+	mockPlanService.On("Get", ctx, "slow").Return(newTestPlan("slow", "Slow", plan.StatusInProgress, nil), nil)
+	mockPlanService.On("Get", ctx, "fast").Return(newTestPlan("fast", "Fast", plan.StatusInProgress, nil), nil)
+	mockSessionService.On("ListAll", ctx).Return([]*session.Session{
+		newTestSession("s1", "slow", now.AddDate(0, 0, -10), 120),
+		newTestSession("s2", "fast", now.AddDate(0, 0, -1), 60),
+		newTestSession("s3", "done", now.AddDate(0, 0, -1), 600),
+	}, nil)
+
+	service := NewService(mockPlanService, mockSessionService)
+	trends, err := service.GetTrends(ctx, now)
+	require.NoError(t, err)
+
+	require.Len(t, trends, 2, "finished plans are left out")
+	assert.Equal(t, "fast", trends[0].PlanID)
+	assert.Equal(t, TrendUp, trends[0].Trend)
+	assert.Equal(t, "slow", trends[1].PlanID)
+	assert.Equal(t, TrendDown, trends[1].Trend)
+	mockPlanService.AssertNotCalled(t, "Get", ctx, "done")
+}
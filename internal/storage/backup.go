@@ -0,0 +1,202 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Database backups are named sessions-<timestamp>[-<reason>].db. Backups
+// with a reason were taken automatically and are pruned; the others were
+// asked for and are kept. Backups asked for are named by
+// export.backup_filename, whose default, sessions-<date>-<time>.db, is
+// listed with them.
+const (
+	backupPrefix       = "sessions-"
+	backupExt          = ".db"
+	backupTimeFormat   = "20060102-150405"
+	exportedTimeFormat = "2006-01-02-150405"
+)
+
+// BackupScheduled is the reason given to scheduled backups. Backups taken
+// before migrating are named for the schema version they precede.
+const BackupScheduled = "auto"
+
+// BackupPolicy controls the automatic backups taken before migrations and
+// on a schedule.
+type BackupPolicy struct {
+	Dir   string        // Where backups go; empty disables automatic backups
+	Keep  int           // Automatic backups to keep; 0 keeps them all
+	Every time.Duration // Back up once the newest backup is this old; 0 only before migrations
+}
+
+// Backup is a database backup file in a backup directory.
+type Backup struct {
+	Path   string    `json:"path"`
+	Time   time.Time `json:"time"`
+	Reason string    `json:"reason,omitempty"` // Empty for backups that were asked for
+	Bytes  int64     `json:"size_bytes"`
+}
+
+// BackupName returns the file name of a backup taken at t. An empty
+// reason names a backup that is never pruned.
+func BackupName(t time.Time, reason string) string {
+	name := backupPrefix + t.Format(backupTimeFormat)
+	if reason != "" {
+		name += "-" + reason
+	}
+	return name + backupExt
+}
+
+// parseBackupName parses a name made by BackupName, or by the default
+// export.backup_filename, whose "-2" for a taken name is no reason.
+func parseBackupName(name string) (time.Time, string, bool) {
+	if !strings.HasPrefix(name, backupPrefix) || !strings.HasSuffix(name, backupExt) {
+		return time.Time{}, "", false
+	}
+	stamp := strings.TrimSuffix(strings.TrimPrefix(name, backupPrefix), backupExt)
+	if len(stamp) >= len(exportedTimeFormat) {
+		if t, err := time.ParseInLocation(exportedTimeFormat, stamp[:len(exportedTimeFormat)], time.Local); err == nil {
+			return t, "", true
+		}
+	}
+	if len(stamp) < len(backupTimeFormat) {
+		return time.Time{}, "", false
+	}
+	t, err := time.ParseInLocation(backupTimeFormat, stamp[:len(backupTimeFormat)], time.Local)
+	if err != nil {
+		return time.Time{}, "", false
+	}
+	reason := strings.TrimPrefix(stamp[len(backupTimeFormat):], "-")
+	return t, reason, true
+}
+
+// Backup writes a consistent copy of the database to path, which must not
+// exist yet. The copy is compacted and includes changes still in the
+// write-ahead log.
+func (s *SQLiteDB) Backup(path string) error {
+	if _, err := os.Stat(path); err == nil {
+		return fmt.Errorf("backup %s already exists", path)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create backup directory: %w", err)
+	}
+	if _, err := s.db.Exec("VACUUM INTO ?", path); err != nil {
+		return fmt.Errorf("failed to back up database: %w", err)
+	}
+	if err := os.Chmod(path, 0o600); err != nil {
+		return fmt.Errorf("failed to restrict backup permissions: %w", err)
+	}
+	return nil
+}
+
+// BackupTo backs the database up into dir under BackupName(now, reason)
+// and returns the backup's path.
+func (s *SQLiteDB) BackupTo(dir, reason string, now time.Time) (string, error) {
+	path := filepath.Join(dir, BackupName(now, reason))
+	if err := s.Backup(path); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// Backups lists the database backups in dir, newest first. A missing
+// directory has none.
+func Backups(dir string) ([]Backup, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup directory: %w", err)
+	}
+
+	var backups []Backup
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		t, reason, ok := parseBackupName(entry.Name())
+		if !ok {
+			continue
+		}
+		backup := Backup{Path: filepath.Join(dir, entry.Name()), Time: t, Reason: reason}
+		if fi, err := entry.Info(); err == nil {
+			backup.Bytes = fi.Size()
+		}
+		backups = append(backups, backup)
+	}
+	sort.Slice(backups, func(i, j int) bool { return backups[i].Time.After(backups[j].Time) })
+	return backups, nil
+}
+
+// PruneBackups deletes all but the newest keep automatic backups in dir
+// and returns how many it deleted. Backups that were asked for are left
+// alone, as is everything when keep is 0.
+func PruneBackups(dir string, keep int) (int, error) {
+	if keep <= 0 {
+		return 0, nil
+	}
+	backups, err := Backups(dir)
+	if err != nil {
+		return 0, err
+	}
+
+	removed, automatic := 0, 0
+	for _, backup := range backups {
+		if backup.Reason == "" {
+			continue
+		}
+		automatic++
+		if automatic <= keep {
+			continue
+		}
+		if err := os.Remove(backup.Path); err != nil {
+			return removed, fmt.Errorf("failed to remove old backup: %w", err)
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// BackupDue reports whether policy schedules a backup at now: once the
+// newest backup in policy.Dir is policy.Every old, or when there is none.
+func BackupDue(policy BackupPolicy, now time.Time) (bool, error) {
+	if policy.Dir == "" || policy.Every <= 0 {
+		return false, nil
+	}
+	backups, err := Backups(policy.Dir)
+	if err != nil {
+		return false, err
+	}
+	return len(backups) == 0 || now.Sub(backups[0].Time) >= policy.Every, nil
+}
+
+// AutoBackup takes a scheduled backup if one is due (see BackupDue) and
+// prunes old ones. It returns the new backup's path, or "" when none was
+// due.
+func (s *SQLiteDB) AutoBackup(policy BackupPolicy, now time.Time) (string, error) {
+	due, err := BackupDue(policy, now)
+	if err != nil || !due {
+		return "", err
+	}
+	return s.backupAndPrune(policy, BackupScheduled, now)
+}
+
+// backupAndPrune takes an automatic backup and prunes old ones.
+func (s *SQLiteDB) backupAndPrune(policy BackupPolicy, reason string, now time.Time) (string, error) {
+	path, err := s.BackupTo(policy.Dir, reason, now)
+	if err != nil {
+		return "", err
+	}
+	if _, err := PruneBackups(policy.Dir, policy.Keep); err != nil {
+		return path, err
+	}
+	return path, nil
+}
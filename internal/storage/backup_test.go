@@ -0,0 +1,118 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLiteDB_Backup(t *testing.T) {
+	dir := t.TempDir()
+	db, err := NewSQLiteDB(filepath.Join(dir, "sessions.db"))
+	require.NoError(t, err)
+	defer db.Close()
+	_, err = db.Exec("CREATE TABLE notes (body TEXT)")
+	require.NoError(t, err)
+	_, err = db.Exec("INSERT INTO notes (body) VALUES ('hello')")
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, "backups", "copy.db")
+	require.NoError(t, db.Backup(path))
+
+	copied, err := OpenSQLiteReadOnly(path)
+	require.NoError(t, err)
+	defer copied.Close()
+	var body string
+	require.NoError(t, copied.QueryRow("SELECT body FROM notes").Scan(&body))
+	assert.Equal(t, "hello", body)
+
+	fi, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), fi.Mode().Perm())
+
+	assert.ErrorContains(t, db.Backup(path), "already exists")
+}
+
+func TestBackups_Prune(t *testing.T) {
+	dir := t.TempDir()
+	base := time.Date(2025, 10, 13, 9, 0, 0, 0, time.Local)
+	names := []string{
+		BackupName(base, ""),
+		BackupName(base.Add(time.Hour), BackupScheduled),
+		BackupName(base.Add(2*time.Hour), "pre-v017"),
+		BackupName(base.Add(3*time.Hour), BackupScheduled),
+		"samedi-2025-10-13.tar.gz",
+	}
+	for _, name := range names {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0o600))
+	}
+
+	backups, err := Backups(dir)
+	require.NoError(t, err)
+	require.Len(t, backups, 4, "other files are not database backups")
+	assert.True(t, backups[0].Time.Equal(base.Add(3*time.Hour)), "newest first")
+	assert.Equal(t, "pre-v017", backups[1].Reason)
+	assert.Empty(t, backups[3].Reason)
+
+	removed, err := PruneBackups(dir, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	assert.NoFileExists(t, filepath.Join(dir, names[1]))
+	assert.FileExists(t, filepath.Join(dir, names[0]), "backups that were asked for are kept")
+
+	missing, err := Backups(filepath.Join(dir, "missing"))
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+}
+
+func TestBackups_ExportedNames(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"sessions-2025-10-13-090000.db", "sessions-2025-10-13-090000-2.db"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0o600))
+	}
+
+	backups, err := Backups(dir)
+	require.NoError(t, err)
+	require.Len(t, backups, 2, "export.backup_filename's default names are backups")
+	for _, backup := range backups {
+		assert.True(t, backup.Time.Equal(time.Date(2025, 10, 13, 9, 0, 0, 0, time.Local)))
+		assert.Empty(t, backup.Reason, "a taken name's -2 is no reason to prune")
+	}
+}
+
+func TestSQLiteDB_AutoBackup(t *testing.T) {
+	dir := t.TempDir()
+	db, err := NewSQLiteDB(filepath.Join(dir, "sessions.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	policy := BackupPolicy{Dir: filepath.Join(dir, "backups"), Keep: 2, Every: 24 * time.Hour}
+	now := time.Date(2025, 10, 13, 9, 0, 0, 0, time.Local)
+
+	path, err := db.AutoBackup(policy, now)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(policy.Dir, "sessions-20251013-090000-auto.db"), path)
+
+	path, err = db.AutoBackup(policy, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, path, "not due yet")
+
+	for day := 1; day <= 3; day++ {
+		_, err = db.AutoBackup(policy, now.AddDate(0, 0, day))
+		require.NoError(t, err)
+	}
+	backups, err := Backups(policy.Dir)
+	require.NoError(t, err)
+	assert.Len(t, backups, 2, "pruned to policy.Keep")
+
+	path, err = db.AutoBackup(BackupPolicy{Dir: policy.Dir}, now.AddDate(1, 0, 0))
+	require.NoError(t, err)
+	assert.Empty(t, path, "no schedule")
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// SaltFileName is the file in the data directory holding the salt that
+// keys new encrypted files. It is not secret.
+const SaltFileName = ".encryption-salt"
+
+const (
+	saltSize = 16
+	keySize  = 32 // AES-256
+)
+
+// encryptedMagic starts every encrypted file, followed by the salt, the
+// nonce, and the AES-GCM sealed content.
+var encryptedMagic = []byte("SAMEDI-ENC1\n")
+
+// encryptedPrefix marks encrypted text stored in the database, such as
+// session notes: the same layout as a file, base64-encoded.
+const encryptedPrefix = "enc:v1:"
+
+// kdfIterations is the PBKDF2-SHA256 work factor. Tests lower it.
+var kdfIterations = 600_000
+
+// ErrNoPassphrase is returned when reading encrypted data without a cipher.
+var ErrNoPassphrase = errors.New("data is encrypted; set the passphrase environment variable to read it")
+
+// ErrWrongPassphrase is returned when encrypted data fails to authenticate,
+// because the passphrase differs from the one it was written with or the
+// data was altered.
+var ErrWrongPassphrase = errors.New("cannot decrypt: wrong passphrase or corrupted data")
+
+// Cipher encrypts plan files and session notes with AES-256-GCM, keyed
+// from a passphrase with PBKDF2. Each payload records its salt, so data
+// copied between directories still decrypts with the same passphrase.
+type Cipher struct {
+	passphrase string
+	saltPath   string
+
+	mu   sync.Mutex
+	salt []byte            // Salt for new payloads, loaded on first Encrypt
+	keys map[string][]byte // Derived keys by salt; derivation is slow on purpose
+}
+
+// NewCipher creates a cipher whose new payloads are salted from the salt
+// file in the data directory in paths. The file is created on the first
+// write, so a cipher that only reads leaves the directory untouched.
+func NewCipher(passphrase string, paths *Paths) (*Cipher, error) {
+	if passphrase == "" {
+		return nil, errors.New("encryption passphrase cannot be empty")
+	}
+
+	return &Cipher{
+		passphrase: passphrase,
+		saltPath:   filepath.Join(paths.BaseDir, SaltFileName),
+		keys:       make(map[string][]byte),
+	}, nil
+}
+
+// writeSalt returns the salt for new payloads, reading it from the salt
+// file or creating the file with a random one.
+func (c *Cipher) writeSalt() ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.salt != nil {
+		return c.salt, nil
+	}
+
+	salt, err := os.ReadFile(c.saltPath) // #nosec G304 - path is within the data directory
+	switch {
+	case err == nil:
+		if len(salt) != saltSize {
+			return nil, fmt.Errorf("invalid encryption salt in %s", c.saltPath)
+		}
+	case errors.Is(err, os.ErrNotExist):
+		salt = make([]byte, saltSize)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, fmt.Errorf("failed to generate encryption salt: %w", err)
+		}
+		if err := os.MkdirAll(filepath.Dir(c.saltPath), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create directory: %w", err)
+		}
+		if err := os.WriteFile(c.saltPath, salt, 0o600); err != nil {
+			return nil, fmt.Errorf("failed to write encryption salt: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("failed to read encryption salt: %w", err)
+	}
+
+	c.salt = salt
+	return salt, nil
+}
+
+// aead returns the AES-GCM cipher for salt, deriving its key once.
+func (c *Cipher) aead(salt []byte) (cipher.AEAD, error) {
+	c.mu.Lock()
+	key, ok := c.keys[string(salt)]
+	if !ok {
+		var err error
+		key, err = pbkdf2.Key(sha256.New, c.passphrase, salt, kdfIterations, keySize)
+		if err != nil {
+			c.mu.Unlock()
+			return nil, fmt.Errorf("failed to derive key: %w", err)
+		}
+		c.keys[string(salt)] = key
+	}
+	c.mu.Unlock()
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// Encrypt seals data, returning the header, salt, nonce, and ciphertext.
+func (c *Cipher) Encrypt(data []byte) ([]byte, error) {
+	salt, err := c.writeSalt()
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := c.aead(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	out := make([]byte, 0, len(encryptedMagic)+saltSize+len(nonce)+len(data)+gcm.Overhead())
+	out = append(out, encryptedMagic...)
+	out = append(out, salt...)
+	out = append(out, nonce...)
+	// The header is authenticated too, so it can't be swapped
+	return gcm.Seal(out, nonce, data, out), nil
+}
+
+// Decrypt opens data sealed by Encrypt.
+func (c *Cipher) Decrypt(data []byte) ([]byte, error) {
+	if !IsEncrypted(data) {
+		return nil, errors.New("data is not encrypted")
+	}
+	header := len(encryptedMagic) + saltSize
+	if len(data) < header {
+		return nil, ErrWrongPassphrase
+	}
+
+	gcm, err := c.aead(data[len(encryptedMagic):header])
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < header+gcm.NonceSize() {
+		return nil, ErrWrongPassphrase
+	}
+
+	nonce := data[header : header+gcm.NonceSize()]
+	plain, err := gcm.Open(nil, nonce, data[header+gcm.NonceSize():], data[:header+gcm.NonceSize()])
+	if err != nil {
+		return nil, ErrWrongPassphrase
+	}
+	return plain, nil
+}
+
+// EncryptString encrypts text for a database column. Empty text stays
+// empty, so "no notes" is still visible as such.
+func (c *Cipher) EncryptString(text string) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+	sealed, err := c.Encrypt([]byte(text))
+	if err != nil {
+		return "", err
+	}
+	return encryptedPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// DecryptString reverses EncryptString. Text that was never encrypted is
+// returned as is.
+func (c *Cipher) DecryptString(text string) (string, error) {
+	if !IsEncryptedString(text) {
+		return text, nil
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(text, encryptedPrefix))
+	if err != nil {
+		return "", ErrWrongPassphrase
+	}
+	plain, err := c.Decrypt(sealed)
+	if err != nil {
+		return "", err
+	}
+	return string(plain), nil
+}
+
+// IsEncrypted reports whether data was written by Cipher.Encrypt.
+func IsEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, encryptedMagic)
+}
+
+// IsEncryptedString reports whether text was written by Cipher.EncryptString.
+func IsEncryptedString(text string) bool {
+	return strings.HasPrefix(text, encryptedPrefix)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestCipher returns a cipher over dir with a cheap key derivation.
+func newTestCipher(t *testing.T, passphrase, dir string) *Cipher {
+	t.Helper()
+	original := kdfIterations
+	t.Cleanup(func() { kdfIterations = original })
+	kdfIterations = 1000
+
+	c, err := NewCipher(passphrase, &Paths{BaseDir: dir})
+	require.NoError(t, err)
+	return c
+}
+
+func TestCipher_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	c := newTestCipher(t, "hunter2", dir)
+	assert.NoFileExists(t, filepath.Join(dir, SaltFileName), "reading alone leaves the directory untouched")
+
+	sealed, err := c.Encrypt([]byte("# My journal"))
+	require.NoError(t, err)
+	assert.True(t, IsEncrypted(sealed))
+	assert.NotContains(t, string(sealed), "journal")
+	assert.FileExists(t, filepath.Join(dir, SaltFileName))
+
+	plain, err := c.Decrypt(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "# My journal", string(plain))
+
+	// Another cipher with the same passphrase reuses the salt file
+	again := newTestCipher(t, "hunter2", dir)
+	plain, err = again.Decrypt(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "# My journal", string(plain))
+}
+
+func TestCipher_WrongPassphraseOrTampering(t *testing.T) {
+	dir := t.TempDir()
+	sealed, err := newTestCipher(t, "hunter2", dir).Encrypt([]byte("secret"))
+	require.NoError(t, err)
+
+	_, err = newTestCipher(t, "hunter3", dir).Decrypt(sealed)
+	assert.ErrorIs(t, err, ErrWrongPassphrase)
+
+	sealed[len(sealed)-1] ^= 0xff
+	_, err = newTestCipher(t, "hunter2", dir).Decrypt(sealed)
+	assert.ErrorIs(t, err, ErrWrongPassphrase)
+
+	_, err = NewCipher("", &Paths{BaseDir: dir})
+	assert.Error(t, err)
+}
+
+func TestCipher_Strings(t *testing.T) {
+	c := newTestCipher(t, "hunter2", t.TempDir())
+
+	sealed, err := c.EncryptString("felt stuck on lifetimes")
+	require.NoError(t, err)
+	assert.True(t, IsEncryptedString(sealed))
+
+	plain, err := c.DecryptString(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "felt stuck on lifetimes", plain)
+
+	empty, err := c.EncryptString("")
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+
+	plain, err = c.DecryptString("written before encryption")
+	require.NoError(t, err)
+	assert.Equal(t, "written before encryption", plain)
+}
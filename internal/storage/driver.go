@@ -0,0 +1,82 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+	"github.com/pezware/samedi.dev/internal/log"
+)
+
+// driverName is the SQLite driver with each statement logged at debug
+// level, so --verbose and debug-level log files show what a command ran.
+// Failed statements are logged at warn level.
+const driverName = "samedi-sqlite3"
+
+// maxLoggedQuery caps how much of a statement is logged.
+const maxLoggedQuery = 200
+
+func init() {
+	sql.Register(driverName, loggingDriver{&sqlite3.SQLiteDriver{}})
+}
+
+type loggingDriver struct {
+	driver.Driver
+}
+
+func (d loggingDriver) Open(dsn string) (driver.Conn, error) {
+	conn, err := d.Driver.Open(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &loggingConn{conn.(*sqlite3.SQLiteConn)}, nil
+}
+
+// loggingConn logs the statements run on a SQLite connection. Statements
+// prepared explicitly run unlogged; samedi executes its SQL directly.
+type loggingConn struct {
+	*sqlite3.SQLiteConn
+}
+
+func (c *loggingConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	start := time.Now()
+	result, err := c.SQLiteConn.ExecContext(ctx, query, args)
+	logQuery("db exec", query, start, err)
+	return result, err
+}
+
+// QueryContext logs the time until the first row is ready.
+func (c *loggingConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	start := time.Now()
+	rows, err := c.SQLiteConn.QueryContext(ctx, query, args)
+	logQuery("db query", query, start, err)
+	return rows, err
+}
+
+// logQuery logs a statement with its duration and any error.
+func logQuery(msg, query string, start time.Time, err error) {
+	if err != nil {
+		log.Warn(msg+" failed", "sql", trimQuery(query), "error", err)
+		return
+	}
+	if log.Enabled(slog.LevelDebug) {
+		log.Debug(msg, "sql", trimQuery(query), "duration", time.Since(start).Round(time.Microsecond))
+	}
+}
+
+// trimQuery collapses a statement's whitespace onto one line and caps its
+// length.
+func trimQuery(query string) string {
+	query = strings.Join(strings.Fields(query), " ")
+	if len(query) > maxLoggedQuery {
+		query = query[:maxLoggedQuery] + "…"
+	}
+	return query
+}
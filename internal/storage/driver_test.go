@@ -0,0 +1,45 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoggingDriver(t *testing.T) {
+	var out bytes.Buffer
+	_, err := log.Setup(log.Options{Verbose: &out})
+	require.NoError(t, err)
+	t.Cleanup(func() { _, _ = log.Setup(log.Options{}) })
+
+	db, err := NewSQLiteDB(filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec("CREATE TABLE t (\n  id INTEGER\n)")
+	require.NoError(t, err)
+	var n int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM t").Scan(&n))
+	_, err = db.Exec("SELECT * FROM missing")
+	require.Error(t, err)
+
+	logged := out.String()
+	assert.Contains(t, logged, `msg="db exec" sql="CREATE TABLE t ( id INTEGER )"`)
+	assert.Contains(t, logged, `msg="db query" sql="SELECT COUNT(*) FROM t"`)
+	assert.Contains(t, logged, `level=WARN msg="db exec failed" sql="SELECT * FROM missing" error="no such table: missing"`)
+}
+
+func TestTrimQuery(t *testing.T) {
+	assert.Equal(t, "SELECT 1 FROM t", trimQuery("  SELECT 1\n\tFROM t "))
+
+	long := trimQuery("SELECT " + strings.Repeat("x", 500))
+	assert.Len(t, long, maxLoggedQuery+len("…"))
+}
@@ -6,17 +6,24 @@ package storage
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 )
 
-// FilesystemStorage handles file operations for plans and cards.
+// FilesystemStorage handles file operations for plans and cards. Writes
+// hold the data directory's cross-process lock, so `samedi ui` and CLI
+// commands running side by side never interleave them.
 type FilesystemStorage struct {
-	paths *Paths
+	paths  *Paths
+	lock   *FileLock
+	cipher *Cipher // Encrypts plan files and journal notes at rest when set
 }
 
 // NewFilesystemStorage creates a new filesystem storage instance.
 func NewFilesystemStorage(paths *Paths) *FilesystemStorage {
 	return &FilesystemStorage{
 		paths: paths,
+		lock:  lockFor(paths.LockPath()),
 	}
 }
 
@@ -25,29 +32,153 @@ func (fs *FilesystemStorage) Initialize() error {
 	return fs.paths.EnsureDirectories()
 }
 
-// ReadFile reads a file from the filesystem.
+// SetCipher turns on encryption at rest: plan files are encrypted as they
+// are written, and encrypted files are decrypted as they are read. Files
+// still in plaintext stay readable and are encrypted on their next write.
+func (fs *FilesystemStorage) SetCipher(c *Cipher) {
+	fs.cipher = c
+}
+
+// Cipher returns the cipher set by SetCipher, or nil.
+func (fs *FilesystemStorage) Cipher() *Cipher {
+	return fs.cipher
+}
+
+// ReadFile reads a file from the filesystem, decrypting it if needed.
 func (fs *FilesystemStorage) ReadFile(path string) ([]byte, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read file %s: %w", path, err)
 	}
-	return data, nil
+	if !IsEncrypted(data) {
+		return data, nil
+	}
+	if fs.cipher == nil {
+		return nil, fmt.Errorf("failed to read file %s: %w", path, ErrNoPassphrase)
+	}
+	plain, err := fs.cipher.Decrypt(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %s: %w", path, err)
+	}
+	return plain, nil
+}
+
+// encrypts reports whether files written to path are encrypted: plan
+// files, including archived ones, and journal notes once a cipher is set.
+func (fs *FilesystemStorage) encrypts(path string) bool {
+	if fs.cipher == nil {
+		return false
+	}
+	for _, dir := range []string{fs.paths.PlansDir, fs.paths.JournalDir()} {
+		if rel, err := filepath.Rel(dir, path); err == nil && !strings.HasPrefix(rel, "..") {
+			return true
+		}
+	}
+	return false
 }
 
-// WriteFile writes data to a file with secure permissions.
+// WriteFile writes data to a file with secure permissions, encrypting plan
+// files and journal notes when a cipher is set. The data goes to a
+// temporary file that is renamed into place, so readers never see a
+// partial write.
 func (fs *FilesystemStorage) WriteFile(path string, data []byte) error {
-	if err := os.WriteFile(path, data, 0o600); err != nil {
-		return fmt.Errorf("failed to write file %s: %w", path, err)
+	if fs.encrypts(path) {
+		sealed, err := fs.cipher.Encrypt(data)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt file %s: %w", path, err)
+		}
+		data = sealed
 	}
-	return nil
+
+	return fs.lock.WithLock(func() error {
+		tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+		if err != nil {
+			return fmt.Errorf("failed to write file %s: %w", path, err)
+		}
+		defer os.Remove(tmp.Name()) //nolint:errcheck // Gone after a successful rename
+
+		if _, err := tmp.Write(data); err != nil {
+			_ = tmp.Close()
+			return fmt.Errorf("failed to write file %s: %w", path, err)
+		}
+		if err := tmp.Close(); err != nil {
+			return fmt.Errorf("failed to write file %s: %w", path, err)
+		}
+		if err := os.Rename(tmp.Name(), path); err != nil {
+			return fmt.Errorf("failed to write file %s: %w", path, err)
+		}
+		return nil
+	})
+}
+
+// EditFile lets edit change the file at path in place, as an external
+// editor does. An encrypted file, or one that should be, is decrypted to
+// a private temporary file next to it for the edit and written back
+// encrypted, so plaintext lasts only as long as the edit.
+func (fs *FilesystemStorage) EditFile(path string, edit func(path string) error) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read file %s: %w", path, err)
+	}
+	if !IsEncrypted(data) && !fs.encrypts(path) {
+		return edit(path)
+	}
+
+	plain, err := fs.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	// Keep the extension so editors still recognize the file type
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))+".*"+filepath.Ext(path))
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck // Best-effort cleanup of the plaintext copy
+
+	if _, err := tmp.Write(plain); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write temporary file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	if err := edit(tmp.Name()); err != nil {
+		return err
+	}
+
+	edited, err := os.ReadFile(tmp.Name())
+	if err != nil {
+		return fmt.Errorf("failed to read edited file: %w", err)
+	}
+	if string(edited) == string(plain) && IsEncrypted(data) {
+		return nil // Unchanged
+	}
+	return fs.WriteFile(path, edited)
 }
 
 // DeleteFile removes a file from the filesystem.
 func (fs *FilesystemStorage) DeleteFile(path string) error {
-	if err := os.Remove(path); err != nil {
-		return fmt.Errorf("failed to delete file %s: %w", path, err)
-	}
-	return nil
+	return fs.lock.WithLock(func() error {
+		if err := os.Remove(path); err != nil {
+			return fmt.Errorf("failed to delete file %s: %w", path, err)
+		}
+		return nil
+	})
+}
+
+// Rename moves a file, creating the target directory if needed.
+func (fs *FilesystemStorage) Rename(from, to string) error {
+	return fs.lock.WithLock(func() error {
+		if err := os.MkdirAll(filepath.Dir(to), 0o755); err != nil {
+			return fmt.Errorf("failed to create directory: %w", err)
+		}
+		if err := os.Rename(from, to); err != nil {
+			return fmt.Errorf("failed to move %s to %s: %w", from, to, err)
+		}
+		return nil
+	})
 }
 
 // FileExists checks if a file exists.
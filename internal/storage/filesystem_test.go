@@ -4,6 +4,7 @@
 package storage
 
 import (
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -115,3 +116,123 @@ func TestFilesystemStorage_FileExists(t *testing.T) {
 	// Now it exists
 	assert.True(t, fs.FileExists(testPath))
 }
+
+func TestFilesystemStorage_WriteFileIsAtomic(t *testing.T) {
+	tmpDir := t.TempDir()
+	fs := NewFilesystemStorage(&Paths{BaseDir: tmpDir, PlansDir: filepath.Join(tmpDir, "plans")})
+
+	path := filepath.Join(tmpDir, "plan.md")
+	require.NoError(t, fs.WriteFile(path, []byte("first")))
+	require.NoError(t, fs.WriteFile(path, []byte("second")))
+
+	data, err := fs.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(data))
+
+	entries, err := os.ReadDir(tmpDir)
+	require.NoError(t, err)
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	assert.ElementsMatch(t, []string{"plan.md", LockFileName}, names, "no temporary files left behind")
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
+
+func TestFilesystemStorage_Rename(t *testing.T) {
+	tmpDir := t.TempDir()
+	fs := NewFilesystemStorage(&Paths{BaseDir: tmpDir})
+
+	from := filepath.Join(tmpDir, "plan.md")
+	to := filepath.Join(tmpDir, "archive", "plan.md")
+	require.NoError(t, fs.WriteFile(from, []byte("content")))
+	require.NoError(t, fs.Rename(from, to))
+
+	assert.NoFileExists(t, from)
+	assert.FileExists(t, to)
+}
+
+func TestFilesystemStorage_Cipher(t *testing.T) {
+	tmpDir := t.TempDir()
+	paths, err := NewPaths(tmpDir)
+	require.NoError(t, err)
+	fs := NewFilesystemStorage(paths)
+	require.NoError(t, fs.Initialize())
+
+	// Plaintext written before encryption stays readable
+	planPath := paths.PlanPath("rust")
+	require.NoError(t, fs.WriteFile(planPath, []byte("# Rust")))
+
+	fs.SetCipher(newTestCipher(t, "hunter2", tmpDir))
+	data, err := fs.ReadFile(planPath)
+	require.NoError(t, err)
+	assert.Equal(t, "# Rust", string(data))
+
+	// Plan files, archived ones included, and journal notes are encrypted
+	// on write
+	for _, path := range []string{planPath, paths.ArchivedPlanPath("go"), paths.JournalPath("2026-10-16")} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, fs.WriteFile(path, []byte("# Notes")))
+		raw, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.True(t, IsEncrypted(raw), path)
+
+		data, err := fs.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "# Notes", string(data))
+	}
+
+	// Templates are not
+	templatePath := paths.TemplatePath("plan-generation")
+	require.NoError(t, fs.WriteFile(templatePath, []byte("template")))
+	raw, err := os.ReadFile(templatePath)
+	require.NoError(t, err)
+	assert.Equal(t, "template", string(raw))
+
+	// Without the cipher encrypted files can't be read
+	_, err = NewFilesystemStorage(paths).ReadFile(planPath)
+	assert.ErrorIs(t, err, ErrNoPassphrase)
+}
+
+func TestFilesystemStorage_EditFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	paths, err := NewPaths(tmpDir)
+	require.NoError(t, err)
+	fs := NewFilesystemStorage(paths)
+	require.NoError(t, fs.Initialize())
+	fs.SetCipher(newTestCipher(t, "hunter2", tmpDir))
+
+	planPath := paths.PlanPath("rust")
+	require.NoError(t, fs.WriteFile(planPath, []byte("# Rust")))
+
+	var editedPath string
+	require.NoError(t, fs.EditFile(planPath, func(path string) error {
+		editedPath = path
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "# Rust", string(data), "the editor sees plaintext")
+		assert.Equal(t, ".md", filepath.Ext(path))
+		return os.WriteFile(path, []byte("# Rust, edited"), 0o600)
+	}))
+
+	assert.NotEqual(t, planPath, editedPath)
+	assert.NoFileExists(t, editedPath, "the plaintext copy is removed")
+	raw, err := os.ReadFile(planPath)
+	require.NoError(t, err)
+	assert.True(t, IsEncrypted(raw))
+	data, err := fs.ReadFile(planPath)
+	require.NoError(t, err)
+	assert.Equal(t, "# Rust, edited", string(data))
+
+	// Unencrypted storage edits the file itself
+	plain := NewFilesystemStorage(paths)
+	templatePath := paths.TemplatePath("notes")
+	require.NoError(t, plain.WriteFile(templatePath, []byte("x")))
+	require.NoError(t, plain.EditFile(templatePath, func(path string) error {
+		assert.Equal(t, templatePath, path)
+		return nil
+	}))
+}
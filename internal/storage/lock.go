@@ -0,0 +1,160 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrLocked is returned when another samedi process holds the write lock
+// for longer than LockTimeout.
+var ErrLocked = errors.New("another samedi process is writing; try again in a moment")
+
+// LockFileName is the lock file shared by every process using a data
+// directory.
+const LockFileName = "samedi.lock"
+
+// LockTimeout is how long a writer waits for the lock before giving up. It
+// matches the SQLite busy timeout.
+const LockTimeout = 5 * time.Second
+
+// lockRetryInterval is how often a waiting writer retries the lock.
+const lockRetryInterval = 50 * time.Millisecond
+
+// FileLock is an advisory cross-process lock on a lock file. Within one
+// process it is reentrant: nested Lock calls only count, so storage
+// operations can lock without knowing whether a caller already has.
+type FileLock struct {
+	path    string
+	timeout time.Duration
+
+	mu    sync.Mutex
+	file  *os.File
+	depth int
+}
+
+// NewFileLock returns a lock on the file at path. The file is created on
+// first use.
+func NewFileLock(path string) *FileLock {
+	return &FileLock{path: path, timeout: LockTimeout}
+}
+
+// Lock acquires the lock, waiting up to LockTimeout. It returns ErrLocked
+// if another process still holds it.
+func (l *FileLock) Lock() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.depth > 0 {
+		l.depth++
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create lock directory: %w", err)
+	}
+	file, err := os.OpenFile(l.path, os.O_CREATE|os.O_RDWR, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open lock file: %w", err)
+	}
+
+	deadline := time.Now().Add(l.timeout)
+	for {
+		locked, err := tryLockFile(file)
+		if err != nil {
+			_ = file.Close()
+			return fmt.Errorf("failed to lock %s: %w", l.path, err)
+		}
+		if locked {
+			break
+		}
+		if time.Now().After(deadline) {
+			_ = file.Close()
+			return ErrLocked
+		}
+		time.Sleep(lockRetryInterval)
+	}
+
+	l.file = file
+	l.depth = 1
+	return nil
+}
+
+// Unlock releases one Lock call; the file lock is dropped when the last
+// one is released.
+func (l *FileLock) Unlock() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.depth == 0 {
+		return nil
+	}
+	l.depth--
+	if l.depth > 0 {
+		return nil
+	}
+
+	file := l.file
+	l.file = nil
+	if err := unlockFile(file); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to unlock %s: %w", l.path, err)
+	}
+	return file.Close()
+}
+
+// WithLock runs fn while holding the lock.
+func (l *FileLock) WithLock(fn func() error) error {
+	if err := l.Lock(); err != nil {
+		return err
+	}
+	err := fn()
+	if unlockErr := l.Unlock(); err == nil {
+		err = unlockErr
+	}
+	return err
+}
+
+// locks holds one FileLock per lock file, so every storage object in a
+// process shares the same reentrant lock for a data directory.
+var (
+	locksMu sync.Mutex
+	locks   = make(map[string]*FileLock)
+)
+
+// lockFor returns the process-wide lock for the lock file at path.
+func lockFor(path string) *FileLock {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+
+	locksMu.Lock()
+	defer locksMu.Unlock()
+	lock, ok := locks[path]
+	if !ok {
+		lock = NewFileLock(path)
+		locks[path] = lock
+	}
+	return lock
+}
+
+// IsBusy reports whether err means another process was writing: either
+// ErrLocked or SQLite giving up after its busy timeout. SQLite errors are
+// matched by message so this builds without cgo.
+func IsBusy(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrLocked) {
+		return true
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "database is locked") || strings.Contains(msg, "database table is locked")
+}
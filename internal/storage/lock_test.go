@@ -0,0 +1,54 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileLock_Reentrant(t *testing.T) {
+	lock := NewFileLock(filepath.Join(t.TempDir(), LockFileName))
+
+	require.NoError(t, lock.Lock())
+	require.NoError(t, lock.Lock(), "nested lock only counts")
+	require.NoError(t, lock.Unlock())
+	assert.NotNil(t, lock.file, "still held after the inner unlock")
+	require.NoError(t, lock.Unlock())
+	assert.Nil(t, lock.file)
+	assert.NoError(t, lock.Unlock(), "extra unlock is a no-op")
+}
+
+func TestFileLock_Contended(t *testing.T) {
+	path := filepath.Join(t.TempDir(), LockFileName)
+
+	// Separate FileLocks open separate descriptors, standing in for two
+	// processes
+	holder := NewFileLock(path)
+	waiter := NewFileLock(path)
+	waiter.timeout = 100 * time.Millisecond
+
+	require.NoError(t, holder.Lock())
+	err := waiter.Lock()
+	assert.ErrorIs(t, err, ErrLocked)
+	assert.True(t, IsBusy(err))
+
+	require.NoError(t, holder.Unlock())
+	require.NoError(t, waiter.Lock())
+	require.NoError(t, waiter.Unlock())
+}
+
+func TestIsBusy(t *testing.T) {
+	assert.True(t, IsBusy(fmt.Errorf("failed to save: %w", ErrLocked)))
+	assert.True(t, IsBusy(fmt.Errorf("failed to save: %w", errors.New("database is locked"))))
+	assert.False(t, IsBusy(fmt.Errorf("failed to save: %w", os.ErrNotExist)))
+	assert.False(t, IsBusy(nil))
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"fmt"
+	"strings"
+)
+
+// minVacuumBytes keeps automatic vacuums from churning small databases,
+// where a high free-space ratio is only a few pages.
+const minVacuumBytes = 1 << 20
+
+// TableStats is the row count of one table.
+type TableStats struct {
+	Name string `json:"name"`
+	Rows int64  `json:"rows"`
+}
+
+// DBStats describes how a SQLite database file is used.
+type DBStats struct {
+	PageSize  int64        `json:"page_size"`
+	PageCount int64        `json:"page_count"`
+	FreePages int64        `json:"free_pages"` // Pages left unused by deletions; VACUUM reclaims them
+	Tables    []TableStats `json:"tables"`
+}
+
+// SizeBytes returns the size of the main database file.
+func (d *DBStats) SizeBytes() int64 {
+	return d.PageSize * d.PageCount
+}
+
+// FreeBytes returns the space VACUUM would reclaim.
+func (d *DBStats) FreeBytes() int64 {
+	return d.PageSize * d.FreePages
+}
+
+// FreePercent returns free space as a percentage of the file.
+func (d *DBStats) FreePercent() int {
+	if d.PageCount == 0 {
+		return 0
+	}
+	return int(d.FreePages * 100 / d.PageCount)
+}
+
+// NeedsVacuum reports whether free space has reached thresholdPercent.
+// A threshold of 0 disables automatic vacuuming, and files under 1 MiB
+// are never worth it.
+func (d *DBStats) NeedsVacuum(thresholdPercent int) bool {
+	if thresholdPercent <= 0 || d.SizeBytes() < minVacuumBytes {
+		return false
+	}
+	return d.FreePercent() >= thresholdPercent
+}
+
+// Stats reports page usage and row counts for every table.
+func (s *SQLiteDB) Stats() (*DBStats, error) {
+	stats := &DBStats{}
+
+	pragmas := []struct {
+		name  string
+		value *int64
+	}{
+		{"page_size", &stats.PageSize},
+		{"page_count", &stats.PageCount},
+		{"freelist_count", &stats.FreePages},
+	}
+	for _, p := range pragmas {
+		if err := s.db.QueryRow("PRAGMA " + p.name).Scan(p.value); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", p.name, err)
+		}
+	}
+
+	rows, err := s.db.Query(`
+		SELECT name FROM sqlite_master
+		WHERE type = 'table' AND name NOT LIKE 'sqlite_%'
+		ORDER BY name
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tables: %w", err)
+	}
+	names := make([]string, 0)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan table name: %w", err)
+		}
+		names = append(names, name)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tables: %w", err)
+	}
+
+	stats.Tables = make([]TableStats, 0, len(names))
+	for _, name := range names {
+		table := TableStats{Name: name}
+		quoted := `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+		if err := s.db.QueryRow("SELECT COUNT(*) FROM " + quoted).Scan(&table.Rows); err != nil {
+			return nil, fmt.Errorf("failed to count rows in %s: %w", name, err)
+		}
+		stats.Tables = append(stats.Tables, table)
+	}
+
+	return stats, nil
+}
+
+// Vacuum rebuilds the database file to reclaim free pages, then truncates
+// the write-ahead log so the space is returned to the filesystem.
+func (s *SQLiteDB) Vacuum() error {
+	if _, err := s.db.Exec("VACUUM"); err != nil {
+		return fmt.Errorf("failed to vacuum database: %w", err)
+	}
+	if _, err := s.db.Exec("PRAGMA wal_checkpoint(TRUNCATE)"); err != nil {
+		return fmt.Errorf("failed to checkpoint WAL: %w", err)
+	}
+	return nil
+}
+
+// IntegrityCheck runs SQLite's integrity check and returns the problems it
+// finds; none means the file is sound.
+func (s *SQLiteDB) IntegrityCheck() ([]string, error) {
+	rows, err := s.db.Query("PRAGMA integrity_check")
+	if err != nil {
+		return nil, fmt.Errorf("failed to check integrity: %w", err)
+	}
+	defer rows.Close()
+
+	var problems []string
+	for rows.Next() {
+		var message string
+		if err := rows.Scan(&message); err != nil {
+			return nil, fmt.Errorf("failed to read integrity check: %w", err)
+		}
+		if message != "ok" {
+			problems = append(problems, message)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to check integrity: %w", err)
+	}
+	return problems, nil
+}
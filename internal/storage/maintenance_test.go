@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLiteDB_Stats(t *testing.T) {
+	db, err := NewSQLiteDB(filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, NewMigrator(db).Migrate())
+
+	_, err = db.Exec(`INSERT INTO jobs (id, type, status, payload, run_at, created_at, updated_at)
+		VALUES ('j1', 'test', 'pending', '{}', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)`)
+	require.NoError(t, err)
+
+	stats, err := db.Stats()
+	require.NoError(t, err)
+
+	assert.Positive(t, stats.PageSize)
+	assert.Positive(t, stats.PageCount)
+	assert.Equal(t, stats.PageSize*stats.PageCount, stats.SizeBytes())
+
+	rows := make(map[string]int64)
+	for _, table := range stats.Tables {
+		rows[table.Name] = table.Rows
+		assert.False(t, strings.HasPrefix(table.Name, "sqlite_"), "internal tables are skipped")
+	}
+	assert.Equal(t, int64(1), rows["jobs"])
+	assert.Contains(t, rows, "sessions")
+}
+
+func TestSQLiteDB_Vacuum(t *testing.T) {
+	db, err := NewSQLiteDB(filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec("CREATE TABLE blobs (data TEXT)")
+	require.NoError(t, err)
+	for i := 0; i < 200; i++ {
+		_, err = db.Exec("INSERT INTO blobs (data) VALUES (?)", strings.Repeat("x", 4096))
+		require.NoError(t, err)
+	}
+	_, err = db.Exec("DELETE FROM blobs")
+	require.NoError(t, err)
+
+	before, err := db.Stats()
+	require.NoError(t, err)
+	require.Positive(t, before.FreePages)
+
+	require.NoError(t, db.Vacuum())
+
+	after, err := db.Stats()
+	require.NoError(t, err)
+	assert.Zero(t, after.FreePages)
+	assert.Less(t, after.SizeBytes(), before.SizeBytes())
+}
+
+func TestSQLiteDB_IntegrityCheck(t *testing.T) {
+	db, err := NewSQLiteDB(filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, NewMigrator(db).Migrate())
+
+	problems, err := db.IntegrityCheck()
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+}
+
+func TestDBStats_NeedsVacuum(t *testing.T) {
+	large := &DBStats{PageSize: 4096, PageCount: 1000, FreePages: 300} // ~4 MB, 30% free
+	assert.Equal(t, 30, large.FreePercent())
+	assert.True(t, large.NeedsVacuum(25))
+	assert.False(t, large.NeedsVacuum(50))
+	assert.False(t, large.NeedsVacuum(0), "zero disables")
+
+	small := &DBStats{PageSize: 4096, PageCount: 10, FreePages: 9}
+	assert.False(t, small.NeedsVacuum(25), "small files are never vacuumed automatically")
+
+	assert.Zero(t, (&DBStats{}).FreePercent())
+}
@@ -0,0 +1,3 @@
+-- Reverts 002_jobs.sql
+
+DROP TABLE IF EXISTS jobs;
@@ -0,0 +1,4 @@
+-- Reverts 003_plan_next_chunk.sql
+
+ALTER TABLE plans DROP COLUMN next_chunk_title;
+ALTER TABLE plans DROP COLUMN next_chunk_id;
@@ -0,0 +1,5 @@
+-- Denormalized next chunk for plan listings
+-- Populated from markdown on plan save; run `samedi plan reindex` to backfill
+
+ALTER TABLE plans ADD COLUMN next_chunk_id TEXT;
+ALTER TABLE plans ADD COLUMN next_chunk_title TEXT;
@@ -0,0 +1,3 @@
+-- Reverts 004_llm_usage.sql
+
+DROP TABLE IF EXISTS llm_usage;
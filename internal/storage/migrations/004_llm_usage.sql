@@ -0,0 +1,18 @@
+-- LLM cost ledger
+-- One row per successful LLM call; shown by `samedi stats --llm`
+
+CREATE TABLE IF NOT EXISTS llm_usage (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    operation TEXT NOT NULL, -- e.g. plan.generate, plan.regenerate, cards.generate
+    provider TEXT NOT NULL,
+    model TEXT,
+    plan_id TEXT,
+    input_tokens INTEGER NOT NULL DEFAULT 0,
+    output_tokens INTEGER NOT NULL DEFAULT 0,
+    estimated BOOLEAN NOT NULL DEFAULT 0, -- counts approximated from text length
+    cost_usd REAL NOT NULL DEFAULT 0,
+    priced BOOLEAN NOT NULL DEFAULT 1, -- false when the model's price is unknown
+    created_at DATETIME NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_llm_usage_created_at ON llm_usage(created_at);
@@ -0,0 +1,4 @@
+-- Reverts 005_week_commitments.sql
+
+DROP TABLE IF EXISTS week_commitment_items;
+DROP TABLE IF EXISTS week_commitments;
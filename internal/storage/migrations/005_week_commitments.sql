@@ -0,0 +1,21 @@
+-- Weekly commitments from `samedi plan week`
+-- One commitment per week, keyed by the local date of the week's first day
+
+CREATE TABLE IF NOT EXISTS week_commitments (
+    week_start TEXT PRIMARY KEY, -- YYYY-MM-DD
+    goals TEXT,
+    available_minutes INTEGER NOT NULL,
+    llm_assisted BOOLEAN NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS week_commitment_items (
+    week_start TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    plan_id TEXT NOT NULL,
+    chunk_id TEXT NOT NULL,
+    title TEXT,
+    minutes INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (week_start, position),
+    FOREIGN KEY (week_start) REFERENCES week_commitments(week_start) ON DELETE CASCADE
+);
@@ -0,0 +1,4 @@
+-- Reverts 006_quiz_attempts.sql
+
+DROP TABLE IF EXISTS quiz_answers;
+DROP TABLE IF EXISTS quiz_attempts;
@@ -0,0 +1,23 @@
+-- Quiz attempts from `samedi quiz`
+-- One row per graded quiz, plus one row per answered question
+
+CREATE TABLE IF NOT EXISTS quiz_attempts (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    plan_id TEXT NOT NULL,
+    chunk_id TEXT NOT NULL,
+    score INTEGER NOT NULL, -- percent, 0-100
+    taken_at DATETIME NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_quiz_attempts_plan ON quiz_attempts(plan_id, chunk_id);
+
+CREATE TABLE IF NOT EXISTS quiz_answers (
+    attempt_id INTEGER NOT NULL,
+    position INTEGER NOT NULL,
+    question TEXT NOT NULL,
+    answer TEXT,
+    points INTEGER NOT NULL, -- 0-10
+    feedback TEXT,
+    PRIMARY KEY (attempt_id, position),
+    FOREIGN KEY (attempt_id) REFERENCES quiz_attempts(id) ON DELETE CASCADE
+);
@@ -0,0 +1,5 @@
+-- Reverts 007_plan_provenance.sql
+
+ALTER TABLE plans DROP COLUMN template_version;
+ALTER TABLE plans DROP COLUMN generated_model;
+ALTER TABLE plans DROP COLUMN generated_provider;
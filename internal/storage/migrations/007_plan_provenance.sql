@@ -0,0 +1,6 @@
+-- Provenance of generated plans: which provider, model and prompt template
+-- version produced them. NULL for hand-written or scaffolded plans.
+
+ALTER TABLE plans ADD COLUMN generated_provider TEXT;
+ALTER TABLE plans ADD COLUMN generated_model TEXT;
+ALTER TABLE plans ADD COLUMN template_version TEXT;
@@ -0,0 +1,3 @@
+-- Reverts 008_plan_versions.sql
+
+DROP TABLE IF EXISTS plan_versions;
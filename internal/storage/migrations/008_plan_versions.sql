@@ -0,0 +1,10 @@
+-- Plan versions: a snapshot of the plan markdown each time it is saved,
+-- for `samedi plan history` and `samedi plan diff`
+
+CREATE TABLE IF NOT EXISTS plan_versions (
+    plan_id TEXT NOT NULL,
+    version INTEGER NOT NULL, -- 1-based, per plan
+    content TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    PRIMARY KEY (plan_id, version)
+);
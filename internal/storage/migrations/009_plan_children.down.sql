@@ -0,0 +1,3 @@
+-- Reverts 009_plan_children.sql
+
+ALTER TABLE plans DROP COLUMN children;
@@ -0,0 +1,4 @@
+-- Sub-plans: the child plan IDs listed in a plan's `children` frontmatter,
+-- as a JSON array like tags. Run `samedi plan reindex` to backfill.
+
+ALTER TABLE plans ADD COLUMN children TEXT;
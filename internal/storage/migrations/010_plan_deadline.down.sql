@@ -0,0 +1,3 @@
+-- Reverts 010_plan_deadline.sql
+
+ALTER TABLE plans DROP COLUMN deadline;
@@ -0,0 +1,5 @@
+-- Plan deadlines: the optional `deadline` frontmatter date as YYYY-MM-DD,
+-- so `plan list --due-soon` can filter and sort in SQL. Run
+-- `samedi plan reindex` to backfill.
+
+ALTER TABLE plans ADD COLUMN deadline TEXT;
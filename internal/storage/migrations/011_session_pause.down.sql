@@ -0,0 +1,5 @@
+-- Reverts 011_session_pause.sql
+-- A paused session loses its pause and counts the paused time as studied.
+
+ALTER TABLE sessions DROP COLUMN paused_seconds;
+ALTER TABLE sessions DROP COLUMN paused_at;
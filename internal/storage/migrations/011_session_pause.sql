@@ -0,0 +1,5 @@
+-- Session pauses: when the active session was paused, and the seconds
+-- spent paused so far, which are left out of duration_minutes.
+
+ALTER TABLE sessions ADD COLUMN paused_at DATETIME;
+ALTER TABLE sessions ADD COLUMN paused_seconds INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+-- Reverts 012_card_reviews.sql
+-- Card schedules in `cards` are kept; only the review history goes.
+
+DROP TABLE IF EXISTS card_reviews;
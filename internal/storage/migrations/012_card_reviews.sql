@@ -0,0 +1,12 @@
+-- Flashcard reviews from the dashboard's Review module
+-- One row per graded card; the card's schedule lives in `cards`
+
+CREATE TABLE IF NOT EXISTS card_reviews (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    card_id TEXT NOT NULL,
+    plan_id TEXT NOT NULL,
+    rating INTEGER NOT NULL CHECK(rating BETWEEN 1 AND 4),
+    reviewed_at DATETIME NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_card_reviews_time ON card_reviews(reviewed_at);
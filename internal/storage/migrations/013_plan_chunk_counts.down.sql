@@ -0,0 +1,4 @@
+-- Reverts 013_plan_chunk_counts.sql
+
+ALTER TABLE plans DROP COLUMN chunks_completed;
+ALTER TABLE plans DROP COLUMN chunks_total;
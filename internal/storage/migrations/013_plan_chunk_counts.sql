@@ -0,0 +1,6 @@
+-- Denormalized chunk counts, so plan listings show progress without
+-- parsing every markdown file. Populated on plan save; NULL until then.
+-- Run `samedi plan reindex` to backfill.
+
+ALTER TABLE plans ADD COLUMN chunks_total INTEGER;
+ALTER TABLE plans ADD COLUMN chunks_completed INTEGER;
@@ -0,0 +1,3 @@
+-- Reverts 014_chunks.sql
+
+DROP TABLE IF EXISTS chunks;
@@ -0,0 +1,15 @@
+-- Chunks indexed from plan markdown, so chunks can be queried across
+-- plans without parsing every file. A plan's rows are replaced whenever
+-- it is saved; run `samedi plan reindex` to backfill.
+
+CREATE TABLE IF NOT EXISTS chunks (
+    plan_id TEXT NOT NULL,
+    id TEXT NOT NULL,
+    position INTEGER NOT NULL,       -- 0-based order within the plan
+    title TEXT NOT NULL,
+    duration_minutes INTEGER NOT NULL DEFAULT 0,
+    status TEXT NOT NULL,
+    PRIMARY KEY (plan_id, id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_chunks_status ON chunks(status);
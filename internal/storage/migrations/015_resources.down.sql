@@ -0,0 +1,4 @@
+-- Reverts 015_resources.sql
+-- Done state also lives in the plan markdown, so nothing is lost.
+
+DROP TABLE IF EXISTS resources;
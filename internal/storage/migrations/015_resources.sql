@@ -0,0 +1,15 @@
+-- Reading list: each plan's chunk resources, merged across chunks, with
+-- their done state. Indexed from the markdown checkboxes whenever a plan
+-- is saved, so the state travels with the plan file; run
+-- `samedi plan reindex` to backfill.
+
+CREATE TABLE IF NOT EXISTS resources (
+    plan_id TEXT NOT NULL,
+    key TEXT NOT NULL,               -- URL, or normalized text without one
+    position INTEGER NOT NULL,       -- 0-based order of first appearance
+    text TEXT NOT NULL,
+    url TEXT NOT NULL DEFAULT '',
+    chunk_ids TEXT NOT NULL,         -- Comma-separated chunks listing it
+    done INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (plan_id, key)
+);
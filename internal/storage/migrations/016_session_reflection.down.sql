@@ -0,0 +1,5 @@
+-- Reverts 016_session_reflection.sql
+-- Session reflections are lost; restore the pre-rollback backup to get
+-- them back.
+
+ALTER TABLE sessions DROP COLUMN reflection;
@@ -0,0 +1,4 @@
+-- Session reflections: answers to the questions asked at `samedi stop`,
+-- stored as a JSON object (encrypted like notes when encryption is on).
+
+ALTER TABLE sessions ADD COLUMN reflection TEXT;
@@ -0,0 +1,5 @@
+-- Reverts 017_job_leases.sql
+-- Running jobs lose their owner; the next worker to start requeues them.
+
+ALTER TABLE jobs DROP COLUMN lease_until;
+ALTER TABLE jobs DROP COLUMN owner;
@@ -0,0 +1,6 @@
+-- Job leases: the worker running a job and until when it holds the job.
+-- Workers renew the lease while the handler runs; only jobs whose lease
+-- ran out are requeued, so a second worker never takes over a live one.
+
+ALTER TABLE jobs ADD COLUMN owner TEXT;
+ALTER TABLE jobs ADD COLUMN lease_until DATETIME;
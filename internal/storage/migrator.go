@@ -5,25 +5,36 @@ package storage
 
 import (
 	"embed"
+	"errors"
 	"fmt"
+	"io/fs"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 //go:embed migrations/*.sql
 var migrationsFS embed.FS
 
-// Migration represents a database migration.
+// downSuffix ends the file that rolls back the migration of the same name.
+const downSuffix = ".down.sql"
+
+// Migration represents a database migration. Down undoes SQL; it is
+// read from NNN_name.down.sql and empty when the migration can't be
+// rolled back.
 type Migration struct {
 	Version int
 	Name    string
 	SQL     string
+	Down    string
 }
 
 // Migrator handles database schema migrations.
 type Migrator struct {
-	db *SQLiteDB
+	db     *SQLiteDB
+	backup BackupPolicy
 }
 
 // NewMigrator creates a new migrator instance.
@@ -31,13 +42,98 @@ func NewMigrator(db *SQLiteDB) *Migrator {
 	return &Migrator{db: db}
 }
 
-// Migrate runs all pending migrations.
+// SetBackup has Migrate back up an existing database into policy.Dir
+// before applying migrations to it, pruning to policy.Keep backups.
+func (m *Migrator) SetBackup(policy BackupPolicy) {
+	m.backup = policy
+}
+
+// Migrate runs all pending migrations. Most runs find the schema already
+// current, which schema_migrations answers without the write lock; only
+// an outdated schema takes the lock, so two processes starting at once do
+// not both apply the same migration.
 func (m *Migrator) Migrate() error {
+	currentVersion, err := m.getCurrentVersion()
+	if err != nil {
+		return fmt.Errorf("failed to get current version: %w", err)
+	}
+	latest, err := latestVersion()
+	if err != nil {
+		return fmt.Errorf("failed to load migrations: %w", err)
+	}
+	if currentVersion >= latest {
+		return nil
+	}
+	return m.db.WithLock(m.migrate)
+}
+
+// latestVersion returns the newest embedded migration version, read from
+// the file names once per process.
+var latestVersion = sync.OnceValues(func() (int, error) {
+	entries, err := migrationsFS.ReadDir("migrations")
+	if err != nil {
+		return 0, fmt.Errorf("failed to read migrations directory: %w", err)
+	}
+	latest := 0
+	for _, entry := range entries {
+		if version, ok := migrationVersion(entry.Name()); ok && version > latest {
+			latest = version
+		}
+	}
+	return latest, nil
+})
+
+// migrationVersion parses the version of a migration file such as
+// "001_initial_schema.sql". Down migrations are not counted.
+func migrationVersion(name string) (int, bool) {
+	if !strings.HasSuffix(name, ".sql") || strings.HasSuffix(name, downSuffix) {
+		return 0, false
+	}
+	prefix, _, ok := strings.Cut(name, "_")
+	if !ok {
+		return 0, false
+	}
+	version, err := strconv.Atoi(prefix)
+	if err != nil {
+		return 0, false
+	}
+	return version, true
+}
+
+// MigrateTo moves the schema to version, applying migrations up to it or
+// rolling back the ones after it. Either way an existing database is
+// backed up first when SetBackup gave a directory. Rolling back fails
+// before changing anything if a migration in the way can't be undone.
+func (m *Migrator) MigrateTo(version int) error {
+	latest, err := latestVersion()
+	if err != nil {
+		return fmt.Errorf("failed to load migrations: %w", err)
+	}
+	if version < 1 || version > latest {
+		return fmt.Errorf("no schema version %d (versions run from 1 to %d)", version, latest)
+	}
+	return m.db.WithLock(func() error { return m.migrateTo(version) })
+}
+
+// migrate applies pending migrations; the caller holds the write lock.
+func (m *Migrator) migrate() error {
+	latest, err := latestVersion()
+	if err != nil {
+		return fmt.Errorf("failed to load migrations: %w", err)
+	}
+	return m.migrateTo(latest)
+}
+
+// migrateTo moves the schema to target; the caller holds the write lock.
+func (m *Migrator) migrateTo(target int) error {
 	// Get current schema version
 	currentVersion, err := m.getCurrentVersion()
 	if err != nil {
 		return fmt.Errorf("failed to get current version: %w", err)
 	}
+	if currentVersion == target {
+		return nil
+	}
 
 	// Load all migrations
 	migrations, err := m.loadMigrations()
@@ -45,9 +141,39 @@ func (m *Migrator) Migrate() error {
 		return fmt.Errorf("failed to load migrations: %w", err)
 	}
 
+	rollback := target < currentVersion
+	if rollback {
+		for _, migration := range migrations {
+			if migration.Version > target && migration.Version <= currentVersion && migration.Down == "" {
+				return fmt.Errorf("migration %d (%s) can't be rolled back", migration.Version, migration.Name)
+			}
+		}
+	}
+
+	// Keep a copy of the data in case a migration fails partway or
+	// loses something; a new database has nothing to lose
+	if currentVersion > 0 && m.backup.Dir != "" {
+		reason := fmt.Sprintf("pre-v%03d", target)
+		if _, err := m.db.backupAndPrune(m.backup, reason, time.Now()); err != nil {
+			return fmt.Errorf("failed to back up database before migrating: %w", err)
+		}
+	}
+
+	if rollback {
+		for i := len(migrations) - 1; i >= 0; i-- {
+			migration := migrations[i]
+			if migration.Version > target && migration.Version <= currentVersion {
+				if err := m.revertMigration(migration); err != nil {
+					return fmt.Errorf("failed to roll back migration %d: %w", migration.Version, err)
+				}
+			}
+		}
+		return nil
+	}
+
 	// Apply pending migrations
 	for _, migration := range migrations {
-		if migration.Version > currentVersion {
+		if migration.Version > currentVersion && migration.Version <= target {
 			if err := m.applyMigration(migration); err != nil {
 				return fmt.Errorf("failed to apply migration %d: %w", migration.Version, err)
 			}
@@ -57,6 +183,38 @@ func (m *Migrator) Migrate() error {
 	return nil
 }
 
+// Pending returns the number of migrations not yet applied. It only reads
+// the schema, so it is safe on read-only databases.
+func (m *Migrator) Pending() (int, error) {
+	currentVersion, err := m.getCurrentVersion()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get current version: %w", err)
+	}
+
+	migrations, err := m.loadMigrations()
+	if err != nil {
+		return 0, fmt.Errorf("failed to load migrations: %w", err)
+	}
+
+	pending := 0
+	for _, migration := range migrations {
+		if migration.Version > currentVersion {
+			pending++
+		}
+	}
+	return pending, nil
+}
+
+// Version returns the schema version the database is at, 0 for a new one.
+func (m *Migrator) Version() (int, error) {
+	return m.getCurrentVersion()
+}
+
+// LatestVersion returns the schema version Migrate brings databases to.
+func LatestVersion() (int, error) {
+	return latestVersion()
+}
+
 // getCurrentVersion returns the current schema version.
 func (m *Migrator) getCurrentVersion() (int, error) {
 	// Check if schema_migrations table exists
@@ -96,20 +254,14 @@ func (m *Migrator) loadMigrations() ([]Migration, error) {
 
 	migrations := make([]Migration, 0, len(entries))
 	for _, entry := range entries {
-		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".sql") {
+		if entry.IsDir() {
 			continue
 		}
-
-		// Parse version from filename (e.g., "001_initial_schema.sql")
-		parts := strings.SplitN(entry.Name(), "_", 2)
-		if len(parts) != 2 {
-			continue
-		}
-
-		version, err := strconv.Atoi(parts[0])
-		if err != nil {
+		version, ok := migrationVersion(entry.Name())
+		if !ok {
 			continue
 		}
+		_, name, _ := strings.Cut(entry.Name(), "_")
 
 		// Read migration SQL
 		sql, err := migrationsFS.ReadFile(fmt.Sprintf("migrations/%s", entry.Name()))
@@ -117,10 +269,17 @@ func (m *Migrator) loadMigrations() ([]Migration, error) {
 			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
 		}
 
+		// Read the rollback, if there is one
+		down, err := migrationsFS.ReadFile(fmt.Sprintf("migrations/%s", strings.TrimSuffix(entry.Name(), ".sql")+downSuffix))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("failed to read rollback of migration %s: %w", entry.Name(), err)
+		}
+
 		migrations = append(migrations, Migration{
 			Version: version,
-			Name:    strings.TrimSuffix(parts[1], ".sql"),
+			Name:    strings.TrimSuffix(name, ".sql"),
 			SQL:     string(sql),
+			Down:    string(down),
 		})
 	}
 
@@ -169,3 +328,27 @@ func (m *Migrator) applyMigration(migration Migration) error {
 
 	return nil
 }
+
+// revertMigration rolls back a single migration.
+func (m *Migrator) revertMigration(migration Migration) error {
+	tx, err := m.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		//nolint:errcheck // Transaction cleanup
+		tx.Rollback()
+	}()
+
+	if _, err := tx.Exec(migration.Down); err != nil {
+		return fmt.Errorf("failed to execute rollback SQL: %w", err)
+	}
+	if _, err := tx.Exec("DELETE FROM schema_migrations WHERE version = ?", migration.Version); err != nil {
+		return fmt.Errorf("failed to unrecord migration: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
@@ -4,8 +4,10 @@
 package storage
 
 import (
+	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -29,10 +31,10 @@ func TestMigrator_Migrate(t *testing.T) {
 	var version int
 	err = db.QueryRow("SELECT MAX(version) FROM schema_migrations").Scan(&version)
 	require.NoError(t, err)
-	assert.Equal(t, 1, version)
+	assert.Equal(t, latestMigrationVersion(t), version)
 
 	// Verify tables created
-	tables := []string{"plans", "sessions", "cards", "schema_migrations"}
+	tables := []string{"plans", "sessions", "cards", "jobs", "llm_usage", "week_commitments", "week_commitment_items", "quiz_attempts", "quiz_answers", "schema_migrations"}
 	for _, table := range tables {
 		var exists bool
 		err = db.QueryRow(`
@@ -62,11 +64,11 @@ func TestMigrator_Migrate_Idempotent(t *testing.T) {
 	err = migrator.Migrate()
 	require.NoError(t, err)
 
-	// Version should still be 1
+	// Version should be unchanged
 	var version int
 	err = db.QueryRow("SELECT MAX(version) FROM schema_migrations").Scan(&version)
 	require.NoError(t, err)
-	assert.Equal(t, 1, version)
+	assert.Equal(t, latestMigrationVersion(t), version)
 }
 
 func TestNewStorage(t *testing.T) {
@@ -95,10 +97,145 @@ func TestNewStorage(t *testing.T) {
 	var version int
 	err = storage.DB.QueryRow("SELECT MAX(version) FROM schema_migrations").Scan(&version)
 	require.NoError(t, err)
-	assert.Equal(t, 1, version)
+	assert.Equal(t, latestMigrationVersion(t), version)
 
 	// Verify directories created
 	assert.DirExists(t, paths.BaseDir)
 	assert.DirExists(t, paths.PlansDir)
 	assert.DirExists(t, paths.CardsDir)
 }
+
+// latestMigrationVersion returns the highest embedded migration version.
+func latestMigrationVersion(t *testing.T) int {
+	t.Helper()
+
+	migrations, err := (&Migrator{}).loadMigrations()
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+
+	return migrations[len(migrations)-1].Version
+}
+
+func TestMigrator_Pending(t *testing.T) {
+	db, err := NewSQLiteDB(filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	migrator := NewMigrator(db)
+
+	pending, err := migrator.Pending()
+	require.NoError(t, err)
+	assert.Equal(t, latestMigrationVersion(t), pending)
+
+	require.NoError(t, migrator.Migrate())
+
+	pending, err = migrator.Pending()
+	require.NoError(t, err)
+	assert.Zero(t, pending)
+}
+
+func TestMigrator_Migrate_CurrentSchemaSkipsLock(t *testing.T) {
+	dir := t.TempDir()
+	db, err := NewSQLiteDB(filepath.Join(dir, "test.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	migrator := NewMigrator(db)
+	require.NoError(t, migrator.Migrate())
+
+	// Another process holding the lock doesn't hold up a current schema
+	holder := NewFileLock(filepath.Join(dir, LockFileName))
+	require.NoError(t, holder.Lock())
+	defer holder.Unlock()
+	db.lock.timeout = 50 * time.Millisecond
+
+	assert.NoError(t, migrator.Migrate())
+
+	_, err = db.Exec("DELETE FROM schema_migrations WHERE version = ?", latestMigrationVersion(t))
+	require.NoError(t, err)
+	assert.ErrorIs(t, migrator.Migrate(), ErrLocked, "an outdated schema waits for the lock")
+}
+
+func TestMigrator_Migrate_BacksUpFirst(t *testing.T) {
+	dir := t.TempDir()
+	backups := filepath.Join(dir, "backups")
+	policy := BackupPolicy{Dir: backups, Keep: 5}
+
+	fresh, err := NewSQLiteDB(filepath.Join(dir, "fresh.db"))
+	require.NoError(t, err)
+	defer fresh.Close()
+	migrator := NewMigrator(fresh)
+	migrator.SetBackup(policy)
+	require.NoError(t, migrator.Migrate())
+	assert.NoDirExists(t, backups, "a new database has nothing to back up")
+
+	// A database one migration behind
+	db, err := NewSQLiteDB(filepath.Join(dir, "test.db"))
+	require.NoError(t, err)
+	defer db.Close()
+	migrator = NewMigrator(db)
+	migrations, err := migrator.loadMigrations()
+	require.NoError(t, err)
+	for _, migration := range migrations[:len(migrations)-1] {
+		require.NoError(t, migrator.applyMigration(migration))
+	}
+
+	migrator.SetBackup(policy)
+	require.NoError(t, migrator.Migrate())
+
+	found, err := Backups(backups)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, fmt.Sprintf("pre-v%03d", latestMigrationVersion(t)), found[0].Reason)
+}
+
+func TestMigrator_MigrateTo(t *testing.T) {
+	dir := t.TempDir()
+	db, err := NewSQLiteDB(filepath.Join(dir, "test.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	migrator := NewMigrator(db)
+	require.NoError(t, migrator.Migrate())
+	_, err = db.Exec(`INSERT INTO sessions (id, plan_id, start_time, reflection) VALUES ('s1', 'rust', CURRENT_TIMESTAMP, '{}')`)
+	require.NoError(t, err)
+
+	backups := filepath.Join(dir, "backups")
+	migrator.SetBackup(BackupPolicy{Dir: backups})
+	require.NoError(t, migrator.MigrateTo(10))
+
+	version, err := migrator.Version()
+	require.NoError(t, err)
+	assert.Equal(t, 10, version)
+
+	var columns int
+	require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM pragma_table_info('sessions') WHERE name IN ('reflection', 'paused_at')`).Scan(&columns))
+	assert.Zero(t, columns, "columns added after version 10 are dropped")
+	var rows int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM sessions").Scan(&rows))
+	assert.Equal(t, 1, rows, "sessions survive")
+
+	found, err := Backups(backups)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, "pre-v010", found[0].Reason)
+
+	// And forward again
+	require.NoError(t, migrator.Migrate())
+	version, err = migrator.Version()
+	require.NoError(t, err)
+	assert.Equal(t, latestMigrationVersion(t), version)
+
+	assert.ErrorContains(t, migrator.MigrateTo(0), "no schema version 0")
+	assert.ErrorContains(t, migrator.MigrateTo(latestMigrationVersion(t)+1), "no schema version")
+}
+
+func TestMigrations_CanRollBack(t *testing.T) {
+	migrations, err := NewMigrator(nil).loadMigrations()
+	require.NoError(t, err)
+	require.Len(t, migrations, latestMigrationVersion(t), "down migrations are not counted as migrations")
+
+	for _, migration := range migrations[1:] {
+		assert.NotEmpty(t, migration.Down, "migration %d needs a %03d_%s%s", migration.Version, migration.Version, migration.Name, downSuffix)
+	}
+}
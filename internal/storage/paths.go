@@ -27,7 +27,17 @@ func DefaultPaths() (*Paths, error) {
 		return nil, fmt.Errorf("failed to get home directory: %w", err)
 	}
 
-	baseDir := filepath.Join(homeDir, ".samedi")
+	return NewPaths(filepath.Join(homeDir, ".samedi"))
+}
+
+// NewPaths returns the filesystem paths for data stored under baseDir.
+// Backups and the config file stay in their default home locations, since
+// the config file is what names baseDir.
+func NewPaths(baseDir string) (*Paths, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get home directory: %w", err)
+	}
 
 	return &Paths{
 		BaseDir:      baseDir,
@@ -36,7 +46,7 @@ func DefaultPaths() (*Paths, error) {
 		TemplatesDir: filepath.Join(baseDir, "templates"),
 		BackupDir:    filepath.Join(homeDir, "samedi-backups"),
 		DatabasePath: filepath.Join(baseDir, "sessions.db"),
-		ConfigPath:   filepath.Join(baseDir, "config.toml"),
+		ConfigPath:   filepath.Join(homeDir, ".samedi", "config.toml"),
 	}, nil
 }
 
@@ -89,11 +99,44 @@ func (p *Paths) Clean() error {
 	return nil
 }
 
+// LockPath returns the lock file that serializes writes to this data
+// directory across processes.
+func (p *Paths) LockPath() string {
+	return filepath.Join(p.BaseDir, LockFileName)
+}
+
 // PlanPath returns the full path for a plan markdown file.
 func (p *Paths) PlanPath(planID string) string {
 	return filepath.Join(p.PlansDir, fmt.Sprintf("%s.md", planID))
 }
 
+// ArchiveDir returns the directory archived plan files are moved to.
+func (p *Paths) ArchiveDir() string {
+	return filepath.Join(p.PlansDir, "archive")
+}
+
+// ArchivedPlanPath returns the path of a plan file moved to the archive.
+func (p *Paths) ArchivedPlanPath(planID string) string {
+	return filepath.Join(p.ArchiveDir(), fmt.Sprintf("%s.md", planID))
+}
+
+// FailedOutputPath returns where LLM output that could not be parsed into
+// a plan is kept for inspection.
+func (p *Paths) FailedOutputPath(planID string) string {
+	return filepath.Join(p.BaseDir, "failed", fmt.Sprintf("%s.md", planID))
+}
+
+// JournalDir returns the directory holding daily journal notes.
+func (p *Paths) JournalDir() string {
+	return filepath.Join(p.BaseDir, "journal")
+}
+
+// JournalPath returns the journal note for a day, named by its date, as
+// in "2026-10-16.md".
+func (p *Paths) JournalPath(date string) string {
+	return filepath.Join(p.JournalDir(), date+".md")
+}
+
 // CardsPath returns the full path for a cards markdown file.
 func (p *Paths) CardsPath(planID string) string {
 	return filepath.Join(p.CardsDir, fmt.Sprintf("%s.cards.md", planID))
@@ -103,3 +146,59 @@ func (p *Paths) CardsPath(planID string) string {
 func (p *Paths) TemplatePath(templateName string) string {
 	return filepath.Join(p.TemplatesDir, fmt.Sprintf("%s.md", templateName))
 }
+
+// ProfilesDir returns the directory holding named profiles. Each profile
+// is a complete storage root of its own (plans, cards, sessions.db).
+func (p *Paths) ProfilesDir() string {
+	return filepath.Join(p.BaseDir, "profiles")
+}
+
+// LogsDir returns the directory holding the diagnostic log.
+func (p *Paths) LogsDir() string {
+	return filepath.Join(p.BaseDir, "logs")
+}
+
+// CrashDir returns the directory holding dashboard crash reports.
+func (p *Paths) CrashDir() string {
+	return filepath.Join(p.BaseDir, "crash")
+}
+
+// Profile returns the paths for the named profile under this root.
+// Backups go to a per-profile subdirectory of the root's backup directory.
+func (p *Paths) Profile(name string) *Paths {
+	baseDir := filepath.Join(p.ProfilesDir(), name)
+
+	return &Paths{
+		BaseDir:      baseDir,
+		PlansDir:     filepath.Join(baseDir, "plans"),
+		CardsDir:     filepath.Join(baseDir, "cards"),
+		TemplatesDir: filepath.Join(baseDir, "templates"),
+		BackupDir:    filepath.Join(p.BackupDir, name),
+		DatabasePath: filepath.Join(baseDir, "sessions.db"),
+		ConfigPath:   filepath.Join(baseDir, "config.toml"),
+	}
+}
+
+// Profiles lists the named profiles under this root that have a database,
+// sorted by name. A missing profiles directory yields no profiles.
+func (p *Paths) Profiles() ([]string, error) {
+	entries, err := os.ReadDir(p.ProfilesDir())
+	if os.IsNotExist(err) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read profiles directory: %w", err)
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if _, err := os.Stat(p.Profile(entry.Name()).DatabasePath); err != nil {
+			continue
+		}
+		names = append(names, entry.Name()) // ReadDir returns entries sorted by name
+	}
+	return names, nil
+}
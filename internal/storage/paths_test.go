@@ -122,6 +122,15 @@ func TestPaths_CardsPath(t *testing.T) {
 	assert.Equal(t, "/home/user/.samedi/cards/french-b1.cards.md", path)
 }
 
+func TestPaths_JournalPath(t *testing.T) {
+	paths := &Paths{
+		BaseDir: "/home/user/.samedi",
+	}
+
+	assert.Equal(t, "/home/user/.samedi/journal", paths.JournalDir())
+	assert.Equal(t, "/home/user/.samedi/journal/2026-10-16.md", paths.JournalPath("2026-10-16"))
+}
+
 func TestPaths_TemplatePath(t *testing.T) {
 	paths := &Paths{
 		TemplatesDir: "/home/user/.samedi/templates",
@@ -130,3 +139,50 @@ func TestPaths_TemplatePath(t *testing.T) {
 	path := paths.TemplatePath("plan-generation")
 	assert.Equal(t, "/home/user/.samedi/templates/plan-generation.md", path)
 }
+
+func TestPaths_Profile(t *testing.T) {
+	paths := &Paths{
+		BaseDir:   "/home/user/.samedi",
+		BackupDir: "/home/user/samedi-backups",
+	}
+
+	work := paths.Profile("work")
+	assert.Equal(t, "/home/user/.samedi/profiles/work", work.BaseDir)
+	assert.Equal(t, "/home/user/.samedi/profiles/work/plans", work.PlansDir)
+	assert.Equal(t, "/home/user/.samedi/profiles/work/sessions.db", work.DatabasePath)
+	assert.Equal(t, "/home/user/samedi-backups/work", work.BackupDir)
+}
+
+func TestPaths_Profiles(t *testing.T) {
+	paths := &Paths{BaseDir: t.TempDir()}
+
+	names, err := paths.Profiles()
+	require.NoError(t, err)
+	assert.Empty(t, names, "no profiles directory means no profiles")
+
+	for _, name := range []string{"work", "personal"} {
+		profile := paths.Profile(name)
+		require.NoError(t, os.MkdirAll(profile.BaseDir, 0o755))
+		require.NoError(t, os.WriteFile(profile.DatabasePath, nil, 0o644))
+	}
+	// A directory without a database is not a profile
+	require.NoError(t, os.MkdirAll(paths.Profile("empty").BaseDir, 0o755))
+
+	names, err = paths.Profiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"personal", "work"}, names)
+}
+
+func TestNewPaths(t *testing.T) {
+	baseDir := filepath.Join(t.TempDir(), "data")
+	paths, err := NewPaths(baseDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, baseDir, paths.BaseDir)
+	assert.Equal(t, filepath.Join(baseDir, "plans"), paths.PlansDir)
+	assert.Equal(t, filepath.Join(baseDir, "sessions.db"), paths.DatabasePath)
+
+	defaults, err := DefaultPaths()
+	require.NoError(t, err)
+	assert.Equal(t, defaults.ConfigPath, paths.ConfigPath, "the config file names the data directory, so it doesn't move")
+}
@@ -18,14 +18,50 @@ type PlanRecord struct {
 	Status     string
 	Tags       []string
 	FilePath   string
+
+	// Children are the IDs of the plan's sub-plans, in frontmatter order.
+	Children []string
+
+	// Deadline is the plan's deadline as YYYY-MM-DD. Empty if it has none.
+	Deadline string
+
+	// NextChunkID and NextChunkTitle are denormalized from the markdown
+	// (first in-progress, else first not-started chunk). Empty when done.
+	NextChunkID    string
+	NextChunkTitle string
+
+	// ChunksTotal and ChunksCompleted are denormalized from the markdown
+	// so listings can show progress without parsing it. ChunksIndexed is
+	// false for plans not saved since the counts were added.
+	ChunksTotal     int
+	ChunksCompleted int
+	ChunksIndexed   bool
+
+	// GeneratedProvider, GeneratedModel and TemplateVersion mirror the
+	// plan's generated_by frontmatter. Empty for hand-written plans.
+	GeneratedProvider string
+	GeneratedModel    string
+	TemplateVersion   string
+
+	// LastSession is the start of the most recent session, joined from
+	// sessions on read. Nil if the plan has never been studied.
+	LastSession *time.Time
 }
 
 // PlanFilter provides optional filtering when listing plans.
 type PlanFilter struct {
 	IDs      []string
 	Statuses []string
-	Tag      string
 	SortBy   string
+
+	// Tags keeps plans tagged with every listed tag, or with any of them
+	// when AnyTag is set. Tags match whole, ignoring case.
+	Tags   []string
+	AnyTag bool
+
+	// DueBefore keeps plans with a deadline on or before this YYYY-MM-DD
+	// date, including overdue ones.
+	DueBefore string
 }
 
 // PlanRepository defines storage operations for plan metadata.
@@ -6,19 +6,22 @@ package storage
 import (
 	"database/sql"
 	"fmt"
-
-	_ "github.com/mattn/go-sqlite3" // SQLite driver
+	"os"
+	"path/filepath"
 )
 
 // SQLiteDB wraps a SQLite database connection.
 type SQLiteDB struct {
-	db *sql.DB
+	db   *sql.DB
+	lock *FileLock // Nil for in-memory databases
 }
 
 // NewSQLiteDB creates a new SQLite database connection.
 func NewSQLiteDB(dbPath string) (*SQLiteDB, error) {
-	// Open database with WAL mode for better concurrency
-	db, err := sql.Open("sqlite3", fmt.Sprintf("file:%s?_journal_mode=WAL&_busy_timeout=5000", dbPath))
+	// Open database with WAL mode for better concurrency. Transactions take
+	// the write lock up front so a second process waits out the busy timeout
+	// instead of failing when it tries to upgrade a read lock.
+	db, err := sql.Open(driverName, fmt.Sprintf("file:%s?_journal_mode=WAL&_busy_timeout=5000&_txlock=immediate", dbPath))
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
@@ -32,6 +35,34 @@ func NewSQLiteDB(dbPath string) (*SQLiteDB, error) {
 	db.SetMaxOpenConns(1) // SQLite doesn't handle concurrent writes well
 	db.SetMaxIdleConns(1)
 
+	s := &SQLiteDB{db: db}
+	if dbPath != ":memory:" {
+		s.lock = lockFor(filepath.Join(filepath.Dir(dbPath), LockFileName))
+	}
+	return s, nil
+}
+
+// OpenSQLiteReadOnly opens an existing SQLite database without write access.
+// The file is never created or migrated, so callers can inspect another
+// store without changing it.
+func OpenSQLiteReadOnly(dbPath string) (*SQLiteDB, error) {
+	if _, err := os.Stat(dbPath); err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	db, err := sql.Open(driverName, fmt.Sprintf("file:%s?mode=ro&_busy_timeout=5000", dbPath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	if err := db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+
 	return &SQLiteDB{db: db}, nil
 }
 
@@ -48,6 +79,16 @@ func (s *SQLiteDB) DB() *sql.DB {
 	return s.db
 }
 
+// WithLock runs fn while holding the data directory's cross-process write
+// lock, for changes such as migrations that must not interleave with
+// another samedi process.
+func (s *SQLiteDB) WithLock(fn func() error) error {
+	if s.lock == nil {
+		return fn()
+	}
+	return s.lock.WithLock(fn)
+}
+
 // Begin starts a new transaction.
 func (s *SQLiteDB) Begin() (*sql.Tx, error) {
 	return s.db.Begin()
@@ -146,3 +146,35 @@ func TestSQLiteDB_Transaction(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 0, count)
 }
+
+func TestOpenSQLiteReadOnly(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test.db")
+
+	writable, err := NewSQLiteDB(dbPath)
+	require.NoError(t, err)
+	_, err = writable.Exec("CREATE TABLE notes (body TEXT)")
+	require.NoError(t, err)
+	_, err = writable.Exec("INSERT INTO notes (body) VALUES ('hello')")
+	require.NoError(t, err)
+	require.NoError(t, writable.Close())
+
+	db, err := OpenSQLiteReadOnly(dbPath)
+	require.NoError(t, err)
+	defer db.Close()
+
+	var body string
+	require.NoError(t, db.QueryRow("SELECT body FROM notes").Scan(&body))
+	assert.Equal(t, "hello", body)
+
+	_, err = db.Exec("INSERT INTO notes (body) VALUES ('nope')")
+	assert.Error(t, err, "writes should be rejected")
+}
+
+func TestOpenSQLiteReadOnly_MissingFile(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "missing.db")
+
+	_, err := OpenSQLiteReadOnly(dbPath)
+	assert.Error(t, err)
+	assert.NoFileExists(t, dbPath)
+}
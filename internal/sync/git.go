@@ -0,0 +1,62 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+// Package sync keeps the samedi data directory in a git repository so
+// plans can be versioned and shared across machines.
+//
+// Markdown files are the source of truth; the SQLite index is never
+// committed and is rebuilt from markdown after each pull.
+package sync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// git runs git commands inside a working tree.
+type git struct {
+	dir string
+}
+
+// run executes git with args and returns stdout without the trailing newline.
+// Leading whitespace is preserved because it is significant in porcelain output.
+func (g *git) run(ctx context.Context, args ...string) (string, error) {
+	fullArgs := append([]string{"-C", g.dir}, args...)
+
+	// #nosec G204 - arguments are constructed internally, never shell-interpreted
+	cmd := exec.CommandContext(ctx, "git", fullArgs...)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = strings.TrimSpace(stdout.String())
+		}
+		return "", fmt.Errorf("git %s failed: %w: %s", args[0], err, msg)
+	}
+
+	return strings.TrimRight(stdout.String(), "\r\n"), nil
+}
+
+// lines splits command output into non-empty lines.
+func lines(output string) []string {
+	result := make([]string, 0)
+	for _, line := range strings.Split(output, "\n") {
+		if line = strings.TrimRight(line, "\r"); strings.TrimSpace(line) != "" {
+			result = append(result, line)
+		}
+	}
+	return result
+}
+
+// Available reports whether the git executable can be found.
+func Available() bool {
+	_, err := exec.LookPath("git")
+	return err == nil
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package sync
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+)
+
+// PlanID returns the plan ID for a path like "plans/rust-async.md",
+// or "" if the path is not a plan file.
+func PlanID(file string) string {
+	file = filepath.ToSlash(file)
+	if path.Dir(file) != "plans" || path.Ext(file) != ".md" {
+		return ""
+	}
+	return strings.TrimSuffix(path.Base(file), ".md")
+}
+
+// DescribeChanges builds a commit message from pending changes. Plan files
+// get specific descriptions ("plan created", "chunk completed"); anything
+// else is summarized by count.
+func (r *Repo) DescribeChanges(ctx context.Context, changes []Change) string {
+	items := make([]string, 0, len(changes))
+	other := 0
+
+	for _, change := range changes {
+		id := PlanID(change.Path)
+		if id == "" {
+			other++
+			continue
+		}
+
+		switch change.Kind {
+		case ChangeAdded:
+			items = append(items, "plan created: "+id)
+		case ChangeDeleted:
+			items = append(items, "plan deleted: "+id)
+		default:
+			items = append(items, r.describePlanEdit(ctx, change.Path, id)...)
+		}
+	}
+
+	if other > 0 {
+		items = append(items, fmt.Sprintf("update %d other file(s)", other))
+	}
+
+	switch len(items) {
+	case 0:
+		return "samedi: sync"
+	case 1:
+		return "samedi: " + items[0]
+	default:
+		return fmt.Sprintf("samedi: %d changes\n\n- %s", len(items), strings.Join(items, "\n- "))
+	}
+}
+
+// describePlanEdit compares a modified plan with its last committed version
+// and reports chunk status transitions.
+func (r *Repo) describePlanEdit(ctx context.Context, file, id string) []string {
+	fallback := []string{"plan updated: " + id}
+
+	previous, err := r.git.run(ctx, "show", "HEAD:"+filepath.ToSlash(file))
+	if err != nil {
+		return fallback
+	}
+	before, err := plan.Parse(previous)
+	if err != nil {
+		return fallback
+	}
+	after, err := plan.ParseFile(filepath.Join(r.dir, file))
+	if err != nil {
+		return fallback
+	}
+
+	return describeChunkChanges(before, after, id, fallback)
+}
+
+// describeChunkChanges lists chunk status transitions between two versions of a plan.
+func describeChunkChanges(before, after *plan.Plan, id string, fallback []string) []string {
+	previous := make(map[string]plan.Status, len(before.Chunks))
+	for _, chunk := range before.Chunks {
+		previous[chunk.ID] = chunk.Status
+	}
+
+	items := make([]string, 0)
+	for _, chunk := range after.Chunks {
+		old, existed := previous[chunk.ID]
+		if existed && old == chunk.Status {
+			continue
+		}
+		switch chunk.Status {
+		case plan.StatusCompleted:
+			items = append(items, fmt.Sprintf("chunk completed: %s/%s", id, chunk.ID))
+		case plan.StatusInProgress:
+			items = append(items, fmt.Sprintf("chunk started: %s/%s", id, chunk.ID))
+		case plan.StatusSkipped:
+			items = append(items, fmt.Sprintf("chunk skipped: %s/%s", id, chunk.ID))
+		}
+	}
+
+	if before.Status != after.Status && after.Status == plan.StatusArchived {
+		items = append(items, "plan archived: "+id)
+	}
+
+	if len(items) == 0 {
+		return fallback
+	}
+	return items
+}
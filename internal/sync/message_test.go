@@ -0,0 +1,59 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanID(t *testing.T) {
+	assert.Equal(t, "rust-async", PlanID("plans/rust-async.md"))
+	assert.Equal(t, "", PlanID("cards/rust-async.md"))
+	assert.Equal(t, "", PlanID("plans/notes.txt"))
+	assert.Equal(t, "", PlanID("plans/nested/x.md"))
+}
+
+func TestDescribeChanges_Summary(t *testing.T) {
+	repo := NewRepo(t.TempDir())
+	ctx := context.Background()
+
+	assert.Equal(t, "samedi: sync", repo.DescribeChanges(ctx, nil))
+	assert.Equal(t, "samedi: update 2 other file(s)", repo.DescribeChanges(ctx, []Change{
+		{Path: ".gitignore", Kind: ChangeAdded},
+		{Path: "templates/plan-generation.md", Kind: ChangeModified},
+	}))
+
+	msg := repo.DescribeChanges(ctx, []Change{
+		{Path: "plans/go.md", Kind: ChangeAdded},
+		{Path: "plans/old.md", Kind: ChangeDeleted},
+	})
+	assert.Equal(t, "samedi: 2 changes\n\n- plan created: go\n- plan deleted: old", msg)
+}
+
+func TestDescribeChunkChanges(t *testing.T) {
+	before := &plan.Plan{Status: plan.StatusInProgress, Chunks: []plan.Chunk{
+		{ID: "chunk-001", Status: plan.StatusInProgress},
+		{ID: "chunk-002", Status: plan.StatusNotStarted},
+		{ID: "chunk-003", Status: plan.StatusNotStarted},
+	}}
+	after := &plan.Plan{Status: plan.StatusArchived, Chunks: []plan.Chunk{
+		{ID: "chunk-001", Status: plan.StatusCompleted},
+		{ID: "chunk-002", Status: plan.StatusInProgress},
+		{ID: "chunk-003", Status: plan.StatusNotStarted},
+	}}
+
+	items := describeChunkChanges(before, after, "rust", []string{"fallback"})
+	assert.Equal(t, []string{
+		"chunk completed: rust/chunk-001",
+		"chunk started: rust/chunk-002",
+		"plan archived: rust",
+	}, items)
+
+	unchanged := describeChunkChanges(before, before, "rust", []string{"plan updated: rust"})
+	assert.Equal(t, []string{"plan updated: rust"}, unchanged)
+}
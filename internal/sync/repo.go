@@ -0,0 +1,435 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// RemoteName is the git remote samedi pushes to and pulls from.
+const RemoteName = "origin"
+
+// gitignore keeps machine-local state out of the repository. Rules added
+// here reach existing repositories on their next commit.
+const gitignore = `# Managed by samedi sync.
+# The SQLite index is rebuilt from markdown after each pull,
+# config and its backup hold machine-specific settings
+# (webhook URLs among them), failed/ keeps
+# unparseable LLM output for local inspection, api-token
+# is the local API's secret, state/ snapshots this
+# machine's active session, and logs/ and crash/ hold its
+# diagnostic log and crash reports.
+sessions.db
+sessions.db-*
+*.lock
+config.toml
+config.toml.bak
+failed/
+api-token
+state/
+logs/
+crash/
+`
+
+// ignoreRules returns the rules of the managed .gitignore.
+func ignoreRules() []string {
+	rules := make([]string, 0)
+	for _, line := range lines(gitignore) {
+		if line != "" && !strings.HasPrefix(line, "#") {
+			rules = append(rules, line)
+		}
+	}
+	return rules
+}
+
+// ChangeKind describes how a file differs from the last commit.
+type ChangeKind string
+
+const (
+	// ChangeAdded is a new file.
+	ChangeAdded ChangeKind = "added"
+	// ChangeModified is an edited file.
+	ChangeModified ChangeKind = "modified"
+	// ChangeDeleted is a removed file.
+	ChangeDeleted ChangeKind = "deleted"
+)
+
+// Change is a pending change in the working tree.
+type Change struct {
+	Path string
+	Kind ChangeKind
+}
+
+// ConflictError reports files left with merge conflicts after a pull.
+type ConflictError struct {
+	Files []string
+}
+
+// Error implements the error interface.
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("merge conflicts in %d file(s): %s", len(e.Files), strings.Join(e.Files, ", "))
+}
+
+// PullResult describes what a pull changed.
+type PullResult struct {
+	// Updated is false when the remote had nothing new.
+	Updated bool
+
+	// ChangedFiles lists paths (relative to the data directory) that the pull touched.
+	ChangedFiles []string
+}
+
+// Repo is the git repository backing the samedi data directory.
+type Repo struct {
+	dir string
+	git *git
+}
+
+// NewRepo returns a Repo for the data directory. It does not create anything.
+func NewRepo(dir string) *Repo {
+	return &Repo{dir: dir, git: &git{dir: dir}}
+}
+
+// Dir returns the data directory.
+func (r *Repo) Dir() string {
+	return r.dir
+}
+
+// IsInitialized reports whether the data directory is a git repository.
+func (r *Repo) IsInitialized() bool {
+	info, err := os.Stat(filepath.Join(r.dir, ".git"))
+	return err == nil && info.IsDir()
+}
+
+// Init creates the repository (if needed), writes the .gitignore, sets the
+// remote when one is given, and records an initial commit of existing data.
+func (r *Repo) Init(ctx context.Context, remote string) error {
+	if !r.IsInitialized() {
+		if _, err := r.git.run(ctx, "init"); err != nil {
+			return fmt.Errorf("failed to initialize repository: %w", err)
+		}
+	}
+
+	if err := r.ensureIgnored(ctx); err != nil {
+		return err
+	}
+
+	if remote != "" {
+		if err := r.SetRemote(ctx, remote); err != nil {
+			return err
+		}
+	}
+
+	if _, err := r.Commit(ctx, "samedi: initialize sync"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ensureIgnored writes the managed .gitignore, or appends the rules an
+// existing one lacks. Files committed before a new rule covered them stop
+// being tracked, so the next commit removes them from the remote too.
+func (r *Repo) ensureIgnored(ctx context.Context) error {
+	path := filepath.Join(r.dir, ".gitignore")
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to read .gitignore: %w", err)
+	}
+
+	present := make(map[string]bool)
+	for _, line := range lines(string(data)) {
+		present[line] = true
+	}
+	missing := make([]string, 0)
+	for _, rule := range ignoreRules() {
+		if !present[rule] {
+			missing = append(missing, rule)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	content := gitignore
+	if len(data) > 0 {
+		content = string(data)
+		if !strings.HasSuffix(content, "\n") {
+			content += "\n"
+		}
+		content += strings.Join(missing, "\n") + "\n"
+	}
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		return fmt.Errorf("failed to write .gitignore: %w", err)
+	}
+
+	if r.head(ctx) == "" {
+		return nil
+	}
+	args := append([]string{"rm", "-r", "--cached", "--quiet", "--ignore-unmatch", "--"}, missing...)
+	if _, err := r.git.run(ctx, args...); err != nil {
+		return fmt.Errorf("failed to untrack ignored files: %w", err)
+	}
+	return nil
+}
+
+// SetRemote adds or updates the sync remote.
+func (r *Repo) SetRemote(ctx context.Context, url string) error {
+	existing, err := r.Remote(ctx)
+	if err != nil {
+		return err
+	}
+
+	args := []string{"remote", "add", RemoteName, url}
+	if existing != "" {
+		args = []string{"remote", "set-url", RemoteName, url}
+	}
+
+	if _, err := r.git.run(ctx, args...); err != nil {
+		return fmt.Errorf("failed to set remote: %w", err)
+	}
+	return nil
+}
+
+// Remote returns the configured remote URL, or "" if none is set.
+func (r *Repo) Remote(ctx context.Context) (string, error) {
+	out, err := r.git.run(ctx, "remote")
+	if err != nil {
+		return "", fmt.Errorf("failed to list remotes: %w", err)
+	}
+
+	for _, name := range lines(out) {
+		if name == RemoteName {
+			url, err := r.git.run(ctx, "remote", "get-url", RemoteName)
+			if err != nil {
+				return "", fmt.Errorf("failed to get remote URL: %w", err)
+			}
+			return url, nil
+		}
+	}
+	return "", nil
+}
+
+// Status lists uncommitted changes in the working tree.
+func (r *Repo) Status(ctx context.Context) ([]Change, error) {
+	out, err := r.git.run(ctx, "status", "--porcelain=v1", "--untracked-files=all")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status: %w", err)
+	}
+	return parseStatus(out), nil
+}
+
+// Commit stages everything and commits it. If message is empty, one is
+// generated from the changes. Returns false if there was nothing to commit.
+func (r *Repo) Commit(ctx context.Context, message string) (bool, error) {
+	if err := r.ensureIgnored(ctx); err != nil {
+		return false, err
+	}
+
+	changes, err := r.Status(ctx)
+	if err != nil {
+		return false, err
+	}
+	if len(changes) == 0 {
+		return false, nil
+	}
+
+	if message == "" {
+		message = r.DescribeChanges(ctx, changes)
+	}
+
+	if _, err := r.git.run(ctx, "add", "--all"); err != nil {
+		return false, fmt.Errorf("failed to stage changes: %w", err)
+	}
+
+	if _, err := r.git.run(ctx, "commit", "--quiet", "-m", message); err != nil {
+		return false, fmt.Errorf("failed to commit: %w", err)
+	}
+
+	return true, nil
+}
+
+// Conflicts lists files with unresolved merge conflicts.
+func (r *Repo) Conflicts(ctx context.Context) ([]string, error) {
+	out, err := r.git.run(ctx, "diff", "--name-only", "--diff-filter=U")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list conflicts: %w", err)
+	}
+	return lines(out), nil
+}
+
+// IsMerging reports whether a merge is in progress.
+func (r *Repo) IsMerging(ctx context.Context) bool {
+	_, err := r.git.run(ctx, "rev-parse", "-q", "--verify", "MERGE_HEAD")
+	return err == nil
+}
+
+// FinishMerge concludes an in-progress merge once all conflicts are resolved.
+// A conflicted file counts as resolved once its conflict markers are gone.
+func (r *Repo) FinishMerge(ctx context.Context) error {
+	conflicts, err := r.Conflicts(ctx)
+	if err != nil {
+		return err
+	}
+
+	unresolved := make([]string, 0)
+	for _, file := range conflicts {
+		if hasConflictMarkers(filepath.Join(r.dir, file)) {
+			unresolved = append(unresolved, file)
+		}
+	}
+	if len(unresolved) > 0 {
+		return &ConflictError{Files: unresolved}
+	}
+
+	if _, err := r.git.run(ctx, "add", "--all"); err != nil {
+		return fmt.Errorf("failed to stage resolved files: %w", err)
+	}
+	if _, err := r.git.run(ctx, "commit", "--quiet", "--no-edit"); err != nil {
+		return fmt.Errorf("failed to commit merge: %w", err)
+	}
+	return nil
+}
+
+// Pull merges the remote branch into the local one. On conflicts the merge
+// is left in progress and a *ConflictError is returned.
+func (r *Repo) Pull(ctx context.Context) (*PullResult, error) {
+	branch, err := r.branch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Nothing to pull if the remote branch doesn't exist yet (first push).
+	heads, err := r.git.run(ctx, "ls-remote", "--heads", RemoteName, branch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query remote: %w", err)
+	}
+	if heads == "" {
+		return &PullResult{}, nil
+	}
+
+	before := r.head(ctx)
+
+	if _, err := r.git.run(ctx, "pull", "--no-rebase", "--no-edit", RemoteName, branch); err != nil {
+		conflicts, conflictErr := r.Conflicts(ctx)
+		if conflictErr == nil && len(conflicts) > 0 {
+			return nil, &ConflictError{Files: conflicts}
+		}
+		return nil, fmt.Errorf("failed to pull: %w", err)
+	}
+
+	after := r.head(ctx)
+	if before == after {
+		return &PullResult{}, nil
+	}
+
+	var out string
+	if before == "" {
+		out, err = r.git.run(ctx, "ls-files")
+	} else {
+		out, err = r.git.run(ctx, "diff", "--name-only", before, after)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pulled changes: %w", err)
+	}
+
+	return &PullResult{Updated: true, ChangedFiles: lines(out)}, nil
+}
+
+// Push publishes local commits to the remote.
+func (r *Repo) Push(ctx context.Context) error {
+	branch, err := r.branch(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.git.run(ctx, "push", "--quiet", "--set-upstream", RemoteName, branch); err != nil {
+		return fmt.Errorf("failed to push: %w", err)
+	}
+	return nil
+}
+
+// branch returns the current branch name.
+func (r *Repo) branch(ctx context.Context) (string, error) {
+	out, err := r.git.run(ctx, "symbolic-ref", "--short", "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("failed to determine current branch: %w", err)
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// head returns the current commit hash, or "" before the first commit.
+func (r *Repo) head(ctx context.Context) string {
+	out, err := r.git.run(ctx, "rev-parse", "--verify", "-q", "HEAD")
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(out)
+}
+
+// parseStatus converts `git status --porcelain=v1` output into changes.
+func parseStatus(output string) []Change {
+	changes := make([]Change, 0)
+	for _, line := range lines(output) {
+		if len(line) < 4 {
+			continue
+		}
+
+		code := line[:2]
+		path := line[3:]
+
+		// Renames are reported as "old -> new"; treat as delete + add.
+		if strings.Contains(code, "R") {
+			if parts := strings.SplitN(path, " -> ", 2); len(parts) == 2 {
+				changes = append(changes,
+					Change{Path: unquote(parts[0]), Kind: ChangeDeleted},
+					Change{Path: unquote(parts[1]), Kind: ChangeAdded},
+				)
+				continue
+			}
+		}
+
+		kind := ChangeModified
+		switch {
+		case code == "??" || strings.Contains(code, "A"):
+			kind = ChangeAdded
+		case strings.Contains(code, "D"):
+			kind = ChangeDeleted
+		}
+
+		changes = append(changes, Change{Path: unquote(path), Kind: kind})
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Path < changes[j].Path })
+	return changes
+}
+
+// hasConflictMarkers reports whether a file still contains git conflict markers.
+// Deleted files have no markers.
+func hasConflictMarkers(path string) bool {
+	data, err := os.ReadFile(path) // #nosec G304 - path is within the data directory
+	if err != nil {
+		return false
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.HasPrefix(line, "<<<<<<< ") || strings.HasPrefix(line, ">>>>>>> ") {
+			return true
+		}
+	}
+	return false
+}
+
+// unquote strips the quotes git adds around paths with special characters.
+func unquote(path string) string {
+	if len(path) >= 2 && strings.HasPrefix(path, `"`) && strings.HasSuffix(path, `"`) {
+		return path[1 : len(path)-1]
+	}
+	return path
+}
@@ -0,0 +1,298 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const planV1 = `---
+id: rust
+title: Rust
+created: 2024-01-01T00:00:00Z
+updated: 2024-01-01T00:00:00Z
+total_hours: 2
+status: not-started
+tags: []
+---
+
+# Rust
+
+## Chunk 1: Basics {#chunk-001}
+
+**Duration**: 1 hour
+**Status**: not-started
+
+## Chunk 2: Ownership {#chunk-002}
+
+**Duration**: 1 hour
+**Status**: not-started
+`
+
+// setupGit isolates git from the user's global config and provides an identity.
+func setupGit(t *testing.T) {
+	t.Helper()
+
+	if !Available() {
+		t.Skip("git not installed")
+	}
+
+	t.Setenv("GIT_CONFIG_GLOBAL", filepath.Join(t.TempDir(), "gitconfig"))
+	t.Setenv("GIT_CONFIG_NOSYSTEM", "1")
+	t.Setenv("GIT_AUTHOR_NAME", "Test")
+	t.Setenv("GIT_AUTHOR_EMAIL", "test@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "Test")
+	t.Setenv("GIT_COMMITTER_EMAIL", "test@example.com")
+}
+
+// newBareRemote creates an empty bare repository to act as the sync remote.
+func newBareRemote(t *testing.T) string {
+	t.Helper()
+
+	dir := filepath.Join(t.TempDir(), "remote.git")
+	out, err := exec.Command("git", "init", "--bare", "--initial-branch=main", dir).CombinedOutput()
+	require.NoError(t, err, string(out))
+	return dir
+}
+
+// newDataDir creates a samedi data directory with a plans folder.
+func newDataDir(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "plans"), 0o755))
+	return dir
+}
+
+func writePlan(t *testing.T, dir, id, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "plans", id+".md"), []byte(content), 0o600))
+}
+
+func lastCommitMessage(t *testing.T, repo *Repo) string {
+	t.Helper()
+	out, err := repo.git.run(context.Background(), "log", "-1", "--format=%B")
+	require.NoError(t, err)
+	return strings.TrimSpace(out)
+}
+
+func TestRepo_Init(t *testing.T) {
+	setupGit(t)
+	ctx := context.Background()
+
+	dir := newDataDir(t)
+	writePlan(t, dir, "rust", planV1)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sessions.db"), []byte("binary"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.toml.bak"), []byte("[events]"), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "state"), 0o700))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "logs"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "logs", "samedi.log"), []byte("log"), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "crash"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "crash", "crash-1.txt"), []byte("panic"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "state", "status.json"), []byte("{}"), 0o600))
+
+	repo := NewRepo(dir)
+	assert.False(t, repo.IsInitialized())
+
+	require.NoError(t, repo.Init(ctx, "https://example.com/data.git"))
+	assert.True(t, repo.IsInitialized())
+	assert.FileExists(t, filepath.Join(dir, ".gitignore"))
+
+	remote, err := repo.Remote(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/data.git", remote)
+
+	tracked, err := repo.git.run(ctx, "ls-files")
+	require.NoError(t, err)
+	assert.Contains(t, tracked, "plans/rust.md")
+	assert.NotContains(t, tracked, "sessions.db", "database must not be committed")
+	assert.NotContains(t, tracked, "state/", "this machine's session snapshot must not be committed")
+	assert.NotContains(t, tracked, "config.toml", "nor the config's backup")
+	assert.NotContains(t, tracked, "logs/", "nor the diagnostic log")
+	assert.NotContains(t, tracked, "crash/", "nor crash reports")
+
+	// Re-running init is safe
+	require.NoError(t, repo.Init(ctx, ""))
+}
+
+func TestRepo_Commit_MigratesIgnoreRules(t *testing.T) {
+	setupGit(t)
+	ctx := context.Background()
+
+	// A repository from before api-token was ignored, with it committed
+	dir := newDataDir(t)
+	writePlan(t, dir, "rust", planV1)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".gitignore"), []byte("sessions.db\nnotes/\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "api-token"), []byte("secret"), 0o600))
+	repo := NewRepo(dir)
+	_, err := repo.git.run(ctx, "init")
+	require.NoError(t, err)
+	_, err = repo.git.run(ctx, "add", "--all")
+	require.NoError(t, err)
+	_, err = repo.git.run(ctx, "commit", "--quiet", "-m", "old")
+	require.NoError(t, err)
+
+	committed, err := repo.Commit(ctx, "")
+	require.NoError(t, err)
+	assert.True(t, committed)
+
+	ignore, err := os.ReadFile(filepath.Join(dir, ".gitignore"))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(ignore), "sessions.db\nnotes/\n"), "the user's rules are kept")
+	for _, rule := range ignoreRules() {
+		assert.Contains(t, string(ignore), rule+"\n")
+	}
+
+	tracked, err := repo.git.run(ctx, "ls-files")
+	require.NoError(t, err)
+	assert.NotContains(t, tracked, "api-token", "the token stops being tracked")
+	assert.Contains(t, tracked, "plans/rust.md")
+	assert.FileExists(t, filepath.Join(dir, "api-token"), "but stays on disk")
+
+	committed, err = repo.Commit(ctx, "")
+	require.NoError(t, err)
+	assert.False(t, committed, "the migration runs once")
+}
+
+func TestRepo_Commit_GeneratesMessages(t *testing.T) {
+	setupGit(t)
+	ctx := context.Background()
+
+	dir := newDataDir(t)
+	repo := NewRepo(dir)
+	require.NoError(t, repo.Init(ctx, ""))
+
+	committed, err := repo.Commit(ctx, "")
+	require.NoError(t, err)
+	assert.False(t, committed, "nothing to commit")
+
+	writePlan(t, dir, "rust", planV1)
+	committed, err = repo.Commit(ctx, "")
+	require.NoError(t, err)
+	assert.True(t, committed)
+	assert.Equal(t, "samedi: plan created: rust", lastCommitMessage(t, repo))
+
+	writePlan(t, dir, "rust", strings.Replace(planV1, "**Status**: not-started\n\n## Chunk 2", "**Status**: completed\n\n## Chunk 2", 1))
+	_, err = repo.Commit(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, "samedi: chunk completed: rust/chunk-001", lastCommitMessage(t, repo))
+
+	require.NoError(t, os.Remove(filepath.Join(dir, "plans", "rust.md")))
+	_, err = repo.Commit(ctx, "custom message")
+	require.NoError(t, err)
+	assert.Equal(t, "custom message", lastCommitMessage(t, repo))
+}
+
+func TestRepo_PushAndPull(t *testing.T) {
+	setupGit(t)
+	ctx := context.Background()
+	remote := newBareRemote(t)
+
+	laptop := NewRepo(newDataDir(t))
+	require.NoError(t, laptop.Init(ctx, remote))
+
+	// Pull before the remote has any branch is a no-op
+	result, err := laptop.Pull(ctx)
+	require.NoError(t, err)
+	assert.False(t, result.Updated)
+
+	writePlan(t, laptop.Dir(), "rust", planV1)
+	_, err = laptop.Commit(ctx, "")
+	require.NoError(t, err)
+	require.NoError(t, laptop.Push(ctx))
+
+	// A second machine starts empty and pulls the plan
+	desktopDir := newDataDir(t)
+	desktop := NewRepo(desktopDir)
+	require.NoError(t, desktop.Init(ctx, remote))
+	branch, err := laptop.branch(ctx)
+	require.NoError(t, err)
+	_, err = desktop.git.run(ctx, "checkout", "-q", "-B", branch)
+	require.NoError(t, err)
+
+	// Both sides have an independent root commit, so allow the merge
+	_, err = desktop.git.run(ctx, "config", "pull.allowUnrelatedHistories", "true")
+	require.NoError(t, err)
+	_, err = desktop.git.run(ctx, "fetch", "-q", RemoteName)
+	require.NoError(t, err)
+	_, err = desktop.git.run(ctx, "reset", "-q", "--hard", RemoteName+"/"+branch)
+	require.NoError(t, err)
+
+	writePlan(t, laptop.Dir(), "go", strings.ReplaceAll(planV1, "rust", "go"))
+	_, err = laptop.Commit(ctx, "")
+	require.NoError(t, err)
+	require.NoError(t, laptop.Push(ctx))
+
+	result, err = desktop.Pull(ctx)
+	require.NoError(t, err)
+	assert.True(t, result.Updated)
+	assert.Equal(t, []string{"plans/go.md"}, result.ChangedFiles)
+	assert.FileExists(t, filepath.Join(desktopDir, "plans", "go.md"))
+}
+
+func TestRepo_Pull_Conflict(t *testing.T) {
+	setupGit(t)
+	ctx := context.Background()
+	remote := newBareRemote(t)
+
+	laptop := NewRepo(newDataDir(t))
+	require.NoError(t, laptop.Init(ctx, remote))
+	writePlan(t, laptop.Dir(), "rust", planV1)
+	_, err := laptop.Commit(ctx, "")
+	require.NoError(t, err)
+	require.NoError(t, laptop.Push(ctx))
+	branch, err := laptop.branch(ctx)
+	require.NoError(t, err)
+
+	desktopDir := filepath.Join(t.TempDir(), "desktop")
+	out, err := exec.Command("git", "clone", "-q", "--branch", branch, remote, desktopDir).CombinedOutput()
+	require.NoError(t, err, string(out))
+	desktop := NewRepo(desktopDir)
+
+	// Both machines edit the same line differently
+	writePlan(t, laptop.Dir(), "rust", strings.Replace(planV1, "title: Rust", "title: Rust (laptop)", 1))
+	_, err = laptop.Commit(ctx, "")
+	require.NoError(t, err)
+	require.NoError(t, laptop.Push(ctx))
+
+	writePlan(t, desktopDir, "rust", strings.Replace(planV1, "title: Rust", "title: Rust (desktop)", 1))
+	_, err = desktop.Commit(ctx, "")
+	require.NoError(t, err)
+
+	_, err = desktop.Pull(ctx)
+	var conflictErr *ConflictError
+	require.True(t, errors.As(err, &conflictErr), "expected ConflictError, got %v", err)
+	assert.Equal(t, []string{"plans/rust.md"}, conflictErr.Files)
+	assert.True(t, desktop.IsMerging(ctx))
+
+	// Unresolved merges cannot be finished
+	assert.Error(t, desktop.FinishMerge(ctx))
+
+	// Resolve and finish
+	writePlan(t, desktopDir, "rust", planV1)
+	require.NoError(t, desktop.FinishMerge(ctx))
+	assert.False(t, desktop.IsMerging(ctx))
+}
+
+func TestParseStatus(t *testing.T) {
+	output := " M plans/rust.md\n?? plans/go.md\n D cards/old.md\nR  plans/a.md -> plans/b.md\n"
+
+	changes := parseStatus(output)
+	assert.Equal(t, []Change{
+		{Path: "cards/old.md", Kind: ChangeDeleted},
+		{Path: "plans/a.md", Kind: ChangeDeleted},
+		{Path: "plans/b.md", Kind: ChangeAdded},
+		{Path: "plans/go.md", Kind: ChangeAdded},
+		{Path: "plans/rust.md", Kind: ChangeModified},
+	}, changes)
+}
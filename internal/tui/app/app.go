@@ -5,10 +5,15 @@ package app
 
 import (
 	"fmt"
+	"runtime/debug"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/pezware/samedi.dev/internal/log"
+	"github.com/pezware/samedi.dev/internal/tui/keymap"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
 )
 
 // App is the shared Bubble Tea shell that coordinates registered modules.
@@ -23,15 +28,26 @@ type App struct {
 	height int
 
 	status *StatusMsg
+
+	quickActions map[string]QuickAction
+	quickOrder   []string
+	prompt       *quickPrompt // Open while a quick action asks for input
+
+	keys     *keymap.Keymap
+	showHelp bool     // Help overlay replaces the module view
+	palette  *palette // Open command palette; replaces the module view
+
+	refreshEvery time.Duration // Auto-refresh interval; zero for none
+
+	crashDir string
+	build    string
+	recent   []string // Latest messages, for crash reports
+	crash    *Crash
 }
 
-var (
-	navStyle         = lipgloss.NewStyle().Bold(true)
-	activeNavStyle   = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("212"))
-	statusStyle      = lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
-	errorStatusStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("209"))
-	borderStyle      = lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
-)
+// navStyle renders key names and inactive modules. Colored styles come
+// from the styles package so they follow the theme.
+var navStyle = lipgloss.NewStyle().Bold(true)
 
 // New constructs a new shell with the provided modules. The first module becomes active.
 func New(modules []Module) (*App, error) {
@@ -59,11 +75,33 @@ func New(modules []Module) (*App, error) {
 		activeID:    order[0],
 		initialized: map[string]bool{},
 		activated:   map[string]bool{},
+		keys:        keymap.Default(),
 	}, nil
 }
 
+// SetKeymap replaces the shell's key bindings and passes them to every
+// module that accepts them.
+func (a *App) SetKeymap(keys *keymap.Keymap) {
+	a.keys = keys
+	for _, module := range a.modules {
+		if keyed, ok := module.(KeymapSetter); ok {
+			keyed.SetKeymap(keys)
+		}
+	}
+}
+
 // Init initializes the currently active module.
-func (a *App) Init() tea.Cmd {
+func (a *App) Init() (cmd tea.Cmd) {
+	defer func() {
+		if r := recover(); r != nil {
+			a.crashed(r, string(debug.Stack()))
+			cmd = tea.Quit
+		}
+	}()
+	return guard(a.init())
+}
+
+func (a *App) init() tea.Cmd {
 	mod := a.activeModule()
 	if mod == nil {
 		return nil
@@ -79,11 +117,32 @@ func (a *App) Init() tea.Cmd {
 		}
 	}
 
-	return tea.Batch(initialCmd, activateCmd)
+	return tea.Batch(initialCmd, activateCmd, a.autoRefreshTick())
+}
+
+// Update processes messages, handling global navigation and delegating to
+// the active module. A panic in a module or a command it returned quits
+// the shell; see Crash.
+func (a *App) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
+	if p, ok := msg.(panicMsg); ok {
+		a.crashed(p.value, p.stack)
+	}
+	if a.crash != nil {
+		return a, tea.Quit
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			a.crashed(r, string(debug.Stack()))
+			model, cmd = a, tea.Quit
+		}
+	}()
+
+	a.recordMessage(msg)
+	model, cmd = a.update(msg)
+	return model, guard(cmd)
 }
 
-// Update processes messages, handling global navigation and delegating to the active module.
-func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+func (a *App) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch m := msg.(type) {
 	case tea.KeyMsg:
 		if cmd, handled := a.handleKeyMsg(m); handled {
@@ -94,8 +153,13 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return a, nil
 		}
 	case StatusMsg:
+		if m.IsError {
+			log.Error("tui error", "module", a.activeID, "message", m.Message)
+		}
 		a.status = &m
 		return a, nil
+	case autoRefreshMsg:
+		return a, a.handleAutoRefresh()
 	case BroadcastMsg:
 		cmd := a.handleBroadcast(m)
 		// Trivial wrapping to satisfy gocritic evalOrder rule
@@ -119,13 +183,47 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (a *App) handleKeyMsg(msg tea.KeyMsg) (tea.Cmd, bool) {
-	switch {
-	case msg.Type == tea.KeyCtrlC || (msg.Type == tea.KeyRunes && len(msg.Runes) == 1 && msg.Runes[0] == 'q'):
+	if a.prompt != nil {
+		return a.handlePromptKey(msg), true
+	}
+	if a.palette != nil {
+		return a.handlePaletteKey(msg), true
+	}
+
+	// A module taking text input gets every key except Ctrl+C
+	if capturer, ok := a.activeModule().(InputCapturer); ok && capturer.CapturingInput() && msg.Type != tea.KeyCtrlC {
+		return nil, false
+	}
+
+	if msg.Type == tea.KeyCtrlC || a.keys.Matches(msg, keymap.Quit) {
 		return tea.Quit, true
-	case msg.Type == tea.KeyTab:
+	}
+
+	// The help overlay swallows keys until it is closed
+	if a.showHelp {
+		if a.keys.Matches(msg, keymap.Help) || a.keys.Matches(msg, keymap.Back) {
+			a.showHelp = false
+		}
+		return nil, true
+	}
+
+	if cmd, ok := a.runQuickAction(msg); ok {
+		return cmd, true
+	}
+
+	switch {
+	case a.keys.Matches(msg, keymap.Help):
+		a.showHelp = true
+		return nil, true
+	case a.keys.Matches(msg, keymap.Refresh):
+		return a.refreshActive(false), true
+	case a.keys.Matches(msg, keymap.Palette):
+		a.openPalette()
+		return nil, true
+	case a.keys.Matches(msg, keymap.NextModule):
 		a.rotateModule(1)
 		return a.activateCurrentModule(false), true
-	case msg.Type == tea.KeyShiftTab:
+	case a.keys.Matches(msg, keymap.PrevModule):
 		a.rotateModule(-1)
 		return a.activateCurrentModule(false), true
 	case msg.Type == tea.KeyRunes && len(msg.Runes) == 1:
@@ -142,7 +240,7 @@ func (a *App) handleKeyMsg(msg tea.KeyMsg) (tea.Cmd, bool) {
 func (a *App) handleBroadcast(msg BroadcastMsg) tea.Cmd {
 	var cmds []tea.Cmd
 	for id, module := range a.modules {
-		if id == a.activeID {
+		if id == a.activeID && !msg.External {
 			continue
 		}
 		updated, cmd := module.Update(msg)
@@ -159,14 +257,33 @@ func (a *App) handleBroadcast(msg BroadcastMsg) tea.Cmd {
 	return tea.Batch(cmds...)
 }
 
-// View renders the navigation bar, active module view, and footer.
-func (a *App) View() string {
+// View renders the navigation bar, active module view, and footer. After
+// a panic it says where the crash report went until the next message
+// quits the shell.
+func (a *App) View() (view string) {
+	if a.crash == nil {
+		defer func() {
+			if r := recover(); r != nil {
+				a.crashed(r, string(debug.Stack()))
+				view = a.crashView()
+			}
+		}()
+		return a.view()
+	}
+	return a.crashView()
+}
+
+func (a *App) view() string {
 	var b strings.Builder
 
 	b.WriteString(a.renderNavigation())
 	b.WriteString("\n")
 
-	if mod := a.activeModule(); mod != nil {
+	if a.palette != nil {
+		b.WriteString(a.renderPalette())
+	} else if a.showHelp {
+		b.WriteString(a.renderHelp())
+	} else if mod := a.activeModule(); mod != nil {
 		b.WriteString(mod.View())
 	} else {
 		b.WriteString("No module available.")
@@ -193,29 +310,39 @@ func (a *App) renderNavigation() string {
 		}
 		label := fmt.Sprintf("%d·%s", idx+1, mod.Title())
 		if id == a.activeID {
-			items = append(items, activeNavStyle.Render(label))
+			items = append(items, styles.Accent().Render(label))
 		} else {
 			items = append(items, navStyle.Render(label))
 		}
 	}
 
-	return borderStyle.Render(strings.Join(items, " │ "))
+	return styles.Border().Render(strings.Join(items, " │ "))
 }
 
 func (a *App) renderFooter() string {
+	if a.prompt != nil {
+		return a.renderPrompt()
+	}
+	if a.palette != nil {
+		return a.renderPaletteHelp()
+	}
+
 	module := a.activeModule()
 	additional := 0
 	if module != nil {
 		additional = len(module.Shortcuts())
 	}
-	parts := make([]string, 0, len(globalShortcuts)+additional)
-
-	global := globalShortcuts
+	global := a.globalShortcuts()
+	parts := make([]string, 0, len(global)+additional)
 
 	for _, sc := range global {
 		parts = append(parts, fmt.Sprintf("%s %s", navStyle.Render(sc.Key), sc.Description))
 	}
 
+	for _, sc := range a.quickActionShortcuts() {
+		parts = append(parts, fmt.Sprintf("%s %s", navStyle.Render(sc.Key), sc.Description))
+	}
+
 	if module != nil {
 		for _, sc := range module.Shortcuts() {
 			parts = append(parts, fmt.Sprintf("%s %s", navStyle.Render(sc.Key), sc.Description))
@@ -224,9 +351,9 @@ func (a *App) renderFooter() string {
 
 	status := ""
 	if a.status != nil {
-		style := statusStyle
+		style := styles.Muted()
 		if a.status.IsError {
-			style = errorStatusStyle
+			style = styles.Error()
 		}
 		status = style.Render(a.status.Message)
 	}
@@ -310,8 +437,55 @@ func (a *App) activateCurrentModule(initial bool) tea.Cmd {
 	return tea.Batch(cmds...)
 }
 
-var globalShortcuts = []Shortcut{
-	{Key: "Tab/Shift+Tab", Description: "switch module"},
-	{Key: "1…9", Description: "jump to module"},
-	{Key: "q", Description: "quit"},
+// globalShortcuts returns the footer hints for the shell's own keys.
+func (a *App) globalShortcuts() []Shortcut {
+	return []Shortcut{
+		{Key: a.keys.Label(keymap.NextModule) + "/" + a.keys.Label(keymap.PrevModule), Description: "switch module"},
+		{Key: "1…9", Description: "jump to module"},
+		{Key: a.keys.Label(keymap.Help), Description: "keys"},
+		{Key: a.keys.Label(keymap.Palette), Description: "commands"},
+		{Key: a.keys.Label(keymap.Refresh), Description: "refresh"},
+		{Key: a.keys.Label(keymap.Quit), Description: "quit"},
+	}
+}
+
+// renderHelp lists every binding: the shell's, the quick actions, and the
+// active module's.
+func (a *App) renderHelp() string {
+	var b strings.Builder
+
+	writeSection := func(title string, shortcuts []Shortcut) {
+		if len(shortcuts) == 0 {
+			return
+		}
+		width := 0
+		for _, sc := range shortcuts {
+			width = max(width, lipgloss.Width(sc.Key))
+		}
+		b.WriteString("\n" + styles.Accent().Render(title) + "\n")
+		for _, sc := range shortcuts {
+			pad := strings.Repeat(" ", width-lipgloss.Width(sc.Key))
+			fmt.Fprintf(&b, "  %s%s  %s\n", navStyle.Render(sc.Key), pad, sc.Description)
+		}
+	}
+
+	global := []Shortcut{{Key: "ctrl+c", Description: "quit"}}
+	for _, action := range []keymap.Action{keymap.Quit, keymap.NextModule, keymap.PrevModule, keymap.Help, keymap.Refresh, keymap.Palette} {
+		global = append(global, Shortcut{Key: a.keys.Label(action), Description: a.keys.Help(action)})
+	}
+	global = append(global, Shortcut{Key: "1…9", Description: "jump to module"})
+	writeSection("Global", global)
+	writeSection("Quick actions", a.quickActionShortcuts())
+
+	if mod := a.activeModule(); mod != nil {
+		shortcuts := mod.Shortcuts()
+		if provider, ok := mod.(HelpProvider); ok {
+			shortcuts = provider.Help()
+		}
+		writeSection(mod.Title(), shortcuts)
+	}
+
+	b.WriteString("\n" + styles.Muted().Render(fmt.Sprintf("Remap keys under [tui.keys] in config.toml · %s or %s to close",
+		a.keys.Label(keymap.Help), a.keys.Label(keymap.Back))))
+	return b.String()
 }
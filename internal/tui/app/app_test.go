@@ -7,17 +7,19 @@ import (
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pezware/samedi.dev/internal/tui/keymap"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 // MockModule is a test implementation of the Module interface
 type MockModule struct {
-	id        string
-	title     string
-	shortcuts []Shortcut
-	initCalls int
-	viewCalls int
+	id         string
+	title      string
+	shortcuts  []Shortcut
+	initCalls  int
+	viewCalls  int
+	broadcasts int
 }
 
 func NewMockModule(id, title string) *MockModule {
@@ -35,7 +37,10 @@ func (m *MockModule) Init() tea.Cmd {
 	return nil
 }
 
-func (m *MockModule) Update(_ tea.Msg) (tea.Model, tea.Cmd) {
+func (m *MockModule) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if _, ok := msg.(BroadcastMsg); ok {
+		m.broadcasts++
+	}
 	return m, nil
 }
 
@@ -155,6 +160,39 @@ func TestUpdate_CtrlC_ReturnsQuitCmd(t *testing.T) {
 	assert.NotNil(t, cmd)
 }
 
+// capturingModule is a MockModule with a text input focused.
+type capturingModule struct {
+	*MockModule
+	keys int
+}
+
+func (m *capturingModule) CapturingInput() bool {
+	return true
+}
+
+func (m *capturingModule) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if _, ok := msg.(tea.KeyMsg); ok {
+		m.keys++
+	}
+	return m, nil
+}
+
+func TestUpdate_CapturingModule_ReceivesGlobalKeys(t *testing.T) {
+	capturing := &capturingModule{MockModule: NewMockModule("first", "First")}
+	app, _ := New([]Module{capturing, NewMockModule("second", "Second")})
+
+	_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	assert.Nil(t, cmd)
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'2'}})
+	app.Update(tea.KeyMsg{Type: tea.KeyTab})
+
+	assert.Equal(t, "first", app.activeID)
+	assert.Equal(t, 3, capturing.keys)
+
+	_, cmd = app.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+	assert.NotNil(t, cmd, "Ctrl+C still quits")
+}
+
 func TestUpdate_TabKey_RotatesModuleForward(t *testing.T) {
 	modules := []Module{
 		NewMockModule("first", "First"),
@@ -234,6 +272,30 @@ func TestUpdate_ErrorStatusMsg_SetsErrorStatus(t *testing.T) {
 
 // Tests for App.Update with WindowSizeMsg
 
+func TestUpdate_BroadcastMsg_SkipsActiveModule(t *testing.T) {
+	plans := NewMockModule("plans", "Plans")
+	stats := NewMockModule("stats", "Stats")
+	app, err := New([]Module{plans, stats})
+	require.NoError(t, err)
+
+	app.Update(BroadcastMsg{Topic: TopicPlansChanged, Payload: "rust"})
+
+	assert.Equal(t, 0, plans.broadcasts)
+	assert.Equal(t, 1, stats.broadcasts)
+}
+
+func TestUpdate_ExternalBroadcastMsg_ReachesActiveModule(t *testing.T) {
+	plans := NewMockModule("plans", "Plans")
+	stats := NewMockModule("stats", "Stats")
+	app, err := New([]Module{plans, stats})
+	require.NoError(t, err)
+
+	app.Update(BroadcastMsg{Topic: TopicPlansChanged, Payload: "rust", External: true})
+
+	assert.Equal(t, 1, plans.broadcasts)
+	assert.Equal(t, 1, stats.broadcasts)
+}
+
 func TestUpdate_WindowSizeMsg_UpdatesDimensions(t *testing.T) {
 	modules := []Module{NewMockModule("test", "Test")}
 	app, _ := New(modules)
@@ -286,6 +348,65 @@ func TestView_RendersFooterWithShortcuts(t *testing.T) {
 	assert.Contains(t, view, "enter")
 }
 
+// keyedModule records the keymap the shell passes to it.
+type keyedModule struct {
+	*MockModule
+	keys *keymap.Keymap
+}
+
+func (m *keyedModule) SetKeymap(keys *keymap.Keymap) {
+	m.keys = keys
+}
+
+func (m *keyedModule) Help() []Shortcut {
+	return []Shortcut{{Key: m.keys.Label(keymap.Select), Description: "open the thing"}}
+}
+
+func TestSetKeymap_RemapsShellKeys(t *testing.T) {
+	keyed := &keyedModule{MockModule: NewMockModule("first", "First")}
+	app, _ := New([]Module{keyed, NewMockModule("second", "Second")})
+	keys, err := keymap.New(map[string][]string{"quit": {"ctrl+q"}, "next_module": {"]"}})
+	require.NoError(t, err)
+
+	app.SetKeymap(keys)
+	assert.Same(t, keys, keyed.keys, "modules receive the shell's keymap")
+
+	_, cmd := app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	assert.Nil(t, cmd, "q no longer quits")
+	app.Update(tea.KeyMsg{Type: tea.KeyTab})
+	assert.Equal(t, "first", app.activeID, "Tab no longer switches")
+
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{']'}})
+	assert.Equal(t, "second", app.activeID)
+	_, cmd = app.Update(tea.KeyMsg{Type: tea.KeyCtrlQ})
+	assert.NotNil(t, cmd)
+
+	footer := app.renderFooter()
+	assert.Contains(t, footer, "]/Shift+Tab")
+	assert.Contains(t, footer, "ctrl+q")
+}
+
+func TestUpdate_HelpKey_TogglesOverlay(t *testing.T) {
+	keyed := &keyedModule{MockModule: NewMockModule("first", "First")}
+	app, _ := New([]Module{keyed, NewMockModule("second", "Second")})
+	keys, err := keymap.New(map[string][]string{"select": {"o"}})
+	require.NoError(t, err)
+	app.SetKeymap(keys)
+
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}})
+	view := app.View()
+	assert.Contains(t, view, "Global")
+	assert.Contains(t, view, "next module")
+	assert.Contains(t, view, "open the thing", "the module's help replaces its shortcuts")
+	assert.NotContains(t, view, "First view")
+
+	app.Update(tea.KeyMsg{Type: tea.KeyTab})
+	assert.Equal(t, "first", app.activeID, "the overlay swallows keys")
+
+	app.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Contains(t, app.View(), "First view")
+}
+
 func TestView_WithStatus_RendersStatus(t *testing.T) {
 	modules := []Module{NewMockModule("test", "Test")}
 	app, _ := New(modules)
@@ -0,0 +1,141 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package app
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pezware/samedi.dev/internal/log"
+)
+
+// recentMessages is how many of the latest messages a crash report lists.
+const recentMessages = 20
+
+// Crash is a panic the shell recovered from. The shell quits after one,
+// so Bubble Tea restores the terminal before the caller reports it.
+type Crash struct {
+	Time   time.Time
+	Module string // Active module when it panicked
+	Panic  string
+	Stack  string
+	Path   string // Crash report file; empty if none was written
+	Err    error  // Why no report was written
+}
+
+// panicMsg carries a panic recovered in a command back to Update.
+type panicMsg struct {
+	value any
+	stack string
+}
+
+// SetCrashReports makes the shell write a report to dir when it recovers
+// from a panic. build describes the running binary, e.g. its version.
+func (a *App) SetCrashReports(dir, build string) {
+	a.crashDir = dir
+	a.build = build
+}
+
+// Crash returns the panic the shell recovered from, or nil.
+func (a *App) Crash() *Crash {
+	return a.crash
+}
+
+// recordMessage remembers msg for a crash report. Only its type, and for
+// keys which key, is kept: messages can carry notes and plan content.
+func (a *App) recordMessage(msg tea.Msg) {
+	desc := fmt.Sprintf("%T", msg)
+	if key, ok := msg.(tea.KeyMsg); ok {
+		desc += fmt.Sprintf(" %q", key.String())
+	}
+	a.recent = append(a.recent, desc)
+	if len(a.recent) > recentMessages {
+		a.recent = a.recent[len(a.recent)-recentMessages:]
+	}
+}
+
+// crashed records a recovered panic and writes its report.
+func (a *App) crashed(value any, stack string) {
+	if a.crash != nil {
+		return
+	}
+	crash := &Crash{
+		Time:   time.Now(),
+		Module: a.activeID,
+		Panic:  fmt.Sprint(value),
+		Stack:  stack,
+	}
+	if a.crashDir == "" {
+		crash.Err = fmt.Errorf("no crash report directory")
+	} else {
+		crash.Path, crash.Err = a.writeCrashReport(crash)
+	}
+	log.Error("tui panic", "module", crash.Module, "panic", crash.Panic, "report", crash.Path)
+	a.crash = crash
+}
+
+// writeCrashReport writes crash to a new file in the crash directory and
+// returns its path.
+func (a *App) writeCrashReport(crash *Crash) (string, error) {
+	if err := os.MkdirAll(a.crashDir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create crash directory: %w", err)
+	}
+
+	var b strings.Builder
+	b.WriteString("samedi crash report\n\n")
+	fmt.Fprintf(&b, "time:   %s\n", crash.Time.Format(time.RFC3339))
+	fmt.Fprintf(&b, "build:  %s\n", a.build)
+	fmt.Fprintf(&b, "go:     %s %s/%s\n", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	fmt.Fprintf(&b, "module: %s\n", crash.Module)
+	fmt.Fprintf(&b, "panic:  %s\n\n", crash.Panic)
+	b.WriteString("recent messages, oldest first:\n")
+	for _, msg := range a.recent {
+		fmt.Fprintf(&b, "  %s\n", msg)
+	}
+	fmt.Fprintf(&b, "\nstack:\n%s", crash.Stack)
+
+	path := filepath.Join(a.crashDir, "crash-"+crash.Time.Format("20060102-150405")+".txt")
+	if err := os.WriteFile(path, []byte(b.String()), 0o600); err != nil {
+		return "", fmt.Errorf("failed to write crash report: %w", err)
+	}
+	return path, nil
+}
+
+// crashView replaces the shell's view once it has crashed.
+func (a *App) crashView() string {
+	if a.crash.Path == "" {
+		return "samedi crashed. Press any key to exit.\n"
+	}
+	return fmt.Sprintf("samedi crashed; report saved to %s. Press any key to exit.\n", a.crash.Path)
+}
+
+// guard runs cmd, turning a panic into a panicMsg for Update. Commands a
+// batch returns are guarded too.
+func guard(cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	return func() (msg tea.Msg) {
+		defer func() {
+			if r := recover(); r != nil {
+				msg = panicMsg{value: r, stack: string(debug.Stack())}
+			}
+		}()
+		msg = cmd()
+		if batch, ok := msg.(tea.BatchMsg); ok {
+			guarded := make(tea.BatchMsg, len(batch))
+			for i, c := range batch {
+				guarded[i] = guard(c)
+			}
+			return guarded
+		}
+		return msg
+	}
+}
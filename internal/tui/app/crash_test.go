@@ -0,0 +1,132 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// panickingModule panics on "p" in Update, on "c" in the command it
+// returns, and in View once viewPanics is set.
+type panickingModule struct {
+	*MockModule
+	viewPanics bool
+}
+
+func (m *panickingModule) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if key, ok := msg.(tea.KeyMsg); ok {
+		switch key.String() {
+		case "p":
+			panic("boom in update")
+		case "c":
+			return m, tea.Batch(
+				func() tea.Msg { return nil },
+				func() tea.Msg { panic("boom in command") },
+			)
+		}
+	}
+	return m, nil
+}
+
+func (m *panickingModule) View() string {
+	if m.viewPanics {
+		panic("boom in view")
+	}
+	return "ok"
+}
+
+func newCrashingApp(t *testing.T) (*App, *panickingModule, string) {
+	t.Helper()
+	mod := &panickingModule{MockModule: NewMockModule("plans", "Plans")}
+	a, err := New([]Module{mod})
+	require.NoError(t, err)
+	dir := t.TempDir()
+	a.SetCrashReports(dir, "samedi 1.2.3")
+	return a, mod, dir
+}
+
+func keyMsg(r rune) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}}
+}
+
+func TestApp_PanicInUpdate_WritesCrashReport(t *testing.T) {
+	a, _, dir := newCrashingApp(t)
+
+	a.Update(keyMsg('j'))
+	_, cmd := a.Update(keyMsg('p'))
+	require.NotNil(t, cmd)
+	assert.IsType(t, tea.QuitMsg{}, cmd())
+
+	crash := a.Crash()
+	require.NotNil(t, crash)
+	assert.Equal(t, "boom in update", crash.Panic)
+	assert.Equal(t, "plans", crash.Module)
+	require.NoError(t, crash.Err)
+	assert.Equal(t, dir, filepath.Dir(crash.Path))
+
+	data, err := os.ReadFile(crash.Path)
+	require.NoError(t, err)
+	report := string(data)
+	assert.Contains(t, report, "build:  samedi 1.2.3")
+	assert.Contains(t, report, "panic:  boom in update")
+	assert.Contains(t, report, `tea.KeyMsg "j"`)
+	assert.Contains(t, report, "crash_test.go") // Stack
+	assert.Contains(t, a.View(), crash.Path)
+
+	// Any further message quits
+	_, cmd = a.Update(keyMsg('j'))
+	assert.IsType(t, tea.QuitMsg{}, cmd())
+}
+
+func TestApp_PanicInCommand_ReachesUpdate(t *testing.T) {
+	a, _, _ := newCrashingApp(t)
+
+	_, cmd := a.Update(keyMsg('c'))
+	require.NotNil(t, cmd)
+	batch, ok := cmd().(tea.BatchMsg)
+	require.True(t, ok)
+	require.Len(t, batch, 2)
+
+	msg := batch[1]()
+	_, cmd = a.Update(msg)
+	assert.IsType(t, tea.QuitMsg{}, cmd())
+	require.NotNil(t, a.Crash())
+	assert.Equal(t, "boom in command", a.Crash().Panic)
+}
+
+func TestApp_PanicInView_ShowsCrash(t *testing.T) {
+	a, mod, _ := newCrashingApp(t)
+	mod.viewPanics = true
+
+	assert.Contains(t, a.View(), "samedi crashed")
+	require.NotNil(t, a.Crash())
+	assert.Equal(t, "boom in view", a.Crash().Panic)
+}
+
+func TestApp_CrashWithoutDirectory(t *testing.T) {
+	mod := &panickingModule{MockModule: NewMockModule("plans", "Plans")}
+	a, err := New([]Module{mod})
+	require.NoError(t, err)
+
+	a.Update(keyMsg('p'))
+	require.NotNil(t, a.Crash())
+	assert.Empty(t, a.Crash().Path)
+	assert.Error(t, a.Crash().Err)
+}
+
+func TestApp_RecordMessage_KeepsLatest(t *testing.T) {
+	a, _, _ := newCrashingApp(t)
+	for i := 0; i < recentMessages+5; i++ {
+		a.recordMessage(keyMsg('j'))
+	}
+	a.recordMessage(tea.WindowSizeMsg{})
+	assert.Len(t, a.recent, recentMessages)
+	assert.Equal(t, "tea.WindowSizeMsg", a.recent[len(a.recent)-1])
+}
@@ -3,7 +3,10 @@
 
 package app
 
-import tea "github.com/charmbracelet/bubbletea"
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pezware/samedi.dev/internal/tui/keymap"
+)
 
 // Module defines the interface that all TUI modules must implement.
 // Modules are standard Bubble Tea models with additional metadata used
@@ -21,6 +24,32 @@ type Module interface {
 	Shortcuts() []Shortcut
 }
 
+// InputCapturer is implemented by modules with text inputs. While
+// CapturingInput returns true, the shell passes keys such as 'q', Tab, and
+// module numbers to the module instead of handling them itself.
+type InputCapturer interface {
+	CapturingInput() bool
+}
+
+// KeymapSetter is implemented by modules whose keys can be remapped. The
+// shell passes its keymap to them in SetKeymap.
+type KeymapSetter interface {
+	SetKeymap(keys *keymap.Keymap)
+}
+
+// HelpProvider is implemented by modules with more bindings than fit in
+// the footer. The help overlay lists Help instead of Shortcuts.
+type HelpProvider interface {
+	Help() []Shortcut
+}
+
+// CommandProvider is implemented by modules with entries in the command
+// palette. Commands is called each time the palette opens, so the entries
+// can follow the module's data, such as one per plan.
+type CommandProvider interface {
+	Commands() []Command
+}
+
 // Shortcut describes a keyboard shortcut exposed by a module or the shell.
 type Shortcut struct {
 	Key         string
@@ -45,9 +74,17 @@ type StatusMsg struct {
 type BroadcastMsg struct {
 	Topic   string
 	Payload interface{}
+
+	// External marks events that no module sent (e.g. a plan edited in
+	// another editor, or a quick action). They reach every module,
+	// including the active one; module-originated broadcasts skip their
+	// sender.
+	External bool
 }
 
 // Predefined broadcast topics.
 const (
-	TopicPlansChanged = "plan:changed"
+	TopicPlansChanged    = "plan:changed"
+	TopicSessionsChanged = "session:changed"
+	TopicReviewsChanged  = "review:changed"
 )
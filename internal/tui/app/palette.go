@@ -0,0 +1,239 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+	"github.com/pezware/samedi.dev/internal/tui/keymap"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
+)
+
+// paletteRows is how many matches the command palette shows at once.
+const paletteRows = 12
+
+// Command is an entry a module lists in the command palette. Choosing it
+// makes the module active and sends it Msg, which the module handles as
+// it would the equivalent key.
+type Command struct {
+	Title string  // e.g. "Open plan: Rust async"
+	Hint  string  // Shown after the title and searched too, e.g. a plan ID
+	Msg   tea.Msg // Sent to the module that listed the command
+}
+
+// paletteEntry is a line of the command palette.
+type paletteEntry struct {
+	title  string
+	hint   string
+	module string // Title of the module the entry belongs to, if any
+	run    func() tea.Cmd
+}
+
+// paletteMatch is an entry matching the palette's query.
+type paletteMatch struct {
+	entry     paletteEntry
+	score     int
+	positions []int // Matched runes of the title
+}
+
+// palette is the command palette overlay: a query, the entries it was
+// opened with, and those matching the query.
+type palette struct {
+	query   []rune
+	entries []paletteEntry
+	matches []paletteMatch
+	cursor  int
+}
+
+// openPalette collects the commands of every module, the quick actions,
+// and the shell's own actions into the palette. The active module's
+// commands come first.
+func (a *App) openPalette() {
+	var entries []paletteEntry
+	ids := append([]string{a.activeID}, a.order...)
+	seen := make(map[string]bool, len(a.order))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		provider, ok := a.modules[id].(CommandProvider)
+		if !ok {
+			continue
+		}
+		for _, command := range provider.Commands() {
+			entries = append(entries, paletteEntry{
+				title:  command.Title,
+				hint:   command.Hint,
+				module: a.modules[id].Title(),
+				run:    a.commandRunner(id, command.Msg),
+			})
+		}
+	}
+
+	for _, key := range a.quickOrder {
+		action := a.quickActions[key]
+		entries = append(entries, paletteEntry{
+			title: action.Description,
+			hint:  key,
+			run:   func() tea.Cmd { return a.startQuickAction(action) },
+		})
+	}
+
+	for idx, id := range a.order {
+		entries = append(entries, paletteEntry{
+			title: "Go to " + a.modules[id].Title(),
+			hint:  fmt.Sprint(idx + 1),
+			run:   a.moduleSwitcher(id),
+		})
+	}
+	entries = append(entries,
+		paletteEntry{title: "Refresh", hint: a.keys.Label(keymap.Refresh), run: func() tea.Cmd { return a.refreshActive(false) }},
+		paletteEntry{title: "Show all keys", hint: a.keys.Label(keymap.Help), run: func() tea.Cmd {
+			a.showHelp = true
+			return nil
+		}},
+	)
+
+	a.palette = &palette{entries: entries}
+	a.palette.filter()
+}
+
+// commandRunner returns a palette action that activates module id and
+// sends it msg.
+func (a *App) commandRunner(id string, msg tea.Msg) func() tea.Cmd {
+	return func() tea.Cmd {
+		activate := a.moduleSwitcher(id)()
+		updated, cmd := a.modules[id].Update(msg)
+		if updatedModule, ok := updated.(Module); ok {
+			a.modules[id] = updatedModule
+		}
+		return tea.Batch(activate, cmd)
+	}
+}
+
+// moduleSwitcher returns a palette action that activates module id.
+func (a *App) moduleSwitcher(id string) func() tea.Cmd {
+	return func() tea.Cmd {
+		if id == a.activeID {
+			return nil
+		}
+		a.activeID = id
+		return a.activateCurrentModule(false)
+	}
+}
+
+// filter matches the entries against the query, best first. Ties keep
+// the palette's order.
+func (p *palette) filter() {
+	query := string(p.query)
+	p.matches = p.matches[:0]
+	for _, entry := range p.entries {
+		score, positions, ok := components.FuzzyMatch(query, entry.title)
+		if !ok {
+			// A plan ID or key can find the entry too
+			if score, _, ok = components.FuzzyMatch(query, entry.hint); !ok {
+				continue
+			}
+			positions = nil
+		}
+		p.matches = append(p.matches, paletteMatch{entry: entry, score: score, positions: positions})
+	}
+	sort.SliceStable(p.matches, func(i, j int) bool {
+		return p.matches[i].score > p.matches[j].score
+	})
+	p.cursor = 0
+}
+
+// handlePaletteKey edits the query and moves through the matches. Enter
+// runs the highlighted entry; Esc or the palette key closes the palette.
+func (a *App) handlePaletteKey(msg tea.KeyMsg) tea.Cmd {
+	p := a.palette
+	switch {
+	case msg.Type == tea.KeyCtrlC:
+		return tea.Quit
+	case msg.Type == tea.KeyEsc || a.keys.Matches(msg, keymap.Palette):
+		a.palette = nil
+	case msg.Type == tea.KeyEnter:
+		a.palette = nil
+		if p.cursor < len(p.matches) {
+			return p.matches[p.cursor].entry.run()
+		}
+	case msg.Type == tea.KeyUp:
+		if p.cursor > 0 {
+			p.cursor--
+		}
+	case msg.Type == tea.KeyDown:
+		if p.cursor < len(p.matches)-1 {
+			p.cursor++
+		}
+	case msg.Type == tea.KeyBackspace:
+		if len(p.query) > 0 {
+			p.query = p.query[:len(p.query)-1]
+			p.filter()
+		}
+	case msg.Type == tea.KeySpace:
+		p.query = append(p.query, ' ')
+		p.filter()
+	case msg.Type == tea.KeyRunes:
+		p.query = append(p.query, msg.Runes...)
+		p.filter()
+	}
+	return nil
+}
+
+// renderPalette renders the query and a page of matches around the
+// cursor, with the matched letters highlighted.
+func (a *App) renderPalette() string {
+	p := a.palette
+	var b strings.Builder
+
+	b.WriteString(styles.Title().Render("Command palette"))
+	b.WriteString("\n\n")
+	fmt.Fprintf(&b, "%s %s█\n\n", navStyle.Render(">"), string(p.query))
+
+	if len(p.matches) == 0 {
+		b.WriteString(styles.Muted().Render("No matching commands."))
+		return b.String()
+	}
+
+	start := max(0, min(p.cursor-paletteRows/2, len(p.matches)-paletteRows))
+	end := min(len(p.matches), start+paletteRows)
+	width := 0
+	for _, match := range p.matches[start:end] {
+		width = max(width, components.Width(match.entry.title))
+	}
+	for i := start; i < end; i++ {
+		match := p.matches[i]
+		title := components.Highlight(match.entry.title, match.positions, styles.Accent(), lipgloss.NewStyle())
+		title += strings.Repeat(" ", width-components.Width(match.entry.title))
+		detail := match.entry.hint
+		if match.entry.module != "" {
+			detail = strings.TrimPrefix(detail+" · "+match.entry.module, " · ")
+		}
+
+		if i == p.cursor {
+			b.WriteString(styles.Selected().Render("▸ "+title) + "  " + styles.Muted().Render(detail))
+		} else {
+			b.WriteString("  " + title + "  " + styles.Muted().Render(detail))
+		}
+		b.WriteString("\n")
+	}
+	if len(p.matches) > paletteRows {
+		b.WriteString(styles.Muted().Render(fmt.Sprintf("%d of %d", p.cursor+1, len(p.matches))))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// renderPaletteHelp renders the palette's keys in place of the footer
+// hints.
+func (a *App) renderPaletteHelp() string {
+	return styles.Muted().Render("[↑/↓] Move  |  [Enter] Run  |  [Esc] Close")
+}
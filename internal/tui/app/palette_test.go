@@ -0,0 +1,140 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package app
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// commandModule is a MockModule with palette commands. It records the
+// messages those commands send.
+type commandModule struct {
+	*MockModule
+	commands []Command
+	received []tea.Msg
+}
+
+func (m *commandModule) Commands() []Command {
+	return m.commands
+}
+
+func (m *commandModule) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if _, ok := msg.(string); ok {
+		m.received = append(m.received, msg)
+	}
+	return m, nil
+}
+
+func newPaletteApp(t *testing.T) (*App, *commandModule) {
+	t.Helper()
+	plans := &commandModule{
+		MockModule: NewMockModule("plans", "Plans"),
+		commands: []Command{
+			{Title: "New plan", Hint: "n", Msg: "new"},
+			{Title: "Open plan: Rust async", Hint: "rust-async", Msg: "open rust-async"},
+		},
+	}
+	shell, err := New([]Module{NewMockModule("sessions", "Sessions"), plans})
+	require.NoError(t, err)
+	return shell, plans
+}
+
+func typeQuery(shell *App, query string) {
+	shell.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(query)})
+}
+
+func TestPalette_RunsModuleCommand(t *testing.T) {
+	shell, plans := newPaletteApp(t)
+
+	shell.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	require.NotNil(t, shell.palette)
+	view := shell.View()
+	assert.Contains(t, view, "Command palette")
+	assert.Contains(t, view, "Open plan: Rust async")
+	assert.Contains(t, view, "Go to Plans")
+
+	typeQuery(shell, "rust")
+	require.NotEmpty(t, shell.palette.matches)
+	assert.Equal(t, "Open plan: Rust async", shell.palette.matches[0].entry.title)
+
+	shell.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, shell.palette)
+	assert.Equal(t, "plans", shell.activeID, "the command's module becomes active")
+	assert.Equal(t, []tea.Msg{"open rust-async"}, plans.received)
+}
+
+func TestPalette_MatchesHints(t *testing.T) {
+	shell, _ := newPaletteApp(t)
+	shell.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+
+	typeQuery(shell, "rust-async")
+	require.Len(t, shell.palette.matches, 1)
+	assert.Empty(t, shell.palette.matches[0].positions, "nothing to highlight in the title")
+
+	typeQuery(shell, "zzz")
+	assert.Empty(t, shell.palette.matches)
+	assert.Contains(t, shell.View(), "No matching commands")
+}
+
+func TestPalette_TypesShellKeysAndCloses(t *testing.T) {
+	shell, plans := newPaletteApp(t)
+	shell.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+
+	// 'q' and digits go into the query instead of quitting or switching
+	_, cmd := shell.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	assert.Nil(t, cmd)
+	typeQuery(shell, "2")
+	assert.Equal(t, "q2", string(shell.palette.query))
+	assert.Equal(t, "sessions", shell.activeID)
+
+	shell.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Nil(t, shell.palette)
+	assert.Empty(t, plans.received)
+
+	// The palette key toggles it too
+	shell.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	shell.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	assert.Nil(t, shell.palette)
+}
+
+func TestPalette_ListsQuickActionsAndModules(t *testing.T) {
+	shell, _ := newPaletteApp(t)
+	ran := false
+	shell.SetQuickActions([]QuickAction{{
+		Key:         "f2",
+		Description: "start next",
+		Run: func(string) tea.Cmd {
+			ran = true
+			return nil
+		},
+	}})
+
+	shell.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	typeQuery(shell, "start next")
+	shell.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.True(t, ran)
+
+	shell.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	typeQuery(shell, "go plans")
+	shell.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, "plans", shell.activeID)
+}
+
+func TestPalette_MovesThroughMatches(t *testing.T) {
+	shell, plans := newPaletteApp(t)
+	shell.activeID = "plans"
+	shell.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+
+	// The active module's commands come first
+	shell.Update(tea.KeyMsg{Type: tea.KeyDown})
+	shell.Update(tea.KeyMsg{Type: tea.KeyDown})
+	shell.Update(tea.KeyMsg{Type: tea.KeyUp})
+	shell.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	assert.Equal(t, []tea.Msg{"open rust-async"}, plans.received)
+}
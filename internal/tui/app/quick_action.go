@@ -0,0 +1,105 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package app
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
+)
+
+// QuickAction is a shell-wide key binding that runs a composed command
+// (e.g. "start the next chunk of the pinned plan") whichever module is
+// active. Actions report back with StatusMsg and BroadcastMsg.
+type QuickAction struct {
+	Key         string // As reported by tea.KeyMsg.String(), e.g. "f2"
+	Description string // Footer hint, e.g. "start next"
+
+	// Prompt, if set, makes the shell ask for a line of input first; the
+	// answer is passed to Run. Esc cancels without running the action.
+	Prompt string
+
+	Run func(input string) tea.Cmd
+}
+
+// quickPrompt is the one-line input shown while a quick action asks for
+// its input.
+type quickPrompt struct {
+	action QuickAction
+	value  []rune
+}
+
+// SetQuickActions binds quick actions in the shell. Later actions win
+// when two share a key.
+func (a *App) SetQuickActions(actions []QuickAction) {
+	a.quickActions = make(map[string]QuickAction, len(actions))
+	a.quickOrder = a.quickOrder[:0]
+	for _, action := range actions {
+		if _, exists := a.quickActions[action.Key]; !exists {
+			a.quickOrder = append(a.quickOrder, action.Key)
+		}
+		a.quickActions[action.Key] = action
+	}
+}
+
+// runQuickAction starts the action bound to the key, if any.
+func (a *App) runQuickAction(msg tea.KeyMsg) (tea.Cmd, bool) {
+	action, ok := a.quickActions[msg.String()]
+	if !ok {
+		return nil, false
+	}
+	return a.startQuickAction(action), true
+}
+
+// startQuickAction runs action, or first asks for its input.
+func (a *App) startQuickAction(action QuickAction) tea.Cmd {
+	if action.Prompt != "" {
+		a.prompt = &quickPrompt{action: action}
+		return nil
+	}
+	return action.Run("")
+}
+
+// handlePromptKey edits the quick action prompt. Enter runs the action
+// with the typed value and Esc cancels.
+func (a *App) handlePromptKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return tea.Quit
+	case tea.KeyEsc:
+		a.prompt = nil
+		return nil
+	case tea.KeyEnter:
+		action, value := a.prompt.action, string(a.prompt.value)
+		a.prompt = nil
+		return action.Run(value)
+	case tea.KeyBackspace:
+		if len(a.prompt.value) > 0 {
+			a.prompt.value = a.prompt.value[:len(a.prompt.value)-1]
+		}
+	case tea.KeySpace:
+		a.prompt.value = append(a.prompt.value, ' ')
+	case tea.KeyRunes:
+		a.prompt.value = append(a.prompt.value, msg.Runes...)
+	}
+	return nil
+}
+
+// renderPrompt renders the quick action prompt in place of the footer hints.
+func (a *App) renderPrompt() string {
+	return fmt.Sprintf("%s %s█\n%s",
+		navStyle.Render(a.prompt.action.Prompt+":"),
+		string(a.prompt.value),
+		styles.Muted().Render("[Enter] Run  |  [Esc] Cancel"))
+}
+
+// quickActionShortcuts returns footer hints for the bound quick actions.
+func (a *App) quickActionShortcuts() []Shortcut {
+	shortcuts := make([]Shortcut, 0, len(a.quickOrder))
+	for _, key := range a.quickOrder {
+		shortcuts = append(shortcuts, Shortcut{Key: key, Description: a.quickActions[key].Description})
+	}
+	return shortcuts
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package app
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newQuickActionApp(t *testing.T, actions ...QuickAction) *App {
+	t.Helper()
+	shell, err := New([]Module{NewMockModule("plans", "Plans")})
+	require.NoError(t, err)
+	shell.SetQuickActions(actions)
+	return shell
+}
+
+func TestQuickAction_RunsFromAnyModule(t *testing.T) {
+	var ran int
+	shell := newQuickActionApp(t, QuickAction{
+		Key:         "f2",
+		Description: "start next",
+		Run: func(string) tea.Cmd {
+			ran++
+			return func() tea.Msg { return StatusMsg{Message: "Started"} }
+		},
+	})
+
+	_, cmd := shell.Update(tea.KeyMsg{Type: tea.KeyF2})
+	require.NotNil(t, cmd)
+	assert.Equal(t, 1, ran)
+	assert.Equal(t, StatusMsg{Message: "Started"}, cmd())
+
+	assert.Contains(t, shell.View(), "start next")
+}
+
+func TestQuickAction_PromptsForInput(t *testing.T) {
+	var got string
+	shell := newQuickActionApp(t, QuickAction{
+		Key:    "f3",
+		Prompt: "Session notes",
+		Run: func(input string) tea.Cmd {
+			got = input
+			return nil
+		},
+	})
+
+	shell.Update(tea.KeyMsg{Type: tea.KeyF3})
+	assert.Contains(t, shell.View(), "Session notes:")
+
+	// 'q' is typed into the prompt instead of quitting
+	shell.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	shell.Update(tea.KeyMsg{Type: tea.KeySpace})
+	shell.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("ok!")})
+	shell.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	shell.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	assert.Equal(t, "q ok", got)
+	assert.NotContains(t, shell.View(), "Session notes:")
+}
+
+func TestQuickAction_PromptCancel(t *testing.T) {
+	ran := false
+	shell := newQuickActionApp(t, QuickAction{
+		Key:    "f3",
+		Prompt: "Session notes",
+		Run: func(string) tea.Cmd {
+			ran = true
+			return nil
+		},
+	})
+
+	shell.Update(tea.KeyMsg{Type: tea.KeyF3})
+	shell.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	assert.False(t, ran)
+	assert.Nil(t, shell.prompt)
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package app
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// RefreshMsg asks the active module to reload its data, after the refresh
+// key or on the auto-refresh interval. Auto refreshes should be quiet:
+// no loading screen, no status message, and the same view afterwards.
+type RefreshMsg struct {
+	Auto bool
+}
+
+// autoRefreshMsg is the auto-refresh ticker firing.
+type autoRefreshMsg struct{}
+
+// SetAutoRefresh makes the shell refresh the active module every interval,
+// so sessions logged from another terminal show up. Zero turns it off.
+func (a *App) SetAutoRefresh(interval time.Duration) {
+	a.refreshEvery = interval
+}
+
+// autoRefreshTick schedules the next auto refresh, if they are on.
+func (a *App) autoRefreshTick() tea.Cmd {
+	if a.refreshEvery <= 0 {
+		return nil
+	}
+	return tea.Tick(a.refreshEvery, func(time.Time) tea.Msg {
+		return autoRefreshMsg{}
+	})
+}
+
+// handleAutoRefresh refreshes the active module unless the user is busy
+// with it: typing, answering a prompt, or in the palette or help overlay.
+func (a *App) handleAutoRefresh() tea.Cmd {
+	next := a.autoRefreshTick()
+	if a.prompt != nil || a.palette != nil || a.showHelp {
+		return next
+	}
+	if capturer, ok := a.activeModule().(InputCapturer); ok && capturer.CapturingInput() {
+		return next
+	}
+	return tea.Batch(a.refreshActive(true), next)
+}
+
+// refreshActive sends the active module a RefreshMsg.
+func (a *App) refreshActive(auto bool) tea.Cmd {
+	mod := a.activeModule()
+	if mod == nil {
+		return nil
+	}
+	updated, cmd := mod.Update(RefreshMsg{Auto: auto})
+	if updatedModule, ok := updated.(Module); ok {
+		a.modules[a.activeID] = updatedModule
+	}
+	return cmd
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package app
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// refreshingModule is a MockModule that records the refreshes it gets.
+type refreshingModule struct {
+	*MockModule
+	refreshes []RefreshMsg
+	capturing bool
+}
+
+func (m *refreshingModule) CapturingInput() bool {
+	return m.capturing
+}
+
+func (m *refreshingModule) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if refresh, ok := msg.(RefreshMsg); ok {
+		m.refreshes = append(m.refreshes, refresh)
+	}
+	return m, nil
+}
+
+func TestRefreshKey_RefreshesActiveModule(t *testing.T) {
+	first := &refreshingModule{MockModule: NewMockModule("first", "First")}
+	second := &refreshingModule{MockModule: NewMockModule("second", "Second")}
+	shell, err := New([]Module{first, second})
+	require.NoError(t, err)
+
+	shell.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+
+	assert.Equal(t, []RefreshMsg{{Auto: false}}, first.refreshes)
+	assert.Empty(t, second.refreshes)
+}
+
+func TestAutoRefresh_OffByDefault(t *testing.T) {
+	shell, err := New([]Module{NewMockModule("first", "First")})
+	require.NoError(t, err)
+
+	assert.Nil(t, shell.autoRefreshTick())
+}
+
+func TestAutoRefresh_RefreshesAndReschedules(t *testing.T) {
+	module := &refreshingModule{MockModule: NewMockModule("first", "First")}
+	shell, err := New([]Module{module})
+	require.NoError(t, err)
+	shell.SetAutoRefresh(30 * time.Second)
+
+	_, cmd := shell.Update(autoRefreshMsg{})
+
+	assert.Equal(t, []RefreshMsg{{Auto: true}}, module.refreshes)
+	assert.NotNil(t, cmd, "the next tick is scheduled")
+}
+
+func TestAutoRefresh_SkipsWhileBusy(t *testing.T) {
+	module := &refreshingModule{MockModule: NewMockModule("first", "First"), capturing: true}
+	shell, err := New([]Module{module})
+	require.NoError(t, err)
+	shell.SetAutoRefresh(30 * time.Second)
+
+	_, cmd := shell.Update(autoRefreshMsg{})
+	assert.Empty(t, module.refreshes, "typing is not interrupted")
+	assert.NotNil(t, cmd, "the next tick is still scheduled")
+
+	module.capturing = false
+	shell.showHelp = true
+	shell.Update(autoRefreshMsg{})
+	assert.Empty(t, module.refreshes, "not while the help overlay is open")
+}
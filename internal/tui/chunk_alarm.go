@@ -0,0 +1,91 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+)
+
+// ChunkAlerter announces that a session on chunk has reached the chunk's
+// planned duration, with a bell, a notification, or a chunk.time_up event.
+type ChunkAlerter func(sessionID, planID string, chunk plan.Chunk) error
+
+// chunkStatsReader reads the time already logged on a chunk. The session
+// service implements it; timers without it count the current session only.
+type chunkStatsReader interface {
+	GetChunkStats(ctx context.Context, planID, chunkID string) (*session.ChunkStats, error)
+}
+
+// chunkAlertedMsg reports a chunk alert going off.
+type chunkAlertedMsg struct {
+	chunk plan.Chunk
+	err   error
+}
+
+// chunkAlarm goes off once per session, when the session brings its
+// chunk's logged time up to the planned duration. A session already past
+// it when first seen never sets it off.
+type chunkAlarm struct {
+	alert ChunkAlerter
+
+	sessionID string
+	planID    string
+	chunk     *plan.Chunk
+	logged    time.Duration // Time on the chunk before the session
+	fired     bool
+}
+
+// track follows sess on chunk, which is nil for a session without one.
+func (a *chunkAlarm) track(sess *session.Session, chunk *plan.Chunk, logged time.Duration, now time.Time) {
+	if sess == nil {
+		*a = chunkAlarm{alert: a.alert}
+		return
+	}
+	if sess.ID != a.sessionID {
+		a.sessionID = sess.ID
+		a.planID, a.chunk, a.logged = sess.PlanID, chunk, logged
+		a.fired = a.due(sess, now)
+		return
+	}
+	a.chunk, a.logged = chunk, logged
+}
+
+// due reports whether sess has reached its chunk's planned duration.
+func (a *chunkAlarm) due(sess *session.Session, now time.Time) bool {
+	if a.chunk == nil || a.chunk.Duration <= 0 {
+		return false
+	}
+	return a.logged+sess.Elapsed(now) >= time.Duration(a.chunk.Duration)*time.Minute
+}
+
+// check returns the alert, the first time sess is due.
+func (a *chunkAlarm) check(sess *session.Session, now time.Time) tea.Cmd {
+	if a.alert == nil || a.fired || sess == nil || sess.ID != a.sessionID || !a.due(sess, now) {
+		return nil
+	}
+	a.fired = true
+	alert, sessionID, planID, chunk := a.alert, a.sessionID, a.planID, *a.chunk
+	return func() tea.Msg {
+		return chunkAlertedMsg{chunk: chunk, err: alert(sessionID, planID, chunk)}
+	}
+}
+
+// loggedOnChunk returns the time logged on the chunk before the active
+// session, or zero if reader can't tell.
+func loggedOnChunk(ctx context.Context, reader any, planID, chunkID string) time.Duration {
+	stats, ok := reader.(chunkStatsReader)
+	if !ok || chunkID == "" {
+		return 0
+	}
+	chunkStats, err := stats.GetChunkStats(ctx, planID, chunkID)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(chunkStats.TotalDuration) * time.Minute
+}
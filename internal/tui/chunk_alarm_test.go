@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunkAlarm(t *testing.T) {
+	start := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	chunk := &plan.Chunk{ID: "chunk-002", Title: "Tokio basics", Duration: 60}
+	sess := &session.Session{ID: "s1", PlanID: "rust-async", ChunkID: "chunk-002", StartTime: start}
+
+	var alerts []string
+	alarm := chunkAlarm{alert: func(sessionID, planID string, c plan.Chunk) error {
+		alerts = append(alerts, sessionID+" "+planID+" "+c.ID)
+		return nil
+	}}
+
+	t.Run("goes off once the chunk's time is up", func(t *testing.T) {
+		alarm.track(sess, chunk, 20*time.Minute, start)
+		assert.Nil(t, alarm.check(sess, start.Add(39*time.Minute)))
+
+		cmd := alarm.check(sess, start.Add(40*time.Minute))
+		require.NotNil(t, cmd)
+		msg, ok := cmd().(chunkAlertedMsg)
+		require.True(t, ok)
+		assert.NoError(t, msg.err)
+		assert.Equal(t, "Tokio basics", msg.chunk.Title)
+		assert.Equal(t, []string{"s1 rust-async chunk-002"}, alerts)
+
+		assert.Nil(t, alarm.check(sess, start.Add(41*time.Minute)), "only once per session")
+		alarm.track(sess, chunk, 20*time.Minute, start.Add(42*time.Minute))
+		assert.Nil(t, alarm.check(sess, start.Add(43*time.Minute)), "a reload doesn't re-arm it")
+	})
+
+	t.Run("a new session re-arms it", func(t *testing.T) {
+		next := &session.Session{ID: "s2", PlanID: "rust-async", ChunkID: "chunk-002", StartTime: start.Add(time.Hour)}
+		alarm.track(next, chunk, 20*time.Minute, next.StartTime)
+		assert.NotNil(t, alarm.check(next, next.StartTime.Add(40*time.Minute)))
+	})
+
+	t.Run("a session already over its time stays quiet", func(t *testing.T) {
+		late := &session.Session{ID: "s3", PlanID: "rust-async", ChunkID: "chunk-002", StartTime: start}
+		alarm.track(late, chunk, 70*time.Minute, start.Add(time.Minute))
+		assert.Nil(t, alarm.check(late, start.Add(2*time.Minute)))
+	})
+
+	t.Run("no chunk, no alarm", func(t *testing.T) {
+		free := &session.Session{ID: "s4", PlanID: "rust-async", StartTime: start}
+		alarm.track(free, nil, 0, start)
+		assert.Nil(t, alarm.check(free, start.Add(5*time.Hour)))
+	})
+}
+
+func TestFocusModel_ChunkAlert(t *testing.T) {
+	now := time.Now()
+	sess := &session.Session{ID: "s1", PlanID: "rust-async", ChunkID: "chunk-002", StartTime: now.Add(-25 * time.Minute)}
+	chunk := &plan.Chunk{ID: "chunk-002", Title: "Tokio basics", Duration: 60}
+	m := NewFocusModel(&fakeTimerSessions{&fakeQuickSessions{active: sess}}, sess, "Rust async", chunk, 20*time.Minute)
+	m.SetChunkAlert(func(string, string, plan.Chunk) error { return errors.New("no speaker") })
+
+	// 20 earlier and 25 current minutes; the chunk's hour is up 15 minutes later
+	assert.Nil(t, m.alarm.check(sess, now.Add(14*time.Minute)))
+	cmd := m.alarm.check(sess, now.Add(15*time.Minute))
+	require.NotNil(t, cmd)
+	m.Update(cmd())
+	assert.Contains(t, m.View(), "chunk alert failed: no speaker")
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package components
+
+import "strings"
+
+// bigGlyphs draws the characters of a clock five rows tall.
+var bigGlyphs = map[rune][5]string{
+	'0': {"███", "█ █", "█ █", "█ █", "███"},
+	'1': {" ██", "  █", "  █", "  █", "  █"},
+	'2': {"███", "  █", "███", "█  ", "███"},
+	'3': {"███", "  █", "███", "  █", "███"},
+	'4': {"█ █", "█ █", "███", "  █", "  █"},
+	'5': {"███", "█  ", "███", "  █", "███"},
+	'6': {"███", "█  ", "███", "█ █", "███"},
+	'7': {"███", "  █", "  █", "  █", "  █"},
+	'8': {"███", "█ █", "███", "█ █", "███"},
+	'9': {"███", "█ █", "███", "  █", "███"},
+	':': {" ", "█", " ", "█", " "},
+	'+': {"   ", " █ ", "███", " █ ", "   "},
+	'-': {"   ", "   ", "███", "   ", "   "},
+	' ': {" ", " ", " ", " ", " "},
+}
+
+// BigText renders s in block characters five rows tall, for a clock
+// read from across the room. It draws digits, ':', '+', '-', and spaces;
+// other characters are left out.
+func BigText(s string) string {
+	var rows [5][]string
+	for _, r := range s {
+		glyph, ok := bigGlyphs[r]
+		if !ok {
+			continue
+		}
+		for i, line := range glyph {
+			rows[i] = append(rows[i], line)
+		}
+	}
+
+	lines := make([]string, len(rows))
+	for i, row := range rows {
+		lines[i] = strings.Join(row, " ")
+	}
+	return strings.Join(lines, "\n")
+}
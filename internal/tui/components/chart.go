@@ -0,0 +1,155 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package components
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// sparkBlocks are the eight heights of a block sparkline, lowest first.
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// barEighths are partial bar cells, one to seven eighths wide.
+var barEighths = []rune("▏▎▍▌▋▊▉")
+
+// Braille dots by column, bottom row first. A cell is two columns of
+// four dots starting at U+2800.
+var (
+	brailleLeft  = []rune{0x40, 0x04, 0x02, 0x01}
+	brailleRight = []rune{0x80, 0x20, 0x10, 0x08}
+)
+
+// Sparkline renders values as a one-line chart at most width characters
+// wide, scaled to the largest value. Up to width values get one block
+// character each; up to twice that many are packed two per braille
+// character; longer series are summed into buckets first. Zero values
+// leave a gap so idle stretches stand out.
+func Sparkline(values []float64, width int) string {
+	if len(values) == 0 || width <= 0 {
+		return ""
+	}
+	if len(values) > 2*width {
+		values = bucket(values, 2*width)
+	}
+	if len(values) > width {
+		return brailleLine(values)
+	}
+
+	peak := maxValue(values)
+	var b strings.Builder
+	for _, v := range values {
+		if v <= 0 || peak <= 0 {
+			b.WriteRune(' ')
+			continue
+		}
+		level := int(math.Ceil(v/peak*float64(len(sparkBlocks)))) - 1
+		b.WriteRune(sparkBlocks[clamp(level, 0, len(sparkBlocks)-1)])
+	}
+	return b.String()
+}
+
+// brailleLine renders two values per braille character, each as a column
+// of up to four dots.
+func brailleLine(values []float64) string {
+	peak := maxValue(values)
+	height := func(v float64) int {
+		if v <= 0 || peak <= 0 {
+			return 0
+		}
+		return clamp(int(math.Ceil(v/peak*4)), 1, 4)
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(values); i += 2 {
+		cell := rune(0x2800)
+		for dot := 0; dot < height(values[i]); dot++ {
+			cell |= brailleLeft[dot]
+		}
+		if i+1 < len(values) {
+			for dot := 0; dot < height(values[i+1]); dot++ {
+				cell |= brailleRight[dot]
+			}
+		}
+		b.WriteRune(cell)
+	}
+	return b.String()
+}
+
+// bucket sums values into n consecutive buckets of near-equal size.
+func bucket(values []float64, n int) []float64 {
+	out := make([]float64, n)
+	for i, v := range values {
+		out[i*n/len(values)] += v
+	}
+	return out
+}
+
+// Bar is one row of a bar chart.
+type Bar struct {
+	Label string
+	Value float64
+	Note  string // Shown after the bar, e.g. "2.5h"
+}
+
+// BarChart renders horizontal bars scaled to the largest value.
+type BarChart struct {
+	bars  []Bar
+	width int
+}
+
+// NewBarChart creates a bar chart whose longest bar is width cells.
+func NewBarChart(bars []Bar, width int) *BarChart {
+	return &BarChart{bars: bars, width: width}
+}
+
+// View renders one line per bar: the label padded to the longest one, the
+// bar drawn in eighth-cell steps, and the note.
+func (c *BarChart) View() string {
+	labelWidth := 0
+	values := make([]float64, len(c.bars))
+	for i, bar := range c.bars {
+		labelWidth = max(labelWidth, Width(bar.Label))
+		values[i] = bar.Value
+	}
+	peak := maxValue(values)
+
+	lines := make([]string, len(c.bars))
+	for i, bar := range c.bars {
+		drawn := ""
+		if peak > 0 && bar.Value > 0 {
+			drawn = barCells(bar.Value / peak * float64(c.width))
+		}
+		line := fmt.Sprintf("%s %s", PadRight(bar.Label, labelWidth), drawn)
+		if bar.Note != "" {
+			line += " " + bar.Note
+		}
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// barCells draws a bar cells wide, ending in a partial cell. Any positive
+// length shows at least a sliver.
+func barCells(cells float64) string {
+	eighths := max(int(math.Round(cells*8)), 1)
+	bar := strings.Repeat("█", eighths/8)
+	if rest := eighths % 8; rest > 0 {
+		bar += string(barEighths[rest-1])
+	}
+	return bar
+}
+
+func maxValue(values []float64) float64 {
+	peak := 0.0
+	for _, v := range values {
+		peak = math.Max(peak, v)
+	}
+	return peak
+}
+
+func clamp(n, lo, hi int) int {
+	return min(max(n, lo), hi)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package components
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparkline_Blocks(t *testing.T) {
+	assert.Equal(t, "▂ ▄█", Sparkline([]float64{1, 0, 2, 4}, 10))
+	assert.Equal(t, "  ", Sparkline([]float64{0, 0}, 10), "all zeros stay blank")
+	assert.Empty(t, Sparkline(nil, 10))
+	assert.Empty(t, Sparkline([]float64{1}, 0))
+}
+
+func TestSparkline_Braille(t *testing.T) {
+	// Four values in two cells: full/empty, then quarter/half
+	line := Sparkline([]float64{4, 0, 1, 2}, 2)
+	assert.Equal(t, 2, utf8.RuneCountInString(line))
+	assert.Equal(t, []rune{0x2800 | 0x47, 0x2800 | 0x40 | 0xA0}, []rune(line))
+}
+
+func TestSparkline_Buckets(t *testing.T) {
+	values := make([]float64, 100)
+	values[99] = 5
+	line := Sparkline(values, 10)
+	assert.Equal(t, 10, utf8.RuneCountInString(line), "long series fit the width")
+	runes := []rune(line)
+	assert.Equal(t, rune(0x2800), runes[0])
+	assert.NotEqual(t, rune(0x2800), runes[9], "the last bucket holds the value")
+}
+
+func TestBarChart_View(t *testing.T) {
+	chart := NewBarChart([]Bar{
+		{Label: "Mon", Value: 2, Note: "2.0h"},
+		{Label: "Tues", Value: 1, Note: "1.0h"},
+		{Label: "Wed", Value: 0},
+		{Label: "Thu", Value: 0.1},
+	}, 4)
+
+	lines := strings.Split(chart.View(), "\n")
+	assert.Equal(t, []string{
+		"Mon  ████ 2.0h",
+		"Tues ██ 1.0h",
+		"Wed",
+		"Thu  ▎",
+	}, lines)
+}
+
+func TestBarCells_Partial(t *testing.T) {
+	assert.Equal(t, "█▌", barCells(1.5))
+	assert.Equal(t, "▏", barCells(0.01), "tiny values still show")
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package components
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Fuzzy match scoring. Matches at the start of a word and runs of
+// consecutive runes rank first; every skipped rune costs a little.
+const (
+	fuzzyMatchScore  = 16
+	fuzzyWordStart   = 24
+	fuzzyConsecutive = 16
+	fuzzyGapPenalty  = 1
+)
+
+// FuzzyMatch reports whether the runes of pattern appear in text in
+// order, ignoring case and spaces in pattern. It returns a score, higher
+// for better matches, and the indexes of the matched runes of text, for
+// Highlight. An empty pattern matches everything with score zero.
+func FuzzyMatch(pattern, text string) (int, []int, bool) {
+	needle := []rune(strings.ToLower(strings.ReplaceAll(pattern, " ", "")))
+	if len(needle) == 0 {
+		return 0, nil, true
+	}
+	haystack := []rune(text)
+
+	positions := make([]int, 0, len(needle))
+	score, last := 0, -1
+	for i := 0; i < len(haystack) && len(positions) < len(needle); i++ {
+		if unicode.ToLower(haystack[i]) != needle[len(positions)] {
+			continue
+		}
+		// Prefer a later word start to a match in the middle of a word,
+		// as long as the rest of the pattern still fits after it
+		if !isWordStart(haystack, i) {
+			if j := nextWordStart(haystack, i, needle[len(positions)]); j >= 0 && fits(haystack[j+1:], needle[len(positions)+1:]) {
+				i = j
+			}
+		}
+
+		score += fuzzyMatchScore
+		if isWordStart(haystack, i) {
+			score += fuzzyWordStart
+		}
+		if last >= 0 && i == last+1 {
+			score += fuzzyConsecutive
+		} else if last >= 0 {
+			score -= (i - last - 1) * fuzzyGapPenalty
+		} else {
+			score -= i * fuzzyGapPenalty
+		}
+		positions = append(positions, i)
+		last = i
+	}
+	if len(positions) < len(needle) {
+		return 0, nil, false
+	}
+	return score, positions, true
+}
+
+// isWordStart reports whether the rune at i begins a word of text.
+func isWordStart(text []rune, i int) bool {
+	if i == 0 {
+		return true
+	}
+	prev, cur := text[i-1], text[i]
+	if !unicode.IsLetter(prev) && !unicode.IsDigit(prev) {
+		return true
+	}
+	return unicode.IsLower(prev) && unicode.IsUpper(cur)
+}
+
+// nextWordStart returns the index of the next word after i that starts
+// with r, ignoring case, or -1.
+func nextWordStart(text []rune, i int, r rune) int {
+	for j := i + 1; j < len(text); j++ {
+		if unicode.ToLower(text[j]) == r && isWordStart(text, j) {
+			return j
+		}
+	}
+	return -1
+}
+
+// fits reports whether needle is a subsequence of text, ignoring the case
+// of text.
+func fits(text, needle []rune) bool {
+	n := 0
+	for _, r := range text {
+		if n < len(needle) && unicode.ToLower(r) == needle[n] {
+			n++
+		}
+	}
+	return n == len(needle)
+}
+
+// Highlight renders the runes of text at positions, as FuzzyMatch
+// returns them, in match and the others in rest.
+func Highlight(text string, positions []int, match, rest lipgloss.Style) string {
+	matched := make(map[int]bool, len(positions))
+	for _, p := range positions {
+		matched[p] = true
+	}
+
+	var b, run strings.Builder
+	inMatch := false
+	flush := func() {
+		if run.Len() == 0 {
+			return
+		}
+		if inMatch {
+			b.WriteString(match.Render(run.String()))
+		} else {
+			b.WriteString(rest.Render(run.String()))
+		}
+		run.Reset()
+	}
+	for i, r := range []rune(text) {
+		if matched[i] != inMatch {
+			flush()
+			inMatch = matched[i]
+		}
+		run.WriteRune(r)
+	}
+	flush()
+	return b.String()
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package components
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	_, positions, ok := FuzzyMatch("rsa", "Rust async")
+	assert.True(t, ok)
+	assert.Equal(t, []int{0, 2, 5}, positions)
+
+	_, positions, ok = FuzzyMatch("as", "Rust async")
+	assert.True(t, ok)
+	assert.Equal(t, []int{5, 6}, positions, "the word start beats the 's' in Rust")
+
+	_, _, ok = FuzzyMatch("xyz", "Rust async")
+	assert.False(t, ok)
+
+	_, _, ok = FuzzyMatch("open rust", "Open plan: Rust async")
+	assert.True(t, ok, "spaces in the pattern are ignored")
+
+	score, positions, ok := FuzzyMatch("", "anything")
+	assert.True(t, ok)
+	assert.Zero(t, score)
+	assert.Empty(t, positions)
+}
+
+func TestFuzzyMatch_RanksWordStartsAndRuns(t *testing.T) {
+	prefix, _, _ := FuzzyMatch("exp", "Export report")
+	scattered, _, _ := FuzzyMatch("exp", "Next plan step")
+	assert.Greater(t, prefix, scattered)
+
+	word, _, _ := FuzzyMatch("sh", "Session history")
+	inner, _, _ := FuzzyMatch("sh", "Push")
+	assert.Greater(t, word, inner)
+}
+
+func TestHighlight(t *testing.T) {
+	style := lipgloss.NewStyle()
+	assert.Equal(t, "Rust async", Highlight("Rust async", []int{0, 5}, style, style))
+	assert.Equal(t, "plain", Highlight("plain", nil, style, style))
+	assert.Empty(t, Highlight("", nil, style, style))
+}
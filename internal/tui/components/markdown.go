@@ -0,0 +1,49 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/glamour"
+	glamourstyles "github.com/charmbracelet/glamour/styles"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
+)
+
+// RenderMarkdown formats markdown for the terminal, wrapped to width
+// columns, with fenced code highlighted by its language tag. Colors follow
+// lipgloss's profile, so output that isn't a terminal or has NO_COLOR set
+// keeps the layout without escape sequences, and the light theme gets
+// glamour's light style.
+func RenderMarkdown(md string, width int) (string, error) {
+	profile, style := lipgloss.ColorProfile(), glamourstyles.DarkStyle
+	switch {
+	case profile == termenv.Ascii:
+		style = glamourstyles.NoTTYStyle
+	case styles.Current().Name == styles.Light:
+		style = glamourstyles.LightStyle
+	}
+	renderer, err := glamour.NewTermRenderer(
+		glamour.WithStandardStyle(style),
+		glamour.WithColorProfile(profile),
+		glamour.WithWordWrap(width),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to create markdown renderer: %w", err)
+	}
+	out, err := renderer.Render(md)
+	if err != nil {
+		return "", fmt.Errorf("failed to render markdown: %w", err)
+	}
+
+	// Glamour pads every line to the wrap width
+	lines := strings.Split(strings.Trim(out, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.Join(lines, "\n"), nil
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const chunkSection = "## Borrowing\n**Duration**: 1 hour\n\n- Shared references\n\n```rust\nlet r = &s;\n```\n"
+
+func TestRenderMarkdown(t *testing.T) {
+	out, err := RenderMarkdown(chunkSection, 40)
+	require.NoError(t, err)
+	assert.NotContains(t, out, "\x1b[", "no escapes without a color terminal")
+	assert.Contains(t, out, "## Borrowing")
+	assert.Contains(t, out, "• Shared references")
+	assert.Contains(t, out, "    let r = &s;", "code blocks are indented")
+	for _, line := range strings.Split(out, "\n") {
+		assert.LessOrEqual(t, Width(line), 40)
+		assert.Equal(t, strings.TrimRight(line, " "), line, "no padding")
+	}
+}
+
+func TestRenderMarkdown_HighlightsCode(t *testing.T) {
+	previous := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	t.Cleanup(func() { lipgloss.SetColorProfile(previous) })
+
+	out, err := RenderMarkdown(chunkSection, 40)
+	require.NoError(t, err)
+	var code string
+	for _, line := range strings.Split(out, "\n") {
+		if strings.Contains(line, "let") {
+			code = line
+		}
+	}
+	assert.Contains(t, code, "\x1b[", "fenced code is colored")
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
 )
 
 // ProgressBar renders a styled progress bar with color-coding.
@@ -54,19 +55,16 @@ func (p *ProgressBar) View() string {
 	}
 
 	// Choose color based on progress
-	var barColor lipgloss.Color
+	var barStyle lipgloss.Style
 	switch {
 	case p.progress < 0.33:
-		barColor = lipgloss.Color("9") // Red
+		barStyle = styles.Error()
 	case p.progress < 0.66:
-		barColor = lipgloss.Color("11") // Yellow
+		barStyle = styles.Warning()
 	default:
-		barColor = lipgloss.Color("10") // Green
+		barStyle = styles.Success()
 	}
 
-	// Style the bar
-	barStyle := lipgloss.NewStyle().Foreground(barColor)
-
 	// Format percentage
 	percentage := int(p.progress * 100)
 
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
 )
 
 // Table renders a styled table with headers and rows.
@@ -67,20 +68,18 @@ func (t *Table) View() string {
 	// Calculate column widths
 	colWidths := make([]int, len(t.headers))
 	for i, header := range t.headers {
-		colWidths[i] = len(header)
+		colWidths[i] = Width(header)
 	}
 	for _, row := range t.rows {
 		for i, cell := range row {
-			if i < len(colWidths) && len(cell) > colWidths[i] {
-				colWidths[i] = len(cell)
+			if i < len(colWidths) && Width(cell) > colWidths[i] {
+				colWidths[i] = Width(cell)
 			}
 		}
 	}
 
 	// Styles
-	headerStyle := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(lipgloss.Color("12")) // Bright blue
+	headerStyle := styles.Title()
 
 	var result strings.Builder
 
@@ -102,7 +101,7 @@ func (t *Table) View() string {
 
 	// Render rows
 	normalStyle := lipgloss.NewStyle()
-	highlightStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("213"))
+	highlightStyle := styles.Accent()
 	for i, row := range t.rows {
 		style := normalStyle
 		if t.highlightedRows[i] {
@@ -138,9 +137,8 @@ func (t *Table) renderRow(cells []string, widths []int, style *lipgloss.Style) s
 			}
 		}
 
-		// Pad cell to column width
-		padded := cell + strings.Repeat(" ", widths[i]-len(cell))
-		row.WriteString(style.Render(padded))
+		// Pad cell to column width, in terminal columns
+		row.WriteString(style.Render(PadRight(cell, widths[i])))
 	}
 
 	if t.border {
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTable_Empty(t *testing.T) {
@@ -109,3 +110,17 @@ func TestTable_LongValues(t *testing.T) {
 	assert.NotEmpty(t, result)
 	assert.Contains(t, result, "ThisIsAVeryLongNameThatExceedsTypicalColumnWidth") // pragma: allowlist secret
 }
+
+func TestTable_WideRunes(t *testing.T) {
+	table := NewTable([]string{"Plan", "Hours"})
+	table.AddRow([]string{"日本語の文法", "3.0"})
+	table.AddRow([]string{"🚀 Rust", "1.5"})
+	table.AddRow([]string{"Go", "0.5"})
+	table.SetBorder(true)
+
+	lines := strings.Split(table.View(), "\n")
+	require.Len(t, lines, 7)
+	for _, line := range lines[1:] {
+		assert.Equal(t, Width(lines[0]), Width(line), "every row is as wide as the border: %q", line)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package components
+
+import (
+	"bytes"
+	"io"
+	"strings"
+)
+
+// TabWriter aligns tab-separated columns like text/tabwriter configured
+// with space padding, but measures cells in terminal columns, so rows
+// with CJK titles or emoji line up. Each tab ends a cell; text after a
+// line's last tab is written as is. A column is as wide as its widest
+// cell among the adjacent lines that have it, plus the padding.
+type TabWriter struct {
+	w       io.Writer
+	padding int
+	buf     bytes.Buffer
+}
+
+// NewTabWriter creates a writer that aligns columns into w, separated by
+// at least padding spaces. Nothing is written until Flush.
+func NewTabWriter(w io.Writer, padding int) *TabWriter {
+	return &TabWriter{w: w, padding: padding}
+}
+
+// Write buffers text to be aligned on Flush.
+func (t *TabWriter) Write(p []byte) (int, error) {
+	return t.buf.Write(p)
+}
+
+// Flush aligns and writes everything written so far.
+func (t *TabWriter) Flush() error {
+	text := t.buf.String()
+	t.buf.Reset()
+
+	lines := strings.Split(text, "\n")
+	cells := make([][]string, len(lines))
+	for i, line := range lines {
+		cells[i] = strings.Split(line, "\t")
+	}
+
+	// widths[i][j] is the width of column j on line i, shared by the
+	// block of adjacent lines that have a cell in column j
+	widths := make([][]int, len(lines))
+	for i := range lines {
+		widths[i] = make([]int, len(cells[i])-1)
+	}
+	for col := 0; ; col++ {
+		found := false
+		for start := 0; start < len(lines); {
+			if len(cells[start])-1 <= col {
+				start++
+				continue
+			}
+			found = true
+			end, width := start, 0
+			for ; end < len(lines) && len(cells[end])-1 > col; end++ {
+				width = max(width, Width(cells[end][col]))
+			}
+			for i := start; i < end; i++ {
+				widths[i][col] = width + t.padding
+			}
+			start = end
+		}
+		if !found {
+			break
+		}
+	}
+
+	var out strings.Builder
+	for i, line := range cells {
+		if i > 0 {
+			out.WriteByte('\n')
+		}
+		for j, cell := range line {
+			if j < len(widths[i]) {
+				out.WriteString(PadRight(cell, widths[i][j]))
+				continue
+			}
+			out.WriteString(cell)
+		}
+	}
+	_, err := io.WriteString(t.w, out.String())
+	return err
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package components
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+)
+
+// Width returns how many terminal columns s takes. CJK characters and
+// most emoji take two; styling escape sequences take none.
+func Width(s string) int {
+	return lipgloss.Width(s)
+}
+
+// Truncate shortens s to at most width columns, ending it with "..." when
+// it is cut. Runes are never split, and wide runes count twice.
+func Truncate(s string, width int) string {
+	if runewidth.StringWidth(s) <= width {
+		return s
+	}
+	if width < 3 {
+		return runewidth.Truncate(s, width, "")
+	}
+	return runewidth.Truncate(s, width, "...")
+}
+
+// PadRight pads s with spaces to width columns.
+func PadRight(s string, width int) string {
+	return s + strings.Repeat(" ", max(0, width-Width(s)))
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package components
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"text/tabwriter"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWidth(t *testing.T) {
+	assert.Equal(t, 5, Width("hello"))
+	assert.Equal(t, 6, Width("日本語"), "CJK runes are two columns")
+	assert.Equal(t, 4, Width("🚀go"))
+	assert.Equal(t, 2, Width("\x1b[1mok\x1b[0m"), "escape sequences take no space")
+}
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "short", Truncate("short", 10))
+	assert.Equal(t, "a long...", Truncate("a long title", 9))
+	assert.Equal(t, "日本...", Truncate("日本語の文法", 8))
+	assert.Equal(t, "日本 ...", Truncate("日本 語の文法", 8), "a wide rune that doesn't fit is dropped whole")
+	assert.Equal(t, "日", Truncate("日本語", 2))
+
+	for _, s := range []string{"日本語の文法を学ぶ", "🚀🚀🚀🚀🚀", "Ünïcödé títlè"} {
+		for width := range 12 {
+			got := Truncate(s, width)
+			assert.True(t, utf8.ValidString(got), "%q at %d", s, width)
+			assert.LessOrEqual(t, Width(got), width, "%q at %d", s, width)
+		}
+	}
+}
+
+func TestPadRight(t *testing.T) {
+	assert.Equal(t, "ab  ", PadRight("ab", 4))
+	assert.Equal(t, "日本", PadRight("日本", 4))
+	assert.Equal(t, "日本 ", PadRight("日本", 5))
+	assert.Equal(t, "toolong", PadRight("toolong", 3))
+}
+
+func TestTabWriter_MatchesTabwriterForASCII(t *testing.T) {
+	input := "ID\tTITLE\tHOURS\n" +
+		"a\tRust\t1.5h\n" +
+		"longer-id\tGo\t12.0h\n" +
+		"\n" +
+		"no tabs here\n" +
+		"x\ty\n" +
+		"trailing\tcell\t\n"
+
+	var want bytes.Buffer
+	tw := tabwriter.NewWriter(&want, 0, 0, 2, ' ', 0)
+	fmt.Fprint(tw, input)
+	require.NoError(t, tw.Flush())
+
+	var got bytes.Buffer
+	w := NewTabWriter(&got, 2)
+	fmt.Fprint(w, input)
+	require.NoError(t, w.Flush())
+
+	assert.Equal(t, want.String(), got.String())
+}
+
+func TestTabWriter_WideRunes(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewTabWriter(&buf, 2)
+	fmt.Fprintln(w, "PLAN\tTITLE\tHOURS")
+	fmt.Fprintln(w, "japanese\t日本語の文法\t3.0h")
+	fmt.Fprintln(w, "rust\t🚀 Rust\t1.5h")
+	fmt.Fprintln(w, "go\tGo\t0.5h")
+	require.NoError(t, w.Flush())
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 4)
+	hoursCol := Width(lines[0][:strings.Index(lines[0], "HOURS")])
+	for _, line := range lines[1:] {
+		assert.Equal(t, hoursCol, Width(line[:strings.LastIndex(line, " ")+1]), line)
+	}
+}
+
+func TestBigText(t *testing.T) {
+	lines := strings.Split(BigText("1:07"), "\n")
+	require.Len(t, lines, 5)
+	assert.Equal(t, " ██   ███ ███", lines[0])
+	assert.Equal(t, "  █ █ █ █   █", lines[1])
+	for _, line := range lines {
+		assert.Equal(t, Width(lines[0]), Width(line), "rows line up")
+	}
+	assert.Equal(t, BigText("12"), BigText("1x2"), "unknown characters are left out")
+}
@@ -0,0 +1,239 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
+)
+
+type focusTickMsg time.Time
+
+// focusChangedMsg reports a pause, resume, or stop.
+type focusChangedMsg struct {
+	session *session.Session
+	stopped bool
+	err     error
+}
+
+// FocusModel is the full-screen timer of `samedi focus`: the running
+// session's clock in large digits, the time left on its chunk, and the
+// chunk's objectives, with nothing else on screen. It is a standalone
+// Bubble Tea program.
+type FocusModel struct {
+	sessions SessionTimer
+	active   *session.Session
+
+	planTitle string
+	chunk     *plan.Chunk   // Nil for a session not linked to a chunk
+	logged    time.Duration // Time on the chunk before this session
+
+	now           time.Time
+	width, height int
+	alarm         chunkAlarm
+
+	noteInput *inputField
+	stopped   *session.Session
+	err       error
+}
+
+// NewFocusModel creates a focus timer for the active session. logged is
+// the time already spent on chunk in earlier sessions.
+func NewFocusModel(sessions SessionTimer, active *session.Session, planTitle string, chunk *plan.Chunk, logged time.Duration) *FocusModel {
+	m := &FocusModel{
+		sessions:  sessions,
+		active:    active,
+		planTitle: planTitle,
+		chunk:     chunk,
+		logged:    logged,
+		now:       time.Now(),
+	}
+	m.alarm.track(active, chunk, logged, m.now)
+	return m
+}
+
+// SetChunkAlert sets how focus mode announces the session reaching the
+// chunk's planned duration.
+func (m *FocusModel) SetChunkAlert(alert ChunkAlerter) {
+	m.alarm.alert = alert
+}
+
+// Stopped returns the session once it is finished, or nil if focus mode
+// was left with the session still running.
+func (m *FocusModel) Stopped() *session.Session {
+	return m.stopped
+}
+
+// Init satisfies tea.Model.
+func (m *FocusModel) Init() tea.Cmd {
+	return m.tick()
+}
+
+// tick schedules the next clock update.
+func (m *FocusModel) tick() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return focusTickMsg(t)
+	})
+}
+
+// Update satisfies tea.Model.
+func (m *FocusModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+	case focusTickMsg:
+		m.now = time.Time(msg)
+		return m, tea.Batch(m.tick(), m.alarm.check(m.active, m.now))
+	case chunkAlertedMsg:
+		if msg.err != nil {
+			m.err = fmt.Errorf("chunk alert failed: %w", msg.err)
+		}
+	case focusChangedMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.err = nil
+		if msg.stopped {
+			m.stopped = msg.session
+			return m, tea.Quit
+		}
+		m.active = msg.session
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyCtrlC {
+			return m, tea.Quit
+		}
+		if m.noteInput != nil {
+			return m.handleNoteKey(msg)
+		}
+		switch msg.String() {
+		case "f", "enter":
+			m.noteInput = newInputField("What did you get done? (optional)")
+			m.noteInput.Focus()
+		case " ", "p":
+			return m, m.togglePause()
+		case "q", "esc":
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+// handleNoteKey edits the finishing notes. Enter stops the session and
+// Esc goes back to the timer.
+func (m *FocusModel) handleNoteKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.noteInput = nil
+		return m, nil
+	case tea.KeyEnter:
+		notes := strings.TrimSpace(m.noteInput.Value())
+		m.noteInput = nil
+		return m, func() tea.Msg {
+			sess, err := m.sessions.Stop(context.Background(), session.StopRequest{Notes: notes})
+			return focusChangedMsg{session: sess, stopped: true, err: err}
+		}
+	}
+	m.noteInput.Update(msg)
+	return m, nil
+}
+
+// togglePause pauses or resumes the session.
+func (m *FocusModel) togglePause() tea.Cmd {
+	paused := m.active.IsPaused()
+	return func() tea.Msg {
+		var sess *session.Session
+		var err error
+		if paused {
+			sess, err = m.sessions.Resume(context.Background())
+		} else {
+			sess, err = m.sessions.Pause(context.Background())
+		}
+		return focusChangedMsg{session: sess, err: err}
+	}
+}
+
+// remaining returns the time left on the chunk's planned duration, which
+// goes negative once it is overrun. ok is false without a chunk duration.
+func (m *FocusModel) remaining(elapsed time.Duration) (time.Duration, bool) {
+	if m.chunk == nil || m.chunk.Duration <= 0 {
+		return 0, false
+	}
+	planned := time.Duration(m.chunk.Duration) * time.Minute
+	return planned - m.logged - elapsed, true
+}
+
+// View satisfies tea.Model.
+func (m *FocusModel) View() string {
+	elapsed := m.active.Elapsed(m.now)
+
+	var b strings.Builder
+	heading := m.planTitle
+	if m.chunk != nil {
+		heading += " · " + m.chunk.Title
+	}
+	b.WriteString(styles.Title().Render(heading))
+	b.WriteString("\n\n")
+
+	clock := lipgloss.NewStyle().Foreground(lipgloss.Color(styles.Current().Accent))
+	if m.active.IsPaused() {
+		clock = styles.Muted()
+	}
+	b.WriteString(clock.Render(components.BigText(formatClock(elapsed))))
+	b.WriteString("\n\n")
+
+	status := "elapsed"
+	if m.active.IsPaused() {
+		status = styles.Warning().Render("❚❚ paused")
+	}
+	if left, ok := m.remaining(elapsed); ok {
+		if left >= 0 {
+			status += " · " + formatClock(left) + " left"
+		} else {
+			status += " · " + styles.Warning().Render(formatClock(-left)+" over")
+		}
+	}
+	b.WriteString(styles.Muted().Render(status))
+	b.WriteString("\n")
+
+	if m.chunk != nil && len(m.chunk.Objectives) > 0 {
+		b.WriteString("\n")
+		b.WriteString(styles.Section().Render("Objectives"))
+		b.WriteString("\n")
+		for _, objective := range m.chunk.Objectives {
+			b.WriteString("• " + objective + "\n")
+		}
+	}
+
+	b.WriteString("\n")
+	if m.err != nil {
+		b.WriteString(styles.Error().Render(m.err.Error()))
+		b.WriteString("\n")
+	}
+	if m.noteInput != nil {
+		b.WriteString(m.noteInput.View())
+		b.WriteString("\n")
+		b.WriteString(styles.Muted().Render("[enter] finish session  [esc] keep going"))
+	} else {
+		pause := "pause"
+		if m.active.IsPaused() {
+			pause = "resume"
+		}
+		b.WriteString(styles.Muted().Render(fmt.Sprintf("[f] finish  [space] %s  [q] leave (the session keeps running)", pause)))
+	}
+
+	if m.width == 0 || m.height == 0 {
+		return b.String()
+	}
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, b.String())
+}
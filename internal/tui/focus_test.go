@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestFocusModel() (*FocusModel, *fakeTimerSessions) {
+	start := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	timer := &fakeTimerSessions{&fakeQuickSessions{
+		active: &session.Session{ID: "s1", PlanID: "rust-async", ChunkID: "chunk-002", StartTime: start},
+	}}
+	chunk := &plan.Chunk{ID: "chunk-002", Title: "Tokio basics", Duration: 60, Objectives: []string{"Spawn a task"}}
+	m := NewFocusModel(timer, timer.active, "Rust async", chunk, 20*time.Minute)
+	m.now = start.Add(25 * time.Minute)
+	return m, timer
+}
+
+func TestFocusModel_View(t *testing.T) {
+	m, _ := newTestFocusModel()
+
+	view := m.View()
+	assert.Contains(t, view, "Rust async · Tokio basics")
+	assert.Contains(t, view, "0:15:00 left", "the chunk's hour less 20 earlier and 25 current minutes")
+	assert.Contains(t, view, "• Spawn a task")
+
+	m.now = m.now.Add(30 * time.Minute)
+	assert.Contains(t, m.View(), "0:15:00 over")
+}
+
+func TestFocusModel_FinishWithNotes(t *testing.T) {
+	m, timer := newTestFocusModel()
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	require.NotNil(t, m.noteInput)
+	// Keys go to the notes now, not the timer
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("quit")})
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+
+	_, cmd = m.Update(cmd())
+	require.NotNil(t, cmd)
+	assert.IsType(t, tea.QuitMsg{}, cmd())
+	require.Len(t, timer.stopped, 1)
+	assert.Equal(t, "quit", timer.stopped[0].Notes)
+	require.NotNil(t, m.Stopped())
+	assert.Equal(t, "s1", m.Stopped().ID)
+}
+
+func TestFocusModel_LeaveKeepsSessionRunning(t *testing.T) {
+	m, timer := newTestFocusModel()
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	require.NotNil(t, cmd)
+	m.Update(cmd())
+	assert.True(t, m.active.IsPaused())
+	assert.Contains(t, m.View(), "paused")
+
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	require.NotNil(t, cmd)
+	assert.IsType(t, tea.QuitMsg{}, cmd())
+	assert.Nil(t, m.Stopped())
+	assert.Empty(t, timer.stopped)
+}
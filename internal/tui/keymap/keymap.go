@@ -0,0 +1,319 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+// Package keymap holds the TUI's key bindings, so the shell and every
+// module agree on them and users can remap them in [tui.keys].
+package keymap
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Action names something a key does. It doubles as the key under [tui.keys].
+type Action string
+
+// Shell actions, handled whichever module is active.
+const (
+	Quit       Action = "quit"
+	NextModule Action = "next_module"
+	PrevModule Action = "prev_module"
+	Help       Action = "help"
+	Refresh    Action = "refresh"
+	Palette    Action = "palette"
+)
+
+// Navigation actions, shared by every module.
+const (
+	Up     Action = "up"
+	Down   Action = "down"
+	Select Action = "select"
+	Back   Action = "back"
+
+	PageUp   Action = "page_up"
+	PageDown Action = "page_down"
+	Top      Action = "top"
+	Bottom   Action = "bottom"
+)
+
+// Stats module actions.
+const (
+	StatsPlans    Action = "stats_plans"
+	StatsSessions Action = "stats_sessions"
+	StatsExport   Action = "stats_export"
+	StatsInsights Action = "stats_insights"
+	TagFilter     Action = "tag_filter"
+	OpenArtifact  Action = "open_artifact"
+	EditNote      Action = "edit_note"
+	SortSessions  Action = "sort_sessions"
+)
+
+// Plans module actions.
+const (
+	NewPlan     Action = "new_plan"
+	EditPlan    Action = "edit_plan"
+	DeletePlan  Action = "delete_plan"
+	Toggle      Action = "toggle"
+	Resources   Action = "resources"
+	Search      Action = "search"       // Also the Stats plan list
+	SortPlans   Action = "sort_plans"   // Also the Stats plan list
+	ReverseSort Action = "reverse_sort" // Also the Stats plan list
+	Board       Action = "board"
+	ColumnLeft  Action = "column_left"
+	ColumnRight Action = "column_right"
+	MoveLeft    Action = "move_left"
+	MoveRight   Action = "move_right"
+)
+
+// Sessions module actions.
+const (
+	StartSession Action = "start_session"
+	StopSession  Action = "stop_session"
+	PauseSession Action = "pause_session"
+)
+
+// Review module actions.
+const (
+	Reveal Action = "reveal"
+)
+
+// binding is an action's default keys and its help text.
+type binding struct {
+	action Action
+	keys   []string
+	help   string
+}
+
+// defaults lists every action in help order. Keys are written as
+// tea.KeyMsg.String() reports them, with "space" for the space bar.
+var defaults = []binding{
+	{Quit, []string{"q"}, "quit"},
+	{NextModule, []string{"tab"}, "next module"},
+	{PrevModule, []string{"shift+tab"}, "previous module"},
+	{Help, []string{"?"}, "show all keys"},
+	{Refresh, []string{"r"}, "reload the module's data"},
+	{Palette, []string{"ctrl+p"}, "command palette"},
+
+	{Up, []string{"up", "k"}, "move up"},
+	{Down, []string{"down", "j"}, "move down"},
+	{Select, []string{"enter"}, "open or confirm"},
+	{Back, []string{"esc"}, "go back or cancel"},
+	{PageUp, []string{"pgup"}, "previous page"},
+	{PageDown, []string{"pgdown"}, "next page"},
+	{Top, []string{"home", "g"}, "jump to top"},
+	{Bottom, []string{"end", "G"}, "jump to bottom"},
+
+	{StatsPlans, []string{"p"}, "plan list"},
+	{StatsSessions, []string{"s"}, "sessions"},
+	{StatsInsights, []string{"i"}, "insights"},
+	{StatsExport, []string{"e"}, "export"},
+	{TagFilter, []string{"t"}, "cycle tag filter"},
+	{OpenArtifact, []string{"o"}, "open artifact"},
+	{EditNote, []string{"n"}, "edit session notes"},
+	{SortSessions, []string{"S"}, "sort sessions"},
+
+	{NewPlan, []string{"n"}, "new plan"},
+	{EditPlan, []string{"e"}, "edit metadata"},
+	{DeletePlan, []string{"d"}, "delete plan"},
+	{Toggle, []string{"space", "x"}, "toggle status"},
+	{Resources, []string{"R"}, "resources"},
+	{Search, []string{"/"}, "search plans"},
+	{SortPlans, []string{"o"}, "sort plans by the next column"},
+	{ReverseSort, []string{"O"}, "reverse the plan sort"},
+	{Board, []string{"b"}, "board of plans or chunks"},
+	{ColumnLeft, []string{"h"}, "previous board column"},
+	{ColumnRight, []string{"l"}, "next board column"},
+	{MoveLeft, []string{"left"}, "move to the previous column"},
+	{MoveRight, []string{"right"}, "move to the next column"},
+
+	{StartSession, []string{"s"}, "start a session"},
+	{StopSession, []string{"x"}, "stop with notes"},
+	{PauseSession, []string{"space", "p"}, "pause or resume"},
+
+	{Reveal, []string{"space"}, "show the answer"},
+}
+
+// shellActions are handled by the app shell before any module sees the key.
+var shellActions = []Action{Quit, NextModule, PrevModule, Help, Refresh, Palette}
+
+// Actions returns every action name, in help order.
+func Actions() []Action {
+	actions := make([]Action, len(defaults))
+	for i, b := range defaults {
+		actions[i] = b.action
+	}
+	return actions
+}
+
+// Keymap maps actions to the keys that trigger them.
+type Keymap struct {
+	keys map[Action][]string
+	help map[Action]string
+}
+
+// Default returns the built-in bindings.
+func Default() *Keymap {
+	km := &Keymap{
+		keys: make(map[Action][]string, len(defaults)),
+		help: make(map[Action]string, len(defaults)),
+	}
+	for _, b := range defaults {
+		km.keys[b.action] = b.keys
+		km.help[b.action] = b.help
+	}
+	return km
+}
+
+// New returns the default bindings with overrides applied. Each override
+// replaces all of an action's keys. Unknown actions, empty key lists, and
+// keys the shell already claims are errors.
+func New(overrides map[string][]string) (*Keymap, error) {
+	km := Default()
+
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		action := Action(name)
+		if _, ok := km.keys[action]; !ok {
+			return nil, fmt.Errorf("unknown key action %q", name)
+		}
+		keys := make([]string, 0, len(overrides[name]))
+		for _, key := range overrides[name] {
+			if key = strings.TrimSpace(key); key != "" {
+				keys = append(keys, key)
+			}
+		}
+		if len(keys) == 0 {
+			return nil, fmt.Errorf("no keys bound to %s", name)
+		}
+		km.keys[action] = keys
+	}
+
+	if err := km.checkShellKeys(); err != nil {
+		return nil, err
+	}
+	return km, nil
+}
+
+// checkShellKeys rejects shell keys bound to a second action, which
+// would never fire because the shell takes the key first.
+func (km *Keymap) checkShellKeys() error {
+	owner := make(map[string]Action)
+	for _, action := range shellActions {
+		for _, key := range km.keys[action] {
+			if isReserved(key) && !(action == Quit && key == "ctrl+c") {
+				return fmt.Errorf("key %s is reserved by the dashboard and cannot be bound to %s", key, action)
+			}
+			if other, ok := owner[key]; ok {
+				return fmt.Errorf("key %s is bound to both %s and %s", key, other, action)
+			}
+			owner[key] = action
+		}
+	}
+	for _, b := range defaults {
+		if km.isShell(b.action) {
+			continue
+		}
+		for _, key := range km.keys[b.action] {
+			if isReserved(key) {
+				return fmt.Errorf("key %s is reserved by the dashboard and cannot be bound to %s", key, b.action)
+			}
+			if other, ok := owner[key]; ok {
+				return fmt.Errorf("key %s is bound to both %s and %s", key, other, b.action)
+			}
+		}
+	}
+	return nil
+}
+
+func (km *Keymap) isShell(action Action) bool {
+	for _, shell := range shellActions {
+		if shell == action {
+			return true
+		}
+	}
+	return false
+}
+
+// isReserved reports whether the shell handles key regardless of the
+// keymap: ctrl+c always quits and 1–9 jump to modules.
+func isReserved(key string) bool {
+	return key == "ctrl+c" || (len(key) == 1 && key[0] >= '1' && key[0] <= '9')
+}
+
+// ShellKeys returns every key the app shell handles itself, including
+// ctrl+c and the module digits.
+func (km *Keymap) ShellKeys() []string {
+	keys := []string{"ctrl+c"}
+	for _, action := range shellActions {
+		keys = append(keys, km.keys[action]...)
+	}
+	for d := '1'; d <= '9'; d++ {
+		keys = append(keys, string(d))
+	}
+	return keys
+}
+
+// Matches reports whether msg is one of action's keys.
+func (km *Keymap) Matches(msg tea.KeyMsg, action Action) bool {
+	pressed := msg.String()
+	if pressed == " " {
+		pressed = "space"
+	}
+	for _, key := range km.keys[action] {
+		if key == pressed {
+			return true
+		}
+	}
+	return false
+}
+
+// Keys returns the keys bound to action.
+func (km *Keymap) Keys(action Action) []string {
+	return km.keys[action]
+}
+
+// Label renders action's keys for footers and help, such as "↑/k".
+func (km *Keymap) Label(action Action) string {
+	keys := km.keys[action]
+	labels := make([]string, len(keys))
+	for i, key := range keys {
+		labels[i] = keyLabel(key)
+	}
+	return strings.Join(labels, "/")
+}
+
+// Help returns action's help text.
+func (km *Keymap) Help(action Action) string {
+	return km.help[action]
+}
+
+// keyLabels are the display names of keys whose tea names read poorly.
+var keyLabels = map[string]string{
+	"up":        "↑",
+	"down":      "↓",
+	"left":      "←",
+	"right":     "→",
+	"enter":     "Enter",
+	"esc":       "Esc",
+	"tab":       "Tab",
+	"shift+tab": "Shift+Tab",
+	"pgup":      "PgUp",
+	"pgdown":    "PgDn",
+	"home":      "Home",
+	"end":       "End",
+}
+
+func keyLabel(key string) string {
+	if label, ok := keyLabels[key]; ok {
+		return label
+	}
+	return key
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package keymap
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runeKey(r rune) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}}
+}
+
+func TestDefault_Matches(t *testing.T) {
+	km := Default()
+
+	assert.True(t, km.Matches(tea.KeyMsg{Type: tea.KeyUp}, Up))
+	assert.True(t, km.Matches(runeKey('k'), Up))
+	assert.False(t, km.Matches(runeKey('j'), Up))
+	assert.True(t, km.Matches(tea.KeyMsg{Type: tea.KeyShiftTab}, PrevModule))
+	assert.True(t, km.Matches(tea.KeyMsg{Type: tea.KeySpace}, Toggle), "the space bar")
+	assert.True(t, km.Matches(runeKey(' '), Toggle), "a typed space")
+
+	for _, action := range Actions() {
+		assert.NotEmpty(t, km.Keys(action), action)
+		assert.NotEmpty(t, km.Help(action), action)
+	}
+}
+
+func TestNew_Overrides(t *testing.T) {
+	km, err := New(map[string][]string{
+		"up":          {"w", " up "},
+		"next_module": {"ctrl+n"},
+		"quit":        {"ctrl+q"},
+	})
+	require.NoError(t, err)
+
+	assert.True(t, km.Matches(runeKey('w'), Up))
+	assert.False(t, km.Matches(runeKey('k'), Up), "an override replaces the defaults")
+	assert.True(t, km.Matches(tea.KeyMsg{Type: tea.KeyCtrlN}, NextModule))
+	assert.False(t, km.Matches(runeKey('q'), Quit))
+	assert.Equal(t, []string{"j"}, km.Keys(Down)[1:], "unmapped actions keep their defaults")
+
+	assert.Equal(t, "w/↑", km.Label(Up))
+	assert.Equal(t, "Enter", km.Label(Select))
+	assert.Contains(t, km.ShellKeys(), "ctrl+q")
+	assert.NotContains(t, km.ShellKeys(), "q")
+}
+
+func TestNew_Invalid(t *testing.T) {
+	tests := map[string]map[string][]string{
+		"unknown action":        {"dance": {"x"}},
+		"no keys":               {"up": {" "}},
+		"shell keys collide":    {"help": {"tab"}},
+		"module key on shell":   {"stats_plans": {"q"}},
+		"module key on default": {"new_plan": {"?"}},
+		"reserved digit":        {"edit_plan": {"3"}},
+		"reserved ctrl+c":       {"next_module": {"ctrl+c"}},
+	}
+	for name, overrides := range tests {
+		_, err := New(overrides)
+		assert.Error(t, err, name)
+	}
+
+	_, err := New(map[string][]string{"quit": {"ctrl+c"}})
+	assert.NoError(t, err, "ctrl+c may be listed for quit")
+}
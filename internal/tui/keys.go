@@ -0,0 +1,48 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pezware/samedi.dev/internal/tui/app"
+	"github.com/pezware/samedi.dev/internal/tui/keymap"
+)
+
+// keyHint renders one entry of a module's help line, such as "[↑/k] Up".
+func keyHint(keys *keymap.Keymap, action keymap.Action, description string) string {
+	return "[" + keys.Label(action) + "] " + description
+}
+
+// keyHints joins help line entries.
+func keyHints(hints ...string) string {
+	return strings.Join(hints, "  |  ")
+}
+
+// shortcutsFor returns shell shortcuts for actions, described by the
+// keymap's help text.
+func shortcutsFor(keys *keymap.Keymap, actions ...keymap.Action) []app.Shortcut {
+	shortcuts := make([]app.Shortcut, len(actions))
+	for i, action := range actions {
+		shortcuts[i] = app.Shortcut{Key: keys.Label(action), Description: keys.Help(action)}
+	}
+	return shortcuts
+}
+
+// commandMsg is a command palette entry a module listed, sent back to it
+// when chosen. The module does what action's key does, on planID when the
+// action needs a plan.
+type commandMsg struct {
+	action keymap.Action
+	planID string
+}
+
+// paletteBusy tells the user a palette command has to wait until they
+// finish with the form, dialog, or review open in module.
+func paletteBusy(module string) tea.Cmd {
+	return func() tea.Msg {
+		return app.StatusMsg{Message: "Finish or cancel what is open in " + module + " first", IsError: true}
+	}
+}
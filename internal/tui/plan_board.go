@@ -0,0 +1,415 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/tui/app"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+	"github.com/pezware/samedi.dev/internal/tui/keymap"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
+)
+
+// Board layout: the width of a column's cards and how many show at once.
+const (
+	boardColumnWidth = 28
+	boardRows        = 12
+)
+
+// boardStatuses are the board's columns, left to right. Archived plans
+// and skipped chunks are left off the board.
+var boardStatuses = []plan.Status{plan.StatusNotStarted, plan.StatusInProgress, plan.StatusCompleted}
+
+// boardTitles names the columns of boardStatuses.
+var boardTitles = []string{"Not Started", "In Progress", "Completed"}
+
+// boardItem is a card on the board: a plan or a chunk.
+type boardItem struct {
+	id    string
+	title string
+	note  string // Shown muted under the title
+}
+
+// planBoard is the kanban view of the plan list or of a plan's chunks: a
+// column per status, and a cursor in each.
+type planBoard struct {
+	column int
+	rows   [3]int
+	follow string // Card to put the cursor on once a move is saved
+}
+
+// planStatusUpdatedMsg reports the outcome of moving a plan on the board.
+type planStatusUpdatedMsg struct {
+	planID string
+	status plan.Status
+	err    error
+}
+
+// boardColumn returns the board column of status, or -1 for statuses the
+// board leaves off.
+func boardColumn(status plan.Status) int {
+	for i, s := range boardStatuses {
+		if s == status {
+			return i
+		}
+	}
+	return -1
+}
+
+// settle clamps the cursors to columns and, after a move, finds the card
+// that moved.
+func (b *planBoard) settle(columns [][]boardItem) {
+	if b.follow != "" {
+		for c, items := range columns {
+			for r, item := range items {
+				if item.id == b.follow {
+					b.column, b.rows[c] = c, r
+				}
+			}
+		}
+		b.follow = ""
+	}
+	for c, items := range columns {
+		b.rows[c] = clampCursor(b.rows[c], len(items))
+	}
+}
+
+// selected returns the card under the cursor, or nil in an empty column.
+func (b *planBoard) selected(columns [][]boardItem) *boardItem {
+	items := columns[b.column]
+	if len(items) == 0 {
+		return nil
+	}
+	return &items[b.rows[b.column]]
+}
+
+// boardKey is what a key asks of the board beyond moving its cursor.
+type boardKey int
+
+const (
+	boardNone   boardKey = iota
+	boardClose           // Back or the board key: leave the board
+	boardOpen            // Select: open the card under the cursor
+	boardMove            // Move the card to the column in planBoard.column
+	boardIgnore          // Not a board key
+)
+
+// update applies msg to the board's cursors. For boardMove the cursor has
+// already gone to the target column, and follow names the card.
+func (b *planBoard) update(keys *keymap.Keymap, msg tea.KeyMsg, columns [][]boardItem) boardKey {
+	switch {
+	case keys.Matches(msg, keymap.Board) || keys.Matches(msg, keymap.Back):
+		return boardClose
+	case keys.Matches(msg, keymap.Select):
+		return boardOpen
+	case keys.Matches(msg, keymap.Up):
+		if b.rows[b.column] > 0 {
+			b.rows[b.column]--
+		}
+	case keys.Matches(msg, keymap.Down):
+		if b.rows[b.column] < len(columns[b.column])-1 {
+			b.rows[b.column]++
+		}
+	case keys.Matches(msg, keymap.ColumnLeft):
+		b.column = max(0, b.column-1)
+	case keys.Matches(msg, keymap.ColumnRight):
+		b.column = min(len(boardStatuses)-1, b.column+1)
+	case keys.Matches(msg, keymap.MoveLeft), keys.Matches(msg, keymap.MoveRight):
+		target := b.column - 1
+		if keys.Matches(msg, keymap.MoveRight) {
+			target = b.column + 1
+		}
+		item := b.selected(columns)
+		if item == nil || target < 0 || target >= len(boardStatuses) {
+			return boardNone
+		}
+		b.follow, b.column = item.id, target
+		return boardMove
+	default:
+		return boardIgnore
+	}
+	return boardNone
+}
+
+// render draws the columns side by side, each scrolled to its cursor.
+// hidden counts the cards left off the board, such as archived plans.
+func (b *planBoard) render(columns [][]boardItem, hidden int, hiddenNote string) string {
+	contents := make([][]string, len(columns))
+	height := 0
+	for c, items := range columns {
+		var lines []string
+		heading := fmt.Sprintf("%s (%d)", boardTitles[c], len(items))
+		if c == b.column {
+			lines = append(lines, styles.Accent().Render(heading), "")
+		} else {
+			lines = append(lines, styles.Section().Render(heading), "")
+		}
+
+		start := max(0, min(b.rows[c]-boardRows/2, len(items)-boardRows))
+		end := min(len(items), start+boardRows)
+		if start > 0 {
+			lines = append(lines, styles.Muted().Render(fmt.Sprintf("↑ %d more", start)))
+		}
+		for r := start; r < end; r++ {
+			title := components.PadRight(components.Truncate(items[r].title, boardColumnWidth), boardColumnWidth)
+			if c == b.column && r == b.rows[c] {
+				title = styles.Selected().Render(title)
+			}
+			lines = append(lines, title, styles.Muted().Render(components.Truncate(items[r].note, boardColumnWidth)))
+		}
+		if end < len(items) {
+			lines = append(lines, styles.Muted().Render(fmt.Sprintf("↓ %d more", len(items)-end)))
+		}
+		if len(items) == 0 {
+			lines = append(lines, styles.Muted().Render("Nothing here"))
+		}
+
+		contents[c] = lines
+		height = max(height, len(lines))
+	}
+
+	// Columns share a height so their borders line up
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.Border().GetForeground()).
+		Padding(0, 1).
+		Width(boardColumnWidth + 2).
+		Height(height)
+	boxes := make([]string, len(columns))
+	for c, lines := range contents {
+		boxes[c] = box.Render(strings.Join(lines, "\n"))
+	}
+
+	out := lipgloss.JoinHorizontal(lipgloss.Top, boxes...)
+	if hidden > 0 {
+		out += "\n" + styles.Muted().Render(fmt.Sprintf("%d %s not shown", hidden, hiddenNote))
+	}
+	return out
+}
+
+// renderBoardHelp renders the board's keys.
+func renderBoardHelp(keys *keymap.Keymap, open string) string {
+	return styles.Muted().Render(keyHints(
+		keyHint(keys, keymap.Up, "Up"),
+		keyHint(keys, keymap.Down, "Down"),
+		fmt.Sprintf("[%s/%s] Column", keys.Label(keymap.ColumnLeft), keys.Label(keymap.ColumnRight)),
+		fmt.Sprintf("[%s/%s] Move", keys.Label(keymap.MoveLeft), keys.Label(keymap.MoveRight)),
+		keyHint(keys, keymap.Select, open),
+		keyHint(keys, keymap.Board, "List")))
+}
+
+// --------------------------------------------------------------------
+// The Plans module's boards
+
+// planBoardColumns sorts the plan list into the board's columns.
+func (m *PlanModule) planBoardColumns() ([][]boardItem, int) {
+	columns := make([][]boardItem, len(boardStatuses))
+	hidden := 0
+	for _, record := range m.plans {
+		c := boardColumn(plan.Status(record.Status))
+		if c < 0 {
+			hidden++
+			continue
+		}
+		note := fmt.Sprintf("%s · %.1fh", record.ID, record.TotalHours)
+		if record.ChunksIndexed && record.ChunksTotal > 0 {
+			note += fmt.Sprintf(" · %d/%d", record.ChunksCompleted, record.ChunksTotal)
+		}
+		columns[c] = append(columns[c], boardItem{id: record.ID, title: record.Title, note: note})
+	}
+	return columns, hidden
+}
+
+// chunkBoardColumns sorts the open plan's chunks into the board's
+// columns.
+func (m *PlanModule) chunkBoardColumns() ([][]boardItem, int) {
+	columns := make([][]boardItem, len(boardStatuses))
+	hidden := 0
+	if m.detailPlan == nil {
+		return columns, hidden
+	}
+	for _, chunk := range m.detailPlan.Chunks {
+		c := boardColumn(chunk.Status)
+		if c < 0 {
+			hidden++
+			continue
+		}
+		note := fmt.Sprintf("%s · %d min", chunk.ID, chunk.Duration)
+		columns[c] = append(columns[c], boardItem{id: chunk.ID, title: chunk.Title, note: note})
+	}
+	return columns, hidden
+}
+
+// handlePlanBoardKeys moves through the board of plans. Moving a plan
+// sets its status.
+func (m *PlanModule) handlePlanBoardKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	columns, _ := m.planBoardColumns()
+	board := m.planBoard
+	board.settle(columns)
+	item, column := board.selected(columns), board.column
+
+	switch board.update(m.keys, msg, columns) {
+	case boardClose:
+		m.planBoard = nil
+		if item != nil {
+			m.selectPlan(item.id)
+		}
+	case boardOpen:
+		if item == nil {
+			return m, nil
+		}
+		m.selectPlan(item.id)
+		return m.openSelectedPlan()
+	case boardIgnore:
+		if s, ok := sortKey(m.keys, msg, m.planSort); ok {
+			if item != nil {
+				board.follow = item.id
+			}
+			return m, m.resortPlans(s)
+		}
+	case boardMove:
+		if m.readOnly {
+			board.follow, board.column = "", column
+			return m, refuseReadOnly()
+		}
+		return m, m.movePlan(item.id, boardStatuses[board.column])
+	}
+	return m, nil
+}
+
+// handleChunkBoardKeys moves through the board of the open plan's
+// chunks. Moving a chunk sets its status as the toggle key does.
+func (m *PlanModule) handleChunkBoardKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	columns, _ := m.chunkBoardColumns()
+	board := m.chunkBoard
+	board.settle(columns)
+	item, column := board.selected(columns), board.column
+
+	switch board.update(m.keys, msg, columns) {
+	case boardClose:
+		m.chunkBoard = nil
+		if item != nil {
+			m.selectChunk(item.id)
+		}
+	case boardOpen:
+		if item == nil {
+			return m, nil
+		}
+		m.chunkBoard = nil
+		m.selectChunk(item.id)
+		m.chunkView = true
+	case boardMove:
+		if m.readOnly {
+			board.follow, board.column = "", column
+			return m, refuseReadOnly()
+		}
+		return m, m.moveChunk(item.id, boardStatuses[board.column])
+	}
+	return m, nil
+}
+
+// selectPlan puts the list cursor on plan id.
+func (m *PlanModule) selectPlan(id string) {
+	for i, record := range m.plans {
+		if record.ID == id {
+			m.listCursor = i
+			return
+		}
+	}
+}
+
+// selectChunk puts the detail view's cursor on chunk id.
+func (m *PlanModule) selectChunk(id string) {
+	for i, chunk := range m.detailPlan.Chunks {
+		if chunk.ID == id {
+			m.chunkCursor = i
+			m.resourceCursor = 0
+			return
+		}
+	}
+}
+
+// movePlan saves a plan's new status.
+func (m *PlanModule) movePlan(planID string, status plan.Status) tea.Cmd {
+	if m.service == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		err := m.service.UpdatePlanStatus(context.Background(), planID, status)
+		return planStatusUpdatedMsg{planID: planID, status: status, err: err}
+	}
+}
+
+// moveChunk saves a chunk's new status.
+func (m *PlanModule) moveChunk(chunkID string, status plan.Status) tea.Cmd {
+	if m.service == nil {
+		return nil
+	}
+	planID := m.detailPlan.ID
+	return func() tea.Msg {
+		err := m.service.UpdateChunkStatus(context.Background(), planID, chunkID, status)
+		return chunkStatusUpdatedMsg{planID: planID, chunkID: chunkID, status: status, err: err}
+	}
+}
+
+// handlePlanStatusUpdated reloads the plans once a move is saved, keeping
+// the board on screen.
+func (m *PlanModule) handlePlanStatusUpdated(msg planStatusUpdatedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		if m.planBoard != nil {
+			m.planBoard.follow = msg.planID
+		}
+		return m, func() tea.Msg {
+			return app.StatusMsg{Message: fmt.Sprintf("Failed to move plan: %v", msg.err), IsError: true}
+		}
+	}
+
+	title := boardTitles[boardColumn(msg.status)]
+	return m, tea.Batch(
+		func() tea.Msg {
+			return app.StatusMsg{Message: "Plan moved to " + title}
+		},
+		func() tea.Msg {
+			return app.BroadcastMsg{Topic: app.TopicPlansChanged, Payload: msg.planID}
+		},
+		func() tea.Msg {
+			records, err := m.service.List(context.Background(), nil)
+			return plansLoadedMsg{records: records, err: err, quiet: true}
+		},
+	)
+}
+
+// renderPlanBoard renders the board of plans.
+func (m *PlanModule) renderPlanBoard() string {
+	columns, hidden := m.planBoardColumns()
+	m.planBoard.settle(columns)
+
+	var b strings.Builder
+	b.WriteString(styles.Title().Render("Plans · board"))
+	b.WriteString("\n\n")
+	b.WriteString(m.planBoard.render(columns, hidden, "archived"))
+	b.WriteString("\n\n")
+	b.WriteString(renderBoardHelp(m.keys, "Open"))
+	return b.String()
+}
+
+// renderChunkBoard renders the board of the open plan's chunks.
+func (m *PlanModule) renderChunkBoard() string {
+	columns, hidden := m.chunkBoardColumns()
+	m.chunkBoard.settle(columns)
+
+	var b strings.Builder
+	b.WriteString(styles.Title().Render(m.detailPlan.Title + " · board"))
+	b.WriteString("\n\n")
+	b.WriteString(m.chunkBoard.render(columns, hidden, "skipped"))
+	b.WriteString("\n\n")
+	b.WriteString(renderBoardHelp(m.keys, "View chunk"))
+	return b.String()
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
+)
+
+// chunkViewWidth is the column the chunk pane wraps its markdown at.
+const chunkViewWidth = 80
+
+// renderChunkView renders the selected chunk's markdown from the plan
+// file, notes and code examples included, for the chunk pane.
+func (m *PlanModule) renderChunkView() string {
+	chunk := m.selectedChunk()
+	if chunk == nil {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n%s\n\n", styles.Section().Render(chunk.Title))
+
+	body, ok := plan.ChunkMarkdown(m.markdown, chunk.ID)
+	if !ok || body == "" {
+		b.WriteString(styles.Muted().Render("Nothing written for this chunk yet."))
+		b.WriteString("\n")
+		return b.String()
+	}
+	rendered, err := components.RenderMarkdown(body, chunkViewWidth)
+	if err != nil {
+		b.WriteString(styles.Error().Render(err.Error()))
+		b.WriteString("\n")
+		return b.String()
+	}
+	b.WriteString(rendered)
+	b.WriteString("\n")
+	return b.String()
+}
@@ -8,13 +8,17 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/stats"
 	"github.com/pezware/samedi.dev/internal/storage"
 	"github.com/pezware/samedi.dev/internal/tui/app"
 	"github.com/pezware/samedi.dev/internal/tui/components"
+	"github.com/pezware/samedi.dev/internal/tui/keymap"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
 )
 
 type planModuleState string
@@ -36,14 +40,52 @@ type PlanModule struct {
 	plans      []*storage.PlanRecord
 	listCursor int
 
+	// planPrefixes holds the tree branch drawn before each plan's ID; the
+	// list is in tree order, with sub-plans beneath their parents, unless
+	// planSort orders it. loaded keeps the plans as stored for re-sorting.
+	planPrefixes []string
+	planSort     plan.ListSort
+	saveSort     SortSaver
+	loaded       []*storage.PlanRecord
+
+	// search, while open, narrows the list to the plans matching it.
+	search *planSearch
+
+	// planBoard, while open, shows the plans as a board of status columns
+	// instead of the list; chunkBoard does the same for the open plan's
+	// chunks.
+	planBoard  *planBoard
+	chunkBoard *planBoard
+
 	detailPlan  *plan.Plan
 	chunkCursor int
 
+	// chunkStats holds the detail plan's actual time per chunk, and
+	// forecast its projected completion.
+	chunkStats *stats.ChunkBreakdown
+	forecast   *stats.Forecast
+
+	// resourceFocus moves the detail view's cursor from the chunk table
+	// into the selected chunk's resources.
+	resourceFocus  bool
+	resourceCursor int
+
+	// markdown is the detail plan's file content. chunkView shows the
+	// selected chunk's section of it, rendered, instead of its resources.
+	markdown  string
+	chunkView bool
+
 	form       *planForm
 	confirm    *confirmDialog
 	loading    bool
 	loadErr    error
 	dataLoaded bool
+
+	// readOnly refuses creating, editing, and deleting plans and changing
+	// chunk or resource status; browsing still works.
+	readOnly bool
+
+	keys *keymap.Keymap
 }
 
 type planFormMode string
@@ -75,11 +117,24 @@ type confirmDialog struct {
 type plansLoadedMsg struct {
 	records []*storage.PlanRecord
 	err     error
+	quiet   bool // An auto refresh: no status message
 }
 
 type planLoadedMsg struct {
 	plan *plan.Plan
 	err  error
+
+	// chunkStats is the time logged against each chunk and forecast the
+	// projected completion; both are nil when sessions could not be read.
+	chunkStats *stats.ChunkBreakdown
+	forecast   *stats.Forecast
+
+	// markdown is the plan file's content, empty if it could not be read.
+	markdown string
+
+	// refresh is set when an open plan is reloaded after an external edit;
+	// the chunk cursor is kept instead of reset.
+	refresh bool
 }
 
 type planSavedMsg struct {
@@ -110,6 +165,34 @@ func NewPlanModule(service *plan.Service) *PlanModule {
 	return &PlanModule{
 		service: service,
 		state:   statePlanList,
+		keys:    keymap.Default(),
+	}
+}
+
+// SetKeymap replaces the module's key bindings.
+func (m *PlanModule) SetKeymap(keys *keymap.Keymap) {
+	m.keys = keys
+}
+
+// SetPlanSort sets the order of the plan list, and how a new one chosen
+// with the sort keys is saved. save may be nil.
+func (m *PlanModule) SetPlanSort(s plan.ListSort, save SortSaver) {
+	m.planSort = s
+	m.saveSort = save
+	m.arrangePlans()
+}
+
+// SetReadOnly turns read-only mode on or off. In read-only mode the keys
+// that change plans report an error in the footer instead.
+func (m *PlanModule) SetReadOnly(readOnly bool) {
+	m.readOnly = readOnly
+}
+
+// refuseReadOnly returns the footer error shown for a change refused in
+// read-only mode.
+func refuseReadOnly() tea.Cmd {
+	return func() tea.Msg {
+		return app.StatusMsg{Message: "Read-only mode: plans cannot be changed", IsError: true}
 	}
 }
 
@@ -125,18 +208,53 @@ func (m *PlanModule) Title() string {
 
 // Shortcuts satisfies app.Module.
 func (m *PlanModule) Shortcuts() []app.Shortcut {
+	keys := m.keys
+	switch {
+	case m.search != nil && m.state == statePlanList:
+		return []app.Shortcut{
+			{Key: "Enter", Description: "open plan"},
+			{Key: "Esc", Description: "clear search"},
+		}
+	case m.planBoard != nil && m.state == statePlanList, m.chunkBoard != nil && m.state == statePlanDetail:
+		return []app.Shortcut{
+			{Key: keys.Label(keymap.ColumnLeft) + "/" + keys.Label(keymap.ColumnRight), Description: "column"},
+			{Key: keys.Label(keymap.MoveLeft) + "/" + keys.Label(keymap.MoveRight), Description: "move"},
+			{Key: keys.Label(keymap.Board), Description: "back to the list"},
+		}
+	case m.readOnly && m.state == statePlanList:
+		return []app.Shortcut{
+			{Key: keys.Label(keymap.Select), Description: "view plan"},
+			{Key: keys.Label(keymap.Search), Description: "search"},
+			{Key: keys.Label(keymap.SortPlans), Description: "sort"},
+			{Key: keys.Label(keymap.Board), Description: "board"},
+		}
+	case m.readOnly && m.state == statePlanDetail:
+		return []app.Shortcut{
+			{Key: keys.Label(keymap.Select), Description: "view chunk"},
+			{Key: keys.Label(keymap.Resources), Description: "browse resources"},
+			{Key: keys.Label(keymap.Back), Description: "back"},
+			{Key: keys.Label(keymap.Board), Description: "board"},
+		}
+	}
+
 	switch m.state {
 	case statePlanList:
 		return []app.Shortcut{
-			{Key: "Enter", Description: "view plan"},
-			{Key: "n", Description: "new plan"},
-			{Key: "d", Description: "delete plan"},
+			{Key: keys.Label(keymap.Select), Description: "view plan"},
+			{Key: keys.Label(keymap.NewPlan), Description: "new plan"},
+			{Key: keys.Label(keymap.DeletePlan), Description: "delete plan"},
+			{Key: keys.Label(keymap.Search), Description: "search"},
+			{Key: keys.Label(keymap.SortPlans), Description: "sort"},
+			{Key: keys.Label(keymap.Board), Description: "board"},
 		}
 	case statePlanDetail:
 		return []app.Shortcut{
-			{Key: "space", Description: "toggle chunk status"},
-			{Key: "e", Description: "edit metadata"},
-			{Key: "d", Description: "delete plan"},
+			{Key: keys.Label(keymap.Select), Description: "view chunk"},
+			{Key: keys.Label(keymap.Toggle), Description: "toggle chunk status"},
+			{Key: keys.Label(keymap.Resources), Description: "check off resources"},
+			{Key: keys.Label(keymap.EditPlan), Description: "edit metadata"},
+			{Key: keys.Label(keymap.DeletePlan), Description: "delete plan"},
+			{Key: keys.Label(keymap.Board), Description: "board"},
 		}
 	case statePlanEdit, statePlanCreate:
 		return []app.Shortcut{
@@ -149,6 +267,28 @@ func (m *PlanModule) Shortcuts() []app.Shortcut {
 	}
 }
 
+// Help lists every plan binding for the shell's help overlay.
+func (m *PlanModule) Help() []app.Shortcut {
+	actions := []keymap.Action{keymap.Up, keymap.Down, keymap.Select, keymap.Back, keymap.Search, keymap.SortPlans, keymap.ReverseSort, keymap.Resources, keymap.Toggle,
+		keymap.Board, keymap.ColumnLeft, keymap.ColumnRight}
+	if !m.readOnly {
+		actions = append(actions, keymap.MoveLeft, keymap.MoveRight, keymap.NewPlan, keymap.EditPlan, keymap.DeletePlan)
+	}
+	return shortcutsFor(m.keys, actions...)
+}
+
+// CapturingInput reports whether the search or a form has a text field,
+// so the shell passes keys such as 'q' and Tab to it.
+func (m *PlanModule) CapturingInput() bool {
+	switch m.state {
+	case statePlanList:
+		return m.search != nil
+	case statePlanEdit, statePlanCreate:
+		return true
+	}
+	return false
+}
+
 // Init satisfies tea.Model.
 func (m *PlanModule) Init() tea.Cmd {
 	return nil
@@ -169,8 +309,18 @@ func (m *PlanModule) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m.handlePlanDeleted(msg)
 	case chunkStatusUpdatedMsg:
 		return m.handleChunkStatusUpdated(msg)
+	case planStatusUpdatedMsg:
+		return m.handlePlanStatusUpdated(msg)
+	case resourceToggledMsg:
+		return m.handleResourceToggled(msg)
 	case planCreatedMsg:
 		return m.handlePlanCreated(msg)
+	case app.BroadcastMsg:
+		return m.handleBroadcast(msg)
+	case app.RefreshMsg:
+		return m, m.refresh(msg.Auto)
+	case commandMsg:
+		return m.runCommand(msg)
 	case app.ModuleActivatedMsg:
 		if msg.ID == m.ID() && m.state != statePlanEdit && m.state != statePlanCreate && m.state != statePlanConfirm {
 			cmd := m.loadPlans()
@@ -192,8 +342,14 @@ func (m *PlanModule) View() string {
 
 	switch m.state {
 	case statePlanList:
+		if m.planBoard != nil && m.search == nil {
+			return m.renderPlanBoard()
+		}
 		return m.renderPlanList()
 	case statePlanDetail:
+		if m.chunkBoard != nil && m.detailPlan != nil {
+			return m.renderChunkBoard()
+		}
 		return m.renderPlanDetail()
 	case statePlanEdit, statePlanCreate:
 		return m.renderForm()
@@ -237,17 +393,139 @@ func (m *PlanModule) handlePlansLoaded(msg plansLoadedMsg) (tea.Model, tea.Cmd)
 		}
 	}
 
-	m.plans = msg.records
+	m.setPlans(msg.records)
 	m.dataLoaded = true
 	if m.listCursor >= len(m.plans) {
 		m.listCursor = maxInt(0, len(m.plans)-1)
 	}
+	if msg.quiet {
+		return m, nil
+	}
 
 	return m, func() tea.Msg {
 		return app.StatusMsg{Message: "Plans refreshed"}
 	}
 }
 
+// Commands satisfies app.CommandProvider: a new plan, and opening each
+// plan.
+func (m *PlanModule) Commands() []app.Command {
+	var commands []app.Command
+	if !m.readOnly {
+		commands = append(commands, app.Command{
+			Title: "New plan",
+			Hint:  m.keys.Label(keymap.NewPlan),
+			Msg:   commandMsg{action: keymap.NewPlan},
+		})
+	}
+	for _, record := range m.plans {
+		commands = append(commands, app.Command{
+			Title: "Open plan: " + record.Title,
+			Hint:  record.ID,
+			Msg:   commandMsg{action: keymap.Select, planID: record.ID},
+		})
+	}
+	return commands
+}
+
+// runCommand runs a command palette entry. An open form or dialog is
+// never discarded for one.
+func (m *PlanModule) runCommand(msg commandMsg) (tea.Model, tea.Cmd) {
+	if m.state == statePlanEdit || m.state == statePlanCreate || m.state == statePlanConfirm {
+		return m, paletteBusy(m.Title())
+	}
+	m.search = nil
+	switch msg.action {
+	case keymap.NewPlan:
+		return m.showCreateForm()
+	case keymap.Select:
+		if m.service == nil {
+			return m, nil
+		}
+		m.resourceFocus = false
+		m.loading = true
+		return m, func() tea.Msg {
+			return m.fetchPlan(msg.planID, false)
+		}
+	}
+	return m, nil
+}
+
+// refresh reloads the plan list, or the open plan, for the refresh key or
+// an auto refresh. An auto refresh keeps the current list on screen while
+// it loads. Open forms and dialogs are left undisturbed.
+func (m *PlanModule) refresh(auto bool) tea.Cmd {
+	switch m.state {
+	case statePlanList:
+		if !auto || m.service == nil {
+			return m.loadPlans()
+		}
+		return func() tea.Msg {
+			records, err := m.service.List(context.Background(), nil)
+			return plansLoadedMsg{records: records, err: err, quiet: true}
+		}
+	case statePlanDetail:
+		if m.detailPlan == nil || m.service == nil {
+			return nil
+		}
+		planID := m.detailPlan.ID
+		if !m.service.Exists(context.Background(), planID) {
+			m.detailPlan = nil
+			m.state = statePlanList
+			return m.loadPlans()
+		}
+		return func() tea.Msg {
+			return m.fetchPlan(planID, true)
+		}
+	default:
+		return nil
+	}
+}
+
+// setPlans stores records in tree order so sub-plans list beneath their
+// parents, or in the order of the plan sort.
+func (m *PlanModule) setPlans(records []*storage.PlanRecord) {
+	m.loaded = records
+	m.arrangePlans()
+}
+
+// arrangePlans orders the loaded plans for the list. A sorted list is
+// flat: sub-plans lose their place beneath their parents.
+func (m *PlanModule) arrangePlans() {
+	if m.planSort.Field != "" {
+		m.plans = append([]*storage.PlanRecord(nil), m.loaded...)
+		plan.SortRecords(m.plans, m.planSort)
+		m.planPrefixes = make([]string, len(m.plans))
+		return
+	}
+
+	rows := plan.Tree(m.loaded)
+	m.plans = make([]*storage.PlanRecord, len(rows))
+	m.planPrefixes = make([]string, len(rows))
+	for i, row := range rows {
+		m.plans[i] = row.Record
+		m.planPrefixes[i] = row.Prefix
+	}
+}
+
+// resortPlans applies a new plan sort, keeping the cursor on the same
+// plan, and saves it.
+func (m *PlanModule) resortPlans(s plan.ListSort) tea.Cmd {
+	var selected string
+	if m.listCursor < len(m.plans) {
+		selected = m.plans[m.listCursor].ID
+	}
+	m.planSort = s
+	m.arrangePlans()
+	for i, record := range m.plans {
+		if record.ID == selected {
+			m.listCursor = i
+			break
+		}
+	}
+	return sortChanged(s, m.saveSort)
+}
+
 func (m *PlanModule) handlePlanLoaded(msg planLoadedMsg) (tea.Model, tea.Cmd) {
 	m.loading = false
 	if msg.err != nil {
@@ -259,13 +537,54 @@ func (m *PlanModule) handlePlanLoaded(msg planLoadedMsg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	if !msg.refresh && (m.state != statePlanDetail || m.detailPlan == nil || m.detailPlan.ID != msg.plan.ID) {
+		m.chunkBoard = nil
+	}
 	m.detailPlan = msg.plan
+	m.chunkStats = msg.chunkStats
+	m.forecast = msg.forecast
+	m.markdown = msg.markdown
 	m.state = statePlanDetail
-	m.chunkCursor = 0
+	if !msg.refresh || m.chunkCursor >= len(msg.plan.Chunks) {
+		m.chunkCursor = 0
+	}
+	if !msg.refresh {
+		m.chunkView = false
+	}
+	m.clampResourceCursor()
 
 	return m, nil
 }
 
+// handleBroadcast reloads plans changed elsewhere, including chunk status
+// changes from sessions. Only external broadcasts reach the active module;
+// open forms and dialogs are left undisturbed.
+func (m *PlanModule) handleBroadcast(msg app.BroadcastMsg) (tea.Model, tea.Cmd) {
+	if msg.Topic != app.TopicPlansChanged && msg.Topic != app.TopicSessionsChanged {
+		return m, nil
+	}
+
+	switch m.state {
+	case statePlanList:
+		return m, m.loadPlans()
+	case statePlanDetail:
+		planID, _ := msg.Payload.(string)
+		if m.detailPlan == nil || planID != m.detailPlan.ID {
+			return m, nil
+		}
+		if m.service == nil || !m.service.Exists(context.Background(), planID) {
+			m.detailPlan = nil
+			m.state = statePlanList
+			return m, m.loadPlans()
+		}
+		return m, func() tea.Msg {
+			return m.fetchPlan(planID, true)
+		}
+	default:
+		return m, nil
+	}
+}
+
 func (m *PlanModule) handlePlanSaved(msg planSavedMsg) (tea.Model, tea.Cmd) {
 	m.loading = false
 	if msg.err != nil {
@@ -389,8 +708,29 @@ func (m *PlanModule) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 }
 
 func (m *PlanModule) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.Type {
-	case tea.KeyUp:
+	if m.search != nil {
+		return m.handleSearchKeys(msg)
+	}
+	if m.planBoard != nil {
+		return m.handlePlanBoardKeys(msg)
+	}
+
+	if s, ok := sortKey(m.keys, msg, m.planSort); ok {
+		return m, m.resortPlans(s)
+	}
+
+	keys := m.keys
+	switch {
+	case keys.Matches(msg, keymap.Search):
+		if len(m.plans) > 0 {
+			m.search = newPlanSearch(m.listCursor)
+		}
+	case keys.Matches(msg, keymap.Board):
+		m.planBoard = &planBoard{}
+		if len(m.plans) > 0 {
+			m.planBoard.follow = m.plans[m.listCursor].ID
+		}
+	case keys.Matches(msg, keymap.Up):
 		if len(m.plans) == 0 {
 			return m, nil
 		}
@@ -398,7 +738,7 @@ func (m *PlanModule) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if m.listCursor < 0 {
 			m.listCursor = len(m.plans) - 1
 		}
-	case tea.KeyDown:
+	case keys.Matches(msg, keymap.Down):
 		if len(m.plans) == 0 {
 			return m, nil
 		}
@@ -406,33 +746,70 @@ func (m *PlanModule) handleListKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if m.listCursor >= len(m.plans) {
 			m.listCursor = 0
 		}
-	case tea.KeyEnter:
+	case keys.Matches(msg, keymap.Select):
 		return m.openSelectedPlan()
-	case tea.KeyRunes:
-		if len(msg.Runes) == 0 {
+	case keys.Matches(msg, keymap.NewPlan):
+		return m.showCreateForm()
+	case keys.Matches(msg, keymap.DeletePlan):
+		return m.startDeleteSelectedPlan()
+	}
+	return m, nil
+}
+
+// handleSearchKeys edits the search. Enter opens the highlighted plan and
+// Esc returns to the whole list where the cursor was.
+func (m *PlanModule) handleSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	matches := m.searchMatches()
+	switch m.search.update(msg, len(matches)) {
+	case searchClosed:
+		m.listCursor = m.search.before
+		m.search = nil
+	case searchChosen:
+		if m.search.cursor >= len(matches) {
 			return m, nil
 		}
-		switch msg.Runes[0] {
-		case 'n', 'N':
-			return m.showCreateForm()
-		case 'd', 'D':
-			return m.startDeleteSelectedPlan()
-		}
+		m.listCursor = matches[m.search.cursor].index
+		m.search = nil
+		return m.openSelectedPlan()
 	}
 	return m, nil
 }
 
+// searchMatches returns the plans matching the open search, best first.
+func (m *PlanModule) searchMatches() []planMatch {
+	fields := make([]planSearchFields, len(m.plans))
+	for i, record := range m.plans {
+		fields[i] = planSearchFields{id: record.ID, title: record.Title, tags: record.Tags}
+	}
+	matches := matchPlans(m.search.input.Value(), fields)
+	m.search.cursor = clampCursor(m.search.cursor, len(matches))
+	return matches
+}
+
 func (m *PlanModule) handleDetailKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if m.detailPlan == nil {
 		return m, nil
 	}
+	if m.resourceFocus {
+		return m.handleResourceKeys(msg)
+	}
+	if m.chunkBoard != nil {
+		return m.handleChunkBoardKeys(msg)
+	}
 
-	switch msg.Type {
-	case tea.KeyEsc:
+	keys := m.keys
+	switch {
+	case keys.Matches(msg, keymap.Back):
+		if m.chunkView {
+			m.chunkView = false
+			return m, nil
+		}
 		m.state = statePlanList
 		m.detailPlan = nil
 		return m, nil
-	case tea.KeyUp:
+	case keys.Matches(msg, keymap.Select):
+		m.chunkView = !m.chunkView && m.selectedChunk() != nil
+	case keys.Matches(msg, keymap.Up):
 		if len(m.detailPlan.Chunks) == 0 {
 			return m, nil
 		}
@@ -440,7 +817,8 @@ func (m *PlanModule) handleDetailKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if m.chunkCursor < 0 {
 			m.chunkCursor = len(m.detailPlan.Chunks) - 1
 		}
-	case tea.KeyDown:
+		m.resourceCursor = 0
+	case keys.Matches(msg, keymap.Down):
 		if len(m.detailPlan.Chunks) == 0 {
 			return m, nil
 		}
@@ -448,23 +826,30 @@ func (m *PlanModule) handleDetailKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if m.chunkCursor >= len(m.detailPlan.Chunks) {
 			m.chunkCursor = 0
 		}
-	case tea.KeyRunes:
-		if len(msg.Runes) == 0 {
-			return m, nil
+		m.resourceCursor = 0
+	case keys.Matches(msg, keymap.Toggle):
+		return m.toggleSelectedChunk()
+	case keys.Matches(msg, keymap.Board):
+		m.chunkView = false
+		m.chunkBoard = &planBoard{}
+		if chunk := m.selectedChunk(); chunk != nil {
+			m.chunkBoard.follow = chunk.ID
 		}
-		switch msg.Runes[0] {
-		case 'e', 'E':
-			return m.showEditForm()
-		case 'd', 'D':
-			m.confirm = &confirmDialog{
-				action:  confirmDelete,
-				message: "Delete this plan? This will remove the markdown file.",
-			}
-			m.state = statePlanConfirm
-			return m, nil
-		case ' ':
-			return m.toggleSelectedChunk()
+	case keys.Matches(msg, keymap.EditPlan):
+		return m.showEditForm()
+	case keys.Matches(msg, keymap.DeletePlan):
+		if m.readOnly {
+			return m, refuseReadOnly()
 		}
+		m.confirm = &confirmDialog{
+			action:  confirmDelete,
+			message: "Delete this plan? This will remove the markdown file.",
+		}
+		m.state = statePlanConfirm
+		return m, nil
+	case keys.Matches(msg, keymap.Resources):
+		m.chunkView = false
+		return m.focusResources()
 	}
 	return m, nil
 }
@@ -509,12 +894,12 @@ func (m *PlanModule) handleConfirmKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
-	switch msg.Type {
-	case tea.KeyEsc:
+	switch {
+	case m.keys.Matches(msg, keymap.Back):
 		m.confirm = nil
 		m.state = statePlanDetail
 		return m, nil
-	case tea.KeyEnter:
+	case m.keys.Matches(msg, keymap.Select):
 		if m.confirm.action == confirmDelete {
 			cmd := m.deleteCurrentPlan()
 			return m, cmd
@@ -534,16 +919,21 @@ func (m *PlanModule) openSelectedPlan() (tea.Model, tea.Cmd) {
 	record := m.plans[m.listCursor]
 	m.loading = true
 	return m, func() tea.Msg {
-		planData, err := m.service.Get(context.Background(), record.ID)
-		return planLoadedMsg{plan: planData, err: err}
+		return m.fetchPlan(record.ID, false)
 	}
 }
 
 func (m *PlanModule) showCreateForm() (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m, refuseReadOnly()
+	}
+
 	inputs := []*inputField{
 		newInputField("Topic (e.g. Rust async)"),
 		newInputField("Total hours (e.g. 40)"),
 		newInputField("Level (beginner/intermediate/advanced)"),
+		newTagInputField(m.knownTags()),
+		newInputField("Deadline (YYYY-MM-DD, optional)"),
 	}
 	inputs[0].Focus()
 	m.form = &planForm{
@@ -560,6 +950,9 @@ func (m *PlanModule) startDeleteSelectedPlan() (tea.Model, tea.Cmd) {
 	if len(m.plans) == 0 || m.listCursor >= len(m.plans) {
 		return m, nil
 	}
+	if m.readOnly {
+		return m, refuseReadOnly()
+	}
 	record := m.plans[m.listCursor]
 
 	m.confirm = &confirmDialog{
@@ -597,15 +990,22 @@ func (m *PlanModule) showEditForm() (tea.Model, tea.Cmd) {
 	if m.detailPlan == nil {
 		return m, nil
 	}
+	if m.readOnly {
+		return m, refuseReadOnly()
+	}
 
 	inputs := []*inputField{
 		newInputField("Title"),
 		newInputField("Total hours"),
-		newInputField("Tags (comma separated)"),
+		newTagInputField(m.knownTags()),
+		newInputField("Deadline (YYYY-MM-DD, optional)"),
 	}
 	inputs[0].SetValue(m.detailPlan.Title)
 	inputs[1].SetValue(fmt.Sprintf("%.1f", m.detailPlan.TotalHours))
 	inputs[2].SetValue(strings.Join(m.detailPlan.Tags, ", "))
+	if m.detailPlan.Deadline != nil {
+		inputs[3].SetValue(m.detailPlan.Deadline.String())
+	}
 	inputs[0].Focus()
 
 	m.form = &planForm{
@@ -640,6 +1040,7 @@ func (m *PlanModule) createPlanFromForm() tea.Cmd {
 	topic := strings.TrimSpace(m.form.inputs[0].Value())
 	hoursStr := strings.TrimSpace(m.form.inputs[1].Value())
 	level := strings.TrimSpace(strings.ToLower(m.form.inputs[2].Value()))
+	tags := parseTags(m.form.inputs[3].Value())
 
 	if topic == "" {
 		m.form.validationErr = fmt.Errorf("topic is required")
@@ -652,11 +1053,19 @@ func (m *PlanModule) createPlanFromForm() tea.Cmd {
 		return nil
 	}
 
+	deadline, err := parseDeadline(m.form.inputs[4].Value())
+	if err != nil {
+		m.form.validationErr = err
+		return nil
+	}
+
 	m.loading = true
 	req := plan.CreateRequest{
 		Topic:      topic,
 		TotalHours: hours,
 		Level:      level,
+		Tags:       tags,
+		Deadline:   deadline,
 	}
 
 	return func() tea.Msg {
@@ -681,7 +1090,7 @@ func (m *PlanModule) updatePlanFromForm() tea.Cmd {
 
 	title := strings.TrimSpace(m.form.inputs[0].Value())
 	hoursStr := strings.TrimSpace(m.form.inputs[1].Value())
-	tagsStr := strings.TrimSpace(m.form.inputs[2].Value())
+	tags := parseTags(m.form.inputs[2].Value())
 
 	if title == "" {
 		m.form.validationErr = fmt.Errorf("title is required")
@@ -694,20 +1103,17 @@ func (m *PlanModule) updatePlanFromForm() tea.Cmd {
 		return nil
 	}
 
-	var tags []string
-	if tagsStr != "" {
-		for _, tag := range strings.Split(tagsStr, ",") {
-			t := strings.TrimSpace(tag)
-			if t != "" {
-				tags = append(tags, t)
-			}
-		}
+	deadline, err := parseDeadline(m.form.inputs[3].Value())
+	if err != nil {
+		m.form.validationErr = err
+		return nil
 	}
 
 	planCopy := *m.detailPlan
 	planCopy.Title = title
 	planCopy.TotalHours = hours
 	planCopy.Tags = tags
+	planCopy.Deadline = deadline
 
 	m.loading = true
 	return func() tea.Msg {
@@ -720,6 +1126,9 @@ func (m *PlanModule) toggleSelectedChunk() (tea.Model, tea.Cmd) {
 	if m.detailPlan == nil || len(m.detailPlan.Chunks) == 0 {
 		return m, nil
 	}
+	if m.readOnly {
+		return m, refuseReadOnly()
+	}
 
 	chunk := m.detailPlan.Chunks[m.chunkCursor]
 	nextStatus := nextChunkStatus(chunk.Status)
@@ -738,19 +1147,42 @@ func (m *PlanModule) toggleSelectedChunk() (tea.Model, tea.Cmd) {
 
 func (m *PlanModule) reloadPlan(planID string) tea.Cmd {
 	return func() tea.Msg {
-		planData, err := m.service.Get(context.Background(), planID)
-		return planLoadedMsg{plan: planData, err: err}
+		return m.fetchPlan(planID, false)
 	}
 }
 
+// fetchPlan loads a plan with the time logged against each of its chunks.
+// The plan still opens if its sessions can't be read.
+func (m *PlanModule) fetchPlan(planID string, refresh bool) planLoadedMsg {
+	ctx := context.Background()
+	planData, err := m.service.Get(ctx, planID)
+	if err != nil {
+		return planLoadedMsg{err: err, refresh: refresh}
+	}
+
+	msg := planLoadedMsg{plan: planData, refresh: refresh}
+	if content, err := m.service.Markdown(ctx, planID); err == nil {
+		msg.markdown = content
+	}
+	if sessions, err := m.service.PlanSessions(ctx, planID); err == nil {
+		breakdown := stats.CalculateChunkStats(planData, sessions)
+		msg.chunkStats = &breakdown
+		msg.forecast = stats.CalculateForecast(planData, sessions, time.Now())
+	}
+	return msg
+}
+
 // --------------------------------------------------------------------
 // Rendering helpers
 
 func (m *PlanModule) renderPlanList() string {
 	var b strings.Builder
 
-	title := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12")).Render("Plans")
-	b.WriteString(title)
+	title := "Plans"
+	if m.planSort.Field != "" {
+		title += " · sorted by " + m.planSort.Label()
+	}
+	b.WriteString(styles.Title().Render(title))
 	b.WriteString("\n\n")
 
 	if len(m.plans) == 0 {
@@ -759,16 +1191,23 @@ func (m *PlanModule) renderPlanList() string {
 		return b.String()
 	}
 
-	table := components.NewTable([]string{"ID", "Title", "Status", "Hours"})
+	if m.search != nil {
+		b.WriteString(m.renderSearchResults())
+		return b.String()
+	}
+
+	showDue := m.anyDeadline()
+	table := components.NewTable(planListHeaders(showDue))
 
+	now := time.Now()
 	for i, record := range m.plans {
-		row := []string{
-			record.ID,
-			record.Title,
-			record.Status,
-			fmt.Sprintf("%.1f", record.TotalHours),
+		id := record.ID
+		if i < len(m.planPrefixes) {
+			id = m.planPrefixes[i] + id
 		}
 
+		row := planListRow(record, id, record.Title, showDue, now)
+
 		if i == m.listCursor {
 			table.AddHighlightedRow(row)
 		} else {
@@ -780,6 +1219,91 @@ func (m *PlanModule) renderPlanList() string {
 	return b.String()
 }
 
+// renderSearchResults renders the search field and the matching plans,
+// best first, with the matched letters marked. Sub-plans lose their tree
+// branches: the matches are not in tree order.
+func (m *PlanModule) renderSearchResults() string {
+	matches := m.searchMatches()
+	var b strings.Builder
+	b.WriteString(m.search.renderSearchBar(len(matches), len(m.plans)))
+	if len(matches) == 0 {
+		b.WriteString(styles.Muted().Render("No plans match."))
+		return b.String()
+	}
+
+	showDue := m.anyDeadline()
+	table := components.NewTable(planListHeaders(showDue))
+	now := time.Now()
+	for i, match := range matches {
+		record := m.plans[match.index]
+		rest := lipgloss.NewStyle()
+		if i == m.search.cursor {
+			rest = styles.Accent()
+		}
+		row := planListRow(record, match.highlightID(record.ID, rest), match.highlightTitle(record.Title, rest), showDue, now)
+		if i == m.search.cursor {
+			table.AddHighlightedRow(row)
+		} else {
+			table.AddRow(row)
+		}
+	}
+	b.WriteString(table.View())
+	return b.String()
+}
+
+// anyDeadline reports whether a plan has a deadline, which adds a due
+// column to the list.
+func (m *PlanModule) anyDeadline() bool {
+	for _, record := range m.plans {
+		if record.Deadline != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// planListHeaders returns the plan list's column headers.
+func planListHeaders(showDue bool) []string {
+	headers := []string{"ID", "Title", "Status", "Progress", "Hours"}
+	if showDue {
+		headers = append(headers, "Due")
+	}
+	return append(headers, "Last Studied", "Next Chunk")
+}
+
+// planListRow returns the plan list's cells for record, with id and title
+// as they should be shown.
+func planListRow(record *storage.PlanRecord, id, title string, showDue bool, now time.Time) []string {
+	progress := "-"
+	if record.ChunksIndexed && record.ChunksTotal > 0 {
+		progress = fmt.Sprintf("%d%%", record.ChunksCompleted*100/record.ChunksTotal)
+	}
+	lastStudied := "never"
+	if record.LastSession != nil {
+		lastStudied = stats.FormatRelativeTime(*record.LastSession, now)
+	}
+	nextChunk := "-"
+	if record.NextChunkTitle != "" {
+		nextChunk = record.NextChunkTitle
+	}
+
+	row := []string{
+		id,
+		title,
+		record.Status,
+		progress,
+		fmt.Sprintf("%.1f", record.TotalHours),
+	}
+	if showDue {
+		due := "-"
+		if record.Deadline != "" {
+			due = record.Deadline
+		}
+		row = append(row, due)
+	}
+	return append(row, lastStudied, nextChunk)
+}
+
 func (m *PlanModule) renderPlanDetail() string {
 	if m.detailPlan == nil {
 		return "Plan not loaded."
@@ -787,23 +1311,48 @@ func (m *PlanModule) renderPlanDetail() string {
 
 	var b strings.Builder
 
-	title := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("11")).Render(m.detailPlan.Title)
+	title := styles.Title().Render(m.detailPlan.Title)
 	b.WriteString(title)
 	b.WriteString("\n")
 	b.WriteString(fmt.Sprintf("Status: %s | Total Hours: %.1f\n", m.detailPlan.Status, m.detailPlan.TotalHours))
 	if len(m.detailPlan.Tags) > 0 {
 		b.WriteString(fmt.Sprintf("Tags: %s\n", strings.Join(m.detailPlan.Tags, ", ")))
 	}
+	if len(m.detailPlan.Children) > 0 {
+		b.WriteString(fmt.Sprintf("Sub-plans: %s\n", strings.Join(m.detailPlan.Children, ", ")))
+	}
+	if reading := readingSummary(m.detailPlan); reading != "" {
+		b.WriteString(fmt.Sprintf("Reading list: %s\n", reading))
+	}
+	if m.forecast != nil {
+		b.WriteString(fmt.Sprintf("Est. completion: %s\n", m.forecast.Summary()))
+		if deadline := m.forecast.DeadlineSummary(); deadline != "" {
+			b.WriteString(fmt.Sprintf("Deadline: %s\n", deadline))
+		}
+		if warning := m.forecast.Warning(); warning != "" {
+			b.WriteString(styles.Error().Render(warning))
+			b.WriteString("\n")
+		}
+	}
 
 	b.WriteString("\nChunks:\n")
 
-	table := components.NewTable([]string{"ID", "Title", "Status", "Duration"})
+	actuals := make(map[string]stats.ChunkStats)
+	if m.chunkStats != nil {
+		for _, cs := range m.chunkStats.Chunks {
+			actuals[cs.ChunkID] = cs
+		}
+	}
+
+	table := components.NewTable([]string{"ID", "Title", "Status", "Duration", "Actual", "Resources"})
 	for i, chunk := range m.detailPlan.Chunks {
 		row := []string{
 			chunk.ID,
 			chunk.Title,
 			string(chunk.Status),
 			fmt.Sprintf("%d min", chunk.Duration),
+			chunkActual(actuals[chunk.ID]),
+			resourceSummary(&m.detailPlan.Chunks[i]),
 		}
 		if i == m.chunkCursor {
 			table.AddHighlightedRow(row)
@@ -813,7 +1362,32 @@ func (m *PlanModule) renderPlanDetail() string {
 	}
 
 	b.WriteString(table.View())
-	b.WriteString("\n[Esc] Back  [space] Toggle status  [e] Edit  [d] Delete")
+	if m.chunkView {
+		b.WriteString(m.renderChunkView())
+	} else {
+		b.WriteString(m.renderChunkResources())
+	}
+	if m.resourceFocus {
+		b.WriteString("\n" + strings.Join([]string{
+			keyHint(m.keys, keymap.Back, "Back to chunks"),
+			keyHint(m.keys, keymap.Up, "Up"),
+			keyHint(m.keys, keymap.Down, "Down"),
+			keyHint(m.keys, keymap.Toggle, "Check off resource"),
+		}, "  "))
+	} else {
+		view := "View chunk"
+		if m.chunkView {
+			view = "Hide chunk"
+		}
+		b.WriteString("\n" + strings.Join([]string{
+			keyHint(m.keys, keymap.Back, "Back"),
+			keyHint(m.keys, keymap.Select, view),
+			keyHint(m.keys, keymap.Toggle, "Toggle status"),
+			keyHint(m.keys, keymap.Resources, "Resources"),
+			keyHint(m.keys, keymap.EditPlan, "Edit"),
+			keyHint(m.keys, keymap.DeletePlan, "Delete"),
+		}, "  "))
+	}
 
 	return b.String()
 }
@@ -836,12 +1410,15 @@ func (m *PlanModule) renderForm() string {
 		"Topic",
 		"Total Hours",
 		"Level",
+		"Tags",
+		"Deadline",
 	}
 	if m.form.mode == formModeEdit {
 		labels = []string{
 			"Title",
 			"Total Hours",
 			"Tags",
+			"Deadline",
 		}
 	}
 
@@ -853,12 +1430,16 @@ func (m *PlanModule) renderForm() string {
 	}
 
 	if m.form.validationErr != nil {
-		errorMsg := lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Render(m.form.validationErr.Error())
+		errorMsg := styles.Error().Render(m.form.validationErr.Error())
 		b.WriteString(errorMsg)
 		b.WriteString("\n")
 	}
 
-	b.WriteString("[Enter] Submit  [Esc] Cancel")
+	help := "[Enter] Submit  [Esc] Cancel"
+	if m.form.focusIndex < len(m.form.inputs) && m.form.inputs[m.form.focusIndex].suggestion() != "" {
+		help = "[→] Complete tag  " + help
+	}
+	b.WriteString(help)
 	return b.String()
 }
 
@@ -867,10 +1448,10 @@ func (m *PlanModule) renderConfirm() string {
 		return ""
 	}
 
-	style := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("9"))
+	style := styles.Error().Bold(true)
 	var b strings.Builder
 	b.WriteString(style.Render(m.confirm.message))
-	b.WriteString("\n\n[Enter] Confirm  [Esc] Cancel")
+	b.WriteString("\n\n" + keyHint(m.keys, keymap.Select, "Confirm") + "  " + keyHint(m.keys, keymap.Back, "Cancel"))
 	return b.String()
 }
 
@@ -891,6 +1472,22 @@ func (m *PlanModule) updateFormFocus() {
 	}
 }
 
+// chunkActual formats the time logged against a chunk with its variance
+// from plan, such as "95 min (+58%) ⚠", or "-" when none was logged.
+func chunkActual(cs stats.ChunkStats) string {
+	if cs.SessionCount == 0 {
+		return "-"
+	}
+	if cs.PlannedMinutes == 0 {
+		return fmt.Sprintf("%d min", cs.ActualMinutes)
+	}
+	actual := fmt.Sprintf("%d min (%+.0f%%)", cs.ActualMinutes, cs.VariancePercent*100)
+	if cs.Overrun {
+		actual += " ⚠"
+	}
+	return actual
+}
+
 func nextChunkStatus(current plan.Status) plan.Status {
 	switch current {
 	case plan.StatusNotStarted:
@@ -910,6 +1507,10 @@ type inputField struct {
 	placeholder string
 	value       []rune
 	focused     bool
+
+	// completions are suggested for the last entry of a comma-separated
+	// value (tags); → accepts the suggestion.
+	completions []string
 }
 
 func newInputField(placeholder string) *inputField {
@@ -919,6 +1520,74 @@ func newInputField(placeholder string) *inputField {
 	}
 }
 
+// newTagInputField returns a comma-separated tags field completing the
+// given known tags.
+func newTagInputField(knownTags []string) *inputField {
+	f := newInputField("Tags (comma separated)")
+	f.completions = knownTags
+	return f
+}
+
+// knownTags returns the tags of the listed plans, most used first.
+func (m *PlanModule) knownTags() []string {
+	counts := plan.CountTags(m.plans)
+	tags := make([]string, len(counts))
+	for i, count := range counts {
+		tags[i] = count.Tag
+	}
+	return tags
+}
+
+// parseDeadline reads an optional YYYY-MM-DD deadline; blank means none.
+func parseDeadline(value string) (*plan.Date, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	deadline, err := plan.ParseDate(value)
+	if err != nil {
+		return nil, err
+	}
+	return &deadline, nil
+}
+
+// parseTags splits a comma-separated tags value.
+func parseTags(value string) []string {
+	var tags []string
+	for _, tag := range strings.Split(value, ",") {
+		if t := strings.TrimSpace(tag); t != "" {
+			tags = append(tags, t)
+		}
+	}
+	return tags
+}
+
+// suggestion returns the text completing the entry being typed: the rest
+// of the first completion it is a prefix of (ignoring case) that is not
+// already in the value.
+func (f *inputField) suggestion() string {
+	if len(f.completions) == 0 {
+		return ""
+	}
+
+	value := string(f.value)
+	entries := strings.Split(value, ",")
+	partial := strings.TrimLeft(entries[len(entries)-1], " ")
+	if partial == "" {
+		return ""
+	}
+
+	used := make(map[string]bool)
+	for _, entry := range entries[:len(entries)-1] {
+		used[strings.ToLower(strings.TrimSpace(entry))] = true
+	}
+	for _, candidate := range f.completions {
+		if len(candidate) > len(partial) && strings.EqualFold(candidate[:len(partial)], partial) && !used[strings.ToLower(candidate)] {
+			return candidate[len(partial):]
+		}
+	}
+	return ""
+}
+
 func (f *inputField) Focus() {
 	f.focused = true
 }
@@ -941,9 +1610,17 @@ func (f *inputField) Update(msg tea.KeyMsg) bool {
 	}
 
 	switch msg.Type {
+	case tea.KeyRight:
+		if suffix := f.suggestion(); suffix != "" {
+			f.value = append(f.value, []rune(suffix)...)
+			return true
+		}
 	case tea.KeyRunes:
 		f.value = append(f.value, msg.Runes...)
 		return true
+	case tea.KeySpace:
+		f.value = append(f.value, ' ')
+		return true
 	case tea.KeyBackspace:
 		if len(f.value) > 0 {
 			f.value = f.value[:len(f.value)-1]
@@ -957,17 +1634,20 @@ func (f *inputField) Update(msg tea.KeyMsg) bool {
 func (f *inputField) View() string {
 	content := string(f.value)
 	if content == "" {
-		content = lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render(f.placeholder)
+		content = styles.Muted().Render(f.placeholder)
 	}
 	cursor := ""
 	if f.focused {
 		cursor = " ▎"
+		if suffix := f.suggestion(); suffix != "" {
+			cursor = styles.Muted().Render(suffix) + cursor
+		}
 	}
 	style := lipgloss.NewStyle().Border(lipgloss.NormalBorder()).Padding(0, 1)
 	if f.focused {
-		style = style.BorderForeground(lipgloss.Color("212"))
+		style = style.BorderForeground(lipgloss.Color(styles.Current().Accent))
 	} else {
-		style = style.BorderForeground(lipgloss.Color("240"))
+		style = style.BorderForeground(lipgloss.Color(styles.Current().Border))
 	}
 	return style.Render(content + cursor)
 }
@@ -5,10 +5,16 @@ package tui
 
 import (
 	"testing"
+	"time"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/pezware/samedi.dev/internal/storage"
 	"github.com/pezware/samedi.dev/internal/tui/app"
+	"github.com/pezware/samedi.dev/internal/tui/keymap"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewPlanModule_CreatesModule(t *testing.T) {
@@ -217,3 +223,500 @@ func TestPlanForm_Structure(t *testing.T) {
 	assert.Equal(t, "test-plan", form.targetPlanID)
 	assert.Nil(t, form.validationErr)
 }
+
+func TestPlanModule_RenderPlanList_ShowsLastStudiedAndNextChunk(t *testing.T) {
+	module := NewPlanModule(nil)
+	studied := time.Now().Add(-3 * 24 * time.Hour)
+	module.plans = []*storage.PlanRecord{
+		{ID: "rust", Title: "Rust", Status: "in-progress", LastSession: &studied, NextChunkTitle: "Ownership"},
+		{ID: "go", Title: "Go", Status: "not-started"},
+	}
+
+	view := module.renderPlanList()
+
+	assert.Contains(t, view, "Last Studied")
+	assert.Contains(t, view, "Next Chunk")
+	assert.Contains(t, view, "3d ago")
+	assert.Contains(t, view, "Ownership")
+	assert.Contains(t, view, "never")
+}
+
+func TestPlanModule_Broadcast_ReloadsOpenPlan(t *testing.T) {
+	module := NewPlanModule(nil)
+	module.state = statePlanDetail
+	module.detailPlan = &plan.Plan{ID: "rust-async"}
+
+	// Another plan changed: nothing to do
+	_, cmd := module.Update(app.BroadcastMsg{Topic: app.TopicPlansChanged, Payload: "go-generics", External: true})
+	assert.Nil(t, cmd)
+	assert.Equal(t, statePlanDetail, module.state)
+
+	// Open plan is gone (no service to load it): fall back to the list
+	_, cmd = module.Update(app.BroadcastMsg{Topic: app.TopicPlansChanged, Payload: "rust-async", External: true})
+	assert.NotNil(t, cmd)
+	assert.Equal(t, statePlanList, module.state)
+	assert.Nil(t, module.detailPlan)
+}
+
+func TestPlanModule_Broadcast_IgnoredWhileEditing(t *testing.T) {
+	module := NewPlanModule(nil)
+	module.state = statePlanEdit
+
+	_, cmd := module.Update(app.BroadcastMsg{Topic: app.TopicPlansChanged, Payload: "rust-async", External: true})
+
+	assert.Nil(t, cmd)
+	assert.Equal(t, statePlanEdit, module.state)
+}
+
+func TestPlanModule_Refresh(t *testing.T) {
+	module := NewPlanModule(nil)
+	records := []*storage.PlanRecord{{ID: "go-generics"}, {ID: "rust-async"}}
+	module.Update(plansLoadedMsg{records: records})
+	module.listCursor = 1
+
+	// An auto refresh swaps the list in silently
+	_, cmd := module.Update(plansLoadedMsg{records: records, quiet: true})
+	assert.Nil(t, cmd)
+	assert.Equal(t, 1, module.listCursor)
+
+	// Open forms are left alone
+	module.state = statePlanEdit
+	_, cmd = module.Update(app.RefreshMsg{})
+	assert.Nil(t, cmd)
+	assert.Equal(t, statePlanEdit, module.state)
+}
+
+func TestPlanModule_Commands(t *testing.T) {
+	module := NewPlanModule(nil)
+	module.Update(plansLoadedMsg{records: []*storage.PlanRecord{{ID: "rust-async", Title: "Rust async"}}})
+
+	commands := module.Commands()
+	require.Len(t, commands, 2)
+	assert.Equal(t, "New plan", commands[0].Title)
+	assert.Equal(t, "Open plan: Rust async", commands[1].Title)
+	assert.Equal(t, "rust-async", commands[1].Hint)
+
+	module.Update(commands[0].Msg)
+	assert.Equal(t, statePlanCreate, module.state)
+
+	// The open form is not thrown away
+	_, cmd := module.Update(commands[1].Msg)
+	require.NotNil(t, cmd)
+	status, ok := cmd().(app.StatusMsg)
+	require.True(t, ok)
+	assert.True(t, status.IsError)
+	assert.Equal(t, statePlanCreate, module.state)
+
+	module.SetReadOnly(true)
+	assert.Len(t, module.Commands(), 1)
+}
+
+func TestPlanModule_PlanRefreshed_KeepsChunkCursor(t *testing.T) {
+	module := NewPlanModule(nil)
+	module.state = statePlanDetail
+	module.chunkCursor = 1
+
+	refreshed := &plan.Plan{ID: "rust-async", Chunks: []plan.Chunk{{ID: "chunk-001"}, {ID: "chunk-002"}}}
+	module.Update(planLoadedMsg{plan: refreshed, refresh: true})
+	assert.Equal(t, 1, module.chunkCursor)
+
+	module.Update(planLoadedMsg{plan: refreshed})
+	assert.Equal(t, 0, module.chunkCursor)
+}
+
+func TestPlanModule_ChunkActuals(t *testing.T) {
+	module := NewPlanModule(nil)
+	loaded := &plan.Plan{ID: "rust-async", Chunks: []plan.Chunk{
+		{ID: "chunk-001", Title: "Futures", Duration: 60},
+		{ID: "chunk-002", Title: "Pinning", Duration: 60},
+		{ID: "chunk-003", Title: "Tokio", Duration: 60},
+	}}
+	module.Update(planLoadedMsg{plan: loaded, chunkStats: &stats.ChunkBreakdown{
+		PlanID: "rust-async",
+		Chunks: []stats.ChunkStats{
+			{ChunkID: "chunk-001", PlannedMinutes: 60, ActualMinutes: 50, SessionCount: 1, VariancePercent: -10.0 / 60},
+			{ChunkID: "chunk-002", PlannedMinutes: 60, ActualMinutes: 95, SessionCount: 2, VariancePercent: 35.0 / 60, Overrun: true},
+			{ChunkID: "chunk-003", PlannedMinutes: 60},
+		},
+	}})
+
+	view := module.renderPlanDetail()
+	assert.Contains(t, view, "Actual")
+	assert.Contains(t, view, "50 min (-17%)")
+	assert.Contains(t, view, "95 min (+58%) ⚠")
+}
+
+func TestPlanModule_Forecast(t *testing.T) {
+	module := NewPlanModule(nil)
+	completion := time.Date(2025, 3, 29, 0, 0, 0, 0, time.UTC)
+	forecast := &stats.Forecast{RemainingHours: 10, WeeklyPace: 2.5, CompletionDate: &completion}
+	forecast.CheckDeadline(plan.Date{Time: time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)}, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))
+	module.Update(planLoadedMsg{plan: &plan.Plan{ID: "rust-async"}, forecast: forecast})
+
+	view := module.renderPlanDetail()
+	assert.Contains(t, view, "Est. completion: Mar 29, 2025 at 2.5 h/week (10.0 h left)")
+	assert.Contains(t, view, "Deadline: Mar 15, 2025 (14 days left, needs 4.7 h/week)")
+	assert.Contains(t, view, "past the deadline of Mar 15, 2025")
+}
+
+func TestPlanModule_ResourceFocus(t *testing.T) {
+	module := NewPlanModule(nil)
+	module.state = statePlanDetail
+	module.detailPlan = &plan.Plan{ID: "rust-async", Chunks: []plan.Chunk{
+		{ID: "chunk-001", Resources: []string{"[x] The Book ch. 16", "[ ] Tokio tutorial", "Async book"}},
+		{ID: "chunk-002"},
+	}}
+
+	view := module.renderPlanDetail()
+	assert.Contains(t, view, "1/2")
+	assert.Contains(t, view, "Reading list: 1/3 done")
+	assert.Contains(t, view, "Resources for chunk-001")
+	assert.Contains(t, view, "[ ] Tokio tutorial")
+
+	module.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'R'}})
+	assert.True(t, module.resourceFocus)
+
+	module.Update(tea.KeyMsg{Type: tea.KeyDown})
+	module.Update(tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, 2, module.resourceCursor)
+	assert.Equal(t, 0, module.chunkCursor, "arrows move the resource cursor while focused")
+	module.Update(tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, 0, module.resourceCursor)
+
+	module.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, module.resourceFocus)
+	assert.Equal(t, statePlanDetail, module.state)
+
+	// A chunk without resources can't take focus
+	module.Update(tea.KeyMsg{Type: tea.KeyDown})
+	_, cmd := module.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'R'}})
+	assert.False(t, module.resourceFocus)
+	assert.NotNil(t, cmd)
+}
+
+func TestPlanModule_ChunkView(t *testing.T) {
+	module := NewPlanModule(nil)
+	loaded := &plan.Plan{ID: "rust", Chunks: []plan.Chunk{
+		{ID: "chunk-001", Title: "Ownership", Resources: []string{"The Book ch. 4"}},
+		{ID: "chunk-002", Title: "Borrowing"},
+	}}
+	markdown := "## Chunk 1: Ownership {#chunk-001}\n**Status**: in-progress\n\n" +
+		"Moves invalidate the source:\n\n```rust\nlet t = s;\n```\n\n---\n\n" +
+		"## Chunk 2: Borrowing {#chunk-002}\n**Status**: not-started\n"
+	module.Update(planLoadedMsg{plan: loaded, markdown: markdown})
+
+	module.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.True(t, module.chunkView)
+	view := module.renderPlanDetail()
+	assert.Contains(t, view, "Moves invalidate the source:")
+	assert.Contains(t, view, "let t = s;")
+	assert.NotContains(t, view, "```")
+	assert.NotContains(t, view, "Resources for chunk-001", "the chunk pane replaces the resource list")
+
+	module.Update(tea.KeyMsg{Type: tea.KeyDown})
+	assert.Contains(t, module.renderPlanDetail(), "Nothing written for this chunk yet.")
+
+	module.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, module.chunkView)
+	assert.Equal(t, statePlanDetail, module.state, "back closes the pane before the plan")
+}
+
+func TestPlanModule_ChunkView_Notes(t *testing.T) {
+	module := NewPlanModule(nil)
+	loaded := &plan.Plan{ID: "rust", Chunks: []plan.Chunk{
+		{ID: "chunk-001", Title: "Ownership", Notes: []string{"2026-10-16: Moves invalidate the source"}},
+	}}
+	markdown := "## Chunk 1: Ownership {#chunk-001}\n**Status**: in-progress\n\n" +
+		"**Notes**:\n- 2026-10-16: Moves invalidate the source\n"
+	module.Update(planLoadedMsg{plan: loaded, markdown: markdown})
+
+	module.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Contains(t, module.renderPlanDetail(), "2026-10-16: Moves invalidate the source")
+}
+
+func TestPlanModule_ResourceToggled_ReportsError(t *testing.T) {
+	module := NewPlanModule(nil)
+
+	_, cmd := module.Update(resourceToggledMsg{planID: "rust-async", err: assert.AnError})
+	require.NotNil(t, cmd)
+	status, ok := cmd().(app.StatusMsg)
+	require.True(t, ok)
+	assert.True(t, status.IsError)
+}
+
+func TestPlanModule_ReadOnly_RefusesChanges(t *testing.T) {
+	module := NewPlanModule(nil)
+	module.SetReadOnly(true)
+	module.plans = []*storage.PlanRecord{{ID: "rust-async", Title: "Rust"}}
+
+	for _, key := range []rune{'n', 'd'} {
+		_, cmd := module.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{key}})
+		require.NotNil(t, cmd, string(key))
+		assert.True(t, cmd().(app.StatusMsg).IsError, string(key))
+		assert.Equal(t, statePlanList, module.state, string(key))
+	}
+
+	module.state = statePlanDetail
+	module.detailPlan = &plan.Plan{ID: "rust-async", Chunks: []plan.Chunk{{ID: "chunk-001"}}}
+	for _, msg := range []tea.KeyMsg{
+		{Type: tea.KeySpace},
+		{Type: tea.KeyRunes, Runes: []rune("e")},
+		{Type: tea.KeyRunes, Runes: []rune("d")},
+	} {
+		_, cmd := module.Update(msg)
+		require.NotNil(t, cmd, msg.String())
+		assert.Contains(t, cmd().(app.StatusMsg).Message, "Read-only", msg.String())
+		assert.Equal(t, statePlanDetail, module.state, msg.String())
+	}
+
+	for _, shortcut := range module.Shortcuts() {
+		assert.NotEqual(t, "e", shortcut.Key)
+	}
+}
+
+func TestPlanModule_Keymap_RemapsKeys(t *testing.T) {
+	module := NewPlanModule(nil)
+	keys, err := keymap.New(map[string][]string{"new_plan": {"a"}, "resources": {"R"}, "back": {"backspace"}})
+	require.NoError(t, err)
+	module.SetKeymap(keys)
+
+	module.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	assert.Equal(t, statePlanList, module.state)
+	module.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	assert.Equal(t, statePlanCreate, module.state)
+	module.form = nil
+
+	module.state = statePlanDetail
+	module.detailPlan = &plan.Plan{ID: "rust-async", Chunks: []plan.Chunk{{ID: "chunk-001", Resources: []string{"Book"}}}}
+	module.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'R'}})
+	assert.True(t, module.resourceFocus)
+	module.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	assert.False(t, module.resourceFocus)
+	module.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, statePlanDetail, module.state, "Esc is no longer back")
+	assert.Contains(t, module.renderPlanDetail(), "[R] Resources")
+
+	module.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	assert.Equal(t, statePlanList, module.state)
+	assert.Equal(t, "a", module.Shortcuts()[1].Key)
+}
+
+func TestInputField_TagCompletion(t *testing.T) {
+	field := newTagInputField([]string{"rust", "rustlang", "async"})
+	field.Focus()
+
+	field.SetValue("as")
+	assert.Equal(t, "ync", field.suggestion())
+
+	// Tags already entered are not suggested again
+	field.SetValue("rust, Ru")
+	assert.Equal(t, "stlang", field.suggestion())
+
+	assert.True(t, field.Update(tea.KeyMsg{Type: tea.KeyRight}))
+	assert.Equal(t, "rust, Rustlang", field.Value())
+	assert.Empty(t, field.suggestion())
+
+	field.SetValue("rust, ")
+	assert.Empty(t, field.suggestion(), "nothing typed yet")
+
+	assert.Equal(t, []string{"rust", "Rustlang"}, parseTags("rust, Rustlang, ,"))
+}
+
+func TestPlanModule_CreateFormSuggestsKnownTags(t *testing.T) {
+	module := NewPlanModule(nil)
+	module.plans = []*storage.PlanRecord{
+		{ID: "a", Tags: []string{"rust", "async"}},
+		{ID: "b", Tags: []string{"rust"}},
+	}
+
+	_, _ = module.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	require.Equal(t, statePlanCreate, module.state)
+	require.Len(t, module.form.inputs, 5)
+	assert.Equal(t, []string{"rust", "async"}, module.form.inputs[3].completions)
+}
+
+func TestParseDeadline(t *testing.T) {
+	deadline, err := parseDeadline("  ")
+	require.NoError(t, err)
+	assert.Nil(t, deadline)
+
+	deadline, err = parseDeadline("2025-06-30")
+	require.NoError(t, err)
+	assert.Equal(t, "2025-06-30", deadline.String())
+
+	_, err = parseDeadline("June 30")
+	assert.Error(t, err)
+}
+
+func TestPlanModule_ListsSubPlansAsTree(t *testing.T) {
+	module := NewPlanModule(nil)
+	_, _ = module.Update(plansLoadedMsg{records: []*storage.PlanRecord{
+		{ID: "go", Title: "Go"},
+		{ID: "backend", Title: "Backend", Children: []string{"go"}},
+	}})
+
+	require.Len(t, module.plans, 2)
+	assert.Equal(t, "backend", module.plans[0].ID, "parents come before their sub-plans")
+	assert.Equal(t, "go", module.plans[1].ID)
+	assert.Contains(t, module.renderPlanList(), "└─ go")
+}
+
+func TestPlanModule_Search(t *testing.T) {
+	module := NewPlanModule(nil)
+	module.Update(plansLoadedMsg{records: []*storage.PlanRecord{
+		{ID: "go", Title: "Go basics"},
+		{ID: "rust-async", Title: "Rust async", Tags: []string{"systems"}},
+		{ID: "french", Title: "French"},
+	}})
+	module.listCursor = 2
+
+	module.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	require.NotNil(t, module.search)
+	assert.True(t, module.CapturingInput(), "letters such as q go into the query")
+
+	module.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("sys")})
+	view := module.renderPlanList()
+	assert.Contains(t, view, "1 of 3 plans")
+	assert.Contains(t, view, "#systems")
+	assert.NotContains(t, view, "French")
+
+	// Esc goes back to the whole list where the cursor was
+	module.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Nil(t, module.search)
+	assert.Equal(t, 2, module.listCursor)
+	assert.False(t, module.CapturingInput())
+
+	// Enter opens the highlighted match
+	module.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	module.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("ra")})
+	_, cmd := module.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.NotNil(t, cmd)
+	assert.Nil(t, module.search)
+	assert.Equal(t, 1, module.listCursor)
+}
+
+func TestPlanModule_SortKeys(t *testing.T) {
+	module := NewPlanModule(nil)
+	var saved []string
+	module.SetPlanSort(plan.ListSort{}, func(s plan.ListSort) error {
+		saved = append(saved, s.String())
+		return nil
+	})
+	module.Update(plansLoadedMsg{records: []*storage.PlanRecord{
+		{ID: "go", Title: "Go", TotalHours: 2, Children: []string{"go-web"}},
+		{ID: "go-web", Title: "Go web", TotalHours: 9, Deadline: "2025-09-01"},
+		{ID: "rust", Title: "Rust", TotalHours: 5, ChunksIndexed: true, ChunksTotal: 4, ChunksCompleted: 1},
+	}})
+	module.listCursor = 2 // rust
+
+	press := func(r rune) {
+		_, cmd := module.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		require.NotNil(t, cmd)
+		cmd()
+	}
+	ids := func() []string {
+		out := make([]string, len(module.plans))
+		for i, record := range module.plans {
+			out[i] = record.ID
+		}
+		return out
+	}
+
+	press('o')
+	assert.Equal(t, []string{"rust", "go", "go-web"}, ids(), "progress first; plans without chunks last")
+	assert.Equal(t, 0, module.listCursor, "the cursor stays on rust")
+	press('o')
+	assert.Equal(t, []string{"go-web", "rust", "go"}, ids())
+	assert.Contains(t, module.renderPlanList(), "sorted by hours ↓")
+	assert.Contains(t, module.renderPlanList(), "Due")
+	press('O')
+	assert.Equal(t, []string{"go", "rust", "go-web"}, ids())
+	assert.Equal(t, []string{"progress:desc", "hours:desc", "hours:asc"}, saved)
+
+	// Past the last column the list is a tree again
+	press('o')
+	press('o')
+	press('o')
+	assert.Equal(t, []string{"go", "go-web", "rust"}, ids())
+	assert.Contains(t, module.renderPlanList(), "└─ go-web")
+}
+
+func TestPlanModule_Board(t *testing.T) {
+	module := NewPlanModule(nil)
+	module.Update(plansLoadedMsg{records: []*storage.PlanRecord{
+		{ID: "go", Title: "Go basics", Status: "in-progress"},
+		{ID: "rust", Title: "Rust async", Status: "not-started"},
+		{ID: "french", Title: "French", Status: "in-progress"},
+		{ID: "latin", Title: "Latin", Status: "archived"},
+	}})
+	module.listCursor = 2 // french
+
+	press := func(msg tea.Msg) tea.Cmd {
+		_, cmd := module.Update(msg)
+		return cmd
+	}
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	require.NotNil(t, module.planBoard)
+	view := module.View()
+	assert.Contains(t, view, "Not Started (1)")
+	assert.Contains(t, view, "In Progress (2)")
+	assert.Contains(t, view, "1 archived not shown")
+	assert.Equal(t, 1, module.planBoard.column, "the board opens on the selected plan")
+	assert.Equal(t, 1, module.planBoard.rows[1])
+
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'h'}})
+	assert.Equal(t, 0, module.planBoard.column)
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'l'}})
+	press(tea.KeyMsg{Type: tea.KeyUp})
+	assert.Equal(t, 0, module.planBoard.rows[1])
+
+	// A saved move reloads the plans and keeps the cursor on the plan
+	press(tea.KeyMsg{Type: tea.KeyRight})
+	assert.Equal(t, 2, module.planBoard.column)
+	assert.Equal(t, "go", module.planBoard.follow)
+	cmd := press(planStatusUpdatedMsg{planID: "go", status: plan.StatusCompleted})
+	require.NotNil(t, cmd)
+	module.Update(plansLoadedMsg{quiet: true, records: []*storage.PlanRecord{
+		{ID: "go", Title: "Go basics", Status: "completed"},
+		{ID: "rust", Title: "Rust async", Status: "not-started"},
+		{ID: "french", Title: "French", Status: "in-progress"},
+	}})
+	assert.Contains(t, module.View(), "Completed (1)")
+	assert.Equal(t, 2, module.planBoard.column)
+	assert.Equal(t, 0, module.planBoard.rows[2])
+
+	// The board key goes back to the list, on the plan the board was on
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	assert.Nil(t, module.planBoard)
+	assert.Equal(t, 0, module.listCursor)
+}
+
+func TestPlanModule_ChunkBoard(t *testing.T) {
+	module := NewPlanModule(nil)
+	module.SetReadOnly(true)
+	module.Update(planLoadedMsg{plan: &plan.Plan{ID: "go", Title: "Go", Chunks: []plan.Chunk{
+		{ID: "chunk-001", Title: "Syntax", Status: plan.StatusCompleted},
+		{ID: "chunk-002", Title: "Types", Status: plan.StatusInProgress},
+		{ID: "chunk-003", Title: "Generics", Status: plan.StatusSkipped},
+	}}})
+	module.chunkCursor = 1
+
+	module.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	require.NotNil(t, module.chunkBoard)
+	view := module.View()
+	assert.Contains(t, view, "Go · board")
+	assert.Contains(t, view, "1 skipped not shown")
+
+	// Read-only mode refuses moves and leaves the cursor where it was
+	_, cmd := module.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	require.NotNil(t, cmd)
+	assert.Contains(t, cmd().(app.StatusMsg).Message, "Read-only")
+	assert.Equal(t, 1, module.chunkBoard.column)
+
+	// Enter views the chunk under the cursor
+	module.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'l'}})
+	module.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, module.chunkBoard)
+	assert.True(t, module.chunkView)
+	assert.Equal(t, 0, module.chunkCursor)
+}
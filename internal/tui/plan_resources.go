@@ -0,0 +1,177 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/tui/app"
+	"github.com/pezware/samedi.dev/internal/tui/keymap"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
+)
+
+type resourceToggledMsg struct {
+	planID string
+	err    error
+}
+
+// selectedChunk returns the chunk under the detail cursor, or nil.
+func (m *PlanModule) selectedChunk() *plan.Chunk {
+	if m.detailPlan == nil || m.chunkCursor >= len(m.detailPlan.Chunks) {
+		return nil
+	}
+	return &m.detailPlan.Chunks[m.chunkCursor]
+}
+
+// focusResources moves the cursor into the selected chunk's resources.
+func (m *PlanModule) focusResources() (tea.Model, tea.Cmd) {
+	chunk := m.selectedChunk()
+	if chunk == nil || len(chunk.Resources) == 0 {
+		return m, func() tea.Msg {
+			return app.StatusMsg{Message: "This chunk has no resources"}
+		}
+	}
+	m.resourceFocus = true
+	m.clampResourceCursor()
+	return m, nil
+}
+
+// clampResourceCursor keeps the resource cursor inside the selected chunk,
+// leaving resource focus if the chunk no longer has resources.
+func (m *PlanModule) clampResourceCursor() {
+	chunk := m.selectedChunk()
+	if chunk == nil || len(chunk.Resources) == 0 {
+		m.resourceFocus = false
+		m.resourceCursor = 0
+		return
+	}
+	if m.resourceCursor >= len(chunk.Resources) {
+		m.resourceCursor = len(chunk.Resources) - 1
+	}
+}
+
+// handleResourceKeys moves through and checks off the selected chunk's
+// resources. Back or the resources key returns to the chunk table.
+func (m *PlanModule) handleResourceKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	chunk := m.selectedChunk()
+	if chunk == nil || len(chunk.Resources) == 0 {
+		m.resourceFocus = false
+		return m, nil
+	}
+
+	keys := m.keys
+	switch {
+	case keys.Matches(msg, keymap.Back), keys.Matches(msg, keymap.Resources):
+		m.resourceFocus = false
+	case keys.Matches(msg, keymap.Up):
+		m.resourceCursor--
+		if m.resourceCursor < 0 {
+			m.resourceCursor = len(chunk.Resources) - 1
+		}
+	case keys.Matches(msg, keymap.Down):
+		m.resourceCursor++
+		if m.resourceCursor >= len(chunk.Resources) {
+			m.resourceCursor = 0
+		}
+	case keys.Matches(msg, keymap.Toggle):
+		return m.toggleSelectedResource()
+	}
+	return m, nil
+}
+
+func (m *PlanModule) toggleSelectedResource() (tea.Model, tea.Cmd) {
+	chunk := m.selectedChunk()
+	if chunk == nil || m.service == nil {
+		return m, nil
+	}
+	if m.readOnly {
+		return m, refuseReadOnly()
+	}
+
+	planID, chunkID, index := m.detailPlan.ID, chunk.ID, m.resourceCursor
+	return m, func() tea.Msg {
+		err := m.service.ToggleResource(context.Background(), planID, chunkID, index)
+		return resourceToggledMsg{planID: planID, err: err}
+	}
+}
+
+func (m *PlanModule) handleResourceToggled(msg resourceToggledMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		return m, func() tea.Msg {
+			return app.StatusMsg{
+				Message: fmt.Sprintf("Failed to update resource: %v", msg.err),
+				IsError: true,
+			}
+		}
+	}
+
+	return m, tea.Batch(
+		func() tea.Msg {
+			return app.BroadcastMsg{Topic: app.TopicPlansChanged, Payload: msg.planID}
+		},
+		func() tea.Msg {
+			return m.fetchPlan(msg.planID, true)
+		},
+	)
+}
+
+// resourceSummary formats a chunk's checked-off resources as "done/total",
+// or "-" when none have checkboxes.
+func resourceSummary(chunk *plan.Chunk) string {
+	done, total := chunk.ResourceProgress()
+	if total == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d/%d", done, total)
+}
+
+// readingSummary formats progress through the plan's reading list, where
+// a resource listed by several chunks counts once, as "done/total done",
+// or "" when the plan has no resources.
+func readingSummary(p *plan.Plan) string {
+	done, total := p.ReadingProgress()
+	if total == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d done", done, total)
+}
+
+// renderChunkResources lists the selected chunk's resources with their
+// checkboxes, highlighting the cursor while resources have focus.
+func (m *PlanModule) renderChunkResources() string {
+	chunk := m.selectedChunk()
+	if chunk == nil || len(chunk.Resources) == 0 {
+		return ""
+	}
+
+	highlightStyle := styles.Selected()
+	doneStyle := styles.Muted().Strikethrough(true)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "\nResources for %s:\n", chunk.ID)
+	for i, res := range chunk.ParsedResources() {
+		box := "   "
+		if res.Checkbox {
+			box = "[ ]"
+			if res.Done {
+				box = "[x]"
+			}
+		}
+		text := res.Text
+		if res.Done {
+			text = doneStyle.Render(text)
+		}
+		line := fmt.Sprintf("  %s %s", box, text)
+		if m.resourceFocus && i == m.resourceCursor {
+			line = highlightStyle.Render(fmt.Sprintf("> %s %s", box, res.Text))
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"fmt"
+	"sort"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
+)
+
+// planSearch is the '/' fuzzy finder of a plan list. While it is open the
+// list shows only the plans matching the query, best first.
+type planSearch struct {
+	input  *inputField
+	cursor int // Highlighted match
+	before int // List cursor to return to when the search is cancelled
+}
+
+// newPlanSearch opens a search from a list whose cursor is at cursor.
+func newPlanSearch(cursor int) *planSearch {
+	input := newInputField("Search titles, tags, and IDs")
+	input.Focus()
+	return &planSearch{input: input, before: cursor}
+}
+
+// searchKey is what a key does to an open search.
+type searchKey int
+
+const (
+	searchTyped  searchKey = iota // The query changed, or nothing happened
+	searchMoved                   // The cursor moved through the matches
+	searchChosen                  // Enter: open the highlighted plan
+	searchClosed                  // Esc: back to the whole list
+)
+
+// update applies msg to the search. Only the arrow keys move the cursor:
+// letters such as j and k are part of the query.
+func (s *planSearch) update(msg tea.KeyMsg, matches int) searchKey {
+	switch msg.Type {
+	case tea.KeyEsc:
+		return searchClosed
+	case tea.KeyEnter:
+		return searchChosen
+	case tea.KeyUp:
+		if s.cursor > 0 {
+			s.cursor--
+		}
+		return searchMoved
+	case tea.KeyDown:
+		if s.cursor < matches-1 {
+			s.cursor++
+		}
+		return searchMoved
+	}
+	if s.input.Update(msg) {
+		s.cursor = 0
+	}
+	return searchTyped
+}
+
+// planSearchFields are the parts of a plan the search looks at.
+type planSearchFields struct {
+	id    string
+	title string
+	tags  []string
+}
+
+// planMatch is a plan matching the search, and where it matched. The
+// title and ID positions are for highlighting; a plan found by a tag
+// shows that tag.
+type planMatch struct {
+	index    int // Position in the unfiltered list
+	score    int
+	titlePos []int
+	idPos    []int
+	tag      string
+	tagPos   []int
+}
+
+// matchPlans fuzzy-matches query against the title, the tags, and the ID
+// of each plan, and returns those matching best first. Plans matching
+// equally well keep their order, so an empty query matches the whole
+// list unchanged.
+func matchPlans(query string, plans []planSearchFields) []planMatch {
+	matches := make([]planMatch, 0, len(plans))
+	for i, fields := range plans {
+		match, found := planMatch{index: i}, false
+		if score, pos, ok := components.FuzzyMatch(query, fields.title); ok {
+			match.score, match.titlePos, found = score, pos, true
+		}
+		if score, pos, ok := components.FuzzyMatch(query, fields.id); ok && (!found || score > match.score) {
+			match.score, match.titlePos, match.idPos, found = score, nil, pos, true
+		}
+		for _, tag := range fields.tags {
+			if score, pos, ok := components.FuzzyMatch(query, tag); ok && (!found || score > match.score) {
+				match.score, match.titlePos, match.idPos, found = score, nil, nil, true
+				match.tag, match.tagPos = tag, pos
+			}
+		}
+		if found {
+			matches = append(matches, match)
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+	return matches
+}
+
+// highlightTitle renders a matched title, with the tag the plan was found
+// by after it. Matched letters are underlined; rest styles the others.
+func (pm planMatch) highlightTitle(title string, rest lipgloss.Style) string {
+	matched := rest.Underline(true).Bold(true)
+	out := components.Highlight(title, pm.titlePos, matched, rest)
+	if pm.tag != "" {
+		out += rest.Render(" #") + components.Highlight(pm.tag, pm.tagPos, matched, rest)
+	}
+	return out
+}
+
+// highlightID renders a matched ID.
+func (pm planMatch) highlightID(id string, rest lipgloss.Style) string {
+	return components.Highlight(id, pm.idPos, rest.Underline(true).Bold(true), rest)
+}
+
+// renderSearchBar renders the query field and how many plans match.
+func (s *planSearch) renderSearchBar(matches, total int) string {
+	return s.input.View() + "\n" + styles.Muted().Render(
+		keyHints("[↑/↓] Move", "[Enter] Open", "[Esc] Clear")+fmt.Sprintf("  ·  %d of %d plans", matches, total)) + "\n\n"
+}
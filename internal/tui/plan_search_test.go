@@ -0,0 +1,42 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchPlans(t *testing.T) {
+	plans := []planSearchFields{
+		{id: "go-basics", title: "Go basics", tags: []string{"programming"}},
+		{id: "rust-async", title: "Rust async", tags: []string{"systems"}},
+		{id: "french", title: "French", tags: []string{"language"}},
+	}
+
+	all := matchPlans("", plans)
+	require.Len(t, all, 3)
+	assert.Equal(t, []int{0, 1, 2}, []int{all[0].index, all[1].index, all[2].index}, "an empty query keeps the order")
+
+	matches := matchPlans("ra", plans)
+	require.NotEmpty(t, matches)
+	assert.Equal(t, 1, matches[0].index)
+	assert.Equal(t, []int{0, 5}, matches[0].titlePos)
+
+	matches = matchPlans("lang", plans)
+	require.Len(t, matches, 1)
+	assert.Equal(t, 2, matches[0].index)
+	assert.Equal(t, "language", matches[0].tag)
+	assert.Nil(t, matches[0].titlePos)
+	assert.Equal(t, "French #language", matches[0].highlightTitle("French", lipgloss.NewStyle()))
+
+	matches = matchPlans("go-b", plans)
+	require.Len(t, matches, 1)
+	assert.Equal(t, []int{0, 1, 2, 3}, matches[0].idPos, "the ID matches better than the title")
+
+	assert.Empty(t, matchPlans("zzz", plans))
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/tui/app"
+	"github.com/pezware/samedi.dev/internal/tui/keymap"
+)
+
+// SortSaver remembers the plan list sort, so the next dashboard and
+// `samedi plan list` start with it.
+type SortSaver func(plan.ListSort) error
+
+// sortKey returns the sort a key leaves a plan list in: the next column
+// for keymap.SortPlans, the other direction for keymap.ReverseSort. ok is
+// false for any other key.
+func sortKey(keys *keymap.Keymap, msg tea.KeyMsg, current plan.ListSort) (plan.ListSort, bool) {
+	switch {
+	case keys.Matches(msg, keymap.SortPlans):
+		return current.Next(), true
+	case keys.Matches(msg, keymap.ReverseSort):
+		return current.Reversed(), true
+	}
+	return current, false
+}
+
+// sortChanged reports the new sort and saves it with save, if set.
+func sortChanged(s plan.ListSort, save SortSaver) tea.Cmd {
+	return func() tea.Msg {
+		if save != nil {
+			if err := save(s); err != nil {
+				return app.StatusMsg{Message: fmt.Sprintf("Failed to save the plan sort: %v", err), IsError: true}
+			}
+		}
+		if s.Field == "" {
+			return app.StatusMsg{Message: "Plans in their usual order"}
+		}
+		return app.StatusMsg{Message: "Plans sorted by " + s.Label()}
+	}
+}
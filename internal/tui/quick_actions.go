@@ -0,0 +1,178 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/tui/app"
+)
+
+// Quick action names accepted in tui.quick_actions bindings.
+const (
+	QuickActionStartNext = "start-next" // Start the next chunk of the pinned plan
+	QuickActionStopNote  = "stop-note"  // Ask for notes, then stop the active session
+	QuickActionStatus    = "status"     // Show the active session in the footer
+)
+
+// QuickActionPlans loads plans for quick actions.
+type QuickActionPlans interface {
+	Get(ctx context.Context, id string) (*plan.Plan, error)
+}
+
+// QuickActionSessions starts, stops, and inspects sessions for quick actions.
+type QuickActionSessions interface {
+	Start(ctx context.Context, req session.StartRequest) (*session.Session, error)
+	Stop(ctx context.Context, req session.StopRequest) (*session.Session, error)
+	GetActive(ctx context.Context) (*session.Session, error)
+	ListAll(ctx context.Context) ([]*session.Session, error)
+}
+
+// quickActionRunner carries the services and settings the built-in quick
+// actions compose.
+type quickActionRunner struct {
+	ctx        context.Context
+	plans      QuickActionPlans
+	sessions   QuickActionSessions
+	pinnedPlan string // Plan for start-next; empty means the last studied plan
+}
+
+// NewQuickActions builds shell quick actions from "key=action" bindings,
+// e.g. "f2=start-next". Unknown actions and malformed bindings are errors.
+func NewQuickActions(bindings []string, pinnedPlan string, plans QuickActionPlans, sessions QuickActionSessions) ([]app.QuickAction, error) {
+	r := &quickActionRunner{
+		ctx:        context.Background(),
+		plans:      plans,
+		sessions:   sessions,
+		pinnedPlan: pinnedPlan,
+	}
+
+	actions := make([]app.QuickAction, 0, len(bindings))
+	for _, binding := range bindings {
+		key, name, ok := strings.Cut(binding, "=")
+		key, name = strings.TrimSpace(key), strings.TrimSpace(name)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid quick action %q (expected key=action)", binding)
+		}
+
+		switch name {
+		case QuickActionStartNext:
+			actions = append(actions, app.QuickAction{Key: key, Description: "start next", Run: r.startNext})
+		case QuickActionStopNote:
+			actions = append(actions, app.QuickAction{Key: key, Description: "stop", Prompt: "Session notes", Run: r.stopWithNote})
+		case QuickActionStatus:
+			actions = append(actions, app.QuickAction{Key: key, Description: "session", Run: r.status})
+		default:
+			return nil, fmt.Errorf("unknown quick action %q for %s", name, key)
+		}
+	}
+	return actions, nil
+}
+
+// ReadOnlyQuickActions drops bindings whose actions start or stop
+// sessions, keeping those that only show information.
+func ReadOnlyQuickActions(bindings []string) []string {
+	kept := make([]string, 0, len(bindings))
+	for _, binding := range bindings {
+		if _, name, _ := strings.Cut(binding, "="); strings.TrimSpace(name) == QuickActionStatus {
+			kept = append(kept, binding)
+		}
+	}
+	return kept
+}
+
+// startNext starts a session on the next open chunk of the pinned plan.
+func (r *quickActionRunner) startNext(string) tea.Cmd {
+	var planID string
+	start := func() tea.Msg {
+		id, err := r.targetPlan()
+		if err != nil {
+			return quickActionError(err)
+		}
+		planID = id
+
+		p, err := r.plans.Get(r.ctx, planID)
+		if err != nil {
+			return quickActionError(err)
+		}
+
+		req := session.StartRequest{PlanID: planID}
+		label := planID
+		if chunk := p.NextChunk(); chunk != nil {
+			req.ChunkID = chunk.ID
+			label = fmt.Sprintf("%s · %s", planID, chunk.Title)
+		}
+		if _, err := r.sessions.Start(r.ctx, req); err != nil {
+			return quickActionError(err)
+		}
+		return app.StatusMsg{Message: "Started " + label}
+	}
+
+	return tea.Sequence(start, func() tea.Msg { return sessionsChanged(planID) })
+}
+
+// stopWithNote stops the active session, saving notes as its notes.
+func (r *quickActionRunner) stopWithNote(notes string) tea.Cmd {
+	var planID string
+	stop := func() tea.Msg {
+		sess, err := r.sessions.Stop(r.ctx, session.StopRequest{Notes: strings.TrimSpace(notes)})
+		if err != nil {
+			return quickActionError(err)
+		}
+		planID = sess.PlanID
+		return app.StatusMsg{Message: fmt.Sprintf("Stopped %s after %s", sess.PlanID, sess.ElapsedTime())}
+	}
+
+	return tea.Sequence(stop, func() tea.Msg { return sessionsChanged(planID) })
+}
+
+// status reports the active session in the footer.
+func (r *quickActionRunner) status(string) tea.Cmd {
+	return func() tea.Msg {
+		sess, err := r.sessions.GetActive(r.ctx)
+		if err != nil {
+			return quickActionError(err)
+		}
+		if sess == nil {
+			return app.StatusMsg{Message: "No active session"}
+		}
+
+		label := sess.PlanID
+		if sess.ChunkID != "" {
+			label += " · " + sess.ChunkID
+		}
+		return app.StatusMsg{Message: fmt.Sprintf("Active: %s (%s)", label, sess.ElapsedTime())}
+	}
+}
+
+// targetPlan returns the pinned plan, or the plan of the most recent session.
+func (r *quickActionRunner) targetPlan() (string, error) {
+	if r.pinnedPlan != "" {
+		return r.pinnedPlan, nil
+	}
+
+	sessions, err := r.sessions.ListAll(r.ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(sessions) == 0 {
+		return "", fmt.Errorf("no plan to start: set tui.pinned_plan or start a session first")
+	}
+	return sessions[0].PlanID, nil
+}
+
+// sessionsChanged tells every module that sessions (and possibly chunk
+// statuses of planID) changed.
+func sessionsChanged(planID string) tea.Msg {
+	return app.BroadcastMsg{Topic: app.TopicSessionsChanged, Payload: planID, External: true}
+}
+
+func quickActionError(err error) tea.Msg {
+	return app.StatusMsg{Message: err.Error(), IsError: true}
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/tui/app"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeQuickPlans struct {
+	plans map[string]*plan.Plan
+}
+
+func (f *fakeQuickPlans) Get(_ context.Context, id string) (*plan.Plan, error) {
+	if p, ok := f.plans[id]; ok {
+		return p, nil
+	}
+	return nil, errors.New("plan not found: " + id)
+}
+
+type fakeQuickSessions struct {
+	active  *session.Session
+	history []*session.Session
+	started []session.StartRequest
+	stopped []session.StopRequest
+}
+
+func (f *fakeQuickSessions) Start(_ context.Context, req session.StartRequest) (*session.Session, error) {
+	if f.active != nil {
+		return nil, errors.New("a session is already active")
+	}
+	f.started = append(f.started, req)
+	f.active = &session.Session{ID: "s1", PlanID: req.PlanID, ChunkID: req.ChunkID, StartTime: time.Now()}
+	return f.active, nil
+}
+
+func (f *fakeQuickSessions) Stop(_ context.Context, req session.StopRequest) (*session.Session, error) {
+	if f.active == nil {
+		return nil, errors.New("no active session")
+	}
+	f.stopped = append(f.stopped, req)
+	stopped := f.active
+	f.active = nil
+	return stopped, nil
+}
+
+func (f *fakeQuickSessions) GetActive(_ context.Context) (*session.Session, error) {
+	return f.active, nil
+}
+
+func (f *fakeQuickSessions) ListAll(_ context.Context) ([]*session.Session, error) {
+	return f.history, nil
+}
+
+// runSequence runs a tea.Sequence command and collects the messages of
+// its steps. The sequence message type is unexported, so it is read as a
+// slice of commands.
+func runSequence(t *testing.T, cmd tea.Cmd) []tea.Msg {
+	t.Helper()
+	require.NotNil(t, cmd)
+	steps := reflect.ValueOf(cmd())
+	require.Equal(t, reflect.Slice, steps.Kind())
+
+	msgs := make([]tea.Msg, 0, steps.Len())
+	for i := 0; i < steps.Len(); i++ {
+		step, ok := steps.Index(i).Interface().(tea.Cmd)
+		require.True(t, ok)
+		msgs = append(msgs, step())
+	}
+	return msgs
+}
+
+func quickActionFixtures() (*fakeQuickPlans, *fakeQuickSessions) {
+	plans := &fakeQuickPlans{plans: map[string]*plan.Plan{
+		"rust-async": {
+			ID: "rust-async",
+			Chunks: []plan.Chunk{
+				{ID: "chunk-001", Title: "Futures", Status: plan.StatusCompleted},
+				{ID: "chunk-002", Title: "Tokio basics", Status: plan.StatusNotStarted},
+			},
+		},
+	}}
+	sessions := &fakeQuickSessions{history: []*session.Session{{ID: "old", PlanID: "rust-async"}}}
+	return plans, sessions
+}
+
+func TestNewQuickActions_InvalidBindings(t *testing.T) {
+	plans, sessions := quickActionFixtures()
+
+	_, err := NewQuickActions([]string{"f2"}, "", plans, sessions)
+	assert.ErrorContains(t, err, "expected key=action")
+
+	_, err = NewQuickActions([]string{"f2=dance"}, "", plans, sessions)
+	assert.ErrorContains(t, err, "unknown quick action")
+}
+
+func TestQuickActions_StartNextAndStop(t *testing.T) {
+	plans, sessions := quickActionFixtures()
+	actions, err := NewQuickActions([]string{"f2=start-next", "f3=stop-note", "f4=status"}, "", plans, sessions)
+	require.NoError(t, err)
+	require.Len(t, actions, 3)
+	assert.Equal(t, "Session notes", actions[1].Prompt)
+
+	msgs := runSequence(t, actions[0].Run(""))
+	require.Len(t, msgs, 2)
+	assert.Equal(t, app.StatusMsg{Message: "Started rust-async · Tokio basics"}, msgs[0])
+	assert.Equal(t, app.BroadcastMsg{Topic: app.TopicSessionsChanged, Payload: "rust-async", External: true}, msgs[1])
+	assert.Equal(t, []session.StartRequest{{PlanID: "rust-async", ChunkID: "chunk-002"}}, sessions.started)
+
+	status := actions[2].Run("")()
+	assert.Contains(t, status.(app.StatusMsg).Message, "Active: rust-async · chunk-002")
+
+	msgs = runSequence(t, actions[1].Run("  finished the tokio tutorial "))
+	assert.Contains(t, msgs[0].(app.StatusMsg).Message, "Stopped rust-async")
+	assert.Equal(t, "finished the tokio tutorial", sessions.stopped[0].Notes)
+}
+
+func TestQuickActions_StartNextUsesPinnedPlan(t *testing.T) {
+	plans, sessions := quickActionFixtures()
+	actions, err := NewQuickActions([]string{"f2=start-next"}, "french-b1", plans, sessions)
+	require.NoError(t, err)
+
+	msgs := runSequence(t, actions[0].Run(""))
+	status := msgs[0].(app.StatusMsg)
+	assert.True(t, status.IsError)
+	assert.Contains(t, status.Message, "french-b1")
+}
+
+func TestQuickActions_StartNextWithoutHistory(t *testing.T) {
+	plans, _ := quickActionFixtures()
+	actions, err := NewQuickActions([]string{"f2=start-next"}, "", plans, &fakeQuickSessions{})
+	require.NoError(t, err)
+
+	msgs := runSequence(t, actions[0].Run(""))
+	assert.Contains(t, msgs[0].(app.StatusMsg).Message, "tui.pinned_plan")
+}
+
+func TestReadOnlyQuickActions(t *testing.T) {
+	bindings := []string{"f2=start-next", "f3=stop-note", "f4 = status"}
+	assert.Equal(t, []string{"f4 = status"}, ReadOnlyQuickActions(bindings))
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/pezware/samedi.dev/internal/quiz"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
+)
+
+// QuizGrader grades a finished quiz's answers (see quiz.Service.Grade).
+type QuizGrader func(ctx context.Context, answers []string) (*quiz.Attempt, error)
+
+type quizState string
+
+const (
+	quizStateAnswering quizState = "answering"
+	quizStateGrading   quizState = "grading"
+	quizStateResults   quizState = "results"
+)
+
+type quizGradedMsg struct {
+	attempt *quiz.Attempt
+	err     error
+}
+
+var quizQuestionStyle = lipgloss.NewStyle().Bold(true)
+
+// QuizModel runs a quiz one question at a time, then grades the answers
+// and shows the results. It is a standalone Bubble Tea program.
+type QuizModel struct {
+	quiz  *quiz.Quiz
+	grade QuizGrader
+
+	state   quizState
+	index   int
+	answers []string
+	input   *inputField
+
+	attempt *quiz.Attempt
+	err     error
+}
+
+// NewQuizModel creates a quiz runner for q.
+func NewQuizModel(q *quiz.Quiz, grade QuizGrader) *QuizModel {
+	input := newInputField("Type your answer…")
+	input.Focus()
+
+	return &QuizModel{
+		quiz:    q,
+		grade:   grade,
+		state:   quizStateAnswering,
+		answers: make([]string, len(q.Questions)),
+		input:   input,
+	}
+}
+
+// Attempt returns the graded attempt, or nil if the quiz was abandoned
+// or grading failed.
+func (m *QuizModel) Attempt() *quiz.Attempt {
+	return m.attempt
+}
+
+// Init satisfies tea.Model.
+func (m *QuizModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update satisfies tea.Model.
+func (m *QuizModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+		switch m.state {
+		case quizStateAnswering:
+			return m.handleAnswerKey(msg)
+		case quizStateResults:
+			return m.handleResultsKey(msg)
+		}
+	case quizGradedMsg:
+		m.state = quizStateResults
+		m.attempt = msg.attempt
+		m.err = msg.err
+	}
+	return m, nil
+}
+
+// handleAnswerKey edits the current answer and moves between questions.
+func (m *QuizModel) handleAnswerKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		return m, tea.Quit
+	case tea.KeyEnter:
+		m.answers[m.index] = m.input.Value()
+		if m.index == len(m.quiz.Questions)-1 {
+			return m, m.startGrading()
+		}
+		m.index++
+		m.input.SetValue(m.answers[m.index])
+		return m, nil
+	case tea.KeyShiftTab:
+		if m.index > 0 {
+			m.answers[m.index] = m.input.Value()
+			m.index--
+			m.input.SetValue(m.answers[m.index])
+		}
+		return m, nil
+	}
+
+	m.input.Update(msg)
+	return m, nil
+}
+
+// handleResultsKey retries failed grading or exits.
+func (m *QuizModel) handleResultsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.err != nil && msg.String() == "r" {
+		return m, m.startGrading()
+	}
+	switch msg.String() {
+	case "q", "esc", "enter":
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+// startGrading grades all answers in the background.
+func (m *QuizModel) startGrading() tea.Cmd {
+	m.state = quizStateGrading
+	m.err = nil
+	answers := append([]string(nil), m.answers...)
+
+	return func() tea.Msg {
+		attempt, err := m.grade(context.Background(), answers)
+		return quizGradedMsg{attempt: attempt, err: err}
+	}
+}
+
+// View satisfies tea.Model.
+func (m *QuizModel) View() string {
+	var b strings.Builder
+	b.WriteString(styles.Accent().Render(fmt.Sprintf("🧠 Quiz: %s", m.quiz.ChunkTitle)))
+	b.WriteString("\n\n")
+
+	switch m.state {
+	case quizStateGrading:
+		b.WriteString("Grading your answers…\n")
+	case quizStateResults:
+		m.renderResults(&b)
+	default:
+		question := m.quiz.Questions[m.index]
+		b.WriteString(styles.Muted().Render(fmt.Sprintf("Question %d of %d", m.index+1, len(m.quiz.Questions))))
+		b.WriteString("\n")
+		b.WriteString(quizQuestionStyle.Render(question.Text))
+		b.WriteString("\n\n")
+		b.WriteString(m.input.View())
+		b.WriteString("\n\n")
+		b.WriteString(styles.Muted().Render("[enter] next  [shift+tab] back  [esc] quit"))
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// renderResults shows each answer's points and feedback, or the grading error.
+func (m *QuizModel) renderResults(b *strings.Builder) {
+	if m.err != nil {
+		b.WriteString(styles.Error().Render(fmt.Sprintf("Grading failed: %v", m.err)))
+		b.WriteString("\n\n")
+		b.WriteString(styles.Muted().Render("[r] retry  [q] quit"))
+		b.WriteString("\n")
+		return
+	}
+
+	for i, result := range m.attempt.Results {
+		fmt.Fprintf(b, "%d. %s  %s\n", i+1, result.Question,
+			styles.Muted().Render(fmt.Sprintf("%d/%d", result.Points, quiz.MaxPoints)))
+		if result.Feedback != "" {
+			fmt.Fprintf(b, "   %s\n", result.Feedback)
+		}
+	}
+
+	fmt.Fprintf(b, "\nScore: %d%%\n\n", m.attempt.Score)
+	b.WriteString(styles.Muted().Render("[q] done"))
+	b.WriteString("\n")
+}
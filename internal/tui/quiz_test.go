@@ -0,0 +1,106 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pezware/samedi.dev/internal/quiz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testQuiz() *quiz.Quiz {
+	return &quiz.Quiz{
+		PlanID:     "rust",
+		ChunkID:    "chunk-001",
+		ChunkTitle: "Futures",
+		Questions: []quiz.Question{
+			{Text: "What does poll return?"},
+			{Text: "Who calls poll?"},
+		},
+	}
+}
+
+func typeText(m *QuizModel, text string) {
+	for _, r := range text {
+		if r == ' ' {
+			m.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+			continue
+		}
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+}
+
+func TestQuizModel_AnswerAndGrade(t *testing.T) {
+	var graded []string
+	grader := func(_ context.Context, answers []string) (*quiz.Attempt, error) {
+		graded = answers
+		return &quiz.Attempt{
+			Score:   75,
+			Results: []quiz.Result{{Question: "What does poll return?", Points: 9, Feedback: "Good."}, {Question: "Who calls poll?", Points: 6}},
+		}, nil
+	}
+	m := NewQuizModel(testQuiz(), grader)
+
+	assert.Contains(t, m.View(), "Question 1 of 2")
+	typeText(m, "Ready or Pending")
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Contains(t, m.View(), "Who calls poll?")
+
+	typeText(m, "executor")
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.Contains(t, m.View(), "Grading")
+
+	m.Update(cmd())
+	assert.Equal(t, []string{"Ready or Pending", "executor"}, graded)
+	require.NotNil(t, m.Attempt())
+	assert.Contains(t, m.View(), "Score: 75%")
+	assert.Contains(t, m.View(), "Good.")
+}
+
+func TestQuizModel_BackKeepsAnswers(t *testing.T) {
+	m := NewQuizModel(testQuiz(), nil)
+
+	typeText(m, "first")
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	typeText(m, "second")
+	m.Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+
+	assert.Equal(t, 0, m.index)
+	assert.Equal(t, "first", m.input.Value())
+	assert.Equal(t, "second", m.answers[1])
+}
+
+func TestQuizModel_GradingErrorCanRetry(t *testing.T) {
+	calls := 0
+	grader := func(_ context.Context, _ []string) (*quiz.Attempt, error) {
+		calls++
+		return nil, errors.New("timeout")
+	}
+	m := NewQuizModel(&quiz.Quiz{ChunkTitle: "Futures", Questions: []quiz.Question{{Text: "Q"}}}, grader)
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m.Update(cmd())
+	assert.Contains(t, m.View(), "Grading failed: timeout")
+	assert.Nil(t, m.Attempt())
+
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	require.NotNil(t, cmd)
+	m.Update(cmd())
+	assert.Equal(t, 2, calls)
+}
+
+func TestQuizModel_EscQuits(t *testing.T) {
+	m := NewQuizModel(testQuiz(), nil)
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.NotNil(t, cmd)
+	assert.Equal(t, tea.Quit(), cmd())
+	assert.Nil(t, m.Attempt())
+}
@@ -0,0 +1,548 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/pezware/samedi.dev/internal/flashcard"
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/pezware/samedi.dev/internal/tui/app"
+	"github.com/pezware/samedi.dev/internal/tui/keymap"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
+)
+
+// CardReviewer lists due flashcards and grades them (see
+// flashcard.Scheduler).
+type CardReviewer interface {
+	Due(ctx context.Context) ([]flashcard.DueCard, error)
+	Grade(ctx context.Context, card flashcard.DueCard, rating flashcard.Rating) (flashcard.Schedule, error)
+}
+
+type reviewState string
+
+const (
+	stateReviewOverview reviewState = "overview"
+	stateReviewQuestion reviewState = "question"
+	stateReviewAnswer   reviewState = "answer"
+	stateReviewSummary  reviewState = "summary"
+)
+
+// reviewDeck is the due cards of one plan, or of every plan when planID
+// is empty.
+type reviewDeck struct {
+	planID string
+	cards  []flashcard.DueCard
+	new    int
+}
+
+// ReviewModule shows how many flashcards are due per plan and runs review
+// sessions: each card's front, then its back, then a 1-4 grade.
+type ReviewModule struct {
+	reviewer CardReviewer
+	ctx      context.Context
+
+	state   reviewState
+	decks   []reviewDeck // "All plans" first, then one per plan
+	cursor  int
+	loaded  bool
+	loadErr error
+
+	// The running review: its cards, the current one, and the ratings
+	// given so far.
+	queue   []flashcard.DueCard
+	index   int
+	grading bool
+	ratings []flashcard.Rating
+
+	// readOnly refuses starting reviews, since grading writes schedules.
+	readOnly bool
+
+	keys *keymap.Keymap
+}
+
+type dueCardsLoadedMsg struct {
+	cards []flashcard.DueCard
+	err   error
+}
+
+type cardGradedMsg struct {
+	rating flashcard.Rating
+	err    error
+}
+
+// NewReviewModule returns a flashcard review module.
+func NewReviewModule(reviewer CardReviewer) *ReviewModule {
+	return &ReviewModule{
+		reviewer: reviewer,
+		ctx:      context.Background(),
+		state:    stateReviewOverview,
+		keys:     keymap.Default(),
+	}
+}
+
+// SetKeymap replaces the module's key bindings.
+func (m *ReviewModule) SetKeymap(keys *keymap.Keymap) {
+	m.keys = keys
+}
+
+// SetReadOnly turns read-only mode on or off. In read-only mode due
+// counts are shown but reviews cannot be started.
+func (m *ReviewModule) SetReadOnly(readOnly bool) {
+	m.readOnly = readOnly
+}
+
+// ID satisfies app.Module.
+func (m *ReviewModule) ID() string {
+	return "review"
+}
+
+// Title satisfies app.Module.
+func (m *ReviewModule) Title() string {
+	return "Review"
+}
+
+// Shortcuts satisfies app.Module.
+func (m *ReviewModule) Shortcuts() []app.Shortcut {
+	keys := m.keys
+	switch m.state {
+	case stateReviewQuestion:
+		return shortcutsFor(keys, keymap.Reveal)
+	case stateReviewAnswer:
+		return []app.Shortcut{{Key: "1-4", Description: "grade"}}
+	case stateReviewSummary:
+		return []app.Shortcut{{Key: keys.Label(keymap.Select), Description: "done"}}
+	}
+	if m.readOnly {
+		return []app.Shortcut{}
+	}
+	return []app.Shortcut{{Key: keys.Label(keymap.Select), Description: "start review"}}
+}
+
+// Help lists every review binding for the shell's help overlay.
+func (m *ReviewModule) Help() []app.Shortcut {
+	help := shortcutsFor(m.keys, keymap.Up, keymap.Down, keymap.Select, keymap.Back, keymap.Reveal)
+	return append(help, app.Shortcut{Key: "1-4", Description: "grade: again, hard, good, easy"})
+}
+
+// CapturingInput reports whether a card is showing, so the grading keys
+// 1-4 reach the module instead of switching modules.
+func (m *ReviewModule) CapturingInput() bool {
+	return m.state == stateReviewQuestion || m.state == stateReviewAnswer
+}
+
+// Commands satisfies app.CommandProvider: reviewing each deck due today.
+func (m *ReviewModule) Commands() []app.Command {
+	if m.readOnly || m.state != stateReviewOverview {
+		return nil
+	}
+	commands := make([]app.Command, 0, len(m.decks))
+	for _, deck := range m.decks {
+		title := "Review cards: " + deck.planID
+		if deck.planID == "" {
+			title = "Review all due cards"
+		}
+		commands = append(commands, app.Command{
+			Title: title,
+			Hint:  fmt.Sprintf("%d due", len(deck.cards)),
+			Msg:   commandMsg{action: keymap.Select, planID: deck.planID},
+		})
+	}
+	return commands
+}
+
+// runCommand starts the review of the deck a command palette entry names.
+// A review already under way carries on.
+func (m *ReviewModule) runCommand(msg commandMsg) tea.Cmd {
+	if m.state != stateReviewOverview {
+		return paletteBusy(m.Title())
+	}
+	for _, deck := range m.decks {
+		if deck.planID == msg.planID {
+			m.start(deck)
+			return nil
+		}
+	}
+	return nil
+}
+
+// Init satisfies tea.Model.
+func (m *ReviewModule) Init() tea.Cmd {
+	return nil
+}
+
+// Update satisfies tea.Model.
+func (m *ReviewModule) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return m, m.handleKey(msg)
+	case dueCardsLoadedMsg:
+		return m, m.handleDueLoaded(msg)
+	case cardGradedMsg:
+		return m, m.handleGraded(msg)
+	case app.BroadcastMsg:
+		if msg.Topic == app.TopicPlansChanged && m.state == stateReviewOverview {
+			return m, m.loadDue()
+		}
+	case app.RefreshMsg:
+		// Mid-review, reloading would reshuffle the queue under the user
+		if m.state == stateReviewOverview {
+			return m, m.loadDue()
+		}
+	case commandMsg:
+		return m, m.runCommand(msg)
+	case app.ModuleActivatedMsg:
+		if msg.ID == m.ID() && m.state == stateReviewOverview {
+			return m, m.loadDue()
+		}
+	}
+	return m, nil
+}
+
+// View satisfies tea.Model.
+func (m *ReviewModule) View() string {
+	if m.loadErr != nil {
+		return fmt.Sprintf("Failed to load flashcards: %v", m.loadErr)
+	}
+	if !m.loaded {
+		return "Loading flashcards…"
+	}
+
+	switch m.state {
+	case stateReviewQuestion, stateReviewAnswer:
+		return m.renderCard()
+	case stateReviewSummary:
+		return m.renderSummary()
+	default:
+		return m.renderOverview()
+	}
+}
+
+// --------------------------------------------------------------------
+// Loading
+
+func (m *ReviewModule) loadDue() tea.Cmd {
+	if m.reviewer == nil {
+		m.loadErr = fmt.Errorf("flashcard service unavailable")
+		return nil
+	}
+	return func() tea.Msg {
+		cards, err := m.reviewer.Due(m.ctx)
+		return dueCardsLoadedMsg{cards: cards, err: err}
+	}
+}
+
+func (m *ReviewModule) handleDueLoaded(msg dueCardsLoadedMsg) tea.Cmd {
+	if msg.err != nil {
+		m.loadErr = msg.err
+		return func() tea.Msg {
+			return app.StatusMsg{Message: fmt.Sprintf("Failed to load flashcards: %v", msg.err), IsError: true}
+		}
+	}
+
+	m.loadErr = nil
+	m.loaded = true
+	m.decks = groupDecks(msg.cards)
+	if m.cursor >= len(m.decks) {
+		m.cursor = 0
+	}
+	return nil
+}
+
+// groupDecks splits due cards into one deck per plan, sorted by plan ID,
+// behind an "All plans" deck holding every card.
+func groupDecks(cards []flashcard.DueCard) []reviewDeck {
+	if len(cards) == 0 {
+		return nil
+	}
+
+	all := reviewDeck{cards: cards}
+	byPlan := make(map[string]*reviewDeck)
+	for _, card := range cards {
+		deck, ok := byPlan[card.PlanID]
+		if !ok {
+			deck = &reviewDeck{planID: card.PlanID}
+			byPlan[card.PlanID] = deck
+		}
+		deck.cards = append(deck.cards, card)
+		if card.New {
+			deck.new++
+			all.new++
+		}
+	}
+
+	decks := []reviewDeck{all}
+	for _, deck := range byPlan {
+		decks = append(decks, *deck)
+	}
+	sort.Slice(decks[1:], func(i, j int) bool {
+		return decks[i+1].planID < decks[j+1].planID
+	})
+	return decks
+}
+
+// --------------------------------------------------------------------
+// Reviewing
+
+func (m *ReviewModule) start(deck reviewDeck) {
+	m.queue = deck.cards
+	m.index = 0
+	m.ratings = nil
+	m.state = stateReviewQuestion
+}
+
+func (m *ReviewModule) grade(rating flashcard.Rating) tea.Cmd {
+	card := m.queue[m.index]
+	m.grading = true
+	return func() tea.Msg {
+		_, err := m.reviewer.Grade(m.ctx, card, rating)
+		return cardGradedMsg{rating: rating, err: err}
+	}
+}
+
+// handleGraded moves to the next card, or finishes the review after the
+// last one.
+func (m *ReviewModule) handleGraded(msg cardGradedMsg) tea.Cmd {
+	m.grading = false
+	if msg.err != nil {
+		return func() tea.Msg {
+			return app.StatusMsg{Message: fmt.Sprintf("Failed to save grade: %v", msg.err), IsError: true}
+		}
+	}
+
+	m.ratings = append(m.ratings, msg.rating)
+	m.index++
+	if m.index < len(m.queue) {
+		m.state = stateReviewQuestion
+		return nil
+	}
+	return m.finish()
+}
+
+// finish shows the summary and reports the review to the other modules.
+// A review ended before any card was graded just returns to the overview.
+func (m *ReviewModule) finish() tea.Cmd {
+	if len(m.ratings) == 0 {
+		m.state = stateReviewOverview
+		m.queue = nil
+		return m.loadDue()
+	}
+
+	m.state = stateReviewSummary
+	summary := m.summary()
+	return tea.Batch(
+		func() tea.Msg {
+			return app.StatusMsg{Message: fmt.Sprintf("Reviewed %d cards · %.0f%% retention", summary.Reviews, summary.Retention)}
+		},
+		func() tea.Msg {
+			return app.BroadcastMsg{Topic: app.TopicReviewsChanged, Payload: summary}
+		},
+	)
+}
+
+// summary tallies the ratings given in the running review.
+func (m *ReviewModule) summary() stats.ReviewStats {
+	reviews := make([]flashcard.Review, len(m.ratings))
+	for i, rating := range m.ratings {
+		reviews[i] = flashcard.Review{Rating: rating}
+	}
+	return stats.CalculateReviewStats(reviews)
+}
+
+// --------------------------------------------------------------------
+// Input handling
+
+func (m *ReviewModule) handleKey(msg tea.KeyMsg) tea.Cmd {
+	keys := m.keys
+	switch m.state {
+	case stateReviewQuestion:
+		switch {
+		case keys.Matches(msg, keymap.Reveal):
+			m.state = stateReviewAnswer
+		case keys.Matches(msg, keymap.Back):
+			return m.finish()
+		}
+	case stateReviewAnswer:
+		// Wait for the grade being saved before moving on
+		if m.grading {
+			return nil
+		}
+		if keys.Matches(msg, keymap.Back) {
+			return m.finish()
+		}
+		if rating, ok := ratingForKey(msg); ok {
+			return m.grade(rating)
+		}
+	case stateReviewSummary:
+		if keys.Matches(msg, keymap.Select) || keys.Matches(msg, keymap.Back) {
+			m.state = stateReviewOverview
+			m.queue = nil
+			m.ratings = nil
+			return m.loadDue()
+		}
+	default:
+		return m.handleOverviewKey(msg)
+	}
+	return nil
+}
+
+func (m *ReviewModule) handleOverviewKey(msg tea.KeyMsg) tea.Cmd {
+	keys := m.keys
+	switch {
+	case keys.Matches(msg, keymap.Up):
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case keys.Matches(msg, keymap.Down):
+		if m.cursor < len(m.decks)-1 {
+			m.cursor++
+		}
+	case keys.Matches(msg, keymap.Select):
+		if len(m.decks) == 0 {
+			return nil
+		}
+		if m.readOnly {
+			return func() tea.Msg {
+				return app.StatusMsg{Message: "Read-only mode: cards cannot be reviewed", IsError: true}
+			}
+		}
+		m.start(m.decks[m.cursor])
+	}
+	return nil
+}
+
+// ratingForKey maps the grading keys 1-4 to ratings. They are fixed, like
+// a form's Enter and Tab, because the digits are not remappable.
+func ratingForKey(msg tea.KeyMsg) (flashcard.Rating, bool) {
+	switch msg.String() {
+	case "1":
+		return flashcard.Again, true
+	case "2":
+		return flashcard.Hard, true
+	case "3":
+		return flashcard.Good, true
+	case "4":
+		return flashcard.Easy, true
+	}
+	return 0, false
+}
+
+// --------------------------------------------------------------------
+// Rendering
+
+func (m *ReviewModule) renderOverview() string {
+	var b strings.Builder
+
+	b.WriteString(styles.Title().Render("Flashcard review"))
+	b.WriteString("\n\n")
+
+	if len(m.decks) == 0 {
+		b.WriteString(styles.Muted().Render("No cards due today."))
+		return b.String()
+	}
+
+	for i, deck := range m.decks {
+		name := deck.planID
+		if name == "" {
+			name = "All plans"
+		}
+		line := fmt.Sprintf("%-24s %3d due", name, len(deck.cards))
+		if deck.new > 0 {
+			line += styles.Muted().Render(fmt.Sprintf("  (%d new)", deck.new))
+		}
+		if i == m.cursor {
+			b.WriteString(styles.Selected().Render("> " + line))
+		} else {
+			b.WriteString("  " + line)
+		}
+		b.WriteString("\n")
+	}
+
+	if !m.readOnly {
+		b.WriteString("\n")
+		b.WriteString(styles.Muted().Render(keyHints(keyHint(m.keys, keymap.Select, "Start review"))))
+	}
+	return b.String()
+}
+
+func (m *ReviewModule) renderCard() string {
+	var b strings.Builder
+	card := m.queue[m.index]
+
+	b.WriteString(styles.Title().Render(fmt.Sprintf("Card %d of %d", m.index+1, len(m.queue))))
+	b.WriteString(styles.Muted().Render("  " + card.PlanID))
+	b.WriteString("\n\n")
+
+	box := lipgloss.NewStyle().
+		Padding(0, 2).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color(styles.Current().Border))
+	b.WriteString(box.Render(lipgloss.NewStyle().Bold(true).Render(card.Question)))
+	b.WriteString("\n\n")
+
+	if m.state == stateReviewQuestion {
+		b.WriteString(styles.Muted().Render(keyHints(
+			keyHint(m.keys, keymap.Reveal, "Show answer"),
+			keyHint(m.keys, keymap.Back, "End review"),
+		)))
+		return b.String()
+	}
+
+	b.WriteString(box.Render(card.Answer))
+	b.WriteString("\n\n")
+
+	// Each grade shows when it would bring the card back
+	now := time.Now()
+	grades := make([]string, 0, len(flashcard.Ratings))
+	for _, rating := range flashcard.Ratings {
+		next := card.Schedule.Review(rating, now)
+		grades = append(grades, fmt.Sprintf("[%d] %s %s", int(rating), rating, styles.Muted().Render(formatInterval(next.IntervalDays))))
+	}
+	b.WriteString(strings.Join(grades, "   "))
+	b.WriteString("\n\n")
+	b.WriteString(styles.Muted().Render(keyHints(keyHint(m.keys, keymap.Back, "End review"))))
+	return b.String()
+}
+
+func (m *ReviewModule) renderSummary() string {
+	var b strings.Builder
+	summary := m.summary()
+
+	b.WriteString(styles.Title().Render("Review complete"))
+	b.WriteString("\n\n")
+
+	b.WriteString(fmt.Sprintf("Cards reviewed:  %d", summary.Reviews))
+	if skipped := len(m.queue) - summary.Reviews; skipped > 0 {
+		b.WriteString(styles.Muted().Render(fmt.Sprintf("  (%d left for later)", skipped)))
+	}
+	b.WriteString("\n")
+	counts := []int{summary.Again, summary.Hard, summary.Good, summary.Easy}
+	for i, rating := range flashcard.Ratings {
+		count := counts[i]
+		b.WriteString(fmt.Sprintf("  %-6s %3d  %3.0f%%\n", rating, count, float64(count)/float64(summary.Reviews)*100))
+	}
+	b.WriteString(fmt.Sprintf("Retention:       %.0f%%\n", summary.Retention))
+
+	b.WriteString("\n")
+	b.WriteString(styles.Muted().Render(keyHints(keyHint(m.keys, keymap.Select, "Done"))))
+	return b.String()
+}
+
+// formatInterval renders a review interval in days as a short label.
+func formatInterval(days int) string {
+	switch {
+	case days < 30:
+		return fmt.Sprintf("%dd", days)
+	case days < 365:
+		return fmt.Sprintf("%dmo", days/30)
+	default:
+		return fmt.Sprintf("%.1fy", float64(days)/365)
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pezware/samedi.dev/internal/flashcard"
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/pezware/samedi.dev/internal/tui/app"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeReviewer serves fixed due cards and records grades.
+type fakeReviewer struct {
+	due    []flashcard.DueCard
+	graded []flashcard.Rating
+}
+
+func (f *fakeReviewer) Due(_ context.Context) ([]flashcard.DueCard, error) {
+	return f.due, nil
+}
+
+func (f *fakeReviewer) Grade(_ context.Context, _ flashcard.DueCard, rating flashcard.Rating) (flashcard.Schedule, error) {
+	f.graded = append(f.graded, rating)
+	return flashcard.Schedule{}, nil
+}
+
+func newTestReviewModule() (*ReviewModule, *fakeReviewer) {
+	now := time.Now()
+	card := func(planID, id, question string) flashcard.DueCard {
+		return flashcard.DueCard{
+			Card:     flashcard.Card{ID: id, PlanID: planID, Question: question, Answer: "answer to " + question},
+			Schedule: flashcard.NewSchedule(now),
+			New:      true,
+		}
+	}
+	reviewer := &fakeReviewer{due: []flashcard.DueCard{
+		card("rust-async", "card-001", "What is a future?"),
+		card("rust-async", "card-002", "What does await do?"),
+		card("go-basics", "card-001", "What is a goroutine?"),
+	}}
+	m := NewReviewModule(reviewer)
+	m.Update(updateReview(m, app.ModuleActivatedMsg{ID: "review"}))
+	return m, reviewer
+}
+
+// updateReview sends msg to the module and returns the message of the
+// command it returns, if any.
+func updateReview(m *ReviewModule, msg tea.Msg) tea.Msg {
+	_, cmd := m.Update(msg)
+	if cmd == nil {
+		return nil
+	}
+	return cmd()
+}
+
+func TestReviewModule_Overview(t *testing.T) {
+	m, _ := newTestReviewModule()
+
+	view := m.View()
+	assert.Contains(t, view, "All plans")
+	assert.Contains(t, view, "go-basics")
+	assert.Contains(t, view, "rust-async")
+
+	require.Len(t, m.decks, 3)
+	assert.Len(t, m.decks[0].cards, 3)
+	assert.Equal(t, "go-basics", m.decks[1].planID, "plans are sorted by ID")
+	assert.Len(t, m.decks[2].cards, 2)
+}
+
+func TestReviewModule_ReviewSession(t *testing.T) {
+	m, reviewer := newTestReviewModule()
+
+	m.Update(key("j"))
+	m.Update(key("j"))
+	m.Update(key("enter"))
+	require.Equal(t, stateReviewQuestion, m.state)
+	assert.True(t, m.CapturingInput())
+	assert.Contains(t, m.View(), "What is a future?")
+	assert.NotContains(t, m.View(), "answer to", "the back stays hidden")
+
+	assert.Nil(t, updateReview(m, key("3")), "grades wait for the reveal")
+	m.Update(key("space"))
+	require.Equal(t, stateReviewAnswer, m.state)
+	assert.Contains(t, m.View(), "answer to What is a future?")
+	assert.Contains(t, m.View(), "[3] Good")
+
+	m.Update(updateReview(m, key("3")))
+	assert.Equal(t, stateReviewQuestion, m.state)
+	assert.Contains(t, m.View(), "Card 2 of 2")
+
+	m.Update(key("space"))
+	_, cmd := m.Update(updateReview(m, key("1")))
+	assert.Equal(t, []flashcard.Rating{flashcard.Good, flashcard.Again}, reviewer.graded)
+
+	require.Equal(t, stateReviewSummary, m.state)
+	assert.False(t, m.CapturingInput())
+	assert.Contains(t, m.View(), "Retention:       50%")
+
+	summary := stats.ReviewStats{Reviews: 2, Again: 1, Good: 1, Retention: 50}
+	assert.Contains(t, runSequence(t, cmd), app.BroadcastMsg{Topic: app.TopicReviewsChanged, Payload: summary})
+
+	m.Update(updateReview(m, key("enter")))
+	assert.Equal(t, stateReviewOverview, m.state)
+}
+
+func TestReviewModule_EndEarly(t *testing.T) {
+	m, reviewer := newTestReviewModule()
+
+	m.Update(key("enter"))
+	m.Update(updateReview(m, key("esc")))
+	assert.Equal(t, stateReviewOverview, m.state, "ending before any grade skips the summary")
+
+	m.Update(key("enter"))
+	m.Update(key("space"))
+	m.Update(updateReview(m, key("4")))
+	updateReview(m, key("esc"))
+	assert.Equal(t, stateReviewSummary, m.state)
+	assert.Contains(t, m.View(), "2 left for later")
+	assert.Equal(t, []flashcard.Rating{flashcard.Easy}, reviewer.graded)
+}
+
+func TestReviewModule_ReadOnly(t *testing.T) {
+	m, _ := newTestReviewModule()
+	m.SetReadOnly(true)
+
+	msg := updateReview(m, key("enter"))
+	require.IsType(t, app.StatusMsg{}, msg)
+	assert.True(t, msg.(app.StatusMsg).IsError)
+	assert.Equal(t, stateReviewOverview, m.state)
+	assert.Empty(t, m.Shortcuts())
+}
+
+func TestReviewModule_Commands(t *testing.T) {
+	m, _ := newTestReviewModule()
+
+	commands := m.Commands()
+	require.Len(t, commands, 3)
+	assert.Equal(t, "Review all due cards", commands[0].Title)
+	assert.Equal(t, "3 due", commands[0].Hint)
+	assert.Equal(t, "Review cards: rust-async", commands[2].Title)
+
+	m.Update(commands[2].Msg)
+	require.Equal(t, stateReviewQuestion, m.state)
+	assert.Contains(t, m.View(), "Card 1 of 2")
+	assert.Empty(t, m.Commands(), "no other deck mid-review")
+
+	msg := updateReview(m, commands[1].Msg)
+	require.IsType(t, app.StatusMsg{}, msg)
+	assert.True(t, msg.(app.StatusMsg).IsError)
+	assert.Contains(t, m.View(), "Card 1 of 2")
+}
+
+func TestFormatInterval(t *testing.T) {
+	assert.Equal(t, "1d", formatInterval(1))
+	assert.Equal(t, "2mo", formatInterval(65))
+	assert.Equal(t, "1.5y", formatInterval(548))
+}
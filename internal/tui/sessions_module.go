@@ -0,0 +1,749 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/pezware/samedi.dev/internal/tui/app"
+	"github.com/pezware/samedi.dev/internal/tui/keymap"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
+)
+
+// SessionTimer starts, stops, and pauses the session the Sessions module
+// shows.
+type SessionTimer interface {
+	Start(ctx context.Context, req session.StartRequest) (*session.Session, error)
+	Stop(ctx context.Context, req session.StopRequest) (*session.Session, error)
+	Pause(ctx context.Context) (*session.Session, error)
+	Resume(ctx context.Context) (*session.Session, error)
+	GetActive(ctx context.Context) (*session.Session, error)
+}
+
+// SessionPlans lists plans and their chunks for the Sessions module's
+// start picker.
+type SessionPlans interface {
+	List(ctx context.Context, filter *storage.PlanFilter) ([]*storage.PlanRecord, error)
+	Get(ctx context.Context, id string) (*plan.Plan, error)
+}
+
+type sessionsState string
+
+const (
+	stateSessionTimer     sessionsState = "timer"
+	stateSessionPickPlan  sessionsState = "pick-plan"
+	stateSessionPickChunk sessionsState = "pick-chunk"
+)
+
+// SessionsModule shows the active session with a live timer and starts,
+// pauses, and stops sessions.
+type SessionsModule struct {
+	sessions SessionTimer
+	plans    SessionPlans
+	ctx      context.Context
+
+	state sessionsState
+
+	active     *session.Session
+	chunkTitle string // Title of the active session's chunk, if known
+	loaded     bool
+	loadErr    error
+
+	// now is the time the timer shows; ticks advance it once a second
+	// while a session runs. tickID tells the current tick chain from
+	// chains left over from earlier loads.
+	now    time.Time
+	tickID int
+
+	pickPlans  []*storage.PlanRecord
+	pickPlan   *plan.Plan
+	pickCursor int
+
+	noteInput *inputField
+
+	// alarm announces the active session reaching its chunk's planned
+	// time; it stays silent until SetChunkAlert.
+	alarm chunkAlarm
+
+	// readOnly refuses starting, pausing, and stopping sessions.
+	readOnly bool
+
+	keys *keymap.Keymap
+}
+
+type activeSessionLoadedMsg struct {
+	session    *session.Session
+	chunkTitle string
+	chunk      *plan.Chunk   // Nil if the session has no chunk or its plan won't load
+	logged     time.Duration // Time on the chunk before the session
+	err        error
+}
+
+type sessionTickMsg struct {
+	id int
+	at time.Time
+}
+
+type sessionPlansLoadedMsg struct {
+	records []*storage.PlanRecord
+	err     error
+}
+
+type sessionChunksLoadedMsg struct {
+	plan *plan.Plan
+	err  error
+}
+
+// sessionChangedMsg reports a start, pause, resume, or stop.
+type sessionChangedMsg struct {
+	session *session.Session
+	status  string
+	err     error
+}
+
+// NewSessionsModule returns a session timer module. plans may be nil, in
+// which case sessions can be stopped and paused but not started.
+func NewSessionsModule(sessions SessionTimer, plans SessionPlans) *SessionsModule {
+	return &SessionsModule{
+		sessions: sessions,
+		plans:    plans,
+		ctx:      context.Background(),
+		state:    stateSessionTimer,
+		now:      time.Now(),
+		keys:     keymap.Default(),
+	}
+}
+
+// SetChunkAlert sets how the module announces a session reaching its
+// chunk's planned duration.
+func (m *SessionsModule) SetChunkAlert(alert ChunkAlerter) {
+	m.alarm.alert = alert
+}
+
+// SetKeymap replaces the module's key bindings.
+func (m *SessionsModule) SetKeymap(keys *keymap.Keymap) {
+	m.keys = keys
+}
+
+// SetReadOnly turns read-only mode on or off. In read-only mode the timer
+// still runs, but sessions cannot be started, paused, or stopped.
+func (m *SessionsModule) SetReadOnly(readOnly bool) {
+	m.readOnly = readOnly
+}
+
+// ID satisfies app.Module.
+func (m *SessionsModule) ID() string {
+	return "sessions"
+}
+
+// Title satisfies app.Module.
+func (m *SessionsModule) Title() string {
+	return "Sessions"
+}
+
+// Shortcuts satisfies app.Module.
+func (m *SessionsModule) Shortcuts() []app.Shortcut {
+	if m.noteInput != nil {
+		return []app.Shortcut{
+			{Key: "Enter", Description: "stop session"},
+			{Key: "Esc", Description: "keep running"},
+		}
+	}
+
+	keys := m.keys
+	switch m.state {
+	case stateSessionPickPlan, stateSessionPickChunk:
+		return []app.Shortcut{
+			{Key: keys.Label(keymap.Select), Description: "choose"},
+			{Key: keys.Label(keymap.Back), Description: "back"},
+		}
+	}
+	if m.readOnly {
+		return []app.Shortcut{}
+	}
+	if m.active == nil {
+		return shortcutsFor(keys, keymap.StartSession)
+	}
+	return shortcutsFor(keys, keymap.PauseSession, keymap.StopSession)
+}
+
+// Help lists every session binding for the shell's help overlay.
+func (m *SessionsModule) Help() []app.Shortcut {
+	actions := []keymap.Action{keymap.Up, keymap.Down, keymap.Select, keymap.Back}
+	if !m.readOnly {
+		actions = append(actions, keymap.StartSession, keymap.PauseSession, keymap.StopSession)
+	}
+	return shortcutsFor(m.keys, actions...)
+}
+
+// CapturingInput reports whether the stop notes prompt is open, so the
+// shell passes every key to it.
+func (m *SessionsModule) CapturingInput() bool {
+	return m.noteInput != nil
+}
+
+// Commands satisfies app.CommandProvider: starting a session, or pausing
+// and stopping the active one.
+func (m *SessionsModule) Commands() []app.Command {
+	if m.readOnly {
+		return nil
+	}
+	command := func(title string, action keymap.Action) app.Command {
+		return app.Command{Title: title, Hint: m.keys.Label(action), Msg: commandMsg{action: action}}
+	}
+	if m.active == nil {
+		return []app.Command{command("Start a session", keymap.StartSession)}
+	}
+	pause := "Pause the session"
+	if m.active.IsPaused() {
+		pause = "Resume the session"
+	}
+	return []app.Command{
+		command(pause, keymap.PauseSession),
+		command("Stop the session with notes", keymap.StopSession),
+	}
+}
+
+// Init satisfies tea.Model.
+func (m *SessionsModule) Init() tea.Cmd {
+	return nil
+}
+
+// Update satisfies tea.Model.
+func (m *SessionsModule) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+	case activeSessionLoadedMsg:
+		return m, m.handleActiveLoaded(msg)
+	case sessionTickMsg:
+		if msg.id != m.tickID {
+			return m, nil
+		}
+		m.now = msg.at
+		return m, tea.Batch(m.tick(), m.alarm.check(m.active, m.now))
+	case chunkAlertedMsg:
+		return m, func() tea.Msg {
+			if msg.err != nil {
+				return app.StatusMsg{Message: fmt.Sprintf("Chunk alert failed: %v", msg.err), IsError: true}
+			}
+			return app.StatusMsg{Message: fmt.Sprintf("Time's up: %d min planned for %s", msg.chunk.Duration, msg.chunk.Title)}
+		}
+	case sessionPlansLoadedMsg:
+		return m, m.handlePlansLoaded(msg)
+	case sessionChunksLoadedMsg:
+		return m, m.handleChunksLoaded(msg)
+	case sessionChangedMsg:
+		return m, m.handleSessionChanged(msg)
+	case app.BroadcastMsg:
+		if msg.Topic == app.TopicSessionsChanged || msg.Topic == app.TopicPlansChanged {
+			return m, m.loadActive()
+		}
+	case app.RefreshMsg:
+		return m, m.loadActive()
+	case commandMsg:
+		if m.state != stateSessionTimer || m.noteInput != nil {
+			return m, paletteBusy(m.Title())
+		}
+		return m, m.runTimerAction(msg.action)
+	case app.ModuleActivatedMsg:
+		// Ticks only reach the active module, so the timer restarts here
+		if msg.ID == m.ID() {
+			return m, m.loadActive()
+		}
+	}
+	return m, nil
+}
+
+// View satisfies tea.Model.
+func (m *SessionsModule) View() string {
+	if m.loadErr != nil {
+		return fmt.Sprintf("Failed to load sessions: %v", m.loadErr)
+	}
+	if !m.loaded {
+		return "Loading session…"
+	}
+
+	switch m.state {
+	case stateSessionPickPlan:
+		return m.renderPlanPicker()
+	case stateSessionPickChunk:
+		return m.renderChunkPicker()
+	default:
+		return m.renderTimer()
+	}
+}
+
+// --------------------------------------------------------------------
+// Loading
+
+// loadActive fetches the active session, and the title of its chunk.
+func (m *SessionsModule) loadActive() tea.Cmd {
+	if m.sessions == nil {
+		m.loadErr = fmt.Errorf("session service unavailable")
+		return nil
+	}
+	return func() tea.Msg {
+		active, err := m.sessions.GetActive(m.ctx)
+		if err != nil || active == nil || active.ChunkID == "" || m.plans == nil {
+			return activeSessionLoadedMsg{session: active, err: err}
+		}
+
+		msg := activeSessionLoadedMsg{session: active}
+		// The chunk title is a nicety; the ID stands in if the plan won't load
+		if p, err := m.plans.Get(m.ctx, active.PlanID); err == nil {
+			for i, chunk := range p.Chunks {
+				if chunk.ID == active.ChunkID {
+					msg.chunkTitle = chunk.Title
+					msg.chunk = &p.Chunks[i]
+				}
+			}
+		}
+		msg.logged = loggedOnChunk(m.ctx, m.sessions, active.PlanID, active.ChunkID)
+		return msg
+	}
+}
+
+func (m *SessionsModule) handleActiveLoaded(msg activeSessionLoadedMsg) tea.Cmd {
+	if msg.err != nil {
+		m.loadErr = msg.err
+		return func() tea.Msg {
+			return app.StatusMsg{Message: fmt.Sprintf("Failed to load session: %v", msg.err), IsError: true}
+		}
+	}
+
+	m.loadErr = nil
+	m.loaded = true
+	m.active = msg.session
+	m.chunkTitle = msg.chunkTitle
+	m.now = time.Now()
+	m.alarm.track(msg.session, msg.chunk, msg.logged, m.now)
+
+	// A stale chain may still be pending; bumping the ID retires it
+	m.tickID++
+	return m.tick()
+}
+
+// tick schedules the next timer update while a session is running.
+func (m *SessionsModule) tick() tea.Cmd {
+	if m.active == nil || m.active.IsPaused() {
+		return nil
+	}
+	id := m.tickID
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return sessionTickMsg{id: id, at: t}
+	})
+}
+
+func (m *SessionsModule) loadPlans() tea.Cmd {
+	filter := &storage.PlanFilter{
+		Statuses: []string{string(plan.StatusNotStarted), string(plan.StatusInProgress)},
+	}
+	return func() tea.Msg {
+		records, err := m.plans.List(m.ctx, filter)
+		return sessionPlansLoadedMsg{records: records, err: err}
+	}
+}
+
+func (m *SessionsModule) handlePlansLoaded(msg sessionPlansLoadedMsg) tea.Cmd {
+	if msg.err != nil {
+		m.state = stateSessionTimer
+		return func() tea.Msg {
+			return app.StatusMsg{Message: fmt.Sprintf("Failed to load plans: %v", msg.err), IsError: true}
+		}
+	}
+	if len(msg.records) == 0 {
+		m.state = stateSessionTimer
+		return func() tea.Msg {
+			return app.StatusMsg{Message: "No open plans to study; create one in Plans", IsError: true}
+		}
+	}
+
+	m.pickPlans = msg.records
+	m.pickCursor = 0
+	m.state = stateSessionPickPlan
+	return nil
+}
+
+func (m *SessionsModule) handleChunksLoaded(msg sessionChunksLoadedMsg) tea.Cmd {
+	if msg.err != nil {
+		return func() tea.Msg {
+			return app.StatusMsg{Message: fmt.Sprintf("Failed to load plan: %v", msg.err), IsError: true}
+		}
+	}
+
+	m.pickPlan = msg.plan
+	m.state = stateSessionPickChunk
+
+	// The cursor starts on the next chunk; entry 0 is the whole plan
+	m.pickCursor = 0
+	if next := msg.plan.NextChunk(); next != nil {
+		for i := range msg.plan.Chunks {
+			if msg.plan.Chunks[i].ID == next.ID {
+				m.pickCursor = i + 1
+			}
+		}
+	}
+	return nil
+}
+
+// --------------------------------------------------------------------
+// Session changes
+
+// start begins a session on the picked plan and chunk.
+func (m *SessionsModule) start(planID, chunkID string) tea.Cmd {
+	return func() tea.Msg {
+		sess, err := m.sessions.Start(m.ctx, session.StartRequest{PlanID: planID, ChunkID: chunkID})
+		if err != nil {
+			return sessionChangedMsg{err: err}
+		}
+		label := planID
+		if chunkID != "" {
+			label += " · " + chunkID
+		}
+		return sessionChangedMsg{session: sess, status: "Started " + label}
+	}
+}
+
+// togglePause pauses a running session or resumes a paused one.
+func (m *SessionsModule) togglePause() tea.Cmd {
+	if m.active.IsPaused() {
+		return func() tea.Msg {
+			sess, err := m.sessions.Resume(m.ctx)
+			return sessionChangedMsg{session: sess, status: "Session resumed", err: err}
+		}
+	}
+	return func() tea.Msg {
+		sess, err := m.sessions.Pause(m.ctx)
+		return sessionChangedMsg{session: sess, status: "Session paused", err: err}
+	}
+}
+
+// stop ends the active session with notes.
+func (m *SessionsModule) stop(notes string) tea.Cmd {
+	return func() tea.Msg {
+		sess, err := m.sessions.Stop(m.ctx, session.StopRequest{Notes: strings.TrimSpace(notes)})
+		if err != nil {
+			return sessionChangedMsg{err: err}
+		}
+		return sessionChangedMsg{session: sess, status: fmt.Sprintf("Stopped %s after %s", sess.PlanID, sess.ElapsedTime())}
+	}
+}
+
+// handleSessionChanged reports a change, tells the other modules, and
+// reloads the timer.
+func (m *SessionsModule) handleSessionChanged(msg sessionChangedMsg) tea.Cmd {
+	if msg.err != nil {
+		return func() tea.Msg {
+			return app.StatusMsg{Message: msg.err.Error(), IsError: true}
+		}
+	}
+
+	planID := msg.session.PlanID
+	return tea.Batch(
+		func() tea.Msg {
+			return app.StatusMsg{Message: msg.status}
+		},
+		func() tea.Msg {
+			return app.BroadcastMsg{Topic: app.TopicSessionsChanged, Payload: planID}
+		},
+		m.loadActive(),
+	)
+}
+
+// --------------------------------------------------------------------
+// Input handling
+
+func (m *SessionsModule) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.noteInput != nil {
+		return m.handleNoteInput(msg)
+	}
+
+	switch m.state {
+	case stateSessionPickPlan:
+		return m, m.handlePlanPickerKey(msg)
+	case stateSessionPickChunk:
+		return m, m.handleChunkPickerKey(msg)
+	default:
+		return m, m.handleTimerKey(msg)
+	}
+}
+
+func (m *SessionsModule) handleTimerKey(msg tea.KeyMsg) tea.Cmd {
+	for _, action := range []keymap.Action{keymap.StartSession, keymap.PauseSession, keymap.StopSession} {
+		if m.keys.Matches(msg, action) {
+			return m.runTimerAction(action)
+		}
+	}
+	return nil
+}
+
+// runTimerAction starts, pauses or resumes, or stops the session, for its
+// key or the command palette.
+func (m *SessionsModule) runTimerAction(action keymap.Action) tea.Cmd {
+	switch action {
+	case keymap.StartSession:
+		if m.active != nil {
+			return nil
+		}
+		if m.readOnly {
+			return refuseReadOnlySession()
+		}
+		if m.plans == nil {
+			return func() tea.Msg {
+				return app.StatusMsg{Message: "Plan service unavailable", IsError: true}
+			}
+		}
+		return m.loadPlans()
+	case keymap.PauseSession:
+		if m.active == nil {
+			return nil
+		}
+		if m.readOnly {
+			return refuseReadOnlySession()
+		}
+		return m.togglePause()
+	case keymap.StopSession:
+		if m.active == nil {
+			return nil
+		}
+		if m.readOnly {
+			return refuseReadOnlySession()
+		}
+		m.noteInput = newInputField("What did you learn? (optional)")
+		m.noteInput.Focus()
+	}
+	return nil
+}
+
+func (m *SessionsModule) handlePlanPickerKey(msg tea.KeyMsg) tea.Cmd {
+	keys := m.keys
+	switch {
+	case keys.Matches(msg, keymap.Up):
+		if m.pickCursor > 0 {
+			m.pickCursor--
+		}
+	case keys.Matches(msg, keymap.Down):
+		if m.pickCursor < len(m.pickPlans)-1 {
+			m.pickCursor++
+		}
+	case keys.Matches(msg, keymap.Back):
+		m.state = stateSessionTimer
+		m.pickPlans = nil
+	case keys.Matches(msg, keymap.Select):
+		planID := m.pickPlans[m.pickCursor].ID
+		return func() tea.Msg {
+			p, err := m.plans.Get(m.ctx, planID)
+			return sessionChunksLoadedMsg{plan: p, err: err}
+		}
+	}
+	return nil
+}
+
+func (m *SessionsModule) handleChunkPickerKey(msg tea.KeyMsg) tea.Cmd {
+	keys := m.keys
+	switch {
+	case keys.Matches(msg, keymap.Up):
+		if m.pickCursor > 0 {
+			m.pickCursor--
+		}
+	case keys.Matches(msg, keymap.Down):
+		if m.pickCursor < len(m.pickPlan.Chunks) {
+			m.pickCursor++
+		}
+	case keys.Matches(msg, keymap.Back):
+		m.state = stateSessionPickPlan
+		m.pickCursor = 0
+		for i, record := range m.pickPlans {
+			if record.ID == m.pickPlan.ID {
+				m.pickCursor = i
+			}
+		}
+		m.pickPlan = nil
+	case keys.Matches(msg, keymap.Select):
+		chunkID := ""
+		if m.pickCursor > 0 {
+			chunkID = m.pickPlan.Chunks[m.pickCursor-1].ID
+		}
+		planID := m.pickPlan.ID
+		m.state = stateSessionTimer
+		m.pickPlans = nil
+		m.pickPlan = nil
+		return m.start(planID, chunkID)
+	}
+	return nil
+}
+
+// handleNoteInput routes keys to the stop notes prompt. Enter stops the
+// session and Esc leaves it running.
+func (m *SessionsModule) handleNoteInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+	case tea.KeyEsc:
+		m.noteInput = nil
+		return m, nil
+	case tea.KeyEnter:
+		notes := m.noteInput.Value()
+		m.noteInput = nil
+		return m, m.stop(notes)
+	}
+
+	m.noteInput.Update(msg)
+	return m, nil
+}
+
+// refuseReadOnlySession returns the footer error shown for a session
+// change refused in read-only mode.
+func refuseReadOnlySession() tea.Cmd {
+	return func() tea.Msg {
+		return app.StatusMsg{Message: "Read-only mode: sessions cannot be changed", IsError: true}
+	}
+}
+
+// --------------------------------------------------------------------
+// Rendering
+
+func (m *SessionsModule) renderTimer() string {
+	var b strings.Builder
+
+	b.WriteString(styles.Title().Render("Session"))
+	b.WriteString("\n\n")
+
+	if m.active == nil {
+		b.WriteString(styles.Muted().Render("No active session."))
+		if !m.readOnly {
+			b.WriteString("\n\n")
+			b.WriteString(styles.Muted().Render(keyHints(keyHint(m.keys, keymap.StartSession, "Start a session"))))
+		}
+		return b.String()
+	}
+
+	sess := m.active
+	chunk := "-"
+	switch {
+	case m.chunkTitle != "":
+		chunk = fmt.Sprintf("%s (%s)", m.chunkTitle, sess.ChunkID)
+	case sess.ChunkID != "":
+		chunk = sess.ChunkID
+	}
+
+	state := styles.Success().Render("● running")
+	if sess.IsPaused() {
+		state = styles.Warning().Render("❚❚ paused")
+	}
+
+	clock := lipgloss.NewStyle().
+		Bold(true).
+		Padding(0, 2).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color(styles.Current().Accent)).
+		Render(formatClock(sess.Elapsed(m.now)))
+	b.WriteString(clock)
+	b.WriteString("  ")
+	b.WriteString(state)
+	b.WriteString("\n\n")
+
+	b.WriteString(fmt.Sprintf("Plan:     %s\n", sess.PlanID))
+	b.WriteString(fmt.Sprintf("Chunk:    %s\n", chunk))
+	b.WriteString(fmt.Sprintf("Started:  %s\n", sess.StartTime.Format("15:04")))
+	if sess.PausedSeconds > 0 || sess.IsPaused() {
+		paused := time.Duration(sess.PausedSeconds) * time.Second
+		if sess.IsPaused() {
+			paused += m.now.Sub(*sess.PausedAt)
+		}
+		b.WriteString(fmt.Sprintf("Paused:   %s\n", formatClock(paused)))
+	}
+	if sess.Notes != "" {
+		b.WriteString(fmt.Sprintf("Notes:    %s\n", sess.Notes))
+	}
+
+	b.WriteString("\n")
+	if m.noteInput != nil {
+		b.WriteString(styles.Section().Render("Session notes"))
+		b.WriteString("\n")
+		b.WriteString(m.noteInput.View())
+		b.WriteString("\n")
+		b.WriteString(styles.Muted().Render("[Enter] Stop session  |  [Esc] Keep running"))
+		return b.String()
+	}
+	if !m.readOnly {
+		pause := "Pause"
+		if sess.IsPaused() {
+			pause = "Resume"
+		}
+		b.WriteString(styles.Muted().Render(keyHints(
+			keyHint(m.keys, keymap.PauseSession, pause),
+			keyHint(m.keys, keymap.StopSession, "Stop"),
+		)))
+	}
+	return b.String()
+}
+
+func (m *SessionsModule) renderPlanPicker() string {
+	var b strings.Builder
+
+	b.WriteString(styles.Title().Render("Start a session: choose a plan"))
+	b.WriteString("\n\n")
+
+	for i, record := range m.pickPlans {
+		line := fmt.Sprintf("%s  %s", record.ID, record.Title)
+		if record.NextChunkTitle != "" {
+			line += styles.Muted().Render("  next: " + record.NextChunkTitle)
+		}
+		b.WriteString(m.pickerLine(i, line))
+	}
+
+	b.WriteString("\n")
+	b.WriteString(styles.Muted().Render(keyHints(
+		keyHint(m.keys, keymap.Select, "Choose plan"),
+		keyHint(m.keys, keymap.Back, "Cancel"),
+	)))
+	return b.String()
+}
+
+func (m *SessionsModule) renderChunkPicker() string {
+	var b strings.Builder
+
+	b.WriteString(styles.Title().Render(fmt.Sprintf("Start a session: %s", m.pickPlan.Title)))
+	b.WriteString("\n\n")
+
+	b.WriteString(m.pickerLine(0, "Whole plan (no chunk)"))
+	for i, chunk := range m.pickPlan.Chunks {
+		line := fmt.Sprintf("%s  %s  %s", chunk.ID, chunk.Title, styles.Muted().Render(fmt.Sprintf("%dm · %s", chunk.Duration, chunk.Status)))
+		b.WriteString(m.pickerLine(i+1, line))
+	}
+
+	b.WriteString("\n")
+	b.WriteString(styles.Muted().Render(keyHints(
+		keyHint(m.keys, keymap.Select, "Start"),
+		keyHint(m.keys, keymap.Back, "Back"),
+	)))
+	return b.String()
+}
+
+// pickerLine renders a picker entry, marking the cursor.
+func (m *SessionsModule) pickerLine(index int, line string) string {
+	if index == m.pickCursor {
+		return styles.Selected().Render("> "+line) + "\n"
+	}
+	return "  " + line + "\n"
+}
+
+// formatClock renders a duration as H:MM:SS.
+func formatClock(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	seconds := int(d.Seconds())
+	return fmt.Sprintf("%d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/pezware/samedi.dev/internal/tui/app"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTimerSessions adds pausing to the quick action fake.
+type fakeTimerSessions struct {
+	*fakeQuickSessions
+}
+
+func (f *fakeTimerSessions) Pause(_ context.Context) (*session.Session, error) {
+	if f.active == nil {
+		return nil, errors.New("no active session")
+	}
+	return f.active, f.active.Pause(time.Now())
+}
+
+func (f *fakeTimerSessions) Resume(_ context.Context) (*session.Session, error) {
+	if f.active == nil {
+		return nil, errors.New("no active session")
+	}
+	return f.active, f.active.Resume(time.Now())
+}
+
+// fakeSessionPlans lists the quick action fake's plans.
+type fakeSessionPlans struct {
+	*fakeQuickPlans
+}
+
+func (f *fakeSessionPlans) List(_ context.Context, _ *storage.PlanFilter) ([]*storage.PlanRecord, error) {
+	records := make([]*storage.PlanRecord, 0, len(f.plans))
+	for id, p := range f.plans {
+		records = append(records, &storage.PlanRecord{ID: id, Title: p.Title})
+	}
+	return records, nil
+}
+
+func newTestSessionsModule() (*SessionsModule, *fakeTimerSessions) {
+	plans, sessions := quickActionFixtures()
+	timer := &fakeTimerSessions{sessions}
+	return NewSessionsModule(timer, &fakeSessionPlans{plans}), timer
+}
+
+// update sends msg to the module and returns the message of the command
+// it returns, if any.
+func update(t *testing.T, m *SessionsModule, msg tea.Msg) tea.Msg {
+	t.Helper()
+	_, cmd := m.Update(msg)
+	if cmd == nil {
+		return nil
+	}
+	return cmd()
+}
+
+func key(s string) tea.KeyMsg {
+	switch s {
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	case "space":
+		return tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func TestSessionsModule_StartFromPicker(t *testing.T) {
+	m, sessions := newTestSessionsModule()
+
+	loaded := update(t, m, app.ModuleActivatedMsg{ID: "sessions", FirstActivation: true})
+	m.Update(loaded)
+	assert.Contains(t, m.View(), "No active session")
+
+	m.Update(update(t, m, key("s")))
+	assert.Equal(t, stateSessionPickPlan, m.state)
+	assert.Contains(t, m.View(), "rust-async")
+
+	m.Update(update(t, m, key("enter")))
+	require.Equal(t, stateSessionPickChunk, m.state)
+	assert.Equal(t, 2, m.pickCursor, "the cursor starts on the next open chunk")
+	assert.Contains(t, m.View(), "Whole plan")
+
+	changed := update(t, m, key("enter"))
+	require.IsType(t, sessionChangedMsg{}, changed)
+	require.Len(t, sessions.started, 1)
+	assert.Equal(t, session.StartRequest{PlanID: "rust-async", ChunkID: "chunk-002"}, sessions.started[0])
+
+	_, cmd := m.Update(changed)
+	msgs := runSequence(t, cmd)
+	assert.Contains(t, msgs, app.BroadcastMsg{Topic: app.TopicSessionsChanged, Payload: "rust-async"})
+	for _, msg := range msgs {
+		if loaded, ok := msg.(activeSessionLoadedMsg); ok {
+			m.Update(loaded)
+			assert.Equal(t, "Tokio basics", loaded.chunkTitle)
+		}
+	}
+	assert.Contains(t, m.View(), "0:00:00")
+	assert.Contains(t, m.View(), "Tokio basics")
+}
+
+func TestSessionsModule_PauseAndStopWithNotes(t *testing.T) {
+	m, sessions := newTestSessionsModule()
+	start := time.Now().Add(-90 * time.Second)
+	sessions.active = &session.Session{ID: "s1", PlanID: "rust-async", StartTime: start, CreatedAt: start}
+	m.Update(update(t, m, app.ModuleActivatedMsg{ID: "sessions"}))
+
+	m.Update(sessionTickMsg{id: m.tickID, at: start.Add(90 * time.Second)})
+	assert.Contains(t, m.View(), "0:01:30")
+	m.Update(sessionTickMsg{id: m.tickID - 1, at: start.Add(time.Hour)})
+	assert.Contains(t, m.View(), "0:01:30", "ticks from an old chain are ignored")
+
+	changed := update(t, m, key("space"))
+	require.IsType(t, sessionChangedMsg{}, changed)
+	assert.True(t, sessions.active.IsPaused())
+	assert.Equal(t, "Session paused", changed.(sessionChangedMsg).status)
+
+	m.Update(update(t, m, app.BroadcastMsg{Topic: app.TopicSessionsChanged}))
+	assert.Contains(t, m.View(), "paused")
+	assert.Nil(t, m.tick(), "the timer stops while paused")
+
+	m.Update(key("x"))
+	require.True(t, m.CapturingInput())
+	m.Update(key("q"))
+	m.Update(key("s"))
+	assert.Empty(t, sessions.stopped, "keys go to the notes prompt")
+
+	changed = update(t, m, key("enter"))
+	require.IsType(t, sessionChangedMsg{}, changed)
+	assert.False(t, m.CapturingInput())
+	require.Len(t, sessions.stopped, 1)
+	assert.Equal(t, "qs", sessions.stopped[0].Notes)
+}
+
+func TestSessionsModule_ReadOnly(t *testing.T) {
+	m, sessions := newTestSessionsModule()
+	m.SetReadOnly(true)
+	m.Update(update(t, m, app.ModuleActivatedMsg{ID: "sessions"}))
+
+	msg := update(t, m, key("s"))
+	require.IsType(t, app.StatusMsg{}, msg)
+	assert.True(t, msg.(app.StatusMsg).IsError)
+	assert.Equal(t, stateSessionTimer, m.state)
+	assert.Empty(t, sessions.started)
+	assert.Empty(t, m.Shortcuts())
+}
+
+func TestSessionsModule_Commands(t *testing.T) {
+	m, sessions := newTestSessionsModule()
+	m.Update(update(t, m, app.ModuleActivatedMsg{ID: "sessions"}))
+
+	commands := m.Commands()
+	require.Len(t, commands, 1)
+	assert.Equal(t, "Start a session", commands[0].Title)
+	m.Update(update(t, m, commands[0].Msg))
+	assert.Equal(t, stateSessionPickPlan, m.state)
+
+	m.state = stateSessionTimer
+	start := time.Now()
+	sessions.active = &session.Session{ID: "s1", PlanID: "rust-async", StartTime: start, CreatedAt: start}
+	m.Update(update(t, m, app.RefreshMsg{}))
+	commands = m.Commands()
+	require.Len(t, commands, 2)
+	assert.Equal(t, "Pause the session", commands[0].Title)
+
+	m.Update(commands[1].Msg)
+	assert.True(t, m.CapturingInput(), "stopping asks for notes")
+	msg := update(t, m, commands[0].Msg)
+	require.IsType(t, app.StatusMsg{}, msg)
+	assert.True(t, msg.(app.StatusMsg).IsError)
+	assert.False(t, sessions.active.IsPaused())
+
+	m.SetReadOnly(true)
+	assert.Empty(t, m.Commands())
+}
+
+func TestFormatClock(t *testing.T) {
+	assert.Equal(t, "0:00:00", formatClock(-time.Second))
+	assert.Equal(t, "0:01:05", formatClock(65*time.Second))
+	assert.Equal(t, "2:03:04", formatClock(2*time.Hour+3*time.Minute+4*time.Second))
+}
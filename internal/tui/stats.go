@@ -6,14 +6,18 @@ package tui
 import (
 	"context"
 	"fmt"
+	"math"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/pezware/samedi.dev/internal/plan"
 	"github.com/pezware/samedi.dev/internal/session"
 	"github.com/pezware/samedi.dev/internal/stats"
 	"github.com/pezware/samedi.dev/internal/tui/app"
 	"github.com/pezware/samedi.dev/internal/tui/components"
+	"github.com/pezware/samedi.dev/internal/tui/keymap"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
 )
 
 // viewState represents the current view in the stats TUI.
@@ -24,7 +28,9 @@ const (
 	viewPlanList       viewState = "plan-list"       // List of all plans
 	viewPlanDetail     viewState = "plan-detail"     // Single plan drill-down
 	viewSessionHistory viewState = "session-history" // Session list
+	viewSessionDetail  viewState = "session-detail"  // Single session with artifacts
 	viewExport         viewState = "export-dialog"   // Export configuration
+	viewInsights       viewState = "insights"        // Best hours and weekdays
 )
 
 // SessionProvider supplies session data for statistics.
@@ -32,6 +38,24 @@ type SessionProvider interface {
 	ListAll(ctx context.Context) ([]*session.Session, error)
 }
 
+// SessionQuerier is implemented by session providers that can filter
+// sessions in the database. The history view then loads only sessions in
+// the dashboard's time range instead of every session ever recorded.
+type SessionQuerier interface {
+	Query(ctx context.Context, filter *session.Filter) ([]*session.Session, error)
+}
+
+// SessionNotesEditor is implemented by session providers that can save
+// notes. Without it, the session detail view is read-only.
+type SessionNotesEditor interface {
+	UpdateNotes(ctx context.Context, id, notes string) (*session.Session, error)
+}
+
+// ReportWriter saves a "summary" or "full" report of the stats as name and
+// returns the path it wrote. An empty name leaves the choice of file to
+// the writer. The export dialog uses it; without one it can't save.
+type ReportWriter func(reportType, name string) (string, error)
+
 // StatsModel is the Bubble Tea module for the stats dashboard.
 type StatsModel struct {
 	service        *stats.Service
@@ -39,11 +63,14 @@ type StatsModel struct {
 	ctx            context.Context
 	timeRange      stats.TimeRange
 
-	totalStats *stats.TotalStats
-	planStats  *stats.PlanStats
-	viewMode   string // "total" or "plan" - kept for backward compatibility
-	width      int
-	height     int
+	totalStats  *stats.TotalStats
+	planStats   *stats.PlanStats
+	reviewStats *stats.ReviewStats // Flashcard reviews in the time range
+	dailyStats  []stats.DailyStats // Active days in the time range, for the chart
+	insights    *stats.Insights    // When sessions in the time range started
+	viewMode    string             // "total" or "plan" - kept for backward compatibility
+	width       int
+	height      int
 
 	// New fields for multi-view navigation
 	currentView    viewState         // Current active view
@@ -52,19 +79,39 @@ type StatsModel struct {
 	selectedPlan   *stats.PlanStats  // Detailed stats for selected plan
 	allPlanStats   []stats.PlanStats // All plan statistics for list view
 	planListCursor int               // Current cursor position in plan list (0-indexed)
+	tagFilter      string            // Plan list shows only plans with this tag; empty for all
+	search         *planSearch       // Plan list search; nil unless open
+	planSort       plan.ListSort     // Plan list order; zero as loaded
+	saveSort       SortSaver         // Remembers a new plan sort; may be nil
 
 	// Session history fields
 	sessions             []*session.Session // All sessions for history view
 	sessionHistoryCursor int                // Current cursor in session list
+	selectedSession      *session.Session   // Session shown in detail view
+	artifactCursor       int                // Current cursor in the artifacts panel
+	noteInput            *inputField        // Notes editor; nil unless editing
+	readOnly             bool               // Refuses editing session notes
+	sessionSort          sessionSort        // Order of the session history
+	history              historyCache       // Filtered and sorted history
 
 	// Export dialog fields
-	exportType       string // "summary" or "full"
-	exportMenuCursor int    // Cursor in export menu
+	exportType       string       // "summary" or "full"
+	exportMenuCursor int          // Cursor in export menu
+	exportInput      *inputField  // File name prompt; nil unless asking
+	exporting        bool         // A report is being written
+	exportedPath     string       // Where the last export went
+	exportErr        error        // Why the last export failed
+	reportWriter     ReportWriter // Saves the chosen report
 
 	// Loading state
-	loading    bool
-	dataLoaded bool
-	loadErr    error
+	loading         bool  // Stats are loading
+	dataLoaded      bool  // Stats have loaded at least once
+	commandView     bool  // A palette command chose the view while stats loaded
+	loadErr         error // Why stats failed to load
+	sessionsLoading bool  // The session history is loading
+	sessionsErr     error // Why the session history failed to load
+
+	keys *keymap.Keymap
 }
 
 // NewStatsModule constructs a stats module backed by the provided services.
@@ -79,6 +126,7 @@ func NewStatsModule(service *stats.Service, sessionService SessionProvider, time
 		height:         24,
 		currentView:    viewOverview,
 		viewHistory:    []viewState{},
+		keys:           keymap.Default(),
 	}
 }
 
@@ -92,27 +140,77 @@ func (m *StatsModel) Title() string {
 	return "Stats"
 }
 
+// SetKeymap replaces the module's key bindings.
+func (m *StatsModel) SetKeymap(keys *keymap.Keymap) {
+	m.keys = keys
+}
+
 // Shortcuts exposes module-specific keyboard hints for the shell footer.
 func (m *StatsModel) Shortcuts() []app.Shortcut {
-	return []app.Shortcut{
-		{Key: "p", Description: "plan list"},
-		{Key: "s", Description: "sessions"},
-		{Key: "e", Description: "export"},
-	}
+	return shortcutsFor(m.keys, keymap.StatsPlans, keymap.StatsSessions, keymap.StatsInsights, keymap.StatsExport)
+}
+
+// Help lists every stats binding for the shell's help overlay.
+func (m *StatsModel) Help() []app.Shortcut {
+	return shortcutsFor(m.keys,
+		keymap.StatsPlans, keymap.StatsSessions, keymap.StatsInsights, keymap.StatsExport,
+		keymap.Up, keymap.Down, keymap.Select, keymap.Back,
+		keymap.PageUp, keymap.PageDown, keymap.Top, keymap.Bottom,
+		keymap.TagFilter, keymap.SortSessions, keymap.OpenArtifact, keymap.EditNote)
 }
 
-type statsDataLoadedMsg struct {
+// statsLoadedMsg carries the figures loadStats read for the time range.
+type statsLoadedMsg struct {
 	totalStats   *stats.TotalStats
 	allPlanStats []stats.PlanStats
-	sessions     []*session.Session
+	reviewStats  *stats.ReviewStats
+	dailyStats   []stats.DailyStats
+	insights     *stats.Insights
 	err          error
+	reload       statsReload
+}
+
+// sessionsLoadedMsg carries the sessions for the history view.
+type sessionsLoadedMsg struct {
+	sessions []*session.Session
+	err      error
+	reload   statsReload
 }
 
+// statsReload says how loaded data replaces what is on screen. A fresh
+// load returns to the overview; a refresh keeps the view and cursors, and
+// an auto refresh does so without a loading screen or status message.
+type statsReload int
+
+const (
+	reloadFresh statsReload = iota
+	reloadRefresh
+	reloadAuto
+)
+
 // Init initializes the model.
 func (m *StatsModel) Init() tea.Cmd {
 	return nil
 }
 
+// SetReadOnly turns read-only mode on or off. In read-only mode session
+// notes can be read but not edited.
+func (m *StatsModel) SetReadOnly(readOnly bool) {
+	m.readOnly = readOnly
+}
+
+// SetPlanSort sets the order of the plan list, and how a new one chosen
+// with the sort keys is saved. save may be nil.
+func (m *StatsModel) SetPlanSort(s plan.ListSort, save SortSaver) {
+	m.planSort = s
+	m.saveSort = save
+}
+
+// SetReportWriter sets how the export dialog saves reports.
+func (m *StatsModel) SetReportWriter(writer ReportWriter) {
+	m.reportWriter = writer
+}
+
 // SetAllPlanStats sets the list of all plan statistics for the plan list view.
 func (m *StatsModel) SetAllPlanStats(planStats []stats.PlanStats) {
 	m.allPlanStats = planStats
@@ -122,13 +220,46 @@ func (m *StatsModel) SetAllPlanStats(planStats []stats.PlanStats) {
 // SetSessions sets the list of sessions for the session history view.
 func (m *StatsModel) SetSessions(sessions []*session.Session) {
 	m.sessions = sessions
+	m.history.valid = false
 	m.sessionHistoryCursor = 0 // Reset cursor
 }
 
-// switchView transitions to a new view and updates history stack.
-// Data loading commands for specific views will be added in Phase 2+.
-//
-//nolint:unparam // tea.Cmd will be used when data loading is implemented
+// applyRefreshedPlanStats replaces the plan stats without leaving the
+// current view. The selected plan is looked up again; if it is gone, the
+// view returns to the overview.
+func (m *StatsModel) applyRefreshedPlanStats(planStats []stats.PlanStats) {
+	cursor := m.planListCursor
+	m.SetAllPlanStats(planStats)
+	m.planListCursor = clampCursor(cursor, len(m.visiblePlanStats()))
+
+	if m.selectedPlanID == "" {
+		return
+	}
+	for i := range planStats {
+		if planStats[i].PlanID == m.selectedPlanID {
+			m.selectedPlan = &planStats[i]
+			return
+		}
+	}
+	m.selectedPlanID = ""
+	m.selectedPlan = nil
+	m.currentView = viewOverview
+	m.viewHistory = m.viewHistory[:0]
+}
+
+// clampCursor keeps cursor within a list of n items.
+func clampCursor(cursor, n int) int {
+	if cursor >= n {
+		cursor = n - 1
+	}
+	if cursor < 0 {
+		cursor = 0
+	}
+	return cursor
+}
+
+// switchView transitions to a new view and updates history stack. Opening
+// the session history retries loading it if that failed.
 func (m *StatsModel) switchView(newView viewState) (*StatsModel, tea.Cmd) {
 	// Push current view to history stack
 	m.viewHistory = append(m.viewHistory, m.currentView)
@@ -139,11 +270,16 @@ func (m *StatsModel) switchView(newView viewState) (*StatsModel, tea.Cmd) {
 	// Reset cursors when switching to certain views to handle filter changes
 	if newView == viewSessionHistory {
 		m.sessionHistoryCursor = 0
+		if m.sessionsErr != nil && !m.sessionsLoading && m.sessionService != nil {
+			return m, m.loadSessionHistory(reloadFresh)
+		}
 	}
 
 	return m, nil
 }
 
+// refreshData reloads the stats and the session history from the
+// services, in parallel.
 func (m *StatsModel) refreshData() tea.Cmd {
 	if m.service == nil || m.sessionService == nil {
 		return func() tea.Msg {
@@ -154,44 +290,96 @@ func (m *StatsModel) refreshData() tea.Cmd {
 		}
 	}
 
-	m.loading = true
-	m.loadErr = nil
+	return m.reloadData(reloadFresh)
+}
+
+// reloadData reloads the stats and the session history as reload says.
+func (m *StatsModel) reloadData(reload statsReload) tea.Cmd {
+	if m.service == nil || m.sessionService == nil {
+		return nil
+	}
+	if reload != reloadAuto || !m.dataLoaded {
+		m.loading = true
+		m.loadErr = nil
+	}
+	return tea.Batch(m.loadStats(reload), m.loadSessionHistory(reload))
+}
 
+// loadStats reads the totals, every plan's stats, reviews, and the daily
+// chart for the time range.
+func (m *StatsModel) loadStats(reload statsReload) tea.Cmd {
 	return func() tea.Msg {
-		totalStats, err := m.service.GetTotalStats(m.ctx, m.timeRange)
-		if err != nil {
-			return statsDataLoadedMsg{err: err}
-		}
+		msg := m.readStats()
+		msg.reload = reload
+		return msg
+	}
+}
 
-		currentStreak, longestStreak, err := m.service.GetStreakInfo(m.ctx)
-		if err == nil {
-			totalStats.CurrentStreak = currentStreak
-			totalStats.LongestStreak = longestStreak
-		}
+// readStats reads what loadStats loads.
+func (m *StatsModel) readStats() statsLoadedMsg {
+	totalStats, err := m.service.GetTotalStats(m.ctx, m.timeRange)
+	if err != nil {
+		return statsLoadedMsg{err: err}
+	}
 
-		allPlanStatsMap, err := m.service.GetAllPlanStats(m.ctx, m.timeRange)
-		if err != nil {
-			return statsDataLoadedMsg{err: err}
-		}
+	currentStreak, longestStreak, err := m.service.GetStreakInfo(m.ctx)
+	if err == nil {
+		totalStats.CurrentStreak = currentStreak
+		totalStats.LongestStreak = longestStreak
+	}
 
-		allPlanStats := make([]stats.PlanStats, 0, len(allPlanStatsMap))
-		for _, ps := range allPlanStatsMap {
-			allPlanStats = append(allPlanStats, ps)
-		}
+	allPlanStatsMap, err := m.service.GetAllPlanStats(m.ctx, m.timeRange)
+	if err != nil {
+		return statsLoadedMsg{err: err}
+	}
 
-		sessions, err := m.sessionService.ListAll(m.ctx)
-		if err != nil {
-			return statsDataLoadedMsg{err: err}
-		}
+	allPlanStats := make([]stats.PlanStats, 0, len(allPlanStatsMap))
+	for _, ps := range allPlanStatsMap {
+		allPlanStats = append(allPlanStats, ps)
+	}
 
-		return statsDataLoadedMsg{
-			totalStats:   totalStats,
-			allPlanStats: allPlanStats,
-			sessions:     sessions,
-		}
+	reviewStats, err := m.service.GetReviewStats(m.ctx, m.timeRange)
+	if err != nil {
+		return statsLoadedMsg{err: err}
+	}
+
+	dailyStats, err := m.service.GetDailyStats(m.ctx, m.timeRange)
+	if err != nil {
+		return statsLoadedMsg{err: err}
+	}
+
+	insights, err := m.service.GetInsights(m.ctx, m.timeRange)
+	if err != nil {
+		return statsLoadedMsg{err: err}
+	}
+
+	return statsLoadedMsg{
+		totalStats:   totalStats,
+		allPlanStats: allPlanStats,
+		reviewStats:  reviewStats,
+		dailyStats:   dailyStats,
+		insights:     insights,
 	}
 }
 
+// loadSessionHistory reads the sessions for the history view.
+func (m *StatsModel) loadSessionHistory(reload statsReload) tea.Cmd {
+	m.sessionsLoading = true
+	return func() tea.Msg {
+		sessions, err := m.loadSessions()
+		return sessionsLoadedMsg{sessions: sessions, err: err, reload: reload}
+	}
+}
+
+// loadSessions fetches sessions for the history view, narrowed to the time
+// range when the provider can query.
+func (m *StatsModel) loadSessions() ([]*session.Session, error) {
+	if querier, ok := m.sessionService.(SessionQuerier); ok {
+		return querier.Query(m.ctx, &session.Filter{Since: m.timeRange.Start})
+	}
+	return m.sessionService.ListAll(m.ctx)
+}
+
 // goBack returns to the previous view from history stack.
 //
 //nolint:unparam // tea.Cmd return kept for consistency with Bubble Tea patterns
@@ -230,12 +418,43 @@ func (m *StatsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, cmd
 		}
 	case app.BroadcastMsg:
-		if msg.Topic == app.TopicPlansChanged {
+		switch msg.Topic {
+		case app.TopicPlansChanged, app.TopicSessionsChanged, app.TopicReviewsChanged:
 			cmd := m.refreshData()
 			return m, cmd
 		}
-	case statsDataLoadedMsg:
+	case app.RefreshMsg:
+		if msg.Auto {
+			return m, m.reloadData(reloadAuto)
+		}
+		return m, m.reloadData(reloadRefresh)
+	case sessionNotesSavedMsg:
+		return m, m.applySavedNotes(msg)
+	case sessionsLoadedMsg:
+		m.sessionsLoading = false
+		if msg.err != nil {
+			m.sessionsErr = msg.err
+			return m, func() tea.Msg {
+				return app.StatusMsg{
+					Message: fmt.Sprintf("Failed to load sessions: %v", msg.err),
+					IsError: true,
+				}
+			}
+		}
+		m.sessionsErr = nil
+		cursor := m.sessionHistoryCursor
+		m.SetSessions(msg.sessions)
+		if msg.reload != reloadFresh {
+			m.sessionHistoryCursor = clampCursor(cursor, len(m.filterSessionsByPlan()))
+		}
+	case reportExportedMsg:
+		return m, m.applyExport(msg)
+	case commandMsg:
+		return m.runCommand(msg)
+	case statsLoadedMsg:
 		m.loading = false
+		commandView := m.commandView
+		m.commandView = false
 		if msg.err != nil {
 			m.loadErr = msg.err
 			return m, func() tea.Msg {
@@ -247,15 +466,25 @@ func (m *StatsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		m.loadErr = nil
+		keepView := (m.dataLoaded && msg.reload != reloadFresh) || commandView
 		m.dataLoaded = true
 		m.totalStats = msg.totalStats
-		m.planStats = nil // Reset any plan-specific view
-		m.viewMode = "total"
-		m.currentView = viewOverview
-		m.viewHistory = m.viewHistory[:0]
-		m.SetAllPlanStats(msg.allPlanStats)
-		m.SetSessions(msg.sessions)
+		m.reviewStats = msg.reviewStats
+		m.dailyStats = msg.dailyStats
+		m.insights = msg.insights
+		if keepView {
+			m.applyRefreshedPlanStats(msg.allPlanStats)
+		} else {
+			m.planStats = nil // Reset any plan-specific view
+			m.viewMode = "total"
+			m.currentView = viewOverview
+			m.viewHistory = m.viewHistory[:0]
+			m.SetAllPlanStats(msg.allPlanStats)
+		}
 
+		if msg.reload == reloadAuto {
+			return m, nil
+		}
 		return m, func() tea.Msg {
 			return app.StatusMsg{Message: "Stats updated"}
 		}
@@ -266,64 +495,110 @@ func (m *StatsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 // handleKeyMsg handles keyboard input messages.
 func (m *StatsModel) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.Type {
-	case tea.KeyCtrlC:
+	if m.noteInput != nil {
+		return m.handleNoteInput(msg)
+	}
+	if m.exportInput != nil {
+		return m.handleExportInput(msg)
+	}
+	if m.search != nil {
+		return m.handleSearchKey(msg)
+	}
+
+	keys := m.keys
+	switch {
+	case msg.Type == tea.KeyCtrlC || keys.Matches(msg, keymap.Quit):
 		return m, tea.Quit
-	case tea.KeyEsc:
+	case keys.Matches(msg, keymap.Back):
 		return m.goBack()
-	case tea.KeyEnter:
+	case keys.Matches(msg, keymap.Select):
 		return m.handleEnterKey()
-	case tea.KeyUp:
+	case keys.Matches(msg, keymap.Up):
 		return m.handleArrowKey(-1)
-	case tea.KeyDown:
+	case keys.Matches(msg, keymap.Down):
 		return m.handleArrowKey(1)
-	case tea.KeyRunes:
-		if len(msg.Runes) > 0 {
-			return m.handleRuneKey(msg.Runes[0])
+	case keys.Matches(msg, keymap.StatsPlans):
+		return m.openView(keymap.StatsPlans)
+	case keys.Matches(msg, keymap.StatsSessions):
+		return m.openView(keymap.StatsSessions)
+	case keys.Matches(msg, keymap.StatsInsights):
+		return m.openView(keymap.StatsInsights)
+	case keys.Matches(msg, keymap.StatsExport):
+		return m.openView(keymap.StatsExport)
+	case keys.Matches(msg, keymap.OpenArtifact) && m.currentView == viewSessionDetail:
+		return m, m.openSelectedArtifact()
+	case keys.Matches(msg, keymap.EditNote) && m.currentView == viewSessionDetail:
+		return m, m.startNoteEdit()
+	case keys.Matches(msg, keymap.TagFilter) && m.currentView == viewPlanList:
+		m.cycleTagFilter()
+	case m.currentView == viewPlanList && (keys.Matches(msg, keymap.SortPlans) || keys.Matches(msg, keymap.ReverseSort)):
+		s, _ := sortKey(keys, msg, m.planSort)
+		return m, m.resortPlans(s)
+	case keys.Matches(msg, keymap.Search) && m.currentView == viewPlanList:
+		if len(m.visiblePlanStats()) > 0 {
+			m.search = newPlanSearch(m.planListCursor)
 		}
+	case m.currentView == viewSessionHistory:
+		m.handleHistoryKey(msg)
 	}
 
 	return m, nil
 }
 
+// handleHistoryKey pages, jumps, and sorts in the session history.
+func (m *StatsModel) handleHistoryKey(msg tea.KeyMsg) {
+	keys := m.keys
+	switch {
+	case keys.Matches(msg, keymap.PageDown):
+		m.moveHistoryCursor(sessionPageSize)
+	case keys.Matches(msg, keymap.PageUp):
+		m.moveHistoryCursor(-sessionPageSize)
+	case keys.Matches(msg, keymap.Top):
+		m.sessionHistoryCursor = 0
+	case keys.Matches(msg, keymap.Bottom):
+		m.moveHistoryCursor(len(m.historySessions()))
+	case keys.Matches(msg, keymap.SortSessions):
+		m.cycleSessionSort()
+	}
+}
+
 // handleEnterKey handles the Enter key based on current view.
 func (m *StatsModel) handleEnterKey() (tea.Model, tea.Cmd) {
-	if m.currentView == viewPlanList && len(m.allPlanStats) > 0 {
+	if visible := m.visiblePlanStats(); m.currentView == viewPlanList && len(visible) > 0 {
 		// Select plan and switch to detail view
-		selectedStat := m.allPlanStats[m.planListCursor]
+		selectedStat := visible[m.planListCursor]
 		m.selectedPlanID = selectedStat.PlanID
 		m.selectedPlan = &selectedStat
 		return m.switchView(viewPlanDetail)
 	}
 
-	if m.currentView == viewExport {
-		// Store export type based on selection
-		if m.exportMenuCursor == 0 {
-			m.exportType = "summary"
-		} else {
-			m.exportType = "full"
+	if m.currentView == viewSessionHistory {
+		filteredSessions := m.filterSessionsByPlan()
+		if len(filteredSessions) > 0 {
+			m.selectedSession = filteredSessions[m.sessionHistoryCursor]
+			m.artifactCursor = 0
+			return m.switchView(viewSessionDetail)
 		}
-		// Note: Actual export happens via CLI commands (samedi report)
-		// TUI mode is for viewing, CLI for exporting
-		// Return to previous view (showing the selection was registered)
-		return m.goBack()
+	}
+
+	if m.currentView == viewExport {
+		m.startExportPrompt()
 	}
 
 	return m, nil
 }
 
-// handleRuneKey handles character key presses.
-func (m *StatsModel) handleRuneKey(r rune) (tea.Model, tea.Cmd) {
-	switch r {
-	case 'q':
-		return m, tea.Quit
-	case 'p':
+// openView opens the plan list, the session history, or the export dialog
+// for its key or the command palette.
+func (m *StatsModel) openView(action keymap.Action) (*StatsModel, tea.Cmd) {
+	switch action {
+	case keymap.StatsPlans:
 		// Don't switch if already on plan list view
 		if m.currentView == viewPlanList {
 			return m, nil
 		}
 		return m.switchView(viewPlanList)
-	case 's':
+	case keymap.StatsSessions:
 		// Don't switch if already on session history view
 		if m.currentView == viewSessionHistory {
 			return m, nil
@@ -331,32 +606,60 @@ func (m *StatsModel) handleRuneKey(r rune) (tea.Model, tea.Cmd) {
 		// If in plan detail view, switch to session history filtered by this plan
 		// Otherwise, switch to session history (all sessions)
 		return m.switchView(viewSessionHistory)
-	case 'e':
+	case keymap.StatsInsights:
+		if m.currentView == viewInsights {
+			return m, nil
+		}
+		return m.switchView(viewInsights)
+	case keymap.StatsExport:
 		// Don't switch if already on export dialog view
 		if m.currentView == viewExport {
 			return m, nil
 		}
+		m.resetExport()
 		return m.switchView(viewExport)
-	case 'j':
-		return m.handleArrowKey(1)
-	case 'k':
-		return m.handleArrowKey(-1)
 	}
-
 	return m, nil
 }
 
+// Commands satisfies app.CommandProvider: the views the stats keys open.
+func (m *StatsModel) Commands() []app.Command {
+	command := func(title string, action keymap.Action) app.Command {
+		return app.Command{Title: title, Hint: m.keys.Label(action), Msg: commandMsg{action: action}}
+	}
+	return []app.Command{
+		command("Plan stats", keymap.StatsPlans),
+		command("Session history", keymap.StatsSessions),
+		command("Learning insights", keymap.StatsInsights),
+		command("Export a report", keymap.StatsExport),
+	}
+}
+
+// runCommand opens the view a command palette entry asks for. Loading
+// data that is on its way keeps that view rather than returning to the
+// overview.
+func (m *StatsModel) runCommand(msg commandMsg) (*StatsModel, tea.Cmd) {
+	if m.noteInput != nil || m.exportInput != nil {
+		return m, paletteBusy(m.Title())
+	}
+	if m.loading {
+		m.commandView = true
+	}
+	m.search = nil
+	return m.openView(msg.action)
+}
+
 // handleArrowKey handles up/down navigation in list views.
 //
 //nolint:unparam // tea.Cmd return kept for consistency with Bubble Tea patterns
 func (m *StatsModel) handleArrowKey(direction int) (*StatsModel, tea.Cmd) {
 	// Handle plan list navigation
-	if m.currentView == viewPlanList && len(m.allPlanStats) > 0 {
+	if visible := m.visiblePlanStats(); m.currentView == viewPlanList && len(visible) > 0 {
 		m.planListCursor += direction
 		// Wrap around
 		if m.planListCursor < 0 {
-			m.planListCursor = len(m.allPlanStats) - 1
-		} else if m.planListCursor >= len(m.allPlanStats) {
+			m.planListCursor = len(visible) - 1
+		} else if m.planListCursor >= len(visible) {
 			m.planListCursor = 0
 		}
 	}
@@ -376,6 +679,13 @@ func (m *StatsModel) handleArrowKey(direction int) (*StatsModel, tea.Cmd) {
 		}
 	}
 
+	// Handle artifacts panel navigation
+	if m.currentView == viewSessionDetail && m.selectedSession != nil {
+		if count := len(m.selectedSession.Artifacts); count > 0 {
+			m.artifactCursor = (m.artifactCursor + direction + count) % count
+		}
+	}
+
 	// Handle export menu navigation
 	if m.currentView == viewExport {
 		m.exportMenuCursor += direction
@@ -411,8 +721,12 @@ func (m *StatsModel) View() string {
 		return m.renderPlanDetail()
 	case viewSessionHistory:
 		return m.renderSessionHistory()
+	case viewSessionDetail:
+		return m.renderSessionDetail()
 	case viewExport:
 		return m.renderExportDialog()
+	case viewInsights:
+		return m.renderInsights()
 	default: // viewOverview
 		return m.renderOverview()
 	}
@@ -423,10 +737,7 @@ func (m *StatsModel) renderOverview() string {
 	var content strings.Builder
 
 	// Title
-	titleStyle := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(lipgloss.Color("12")).
-		PaddingBottom(1)
+	titleStyle := styles.Title().PaddingBottom(1)
 
 	if m.viewMode == "total" {
 		content.WriteString(titleStyle.Render("Learning Statistics"))
@@ -450,17 +761,28 @@ func (m *StatsModel) renderPlanList() string {
 	var content strings.Builder
 
 	// Title
-	titleStyle := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(lipgloss.Color("12")).
-		PaddingBottom(1)
+	titleStyle := styles.Title().PaddingBottom(1)
 
-	content.WriteString(titleStyle.Render("Learning Plans"))
+	title := "Learning Plans"
+	if m.tagFilter != "" {
+		title += fmt.Sprintf(" · tag: %s", m.tagFilter)
+	}
+	if m.planSort.Field != "" {
+		title += " · sorted by " + m.planSort.Label()
+	}
+	content.WriteString(titleStyle.Render(title))
 	content.WriteString("\n\n")
 
+	if m.search != nil {
+		// The search bar has its own key hints
+		content.WriteString(m.renderSearchResults())
+		return content.String()
+	}
+
 	// If no plans, show empty state
-	if len(m.allPlanStats) == 0 {
-		emptyStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+	visible := m.visiblePlanStats()
+	if len(visible) == 0 {
+		emptyStyle := styles.Muted()
 		content.WriteString(emptyStyle.Render("No plans found. Create a plan to get started!"))
 		content.WriteString("\n\n")
 		content.WriteString(m.renderPlanListHelp())
@@ -471,34 +793,20 @@ func (m *StatsModel) renderPlanList() string {
 	table := components.NewTable([]string{"Title", "Progress", "Hours", "Status"})
 
 	// Add rows for each plan
-	for i, planStat := range m.allPlanStats {
-		// Format values
-		title := planStat.PlanTitle
-		progress := fmt.Sprintf("%d%%", planStat.ProgressPercent())
-		hours := fmt.Sprintf("%.1f / %.1f", planStat.TotalHours, planStat.PlannedHours)
-		status := formatPlanStatus(planStat.Status)
-
-		// Highlight selected row
+	for i, planStat := range visible {
+		rest := lipgloss.NewStyle()
 		if i == m.planListCursor {
-			highlightStyle := lipgloss.NewStyle().
-				Foreground(lipgloss.Color("0")).
-				Background(lipgloss.Color("12")).
-				Bold(true)
-			title = highlightStyle.Render(title)
-			progress = highlightStyle.Render(progress)
-			hours = highlightStyle.Render(hours)
-			status = highlightStyle.Render(status)
+			rest = styles.Selected()
 		}
-
-		table.AddRow([]string{title, progress, hours, status})
+		table.AddRow(planStatsRow(planStat, rest.Render(planStat.PlanTitle), rest))
 	}
 
 	content.WriteString(table.View())
 	content.WriteString("\n\n")
 
 	// Footer info
-	footerStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
-	content.WriteString(footerStyle.Render(fmt.Sprintf("Showing %d plans", len(m.allPlanStats))))
+	footerStyle := styles.Muted()
+	content.WriteString(footerStyle.Render(fmt.Sprintf("Showing %d plans", len(visible))))
 	content.WriteString("\n\n")
 
 	// Help
@@ -507,16 +815,34 @@ func (m *StatsModel) renderPlanList() string {
 	return content.String()
 }
 
+// planStatsRow returns the plan list's cells for planStat, with the
+// title as it should be shown and the other cells in style.
+func planStatsRow(planStat stats.PlanStats, title string, style lipgloss.Style) []string {
+	return []string{
+		title,
+		style.Render(fmt.Sprintf("%d%%", planStat.ProgressPercent())),
+		style.Render(fmt.Sprintf("%.1f / %.1f", planStat.TotalHours, planStat.PlannedHours)),
+		style.Render(formatPlanStatus(planStat.Status)),
+	}
+}
+
 // renderPlanListHelp renders help text for the plan list view.
 func (m *StatsModel) renderPlanListHelp() string {
-	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
-	return helpStyle.Render("[↑/k] Up  |  [↓/j] Down  |  [Enter] View Details  |  [Esc] Back")
+	helpStyle := styles.Muted()
+	return helpStyle.Render(keyHints(
+		keyHint(m.keys, keymap.Up, "Up"),
+		keyHint(m.keys, keymap.Down, "Down"),
+		keyHint(m.keys, keymap.Select, "View Details"),
+		keyHint(m.keys, keymap.Search, "Search"),
+		keyHint(m.keys, keymap.SortPlans, "Sort"),
+		keyHint(m.keys, keymap.TagFilter, "Filter by Tag"),
+		keyHint(m.keys, keymap.Back, "Back")))
 }
 
 // renderPlanDetail renders the plan detail view with comprehensive plan information.
 func (m *StatsModel) renderPlanDetail() string {
 	if m.selectedPlan == nil {
-		emptyStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+		emptyStyle := styles.Muted()
 		return lipgloss.NewStyle().Padding(2).Render(
 			emptyStyle.Render("No plan selected"),
 		)
@@ -525,18 +851,13 @@ func (m *StatsModel) renderPlanDetail() string {
 	var content strings.Builder
 
 	// Title with plan name
-	titleStyle := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(lipgloss.Color("12")).
-		PaddingBottom(1)
+	titleStyle := styles.Title().PaddingBottom(1)
 
 	content.WriteString(titleStyle.Render(m.selectedPlan.PlanTitle))
 	content.WriteString("\n\n")
 
 	// Status badge
-	statusStyle := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(lipgloss.Color("14"))
+	statusStyle := styles.Section()
 	content.WriteString(statusStyle.Render("Status: "))
 	content.WriteString(formatPlanStatus(m.selectedPlan.Status))
 	content.WriteString("\n\n")
@@ -582,8 +903,10 @@ func (m *StatsModel) renderPlanDetail() string {
 
 // renderPlanDetailHelp renders help text for the plan detail view.
 func (m *StatsModel) renderPlanDetailHelp() string {
-	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
-	return helpStyle.Render("[s] View Sessions  |  [Esc] Back to Plan List")
+	helpStyle := styles.Muted()
+	return helpStyle.Render(keyHints(
+		keyHint(m.keys, keymap.StatsSessions, "View Sessions"),
+		keyHint(m.keys, keymap.Back, "Back to Plan List")))
 }
 
 // renderSessionHistory renders the session history view with filtering and navigation.
@@ -592,16 +915,25 @@ func (m *StatsModel) renderSessionHistory() string {
 
 	// Title
 	title := m.getSessionHistoryTitle()
-	titleStyle := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(lipgloss.Color("12")).
-		PaddingBottom(1)
+	titleStyle := styles.Title().PaddingBottom(1)
 	content.WriteString(titleStyle.Render(title))
 	content.WriteString("\n\n")
 
 	// Filter sessions
 	filteredSessions := m.filterSessionsByPlan()
 
+	// Loading and error states
+	if len(filteredSessions) == 0 && m.sessionsLoading {
+		content.WriteString(styles.Muted().Render("Loading sessions..."))
+		return content.String()
+	}
+	if len(filteredSessions) == 0 && m.sessionsErr != nil {
+		content.WriteString(styles.Error().Render("Failed to load sessions: " + m.sessionsErr.Error()))
+		content.WriteString("\n\n")
+		content.WriteString(m.renderSessionHistoryHelp())
+		return content.String()
+	}
+
 	// Empty state
 	if len(filteredSessions) == 0 {
 		content.WriteString(m.renderSessionHistoryEmpty())
@@ -614,8 +946,10 @@ func (m *StatsModel) renderSessionHistory() string {
 	content.WriteString("\n\n")
 
 	// Footer
-	footerStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
-	content.WriteString(footerStyle.Render(fmt.Sprintf("Showing %d sessions", len(filteredSessions))))
+	footerStyle := styles.Muted()
+	page, pages := m.historyPage(len(filteredSessions))
+	content.WriteString(footerStyle.Render(fmt.Sprintf("Showing %d sessions  ·  Page %d of %d  ·  Sorted by %s",
+		len(filteredSessions), page, pages, m.sessionSort)))
 	content.WriteString("\n\n")
 
 	// Help
@@ -632,25 +966,22 @@ func (m *StatsModel) getSessionHistoryTitle() string {
 	return "Session History"
 }
 
-// filterSessionsByPlan filters sessions by selected plan if applicable.
-func (m *StatsModel) filterSessionsByPlan() []*session.Session {
-	if m.selectedPlanID == "" {
-		return m.sessions
-	}
+// historyFilter selects the sessions the history view shows: those in the
+// time range and, after drilling into a plan, on that plan.
+func (m *StatsModel) historyFilter() *session.Filter {
+	return &session.Filter{PlanID: m.selectedPlanID, Since: m.timeRange.Start}
+}
 
-	filtered := make([]*session.Session, 0)
-	for _, s := range m.sessions {
-		if s.PlanID == m.selectedPlanID {
-			filtered = append(filtered, s)
-		}
-	}
-	return filtered
+// filterSessionsByPlan returns the history filtered by the history filter,
+// in the chosen sort order.
+func (m *StatsModel) filterSessionsByPlan() []*session.Session {
+	return m.historySessions()
 }
 
 // renderSessionHistoryEmpty renders empty state for session history.
 func (m *StatsModel) renderSessionHistoryEmpty() string {
 	var content strings.Builder
-	emptyStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+	emptyStyle := styles.Muted()
 	content.WriteString(emptyStyle.Render("No sessions found."))
 	content.WriteString("\n\n")
 	content.WriteString(m.renderSessionHistoryHelp())
@@ -661,8 +992,7 @@ func (m *StatsModel) renderSessionHistoryEmpty() string {
 func (m *StatsModel) buildSessionTable(filteredSessions []*session.Session) string {
 	table := components.NewTable([]string{"Date", "Plan", "Duration", "Notes"})
 
-	// Paginate sessions (max 20 visible)
-	displaySessions, startOffset := m.paginateSessions(filteredSessions, 20)
+	displaySessions, startOffset := m.paginateSessions(filteredSessions, sessionPageSize)
 
 	// Add rows with absolute index for highlight comparison
 	for i, sess := range displaySessions {
@@ -674,28 +1004,15 @@ func (m *StatsModel) buildSessionTable(filteredSessions []*session.Session) stri
 	return table.View()
 }
 
-// paginateSessions returns a slice of sessions to display based on cursor position
-// and the starting offset in the original list.
+// paginateSessions returns the page of sessions holding the cursor and the
+// page's offset in the original list.
 func (m *StatsModel) paginateSessions(sessions []*session.Session, maxDisplay int) ([]*session.Session, int) {
 	if len(sessions) <= maxDisplay {
 		return sessions, 0
 	}
 
-	// Center window around cursor
-	start := m.sessionHistoryCursor - maxDisplay/2
-	if start < 0 {
-		start = 0
-	}
-
-	end := start + maxDisplay
-	if end > len(sessions) {
-		end = len(sessions)
-		start = end - maxDisplay
-		if start < 0 {
-			start = 0
-		}
-	}
-
+	start := m.sessionHistoryCursor / maxDisplay * maxDisplay
+	end := min(start+maxDisplay, len(sessions))
 	return sessions[start:end], start
 }
 
@@ -709,10 +1026,7 @@ func (m *StatsModel) formatSessionRow(sess *session.Session, isSelected bool) []
 
 	// Apply highlighting if selected
 	if isSelected {
-		highlightStyle := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("0")).
-			Background(lipgloss.Color("12")).
-			Bold(true)
+		highlightStyle := styles.Selected()
 		dateStr = highlightStyle.Render(dateStr)
 		planID = highlightStyle.Render(planID)
 		durationStr = highlightStyle.Render(durationStr)
@@ -722,12 +1036,9 @@ func (m *StatsModel) formatSessionRow(sess *session.Session, isSelected bool) []
 	return []string{dateStr, planID, durationStr, notesPreview}
 }
 
-// truncateString truncates a string to maxLen with ellipsis.
+// truncateString truncates a string to maxLen columns with ellipsis.
 func truncateString(s string, maxLen int) string {
-	if len(s) <= maxLen {
-		return s
-	}
-	return s[:maxLen-3] + "..."
+	return components.Truncate(s, maxLen)
 }
 
 // formatNotes formats notes for display in table.
@@ -736,9 +1047,7 @@ func formatNotes(notes string, maxLen int) string {
 	notes = strings.ReplaceAll(notes, "\n", " ")
 
 	// Truncate if needed
-	if len(notes) > maxLen {
-		notes = notes[:maxLen-3] + "..."
-	}
+	notes = components.Truncate(notes, maxLen)
 
 	// Return dash if empty
 	if notes == "" {
@@ -750,82 +1059,15 @@ func formatNotes(notes string, maxLen int) string {
 
 // renderSessionHistoryHelp renders help text for the session history view.
 func (m *StatsModel) renderSessionHistoryHelp() string {
-	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
-	return helpStyle.Render("[↑/k] Up  |  [↓/j] Down  |  [Esc] Back")
-}
-
-// renderExportDialog renders the export dialog with options for quick export.
-func (m *StatsModel) renderExportDialog() string {
-	var content strings.Builder
-
-	// Title
-	titleStyle := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(lipgloss.Color("12")).
-		PaddingBottom(1)
-
-	content.WriteString(titleStyle.Render("Export Learning Report"))
-	content.WriteString("\n\n")
-
-	// Info text
-	infoStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
-	content.WriteString(infoStyle.Render("Select export type:"))
-	content.WriteString("\n\n")
-
-	// Export options
-	exportOptions := []struct {
-		name        string
-		description string
-	}{
-		{"Summary Report", "Quick overview of your learning progress"},
-		{"Full Report", "Detailed report with daily breakdowns"},
-	}
-
-	for i, option := range exportOptions {
-		optionStyle := lipgloss.NewStyle()
-
-		// Highlight selected option
-		if i == m.exportMenuCursor {
-			optionStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("0")).
-				Background(lipgloss.Color("12")).
-				Bold(true).
-				Width(50)
-		}
-
-		nameText := fmt.Sprintf("  [%d] %s", i+1, option.name)
-		content.WriteString(optionStyle.Render(nameText))
-		content.WriteString("\n")
-
-		if i == m.exportMenuCursor {
-			descStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("8")).PaddingLeft(6)
-			content.WriteString(descStyle.Render(option.description))
-			content.WriteString("\n")
-		}
-		content.WriteString("\n")
-	}
-
-	content.WriteString("\n")
-
-	// Note about output
-	noteStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("11")).
-		Italic(true)
-	content.WriteString(noteStyle.Render("Note: Report will be printed to terminal. Use shell redirection to save to file."))
-	content.WriteString("\n")
-	content.WriteString(noteStyle.Render("      Example: samedi stats --tui (then press 'e' and Enter) > report.md"))
-	content.WriteString("\n\n")
-
-	// Help
-	content.WriteString(m.renderExportHelp())
-
-	return content.String()
-}
-
-// renderExportHelp renders help text for the export dialog.
-func (m *StatsModel) renderExportHelp() string {
-	helpStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
-	return helpStyle.Render("[↑/k] Up  |  [↓/j] Down  |  [Enter] Export  |  [Esc] Cancel")
+	helpStyle := styles.Muted()
+	return helpStyle.Render(keyHints(
+		keyHint(m.keys, keymap.Up, "Up"),
+		keyHint(m.keys, keymap.Down, "Down"),
+		keyHint(m.keys, keymap.PageDown, "Next page"),
+		keyHint(m.keys, keymap.PageUp, "Previous page"),
+		keyHint(m.keys, keymap.SortSessions, "Sort"),
+		keyHint(m.keys, keymap.Select, "Details"),
+		keyHint(m.keys, keymap.Back, "Back")))
 }
 
 // renderTotalStats renders total statistics view.
@@ -843,6 +1085,12 @@ func (m *StatsModel) renderTotalStats() string {
 		fmt.Sprintf("Average session:  %.0f minutes", m.totalStats.AverageSession),
 	}))
 
+	// Daily hours across the range, once there is any activity
+	if chart := m.renderDailyChart(); len(chart) > 0 {
+		result.WriteString("\n")
+		result.WriteString(m.renderSection("Daily Hours", chart))
+	}
+
 	result.WriteString("\n")
 
 	// Streaks section
@@ -868,9 +1116,43 @@ func (m *StatsModel) renderTotalStats() string {
 		}))
 	}
 
+	// Flashcards, once any have been reviewed
+	if m.reviewStats != nil && m.reviewStats.Reviews > 0 {
+		result.WriteString("\n")
+		result.WriteString(m.renderSection("Flashcards", []string{
+			fmt.Sprintf("Cards reviewed:   %d", m.reviewStats.Reviews),
+			fmt.Sprintf("Retention:        %.0f%%", m.reviewStats.Retention),
+		}))
+	}
+
 	return result.String()
 }
 
+// renderDailyChart returns the lines of the overview's daily hours chart:
+// a sparkline sized to the terminal and its caption.
+func (m *StatsModel) renderDailyChart() []string {
+	days := stats.FillDays(m.dailyStats, m.timeRange)
+	if len(days) == 0 {
+		return nil
+	}
+
+	hours := make([]float64, len(days))
+	total, peak := 0.0, 0.0
+	for i, ds := range days {
+		hours[i] = ds.Hours()
+		total += hours[i]
+		peak = math.Max(peak, hours[i])
+	}
+
+	width := min(max(m.width-10, 20), 60)
+	first, last := days[0].Date, days[len(days)-1].Date
+	return []string{
+		styles.Accent().Render(components.Sparkline(hours, width)),
+		styles.Muted().Render(fmt.Sprintf("%s – %s · peak %.1fh · avg %.1fh/day",
+			first.Format("Jan 2"), last.Format("Jan 2"), peak, total/float64(len(days)))),
+	}
+}
+
 // renderPlanStats renders plan-specific statistics view.
 func (m *StatsModel) renderPlanStats() string {
 	if m.planStats == nil {
@@ -923,9 +1205,7 @@ func (m *StatsModel) renderPlanStats() string {
 func (m *StatsModel) renderSection(title string, items []string) string {
 	var section strings.Builder
 
-	sectionStyle := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(lipgloss.Color("14")) // Cyan
+	sectionStyle := styles.Section()
 
 	section.WriteString(sectionStyle.Render(title))
 	section.WriteString("\n")
@@ -941,11 +1221,19 @@ func (m *StatsModel) renderSection(title string, items []string) string {
 
 // renderHelp renders help text.
 func (m *StatsModel) renderHelp() string {
-	helpStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("8")) // Gray
-
-	helpText := "[q] quit  |  [p] plan list  |  [s] sessions  |  [e] export\n" +
-		"[↑/k] up  |  [↓/j] down  |  [Enter] select  |  [Esc] back"
+	helpStyle := styles.Muted()
+
+	helpText := keyHints(
+		keyHint(m.keys, keymap.Quit, "quit"),
+		keyHint(m.keys, keymap.StatsPlans, "plan list"),
+		keyHint(m.keys, keymap.StatsSessions, "sessions"),
+		keyHint(m.keys, keymap.StatsInsights, "insights"),
+		keyHint(m.keys, keymap.StatsExport, "export")) + "\n" +
+		keyHints(
+			keyHint(m.keys, keymap.Up, "up"),
+			keyHint(m.keys, keymap.Down, "down"),
+			keyHint(m.keys, keymap.Select, "select"),
+			keyHint(m.keys, keymap.Back, "back"))
 
 	return helpStyle.Render(helpText)
 }
@@ -0,0 +1,177 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/pezware/samedi.dev/internal/tui/app"
+	"github.com/pezware/samedi.dev/internal/tui/keymap"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
+)
+
+// reportExportedMsg reports how an export from the dialog went.
+type reportExportedMsg struct {
+	path string
+	err  error
+}
+
+// resetExport clears the last export's outcome before the dialog opens.
+func (m *StatsModel) resetExport() {
+	m.exportInput = nil
+	m.exportedPath = ""
+	m.exportErr = nil
+}
+
+// startExportPrompt records the chosen report type and asks for a file
+// name.
+func (m *StatsModel) startExportPrompt() {
+	if m.exporting {
+		return
+	}
+	if m.exportMenuCursor == 0 {
+		m.exportType = "summary"
+	} else {
+		m.exportType = "full"
+	}
+	m.resetExport()
+	m.exportInput = newInputField("Blank for a name from export.report_filename")
+	m.exportInput.Focus()
+}
+
+// handleExportInput routes keys to the file name prompt. Enter starts the
+// export and Esc returns to the report types.
+func (m *StatsModel) handleExportInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+	case tea.KeyEsc:
+		m.exportInput = nil
+		return m, nil
+	case tea.KeyEnter:
+		name := strings.TrimSpace(m.exportInput.Value())
+		m.exportInput = nil
+		m.exporting = true
+		return m, m.exportReport(m.exportType, name)
+	}
+
+	m.exportInput.Update(msg)
+	return m, nil
+}
+
+// exportReport writes a report of reportType as name in the background.
+func (m *StatsModel) exportReport(reportType, name string) tea.Cmd {
+	writer := m.reportWriter
+	return func() tea.Msg {
+		if writer == nil {
+			return reportExportedMsg{err: fmt.Errorf("export is not available here; use 'samedi report'")}
+		}
+		path, err := writer(reportType, name)
+		return reportExportedMsg{path: path, err: err}
+	}
+}
+
+// applyExport shows how the export went in the dialog and the status bar.
+func (m *StatsModel) applyExport(msg reportExportedMsg) tea.Cmd {
+	m.exporting = false
+	m.exportedPath, m.exportErr = msg.path, msg.err
+	return func() tea.Msg {
+		if msg.err != nil {
+			return app.StatusMsg{Message: fmt.Sprintf("Export failed: %v", msg.err), IsError: true}
+		}
+		return app.StatusMsg{Message: "Report exported to " + msg.path}
+	}
+}
+
+// renderExportDialog renders the export dialog: the report types, then
+// the file name prompt and the outcome of the export.
+func (m *StatsModel) renderExportDialog() string {
+	var content strings.Builder
+
+	// Title
+	titleStyle := styles.Title().PaddingBottom(1)
+
+	content.WriteString(titleStyle.Render("Export Learning Report"))
+	content.WriteString("\n\n")
+
+	// Info text
+	infoStyle := styles.Muted()
+	content.WriteString(infoStyle.Render("Select export type:"))
+	content.WriteString("\n\n")
+
+	// Export options
+	exportOptions := []struct {
+		name        string
+		description string
+	}{
+		{"Summary Report", "Quick overview of your learning progress"},
+		{"Full Report", "Detailed report with daily breakdowns"},
+	}
+
+	for i, option := range exportOptions {
+		optionStyle := lipgloss.NewStyle()
+
+		// Highlight selected option
+		if i == m.exportMenuCursor {
+			optionStyle = styles.Selected().Width(50)
+		}
+
+		nameText := fmt.Sprintf("  [%d] %s", i+1, option.name)
+		content.WriteString(optionStyle.Render(nameText))
+		content.WriteString("\n")
+
+		if i == m.exportMenuCursor {
+			descStyle := styles.Muted().PaddingLeft(6)
+			content.WriteString(descStyle.Render(option.description))
+			content.WriteString("\n")
+		}
+		content.WriteString("\n")
+	}
+
+	switch {
+	case m.exportInput != nil:
+		content.WriteString(infoStyle.Render("Save as (a file or directory, as with 'samedi report -o'):"))
+		content.WriteString("\n")
+		content.WriteString(m.exportInput.View())
+		content.WriteString("\n\n")
+	case m.exporting:
+		content.WriteString(infoStyle.Render("Exporting..."))
+		content.WriteString("\n\n")
+	case m.exportErr != nil:
+		content.WriteString(styles.Error().Render("✗ Export failed: " + m.exportErr.Error()))
+		content.WriteString("\n\n")
+	case m.exportedPath != "":
+		content.WriteString(styles.Success().Render("✓ Saved to " + m.exportedPath))
+		content.WriteString("\n\n")
+	default:
+		noteStyle := styles.Muted().Italic(true)
+		content.WriteString(noteStyle.Render("Existing files are never overwritten."))
+		content.WriteString("\n")
+		content.WriteString(noteStyle.Render("For other options, use 'samedi report'."))
+		content.WriteString("\n\n")
+	}
+
+	// Help
+	content.WriteString(m.renderExportHelp())
+
+	return content.String()
+}
+
+// renderExportHelp renders help text for the export dialog.
+func (m *StatsModel) renderExportHelp() string {
+	helpStyle := styles.Muted()
+	if m.exportInput != nil {
+		return helpStyle.Render(keyHints(
+			keyHint(m.keys, keymap.Select, "Save"),
+			keyHint(m.keys, keymap.Back, "Back")))
+	}
+	return helpStyle.Render(keyHints(
+		keyHint(m.keys, keymap.Up, "Up"),
+		keyHint(m.keys, keymap.Down, "Down"),
+		keyHint(m.keys, keymap.Select, "Export"),
+		keyHint(m.keys, keymap.Back, "Cancel")))
+}
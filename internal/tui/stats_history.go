@@ -0,0 +1,125 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"sort"
+
+	"github.com/pezware/samedi.dev/internal/session"
+)
+
+// sessionPageSize is how many sessions one page of history shows.
+const sessionPageSize = 20
+
+// sessionSort is the order of the session history.
+type sessionSort int
+
+const (
+	sortByDate     sessionSort = iota // Newest first
+	sortByDuration                    // Longest first
+	sortByPlan                        // Plan A–Z, newest first within a plan
+)
+
+func (s sessionSort) String() string {
+	switch s {
+	case sortByDuration:
+		return "duration"
+	case sortByPlan:
+		return "plan"
+	default:
+		return "date"
+	}
+}
+
+// historyKey identifies what the cached history was built from.
+type historyKey struct {
+	planID string
+	sortBy sessionSort
+}
+
+// historyCache holds the filtered, sorted history so key presses and
+// redraws don't filter and sort thousands of sessions each time.
+type historyCache struct {
+	key      historyKey
+	sessions []*session.Session
+	valid    bool
+}
+
+// historySessions returns the sessions the history view lists, filtered
+// and sorted, rebuilding them only when the sessions, the plan, or the
+// sort order changed.
+func (m *StatsModel) historySessions() []*session.Session {
+	key := historyKey{planID: m.selectedPlanID, sortBy: m.sessionSort}
+	if m.history.valid && m.history.key == key {
+		return m.history.sessions
+	}
+
+	filter := m.historyFilter()
+	filtered := make([]*session.Session, 0, len(m.sessions))
+	for _, s := range m.sessions {
+		if filter.Matches(s) {
+			filtered = append(filtered, s)
+		}
+	}
+	sortSessions(filtered, m.sessionSort)
+
+	m.history = historyCache{key: key, sessions: filtered, valid: true}
+	return filtered
+}
+
+// sortSessions orders sessions in place. Ties fall back to newest first.
+func sortSessions(sessions []*session.Session, by sessionSort) {
+	newer := func(a, b *session.Session) bool { return a.StartTime.After(b.StartTime) }
+	sort.SliceStable(sessions, func(i, j int) bool {
+		a, b := sessions[i], sessions[j]
+		switch by {
+		case sortByDuration:
+			if a.Duration != b.Duration {
+				return a.Duration > b.Duration
+			}
+		case sortByPlan:
+			if a.PlanID != b.PlanID {
+				return a.PlanID < b.PlanID
+			}
+		}
+		return newer(a, b)
+	})
+}
+
+// cycleSessionSort switches the history to the next sort order, keeping
+// the highlighted session under the cursor.
+func (m *StatsModel) cycleSessionSort() {
+	var current *session.Session
+	if visible := m.historySessions(); m.sessionHistoryCursor < len(visible) {
+		current = visible[m.sessionHistoryCursor]
+	}
+
+	m.sessionSort = (m.sessionSort + 1) % (sortByPlan + 1)
+
+	m.sessionHistoryCursor = 0
+	for i, s := range m.historySessions() {
+		if s == current {
+			m.sessionHistoryCursor = i
+			break
+		}
+	}
+}
+
+// moveHistoryCursor moves the history cursor by delta, stopping at the
+// first and last sessions rather than wrapping.
+func (m *StatsModel) moveHistoryCursor(delta int) {
+	count := len(m.historySessions())
+	if count == 0 {
+		return
+	}
+	m.sessionHistoryCursor = max(0, min(count-1, m.sessionHistoryCursor+delta))
+}
+
+// historyPage returns the 1-based page the cursor is on and the page count.
+func (m *StatsModel) historyPage(count int) (page, pages int) {
+	if count == 0 {
+		return 1, 1
+	}
+	return m.sessionHistoryCursor/sessionPageSize + 1, (count + sessionPageSize - 1) / sessionPageSize
+}
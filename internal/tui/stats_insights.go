@@ -0,0 +1,81 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+	"github.com/pezware/samedi.dev/internal/tui/keymap"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
+)
+
+// renderInsights renders the Insights view: the best time of day, hour,
+// and weekday in the time range, a chart of hours by weekday, and a
+// sparkline of hours across the day.
+func (m *StatsModel) renderInsights() string {
+	var content strings.Builder
+	content.WriteString(styles.Title().PaddingBottom(1).Render("Learning Insights"))
+	content.WriteString("\n\n")
+
+	insights := m.insights
+	if insights == nil || insights.Sessions == 0 {
+		content.WriteString(styles.Muted().Render("No sessions in this time range yet."))
+		content.WriteString("\n\n")
+		content.WriteString(m.renderInsightsHelp())
+		return content.String()
+	}
+
+	if insights.BestHour == nil {
+		content.WriteString(m.renderSection("Best Times", []string{
+			styles.Muted().Render(fmt.Sprintf("Log at least %d sessions to see your best times (%d so far).",
+				stats.InsightMinSessions, insights.Sessions)),
+		}))
+	} else {
+		content.WriteString(m.renderSection("Best Times", []string{
+			fmt.Sprintf("Time of day:  %s  %s", styles.Accent().Render(components.PadRight(insights.BestPeriod.Label, 9)), insights.BestPeriod.Summary()),
+			fmt.Sprintf("Hour:         %s  %s", styles.Accent().Render(components.PadRight(insights.BestHour.Label, 9)), insights.BestHour.Summary()),
+			fmt.Sprintf("Weekday:      %s  %s", styles.Accent().Render(components.PadRight(insights.BestWeekday.Label, 9)), insights.BestWeekday.Summary()),
+		}))
+	}
+	content.WriteString("\n")
+
+	bars := make([]components.Bar, len(insights.Weekdays))
+	for i, day := range insights.Weekdays {
+		hours := float64(day.Minutes) / 60
+		bars[i] = components.Bar{Label: day.Label, Value: hours}
+		if day.Minutes > 0 {
+			bars[i].Note = fmt.Sprintf("%.1fh", hours)
+		}
+	}
+	width := min(max(m.width-30, 10), 30)
+	content.WriteString(m.renderSection("Hours by Weekday", strings.Split(components.NewBarChart(bars, width).View(), "\n")))
+	content.WriteString("\n")
+
+	hours := make([]float64, len(insights.Hours))
+	for i, hour := range insights.Hours {
+		hours[i] = float64(hour.Minutes) / 60
+	}
+	content.WriteString(m.renderSection("Hours by Start Time", []string{
+		styles.Accent().Render(components.Sparkline(hours, len(hours))),
+		styles.Muted().Render(hourAxis),
+	}))
+
+	content.WriteString("\n")
+	content.WriteString(m.renderInsightsHelp())
+	return content.String()
+}
+
+// hourAxis labels a 24-character sparkline of the hours of the day.
+const hourAxis = "0     6     12    18   23"
+
+// renderInsightsHelp renders the Insights view's key hints.
+func (m *StatsModel) renderInsightsHelp() string {
+	return styles.Muted().Render(keyHints(
+		keyHint(m.keys, keymap.StatsPlans, "Plan list"),
+		keyHint(m.keys, keymap.StatsSessions, "Sessions"),
+		keyHint(m.keys, keymap.Back, "Back")))
+}
@@ -0,0 +1,217 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/tui/app"
+	"github.com/pezware/samedi.dev/internal/tui/keymap"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
+)
+
+// openArtifact is swapped out in tests.
+var openArtifact = session.OpenArtifact
+
+// renderSessionDetail renders a single session with its artifacts panel.
+func (m *StatsModel) renderSessionDetail() string {
+	sess := m.selectedSession
+	if sess == nil {
+		emptyStyle := styles.Muted()
+		return lipgloss.NewStyle().Padding(2).Render(
+			emptyStyle.Render("No session selected"),
+		)
+	}
+
+	var content strings.Builder
+
+	titleStyle := styles.Title().PaddingBottom(1)
+	content.WriteString(titleStyle.Render(fmt.Sprintf("Session: %s", sess.StartTime.Format("Jan 2, 2006 15:04"))))
+	content.WriteString("\n\n")
+
+	chunk := sess.ChunkID
+	if chunk == "" {
+		chunk = "-"
+	}
+	content.WriteString(m.renderSection("Session", []string{
+		fmt.Sprintf("Plan:      %s", sess.PlanID),
+		fmt.Sprintf("Chunk:     %s", chunk),
+		fmt.Sprintf("Duration:  %s", sess.ElapsedTime()),
+	}))
+	content.WriteString("\n")
+
+	if m.noteInput != nil {
+		content.WriteString(m.renderSection("Notes", []string{m.noteInput.View()}))
+	} else {
+		content.WriteString(m.renderSection("Notes", m.noteLines()))
+	}
+	content.WriteString("\n")
+
+	content.WriteString(m.renderSection("Artifacts", m.artifactLines()))
+
+	content.WriteString("\n\n")
+	content.WriteString(m.renderSessionDetailHelp())
+
+	return content.String()
+}
+
+// noteLines returns the selected session's full notes, wrapped to the
+// terminal width.
+func (m *StatsModel) noteLines() []string {
+	if m.selectedSession.Notes == "" {
+		return []string{"No notes"}
+	}
+	width := maxInt(m.width-4, 20)
+	wrapped := lipgloss.NewStyle().Width(width).Render(m.selectedSession.Notes)
+	return strings.Split(wrapped, "\n")
+}
+
+// artifactLines formats the selected session's artifacts, marking the
+// cursor and any local files that no longer exist.
+func (m *StatsModel) artifactLines() []string {
+	if len(m.selectedSession.Artifacts) == 0 {
+		return []string{"No artifacts recorded"}
+	}
+
+	highlightStyle := styles.Selected()
+	missingStyle := styles.Error()
+
+	lines := make([]string, 0, len(m.selectedSession.Artifacts))
+	for i, raw := range m.selectedSession.Artifacts {
+		artifact := session.ParseArtifact(raw)
+		line := fmt.Sprintf("%d. [%s] %s", i+1, artifact.Kind, artifact.Raw)
+		if i == m.artifactCursor {
+			line = highlightStyle.Render(line)
+		}
+		if artifact.Kind == session.ArtifactFile && !artifact.Exists {
+			line += " " + missingStyle.Render("(missing)")
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// openSelectedArtifact opens the artifact under the cursor and reports
+// the outcome in the status bar.
+func (m *StatsModel) openSelectedArtifact() tea.Cmd {
+	if m.selectedSession == nil || len(m.selectedSession.Artifacts) == 0 {
+		return nil
+	}
+
+	artifact := session.ParseArtifact(m.selectedSession.Artifacts[m.artifactCursor])
+	return func() tea.Msg {
+		if err := openArtifact(artifact); err != nil {
+			return app.StatusMsg{Message: err.Error(), IsError: true}
+		}
+		return app.StatusMsg{Message: fmt.Sprintf("Opened %s", artifact.Target)}
+	}
+}
+
+// sessionNotesSavedMsg reports the outcome of saving a session's notes.
+type sessionNotesSavedMsg struct {
+	session *session.Session
+	err     error
+}
+
+// CapturingInput reports whether the notes editor, the export file name
+// prompt, or the plan search is open, so the shell passes every key to it.
+func (m *StatsModel) CapturingInput() bool {
+	return m.noteInput != nil || m.exportInput != nil || m.search != nil
+}
+
+// startNoteEdit opens the notes editor prefilled with the current notes.
+func (m *StatsModel) startNoteEdit() tea.Cmd {
+	if m.selectedSession == nil {
+		return nil
+	}
+	if m.readOnly {
+		return refuseReadOnlySession()
+	}
+	if _, ok := m.sessionService.(SessionNotesEditor); !ok {
+		return func() tea.Msg {
+			return app.StatusMsg{Message: "Note editing unavailable", IsError: true}
+		}
+	}
+
+	m.noteInput = newInputField("Session notes")
+	m.noteInput.SetValue(m.selectedSession.Notes)
+	m.noteInput.Focus()
+	return nil
+}
+
+// handleNoteInput routes keys to the notes editor. Enter saves and Esc
+// discards the edit.
+func (m *StatsModel) handleNoteInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+	case tea.KeyEsc:
+		m.noteInput = nil
+		return m, nil
+	case tea.KeyEnter:
+		notes := m.noteInput.Value()
+		m.noteInput = nil
+		return m, m.saveNotes(m.selectedSession.ID, notes)
+	}
+
+	m.noteInput.Update(msg)
+	return m, nil
+}
+
+// saveNotes persists notes through the session provider.
+func (m *StatsModel) saveNotes(id, notes string) tea.Cmd {
+	editor, ok := m.sessionService.(SessionNotesEditor)
+	if !ok {
+		return nil
+	}
+	return func() tea.Msg {
+		updated, err := editor.UpdateNotes(m.ctx, id, notes)
+		return sessionNotesSavedMsg{session: updated, err: err}
+	}
+}
+
+// applySavedNotes replaces the saved session in the loaded list.
+func (m *StatsModel) applySavedNotes(msg sessionNotesSavedMsg) tea.Cmd {
+	if msg.err != nil {
+		return func() tea.Msg {
+			return app.StatusMsg{Message: fmt.Sprintf("Failed to save notes: %v", msg.err), IsError: true}
+		}
+	}
+
+	for i, sess := range m.sessions {
+		if sess.ID == msg.session.ID {
+			m.sessions[i] = msg.session
+		}
+	}
+	m.history.valid = false
+	if m.selectedSession != nil && m.selectedSession.ID == msg.session.ID {
+		m.selectedSession = msg.session
+	}
+
+	return func() tea.Msg {
+		return app.StatusMsg{Message: "Notes saved"}
+	}
+}
+
+// renderSessionDetailHelp renders help text for the session detail view.
+func (m *StatsModel) renderSessionDetailHelp() string {
+	helpStyle := styles.Muted()
+	if m.noteInput != nil {
+		return helpStyle.Render("[Enter] Save notes  |  [Esc] Cancel")
+	}
+	hints := []string{
+		keyHint(m.keys, keymap.Up, "Up"),
+		keyHint(m.keys, keymap.Down, "Down"),
+		keyHint(m.keys, keymap.OpenArtifact, "Open artifact"),
+	}
+	if !m.readOnly {
+		hints = append(hints, keyHint(m.keys, keymap.EditNote, "Edit notes"))
+	}
+	hints = append(hints, keyHint(m.keys, keymap.Back, "Back to Sessions"))
+	return helpStyle.Render(keyHints(hints...))
+}
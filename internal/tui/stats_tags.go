@@ -0,0 +1,174 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/stats"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/pezware/samedi.dev/internal/tui/components"
+	"github.com/pezware/samedi.dev/internal/tui/styles"
+)
+
+// visiblePlanStats returns the plans shown in the plan list: all of them,
+// or those carrying the tag filter, in the order of the plan sort.
+func (m *StatsModel) visiblePlanStats() []stats.PlanStats {
+	if m.tagFilter == "" && m.planSort.Field == "" {
+		return m.allPlanStats
+	}
+
+	var visible []stats.PlanStats
+	for _, ps := range m.allPlanStats {
+		if m.tagFilter == "" {
+			visible = append(visible, ps)
+			continue
+		}
+		for _, tag := range ps.Tags {
+			if strings.EqualFold(tag, m.tagFilter) {
+				visible = append(visible, ps)
+				break
+			}
+		}
+	}
+	if m.planSort.Field != "" {
+		sort.SliceStable(visible, func(i, j int) bool {
+			return m.planSort.Less(planStatsSortKeys(visible[i]), planStatsSortKeys(visible[j]))
+		})
+	}
+	return visible
+}
+
+// planStatsSortKeys returns what the plan sort compares of ps. Hours are
+// those of the time range, as the list shows them.
+func planStatsSortKeys(ps stats.PlanStats) plan.SortKeys {
+	keys := plan.SortKeys{
+		Title:       ps.PlanTitle,
+		Status:      ps.Status,
+		Hours:       ps.TotalHours,
+		Progress:    -1,
+		LastStudied: ps.LastSession,
+	}
+	if ps.TotalChunks > 0 {
+		keys.Progress = ps.Progress
+	}
+	if ps.Forecast != nil && ps.Forecast.Deadline != nil {
+		keys.Deadline = ps.Forecast.Deadline.String()
+	}
+	return keys
+}
+
+// resortPlans applies a new plan sort, keeping the cursor on the same
+// plan, and saves it.
+func (m *StatsModel) resortPlans(s plan.ListSort) tea.Cmd {
+	var selected string
+	if visible := m.visiblePlanStats(); m.planListCursor < len(visible) {
+		selected = visible[m.planListCursor].PlanID
+	}
+	m.planSort = s
+	for i, ps := range m.visiblePlanStats() {
+		if ps.PlanID == selected {
+			m.planListCursor = i
+			break
+		}
+	}
+	return sortChanged(s, m.saveSort)
+}
+
+// cycleTagFilter moves the plan list filter to the next tag, most used
+// first, and back to all plans after the last one. Tags differing only in
+// case filter the same plans, so only the first spelling is offered.
+func (m *StatsModel) cycleTagFilter() {
+	records := make([]*storage.PlanRecord, len(m.allPlanStats))
+	for i, ps := range m.allPlanStats {
+		records[i] = &storage.PlanRecord{ID: ps.PlanID, Tags: ps.Tags}
+	}
+
+	var tags []string
+	seen := make(map[string]bool)
+	for _, count := range plan.CountTags(records) {
+		if key := strings.ToLower(count.Tag); !seen[key] {
+			seen[key] = true
+			tags = append(tags, count.Tag)
+		}
+	}
+
+	next := ""
+	for i, tag := range tags {
+		if m.tagFilter == "" {
+			next = tag
+			break
+		}
+		if strings.EqualFold(tag, m.tagFilter) {
+			if i+1 < len(tags) {
+				next = tags[i+1]
+			}
+			break
+		}
+	}
+
+	m.tagFilter = next
+	m.planListCursor = 0
+}
+
+// searchMatches returns the visible plans matching the open search, best
+// first.
+func (m *StatsModel) searchMatches() ([]stats.PlanStats, []planMatch) {
+	visible := m.visiblePlanStats()
+	fields := make([]planSearchFields, len(visible))
+	for i, ps := range visible {
+		fields[i] = planSearchFields{id: ps.PlanID, title: ps.PlanTitle, tags: ps.Tags}
+	}
+	matches := matchPlans(m.search.input.Value(), fields)
+	m.search.cursor = clampCursor(m.search.cursor, len(matches))
+	return visible, matches
+}
+
+// handleSearchKey edits the plan list search. Enter shows the highlighted
+// plan's details, and going back from them returns to the whole list with
+// that plan selected. Esc returns to the list where the cursor was.
+func (m *StatsModel) handleSearchKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	visible, matches := m.searchMatches()
+	switch m.search.update(msg, len(matches)) {
+	case searchClosed:
+		m.planListCursor = clampCursor(m.search.before, len(visible))
+		m.search = nil
+	case searchChosen:
+		if m.search.cursor >= len(matches) {
+			return m, nil
+		}
+		m.planListCursor = matches[m.search.cursor].index
+		m.search = nil
+		return m.handleEnterKey()
+	}
+	return m, nil
+}
+
+// renderSearchResults renders the search field and the matching plans
+// with the matched letters marked.
+func (m *StatsModel) renderSearchResults() string {
+	visible, matches := m.searchMatches()
+	var b strings.Builder
+	b.WriteString(m.search.renderSearchBar(len(matches), len(visible)))
+	if len(matches) == 0 {
+		b.WriteString(styles.Muted().Render("No plans match."))
+		return b.String()
+	}
+
+	table := components.NewTable([]string{"Title", "Progress", "Hours", "Status"})
+	for i, match := range matches {
+		rest := lipgloss.NewStyle()
+		if i == m.search.cursor {
+			rest = styles.Selected()
+		}
+		planStat := visible[match.index]
+		table.AddRow(planStatsRow(planStat, match.highlightTitle(planStat.PlanTitle, rest), rest))
+	}
+	b.WriteString(table.View())
+	return b.String()
+}
@@ -5,6 +5,7 @@ package tui
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -15,7 +16,10 @@ import (
 	"github.com/pezware/samedi.dev/internal/stats"
 	"github.com/pezware/samedi.dev/internal/storage"
 	"github.com/pezware/samedi.dev/internal/tui/app"
+	"github.com/pezware/samedi.dev/internal/tui/keymap"
+	"github.com/pezware/samedi.dev/pkg/testsupport"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func newTestStatsModuleWithTotals(total *stats.TotalStats) *StatsModel {
@@ -98,13 +102,20 @@ func (s *stubSessionService) ListAll(_ context.Context) ([]*session.Session, err
 }
 
 func drainStatsCommands(model *StatsModel, cmd tea.Cmd) *StatsModel {
-	for cmd != nil {
+	queue := []tea.Cmd{cmd}
+	for len(queue) > 0 {
+		cmd, queue = queue[0], queue[1:]
+		if cmd == nil {
+			continue
+		}
 		msg := cmd()
-		var nextCmd tea.Cmd
-		var updated tea.Model
-		updated, nextCmd = model.Update(msg)
+		if batch, ok := msg.(tea.BatchMsg); ok {
+			queue = append(queue, batch...)
+			continue
+		}
+		updated, nextCmd := model.Update(msg)
 		model = updated.(*StatsModel)
-		cmd = nextCmd
+		queue = append(queue, nextCmd)
 	}
 	return model
 }
@@ -515,6 +526,26 @@ func TestStatsModel_PlanList_NavigateDown(t *testing.T) {
 	assert.Equal(t, 2, m.planListCursor)
 }
 
+func TestStatsModel_Keymap_RemapsKeys(t *testing.T) {
+	model := newTestStatsModuleWithTotals(&stats.TotalStats{})
+	model.SetAllPlanStats([]stats.PlanStats{{PlanID: "plan1"}, {PlanID: "plan2"}})
+	keys, err := keymap.New(map[string][]string{"stats_plans": {"l"}, "down": {"s"}, "stats_sessions": {"h"}})
+	require.NoError(t, err)
+	model.SetKeymap(keys)
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
+	assert.Equal(t, viewOverview, model.currentView)
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'l'}})
+	assert.Equal(t, viewPlanList, model.currentView)
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	assert.Equal(t, 1, model.planListCursor, "s moves down instead of opening sessions")
+	assert.Equal(t, viewPlanList, model.currentView)
+
+	assert.Equal(t, "l", model.Shortcuts()[0].Key)
+	assert.Contains(t, model.renderPlanListHelp(), "[s] Down")
+}
+
 func TestStatsModel_PlanList_NavigateUp(t *testing.T) {
 	totalStats := &stats.TotalStats{}
 	model := newTestStatsModuleWithTotals(totalStats)
@@ -1071,8 +1102,9 @@ func TestStatsModel_Export_SelectOption(t *testing.T) {
 	m = updatedModel.(*StatsModel)
 	assert.Equal(t, "summary", m.exportType)
 
-	// Should go back to previous view
-	assert.Equal(t, viewOverview, m.currentView)
+	// Should ask for a file name
+	assert.Equal(t, viewExport, m.currentView)
+	assert.NotNil(t, m.exportInput)
 }
 
 func TestStatsModel_Export_SelectFullReport(t *testing.T) {
@@ -1093,8 +1125,87 @@ func TestStatsModel_Export_SelectFullReport(t *testing.T) {
 	m = updatedModel.(*StatsModel)
 	assert.Equal(t, "full", m.exportType)
 
-	// Should go back to previous view
-	assert.Equal(t, viewOverview, m.currentView)
+	// Should ask for a file name
+	assert.Equal(t, viewExport, m.currentView)
+	assert.NotNil(t, m.exportInput)
+}
+
+func TestStatsModel_Export_WritesReport(t *testing.T) {
+	model := newTestStatsModuleWithTotals(&stats.TotalStats{})
+	var writtenType, writtenName string
+	model.SetReportWriter(func(reportType, name string) (string, error) {
+		writtenType, writtenName = reportType, name
+		return "/tmp/notes/q1.md", nil
+	})
+
+	updatedModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	m := updatedModel.(*StatsModel)
+	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updatedModel.(*StatsModel)
+	require.NotNil(t, m.exportInput, "choosing a type asks for a file name")
+	assert.True(t, m.CapturingInput())
+
+	for _, key := range []tea.KeyMsg{
+		{Type: tea.KeyRunes, Runes: []rune("notes/q1")},
+		{Type: tea.KeyRunes, Runes: []rune{'e'}}, // Typed, not the export shortcut
+		{Type: tea.KeyBackspace},
+	} {
+		updatedModel, _ = m.Update(key)
+		m = updatedModel.(*StatsModel)
+	}
+	updatedModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updatedModel.(*StatsModel)
+	require.NotNil(t, cmd)
+	assert.True(t, m.exporting)
+	assert.Contains(t, m.View(), "Exporting")
+
+	msg := cmd()
+	assert.Equal(t, "summary", writtenType)
+	assert.Equal(t, "notes/q1", writtenName)
+
+	updatedModel, cmd = m.Update(msg)
+	m = updatedModel.(*StatsModel)
+	assert.False(t, m.exporting)
+	assert.Equal(t, viewExport, m.currentView, "the dialog stays open to show the outcome")
+	assert.Contains(t, m.View(), "Saved to /tmp/notes/q1.md")
+	require.NotNil(t, cmd)
+	assert.Equal(t, app.StatusMsg{Message: "Report exported to /tmp/notes/q1.md"}, cmd())
+}
+
+func TestStatsModel_Export_ReportsFailure(t *testing.T) {
+	model := newTestStatsModuleWithTotals(&stats.TotalStats{})
+	model.SetReportWriter(func(string, string) (string, error) {
+		return "", errors.New("disk full")
+	})
+
+	updatedModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	m := updatedModel.(*StatsModel)
+	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updatedModel.(*StatsModel)
+	updatedModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updatedModel.(*StatsModel)
+	require.NotNil(t, cmd)
+
+	updatedModel, cmd = m.Update(cmd())
+	m = updatedModel.(*StatsModel)
+	assert.Contains(t, m.View(), "Export failed: disk full")
+	status, ok := cmd().(app.StatusMsg)
+	require.True(t, ok)
+	assert.True(t, status.IsError)
+}
+
+func TestStatsModel_Export_EscReturnsToTypes(t *testing.T) {
+	model := newTestStatsModuleWithTotals(&stats.TotalStats{})
+
+	updatedModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	m := updatedModel.(*StatsModel)
+	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updatedModel.(*StatsModel)
+	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updatedModel.(*StatsModel)
+
+	assert.Nil(t, m.exportInput)
+	assert.Equal(t, viewExport, m.currentView)
 }
 
 func TestStatsModel_RefreshOnBroadcast(t *testing.T) {
@@ -1121,6 +1232,107 @@ func TestStatsModel_RefreshOnBroadcast(t *testing.T) {
 	assert.Greater(t, sessionStub.listAllCalls, initialSessions)
 }
 
+func TestStatsModel_LoadsStatsAndSessionsSeparately(t *testing.T) {
+	planStub := newStubPlanService()
+	sessionStub := newStubSessionService()
+	module := NewStatsModule(stats.NewService(planStub, sessionStub), sessionStub, stats.NewTimeRangeAll())
+
+	_, cmd := module.Update(app.ModuleActivatedMsg{ID: module.ID(), FirstActivation: true})
+	require.NotNil(t, cmd)
+	batch, ok := cmd().(tea.BatchMsg)
+	require.True(t, ok, "stats and sessions load in parallel")
+
+	var sawStats, sawSessions bool
+	for _, load := range batch {
+		switch load().(type) {
+		case statsLoadedMsg:
+			sawStats = true
+		case sessionsLoadedMsg:
+			sawSessions = true
+		}
+	}
+	assert.True(t, sawStats)
+	assert.True(t, sawSessions)
+}
+
+func TestStatsModel_AutoRefreshKeepsView(t *testing.T) {
+	planStub := newStubPlanService()
+	sessionStub := newStubSessionService()
+	module := NewStatsModule(stats.NewService(planStub, sessionStub), sessionStub, stats.NewTimeRangeAll())
+	_, cmd := module.Update(app.ModuleActivatedMsg{ID: module.ID(), FirstActivation: true})
+	module = drainStatsCommands(module, cmd)
+	require.Len(t, module.allPlanStats, 1)
+
+	module.currentView = viewPlanList
+	module, _ = module.switchView(viewPlanDetail)
+	module.selectedPlanID = "plan-1"
+	module.selectedPlan = &module.allPlanStats[0]
+
+	_, cmd = module.Update(app.RefreshMsg{Auto: true})
+	assert.False(t, module.loading, "no loading screen")
+	module = drainStatsCommands(module, cmd)
+	assert.Equal(t, viewPlanDetail, module.currentView)
+	require.NotNil(t, module.selectedPlan)
+	assert.Equal(t, "plan-1", module.selectedPlan.PlanID)
+
+	// A plan deleted elsewhere sends the view back to the overview
+	planStub.plans = map[string]*plan.Plan{}
+	planStub.records = nil
+	_, cmd = module.Update(app.RefreshMsg{})
+	assert.True(t, module.loading)
+	module = drainStatsCommands(module, cmd)
+	assert.Equal(t, viewOverview, module.currentView)
+	assert.Nil(t, module.selectedPlan)
+	assert.Empty(t, module.viewHistory)
+}
+
+func TestStatsModel_CommandKeepsViewWhileLoading(t *testing.T) {
+	planStub := newStubPlanService()
+	sessionStub := newStubSessionService()
+	module := NewStatsModule(stats.NewService(planStub, sessionStub), sessionStub, stats.NewTimeRangeAll())
+	commands := module.Commands()
+	require.Len(t, commands, 4)
+	assert.Equal(t, "Session history", commands[1].Title)
+
+	// The palette activates the module, then sends the command
+	_, load := module.Update(app.ModuleActivatedMsg{ID: module.ID(), FirstActivation: true})
+	module.Update(commands[1].Msg)
+	module = drainStatsCommands(module, load)
+
+	assert.True(t, module.dataLoaded)
+	assert.Equal(t, viewSessionHistory, module.currentView)
+
+	// Loads without a command still start on the overview
+	_, load = module.Update(app.BroadcastMsg{Topic: app.TopicSessionsChanged})
+	module = drainStatsCommands(module, load)
+	assert.Equal(t, viewOverview, module.currentView)
+}
+
+func TestStatsModel_SessionHistoryLoadingAndErrors(t *testing.T) {
+	model := newTestStatsModuleWithTotals(&stats.TotalStats{})
+	model.sessionService = newStubSessionService()
+	model.sessionsLoading = true
+	model.currentView = viewSessionHistory
+	assert.Contains(t, model.View(), "Loading sessions")
+
+	updated, cmd := model.Update(sessionsLoadedMsg{err: errors.New("database is locked")})
+	m := updated.(*StatsModel)
+	require.NotNil(t, cmd)
+	status, ok := cmd().(app.StatusMsg)
+	require.True(t, ok)
+	assert.True(t, status.IsError)
+	assert.Contains(t, m.View(), "Failed to load sessions: database is locked")
+
+	// Opening the history again retries
+	m.currentView = viewOverview
+	_, cmd = m.switchView(viewSessionHistory)
+	require.NotNil(t, cmd)
+	updated, _ = m.Update(cmd())
+	m = updated.(*StatsModel)
+	assert.NoError(t, m.sessionsErr)
+	assert.False(t, m.sessionsLoading)
+}
+
 // Test Module Interface Methods
 
 func TestStatsModel_ID(t *testing.T) {
@@ -1317,3 +1529,409 @@ func TestStatsModel_IgnoresUnknownMessages(t *testing.T) {
 	assert.NotNil(t, updatedModel)
 	assert.Nil(t, cmd)
 }
+
+func TestStatsModel_SessionDetail_ArtifactsPanel(t *testing.T) {
+	model := newTestStatsModule()
+	now := time.Now()
+	model.SetSessions([]*session.Session{
+		{
+			ID:        "sess1",
+			PlanID:    "plan1",
+			ChunkID:   "chunk-002",
+			StartTime: now.Add(-time.Hour),
+			EndTime:   &now,
+			Duration:  60,
+			Notes:     "Read the docs",
+			Artifacts: []string{"https://example.com/guide", "/no/such/notes.md"},
+		},
+	})
+
+	updatedModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	updatedModel, _ = updatedModel.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m := updatedModel.(*StatsModel)
+
+	assert.Equal(t, viewSessionDetail, m.currentView)
+	view := m.View()
+	assert.Contains(t, view, "chunk-002")
+	assert.Contains(t, view, "Read the docs")
+	assert.Contains(t, view, "1. [url] https://example.com/guide")
+	assert.Contains(t, view, "2. [file] /no/such/notes.md")
+	assert.Contains(t, view, "(missing)")
+
+	// Esc returns to the session list
+	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, viewSessionHistory, updatedModel.(*StatsModel).currentView)
+}
+
+func TestStatsModel_SessionDetail_OpenArtifact(t *testing.T) {
+	var opened []string
+	original := openArtifact
+	openArtifact = func(artifact session.Artifact) error {
+		opened = append(opened, artifact.Target)
+		return nil
+	}
+	t.Cleanup(func() { openArtifact = original })
+
+	model := newTestStatsModule()
+	now := time.Now()
+	model.SetSessions([]*session.Session{
+		{
+			ID:        "sess1",
+			PlanID:    "plan1",
+			StartTime: now.Add(-time.Hour),
+			EndTime:   &now,
+			Duration:  60,
+			Artifacts: []string{"https://example.com/one", "https://example.com/two"},
+		},
+	})
+
+	updatedModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	updatedModel, _ = updatedModel.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updatedModel, _ = updatedModel.Update(tea.KeyMsg{Type: tea.KeyDown})
+	_, cmd := updatedModel.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+
+	require.NotNil(t, cmd)
+	msg := cmd()
+	status, ok := msg.(app.StatusMsg)
+	require.True(t, ok)
+	assert.False(t, status.IsError)
+	assert.Equal(t, []string{"https://example.com/two"}, opened)
+}
+
+func TestStatsModel_SessionDetail_EditNotes(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	repo := testsupport.NewSessionRepository()
+	require.NoError(t, repo.Create(ctx, &session.Session{
+		ID:        "sess1",
+		PlanID:    "plan1",
+		StartTime: now.Add(-time.Hour),
+		EndTime:   &now,
+		Duration:  60,
+		Notes:     "Read",
+		CreatedAt: now,
+	}))
+	sessions := session.NewService(repo, nil)
+
+	model := NewStatsModule(nil, sessions, stats.NewTimeRangeAll())
+	model.totalStats = &stats.TotalStats{}
+	model.dataLoaded = true
+	all, err := sessions.ListAll(ctx)
+	require.NoError(t, err)
+	model.SetSessions(all)
+
+	var updated tea.Model = model
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	m := updated.(*StatsModel)
+	require.True(t, m.CapturingInput())
+
+	// 'q' is text while editing, not quit
+	for _, r := range " chapter q" {
+		key := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}}
+		if r == ' ' {
+			key = tea.KeyMsg{Type: tea.KeySpace}
+		}
+		updated, _ = updated.Update(key)
+	}
+	assert.Contains(t, m.View(), "Read chapter q")
+
+	_, cmd := updated.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	m = drainStatsCommands(m, cmd)
+
+	assert.False(t, m.CapturingInput())
+	assert.Equal(t, "Read chapter q", m.selectedSession.Notes)
+	assert.Equal(t, "Read chapter q", m.sessions[0].Notes)
+
+	stored, err := repo.Get(ctx, "sess1")
+	require.NoError(t, err)
+	assert.Equal(t, "Read chapter q", stored.Notes)
+}
+
+func TestStatsModel_SessionDetail_EditNotesCancel(t *testing.T) {
+	model := NewStatsModule(nil, session.NewService(testsupport.NewSessionRepository(), nil), stats.NewTimeRangeAll())
+	model.totalStats = &stats.TotalStats{}
+	model.dataLoaded = true
+	now := time.Now()
+	model.SetSessions([]*session.Session{{ID: "sess1", PlanID: "plan1", StartTime: now, Notes: "Original"}})
+
+	var updated tea.Model = model
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m := updated.(*StatsModel)
+
+	assert.False(t, m.CapturingInput())
+	assert.Equal(t, viewSessionDetail, m.currentView, "Esc closes the editor, not the view")
+	assert.Equal(t, "Original", m.selectedSession.Notes)
+}
+
+func TestStatsModel_SessionDetail_EditNotesUnavailable(t *testing.T) {
+	model := NewStatsModule(nil, newStubSessionService(), stats.NewTimeRangeAll())
+	model.totalStats = &stats.TotalStats{}
+	model.dataLoaded = true
+	model.SetSessions([]*session.Session{{ID: "sess1", PlanID: "plan1", StartTime: time.Now()}})
+
+	var updated tea.Model = model
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	updated, cmd := updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+
+	assert.False(t, updated.(*StatsModel).CapturingInput())
+	require.NotNil(t, cmd)
+	status, ok := cmd().(app.StatusMsg)
+	require.True(t, ok)
+	assert.True(t, status.IsError)
+}
+
+func TestStatsModel_SessionDetail_EditNotesReadOnly(t *testing.T) {
+	model := NewStatsModule(nil, newStubSessionService(), stats.NewTimeRangeAll())
+	model.SetReadOnly(true)
+	model.totalStats = &stats.TotalStats{}
+	model.dataLoaded = true
+	model.SetSessions([]*session.Session{{ID: "sess1", PlanID: "plan1", StartTime: time.Now()}})
+
+	var updated tea.Model = model
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.NotContains(t, updated.View(), "Edit notes")
+	updated, cmd := updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+
+	assert.False(t, updated.(*StatsModel).CapturingInput())
+	require.NotNil(t, cmd)
+	status, ok := cmd().(app.StatusMsg)
+	require.True(t, ok)
+	assert.True(t, status.IsError)
+	assert.Contains(t, status.Message, "Read-only mode")
+}
+
+func TestStatsModel_PlanList_TagFilter(t *testing.T) {
+	model := newTestStatsModuleWithTotals(&stats.TotalStats{})
+	model.SetAllPlanStats([]stats.PlanStats{
+		{PlanID: "rust", PlanTitle: "Rust", Tags: []string{"programming", "systems"}},
+		{PlanID: "go", PlanTitle: "Go", Tags: []string{"Programming"}},
+		{PlanID: "french", PlanTitle: "French", Tags: []string{"language"}},
+	})
+
+	press := func(r rune) {
+		_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	press('p')
+
+	// Most used tag first; matching ignores case
+	press('t')
+	assert.Equal(t, "language", model.tagFilter, "ties are alphabetical")
+	press('t')
+	assert.Equal(t, "Programming", model.tagFilter, "one entry per spelling")
+	assert.Len(t, model.visiblePlanStats(), 2)
+	assert.Contains(t, model.View(), "tag: Programming")
+
+	// Enter opens the selected plan of the filtered list
+	press('j')
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, "go", model.selectedPlanID)
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	press('t')
+	assert.Equal(t, "systems", model.tagFilter)
+	press('t')
+	assert.Empty(t, model.tagFilter, "cycles back to all plans")
+	assert.Len(t, model.visiblePlanStats(), 3)
+}
+
+func TestStatsModel_View_DailyHoursChart(t *testing.T) {
+	model := newTestStatsModuleWithTotals(nil)
+	assert.NotContains(t, model.View(), "Daily Hours", "no chart without activity")
+
+	today := time.Now()
+	day := func(offset int) time.Time {
+		d := today.AddDate(0, 0, offset)
+		return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, d.Location())
+	}
+	model.dailyStats = []stats.DailyStats{
+		{Date: day(-3), Duration: 90, SessionCount: 1},
+		{Date: day(-1), Duration: 30, SessionCount: 1},
+	}
+
+	view := model.View()
+	assert.Contains(t, view, "Daily Hours")
+	assert.Contains(t, view, "█ ▃", "the peak day, an idle day, then a lighter one")
+	assert.Contains(t, view, "peak 1.5h · avg 0.5h/day")
+}
+
+func TestStatsModel_SessionHistory_TimeRange(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	repo := testsupport.NewSessionRepository()
+	for i, start := range []time.Time{now.Add(-time.Hour), now.AddDate(0, 0, -10)} {
+		end := start.Add(30 * time.Minute)
+		require.NoError(t, repo.Create(ctx, &session.Session{
+			ID:        fmt.Sprintf("sess%d", i),
+			PlanID:    "plan1",
+			StartTime: start,
+			EndTime:   &end,
+			Duration:  30,
+			CreatedAt: start,
+		}))
+	}
+
+	timeRange := stats.NewTimeRangeSince(now.AddDate(0, 0, -7))
+	model := NewStatsModule(nil, session.NewService(repo, nil), timeRange)
+	loaded, err := model.loadSessions()
+	require.NoError(t, err)
+	require.Len(t, loaded, 1, "the provider is queried for the time range only")
+	assert.Equal(t, "sess0", loaded[0].ID)
+
+	// Providers that can't query get every session; the view narrows them
+	all, err := repo.List(ctx, nil)
+	require.NoError(t, err)
+	model.SetSessions(all)
+	filtered := model.filterSessionsByPlan()
+	require.Len(t, filtered, 1)
+	assert.Equal(t, "sess0", filtered[0].ID)
+
+	model.selectedPlanID = "plan2"
+	assert.Empty(t, model.filterSessionsByPlan())
+}
+
+func TestStatsModel_SessionHistory_PagingAndSort(t *testing.T) {
+	model := newTestStatsModule()
+	now := time.Now()
+	sessions := make([]*session.Session, 45)
+	for i := range sessions {
+		sessions[i] = &session.Session{
+			ID:        fmt.Sprintf("sess%02d", i),
+			PlanID:    fmt.Sprintf("plan-%d", i%3),
+			StartTime: now.Add(-time.Duration(i) * time.Hour),
+			Duration:  i,
+		}
+	}
+	model.SetSessions(sessions)
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+
+	press := func(msg tea.KeyMsg) { model.Update(msg) }
+
+	press(tea.KeyMsg{Type: tea.KeyPgDown})
+	assert.Equal(t, 20, model.sessionHistoryCursor)
+	page, offset := model.paginateSessions(model.filterSessionsByPlan(), sessionPageSize)
+	assert.Equal(t, 20, offset, "pages don't slide with the cursor")
+	assert.Len(t, page, 20)
+	assert.Contains(t, model.View(), "Page 2 of 3")
+
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'G'}})
+	assert.Equal(t, 44, model.sessionHistoryCursor)
+	press(tea.KeyMsg{Type: tea.KeyPgDown})
+	assert.Equal(t, 44, model.sessionHistoryCursor, "paging stops at the last session")
+	page, _ = model.paginateSessions(model.filterSessionsByPlan(), sessionPageSize)
+	assert.Len(t, page, 5)
+
+	press(tea.KeyMsg{Type: tea.KeyPgUp})
+	assert.Equal(t, 24, model.sessionHistoryCursor)
+	press(tea.KeyMsg{Type: tea.KeyHome})
+	assert.Equal(t, 0, model.sessionHistoryCursor)
+
+	// Sorting keeps the highlighted session selected
+	press(tea.KeyMsg{Type: tea.KeyDown})
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'S'}})
+	assert.Equal(t, sortByDuration, model.sessionSort)
+	history := model.filterSessionsByPlan()
+	assert.Equal(t, "sess44", history[0].ID, "longest first")
+	assert.Equal(t, "sess01", history[model.sessionHistoryCursor].ID)
+	assert.Contains(t, model.View(), "Sorted by duration")
+
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'S'}})
+	history = model.filterSessionsByPlan()
+	assert.Equal(t, []string{"plan-0", "plan-0"}, []string{history[0].PlanID, history[14].PlanID})
+	assert.Equal(t, "sess00", history[0].ID, "newest first within a plan")
+	assert.Equal(t, "plan-1", history[15].PlanID)
+
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'S'}})
+	assert.Equal(t, sortByDate, model.sessionSort)
+}
+
+func TestStatsModel_PlanList_Search(t *testing.T) {
+	model := newTestStatsModuleWithTotals(&stats.TotalStats{})
+	model.SetAllPlanStats([]stats.PlanStats{
+		{PlanID: "rust", PlanTitle: "Rust", Tags: []string{"systems"}},
+		{PlanID: "go", PlanTitle: "Go"},
+		{PlanID: "french", PlanTitle: "French", Tags: []string{"language"}},
+	})
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	require.NotNil(t, model.search)
+	assert.True(t, model.CapturingInput())
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("fr")})
+	view := model.View()
+	assert.Contains(t, view, "1 of 3 plans")
+	assert.NotContains(t, view, "Rust")
+
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, model.search)
+	assert.Equal(t, viewPlanDetail, model.currentView)
+	assert.Equal(t, "french", model.selectedPlanID)
+
+	// Back in the list, the chosen plan is selected
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, viewPlanList, model.currentView)
+	assert.Equal(t, 2, model.planListCursor)
+}
+
+func TestStatsModel_PlanList_Sort(t *testing.T) {
+	model := newTestStatsModuleWithTotals(&stats.TotalStats{})
+	model.SetAllPlanStats([]stats.PlanStats{
+		{PlanID: "rust", PlanTitle: "Rust", TotalHours: 1, TotalChunks: 4, Progress: 0.75},
+		{PlanID: "go", PlanTitle: "Go", TotalHours: 6, TotalChunks: 4, Progress: 0.25},
+	})
+	saved := ""
+	model.SetPlanSort(plan.ListSort{Field: plan.SortHours, Desc: true}, func(s plan.ListSort) error {
+		saved = s.String()
+		return nil
+	})
+	_, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
+
+	visible := model.visiblePlanStats()
+	require.Len(t, visible, 2)
+	assert.Equal(t, "go", visible[0].PlanID)
+	assert.Contains(t, model.View(), "sorted by hours ↓")
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'O'}})
+	require.NotNil(t, cmd)
+	status, ok := cmd().(app.StatusMsg)
+	require.True(t, ok)
+	assert.Equal(t, "Plans sorted by hours ↑", status.Message)
+	assert.Equal(t, "hours:asc", saved)
+	assert.Equal(t, "rust", model.visiblePlanStats()[0].PlanID)
+	assert.Equal(t, 1, model.planListCursor, "the cursor stays on go")
+}
+
+func TestStatsModel_Insights(t *testing.T) {
+	module := newTestStatsModule()
+	_, _ = module.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+	require.Equal(t, viewInsights, module.currentView)
+	assert.Contains(t, module.View(), "No sessions in this time range yet.")
+
+	monday := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	sessions := make([]session.Session, 0, 5)
+	for day := 0; day < 5; day++ {
+		start := monday.AddDate(0, 0, 7*(day%2)+day/2)
+		end := start.Add(time.Hour)
+		sessions = append(sessions, session.Session{ID: fmt.Sprint(day), PlanID: "rust", StartTime: start, EndTime: &end, Duration: 60})
+	}
+	module.insights = stats.CalculateInsights(sessions, stats.NewTimeRangeAll(), nil)
+
+	view := module.View()
+	assert.Contains(t, view, "Learning Insights")
+	assert.Contains(t, view, "morning")
+	assert.Contains(t, view, "09:00")
+	assert.Contains(t, view, "Monday")
+	assert.Contains(t, view, "Hours by Start Time")
+
+	_, _ = module.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, viewOverview, module.currentView)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package styles
+
+import "github.com/charmbracelet/lipgloss"
+
+// The functions below build styles from the active theme. They are called
+// at render time, so a theme set with Use applies from the next frame.
+
+// Title styles view titles and table headers.
+func Title() lipgloss.Style {
+	return lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(Current().Title))
+}
+
+// Section styles section headings.
+func Section() lipgloss.Style {
+	return lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(Current().Section))
+}
+
+// Accent styles the active item: the current module and highlighted rows.
+func Accent() lipgloss.Style {
+	return lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(Current().Accent))
+}
+
+// Muted styles help lines, placeholders, and empty states.
+func Muted() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(Current().Muted))
+}
+
+// Border styles separators.
+func Border() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(Current().Border))
+}
+
+// Selected styles the list item under the cursor.
+func Selected() lipgloss.Style {
+	theme := Current()
+	return lipgloss.NewStyle().
+		Foreground(lipgloss.Color(theme.SelectedFg)).
+		Background(lipgloss.Color(theme.SelectedBg)).
+		Bold(true)
+}
+
+// Success styles completed work.
+func Success() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(Current().Success))
+}
+
+// Warning styles notes and partial progress.
+func Warning() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(Current().Warning))
+}
+
+// Error styles errors and missing items.
+func Error() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(Current().Error))
+}
@@ -0,0 +1,250 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+// Package styles holds the TUI color theme. The CLI picks a theme from
+// tui.theme before starting a program, and every module and component
+// builds its lipgloss styles from the active theme.
+package styles
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Theme maps each color role to a lipgloss color: an ANSI number
+// ("0"–"255") or a hex value ("#bd93f9").
+type Theme struct {
+	Name string
+
+	Title      string // View titles and table headers
+	Section    string // Section headings and secondary emphasis
+	Accent     string // Active navigation, highlighted rows, focused inputs
+	Muted      string // Help lines, placeholders, empty states
+	Border     string // Separators and unfocused borders
+	SelectedFg string // Text of the selected list item
+	SelectedBg string // Background of the selected list item
+	Success    string
+	Warning    string
+	Error      string
+}
+
+// Theme names with special meaning.
+const (
+	// Auto picks Dark or Light from the terminal background.
+	Auto         = "auto"
+	Dark         = "dark"
+	Light        = "light"
+	HighContrast = "high-contrast"
+)
+
+// builtins are the themes available without configuration. The hex
+// palettes need a true-color terminal; lipgloss approximates them otherwise.
+var builtins = map[string]Theme{
+	Dark: {
+		Name:  Dark,
+		Title: "12", Section: "14", Accent: "212", Muted: "244", Border: "240",
+		SelectedFg: "0", SelectedBg: "12",
+		Success: "10", Warning: "11", Error: "9",
+	},
+	Light: {
+		Name:  Light,
+		Title: "25", Section: "30", Accent: "161", Muted: "242", Border: "249",
+		SelectedFg: "15", SelectedBg: "25",
+		Success: "28", Warning: "130", Error: "160",
+	},
+	HighContrast: {
+		Name:  HighContrast,
+		Title: "15", Section: "15", Accent: "14", Muted: "15", Border: "15",
+		SelectedFg: "0", SelectedBg: "11",
+		Success: "10", Warning: "11", Error: "9",
+	},
+	"dracula": {
+		Name:  "dracula",
+		Title: "#bd93f9", Section: "#8be9fd", Accent: "#ff79c6", Muted: "#6272a4", Border: "#44475a",
+		SelectedFg: "#282a36", SelectedBg: "#bd93f9",
+		Success: "#50fa7b", Warning: "#f1fa8c", Error: "#ff5555",
+	},
+	"monokai": {
+		Name:  "monokai",
+		Title: "#66d9ef", Section: "#a6e22e", Accent: "#f92672", Muted: "#75715e", Border: "#49483e",
+		SelectedFg: "#272822", SelectedBg: "#66d9ef",
+		Success: "#a6e22e", Warning: "#e6db74", Error: "#f92672",
+	},
+	"gruvbox": {
+		Name:  "gruvbox",
+		Title: "#83a598", Section: "#8ec07c", Accent: "#d3869b", Muted: "#928374", Border: "#504945",
+		SelectedFg: "#282828", SelectedBg: "#fabd2f",
+		Success: "#b8bb26", Warning: "#fabd2f", Error: "#fb4934",
+	},
+}
+
+// BaseRole is the custom theme entry naming the built-in theme it
+// starts from. Without it a custom theme starts from Auto.
+const BaseRole = "base"
+
+// Roles lists the color roles a custom theme can set.
+var Roles = []string{
+	"title", "section", "accent", "muted", "border",
+	"selected_fg", "selected_bg", "success", "warning", "error",
+}
+
+// Names returns the built-in theme names, including Auto.
+func Names() []string {
+	names := []string{Auto}
+	for name := range builtins {
+		names = append(names, name)
+	}
+	sort.Strings(names[1:])
+	return names
+}
+
+// hasDarkBackground reports the terminal background. Tests swap it out.
+var hasDarkBackground = lipgloss.HasDarkBackground
+
+// Check validates a theme name against the built-ins and the custom
+// themes from [tui.themes], without querying the terminal.
+func Check(name string, custom map[string]map[string]string) error {
+	for themeName, roles := range custom {
+		if themeName == "" {
+			return fmt.Errorf("custom theme needs a name")
+		}
+		if _, ok := builtins[themeName]; ok || themeName == Auto {
+			return fmt.Errorf("custom theme %q shadows a built-in theme", themeName)
+		}
+		if err := checkRoles(themeName, roles); err != nil {
+			return err
+		}
+	}
+
+	if name == Auto {
+		return nil
+	}
+	if _, ok := builtins[name]; ok {
+		return nil
+	}
+	if _, ok := custom[name]; ok {
+		return nil
+	}
+	return fmt.Errorf("unknown theme %q (must be one of %s, or a theme defined under [tui.themes])", name, strings.Join(Names(), ", "))
+}
+
+func checkRoles(themeName string, roles map[string]string) error {
+	for role, value := range roles {
+		if role == BaseRole {
+			if _, ok := builtins[value]; !ok && value != Auto {
+				return fmt.Errorf("theme %q: base must be a built-in theme, got %q", themeName, value)
+			}
+			continue
+		}
+		if !isRole(role) {
+			return fmt.Errorf("theme %q: unknown color role %q (must be one of %s)", themeName, role, strings.Join(Roles, ", "))
+		}
+		if !validColor(value) {
+			return fmt.Errorf("theme %q: invalid %s color %q (use an ANSI number 0-255 or #rrggbb)", themeName, role, value)
+		}
+	}
+	return nil
+}
+
+// Load resolves a theme name to colors, detecting the terminal background
+// for Auto. Custom themes start from their base and override its roles.
+func Load(name string, custom map[string]map[string]string) (Theme, error) {
+	if err := Check(name, custom); err != nil {
+		return Theme{}, err
+	}
+
+	roles, isCustom := custom[name]
+	base := name
+	if isCustom {
+		base = roles[BaseRole]
+		if base == "" {
+			base = Auto
+		}
+	}
+	if base == Auto {
+		base = Light
+		if hasDarkBackground() {
+			base = Dark
+		}
+	}
+
+	theme := builtins[base]
+	theme.Name = name
+	for role, value := range roles {
+		if field := theme.field(role); field != nil {
+			*field = value
+		}
+	}
+	return theme, nil
+}
+
+// field returns the color for a role name.
+func (t *Theme) field(role string) *string {
+	switch role {
+	case "title":
+		return &t.Title
+	case "section":
+		return &t.Section
+	case "accent":
+		return &t.Accent
+	case "muted":
+		return &t.Muted
+	case "border":
+		return &t.Border
+	case "selected_fg":
+		return &t.SelectedFg
+	case "selected_bg":
+		return &t.SelectedBg
+	case "success":
+		return &t.Success
+	case "warning":
+		return &t.Warning
+	case "error":
+		return &t.Error
+	}
+	return nil
+}
+
+func isRole(role string) bool {
+	for _, r := range Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+var hexColor = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+func validColor(value string) bool {
+	if hexColor.MatchString(value) {
+		return true
+	}
+	n, err := strconv.Atoi(value)
+	return err == nil && n >= 0 && n <= 255
+}
+
+var (
+	mu     sync.RWMutex
+	active = builtins[Dark]
+)
+
+// Use makes theme the active theme.
+func Use(theme Theme) {
+	mu.Lock()
+	defer mu.Unlock()
+	active = theme
+}
+
+// Current returns the active theme. Until Use is called it is Dark.
+func Current() Theme {
+	mu.RLock()
+	defer mu.RUnlock()
+	return active
+}
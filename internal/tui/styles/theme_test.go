@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package styles
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setDarkBackground(t *testing.T, dark bool) {
+	t.Helper()
+	original := hasDarkBackground
+	t.Cleanup(func() { hasDarkBackground = original })
+	hasDarkBackground = func() bool { return dark }
+}
+
+func TestLoad_Auto(t *testing.T) {
+	setDarkBackground(t, true)
+	theme, err := Load(Auto, nil)
+	require.NoError(t, err)
+	assert.Equal(t, builtins[Dark].Title, theme.Title)
+	assert.Equal(t, Auto, theme.Name)
+
+	setDarkBackground(t, false)
+	theme, err = Load(Auto, nil)
+	require.NoError(t, err)
+	assert.Equal(t, builtins[Light].Title, theme.Title)
+}
+
+func TestLoad_Builtins(t *testing.T) {
+	for _, name := range Names() {
+		theme, err := Load(name, nil)
+		require.NoError(t, err, name)
+		for _, role := range Roles {
+			assert.True(t, validColor(*theme.field(role)), "%s %s", name, role)
+		}
+	}
+}
+
+func TestLoad_CustomTheme(t *testing.T) {
+	setDarkBackground(t, false)
+	custom := map[string]map[string]string{
+		"ocean":  {"base": "high-contrast", "accent": "#5fafff"},
+		"sunset": {"title": "208"},
+	}
+
+	theme, err := Load("ocean", custom)
+	require.NoError(t, err)
+	assert.Equal(t, "#5fafff", theme.Accent)
+	assert.Equal(t, builtins[HighContrast].Title, theme.Title, "unset roles come from the base")
+
+	theme, err = Load("sunset", custom)
+	require.NoError(t, err)
+	assert.Equal(t, "208", theme.Title)
+	assert.Equal(t, builtins[Light].Muted, theme.Muted, "no base follows the terminal")
+}
+
+func TestCheck_Invalid(t *testing.T) {
+	assert.Error(t, Check("solarized", nil))
+
+	for name, custom := range map[string]map[string]map[string]string{
+		"bad color":      {"mine": {"title": "blue"}},
+		"out of range":   {"mine": {"title": "256"}},
+		"unknown role":   {"mine": {"background": "#000"}},
+		"custom base":    {"mine": {"base": "other"}},
+		"shadows":        {"dark": {"title": "1"}},
+		"missing a name": {"": {"title": "1"}},
+	} {
+		assert.Error(t, Check(Auto, custom), name)
+	}
+
+	assert.NoError(t, Check("mine", map[string]map[string]string{"mine": {"title": "#abc", "base": "gruvbox"}}))
+}
+
+func TestUse_ChangesStyles(t *testing.T) {
+	original := Current()
+	t.Cleanup(func() { Use(original) })
+
+	theme := builtins[Dark]
+	theme.Title = "#123456"
+	Use(theme)
+
+	assert.Equal(t, lipgloss.Color("#123456"), Title().GetForeground())
+	assert.Equal(t, lipgloss.Color(theme.SelectedBg), Selected().GetBackground())
+}
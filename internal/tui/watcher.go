@@ -0,0 +1,153 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/fsnotify/fsnotify"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/tui/app"
+)
+
+// watchDebounce coalesces the burst of events editors produce on save
+// (truncate, write, chmod, rename-over) into a single reindex.
+const watchDebounce = 250 * time.Millisecond
+
+// PlanWatcher reindexes plans edited outside the TUI and notifies every
+// module with an external TopicPlansChanged broadcast.
+type PlanWatcher struct {
+	service *plan.Service
+	dir     string
+	send    func(tea.Msg)
+
+	watcher *fsnotify.Watcher
+	mu      sync.Mutex
+	timers  map[string]*time.Timer
+	done    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewPlanWatcher returns a watcher for the plans directory. send delivers
+// messages to the running program (typically tea.Program.Send).
+func NewPlanWatcher(service *plan.Service, dir string, send func(tea.Msg)) *PlanWatcher {
+	return &PlanWatcher{
+		service: service,
+		dir:     dir,
+		send:    send,
+		timers:  make(map[string]*time.Timer),
+		done:    make(chan struct{}),
+	}
+}
+
+// Start begins watching the plans directory in the background.
+func (w *PlanWatcher) Start() error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create file watcher: %w", err)
+	}
+	if err := watcher.Add(w.dir); err != nil {
+		_ = watcher.Close()
+		return fmt.Errorf("failed to watch %s: %w", w.dir, err)
+	}
+
+	w.watcher = watcher
+	w.wg.Add(1)
+	go w.loop()
+	return nil
+}
+
+// Close stops watching and cancels pending reindexes.
+func (w *PlanWatcher) Close() error {
+	if w.watcher == nil {
+		return nil
+	}
+
+	close(w.done)
+	err := w.watcher.Close()
+	w.wg.Wait()
+
+	w.mu.Lock()
+	for id, timer := range w.timers {
+		timer.Stop()
+		delete(w.timers, id)
+	}
+	w.mu.Unlock()
+
+	return err
+}
+
+func (w *PlanWatcher) loop() {
+	defer w.wg.Done()
+	for {
+		select {
+		case <-w.done:
+			return
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			if id := watchedPlanID(event); id != "" {
+				w.schedule(id)
+			}
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			w.send(app.StatusMsg{Message: fmt.Sprintf("Plan watcher error: %v", err), IsError: true})
+		}
+	}
+}
+
+// schedule (re)starts the debounce timer for a plan.
+func (w *PlanWatcher) schedule(id string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if timer, ok := w.timers[id]; ok {
+		timer.Stop()
+	}
+	w.timers[id] = time.AfterFunc(watchDebounce, func() {
+		w.mu.Lock()
+		delete(w.timers, id)
+		w.mu.Unlock()
+		w.reindex(id)
+	})
+}
+
+// reindex refreshes one plan in SQLite and broadcasts the change.
+func (w *PlanWatcher) reindex(id string) {
+	select {
+	case <-w.done:
+		return
+	default:
+	}
+
+	if err := w.service.RefreshIndex(context.Background(), id); err != nil {
+		w.send(app.StatusMsg{Message: fmt.Sprintf("Failed to reindex %s: %v", id, err), IsError: true})
+		return
+	}
+	w.send(app.BroadcastMsg{Topic: app.TopicPlansChanged, Payload: id, External: true})
+}
+
+// watchedPlanID returns the plan ID an event refers to, or "" for events
+// that don't change a plan file (chmod, hidden files, editor swap files).
+func watchedPlanID(event fsnotify.Event) string {
+	if !event.Has(fsnotify.Write) && !event.Has(fsnotify.Create) &&
+		!event.Has(fsnotify.Remove) && !event.Has(fsnotify.Rename) {
+		return ""
+	}
+
+	name := filepath.Base(event.Name)
+	if strings.HasPrefix(name, ".") || filepath.Ext(name) != ".md" {
+		return ""
+	}
+	return strings.TrimSuffix(name, ".md")
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package tui
+
+import (
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/fsnotify/fsnotify"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchedPlanID(t *testing.T) {
+	dir := filepath.Join("home", ".samedi", "plans")
+
+	tests := []struct {
+		name  string
+		event fsnotify.Event
+		want  string
+	}{
+		{"write", fsnotify.Event{Name: filepath.Join(dir, "rust-async.md"), Op: fsnotify.Write}, "rust-async"},
+		{"create", fsnotify.Event{Name: filepath.Join(dir, "rust-async.md"), Op: fsnotify.Create}, "rust-async"},
+		{"remove", fsnotify.Event{Name: filepath.Join(dir, "rust-async.md"), Op: fsnotify.Remove}, "rust-async"},
+		{"rename", fsnotify.Event{Name: filepath.Join(dir, "rust-async.md"), Op: fsnotify.Rename}, "rust-async"},
+		{"chmod only", fsnotify.Event{Name: filepath.Join(dir, "rust-async.md"), Op: fsnotify.Chmod}, ""},
+		{"not markdown", fsnotify.Event{Name: filepath.Join(dir, "rust-async.md.swp"), Op: fsnotify.Write}, ""},
+		{"hidden file", fsnotify.Event{Name: filepath.Join(dir, ".rust-async.md"), Op: fsnotify.Write}, ""},
+		{"vim probe", fsnotify.Event{Name: filepath.Join(dir, "4913"), Op: fsnotify.Create}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, watchedPlanID(tt.event))
+		})
+	}
+}
+
+func TestPlanWatcher_Start_MissingDirectory(t *testing.T) {
+	watcher := NewPlanWatcher(nil, filepath.Join(t.TempDir(), "missing"), func(tea.Msg) {})
+
+	err := watcher.Start()
+
+	assert.Error(t, err)
+	assert.NoError(t, watcher.Close())
+}
+
+func TestPlanWatcher_StartAndClose(t *testing.T) {
+	watcher := NewPlanWatcher(nil, t.TempDir(), func(tea.Msg) {})
+
+	assert.NoError(t, watcher.Start())
+	watcher.schedule("rust-async")
+	assert.NoError(t, watcher.Close())
+	assert.Empty(t, watcher.timers)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+// Package week plans a week of study as a concrete set of chunks (the
+// week's commitment) and reviews how closely the week followed it.
+package week
+
+import (
+	"fmt"
+	"time"
+)
+
+// dateLayout formats week start dates for storage and display.
+const dateLayout = "2006-01-02"
+
+// Item is one chunk committed to for the week.
+type Item struct {
+	PlanID  string `json:"plan_id"`
+	ChunkID string `json:"chunk_id"`
+	Title   string `json:"title"`
+	Minutes int    `json:"minutes"`
+}
+
+// Commitment is the set of chunks planned for one week.
+type Commitment struct {
+	WeekStart        time.Time `json:"week_start"` // Local midnight of the first day of the week
+	Goals            string    `json:"goals,omitempty"`
+	AvailableMinutes int       `json:"available_minutes"`
+	LLMAssisted      bool      `json:"llm_assisted"`
+	Items            []Item    `json:"items"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+// CommittedMinutes returns the total estimated time of all items.
+func (c *Commitment) CommittedMinutes() int {
+	total := 0
+	for _, item := range c.Items {
+		total += item.Minutes
+	}
+	return total
+}
+
+// WeekEnd returns the start of the following week.
+func (c *Commitment) WeekEnd() time.Time {
+	return c.WeekStart.AddDate(0, 0, 7)
+}
+
+// Validate checks that the commitment can be stored.
+func (c *Commitment) Validate() error {
+	if c.WeekStart.IsZero() {
+		return fmt.Errorf("week start cannot be zero")
+	}
+	if c.AvailableMinutes <= 0 {
+		return fmt.Errorf("available time must be positive, got %d minutes", c.AvailableMinutes)
+	}
+	if len(c.Items) == 0 {
+		return fmt.Errorf("commitment has no chunks")
+	}
+
+	seen := make(map[string]bool, len(c.Items))
+	for _, item := range c.Items {
+		if item.PlanID == "" || item.ChunkID == "" {
+			return fmt.Errorf("committed chunks need a plan ID and chunk ID")
+		}
+		key := item.PlanID + "/" + item.ChunkID
+		if seen[key] {
+			return fmt.Errorf("duplicate chunk in commitment: %s", key)
+		}
+		seen[key] = true
+	}
+
+	if c.CreatedAt.IsZero() {
+		return fmt.Errorf("created_at cannot be zero")
+	}
+	return nil
+}
+
+// StartOf returns local midnight of the first day of the week containing t.
+// Weeks start on Monday unless startsSunday is set.
+func StartOf(t time.Time, startsSunday bool) time.Time {
+	t = t.Local()
+	offset := int(t.Weekday())
+	if !startsSunday {
+		offset = (offset + 6) % 7 // Monday = 0
+	}
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+	return day.AddDate(0, 0, -offset)
+}
+
+// Label formats a week start as "2025-10-13".
+func Label(weekStart time.Time) string {
+	return weekStart.Format(dateLayout)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package week
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartOf(t *testing.T) {
+	wednesday := time.Date(2025, 10, 15, 18, 30, 0, 0, time.Local)
+
+	assert.Equal(t, time.Date(2025, 10, 13, 0, 0, 0, 0, time.Local), StartOf(wednesday, false))
+	assert.Equal(t, time.Date(2025, 10, 12, 0, 0, 0, 0, time.Local), StartOf(wednesday, true))
+
+	sunday := time.Date(2025, 10, 19, 9, 0, 0, 0, time.Local)
+	assert.Equal(t, time.Date(2025, 10, 13, 0, 0, 0, 0, time.Local), StartOf(sunday, false))
+	assert.Equal(t, time.Date(2025, 10, 19, 0, 0, 0, 0, time.Local), StartOf(sunday, true))
+}
+
+func TestCommitment_Validate(t *testing.T) {
+	valid := func() *Commitment {
+		return &Commitment{
+			WeekStart:        time.Date(2025, 10, 13, 0, 0, 0, 0, time.Local),
+			AvailableMinutes: 300,
+			Items:            []Item{{PlanID: "rust", ChunkID: "chunk-001", Minutes: 60}},
+			CreatedAt:        time.Now(),
+		}
+	}
+
+	assert.NoError(t, valid().Validate())
+
+	c := valid()
+	c.AvailableMinutes = 0
+	assert.Error(t, c.Validate())
+
+	c = valid()
+	c.Items = nil
+	assert.Error(t, c.Validate())
+
+	c = valid()
+	c.Items = append(c.Items, c.Items[0])
+	assert.ErrorContains(t, c.Validate(), "duplicate chunk")
+}
+
+func TestCommitment_CommittedMinutes(t *testing.T) {
+	c := &Commitment{Items: []Item{{Minutes: 60}, {Minutes: 45}}}
+	assert.Equal(t, 105, c.CommittedMinutes())
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package week
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+)
+
+// candidatesPerPlan limits how far ahead in each plan a week may reach.
+const candidatesPerPlan = 10
+
+// selectionRegex matches "plan-id/chunk-id" references in LLM output.
+// Plan IDs may keep letters of scripts that have no ASCII spelling.
+var selectionRegex = regexp.MustCompile(`([\p{Ll}\p{Lo}\p{Lm}\p{Nd}][\p{Ll}\p{Lo}\p{Lm}\p{Nd}-]*)/(chunk-[0-9]+)`)
+
+// candidates returns the next open chunks of each plan, in plan order.
+// Completed and skipped chunks are never proposed.
+func candidates(plans []*plan.Plan) map[string][]Item {
+	byPlan := make(map[string][]Item, len(plans))
+	for _, p := range plans {
+		items := make([]Item, 0, candidatesPerPlan)
+		for _, chunk := range p.Chunks {
+			if chunk.Status == plan.StatusCompleted || chunk.Status == plan.StatusSkipped {
+				continue
+			}
+			items = append(items, Item{
+				PlanID:  p.ID,
+				ChunkID: chunk.ID,
+				Title:   chunk.Title,
+				Minutes: chunk.Duration,
+			})
+			if len(items) == candidatesPerPlan {
+				break
+			}
+		}
+		byPlan[p.ID] = items
+	}
+	return byPlan
+}
+
+// propose fills the available time round-robin across plans, taking each
+// plan's chunks in order. A plan drops out once its next chunk doesn't fit,
+// so chunks are never proposed out of sequence.
+func propose(plans []*plan.Plan, availableMinutes int) []Item {
+	byPlan := candidates(plans)
+	next := make(map[string]int, len(plans))
+	done := make(map[string]bool, len(plans))
+
+	items := make([]Item, 0)
+	remaining := availableMinutes
+
+	for {
+		added := false
+		for _, p := range plans {
+			if done[p.ID] {
+				continue
+			}
+			queue := byPlan[p.ID]
+			i := next[p.ID]
+			if i >= len(queue) || queue[i].Minutes > remaining {
+				done[p.ID] = true
+				continue
+			}
+			items = append(items, queue[i])
+			remaining -= queue[i].Minutes
+			next[p.ID] = i + 1
+			added = true
+		}
+		if !added {
+			return items
+		}
+	}
+}
+
+// buildPrompt asks an LLM to pick chunks for the week from the candidates.
+func buildPrompt(plans []*plan.Plan, availableMinutes int, goals string) string {
+	byPlan := candidates(plans)
+
+	var b strings.Builder
+	b.WriteString("You are helping a learner plan their study week.\n\n")
+	fmt.Fprintf(&b, "Available time this week: %d minutes.\n", availableMinutes)
+	if goals != "" {
+		fmt.Fprintf(&b, "Goals for this week: %s\n", goals)
+	}
+	b.WriteString("\nOpen chunks by plan (chunks within a plan must be studied in order):\n")
+
+	for _, p := range plans {
+		if len(byPlan[p.ID]) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\n%s — %s\n", p.ID, p.Title)
+		for _, item := range byPlan[p.ID] {
+			fmt.Fprintf(&b, "- %s/%s (%d min): %s\n", item.PlanID, item.ChunkID, item.Minutes, item.Title)
+		}
+	}
+
+	b.WriteString("\nChoose the chunks to commit to this week. Fit the available time, ")
+	b.WriteString("favor the stated goals, and keep each plan's chunks in order.\n")
+	b.WriteString("Reply with one plan-id/chunk-id per line and nothing else.\n")
+
+	return b.String()
+}
+
+// parseSelection extracts known candidates from LLM output, keeping the
+// LLM's order. Unknown references and duplicates are ignored, and a plan's
+// chunks are only accepted in sequence.
+func parseSelection(output string, plans []*plan.Plan) []Item {
+	byPlan := candidates(plans)
+	next := make(map[string]int, len(plans))
+	seen := make(map[string]bool)
+
+	items := make([]Item, 0)
+	for _, match := range selectionRegex.FindAllStringSubmatch(output, -1) {
+		planID, chunkID := match[1], match[2]
+		key := planID + "/" + chunkID
+		if seen[key] {
+			continue
+		}
+
+		queue := byPlan[planID]
+		i := next[planID]
+		if i >= len(queue) || queue[i].ChunkID != chunkID {
+			continue
+		}
+
+		items = append(items, queue[i])
+		next[planID] = i + 1
+		seen[key] = true
+	}
+	return items
+}
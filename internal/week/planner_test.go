@@ -0,0 +1,102 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package week
+
+import (
+	"testing"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPlans() []*plan.Plan {
+	return []*plan.Plan{
+		{
+			ID:    "rust",
+			Title: "Rust Async",
+			Chunks: []plan.Chunk{
+				{ID: "chunk-001", Title: "Futures", Duration: 60, Status: plan.StatusCompleted},
+				{ID: "chunk-002", Title: "Pinning", Duration: 60, Status: plan.StatusInProgress},
+				{ID: "chunk-003", Title: "Tokio", Duration: 90, Status: plan.StatusNotStarted},
+				{ID: "chunk-004", Title: "Streams", Duration: 60, Status: plan.StatusNotStarted},
+			},
+		},
+		{
+			ID:    "french",
+			Title: "French B1",
+			Chunks: []plan.Chunk{
+				{ID: "chunk-001", Title: "Subjunctive", Duration: 45, Status: plan.StatusNotStarted},
+				{ID: "chunk-002", Title: "Listening", Duration: 45, Status: plan.StatusSkipped},
+				{ID: "chunk-003", Title: "Writing", Duration: 45, Status: plan.StatusNotStarted},
+			},
+		},
+	}
+}
+
+func itemKeys(items []Item) []string {
+	keys := make([]string, len(items))
+	for i, item := range items {
+		keys[i] = item.PlanID + "/" + item.ChunkID
+	}
+	return keys
+}
+
+func TestPropose_RoundRobin(t *testing.T) {
+	items := propose(testPlans(), 240)
+
+	// rust 60, french 45, rust 90, french 45 = 240
+	assert.Equal(t, []string{"rust/chunk-002", "french/chunk-001", "rust/chunk-003", "french/chunk-003"}, itemKeys(items))
+}
+
+func TestPropose_KeepsChunksInOrder(t *testing.T) {
+	// After rust/chunk-002 (60) and french/chunk-001 (45), 45 minutes remain:
+	// rust/chunk-003 (90) doesn't fit, so rust stops rather than skipping ahead.
+	items := propose(testPlans(), 150)
+
+	assert.Equal(t, []string{"rust/chunk-002", "french/chunk-001", "french/chunk-003"}, itemKeys(items))
+}
+
+func TestPropose_NothingFits(t *testing.T) {
+	assert.Empty(t, propose(testPlans(), 30))
+}
+
+func TestBuildPrompt(t *testing.T) {
+	prompt := buildPrompt(testPlans(), 300, "finish pinning")
+
+	assert.Contains(t, prompt, "300 minutes")
+	assert.Contains(t, prompt, "finish pinning")
+	assert.Contains(t, prompt, "- rust/chunk-002 (60 min): Pinning")
+	assert.NotContains(t, prompt, "rust/chunk-001", "completed chunks are not offered")
+	assert.NotContains(t, prompt, "french/chunk-002", "skipped chunks are not offered")
+}
+
+func TestParseSelection(t *testing.T) {
+	output := "Here is your week:\n" +
+		"- french/chunk-001\n" +
+		"- rust/chunk-002\n" +
+		"- rust/chunk-002\n" + // duplicate
+		"- rust/chunk-004\n" + // out of order (chunk-003 not chosen)
+		"- music/chunk-001\n" + // unknown plan
+		"- french/chunk-003\n"
+
+	items := parseSelection(output, testPlans())
+
+	require.Len(t, items, 3)
+	assert.Equal(t, []string{"french/chunk-001", "rust/chunk-002", "french/chunk-003"}, itemKeys(items))
+	assert.Equal(t, "Writing", items[2].Title)
+	assert.Equal(t, 45, items[2].Minutes)
+}
+
+func TestParseSelection_UnicodePlanID(t *testing.T) {
+	plans := []*plan.Plan{{
+		ID:     "日本語-文法",
+		Chunks: []plan.Chunk{{ID: "chunk-001", Title: "助詞", Duration: 30, Status: plan.StatusNotStarted}},
+	}}
+
+	items := parseSelection("- 日本語-文法/chunk-001\n", plans)
+
+	require.Len(t, items, 1)
+	assert.Equal(t, "助詞", items[0].Title)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package week
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+)
+
+// Repository defines the interface for commitment persistence.
+type Repository interface {
+	// Save stores a commitment, replacing any existing one for the same week.
+	Save(ctx context.Context, commitment *Commitment) error
+
+	// Get retrieves the commitment for the week starting at weekStart.
+	// Returns nil without error if the week has no commitment.
+	Get(ctx context.Context, weekStart time.Time) (*Commitment, error)
+}
+
+// SQLiteRepository implements commitment storage using SQLite.
+type SQLiteRepository struct {
+	db *storage.SQLiteDB
+}
+
+// NewSQLiteRepository creates a new SQLite-backed commitment repository.
+func NewSQLiteRepository(db *storage.SQLiteDB) Repository {
+	return &SQLiteRepository{db: db}
+}
+
+// Save stores a commitment and its items in one transaction.
+func (r *SQLiteRepository) Save(ctx context.Context, commitment *Commitment) error {
+	if err := commitment.Validate(); err != nil {
+		return fmt.Errorf("invalid commitment: %w", err)
+	}
+
+	tx, err := r.db.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		// Ignore error on rollback - it's expected if commit succeeded
+		//nolint:errcheck // Transaction cleanup
+		tx.Rollback()
+	}()
+
+	week := Label(commitment.WeekStart)
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM week_commitment_items WHERE week_start = ?`, week); err != nil {
+		return fmt.Errorf("failed to clear previous commitment: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM week_commitments WHERE week_start = ?`, week); err != nil {
+		return fmt.Errorf("failed to clear previous commitment: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO week_commitments (week_start, goals, available_minutes, llm_assisted, created_at)
+		VALUES (?, ?, ?, ?, ?)
+	`, week, commitment.Goals, commitment.AvailableMinutes, commitment.LLMAssisted, commitment.CreatedAt.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to save commitment: %w", err)
+	}
+
+	for i, item := range commitment.Items {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO week_commitment_items (week_start, position, plan_id, chunk_id, title, minutes)
+			VALUES (?, ?, ?, ?, ?, ?)
+		`, week, i, item.PlanID, item.ChunkID, item.Title, item.Minutes)
+		if err != nil {
+			return fmt.Errorf("failed to save committed chunk %s/%s: %w", item.PlanID, item.ChunkID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// Get retrieves the commitment for a week, or nil if there is none.
+func (r *SQLiteRepository) Get(ctx context.Context, weekStart time.Time) (*Commitment, error) {
+	week := Label(weekStart)
+
+	commitment := &Commitment{WeekStart: weekStart}
+	var goals sql.NullString
+	err := r.db.DB().QueryRowContext(ctx, `
+		SELECT goals, available_minutes, llm_assisted, created_at
+		FROM week_commitments
+		WHERE week_start = ?
+	`, week).Scan(&goals, &commitment.AvailableMinutes, &commitment.LLMAssisted, &commitment.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commitment: %w", err)
+	}
+	commitment.Goals = goals.String
+
+	rows, err := r.db.DB().QueryContext(ctx, `
+		SELECT plan_id, chunk_id, COALESCE(title, ''), minutes
+		FROM week_commitment_items
+		WHERE week_start = ?
+		ORDER BY position
+	`, week)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get committed chunks: %w", err)
+	}
+	defer rows.Close()
+
+	commitment.Items = make([]Item, 0)
+	for rows.Next() {
+		var item Item
+		if err := rows.Scan(&item.PlanID, &item.ChunkID, &item.Title, &item.Minutes); err != nil {
+			return nil, fmt.Errorf("failed to scan committed chunk: %w", err)
+		}
+		commitment.Items = append(commitment.Items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating committed chunks: %w", err)
+	}
+
+	return commitment, nil
+}
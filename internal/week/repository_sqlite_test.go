@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package week
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestDB(t *testing.T) *storage.SQLiteDB {
+	t.Helper()
+
+	db, err := storage.NewSQLiteDB(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	require.NoError(t, storage.NewMigrator(db).Migrate())
+
+	return db
+}
+
+func TestSQLiteRepository_SaveAndGet(t *testing.T) {
+	repo := NewSQLiteRepository(setupTestDB(t))
+	ctx := context.Background()
+	weekStart := time.Date(2025, 10, 13, 0, 0, 0, 0, time.Local)
+
+	commitment := &Commitment{
+		WeekStart:        weekStart,
+		Goals:            "finish pinning",
+		AvailableMinutes: 300,
+		LLMAssisted:      true,
+		Items: []Item{
+			{PlanID: "rust", ChunkID: "chunk-002", Title: "Pinning", Minutes: 60},
+			{PlanID: "french", ChunkID: "chunk-001", Title: "Subjunctive", Minutes: 45},
+		},
+		CreatedAt: time.Now(),
+	}
+	require.NoError(t, repo.Save(ctx, commitment))
+
+	got, err := repo.Get(ctx, weekStart)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "finish pinning", got.Goals)
+	assert.Equal(t, 300, got.AvailableMinutes)
+	assert.True(t, got.LLMAssisted)
+	assert.Equal(t, commitment.Items, got.Items)
+}
+
+func TestSQLiteRepository_Save_Replaces(t *testing.T) {
+	repo := NewSQLiteRepository(setupTestDB(t))
+	ctx := context.Background()
+	weekStart := time.Date(2025, 10, 13, 0, 0, 0, 0, time.Local)
+
+	first := &Commitment{
+		WeekStart:        weekStart,
+		AvailableMinutes: 300,
+		Items:            []Item{{PlanID: "rust", ChunkID: "chunk-002", Minutes: 60}, {PlanID: "rust", ChunkID: "chunk-003", Minutes: 90}},
+		CreatedAt:        time.Now(),
+	}
+	require.NoError(t, repo.Save(ctx, first))
+
+	second := &Commitment{
+		WeekStart:        weekStart,
+		AvailableMinutes: 120,
+		Items:            []Item{{PlanID: "french", ChunkID: "chunk-001", Minutes: 45}},
+		CreatedAt:        time.Now(),
+	}
+	require.NoError(t, repo.Save(ctx, second))
+
+	got, err := repo.Get(ctx, weekStart)
+	require.NoError(t, err)
+	assert.Equal(t, 120, got.AvailableMinutes)
+	require.Len(t, got.Items, 1)
+	assert.Equal(t, "french", got.Items[0].PlanID)
+}
+
+func TestSQLiteRepository_Get_None(t *testing.T) {
+	repo := NewSQLiteRepository(setupTestDB(t))
+
+	got, err := repo.Get(context.Background(), time.Date(2025, 10, 13, 0, 0, 0, 0, time.Local))
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
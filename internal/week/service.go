@@ -0,0 +1,276 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package week
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/llm"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/storage"
+)
+
+// PlanService defines the plan operations needed to plan a week.
+type PlanService interface {
+	Get(ctx context.Context, id string) (*plan.Plan, error)
+	List(ctx context.Context, filter *storage.PlanFilter) ([]*storage.PlanRecord, error)
+}
+
+// SessionService defines the session operations needed for reviews.
+type SessionService interface {
+	ListAll(ctx context.Context) ([]*session.Session, error)
+}
+
+// Service proposes, stores, and reviews weekly commitments.
+type Service struct {
+	repo     Repository
+	plans    PlanService
+	sessions SessionService
+	provider llm.Provider // Optional - for LLM-assisted proposals
+	now      func() time.Time
+}
+
+// NewService creates a new week service.
+func NewService(repo Repository, plans PlanService, sessions SessionService) *Service {
+	return &Service{
+		repo:     repo,
+		plans:    plans,
+		sessions: sessions,
+		now:      time.Now,
+	}
+}
+
+// SetLLMProvider enables LLM-assisted proposals.
+func (s *Service) SetLLMProvider(provider llm.Provider) {
+	s.provider = provider
+}
+
+// ProposeRequest contains parameters for proposing a week.
+type ProposeRequest struct {
+	WeekStart        time.Time
+	AvailableMinutes int
+	Goals            string
+	PlanIDs          []string // Optional; empty means every active plan
+	UseLLM           bool
+}
+
+// Proposal is a proposed commitment. Warning explains why an LLM-assisted
+// proposal fell back to the built-in planner.
+type Proposal struct {
+	Commitment *Commitment
+	Warning    string
+}
+
+// Propose builds a commitment for the week without saving it. The
+// built-in planner fills the available time round-robin across plans; with
+// UseLLM, the LLM picks from the same candidates.
+func (s *Service) Propose(ctx context.Context, req ProposeRequest) (*Proposal, error) {
+	if req.AvailableMinutes <= 0 {
+		return nil, fmt.Errorf("available time must be positive, got %d minutes", req.AvailableMinutes)
+	}
+
+	plans, err := s.activePlans(ctx, req.PlanIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(plans) == 0 {
+		return nil, fmt.Errorf("no active plans to schedule (create one with 'samedi init')")
+	}
+
+	proposal := &Proposal{
+		Commitment: &Commitment{
+			WeekStart:        req.WeekStart,
+			Goals:            req.Goals,
+			AvailableMinutes: req.AvailableMinutes,
+			CreatedAt:        s.now(),
+		},
+	}
+
+	if req.UseLLM {
+		items, err := s.proposeWithLLM(ctx, plans, req)
+		switch {
+		case err != nil:
+			proposal.Warning = fmt.Sprintf("LLM planning failed (%v); using the built-in planner", err)
+		case len(items) == 0:
+			proposal.Warning = "LLM reply named no open chunks; using the built-in planner"
+		default:
+			proposal.Commitment.Items = items
+			proposal.Commitment.LLMAssisted = true
+			return proposal, nil
+		}
+	}
+
+	proposal.Commitment.Items = propose(plans, req.AvailableMinutes)
+	if len(proposal.Commitment.Items) == 0 {
+		return nil, fmt.Errorf("no open chunk fits in %d minutes", req.AvailableMinutes)
+	}
+	return proposal, nil
+}
+
+// proposeWithLLM asks the configured LLM to choose chunks.
+func (s *Service) proposeWithLLM(ctx context.Context, plans []*plan.Plan, req ProposeRequest) ([]Item, error) {
+	if s.provider == nil {
+		return nil, fmt.Errorf("no LLM provider configured")
+	}
+
+	callCtx := llm.WithCallInfo(ctx, llm.CallInfo{Operation: llm.OperationWeekPlan})
+	output, err := s.provider.Call(callCtx, buildPrompt(plans, req.AvailableMinutes, req.Goals))
+	if err != nil {
+		return nil, err
+	}
+	return parseSelection(output, plans), nil
+}
+
+// activePlans loads plans that are not completed or archived, in-progress
+// plans first. If ids is non-empty, only those plans are loaded.
+func (s *Service) activePlans(ctx context.Context, ids []string) ([]*plan.Plan, error) {
+	filter := &storage.PlanFilter{IDs: ids}
+	if len(ids) == 0 {
+		filter.Statuses = []string{string(plan.StatusInProgress), string(plan.StatusNotStarted)}
+	}
+
+	records, err := s.plans.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plans: %w", err)
+	}
+	if len(ids) > 0 && len(records) < len(ids) {
+		return nil, fmt.Errorf("%w: %s", plan.ErrPlanNotFound, missingID(ids, records))
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		iActive := records[i].Status == string(plan.StatusInProgress)
+		jActive := records[j].Status == string(plan.StatusInProgress)
+		if iActive != jActive {
+			return iActive
+		}
+		return records[i].ID < records[j].ID
+	})
+
+	plans := make([]*plan.Plan, 0, len(records))
+	for _, record := range records {
+		p, err := s.plans.Get(ctx, record.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load plan %s: %w", record.ID, err)
+		}
+		plans = append(plans, p)
+	}
+	return plans, nil
+}
+
+// missingID returns the first requested ID without a record.
+func missingID(ids []string, records []*storage.PlanRecord) string {
+	found := make(map[string]bool, len(records))
+	for _, record := range records {
+		found[record.ID] = true
+	}
+	for _, id := range ids {
+		if !found[id] {
+			return id
+		}
+	}
+	return ""
+}
+
+// Commit saves a commitment, replacing any existing one for its week.
+func (s *Service) Commit(ctx context.Context, commitment *Commitment) error {
+	if err := s.repo.Save(ctx, commitment); err != nil {
+		return fmt.Errorf("failed to save commitment: %w", err)
+	}
+	return nil
+}
+
+// Get returns the commitment for a week, or nil if there is none.
+func (s *Service) Get(ctx context.Context, weekStart time.Time) (*Commitment, error) {
+	commitment, err := s.repo.Get(ctx, weekStart)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load commitment: %w", err)
+	}
+	return commitment, nil
+}
+
+// ItemProgress is the outcome of one committed chunk.
+type ItemProgress struct {
+	Item
+	Status        plan.Status `json:"status"` // Current chunk status; empty if the chunk no longer exists
+	MinutesLogged int         `json:"minutes_logged"`
+	Done          bool        `json:"done"`
+}
+
+// Review compares a commitment with what actually happened that week.
+type Review struct {
+	Commitment *Commitment    `json:"commitment"`
+	Items      []ItemProgress `json:"items"`
+
+	CompletedItems   int `json:"completed_items"`
+	LoggedMinutes    int `json:"logged_minutes"`    // Time on committed chunks during the week
+	UnplannedMinutes int `json:"unplanned_minutes"` // Time on anything else during the week
+}
+
+// Adherence returns the percentage of committed chunks completed.
+func (r *Review) Adherence() int {
+	if len(r.Items) == 0 {
+		return 0
+	}
+	return r.CompletedItems * 100 / len(r.Items)
+}
+
+// Review reports progress on a week's commitment. Returns nil without
+// error if the week has no commitment.
+func (s *Service) Review(ctx context.Context, weekStart time.Time) (*Review, error) {
+	commitment, err := s.Get(ctx, weekStart)
+	if err != nil || commitment == nil {
+		return nil, err
+	}
+
+	sessions, err := s.sessions.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	logged := make(map[string]int)
+	total := 0
+	weekEnd := commitment.WeekEnd()
+	for _, sess := range sessions {
+		if sess.StartTime.Before(weekStart) || !sess.StartTime.Before(weekEnd) {
+			continue
+		}
+		logged[sess.PlanID+"/"+sess.ChunkID] += sess.Duration
+		total += sess.Duration
+	}
+
+	review := &Review{Commitment: commitment, Items: make([]ItemProgress, 0, len(commitment.Items))}
+	loadedPlans := make(map[string]*plan.Plan)
+
+	for _, item := range commitment.Items {
+		progress := ItemProgress{Item: item, MinutesLogged: logged[item.PlanID+"/"+item.ChunkID]}
+
+		p, ok := loadedPlans[item.PlanID]
+		if !ok {
+			p, _ = s.plans.Get(ctx, item.PlanID) // A deleted plan leaves the item without status
+			loadedPlans[item.PlanID] = p
+		}
+		if p != nil {
+			for _, chunk := range p.Chunks {
+				if chunk.ID == item.ChunkID {
+					progress.Status = chunk.Status
+					progress.Done = chunk.Status == plan.StatusCompleted
+					break
+				}
+			}
+		}
+
+		if progress.Done {
+			review.CompletedItems++
+		}
+		review.LoggedMinutes += progress.MinutesLogged
+		review.Items = append(review.Items, progress)
+	}
+	review.UnplannedMinutes = total - review.LoggedMinutes
+
+	return review, nil
+}
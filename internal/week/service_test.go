@@ -0,0 +1,170 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package week
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/llm"
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubPlans serves plans from memory.
+type stubPlans []*plan.Plan
+
+func (s stubPlans) Get(_ context.Context, id string) (*plan.Plan, error) {
+	for _, p := range s {
+		if p.ID == id {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("plan not found: %s", id)
+}
+
+func (s stubPlans) List(_ context.Context, filter *storage.PlanFilter) ([]*storage.PlanRecord, error) {
+	records := make([]*storage.PlanRecord, 0, len(s))
+	for _, p := range s {
+		if filter != nil && len(filter.IDs) > 0 && !containsString(filter.IDs, p.ID) {
+			continue
+		}
+		records = append(records, &storage.PlanRecord{ID: p.ID, Status: string(p.Status)})
+	}
+	return records, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+type stubSessions []*session.Session
+
+func (s stubSessions) ListAll(_ context.Context) ([]*session.Session, error) {
+	return s, nil
+}
+
+func newTestService(t *testing.T, plans stubPlans, sessions stubSessions) *Service {
+	t.Helper()
+	return NewService(NewSQLiteRepository(setupTestDB(t)), plans, sessions)
+}
+
+func TestService_Propose(t *testing.T) {
+	plans := testPlans()
+	plans[0].Status = plan.StatusInProgress
+	plans[1].Status = plan.StatusNotStarted
+	svc := newTestService(t, stubPlans(plans), nil)
+
+	weekStart := time.Date(2025, 10, 13, 0, 0, 0, 0, time.Local)
+	proposal, err := svc.Propose(context.Background(), ProposeRequest{
+		WeekStart:        weekStart,
+		AvailableMinutes: 120,
+		Goals:            "steady progress",
+	})
+	require.NoError(t, err)
+
+	commitment := proposal.Commitment
+	assert.Equal(t, weekStart, commitment.WeekStart)
+	assert.Equal(t, "steady progress", commitment.Goals)
+	assert.False(t, commitment.LLMAssisted)
+	assert.Equal(t, []string{"rust/chunk-002", "french/chunk-001"}, itemKeys(commitment.Items))
+}
+
+func TestService_Propose_UnknownPlan(t *testing.T) {
+	svc := newTestService(t, stubPlans(testPlans()), nil)
+
+	_, err := svc.Propose(context.Background(), ProposeRequest{AvailableMinutes: 120, PlanIDs: []string{"music"}})
+	assert.ErrorIs(t, err, plan.ErrPlanNotFound)
+	assert.ErrorContains(t, err, "music")
+}
+
+func TestService_Propose_WithLLM(t *testing.T) {
+	svc := newTestService(t, stubPlans(testPlans()), nil)
+	mock := llm.NewMockProvider()
+	mock.DefaultResponse = "french/chunk-001\nfrench/chunk-003\n"
+	svc.SetLLMProvider(mock)
+
+	proposal, err := svc.Propose(context.Background(), ProposeRequest{AvailableMinutes: 120, UseLLM: true})
+	require.NoError(t, err)
+
+	assert.Empty(t, proposal.Warning)
+	assert.True(t, proposal.Commitment.LLMAssisted)
+	assert.Equal(t, []string{"french/chunk-001", "french/chunk-003"}, itemKeys(proposal.Commitment.Items))
+	assert.Contains(t, mock.LastPrompt, "120 minutes")
+}
+
+func TestService_Propose_LLMFallback(t *testing.T) {
+	svc := newTestService(t, stubPlans(testPlans()), nil)
+	mock := llm.NewMockProvider()
+	mock.DefaultResponse = "Sorry, I can't help with that."
+	svc.SetLLMProvider(mock)
+
+	proposal, err := svc.Propose(context.Background(), ProposeRequest{AvailableMinutes: 120, UseLLM: true})
+	require.NoError(t, err)
+
+	assert.Contains(t, proposal.Warning, "built-in planner")
+	assert.False(t, proposal.Commitment.LLMAssisted)
+	assert.NotEmpty(t, proposal.Commitment.Items)
+}
+
+func TestService_Review(t *testing.T) {
+	plans := testPlans()
+	weekStart := time.Date(2025, 10, 13, 0, 0, 0, 0, time.Local)
+
+	sessions := stubSessions{
+		{PlanID: "rust", ChunkID: "chunk-002", StartTime: weekStart.Add(26 * time.Hour), Duration: 50},
+		{PlanID: "rust", ChunkID: "chunk-002", StartTime: weekStart.Add(50 * time.Hour), Duration: 20},
+		{PlanID: "french", ChunkID: "chunk-003", StartTime: weekStart.Add(74 * time.Hour), Duration: 30}, // unplanned
+		{PlanID: "rust", ChunkID: "chunk-002", StartTime: weekStart.Add(-time.Hour), Duration: 45},       // previous week
+	}
+	svc := newTestService(t, stubPlans(plans), sessions)
+	ctx := context.Background()
+
+	require.NoError(t, svc.Commit(ctx, &Commitment{
+		WeekStart:        weekStart,
+		AvailableMinutes: 180,
+		Items: []Item{
+			{PlanID: "rust", ChunkID: "chunk-002", Minutes: 60},
+			{PlanID: "french", ChunkID: "chunk-001", Minutes: 45},
+			{PlanID: "gone", ChunkID: "chunk-001", Minutes: 30},
+		},
+		CreatedAt: time.Now(),
+	}))
+
+	// rust/chunk-002 gets completed during the week
+	plans[0].Chunks[1].Status = plan.StatusCompleted
+
+	review, err := svc.Review(ctx, weekStart)
+	require.NoError(t, err)
+	require.NotNil(t, review)
+	require.Len(t, review.Items, 3)
+
+	assert.True(t, review.Items[0].Done)
+	assert.Equal(t, 70, review.Items[0].MinutesLogged)
+	assert.Equal(t, plan.StatusNotStarted, review.Items[1].Status)
+	assert.Equal(t, plan.Status(""), review.Items[2].Status, "deleted plan has no status")
+
+	assert.Equal(t, 1, review.CompletedItems)
+	assert.Equal(t, 33, review.Adherence())
+	assert.Equal(t, 70, review.LoggedMinutes)
+	assert.Equal(t, 30, review.UnplannedMinutes)
+}
+
+func TestService_Review_NoCommitment(t *testing.T) {
+	svc := newTestService(t, stubPlans(testPlans()), nil)
+
+	review, err := svc.Review(context.Background(), time.Date(2025, 10, 13, 0, 0, 0, 0, time.Local))
+	require.NoError(t, err)
+	assert.Nil(t, review)
+}
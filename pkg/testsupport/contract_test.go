@@ -0,0 +1,411 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package testsupport
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pezware/samedi.dev/internal/plan"
+	"github.com/pezware/samedi.dev/internal/session"
+	"github.com/pezware/samedi.dev/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// repositories is one implementation of each repository, sharing storage
+// the way the SQLite repositories share a database.
+type repositories struct {
+	plans    storage.PlanRepository
+	sessions session.Repository
+}
+
+// implementations returns a factory per implementation under contract.
+func implementations() map[string]func(t *testing.T) repositories {
+	return map[string]func(t *testing.T) repositories{
+		"sqlite": func(t *testing.T) repositories {
+			t.Helper()
+			db, err := storage.NewSQLiteDB(":memory:")
+			require.NoError(t, err)
+			require.NoError(t, storage.NewMigrator(db).Migrate())
+			t.Cleanup(func() { _ = db.Close() })
+			return repositories{
+				plans:    plan.NewSQLiteRepository(db),
+				sessions: session.NewSQLiteRepository(db),
+			}
+		},
+		"memory": func(_ *testing.T) repositories {
+			sessions := NewSessionRepository()
+			return repositories{
+				plans:    NewPlanRepository(sessions),
+				sessions: sessions,
+			}
+		},
+	}
+}
+
+// runContract runs a test against every implementation.
+func runContract(t *testing.T, test func(t *testing.T, repos repositories)) {
+	for name, factory := range implementations() {
+		t.Run(name, func(t *testing.T) {
+			test(t, factory(t))
+		})
+	}
+}
+
+var baseTime = time.Date(2025, 10, 14, 9, 0, 0, 0, time.UTC)
+
+func completedSession(id, planID string, start time.Time) *session.Session {
+	end := start.Add(time.Hour)
+	return &session.Session{
+		ID:        id,
+		PlanID:    planID,
+		ChunkID:   "chunk-001",
+		StartTime: start,
+		EndTime:   &end,
+		Duration:  60,
+		Artifacts: []string{"https://example.com"},
+		CreatedAt: start,
+	}
+}
+
+func planRecord(id string, created time.Time) *storage.PlanRecord {
+	return &storage.PlanRecord{
+		ID:         id,
+		Title:      "Plan " + id,
+		CreatedAt:  created,
+		UpdatedAt:  created,
+		TotalHours: 10,
+		Status:     "not-started",
+		Tags:       []string{"Programming"},
+		FilePath:   "/plans/" + id + ".md",
+	}
+}
+
+func sessionIDs(sessions []*session.Session) []string {
+	ids := make([]string, 0, len(sessions))
+	for _, sess := range sessions {
+		ids = append(ids, sess.ID)
+	}
+	return ids
+}
+
+func planIDs(records []*storage.PlanRecord) []string {
+	ids := make([]string, 0, len(records))
+	for _, record := range records {
+		ids = append(ids, record.ID)
+	}
+	return ids
+}
+
+func TestSessionRepositoryContract_CreateAndGet(t *testing.T) {
+	runContract(t, func(t *testing.T, repos repositories) {
+		ctx := context.Background()
+		sess := completedSession("s1", "p1", baseTime)
+		require.NoError(t, repos.sessions.Create(ctx, sess))
+
+		got, err := repos.sessions.Get(ctx, "s1")
+		require.NoError(t, err)
+		assert.Equal(t, "p1", got.PlanID)
+		assert.Equal(t, "chunk-001", got.ChunkID)
+		assert.True(t, baseTime.Equal(got.StartTime))
+		require.NotNil(t, got.EndTime)
+		assert.Equal(t, []string{"https://example.com"}, got.Artifacts)
+
+		// Returned sessions are copies
+		got.Artifacts[0] = "changed"
+		again, err := repos.sessions.Get(ctx, "s1")
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com", again.Artifacts[0])
+
+		assert.Error(t, repos.sessions.Create(ctx, sess), "duplicate ID")
+
+		_, err = repos.sessions.Get(ctx, "missing")
+		assert.ErrorContains(t, err, "session not found")
+	})
+}
+
+func TestSessionRepositoryContract_GetActive(t *testing.T) {
+	runContract(t, func(t *testing.T, repos repositories) {
+		ctx := context.Background()
+
+		active, err := repos.sessions.GetActive(ctx)
+		require.NoError(t, err)
+		assert.Nil(t, active)
+
+		require.NoError(t, repos.sessions.Create(ctx, completedSession("done", "p1", baseTime)))
+		for i, id := range []string{"older", "newer"} {
+			start := baseTime.Add(time.Duration(i+1) * time.Hour)
+			require.NoError(t, repos.sessions.Create(ctx, &session.Session{
+				ID: id, PlanID: "p1", StartTime: start, CreatedAt: start,
+			}))
+		}
+
+		active, err = repos.sessions.GetActive(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, active)
+		assert.Equal(t, "newer", active.ID)
+	})
+}
+
+func TestSessionRepositoryContract_Update(t *testing.T) {
+	runContract(t, func(t *testing.T, repos repositories) {
+		ctx := context.Background()
+		require.NoError(t, repos.sessions.Create(ctx, completedSession("s1", "p1", baseTime)))
+
+		updated := completedSession("s1", "p1", baseTime)
+		updated.Notes = "Finished"
+		updated.CreatedAt = baseTime.Add(48 * time.Hour)
+		require.NoError(t, repos.sessions.Update(ctx, updated))
+
+		got, err := repos.sessions.Get(ctx, "s1")
+		require.NoError(t, err)
+		assert.Equal(t, "Finished", got.Notes)
+		assert.True(t, baseTime.Equal(got.CreatedAt), "created_at is not updated")
+
+		err = repos.sessions.Update(ctx, completedSession("missing", "p1", baseTime))
+		assert.ErrorContains(t, err, "session not found")
+	})
+}
+
+func TestSessionRepositoryContract_List(t *testing.T) {
+	runContract(t, func(t *testing.T, repos repositories) {
+		ctx := context.Background()
+		require.NoError(t, repos.sessions.Create(ctx, completedSession("a1", "a", baseTime)))
+		require.NoError(t, repos.sessions.Create(ctx, completedSession("b1", "b", baseTime.Add(2*time.Hour))))
+		require.NoError(t, repos.sessions.Create(ctx, completedSession("a2", "a", baseTime.Add(4*time.Hour))))
+
+		all, err := repos.sessions.List(ctx, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a2", "b1", "a1"}, sessionIDs(all))
+
+		limited, err := repos.sessions.List(ctx, &session.Filter{Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a2", "b1"}, sessionIDs(limited))
+
+		byPlan, err := repos.sessions.List(ctx, &session.Filter{PlanID: "a", Limit: 1})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a2"}, sessionIDs(byPlan))
+
+		getByPlan, err := repos.sessions.GetByPlan(ctx, "a")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a2", "a1"}, sessionIDs(getByPlan))
+
+		none, err := repos.sessions.List(ctx, &session.Filter{PlanID: "missing"})
+		require.NoError(t, err)
+		assert.NotNil(t, none)
+		assert.Empty(t, none)
+	})
+}
+
+func TestSessionRepositoryContract_ListFilter(t *testing.T) {
+	runContract(t, func(t *testing.T, repos repositories) {
+		ctx := context.Background()
+		first := completedSession("a1", "a", baseTime)
+		first.Notes = "Read the Tokio tutorial"
+		second := completedSession("a2", "a", baseTime.Add(2*time.Hour))
+		second.ChunkID = "chunk-003"
+		second.Duration = 20
+		third := completedSession("b1", "b", baseTime.Add(4*time.Hour))
+		third.ChunkID = "chunk-003"
+		third.Notes = "tokio channels"
+		for _, sess := range []*session.Session{first, second, third} {
+			require.NoError(t, repos.sessions.Create(ctx, sess))
+		}
+
+		byChunk, err := repos.sessions.List(ctx, &session.Filter{ChunkID: "chunk-003"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"b1", "a2"}, sessionIDs(byChunk))
+
+		window, err := repos.sessions.List(ctx, &session.Filter{Since: baseTime.Add(time.Hour), Until: baseTime.Add(4 * time.Hour)})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a2"}, sessionIDs(window), "since is inclusive, until exclusive")
+
+		inOtherZone, err := repos.sessions.List(ctx, &session.Filter{Since: baseTime.Add(2 * time.Hour).In(time.FixedZone("UTC+9", 9*3600))})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"b1", "a2"}, sessionIDs(inOtherZone), "times compare as instants")
+
+		long, err := repos.sessions.List(ctx, &session.Filter{MinMinutes: 30})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"b1", "a1"}, sessionIDs(long))
+
+		grep, err := repos.sessions.List(ctx, &session.Filter{NoteContains: "TOKIO", Limit: 1})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"b1"}, sessionIDs(grep), "notes match ignoring case, then the limit applies")
+	})
+}
+
+func TestSessionRepositoryContract_Delete(t *testing.T) {
+	runContract(t, func(t *testing.T, repos repositories) {
+		ctx := context.Background()
+		require.NoError(t, repos.sessions.Create(ctx, completedSession("s1", "p1", baseTime)))
+
+		require.NoError(t, repos.sessions.Delete(ctx, "s1"))
+		_, err := repos.sessions.Get(ctx, "s1")
+		assert.Error(t, err)
+
+		assert.ErrorContains(t, repos.sessions.Delete(ctx, "s1"), "session not found")
+	})
+}
+
+func TestSessionRepositoryContract_Artifacts(t *testing.T) {
+	runContract(t, func(t *testing.T, repos repositories) {
+		ctx := context.Background()
+		empty := completedSession("empty", "p1", baseTime)
+		empty.Artifacts = []string{}
+		none := completedSession("none", "p1", baseTime)
+		none.Artifacts = nil
+		require.NoError(t, repos.sessions.Create(ctx, empty))
+		require.NoError(t, repos.sessions.Create(ctx, none))
+
+		got, err := repos.sessions.Get(ctx, "empty")
+		require.NoError(t, err)
+		assert.NotNil(t, got.Artifacts)
+		assert.Empty(t, got.Artifacts)
+
+		got, err = repos.sessions.Get(ctx, "none")
+		require.NoError(t, err)
+		assert.Nil(t, got.Artifacts)
+	})
+}
+
+func TestPlanRepositoryContract_UpsertAndGet(t *testing.T) {
+	runContract(t, func(t *testing.T, repos repositories) {
+		ctx := context.Background()
+		require.NoError(t, repos.plans.Upsert(ctx, planRecord("p1", baseTime)))
+
+		updated := planRecord("p1", baseTime.Add(24*time.Hour))
+		updated.Title = "Renamed"
+		updated.Status = "in-progress"
+		require.NoError(t, repos.plans.Upsert(ctx, updated))
+
+		got, err := repos.plans.Get(ctx, "p1")
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed", got.Title)
+		assert.Equal(t, "in-progress", got.Status)
+		assert.Equal(t, []string{"Programming"}, got.Tags)
+		assert.True(t, baseTime.Equal(got.CreatedAt), "created_at is kept on update")
+		assert.Nil(t, got.LastSession)
+
+		_, err = repos.plans.Get(ctx, "missing")
+		assert.ErrorContains(t, err, "plan not found")
+	})
+}
+
+func TestPlanRepositoryContract_Constraints(t *testing.T) {
+	runContract(t, func(t *testing.T, repos repositories) {
+		ctx := context.Background()
+
+		invalid := planRecord("p1", baseTime)
+		invalid.Status = "paused"
+		assert.Error(t, repos.plans.Upsert(ctx, invalid))
+
+		require.NoError(t, repos.plans.Upsert(ctx, planRecord("p1", baseTime)))
+		clash := planRecord("p2", baseTime)
+		clash.FilePath = "/plans/p1.md"
+		assert.Error(t, repos.plans.Upsert(ctx, clash))
+	})
+}
+
+func TestPlanRepositoryContract_ListFilters(t *testing.T) {
+	runContract(t, func(t *testing.T, repos repositories) {
+		ctx := context.Background()
+		for i, id := range []string{"p1", "p2", "p3"} {
+			record := planRecord(id, baseTime.Add(time.Duration(i)*time.Hour))
+			if id == "p2" {
+				record.Status = "completed"
+				record.Tags = []string{"music"}
+			}
+			require.NoError(t, repos.plans.Upsert(ctx, record))
+		}
+
+		all, err := repos.plans.List(ctx, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"p3", "p2", "p1"}, planIDs(all))
+
+		byID, err := repos.plans.List(ctx, &storage.PlanFilter{IDs: []string{"p1", "p3"}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"p3", "p1"}, planIDs(byID))
+
+		byStatus, err := repos.plans.List(ctx, &storage.PlanFilter{Statuses: []string{"completed"}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"p2"}, planIDs(byStatus))
+
+		byTag, err := repos.plans.List(ctx, &storage.PlanFilter{Tags: []string{"programming"}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"p3", "p1"}, planIDs(byTag))
+
+		allTags, err := repos.plans.List(ctx, &storage.PlanFilter{Tags: []string{"programming", "music"}})
+		require.NoError(t, err)
+		assert.Empty(t, allTags)
+
+		anyTag, err := repos.plans.List(ctx, &storage.PlanFilter{Tags: []string{"programming", "music"}, AnyTag: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"p3", "p2", "p1"}, planIDs(anyTag))
+
+		// Tags match whole, not as substrings
+		partial, err := repos.plans.List(ctx, &storage.PlanFilter{Tags: []string{"program"}})
+		require.NoError(t, err)
+		assert.Empty(t, partial)
+
+		none, err := repos.plans.List(ctx, &storage.PlanFilter{Tags: []string{"cooking"}})
+		require.NoError(t, err)
+		assert.Empty(t, none)
+	})
+}
+
+func TestPlanRepositoryContract_ListSort(t *testing.T) {
+	runContract(t, func(t *testing.T, repos repositories) {
+		ctx := context.Background()
+		for i, id := range []string{"b", "c", "a"} {
+			record := planRecord(id, baseTime.Add(time.Duration(i)*time.Hour))
+			record.Title = id
+			record.TotalHours = float64(10 * (i + 1))
+			record.UpdatedAt = baseTime.Add(time.Duration(10-i) * time.Hour)
+			require.NoError(t, repos.plans.Upsert(ctx, record))
+		}
+
+		tests := map[string][]string{
+			"title":   {"a", "b", "c"},
+			"hours":   {"a", "c", "b"},
+			"updated": {"b", "c", "a"},
+			"created": {"a", "c", "b"},
+			"bogus":   {"a", "c", "b"},
+		}
+		for sortBy, want := range tests {
+			got, err := repos.plans.List(ctx, &storage.PlanFilter{SortBy: sortBy})
+			require.NoError(t, err)
+			assert.Equal(t, want, planIDs(got), sortBy)
+		}
+	})
+}
+
+func TestPlanRepositoryContract_LastSession(t *testing.T) {
+	runContract(t, func(t *testing.T, repos repositories) {
+		ctx := context.Background()
+		require.NoError(t, repos.plans.Upsert(ctx, planRecord("p1", baseTime)))
+		require.NoError(t, repos.sessions.Create(ctx, completedSession("s1", "p1", baseTime)))
+		latest := baseTime.Add(24 * time.Hour)
+		require.NoError(t, repos.sessions.Create(ctx, completedSession("s2", "p1", latest)))
+
+		got, err := repos.plans.Get(ctx, "p1")
+		require.NoError(t, err)
+		require.NotNil(t, got.LastSession)
+		assert.True(t, latest.Equal(*got.LastSession))
+	})
+}
+
+func TestPlanRepositoryContract_Delete(t *testing.T) {
+	runContract(t, func(t *testing.T, repos repositories) {
+		ctx := context.Background()
+		require.NoError(t, repos.plans.Upsert(ctx, planRecord("p1", baseTime)))
+
+		require.NoError(t, repos.plans.Delete(ctx, "p1"))
+		_, err := repos.plans.Get(ctx, "p1")
+		assert.Error(t, err)
+
+		assert.ErrorContains(t, repos.plans.Delete(ctx, "p1"), "plan not found")
+	})
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+package testsupport
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pezware/samedi.dev/internal/storage"
+)
+
+// validPlanStatuses mirrors the CHECK constraint on plans.status.
+var validPlanStatuses = map[string]bool{
+	"not-started": true,
+	"in-progress": true,
+	"completed":   true,
+	"archived":    true,
+}
+
+// PlanRepository is an in-memory storage.PlanRepository.
+type PlanRepository struct {
+	mu       sync.RWMutex
+	records  map[string]*storage.PlanRecord
+	order    []string           // Insertion order, for stable sorting of ties
+	sessions *SessionRepository // Optional - supplies LastSession
+}
+
+// NewPlanRepository creates an empty in-memory plan repository. If
+// sessions is non-nil, records read back carry LastSession from it, as the
+// SQLite repository joins it from the sessions table.
+func NewPlanRepository(sessions *SessionRepository) *PlanRepository {
+	return &PlanRepository{
+		records:  make(map[string]*storage.PlanRecord),
+		sessions: sessions,
+	}
+}
+
+// Upsert creates or updates a plan's metadata. On update, CreatedAt is
+// kept from the original record.
+func (r *PlanRepository) Upsert(_ context.Context, record *storage.PlanRecord) error {
+	if !validPlanStatuses[record.Status] {
+		return fmt.Errorf("failed to upsert plan: invalid status %q", record.Status)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, existing := range r.records {
+		if id != record.ID && existing.FilePath == record.FilePath {
+			return fmt.Errorf("failed to upsert plan: file path already used by plan %s", id)
+		}
+	}
+
+	stored := copyPlanRecord(record)
+	stored.LastSession = nil
+	if existing, ok := r.records[record.ID]; ok {
+		stored.CreatedAt = existing.CreatedAt
+	} else {
+		r.order = append(r.order, record.ID)
+	}
+	r.records[record.ID] = stored
+	return nil
+}
+
+// Get retrieves a plan's metadata by ID.
+func (r *PlanRepository) Get(_ context.Context, id string) (*storage.PlanRecord, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	record, ok := r.records[id]
+	if !ok {
+		return nil, fmt.Errorf("plan not found: %s", id)
+	}
+	return r.read(record), nil
+}
+
+// List retrieves plans matching filter, sorted by filter.SortBy (newest
+// first by default). Tags match whole tags, ignoring case.
+func (r *PlanRepository) List(_ context.Context, filter *storage.PlanFilter) ([]*storage.PlanRecord, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var records []*storage.PlanRecord
+	for _, id := range r.order {
+		record := r.records[id]
+		if matchesPlanFilter(record, filter) {
+			records = append(records, r.read(record))
+		}
+	}
+
+	sortBy := ""
+	if filter != nil {
+		sortBy = filter.SortBy
+	}
+	sort.SliceStable(records, planLess(records, sortBy))
+
+	return records, nil
+}
+
+// Delete removes a plan's metadata.
+func (r *PlanRepository) Delete(_ context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.records[id]; !ok {
+		return fmt.Errorf("plan not found: %s", id)
+	}
+	delete(r.records, id)
+	for i, existing := range r.order {
+		if existing == id {
+			r.order = append(r.order[:i], r.order[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// read copies a stored record and fills in LastSession.
+// Callers must hold the lock.
+func (r *PlanRepository) read(record *storage.PlanRecord) *storage.PlanRecord {
+	cp := copyPlanRecord(record)
+	if r.sessions != nil {
+		if last := r.sessions.lastSession(record.ID); last != nil {
+			start := last.StartTime
+			cp.LastSession = &start
+		}
+	}
+	return cp
+}
+
+// matchesPlanFilter reports whether record passes every set filter field.
+func matchesPlanFilter(record *storage.PlanRecord, filter *storage.PlanFilter) bool {
+	if filter == nil {
+		return true
+	}
+	if len(filter.IDs) > 0 && !contains(filter.IDs, record.ID) {
+		return false
+	}
+	if len(filter.Statuses) > 0 && !contains(filter.Statuses, record.Status) {
+		return false
+	}
+	if len(filter.Tags) > 0 {
+		matched := 0
+		for _, want := range filter.Tags {
+			for _, tag := range record.Tags {
+				if strings.EqualFold(tag, want) {
+					matched++
+					break
+				}
+			}
+		}
+		if matched == 0 || (!filter.AnyTag && matched < len(filter.Tags)) {
+			return false
+		}
+	}
+	return true
+}
+
+// planLess orders records like the SQLite repository's ORDER BY clauses.
+// Unknown sort fields fall back to newest first.
+func planLess(records []*storage.PlanRecord, sortBy string) func(i, j int) bool {
+	switch sortBy {
+	case "updated":
+		return func(i, j int) bool { return records[i].UpdatedAt.After(records[j].UpdatedAt) }
+	case "title":
+		return func(i, j int) bool { return records[i].Title < records[j].Title }
+	case "status":
+		return func(i, j int) bool { return records[i].Status < records[j].Status }
+	case "hours":
+		return func(i, j int) bool { return records[i].TotalHours > records[j].TotalHours }
+	default:
+		return func(i, j int) bool { return records[i].CreatedAt.After(records[j].CreatedAt) }
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// copyPlanRecord returns a deep copy of a plan record.
+func copyPlanRecord(record *storage.PlanRecord) *storage.PlanRecord {
+	cp := *record
+	if record.Tags != nil {
+		cp.Tags = append([]string{}, record.Tags...)
+	}
+	if record.LastSession != nil {
+		last := *record.LastSession
+		cp.LastSession = &last
+	}
+	return &cp
+}
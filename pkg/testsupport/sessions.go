@@ -0,0 +1,169 @@
+// Copyright (c) 2025 Samedi Contributors
+// SPDX-License-Identifier: MIT
+
+// Package testsupport provides in-memory repository implementations for
+// tests. Each one follows the semantics of its SQLite counterpart, including
+// ordering, not-found errors, and copy-on-read, so code tested against a
+// double behaves the same against the real database. The contract tests in
+// this package run the same cases against both implementations.
+//
+// The package lives under pkg/ so code outside this module, such as
+// plugins, can test against the same doubles.
+package testsupport
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/pezware/samedi.dev/internal/session"
+)
+
+// SessionRepository is an in-memory session.Repository.
+type SessionRepository struct {
+	mu       sync.RWMutex
+	sessions map[string]*session.Session
+	order    []string // Insertion order, for stable sorting of equal start times
+}
+
+// NewSessionRepository creates an empty in-memory session repository.
+func NewSessionRepository() *SessionRepository {
+	return &SessionRepository{sessions: make(map[string]*session.Session)}
+}
+
+// Create inserts a new session. Creating a duplicate ID is an error.
+func (r *SessionRepository) Create(_ context.Context, sess *session.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.sessions[sess.ID]; exists {
+		return fmt.Errorf("failed to create session: session already exists: %s", sess.ID)
+	}
+	r.sessions[sess.ID] = copySession(sess)
+	r.order = append(r.order, sess.ID)
+	return nil
+}
+
+// Get retrieves a session by ID.
+func (r *SessionRepository) Get(_ context.Context, id string) (*session.Session, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	sess, ok := r.sessions[id]
+	if !ok {
+		return nil, fmt.Errorf("session not found: %s", id)
+	}
+	return copySession(sess), nil
+}
+
+// GetActive retrieves the most recently started session without an end
+// time. Returns nil if no active session exists.
+func (r *SessionRepository) GetActive(_ context.Context) (*session.Session, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, sess := range r.sorted() {
+		if sess.EndTime == nil {
+			return copySession(sess), nil
+		}
+	}
+	return nil, nil
+}
+
+// Update replaces an existing session. CreatedAt is never changed.
+func (r *SessionRepository) Update(_ context.Context, sess *session.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.sessions[sess.ID]
+	if !ok {
+		return fmt.Errorf("session not found: %s", sess.ID)
+	}
+	updated := copySession(sess)
+	updated.CreatedAt = existing.CreatedAt
+	r.sessions[sess.ID] = updated
+	return nil
+}
+
+// List retrieves the sessions that pass filter, ordered by start time
+// descending. A nil filter returns every session.
+func (r *SessionRepository) List(_ context.Context, filter *session.Filter) ([]*session.Session, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	sessions := make([]*session.Session, 0)
+	for _, sess := range r.sorted() {
+		if !filter.Matches(sess) {
+			continue
+		}
+		sessions = append(sessions, copySession(sess))
+		if filter != nil && filter.Limit > 0 && len(sessions) == filter.Limit {
+			break
+		}
+	}
+	return sessions, nil
+}
+
+// GetByPlan retrieves all sessions for a plan, newest first.
+func (r *SessionRepository) GetByPlan(ctx context.Context, planID string) ([]*session.Session, error) {
+	return r.List(ctx, &session.Filter{PlanID: planID})
+}
+
+// Delete removes a session by ID.
+func (r *SessionRepository) Delete(_ context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.sessions[id]; !ok {
+		return fmt.Errorf("session not found: %s", id)
+	}
+	delete(r.sessions, id)
+	for i, existing := range r.order {
+		if existing == id {
+			r.order = append(r.order[:i], r.order[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// sorted returns stored sessions ordered by start time descending.
+// Callers must hold the lock.
+func (r *SessionRepository) sorted() []*session.Session {
+	sessions := make([]*session.Session, 0, len(r.order))
+	for _, id := range r.order {
+		sessions = append(sessions, r.sessions[id])
+	}
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].StartTime.After(sessions[j].StartTime)
+	})
+	return sessions
+}
+
+// lastSession returns the latest session start for a plan, or nil.
+func (r *SessionRepository) lastSession(planID string) *session.Session {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, sess := range r.sorted() {
+		if sess.PlanID == planID {
+			return sess
+		}
+	}
+	return nil
+}
+
+// copySession returns a deep copy so callers can't mutate stored state,
+// matching a database round trip.
+func copySession(sess *session.Session) *session.Session {
+	cp := *sess
+	if sess.EndTime != nil {
+		end := *sess.EndTime
+		cp.EndTime = &end
+	}
+	if sess.Artifacts != nil {
+		cp.Artifacts = append([]string{}, sess.Artifacts...)
+	}
+	return &cp
+}